@@ -9,21 +9,27 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
 	// Infrastructure
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 	"github.com/sogos/mirai-backend/internal/infrastructure/config"
+	"github.com/sogos/mirai-backend/internal/infrastructure/crawler"
 	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
-	"github.com/sogos/mirai-backend/internal/infrastructure/external/gemini"
+	"github.com/sogos/mirai-backend/internal/infrastructure/export"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/aiprovider"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/kratos"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/smtp"
 	"github.com/sogos/mirai-backend/internal/infrastructure/external/stripe"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/xapi"
+	"github.com/sogos/mirai-backend/internal/infrastructure/extraction"
 	"github.com/sogos/mirai-backend/internal/infrastructure/logging"
 	"github.com/sogos/mirai-backend/internal/infrastructure/persistence/postgres"
 	"github.com/sogos/mirai-backend/internal/infrastructure/pubsub"
 	"github.com/sogos/mirai-backend/internal/infrastructure/storage"
+	"github.com/sogos/mirai-backend/internal/infrastructure/transcription"
 	"github.com/sogos/mirai-backend/internal/infrastructure/worker"
 	"github.com/sogos/mirai-backend/pkg/httputil"
 
@@ -65,6 +71,7 @@ func main() {
 	teamRepo := postgres.NewTeamRepository(db.DB)
 	invitationRepo := postgres.NewInvitationRepository(db.DB)
 	pendingRegRepo := postgres.NewPendingRegistrationRepository(db.DB)
+	stripeWebhookEventRepo := postgres.NewStripeWebhookEventRepository(db.DB)
 	courseRepo := postgres.NewCourseRepository(db.DB)
 	folderRepo := postgres.NewFolderRepository(db.DB)
 
@@ -73,12 +80,15 @@ func main() {
 	smeTaskRepo := postgres.NewSMETaskRepository(db.DB)
 	smeSubmissionRepo := postgres.NewSMESubmissionRepository(db.DB)
 	smeKnowledgeRepo := postgres.NewSMEKnowledgeRepository(db.DB)
+	smeKnowledgeVersionRepo := postgres.NewSMEKnowledgeVersionRepository(db.DB)
 
 	// Target Audience repository
 	targetAudienceRepo := postgres.NewTargetAudienceRepository(db.DB)
 
 	// AI & Generation repositories
 	aiSettingsRepo := postgres.NewTenantAISettingsRepository(db.DB)
+	tokenUsageRepo := postgres.NewTokenUsageRepository(db.DB)
+	lrsSettingsRepo := postgres.NewTenantLRSSettingsRepository(db.DB)
 	notificationRepo := postgres.NewNotificationRepository(db.DB)
 	outlineRepo := postgres.NewCourseOutlineRepository(db.DB)
 	sectionRepo := postgres.NewOutlineSectionRepository(db.DB)
@@ -87,10 +97,18 @@ func main() {
 	componentRepo := postgres.NewLessonComponentRepository(db.DB)
 	genInputRepo := postgres.NewCourseGenerationInputRepository(db.DB)
 	generationJobRepo := postgres.NewGenerationJobRepository(db.DB, cfg.StaleJobTimeoutMinutes)
+	courseExportRepo := postgres.NewCourseExportRepository(db.DB)
 
 	// Initialize shared HTTP client
 	httpClient := httputil.NewClient()
 
+	// LRS endpoints are tenant-supplied, so requests to them may only reach public addresses
+	lrsHTTPClient := httputil.NewPublicClient(httputil.DefaultTimeout, nil)
+	if cfg.LRSAllowPrivateNet {
+		lrsHTTPClient = httputil.NewClient()
+	}
+	lrsClient := xapi.NewClient(lrsHTTPClient)
+
 	// Initialize external clients
 	kratosClient := kratos.NewClient(httpClient, cfg.KratosURL, cfg.KratosAdminURL)
 	stripeClient := stripe.NewClient(
@@ -167,23 +185,39 @@ func main() {
 	// WARNING: Only use for non-tenant-specific data
 	globalCache := cache.NewGlobalCache(baseCache)
 
+	// Tenant suspension: enforced on RPCs, presigned URLs, exports and tenant emails
+	suspendedTenantAccess := connectserver.SuspendedTenantAccess(cfg.SuspendedTenantAccess)
+	if !suspendedTenantAccess.IsValid() {
+		logger.Error("invalid SUSPENDED_TENANT_ACCESS, expected read_only or blocked", "value", cfg.SuspendedTenantAccess)
+		os.Exit(1)
+	}
+	tenantSuspensionService := service.NewTenantSuspensionService(tenantRepo, globalCache, logger)
+	tenantStorage.SetAccessChecker(tenantSuspensionService)
+	if emailClient != nil {
+		emailClient = tenantSuspensionService.GuardEmail(emailClient)
+	}
+
 	// Initialize Redis pub/sub for real-time notifications
 	var notificationPubSub pubsub.Publisher
 	var notificationSubscriber pubsub.Subscriber
+	var jobEventBroker service.JobEventBroker // Live generation job progress (WatchJob)
 	if cfg.RedisURL != "" {
 		redisPubSub, err := pubsub.NewRedisPubSub(pubsub.RedisConfig{URL: cfg.RedisURL}, logger)
 		if err != nil {
 			logger.Warn("failed to initialize Redis pub/sub, real-time notifications disabled", "error", err)
 			notificationPubSub = pubsub.NewNoOpPubSub()
 			notificationSubscriber = pubsub.NewNoOpPubSub()
+			jobEventBroker = pubsub.NewNoOpPubSub()
 		} else {
 			notificationPubSub = redisPubSub
 			notificationSubscriber = redisPubSub
+			jobEventBroker = redisPubSub
 			logger.Info("Redis pub/sub initialized for real-time notifications")
 		}
 	} else {
 		notificationPubSub = pubsub.NewNoOpPubSub()
 		notificationSubscriber = pubsub.NewNoOpPubSub()
+		jobEventBroker = pubsub.NewNoOpPubSub()
 		logger.Warn("Redis URL not configured, real-time notifications disabled")
 	}
 
@@ -201,21 +235,34 @@ func main() {
 		logger.Warn("ENCRYPTION_KEY not configured, AI features requiring API keys will not work")
 	}
 
+	// Plan entitlements (SME, course and AI token limits, export formats and features)
+	entitlementService := service.NewEntitlementService(companyRepo, smeRepo, courseRepo, logger)
+
 	// Initialize application services
 	authService := service.NewAuthService(userRepo, companyRepo, invitationRepo, pendingRegRepo, kratosClient, stripeClient, logger, cfg.FrontendURL, cfg.MarketingURL, cfg.BackendURL)
-	billingService := service.NewBillingService(userRepo, companyRepo, stripeClient, logger, cfg.FrontendURL)
+	invitationService := service.NewInvitationService(userRepo, companyRepo, invitationRepo, stripeClient, emailClient, logger, cfg.FrontendURL)
+	billingService := service.NewBillingService(userRepo, companyRepo, stripeClient, tenantSuspensionService, entitlementService, invitationService, logger, cfg.FrontendURL)
 	userService := service.NewUserService(userRepo, companyRepo, kratosClient, stripeClient, logger, cfg.FrontendURL)
 	companyService := service.NewCompanyService(userRepo, companyRepo, logger)
 	teamService := service.NewTeamService(userRepo, companyRepo, teamRepo, folderRepo, kratosClient, logger)
-	invitationService := service.NewInvitationService(userRepo, companyRepo, invitationRepo, stripeClient, emailClient, logger, cfg.FrontendURL)
-	courseService := service.NewCourseService(courseRepo, folderRepo, userRepo, tenantStorage, tenantCache, logger)
+	courseService := service.NewCourseService(courseRepo, folderRepo, userRepo, tenantStorage, tenantCache, entitlementService, logger)
 
 	// Notification service (created first for dependency injection)
 	notificationService := service.NewNotificationService(userRepo, notificationRepo, kratosClient, emailClient, notificationPubSub, cfg.FrontendURL, logger)
 
+	// Dunning of past due subscriptions (reminders, then restriction, then suspension)
+	dunningPolicy := service.DunningPolicy{
+		RestrictAfter: time.Duration(cfg.DunningRestrictAfterDays) * 24 * time.Hour,
+		SuspendAfter:  time.Duration(cfg.DunningSuspendAfterDays) * 24 * time.Hour,
+	}
+	for _, days := range cfg.DunningReminderDays {
+		dunningPolicy.ReminderAfter = append(dunningPolicy.ReminderAfter, time.Duration(days)*24*time.Hour)
+	}
+	dunningService := service.NewDunningService(userRepo, companyRepo, tenantSuspensionService, notificationService, dunningPolicy, logger)
+
 	// SME and Target Audience services
 	// Note: enhancer is nil initially, will be set when AI services are available
-	smeService := service.NewSMEService(userRepo, companyRepo, teamRepo, smeRepo, smeTaskRepo, smeSubmissionRepo, smeKnowledgeRepo, tenantStorage, notificationService, nil, logger)
+	smeService := service.NewSMEService(userRepo, companyRepo, teamRepo, smeRepo, smeTaskRepo, smeSubmissionRepo, smeKnowledgeRepo, smeKnowledgeVersionRepo, genLessonRepo, componentRepo, tenantStorage, notificationService, nil, entitlementService, logger)
 	targetAudienceService := service.NewTargetAudienceService(userRepo, targetAudienceRepo, logger)
 
 	// Initialize Asynq worker client for enqueueing tasks (needed by AI services)
@@ -230,16 +277,34 @@ func main() {
 	var aiGenerationService *service.AIGenerationService
 	var smeIngestionService *service.SMEIngestionService
 	if encryptor != nil {
-		tenantSettingsService = service.NewTenantSettingsService(userRepo, aiSettingsRepo, encryptor, logger)
+		// Model API calls get a longer timeout than other outbound requests.
+		// Self-hosted base URLs are tenant-supplied, so they may only reach
+		// public addresses and the internal networks the operator allows.
+		selfHostedNetworks, err := httputil.ParseNetworks(cfg.SelfHostedAIAllowedNetworks)
+		if err != nil {
+			logger.Error("invalid SELF_HOSTED_AI_ALLOWED_NETWORKS", "error", err)
+			os.Exit(1)
+		}
+		aiHTTPClients := aiprovider.Clients{
+			Hosted:     httputil.NewClientWithTimeout(aiprovider.RequestTimeout),
+			SelfHosted: httputil.NewPublicClient(aiprovider.RequestTimeout, selfHostedNetworks),
+		}
+
+		// Monthly token limits and the usage ledger
+		tokenBudget := service.NewTokenBudget(aiSettingsRepo, tokenUsageRepo, entitlementService, logger)
 
-		// Create Gemini provider factory for per-tenant API key management
-		geminiProviderFactory := gemini.NewProviderFactory(tenantSettingsService, logger)
+		tenantSettingsService = service.NewTenantSettingsService(userRepo, aiSettingsRepo, tokenBudget, lrsSettingsRepo, lrsClient, aiprovider.NewKeyTester(aiHTTPClients), encryptor, logger)
+
+		// Create AI provider factory for per-tenant provider selection and API key management
+		aiProviderFactory := aiprovider.NewFactory(tenantSettingsService, aiHTTPClients, logger)
 
 		// AI Generation service
 		aiGenerationService = service.NewAIGenerationService(
 			userRepo,
 			smeRepo,
 			smeKnowledgeRepo,
+			smeKnowledgeVersionRepo,
+			smeSubmissionRepo,
 			targetAudienceRepo,
 			generationJobRepo,
 			outlineRepo,
@@ -248,38 +313,134 @@ func main() {
 			genLessonRepo,
 			componentRepo,
 			genInputRepo,
-			aiSettingsRepo,
-			geminiProviderFactory,
+			courseRepo,
+			tokenBudget,
+			aiProviderFactory,
+			aiProviderFactory,   // For lesson knowledge retrieval embeddings
 			notificationService, // For tenant-isolated job notifications
 			notificationService, // For course completion notifications (implements CourseCompletionNotifier)
 			notificationService, // For outline completion notifications (implements OutlineCompletionNotifier)
+			notificationService, // For stale lesson notifications (implements StaleLessonNotifier)
 			workerClient,        // For event-driven job processing (push)
+			jobEventBroker,      // For live job progress streaming
 			logger,
 		)
 
+		// Local speech-to-text for audio/video submissions (optional)
+		var transcriber domainservice.Transcriber
+		if cfg.WhisperModelPath != "" {
+			transcriber = transcription.NewWhisperTranscriber(transcription.WhisperConfig{
+				BinaryPath: cfg.WhisperBinaryPath,
+				ModelPath:  cfg.WhisperModelPath,
+				FFmpegPath: cfg.FFmpegPath,
+				Language:   cfg.WhisperLanguage,
+				Threads:    cfg.WhisperThreads,
+			})
+			logger.Info("audio/video transcription enabled", "model", cfg.WhisperModelPath)
+		}
+
+		// Fetches web pages for URL submissions
+		webCrawler := crawler.NewCrawler(crawler.Config{
+			MaxDepth:             cfg.URLCrawlMaxDepth,
+			MaxPages:             cfg.URLCrawlMaxPages,
+			AllowPrivateNetworks: cfg.URLCrawlAllowPrivateNet,
+		})
+
 		// SME Ingestion service
 		smeIngestionService = service.NewSMEIngestionService(
 			smeRepo,
 			smeTaskRepo,
 			smeSubmissionRepo,
 			smeKnowledgeRepo,
+			smeKnowledgeVersionRepo,
 			generationJobRepo,
-			aiSettingsRepo,
+			tokenBudget,
 			tenantStorage,
-			geminiProviderFactory,
+			extraction.NewDefaultRegistry(),
+			aiProviderFactory,
+			aiProviderFactory, // Embeddings for semantic knowledge search
+			transcriber,       // Nil when WHISPER_MODEL_PATH is unset
+			webCrawler,
 			notificationService,
 			logger,
 		)
 
+		// Enable semantic knowledge search
+		smeService.SetEmbedderFactory(aiProviderFactory, tokenBudget)
+
 		logger.Info("AI services initialized")
 	} else {
 		logger.Warn("AI services not initialized (encryption key required)")
 	}
 
+	// LRS settings are encrypted, so cmi5 exports only forward statements when the encryptor is configured.
+	// Packages post to Mirai with a course-scoped token and never hold the LRS credentials.
+	var xapiStatementService *service.XAPIStatementService
+	var statementForwarder service.StatementForwarder
+	if tenantSettingsService != nil {
+		xapiStatementService = service.NewXAPIStatementService(
+			courseExportRepo,
+			tenantSettingsService,
+			lrsClient,
+			tenantSuspensionService,
+			encryptor.DeriveKey("xapi-statement-token"),
+			cfg.BackendURL,
+			logger,
+		)
+		statementForwarder = xapiStatementService
+	}
+
+	// Course export service (renders downloadable packages in the worker)
+	exportService := service.NewExportService(
+		userRepo,
+		courseRepo,
+		courseExportRepo,
+		outlineRepo,
+		sectionRepo,
+		lessonRepo,
+		genLessonRepo,
+		componentRepo,
+		tenantStorage,
+		[]domainservice.CourseExporter{
+			export.NewPDFExporter(),
+			export.NewSCORM12Exporter(),
+			export.NewSCORM2004Exporter(),
+			export.NewCMI5Exporter(),
+		},
+		statementForwarder,
+		tenantSuspensionService,
+		workerClient,
+		entitlementService,
+		logger,
+	)
+
 	// Background services for deferred account provisioning
 	provisioningService := service.NewProvisioningService(pendingRegRepo, tenantRepo, userRepo, companyRepo, kratosClient, emailClient, logger, cfg.FrontendURL)
 	cleanupService := service.NewCleanupService(pendingRegRepo, logger)
 
+	// Stripe webhook events, stored so each is applied once and can be replayed
+	stripeWebhookService := service.NewStripeWebhookService(billingService, dunningService, stripeWebhookEventRepo, companyRepo, pendingRegRepo, stripeClient, workerClient, emailClient, logger)
+
+	// Platform administration of failed background tasks, only for the listed identities
+	var jobAdminService *service.JobAdminService
+	if len(cfg.PlatformAdminIdentityIDs) > 0 {
+		adminIdentityIDs := make([]uuid.UUID, 0, len(cfg.PlatformAdminIdentityIDs))
+		for _, value := range cfg.PlatformAdminIdentityIDs {
+			id, err := uuid.Parse(value)
+			if err != nil {
+				logger.Error("invalid PLATFORM_ADMIN_IDENTITY_IDS, expected Kratos identity IDs", "value", value)
+				os.Exit(1)
+			}
+			adminIdentityIDs = append(adminIdentityIDs, id)
+		}
+
+		jobInspector := worker.NewInspector(redisAddr)
+		defer jobInspector.Close()
+		jobAdminService = service.NewJobAdminService(jobInspector, generationJobRepo, pendingRegRepo, workerClient, stripeWebhookService, entitlementService, adminIdentityIDs, logger)
+	} else {
+		logger.Info("platform administration disabled (PLATFORM_ADMIN_IDENTITY_IDS not set)")
+	}
+
 	// Create Connect server mux
 	mux := connectserver.NewServeMux(connectserver.ServerConfig{
 		AuthService:            authService,
@@ -287,20 +448,24 @@ func main() {
 		CompanyService:         companyService,
 		TeamService:            teamService,
 		BillingService:         billingService,
+		StripeWebhookService:   stripeWebhookService,
 		InvitationService:      invitationService,
 		CourseService:          courseService,
+		ExportService:          exportService,
 		SMEService:             smeService,
 		TargetAudienceService:  targetAudienceService,
 		TenantSettingsService:  tenantSettingsService,
 		NotificationService:    notificationService,
 		AIGenerationService:    aiGenerationService,
-		PendingRegRepo:         pendingRegRepo,
+		JobAdminService:        jobAdminService,
+		TenantSuspension:       tenantSuspensionService,
+		XAPIStatementService:   xapiStatementService,
+		SuspendedTenantAccess:  suspendedTenantAccess,
 		UserRepo:               userRepo,               // For tenant context in auth interceptor
 		Cache:                  globalCache,            // For caching user tenant mappings (not tenant-scoped)
 		NotificationSubscriber: notificationSubscriber, // For real-time notification streaming
 		Identity:               kratosClient,
 		Payments:               stripeClient,
-		WorkerClient:           workerClient, // For enqueueing background tasks
 		Logger:                 logger,
 		AllowedOrigin:          cfg.AllowedOrigin,
 		FrontendURL:            cfg.FrontendURL,
@@ -332,6 +497,8 @@ func main() {
 		cleanupService,
 		aiGenerationService,
 		smeIngestionService,
+		exportService,
+		dunningService,
 		workerClient,
 		logger,
 	)
@@ -26,12 +26,13 @@ const (
 type GenerationJobType int32
 
 const (
-	GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED     GenerationJobType = 0
-	GenerationJobType_GENERATION_JOB_TYPE_SME_INGESTION   GenerationJobType = 1 // Process SME content submissions
-	GenerationJobType_GENERATION_JOB_TYPE_COURSE_OUTLINE  GenerationJobType = 2 // Generate course outline
-	GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT  GenerationJobType = 3 // Generate content for a lesson
-	GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN GenerationJobType = 4 // Regenerate single component
-	GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE     GenerationJobType = 5 // Parent job tracking all lesson generation
+	GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED        GenerationJobType = 0
+	GenerationJobType_GENERATION_JOB_TYPE_SME_INGESTION      GenerationJobType = 1 // Process SME content submissions
+	GenerationJobType_GENERATION_JOB_TYPE_COURSE_OUTLINE     GenerationJobType = 2 // Generate course outline
+	GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT     GenerationJobType = 3 // Generate content for a lesson
+	GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN    GenerationJobType = 4 // Regenerate single component
+	GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE        GenerationJobType = 5 // Parent job tracking all lesson generation
+	GenerationJobType_GENERATION_JOB_TYPE_STALE_LESSON_REGEN GenerationJobType = 6 // Parent job tracking regeneration of stale lessons
 )
 
 // Enum value maps for GenerationJobType.
@@ -43,14 +44,16 @@ var (
 		3: "GENERATION_JOB_TYPE_LESSON_CONTENT",
 		4: "GENERATION_JOB_TYPE_COMPONENT_REGEN",
 		5: "GENERATION_JOB_TYPE_FULL_COURSE",
+		6: "GENERATION_JOB_TYPE_STALE_LESSON_REGEN",
 	}
 	GenerationJobType_value = map[string]int32{
-		"GENERATION_JOB_TYPE_UNSPECIFIED":     0,
-		"GENERATION_JOB_TYPE_SME_INGESTION":   1,
-		"GENERATION_JOB_TYPE_COURSE_OUTLINE":  2,
-		"GENERATION_JOB_TYPE_LESSON_CONTENT":  3,
-		"GENERATION_JOB_TYPE_COMPONENT_REGEN": 4,
-		"GENERATION_JOB_TYPE_FULL_COURSE":     5,
+		"GENERATION_JOB_TYPE_UNSPECIFIED":        0,
+		"GENERATION_JOB_TYPE_SME_INGESTION":      1,
+		"GENERATION_JOB_TYPE_COURSE_OUTLINE":     2,
+		"GENERATION_JOB_TYPE_LESSON_CONTENT":     3,
+		"GENERATION_JOB_TYPE_COMPONENT_REGEN":    4,
+		"GENERATION_JOB_TYPE_FULL_COURSE":        5,
+		"GENERATION_JOB_TYPE_STALE_LESSON_REGEN": 6,
 	}
 )
 
@@ -140,6 +143,65 @@ func (GenerationJobStatus) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{1}
 }
 
+// GenerationJobEventType categorizes live job updates streamed by WatchJob.
+type GenerationJobEventType int32
+
+const (
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_UNSPECIFIED   GenerationJobEventType = 0
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_PROGRESS      GenerationJobEventType = 1 // Progress percent or message changed
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_SECTION_READY GenerationJobEventType = 2 // An outline section was stored
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_LESSON_READY  GenerationJobEventType = 3 // A lesson's content was stored
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_STATUS        GenerationJobEventType = 4 // The job completed, failed or was cancelled
+	GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_KEEPALIVE     GenerationJobEventType = 5 // Heartbeat to keep the connection alive
+)
+
+// Enum value maps for GenerationJobEventType.
+var (
+	GenerationJobEventType_name = map[int32]string{
+		0: "GENERATION_JOB_EVENT_TYPE_UNSPECIFIED",
+		1: "GENERATION_JOB_EVENT_TYPE_PROGRESS",
+		2: "GENERATION_JOB_EVENT_TYPE_SECTION_READY",
+		3: "GENERATION_JOB_EVENT_TYPE_LESSON_READY",
+		4: "GENERATION_JOB_EVENT_TYPE_STATUS",
+		5: "GENERATION_JOB_EVENT_TYPE_KEEPALIVE",
+	}
+	GenerationJobEventType_value = map[string]int32{
+		"GENERATION_JOB_EVENT_TYPE_UNSPECIFIED":   0,
+		"GENERATION_JOB_EVENT_TYPE_PROGRESS":      1,
+		"GENERATION_JOB_EVENT_TYPE_SECTION_READY": 2,
+		"GENERATION_JOB_EVENT_TYPE_LESSON_READY":  3,
+		"GENERATION_JOB_EVENT_TYPE_STATUS":        4,
+		"GENERATION_JOB_EVENT_TYPE_KEEPALIVE":     5,
+	}
+)
+
+func (x GenerationJobEventType) Enum() *GenerationJobEventType {
+	p := new(GenerationJobEventType)
+	*p = x
+	return p
+}
+
+func (x GenerationJobEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerationJobEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_ai_generation_proto_enumTypes[2].Descriptor()
+}
+
+func (GenerationJobEventType) Type() protoreflect.EnumType {
+	return &file_mirai_v1_ai_generation_proto_enumTypes[2]
+}
+
+func (x GenerationJobEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerationJobEventType.Descriptor instead.
+func (GenerationJobEventType) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{2}
+}
+
 // OutlineApprovalStatus for generated content review.
 type OutlineApprovalStatus int32
 
@@ -180,11 +242,11 @@ func (x OutlineApprovalStatus) String() string {
 }
 
 func (OutlineApprovalStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_ai_generation_proto_enumTypes[2].Descriptor()
+	return file_mirai_v1_ai_generation_proto_enumTypes[3].Descriptor()
 }
 
 func (OutlineApprovalStatus) Type() protoreflect.EnumType {
-	return &file_mirai_v1_ai_generation_proto_enumTypes[2]
+	return &file_mirai_v1_ai_generation_proto_enumTypes[3]
 }
 
 func (x OutlineApprovalStatus) Number() protoreflect.EnumNumber {
@@ -193,7 +255,7 @@ func (x OutlineApprovalStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OutlineApprovalStatus.Descriptor instead.
 func (OutlineApprovalStatus) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{2}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{3}
 }
 
 // LessonComponentType - content block types for lessons.
@@ -237,11 +299,11 @@ func (x LessonComponentType) String() string {
 }
 
 func (LessonComponentType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_ai_generation_proto_enumTypes[3].Descriptor()
+	return file_mirai_v1_ai_generation_proto_enumTypes[4].Descriptor()
 }
 
 func (LessonComponentType) Type() protoreflect.EnumType {
-	return &file_mirai_v1_ai_generation_proto_enumTypes[3]
+	return &file_mirai_v1_ai_generation_proto_enumTypes[4]
 }
 
 func (x LessonComponentType) Number() protoreflect.EnumNumber {
@@ -250,7 +312,7 @@ func (x LessonComponentType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LessonComponentType.Descriptor instead.
 func (LessonComponentType) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
 }
 
 // HeadingLevel for heading components.
@@ -293,11 +355,11 @@ func (x HeadingLevel) String() string {
 }
 
 func (HeadingLevel) Descriptor() protoreflect.EnumDescriptor {
-	return file_mirai_v1_ai_generation_proto_enumTypes[4].Descriptor()
+	return file_mirai_v1_ai_generation_proto_enumTypes[5].Descriptor()
 }
 
 func (HeadingLevel) Type() protoreflect.EnumType {
-	return &file_mirai_v1_ai_generation_proto_enumTypes[4]
+	return &file_mirai_v1_ai_generation_proto_enumTypes[5]
 }
 
 func (x HeadingLevel) Number() protoreflect.EnumNumber {
@@ -306,7 +368,7 @@ func (x HeadingLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HeadingLevel.Descriptor instead.
 func (HeadingLevel) EnumDescriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{5}
 }
 
 // GenerationJob represents an AI generation job.
@@ -337,9 +399,11 @@ type GenerationJob struct {
 	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`
 	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
 	// Parent job ID - links child lesson jobs to parent full_course job
-	ParentJobId   *string `protobuf:"bytes,20,opt,name=parent_job_id,json=parentJobId,proto3,oneof" json:"parent_job_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ParentJobId *string `protobuf:"bytes,20,opt,name=parent_job_id,json=parentJobId,proto3,oneof" json:"parent_job_id,omitempty"`
+	// Knowledge chunks selected by retrieval for lesson generation
+	RetrievedChunkIds []string `protobuf:"bytes,21,rep,name=retrieved_chunk_ids,json=retrievedChunkIds,proto3" json:"retrieved_chunk_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GenerationJob) Reset() {
@@ -512,6 +576,13 @@ func (x *GenerationJob) GetParentJobId() string {
 	return ""
 }
 
+func (x *GenerationJob) GetRetrievedChunkIds() []string {
+	if x != nil {
+		return x.RetrievedChunkIds
+	}
+	return nil
+}
+
 // CourseOutline represents the generated course structure.
 type CourseOutline struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -810,8 +881,11 @@ type GeneratedLesson struct {
 	Components      []*LessonComponent     `protobuf:"bytes,6,rep,name=components,proto3" json:"components,omitempty"`
 	SegueText       *string                `protobuf:"bytes,7,opt,name=segue_text,json=segueText,proto3,oneof" json:"segue_text,omitempty"` // Transition to next lesson
 	GeneratedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Set when SME knowledge the lesson cites changed after it was generated
+	StaleSince    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+	StaleReason   *string                `protobuf:"bytes,10,opt,name=stale_reason,json=staleReason,proto3,oneof" json:"stale_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GeneratedLesson) Reset() {
@@ -900,6 +974,20 @@ func (x *GeneratedLesson) GetGeneratedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *GeneratedLesson) GetStaleSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StaleSince
+	}
+	return nil
+}
+
+func (x *GeneratedLesson) GetStaleReason() string {
+	if x != nil && x.StaleReason != nil {
+		return *x.StaleReason
+	}
+	return ""
+}
+
 // LessonComponent represents a content component in a lesson.
 type LessonComponent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1032,6 +1120,115 @@ func (x *ComponentAlignment) GetLearningObjectiveIds() []string {
 	return nil
 }
 
+// ComponentSource traces a cited SME knowledge chunk back to where it came from.
+type ComponentSource struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId                string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	SmeId                  string                 `protobuf:"bytes,2,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Topic                  string                 `protobuf:"bytes,3,opt,name=topic,proto3" json:"topic,omitempty"`
+	SubmissionId           *string                `protobuf:"bytes,4,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`                                  // Submission the chunk was extracted from
+	FileName               *string                `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3,oneof" json:"file_name,omitempty"`                                              // Uploaded file name of that submission
+	SourceHeading          *string                `protobuf:"bytes,6,opt,name=source_heading,json=sourceHeading,proto3,oneof" json:"source_heading,omitempty"`                               // Heading in the source document
+	SourcePage             *int32                 `protobuf:"varint,7,opt,name=source_page,json=sourcePage,proto3,oneof" json:"source_page,omitempty"`                                       // Page or slide number in the source document
+	SourceTimestampSeconds *int32                 `protobuf:"varint,8,opt,name=source_timestamp_seconds,json=sourceTimestampSeconds,proto3,oneof" json:"source_timestamp_seconds,omitempty"` // Position in the source recording
+	Outdated               bool                   `protobuf:"varint,9,opt,name=outdated,proto3" json:"outdated,omitempty"`                                                                   // Chunk was edited or deleted after the lesson was generated
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ComponentSource) Reset() {
+	*x = ComponentSource{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComponentSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComponentSource) ProtoMessage() {}
+
+func (x *ComponentSource) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComponentSource.ProtoReflect.Descriptor instead.
+func (*ComponentSource) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ComponentSource) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSubmissionId() string {
+	if x != nil && x.SubmissionId != nil {
+		return *x.SubmissionId
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSourceHeading() string {
+	if x != nil && x.SourceHeading != nil {
+		return *x.SourceHeading
+	}
+	return ""
+}
+
+func (x *ComponentSource) GetSourcePage() int32 {
+	if x != nil && x.SourcePage != nil {
+		return *x.SourcePage
+	}
+	return 0
+}
+
+func (x *ComponentSource) GetSourceTimestampSeconds() int32 {
+	if x != nil && x.SourceTimestampSeconds != nil {
+		return *x.SourceTimestampSeconds
+	}
+	return 0
+}
+
+func (x *ComponentSource) GetOutdated() bool {
+	if x != nil {
+		return x.Outdated
+	}
+	return false
+}
+
 // TextContent for text components.
 type TextContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1043,7 +1240,7 @@ type TextContent struct {
 
 func (x *TextContent) Reset() {
 	*x = TextContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1252,7 @@ func (x *TextContent) String() string {
 func (*TextContent) ProtoMessage() {}
 
 func (x *TextContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[7]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1265,7 @@ func (x *TextContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextContent.ProtoReflect.Descriptor instead.
 func (*TextContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{7}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TextContent) GetHtml() string {
@@ -1096,7 +1293,7 @@ type HeadingContent struct {
 
 func (x *HeadingContent) Reset() {
 	*x = HeadingContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1108,7 +1305,7 @@ func (x *HeadingContent) String() string {
 func (*HeadingContent) ProtoMessage() {}
 
 func (x *HeadingContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[8]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,7 +1318,7 @@ func (x *HeadingContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HeadingContent.ProtoReflect.Descriptor instead.
 func (*HeadingContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{8}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *HeadingContent) GetLevel() HeadingLevel {
@@ -1150,7 +1347,7 @@ type ImageContent struct {
 
 func (x *ImageContent) Reset() {
 	*x = ImageContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1162,7 +1359,7 @@ func (x *ImageContent) String() string {
 func (*ImageContent) ProtoMessage() {}
 
 func (x *ImageContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[9]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1372,7 @@ func (x *ImageContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImageContent.ProtoReflect.Descriptor instead.
 func (*ImageContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ImageContent) GetUrl() string {
@@ -1215,7 +1412,7 @@ type QuizContent struct {
 
 func (x *QuizContent) Reset() {
 	*x = QuizContent{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1227,7 +1424,7 @@ func (x *QuizContent) String() string {
 func (*QuizContent) ProtoMessage() {}
 
 func (x *QuizContent) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[10]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1437,7 @@ func (x *QuizContent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuizContent.ProtoReflect.Descriptor instead.
 func (*QuizContent) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *QuizContent) GetQuestion() string {
@@ -1303,7 +1500,7 @@ type QuizOption struct {
 
 func (x *QuizOption) Reset() {
 	*x = QuizOption{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +1512,7 @@ func (x *QuizOption) String() string {
 func (*QuizOption) ProtoMessage() {}
 
 func (x *QuizOption) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[11]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +1525,7 @@ func (x *QuizOption) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QuizOption.ProtoReflect.Descriptor instead.
 func (*QuizOption) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *QuizOption) GetId() string {
@@ -1359,7 +1556,7 @@ type CourseGenerationInput struct {
 
 func (x *CourseGenerationInput) Reset() {
 	*x = CourseGenerationInput{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1371,7 +1568,7 @@ func (x *CourseGenerationInput) String() string {
 func (*CourseGenerationInput) ProtoMessage() {}
 
 func (x *CourseGenerationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[12]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1384,7 +1581,7 @@ func (x *CourseGenerationInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CourseGenerationInput.ProtoReflect.Descriptor instead.
 func (*CourseGenerationInput) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{12}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CourseGenerationInput) GetCourseId() string {
@@ -1432,7 +1629,7 @@ type GenerateCourseOutlineRequest struct {
 
 func (x *GenerateCourseOutlineRequest) Reset() {
 	*x = GenerateCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +1641,7 @@ func (x *GenerateCourseOutlineRequest) String() string {
 func (*GenerateCourseOutlineRequest) ProtoMessage() {}
 
 func (x *GenerateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[13]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1654,7 @@ func (x *GenerateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateCourseOutlineRequest.ProtoReflect.Descriptor instead.
 func (*GenerateCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{13}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GenerateCourseOutlineRequest) GetInput() *CourseGenerationInput {
@@ -1477,7 +1674,7 @@ type GenerateCourseOutlineResponse struct {
 
 func (x *GenerateCourseOutlineResponse) Reset() {
 	*x = GenerateCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1489,7 +1686,7 @@ func (x *GenerateCourseOutlineResponse) String() string {
 func (*GenerateCourseOutlineResponse) ProtoMessage() {}
 
 func (x *GenerateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[14]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,7 +1699,7 @@ func (x *GenerateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateCourseOutlineResponse.ProtoReflect.Descriptor instead.
 func (*GenerateCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{14}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GenerateCourseOutlineResponse) GetJob() *GenerationJob {
@@ -1523,7 +1720,7 @@ type GetCourseOutlineRequest struct {
 
 func (x *GetCourseOutlineRequest) Reset() {
 	*x = GetCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1535,7 +1732,7 @@ func (x *GetCourseOutlineRequest) String() string {
 func (*GetCourseOutlineRequest) ProtoMessage() {}
 
 func (x *GetCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[15]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1548,7 +1745,7 @@ func (x *GetCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseOutlineRequest.ProtoReflect.Descriptor instead.
 func (*GetCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{15}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetCourseOutlineRequest) GetCourseId() string {
@@ -1575,7 +1772,7 @@ type GetCourseOutlineResponse struct {
 
 func (x *GetCourseOutlineResponse) Reset() {
 	*x = GetCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1587,7 +1784,7 @@ func (x *GetCourseOutlineResponse) String() string {
 func (*GetCourseOutlineResponse) ProtoMessage() {}
 
 func (x *GetCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[16]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +1797,7 @@ func (x *GetCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCourseOutlineResponse.ProtoReflect.Descriptor instead.
 func (*GetCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{16}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetCourseOutlineResponse) GetOutline() *CourseOutline {
@@ -1621,7 +1818,7 @@ type ApproveCourseOutlineRequest struct {
 
 func (x *ApproveCourseOutlineRequest) Reset() {
 	*x = ApproveCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +1830,7 @@ func (x *ApproveCourseOutlineRequest) String() string {
 func (*ApproveCourseOutlineRequest) ProtoMessage() {}
 
 func (x *ApproveCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[17]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1646,7 +1843,7 @@ func (x *ApproveCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveCourseOutlineRequest.ProtoReflect.Descriptor instead.
 func (*ApproveCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{17}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ApproveCourseOutlineRequest) GetCourseId() string {
@@ -1673,7 +1870,7 @@ type ApproveCourseOutlineResponse struct {
 
 func (x *ApproveCourseOutlineResponse) Reset() {
 	*x = ApproveCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1685,7 +1882,7 @@ func (x *ApproveCourseOutlineResponse) String() string {
 func (*ApproveCourseOutlineResponse) ProtoMessage() {}
 
 func (x *ApproveCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[18]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1698,7 +1895,7 @@ func (x *ApproveCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveCourseOutlineResponse.ProtoReflect.Descriptor instead.
 func (*ApproveCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{18}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ApproveCourseOutlineResponse) GetOutline() *CourseOutline {
@@ -1720,7 +1917,7 @@ type RejectCourseOutlineRequest struct {
 
 func (x *RejectCourseOutlineRequest) Reset() {
 	*x = RejectCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1732,7 +1929,7 @@ func (x *RejectCourseOutlineRequest) String() string {
 func (*RejectCourseOutlineRequest) ProtoMessage() {}
 
 func (x *RejectCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[19]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1745,7 +1942,7 @@ func (x *RejectCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectCourseOutlineRequest.ProtoReflect.Descriptor instead.
 func (*RejectCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{19}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RejectCourseOutlineRequest) GetCourseId() string {
@@ -1779,7 +1976,7 @@ type RejectCourseOutlineResponse struct {
 
 func (x *RejectCourseOutlineResponse) Reset() {
 	*x = RejectCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1791,7 +1988,7 @@ func (x *RejectCourseOutlineResponse) String() string {
 func (*RejectCourseOutlineResponse) ProtoMessage() {}
 
 func (x *RejectCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[20]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +2001,7 @@ func (x *RejectCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectCourseOutlineResponse.ProtoReflect.Descriptor instead.
 func (*RejectCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{20}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RejectCourseOutlineResponse) GetOutline() *CourseOutline {
@@ -1826,7 +2023,7 @@ type UpdateCourseOutlineRequest struct {
 
 func (x *UpdateCourseOutlineRequest) Reset() {
 	*x = UpdateCourseOutlineRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1838,7 +2035,7 @@ func (x *UpdateCourseOutlineRequest) String() string {
 func (*UpdateCourseOutlineRequest) ProtoMessage() {}
 
 func (x *UpdateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[21]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,7 +2048,7 @@ func (x *UpdateCourseOutlineRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCourseOutlineRequest.ProtoReflect.Descriptor instead.
 func (*UpdateCourseOutlineRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{21}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *UpdateCourseOutlineRequest) GetCourseId() string {
@@ -1885,7 +2082,7 @@ type UpdateCourseOutlineResponse struct {
 
 func (x *UpdateCourseOutlineResponse) Reset() {
 	*x = UpdateCourseOutlineResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1897,7 +2094,7 @@ func (x *UpdateCourseOutlineResponse) String() string {
 func (*UpdateCourseOutlineResponse) ProtoMessage() {}
 
 func (x *UpdateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[22]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1910,7 +2107,7 @@ func (x *UpdateCourseOutlineResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateCourseOutlineResponse.ProtoReflect.Descriptor instead.
 func (*UpdateCourseOutlineResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{22}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *UpdateCourseOutlineResponse) GetOutline() *CourseOutline {
@@ -1931,7 +2128,7 @@ type GenerateLessonContentRequest struct {
 
 func (x *GenerateLessonContentRequest) Reset() {
 	*x = GenerateLessonContentRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1943,7 +2140,7 @@ func (x *GenerateLessonContentRequest) String() string {
 func (*GenerateLessonContentRequest) ProtoMessage() {}
 
 func (x *GenerateLessonContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[23]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1956,7 +2153,7 @@ func (x *GenerateLessonContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateLessonContentRequest.ProtoReflect.Descriptor instead.
 func (*GenerateLessonContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{23}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GenerateLessonContentRequest) GetCourseId() string {
@@ -1983,7 +2180,7 @@ type GenerateLessonContentResponse struct {
 
 func (x *GenerateLessonContentResponse) Reset() {
 	*x = GenerateLessonContentResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +2192,7 @@ func (x *GenerateLessonContentResponse) String() string {
 func (*GenerateLessonContentResponse) ProtoMessage() {}
 
 func (x *GenerateLessonContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[24]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2205,7 @@ func (x *GenerateLessonContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateLessonContentResponse.ProtoReflect.Descriptor instead.
 func (*GenerateLessonContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{24}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GenerateLessonContentResponse) GetJob() *GenerationJob {
@@ -2028,7 +2225,7 @@ type GenerateAllLessonsRequest struct {
 
 func (x *GenerateAllLessonsRequest) Reset() {
 	*x = GenerateAllLessonsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2040,7 +2237,7 @@ func (x *GenerateAllLessonsRequest) String() string {
 func (*GenerateAllLessonsRequest) ProtoMessage() {}
 
 func (x *GenerateAllLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[25]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +2250,7 @@ func (x *GenerateAllLessonsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateAllLessonsRequest.ProtoReflect.Descriptor instead.
 func (*GenerateAllLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{25}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GenerateAllLessonsRequest) GetCourseId() string {
@@ -2073,7 +2270,7 @@ type GenerateAllLessonsResponse struct {
 
 func (x *GenerateAllLessonsResponse) Reset() {
 	*x = GenerateAllLessonsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2085,7 +2282,7 @@ func (x *GenerateAllLessonsResponse) String() string {
 func (*GenerateAllLessonsResponse) ProtoMessage() {}
 
 func (x *GenerateAllLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[26]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2098,7 +2295,7 @@ func (x *GenerateAllLessonsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateAllLessonsResponse.ProtoReflect.Descriptor instead.
 func (*GenerateAllLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{26}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GenerateAllLessonsResponse) GetJob() *GenerationJob {
@@ -2121,7 +2318,7 @@ type RegenerateComponentRequest struct {
 
 func (x *RegenerateComponentRequest) Reset() {
 	*x = RegenerateComponentRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2133,7 +2330,7 @@ func (x *RegenerateComponentRequest) String() string {
 func (*RegenerateComponentRequest) ProtoMessage() {}
 
 func (x *RegenerateComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[27]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2146,7 +2343,7 @@ func (x *RegenerateComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateComponentRequest.ProtoReflect.Descriptor instead.
 func (*RegenerateComponentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{27}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *RegenerateComponentRequest) GetCourseId() string {
@@ -2187,7 +2384,7 @@ type RegenerateComponentResponse struct {
 
 func (x *RegenerateComponentResponse) Reset() {
 	*x = RegenerateComponentResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2199,7 +2396,7 @@ func (x *RegenerateComponentResponse) String() string {
 func (*RegenerateComponentResponse) ProtoMessage() {}
 
 func (x *RegenerateComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[28]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,7 +2409,7 @@ func (x *RegenerateComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateComponentResponse.ProtoReflect.Descriptor instead.
 func (*RegenerateComponentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{28}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *RegenerateComponentResponse) GetJob() *GenerationJob {
@@ -2232,7 +2429,7 @@ type GetJobRequest struct {
 
 func (x *GetJobRequest) Reset() {
 	*x = GetJobRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2244,7 +2441,7 @@ func (x *GetJobRequest) String() string {
 func (*GetJobRequest) ProtoMessage() {}
 
 func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[29]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2257,7 +2454,7 @@ func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
 func (*GetJobRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{29}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *GetJobRequest) GetJobId() string {
@@ -2269,15 +2466,19 @@ func (x *GetJobRequest) GetJobId() string {
 
 // GetJobResponse contains the job.
 type GetJobResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Job   *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	// Position of a queued job among all queued jobs, 1 being next (0 unless queued)
+	QueuePosition int32 `protobuf:"varint,2,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
+	// Number of queued jobs across all tenants (0 unless queued)
+	QueueDepth    int32 `protobuf:"varint,3,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetJobResponse) Reset() {
 	*x = GetJobResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2289,7 +2490,7 @@ func (x *GetJobResponse) String() string {
 func (*GetJobResponse) ProtoMessage() {}
 
 func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[30]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2302,7 +2503,7 @@ func (x *GetJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetJobResponse.ProtoReflect.Descriptor instead.
 func (*GetJobResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{30}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetJobResponse) GetJob() *GenerationJob {
@@ -2312,6 +2513,20 @@ func (x *GetJobResponse) GetJob() *GenerationJob {
 	return nil
 }
 
+func (x *GetJobResponse) GetQueuePosition() int32 {
+	if x != nil {
+		return x.QueuePosition
+	}
+	return 0
+}
+
+func (x *GetJobResponse) GetQueueDepth() int32 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
 // ListJobsRequest contains filters for jobs.
 type ListJobsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2324,7 +2539,7 @@ type ListJobsRequest struct {
 
 func (x *ListJobsRequest) Reset() {
 	*x = ListJobsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2336,7 +2551,7 @@ func (x *ListJobsRequest) String() string {
 func (*ListJobsRequest) ProtoMessage() {}
 
 func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[31]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2349,7 +2564,7 @@ func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
 func (*ListJobsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{31}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListJobsRequest) GetType() GenerationJobType {
@@ -2383,7 +2598,7 @@ type ListJobsResponse struct {
 
 func (x *ListJobsResponse) Reset() {
 	*x = ListJobsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2395,7 +2610,7 @@ func (x *ListJobsResponse) String() string {
 func (*ListJobsResponse) ProtoMessage() {}
 
 func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[32]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2408,7 +2623,7 @@ func (x *ListJobsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListJobsResponse.ProtoReflect.Descriptor instead.
 func (*ListJobsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{32}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListJobsResponse) GetJobs() []*GenerationJob {
@@ -2428,7 +2643,7 @@ type CancelJobRequest struct {
 
 func (x *CancelJobRequest) Reset() {
 	*x = CancelJobRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2440,7 +2655,7 @@ func (x *CancelJobRequest) String() string {
 func (*CancelJobRequest) ProtoMessage() {}
 
 func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[33]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2453,7 +2668,7 @@ func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
 func (*CancelJobRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{33}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *CancelJobRequest) GetJobId() string {
@@ -2473,7 +2688,7 @@ type CancelJobResponse struct {
 
 func (x *CancelJobResponse) Reset() {
 	*x = CancelJobResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2485,7 +2700,7 @@ func (x *CancelJobResponse) String() string {
 func (*CancelJobResponse) ProtoMessage() {}
 
 func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[34]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2498,7 +2713,7 @@ func (x *CancelJobResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelJobResponse.ProtoReflect.Descriptor instead.
 func (*CancelJobResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{34}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *CancelJobResponse) GetJob() *GenerationJob {
@@ -2508,6 +2723,211 @@ func (x *CancelJobResponse) GetJob() *GenerationJob {
 	return nil
 }
 
+// ResumeJobRequest resumes a failed course generation job.
+type ResumeJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeJobRequest) Reset() {
+	*x = ResumeJobRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobRequest) ProtoMessage() {}
+
+func (x *ResumeJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobRequest.ProtoReflect.Descriptor instead.
+func (*ResumeJobRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResumeJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// ResumeJobResponse returns the resumed job.
+type ResumeJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeJobResponse) Reset() {
+	*x = ResumeJobResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeJobResponse) ProtoMessage() {}
+
+func (x *ResumeJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeJobResponse.ProtoReflect.Descriptor instead.
+func (*ResumeJobResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResumeJobResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// WatchJobRequest starts following a job.
+type WatchJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobId         string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobRequest) Reset() {
+	*x = WatchJobRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobRequest) ProtoMessage() {}
+
+func (x *WatchJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchJobRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// WatchJobResponse is one live update. The stream starts with the current
+// state of the job and each of its children.
+type WatchJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventType     GenerationJobEventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=mirai.v1.GenerationJobEventType" json:"event_type,omitempty"`
+	Job           *GenerationJob         `protobuf:"bytes,2,opt,name=job,proto3" json:"job,omitempty"`         // Job the event is about: the watched job or one of its children
+	Section       *OutlineSection        `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"` // Set for SECTION_READY
+	Lesson        *GeneratedLesson       `protobuf:"bytes,4,opt,name=lesson,proto3" json:"lesson,omitempty"`   // Set for LESSON_READY
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobResponse) Reset() {
+	*x = WatchJobResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobResponse) ProtoMessage() {}
+
+func (x *WatchJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobResponse.ProtoReflect.Descriptor instead.
+func (*WatchJobResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *WatchJobResponse) GetEventType() GenerationJobEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WatchJobResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+func (x *WatchJobResponse) GetSection() *OutlineSection {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *WatchJobResponse) GetLesson() *GeneratedLesson {
+	if x != nil {
+		return x.Lesson
+	}
+	return nil
+}
+
 // GetGeneratedLessonRequest fetches generated lesson content.
 type GetGeneratedLessonRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2518,7 +2938,7 @@ type GetGeneratedLessonRequest struct {
 
 func (x *GetGeneratedLessonRequest) Reset() {
 	*x = GetGeneratedLessonRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2530,7 +2950,7 @@ func (x *GetGeneratedLessonRequest) String() string {
 func (*GetGeneratedLessonRequest) ProtoMessage() {}
 
 func (x *GetGeneratedLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[35]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2543,7 +2963,7 @@ func (x *GetGeneratedLessonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeneratedLessonRequest.ProtoReflect.Descriptor instead.
 func (*GetGeneratedLessonRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{35}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetGeneratedLessonRequest) GetLessonId() string {
@@ -2555,15 +2975,17 @@ func (x *GetGeneratedLessonRequest) GetLessonId() string {
 
 // GetGeneratedLessonResponse contains the lesson.
 type GetGeneratedLessonResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Lesson        *GeneratedLesson       `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Lesson *GeneratedLesson       `protobuf:"bytes,1,opt,name=lesson,proto3" json:"lesson,omitempty"`
+	// SME knowledge cited by the lesson's components (see ComponentAlignment.sme_chunk_ids)
+	Sources       []*ComponentSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetGeneratedLessonResponse) Reset() {
 	*x = GetGeneratedLessonResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2575,7 +2997,7 @@ func (x *GetGeneratedLessonResponse) String() string {
 func (*GetGeneratedLessonResponse) ProtoMessage() {}
 
 func (x *GetGeneratedLessonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[36]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2588,7 +3010,7 @@ func (x *GetGeneratedLessonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeneratedLessonResponse.ProtoReflect.Descriptor instead.
 func (*GetGeneratedLessonResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{36}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetGeneratedLessonResponse) GetLesson() *GeneratedLesson {
@@ -2598,6 +3020,13 @@ func (x *GetGeneratedLessonResponse) GetLesson() *GeneratedLesson {
 	return nil
 }
 
+func (x *GetGeneratedLessonResponse) GetSources() []*ComponentSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
 // ListGeneratedLessonsRequest fetches all lessons for a course.
 type ListGeneratedLessonsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2608,7 +3037,7 @@ type ListGeneratedLessonsRequest struct {
 
 func (x *ListGeneratedLessonsRequest) Reset() {
 	*x = ListGeneratedLessonsRequest{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2620,7 +3049,7 @@ func (x *ListGeneratedLessonsRequest) String() string {
 func (*ListGeneratedLessonsRequest) ProtoMessage() {}
 
 func (x *ListGeneratedLessonsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[37]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2633,7 +3062,7 @@ func (x *ListGeneratedLessonsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGeneratedLessonsRequest.ProtoReflect.Descriptor instead.
 func (*ListGeneratedLessonsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{37}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ListGeneratedLessonsRequest) GetCourseId() string {
@@ -2653,7 +3082,7 @@ type ListGeneratedLessonsResponse struct {
 
 func (x *ListGeneratedLessonsResponse) Reset() {
 	*x = ListGeneratedLessonsResponse{}
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2665,7 +3094,7 @@ func (x *ListGeneratedLessonsResponse) String() string {
 func (*ListGeneratedLessonsResponse) ProtoMessage() {}
 
 func (x *ListGeneratedLessonsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_ai_generation_proto_msgTypes[38]
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2678,7 +3107,7 @@ func (x *ListGeneratedLessonsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListGeneratedLessonsResponse.ProtoReflect.Descriptor instead.
 func (*ListGeneratedLessonsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{38}
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListGeneratedLessonsResponse) GetLessons() []*GeneratedLesson {
@@ -2688,11 +3117,191 @@ func (x *ListGeneratedLessonsResponse) GetLessons() []*GeneratedLesson {
 	return nil
 }
 
+// ListStaleLessonsRequest fetches the stale lessons of a course.
+type ListStaleLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleLessonsRequest) Reset() {
+	*x = ListStaleLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleLessonsRequest) ProtoMessage() {}
+
+func (x *ListStaleLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ListStaleLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ListStaleLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// ListStaleLessonsResponse contains the stale lessons.
+type ListStaleLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*GeneratedLesson     `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaleLessonsResponse) Reset() {
+	*x = ListStaleLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaleLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaleLessonsResponse) ProtoMessage() {}
+
+func (x *ListStaleLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaleLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ListStaleLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListStaleLessonsResponse) GetLessons() []*GeneratedLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+// RegenerateStaleLessonsRequest regenerates the stale lessons of a course.
+type RegenerateStaleLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      string                 `protobuf:"bytes,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateStaleLessonsRequest) Reset() {
+	*x = RegenerateStaleLessonsRequest{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateStaleLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateStaleLessonsRequest) ProtoMessage() {}
+
+func (x *RegenerateStaleLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateStaleLessonsRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateStaleLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *RegenerateStaleLessonsRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+// RegenerateStaleLessonsResponse returns the parent job.
+type RegenerateStaleLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *GenerationJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateStaleLessonsResponse) Reset() {
+	*x = RegenerateStaleLessonsResponse{}
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateStaleLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateStaleLessonsResponse) ProtoMessage() {}
+
+func (x *RegenerateStaleLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_ai_generation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateStaleLessonsResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateStaleLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_ai_generation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RegenerateStaleLessonsResponse) GetJob() *GenerationJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
 var File_mirai_v1_ai_generation_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmirai/v1/ai_generation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\b\n" +
+	"\x1cmirai/v1/ai_generation.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\b\n" +
 	"\rGenerationJob\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12/\n" +
@@ -2720,7 +3329,8 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\n" +
 	"started_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampH\aR\tstartedAt\x88\x01\x01\x12B\n" +
 	"\fcompleted_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\bR\vcompletedAt\x88\x01\x01\x12'\n" +
-	"\rparent_job_id\x18\x14 \x01(\tH\tR\vparentJobId\x88\x01\x01B\f\n" +
+	"\rparent_job_id\x18\x14 \x01(\tH\tR\vparentJobId\x88\x01\x01\x12.\n" +
+	"\x13retrieved_chunk_ids\x18\x15 \x03(\tR\x11retrievedChunkIdsB\f\n" +
 	"\n" +
 	"_course_idB\f\n" +
 	"\n" +
@@ -2761,7 +3371,7 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x1aestimated_duration_minutes\x18\x05 \x01(\x05R\x18estimatedDurationMinutes\x12/\n" +
 	"\x13learning_objectives\x18\x06 \x03(\tR\x12learningObjectives\x12+\n" +
 	"\x12is_last_in_section\x18\a \x01(\bR\x0fisLastInSection\x12)\n" +
-	"\x11is_last_in_course\x18\b \x01(\bR\x0eisLastInCourse\"\xcc\x02\n" +
+	"\x11is_last_in_course\x18\b \x01(\bR\x0eisLastInCourse\"\xc2\x03\n" +
 	"\x0fGeneratedLesson\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x1d\n" +
@@ -2774,8 +3384,13 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"components\x12\"\n" +
 	"\n" +
 	"segue_text\x18\a \x01(\tH\x00R\tsegueText\x88\x01\x01\x12=\n" +
-	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAtB\r\n" +
-	"\v_segue_text\"\xdc\x01\n" +
+	"\fgenerated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12;\n" +
+	"\vstale_since\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"staleSince\x12&\n" +
+	"\fstale_reason\x18\n" +
+	" \x01(\tH\x01R\vstaleReason\x88\x01\x01B\r\n" +
+	"\v_segue_textB\x0f\n" +
+	"\r_stale_reason\"\xdc\x01\n" +
 	"\x0fLessonComponent\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1d.mirai.v1.LessonComponentTypeR\x04type\x12\x14\n" +
@@ -2786,7 +3401,24 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"_alignment\"n\n" +
 	"\x12ComponentAlignment\x12\"\n" +
 	"\rsme_chunk_ids\x18\x01 \x03(\tR\vsmeChunkIds\x124\n" +
-	"\x16learning_objective_ids\x18\x02 \x03(\tR\x14learningObjectiveIds\"?\n" +
+	"\x16learning_objective_ids\x18\x02 \x03(\tR\x14learningObjectiveIds\"\xb2\x03\n" +
+	"\x0fComponentSource\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x15\n" +
+	"\x06sme_id\x18\x02 \x01(\tR\x05smeId\x12\x14\n" +
+	"\x05topic\x18\x03 \x01(\tR\x05topic\x12(\n" +
+	"\rsubmission_id\x18\x04 \x01(\tH\x00R\fsubmissionId\x88\x01\x01\x12 \n" +
+	"\tfile_name\x18\x05 \x01(\tH\x01R\bfileName\x88\x01\x01\x12*\n" +
+	"\x0esource_heading\x18\x06 \x01(\tH\x02R\rsourceHeading\x88\x01\x01\x12$\n" +
+	"\vsource_page\x18\a \x01(\x05H\x03R\n" +
+	"sourcePage\x88\x01\x01\x12=\n" +
+	"\x18source_timestamp_seconds\x18\b \x01(\x05H\x04R\x16sourceTimestampSeconds\x88\x01\x01\x12\x1a\n" +
+	"\boutdated\x18\t \x01(\bR\boutdatedB\x10\n" +
+	"\x0e_submission_idB\f\n" +
+	"\n" +
+	"_file_nameB\x11\n" +
+	"\x0f_source_headingB\x0e\n" +
+	"\f_source_pageB\x1b\n" +
+	"\x19_source_timestamp_seconds\"?\n" +
 	"\vTextContent\x12\x12\n" +
 	"\x04html\x18\x01 \x01(\tR\x04html\x12\x1c\n" +
 	"\tplaintext\x18\x02 \x01(\tR\tplaintext\"R\n" +
@@ -2868,9 +3500,12 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x1bRegenerateComponentResponse\x12)\n" +
 	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"&\n" +
 	"\rGetJobRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\";\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x83\x01\n" +
 	"\x0eGetJobResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"\xc7\x01\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x12%\n" +
+	"\x0equeue_position\x18\x02 \x01(\x05R\rqueuePosition\x12\x1f\n" +
+	"\vqueue_depth\x18\x03 \x01(\x05R\n" +
+	"queueDepth\"\xc7\x01\n" +
 	"\x0fListJobsRequest\x124\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1b.mirai.v1.GenerationJobTypeH\x00R\x04type\x88\x01\x01\x12:\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1d.mirai.v1.GenerationJobStatusH\x01R\x06status\x88\x01\x01\x12 \n" +
@@ -2884,29 +3519,58 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x10CancelJobRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\">\n" +
 	"\x11CancelJobResponse\x12)\n" +
-	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"8\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\")\n" +
+	"\x10ResumeJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\">\n" +
+	"\x11ResumeJobResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\"(\n" +
+	"\x0fWatchJobRequest\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\xe5\x01\n" +
+	"\x10WatchJobResponse\x12?\n" +
+	"\n" +
+	"event_type\x18\x01 \x01(\x0e2 .mirai.v1.GenerationJobEventTypeR\teventType\x12)\n" +
+	"\x03job\x18\x02 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job\x122\n" +
+	"\asection\x18\x03 \x01(\v2\x18.mirai.v1.OutlineSectionR\asection\x121\n" +
+	"\x06lesson\x18\x04 \x01(\v2\x19.mirai.v1.GeneratedLessonR\x06lesson\"8\n" +
 	"\x19GetGeneratedLessonRequest\x12\x1b\n" +
-	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"O\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\"\x84\x01\n" +
 	"\x1aGetGeneratedLessonResponse\x121\n" +
-	"\x06lesson\x18\x01 \x01(\v2\x19.mirai.v1.GeneratedLessonR\x06lesson\":\n" +
+	"\x06lesson\x18\x01 \x01(\v2\x19.mirai.v1.GeneratedLessonR\x06lesson\x123\n" +
+	"\asources\x18\x02 \x03(\v2\x19.mirai.v1.ComponentSourceR\asources\":\n" +
 	"\x1bListGeneratedLessonsRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"S\n" +
 	"\x1cListGeneratedLessonsResponse\x123\n" +
-	"\alessons\x18\x01 \x03(\v2\x19.mirai.v1.GeneratedLessonR\alessons*\xfd\x01\n" +
+	"\alessons\x18\x01 \x03(\v2\x19.mirai.v1.GeneratedLessonR\alessons\"6\n" +
+	"\x17ListStaleLessonsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"O\n" +
+	"\x18ListStaleLessonsResponse\x123\n" +
+	"\alessons\x18\x01 \x03(\v2\x19.mirai.v1.GeneratedLessonR\alessons\"<\n" +
+	"\x1dRegenerateStaleLessonsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\tR\bcourseId\"K\n" +
+	"\x1eRegenerateStaleLessonsResponse\x12)\n" +
+	"\x03job\x18\x01 \x01(\v2\x17.mirai.v1.GenerationJobR\x03job*\xa9\x02\n" +
 	"\x11GenerationJobType\x12#\n" +
 	"\x1fGENERATION_JOB_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
 	"!GENERATION_JOB_TYPE_SME_INGESTION\x10\x01\x12&\n" +
 	"\"GENERATION_JOB_TYPE_COURSE_OUTLINE\x10\x02\x12&\n" +
 	"\"GENERATION_JOB_TYPE_LESSON_CONTENT\x10\x03\x12'\n" +
 	"#GENERATION_JOB_TYPE_COMPONENT_REGEN\x10\x04\x12#\n" +
-	"\x1fGENERATION_JOB_TYPE_FULL_COURSE\x10\x05*\xf0\x01\n" +
+	"\x1fGENERATION_JOB_TYPE_FULL_COURSE\x10\x05\x12*\n" +
+	"&GENERATION_JOB_TYPE_STALE_LESSON_REGEN\x10\x06*\xf0\x01\n" +
 	"\x13GenerationJobStatus\x12%\n" +
 	"!GENERATION_JOB_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
 	"\x1cGENERATION_JOB_STATUS_QUEUED\x10\x01\x12$\n" +
 	" GENERATION_JOB_STATUS_PROCESSING\x10\x02\x12#\n" +
 	"\x1fGENERATION_JOB_STATUS_COMPLETED\x10\x03\x12 \n" +
 	"\x1cGENERATION_JOB_STATUS_FAILED\x10\x04\x12#\n" +
-	"\x1fGENERATION_JOB_STATUS_CANCELLED\x10\x05*\xe8\x01\n" +
+	"\x1fGENERATION_JOB_STATUS_CANCELLED\x10\x05*\x93\x02\n" +
+	"\x16GenerationJobEventType\x12)\n" +
+	"%GENERATION_JOB_EVENT_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"GENERATION_JOB_EVENT_TYPE_PROGRESS\x10\x01\x12+\n" +
+	"'GENERATION_JOB_EVENT_TYPE_SECTION_READY\x10\x02\x12*\n" +
+	"&GENERATION_JOB_EVENT_TYPE_LESSON_READY\x10\x03\x12$\n" +
+	" GENERATION_JOB_EVENT_TYPE_STATUS\x10\x04\x12'\n" +
+	"#GENERATION_JOB_EVENT_TYPE_KEEPALIVE\x10\x05*\xe8\x01\n" +
 	"\x15OutlineApprovalStatus\x12'\n" +
 	"#OUTLINE_APPROVAL_STATUS_UNSPECIFIED\x10\x00\x12*\n" +
 	"&OUTLINE_APPROVAL_STATUS_PENDING_REVIEW\x10\x01\x12$\n" +
@@ -2924,7 +3588,7 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x10HEADING_LEVEL_H1\x10\x01\x12\x14\n" +
 	"\x10HEADING_LEVEL_H2\x10\x02\x12\x14\n" +
 	"\x10HEADING_LEVEL_H3\x10\x03\x12\x14\n" +
-	"\x10HEADING_LEVEL_H4\x10\x042\xc6\t\n" +
+	"\x10HEADING_LEVEL_H4\x10\x042\x99\f\n" +
 	"\x13AIGenerationService\x12h\n" +
 	"\x15GenerateCourseOutline\x12&.mirai.v1.GenerateCourseOutlineRequest\x1a'.mirai.v1.GenerateCourseOutlineResponse\x12Y\n" +
 	"\x10GetCourseOutline\x12!.mirai.v1.GetCourseOutlineRequest\x1a\".mirai.v1.GetCourseOutlineResponse\x12e\n" +
@@ -2936,9 +3600,13 @@ const file_mirai_v1_ai_generation_proto_rawDesc = "" +
 	"\x13RegenerateComponent\x12$.mirai.v1.RegenerateComponentRequest\x1a%.mirai.v1.RegenerateComponentResponse\x12;\n" +
 	"\x06GetJob\x12\x17.mirai.v1.GetJobRequest\x1a\x18.mirai.v1.GetJobResponse\x12A\n" +
 	"\bListJobs\x12\x19.mirai.v1.ListJobsRequest\x1a\x1a.mirai.v1.ListJobsResponse\x12D\n" +
-	"\tCancelJob\x12\x1a.mirai.v1.CancelJobRequest\x1a\x1b.mirai.v1.CancelJobResponse\x12_\n" +
+	"\tCancelJob\x12\x1a.mirai.v1.CancelJobRequest\x1a\x1b.mirai.v1.CancelJobResponse\x12D\n" +
+	"\tResumeJob\x12\x1a.mirai.v1.ResumeJobRequest\x1a\x1b.mirai.v1.ResumeJobResponse\x12C\n" +
+	"\bWatchJob\x12\x19.mirai.v1.WatchJobRequest\x1a\x1a.mirai.v1.WatchJobResponse0\x01\x12_\n" +
 	"\x12GetGeneratedLesson\x12#.mirai.v1.GetGeneratedLessonRequest\x1a$.mirai.v1.GetGeneratedLessonResponse\x12e\n" +
-	"\x14ListGeneratedLessons\x12%.mirai.v1.ListGeneratedLessonsRequest\x1a&.mirai.v1.ListGeneratedLessonsResponseB\x97\x01\n" +
+	"\x14ListGeneratedLessons\x12%.mirai.v1.ListGeneratedLessonsRequest\x1a&.mirai.v1.ListGeneratedLessonsResponse\x12Y\n" +
+	"\x10ListStaleLessons\x12!.mirai.v1.ListStaleLessonsRequest\x1a\".mirai.v1.ListStaleLessonsResponse\x12k\n" +
+	"\x16RegenerateStaleLessons\x12'.mirai.v1.RegenerateStaleLessonsRequest\x1a(.mirai.v1.RegenerateStaleLessonsResponseB\x97\x01\n" +
 	"\fcom.mirai.v1B\x11AiGenerationProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -2953,120 +3621,147 @@ func file_mirai_v1_ai_generation_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_ai_generation_proto_rawDescData
 }
 
-var file_mirai_v1_ai_generation_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_mirai_v1_ai_generation_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_mirai_v1_ai_generation_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_mirai_v1_ai_generation_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_mirai_v1_ai_generation_proto_goTypes = []any{
-	(GenerationJobType)(0),                // 0: mirai.v1.GenerationJobType
-	(GenerationJobStatus)(0),              // 1: mirai.v1.GenerationJobStatus
-	(OutlineApprovalStatus)(0),            // 2: mirai.v1.OutlineApprovalStatus
-	(LessonComponentType)(0),              // 3: mirai.v1.LessonComponentType
-	(HeadingLevel)(0),                     // 4: mirai.v1.HeadingLevel
-	(*GenerationJob)(nil),                 // 5: mirai.v1.GenerationJob
-	(*CourseOutline)(nil),                 // 6: mirai.v1.CourseOutline
-	(*OutlineSection)(nil),                // 7: mirai.v1.OutlineSection
-	(*OutlineLesson)(nil),                 // 8: mirai.v1.OutlineLesson
-	(*GeneratedLesson)(nil),               // 9: mirai.v1.GeneratedLesson
-	(*LessonComponent)(nil),               // 10: mirai.v1.LessonComponent
-	(*ComponentAlignment)(nil),            // 11: mirai.v1.ComponentAlignment
-	(*TextContent)(nil),                   // 12: mirai.v1.TextContent
-	(*HeadingContent)(nil),                // 13: mirai.v1.HeadingContent
-	(*ImageContent)(nil),                  // 14: mirai.v1.ImageContent
-	(*QuizContent)(nil),                   // 15: mirai.v1.QuizContent
-	(*QuizOption)(nil),                    // 16: mirai.v1.QuizOption
-	(*CourseGenerationInput)(nil),         // 17: mirai.v1.CourseGenerationInput
-	(*GenerateCourseOutlineRequest)(nil),  // 18: mirai.v1.GenerateCourseOutlineRequest
-	(*GenerateCourseOutlineResponse)(nil), // 19: mirai.v1.GenerateCourseOutlineResponse
-	(*GetCourseOutlineRequest)(nil),       // 20: mirai.v1.GetCourseOutlineRequest
-	(*GetCourseOutlineResponse)(nil),      // 21: mirai.v1.GetCourseOutlineResponse
-	(*ApproveCourseOutlineRequest)(nil),   // 22: mirai.v1.ApproveCourseOutlineRequest
-	(*ApproveCourseOutlineResponse)(nil),  // 23: mirai.v1.ApproveCourseOutlineResponse
-	(*RejectCourseOutlineRequest)(nil),    // 24: mirai.v1.RejectCourseOutlineRequest
-	(*RejectCourseOutlineResponse)(nil),   // 25: mirai.v1.RejectCourseOutlineResponse
-	(*UpdateCourseOutlineRequest)(nil),    // 26: mirai.v1.UpdateCourseOutlineRequest
-	(*UpdateCourseOutlineResponse)(nil),   // 27: mirai.v1.UpdateCourseOutlineResponse
-	(*GenerateLessonContentRequest)(nil),  // 28: mirai.v1.GenerateLessonContentRequest
-	(*GenerateLessonContentResponse)(nil), // 29: mirai.v1.GenerateLessonContentResponse
-	(*GenerateAllLessonsRequest)(nil),     // 30: mirai.v1.GenerateAllLessonsRequest
-	(*GenerateAllLessonsResponse)(nil),    // 31: mirai.v1.GenerateAllLessonsResponse
-	(*RegenerateComponentRequest)(nil),    // 32: mirai.v1.RegenerateComponentRequest
-	(*RegenerateComponentResponse)(nil),   // 33: mirai.v1.RegenerateComponentResponse
-	(*GetJobRequest)(nil),                 // 34: mirai.v1.GetJobRequest
-	(*GetJobResponse)(nil),                // 35: mirai.v1.GetJobResponse
-	(*ListJobsRequest)(nil),               // 36: mirai.v1.ListJobsRequest
-	(*ListJobsResponse)(nil),              // 37: mirai.v1.ListJobsResponse
-	(*CancelJobRequest)(nil),              // 38: mirai.v1.CancelJobRequest
-	(*CancelJobResponse)(nil),             // 39: mirai.v1.CancelJobResponse
-	(*GetGeneratedLessonRequest)(nil),     // 40: mirai.v1.GetGeneratedLessonRequest
-	(*GetGeneratedLessonResponse)(nil),    // 41: mirai.v1.GetGeneratedLessonResponse
-	(*ListGeneratedLessonsRequest)(nil),   // 42: mirai.v1.ListGeneratedLessonsRequest
-	(*ListGeneratedLessonsResponse)(nil),  // 43: mirai.v1.ListGeneratedLessonsResponse
-	(*timestamppb.Timestamp)(nil),         // 44: google.protobuf.Timestamp
+	(GenerationJobType)(0),                 // 0: mirai.v1.GenerationJobType
+	(GenerationJobStatus)(0),               // 1: mirai.v1.GenerationJobStatus
+	(GenerationJobEventType)(0),            // 2: mirai.v1.GenerationJobEventType
+	(OutlineApprovalStatus)(0),             // 3: mirai.v1.OutlineApprovalStatus
+	(LessonComponentType)(0),               // 4: mirai.v1.LessonComponentType
+	(HeadingLevel)(0),                      // 5: mirai.v1.HeadingLevel
+	(*GenerationJob)(nil),                  // 6: mirai.v1.GenerationJob
+	(*CourseOutline)(nil),                  // 7: mirai.v1.CourseOutline
+	(*OutlineSection)(nil),                 // 8: mirai.v1.OutlineSection
+	(*OutlineLesson)(nil),                  // 9: mirai.v1.OutlineLesson
+	(*GeneratedLesson)(nil),                // 10: mirai.v1.GeneratedLesson
+	(*LessonComponent)(nil),                // 11: mirai.v1.LessonComponent
+	(*ComponentAlignment)(nil),             // 12: mirai.v1.ComponentAlignment
+	(*ComponentSource)(nil),                // 13: mirai.v1.ComponentSource
+	(*TextContent)(nil),                    // 14: mirai.v1.TextContent
+	(*HeadingContent)(nil),                 // 15: mirai.v1.HeadingContent
+	(*ImageContent)(nil),                   // 16: mirai.v1.ImageContent
+	(*QuizContent)(nil),                    // 17: mirai.v1.QuizContent
+	(*QuizOption)(nil),                     // 18: mirai.v1.QuizOption
+	(*CourseGenerationInput)(nil),          // 19: mirai.v1.CourseGenerationInput
+	(*GenerateCourseOutlineRequest)(nil),   // 20: mirai.v1.GenerateCourseOutlineRequest
+	(*GenerateCourseOutlineResponse)(nil),  // 21: mirai.v1.GenerateCourseOutlineResponse
+	(*GetCourseOutlineRequest)(nil),        // 22: mirai.v1.GetCourseOutlineRequest
+	(*GetCourseOutlineResponse)(nil),       // 23: mirai.v1.GetCourseOutlineResponse
+	(*ApproveCourseOutlineRequest)(nil),    // 24: mirai.v1.ApproveCourseOutlineRequest
+	(*ApproveCourseOutlineResponse)(nil),   // 25: mirai.v1.ApproveCourseOutlineResponse
+	(*RejectCourseOutlineRequest)(nil),     // 26: mirai.v1.RejectCourseOutlineRequest
+	(*RejectCourseOutlineResponse)(nil),    // 27: mirai.v1.RejectCourseOutlineResponse
+	(*UpdateCourseOutlineRequest)(nil),     // 28: mirai.v1.UpdateCourseOutlineRequest
+	(*UpdateCourseOutlineResponse)(nil),    // 29: mirai.v1.UpdateCourseOutlineResponse
+	(*GenerateLessonContentRequest)(nil),   // 30: mirai.v1.GenerateLessonContentRequest
+	(*GenerateLessonContentResponse)(nil),  // 31: mirai.v1.GenerateLessonContentResponse
+	(*GenerateAllLessonsRequest)(nil),      // 32: mirai.v1.GenerateAllLessonsRequest
+	(*GenerateAllLessonsResponse)(nil),     // 33: mirai.v1.GenerateAllLessonsResponse
+	(*RegenerateComponentRequest)(nil),     // 34: mirai.v1.RegenerateComponentRequest
+	(*RegenerateComponentResponse)(nil),    // 35: mirai.v1.RegenerateComponentResponse
+	(*GetJobRequest)(nil),                  // 36: mirai.v1.GetJobRequest
+	(*GetJobResponse)(nil),                 // 37: mirai.v1.GetJobResponse
+	(*ListJobsRequest)(nil),                // 38: mirai.v1.ListJobsRequest
+	(*ListJobsResponse)(nil),               // 39: mirai.v1.ListJobsResponse
+	(*CancelJobRequest)(nil),               // 40: mirai.v1.CancelJobRequest
+	(*CancelJobResponse)(nil),              // 41: mirai.v1.CancelJobResponse
+	(*ResumeJobRequest)(nil),               // 42: mirai.v1.ResumeJobRequest
+	(*ResumeJobResponse)(nil),              // 43: mirai.v1.ResumeJobResponse
+	(*WatchJobRequest)(nil),                // 44: mirai.v1.WatchJobRequest
+	(*WatchJobResponse)(nil),               // 45: mirai.v1.WatchJobResponse
+	(*GetGeneratedLessonRequest)(nil),      // 46: mirai.v1.GetGeneratedLessonRequest
+	(*GetGeneratedLessonResponse)(nil),     // 47: mirai.v1.GetGeneratedLessonResponse
+	(*ListGeneratedLessonsRequest)(nil),    // 48: mirai.v1.ListGeneratedLessonsRequest
+	(*ListGeneratedLessonsResponse)(nil),   // 49: mirai.v1.ListGeneratedLessonsResponse
+	(*ListStaleLessonsRequest)(nil),        // 50: mirai.v1.ListStaleLessonsRequest
+	(*ListStaleLessonsResponse)(nil),       // 51: mirai.v1.ListStaleLessonsResponse
+	(*RegenerateStaleLessonsRequest)(nil),  // 52: mirai.v1.RegenerateStaleLessonsRequest
+	(*RegenerateStaleLessonsResponse)(nil), // 53: mirai.v1.RegenerateStaleLessonsResponse
+	(*timestamppb.Timestamp)(nil),          // 54: google.protobuf.Timestamp
 }
 var file_mirai_v1_ai_generation_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.GenerationJob.type:type_name -> mirai.v1.GenerationJobType
 	1,  // 1: mirai.v1.GenerationJob.status:type_name -> mirai.v1.GenerationJobStatus
-	44, // 2: mirai.v1.GenerationJob.created_at:type_name -> google.protobuf.Timestamp
-	44, // 3: mirai.v1.GenerationJob.started_at:type_name -> google.protobuf.Timestamp
-	44, // 4: mirai.v1.GenerationJob.completed_at:type_name -> google.protobuf.Timestamp
-	7,  // 5: mirai.v1.CourseOutline.sections:type_name -> mirai.v1.OutlineSection
-	2,  // 6: mirai.v1.CourseOutline.approval_status:type_name -> mirai.v1.OutlineApprovalStatus
-	44, // 7: mirai.v1.CourseOutline.generated_at:type_name -> google.protobuf.Timestamp
-	44, // 8: mirai.v1.CourseOutline.approved_at:type_name -> google.protobuf.Timestamp
-	8,  // 9: mirai.v1.OutlineSection.lessons:type_name -> mirai.v1.OutlineLesson
-	10, // 10: mirai.v1.GeneratedLesson.components:type_name -> mirai.v1.LessonComponent
-	44, // 11: mirai.v1.GeneratedLesson.generated_at:type_name -> google.protobuf.Timestamp
-	3,  // 12: mirai.v1.LessonComponent.type:type_name -> mirai.v1.LessonComponentType
-	11, // 13: mirai.v1.LessonComponent.alignment:type_name -> mirai.v1.ComponentAlignment
-	4,  // 14: mirai.v1.HeadingContent.level:type_name -> mirai.v1.HeadingLevel
-	16, // 15: mirai.v1.QuizContent.options:type_name -> mirai.v1.QuizOption
-	17, // 16: mirai.v1.GenerateCourseOutlineRequest.input:type_name -> mirai.v1.CourseGenerationInput
-	5,  // 17: mirai.v1.GenerateCourseOutlineResponse.job:type_name -> mirai.v1.GenerationJob
-	6,  // 18: mirai.v1.GetCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	6,  // 19: mirai.v1.ApproveCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	6,  // 20: mirai.v1.RejectCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	7,  // 21: mirai.v1.UpdateCourseOutlineRequest.sections:type_name -> mirai.v1.OutlineSection
-	6,  // 22: mirai.v1.UpdateCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
-	5,  // 23: mirai.v1.GenerateLessonContentResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 24: mirai.v1.GenerateAllLessonsResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 25: mirai.v1.RegenerateComponentResponse.job:type_name -> mirai.v1.GenerationJob
-	5,  // 26: mirai.v1.GetJobResponse.job:type_name -> mirai.v1.GenerationJob
-	0,  // 27: mirai.v1.ListJobsRequest.type:type_name -> mirai.v1.GenerationJobType
-	1,  // 28: mirai.v1.ListJobsRequest.status:type_name -> mirai.v1.GenerationJobStatus
-	5,  // 29: mirai.v1.ListJobsResponse.jobs:type_name -> mirai.v1.GenerationJob
-	5,  // 30: mirai.v1.CancelJobResponse.job:type_name -> mirai.v1.GenerationJob
-	9,  // 31: mirai.v1.GetGeneratedLessonResponse.lesson:type_name -> mirai.v1.GeneratedLesson
-	9,  // 32: mirai.v1.ListGeneratedLessonsResponse.lessons:type_name -> mirai.v1.GeneratedLesson
-	18, // 33: mirai.v1.AIGenerationService.GenerateCourseOutline:input_type -> mirai.v1.GenerateCourseOutlineRequest
-	20, // 34: mirai.v1.AIGenerationService.GetCourseOutline:input_type -> mirai.v1.GetCourseOutlineRequest
-	22, // 35: mirai.v1.AIGenerationService.ApproveCourseOutline:input_type -> mirai.v1.ApproveCourseOutlineRequest
-	24, // 36: mirai.v1.AIGenerationService.RejectCourseOutline:input_type -> mirai.v1.RejectCourseOutlineRequest
-	26, // 37: mirai.v1.AIGenerationService.UpdateCourseOutline:input_type -> mirai.v1.UpdateCourseOutlineRequest
-	28, // 38: mirai.v1.AIGenerationService.GenerateLessonContent:input_type -> mirai.v1.GenerateLessonContentRequest
-	30, // 39: mirai.v1.AIGenerationService.GenerateAllLessons:input_type -> mirai.v1.GenerateAllLessonsRequest
-	32, // 40: mirai.v1.AIGenerationService.RegenerateComponent:input_type -> mirai.v1.RegenerateComponentRequest
-	34, // 41: mirai.v1.AIGenerationService.GetJob:input_type -> mirai.v1.GetJobRequest
-	36, // 42: mirai.v1.AIGenerationService.ListJobs:input_type -> mirai.v1.ListJobsRequest
-	38, // 43: mirai.v1.AIGenerationService.CancelJob:input_type -> mirai.v1.CancelJobRequest
-	40, // 44: mirai.v1.AIGenerationService.GetGeneratedLesson:input_type -> mirai.v1.GetGeneratedLessonRequest
-	42, // 45: mirai.v1.AIGenerationService.ListGeneratedLessons:input_type -> mirai.v1.ListGeneratedLessonsRequest
-	19, // 46: mirai.v1.AIGenerationService.GenerateCourseOutline:output_type -> mirai.v1.GenerateCourseOutlineResponse
-	21, // 47: mirai.v1.AIGenerationService.GetCourseOutline:output_type -> mirai.v1.GetCourseOutlineResponse
-	23, // 48: mirai.v1.AIGenerationService.ApproveCourseOutline:output_type -> mirai.v1.ApproveCourseOutlineResponse
-	25, // 49: mirai.v1.AIGenerationService.RejectCourseOutline:output_type -> mirai.v1.RejectCourseOutlineResponse
-	27, // 50: mirai.v1.AIGenerationService.UpdateCourseOutline:output_type -> mirai.v1.UpdateCourseOutlineResponse
-	29, // 51: mirai.v1.AIGenerationService.GenerateLessonContent:output_type -> mirai.v1.GenerateLessonContentResponse
-	31, // 52: mirai.v1.AIGenerationService.GenerateAllLessons:output_type -> mirai.v1.GenerateAllLessonsResponse
-	33, // 53: mirai.v1.AIGenerationService.RegenerateComponent:output_type -> mirai.v1.RegenerateComponentResponse
-	35, // 54: mirai.v1.AIGenerationService.GetJob:output_type -> mirai.v1.GetJobResponse
-	37, // 55: mirai.v1.AIGenerationService.ListJobs:output_type -> mirai.v1.ListJobsResponse
-	39, // 56: mirai.v1.AIGenerationService.CancelJob:output_type -> mirai.v1.CancelJobResponse
-	41, // 57: mirai.v1.AIGenerationService.GetGeneratedLesson:output_type -> mirai.v1.GetGeneratedLessonResponse
-	43, // 58: mirai.v1.AIGenerationService.ListGeneratedLessons:output_type -> mirai.v1.ListGeneratedLessonsResponse
-	46, // [46:59] is the sub-list for method output_type
-	33, // [33:46] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	54, // 2: mirai.v1.GenerationJob.created_at:type_name -> google.protobuf.Timestamp
+	54, // 3: mirai.v1.GenerationJob.started_at:type_name -> google.protobuf.Timestamp
+	54, // 4: mirai.v1.GenerationJob.completed_at:type_name -> google.protobuf.Timestamp
+	8,  // 5: mirai.v1.CourseOutline.sections:type_name -> mirai.v1.OutlineSection
+	3,  // 6: mirai.v1.CourseOutline.approval_status:type_name -> mirai.v1.OutlineApprovalStatus
+	54, // 7: mirai.v1.CourseOutline.generated_at:type_name -> google.protobuf.Timestamp
+	54, // 8: mirai.v1.CourseOutline.approved_at:type_name -> google.protobuf.Timestamp
+	9,  // 9: mirai.v1.OutlineSection.lessons:type_name -> mirai.v1.OutlineLesson
+	11, // 10: mirai.v1.GeneratedLesson.components:type_name -> mirai.v1.LessonComponent
+	54, // 11: mirai.v1.GeneratedLesson.generated_at:type_name -> google.protobuf.Timestamp
+	54, // 12: mirai.v1.GeneratedLesson.stale_since:type_name -> google.protobuf.Timestamp
+	4,  // 13: mirai.v1.LessonComponent.type:type_name -> mirai.v1.LessonComponentType
+	12, // 14: mirai.v1.LessonComponent.alignment:type_name -> mirai.v1.ComponentAlignment
+	5,  // 15: mirai.v1.HeadingContent.level:type_name -> mirai.v1.HeadingLevel
+	18, // 16: mirai.v1.QuizContent.options:type_name -> mirai.v1.QuizOption
+	19, // 17: mirai.v1.GenerateCourseOutlineRequest.input:type_name -> mirai.v1.CourseGenerationInput
+	6,  // 18: mirai.v1.GenerateCourseOutlineResponse.job:type_name -> mirai.v1.GenerationJob
+	7,  // 19: mirai.v1.GetCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	7,  // 20: mirai.v1.ApproveCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	7,  // 21: mirai.v1.RejectCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	8,  // 22: mirai.v1.UpdateCourseOutlineRequest.sections:type_name -> mirai.v1.OutlineSection
+	7,  // 23: mirai.v1.UpdateCourseOutlineResponse.outline:type_name -> mirai.v1.CourseOutline
+	6,  // 24: mirai.v1.GenerateLessonContentResponse.job:type_name -> mirai.v1.GenerationJob
+	6,  // 25: mirai.v1.GenerateAllLessonsResponse.job:type_name -> mirai.v1.GenerationJob
+	6,  // 26: mirai.v1.RegenerateComponentResponse.job:type_name -> mirai.v1.GenerationJob
+	6,  // 27: mirai.v1.GetJobResponse.job:type_name -> mirai.v1.GenerationJob
+	0,  // 28: mirai.v1.ListJobsRequest.type:type_name -> mirai.v1.GenerationJobType
+	1,  // 29: mirai.v1.ListJobsRequest.status:type_name -> mirai.v1.GenerationJobStatus
+	6,  // 30: mirai.v1.ListJobsResponse.jobs:type_name -> mirai.v1.GenerationJob
+	6,  // 31: mirai.v1.CancelJobResponse.job:type_name -> mirai.v1.GenerationJob
+	6,  // 32: mirai.v1.ResumeJobResponse.job:type_name -> mirai.v1.GenerationJob
+	2,  // 33: mirai.v1.WatchJobResponse.event_type:type_name -> mirai.v1.GenerationJobEventType
+	6,  // 34: mirai.v1.WatchJobResponse.job:type_name -> mirai.v1.GenerationJob
+	8,  // 35: mirai.v1.WatchJobResponse.section:type_name -> mirai.v1.OutlineSection
+	10, // 36: mirai.v1.WatchJobResponse.lesson:type_name -> mirai.v1.GeneratedLesson
+	10, // 37: mirai.v1.GetGeneratedLessonResponse.lesson:type_name -> mirai.v1.GeneratedLesson
+	13, // 38: mirai.v1.GetGeneratedLessonResponse.sources:type_name -> mirai.v1.ComponentSource
+	10, // 39: mirai.v1.ListGeneratedLessonsResponse.lessons:type_name -> mirai.v1.GeneratedLesson
+	10, // 40: mirai.v1.ListStaleLessonsResponse.lessons:type_name -> mirai.v1.GeneratedLesson
+	6,  // 41: mirai.v1.RegenerateStaleLessonsResponse.job:type_name -> mirai.v1.GenerationJob
+	20, // 42: mirai.v1.AIGenerationService.GenerateCourseOutline:input_type -> mirai.v1.GenerateCourseOutlineRequest
+	22, // 43: mirai.v1.AIGenerationService.GetCourseOutline:input_type -> mirai.v1.GetCourseOutlineRequest
+	24, // 44: mirai.v1.AIGenerationService.ApproveCourseOutline:input_type -> mirai.v1.ApproveCourseOutlineRequest
+	26, // 45: mirai.v1.AIGenerationService.RejectCourseOutline:input_type -> mirai.v1.RejectCourseOutlineRequest
+	28, // 46: mirai.v1.AIGenerationService.UpdateCourseOutline:input_type -> mirai.v1.UpdateCourseOutlineRequest
+	30, // 47: mirai.v1.AIGenerationService.GenerateLessonContent:input_type -> mirai.v1.GenerateLessonContentRequest
+	32, // 48: mirai.v1.AIGenerationService.GenerateAllLessons:input_type -> mirai.v1.GenerateAllLessonsRequest
+	34, // 49: mirai.v1.AIGenerationService.RegenerateComponent:input_type -> mirai.v1.RegenerateComponentRequest
+	36, // 50: mirai.v1.AIGenerationService.GetJob:input_type -> mirai.v1.GetJobRequest
+	38, // 51: mirai.v1.AIGenerationService.ListJobs:input_type -> mirai.v1.ListJobsRequest
+	40, // 52: mirai.v1.AIGenerationService.CancelJob:input_type -> mirai.v1.CancelJobRequest
+	42, // 53: mirai.v1.AIGenerationService.ResumeJob:input_type -> mirai.v1.ResumeJobRequest
+	44, // 54: mirai.v1.AIGenerationService.WatchJob:input_type -> mirai.v1.WatchJobRequest
+	46, // 55: mirai.v1.AIGenerationService.GetGeneratedLesson:input_type -> mirai.v1.GetGeneratedLessonRequest
+	48, // 56: mirai.v1.AIGenerationService.ListGeneratedLessons:input_type -> mirai.v1.ListGeneratedLessonsRequest
+	50, // 57: mirai.v1.AIGenerationService.ListStaleLessons:input_type -> mirai.v1.ListStaleLessonsRequest
+	52, // 58: mirai.v1.AIGenerationService.RegenerateStaleLessons:input_type -> mirai.v1.RegenerateStaleLessonsRequest
+	21, // 59: mirai.v1.AIGenerationService.GenerateCourseOutline:output_type -> mirai.v1.GenerateCourseOutlineResponse
+	23, // 60: mirai.v1.AIGenerationService.GetCourseOutline:output_type -> mirai.v1.GetCourseOutlineResponse
+	25, // 61: mirai.v1.AIGenerationService.ApproveCourseOutline:output_type -> mirai.v1.ApproveCourseOutlineResponse
+	27, // 62: mirai.v1.AIGenerationService.RejectCourseOutline:output_type -> mirai.v1.RejectCourseOutlineResponse
+	29, // 63: mirai.v1.AIGenerationService.UpdateCourseOutline:output_type -> mirai.v1.UpdateCourseOutlineResponse
+	31, // 64: mirai.v1.AIGenerationService.GenerateLessonContent:output_type -> mirai.v1.GenerateLessonContentResponse
+	33, // 65: mirai.v1.AIGenerationService.GenerateAllLessons:output_type -> mirai.v1.GenerateAllLessonsResponse
+	35, // 66: mirai.v1.AIGenerationService.RegenerateComponent:output_type -> mirai.v1.RegenerateComponentResponse
+	37, // 67: mirai.v1.AIGenerationService.GetJob:output_type -> mirai.v1.GetJobResponse
+	39, // 68: mirai.v1.AIGenerationService.ListJobs:output_type -> mirai.v1.ListJobsResponse
+	41, // 69: mirai.v1.AIGenerationService.CancelJob:output_type -> mirai.v1.CancelJobResponse
+	43, // 70: mirai.v1.AIGenerationService.ResumeJob:output_type -> mirai.v1.ResumeJobResponse
+	45, // 71: mirai.v1.AIGenerationService.WatchJob:output_type -> mirai.v1.WatchJobResponse
+	47, // 72: mirai.v1.AIGenerationService.GetGeneratedLesson:output_type -> mirai.v1.GetGeneratedLessonResponse
+	49, // 73: mirai.v1.AIGenerationService.ListGeneratedLessons:output_type -> mirai.v1.ListGeneratedLessonsResponse
+	51, // 74: mirai.v1.AIGenerationService.ListStaleLessons:output_type -> mirai.v1.ListStaleLessonsResponse
+	53, // 75: mirai.v1.AIGenerationService.RegenerateStaleLessons:output_type -> mirai.v1.RegenerateStaleLessonsResponse
+	59, // [59:76] is the sub-list for method output_type
+	42, // [42:59] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_ai_generation_proto_init() }
@@ -3078,18 +3773,19 @@ func file_mirai_v1_ai_generation_proto_init() {
 	file_mirai_v1_ai_generation_proto_msgTypes[1].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[4].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[5].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[9].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[7].OneofWrappers = []any{}
 	file_mirai_v1_ai_generation_proto_msgTypes[10].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[12].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[15].OneofWrappers = []any{}
-	file_mirai_v1_ai_generation_proto_msgTypes[31].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[13].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[16].OneofWrappers = []any{}
+	file_mirai_v1_ai_generation_proto_msgTypes[32].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_ai_generation_proto_rawDesc), len(file_mirai_v1_ai_generation_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   39,
+			NumEnums:      6,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,100 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Entitlements are the limits and features a company's plan grants,
+// including enterprise contract overrides. Limits of 0 are unlimited.
+type Entitlements struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Plan            Plan                   `protobuf:"varint,1,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`
+	MaxSmes         int32                  `protobuf:"varint,2,opt,name=max_smes,json=maxSmes,proto3" json:"max_smes,omitempty"`
+	MaxCourses      int32                  `protobuf:"varint,3,opt,name=max_courses,json=maxCourses,proto3" json:"max_courses,omitempty"`
+	MonthlyAiTokens int64                  `protobuf:"varint,4,opt,name=monthly_ai_tokens,json=monthlyAiTokens,proto3" json:"monthly_ai_tokens,omitempty"`
+	ExportFormats   []ExportFormat         `protobuf:"varint,5,rep,packed,name=export_formats,json=exportFormats,proto3,enum=mirai.v1.ExportFormat" json:"export_formats,omitempty"`
+	Sso             bool                   `protobuf:"varint,6,opt,name=sso,proto3" json:"sso,omitempty"`
+	CustomBranding  bool                   `protobuf:"varint,7,opt,name=custom_branding,json=customBranding,proto3" json:"custom_branding,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Entitlements) Reset() {
+	*x = Entitlements{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entitlements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entitlements) ProtoMessage() {}
+
+func (x *Entitlements) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entitlements.ProtoReflect.Descriptor instead.
+func (*Entitlements) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Entitlements) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *Entitlements) GetMaxSmes() int32 {
+	if x != nil {
+		return x.MaxSmes
+	}
+	return 0
+}
+
+func (x *Entitlements) GetMaxCourses() int32 {
+	if x != nil {
+		return x.MaxCourses
+	}
+	return 0
+}
+
+func (x *Entitlements) GetMonthlyAiTokens() int64 {
+	if x != nil {
+		return x.MonthlyAiTokens
+	}
+	return 0
+}
+
+func (x *Entitlements) GetExportFormats() []ExportFormat {
+	if x != nil {
+		return x.ExportFormats
+	}
+	return nil
+}
+
+func (x *Entitlements) GetSso() bool {
+	if x != nil {
+		return x.Sso
+	}
+	return false
+}
+
+func (x *Entitlements) GetCustomBranding() bool {
+	if x != nil {
+		return x.CustomBranding
+	}
+	return false
+}
+
 // GetBillingInfoRequest is empty as company is identified by auth context.
 type GetBillingInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -30,7 +124,7 @@ type GetBillingInfoRequest struct {
 
 func (x *GetBillingInfoRequest) Reset() {
 	*x = GetBillingInfoRequest{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[0]
+	mi := &file_mirai_v1_billing_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -42,7 +136,7 @@ func (x *GetBillingInfoRequest) String() string {
 func (*GetBillingInfoRequest) ProtoMessage() {}
 
 func (x *GetBillingInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[0]
+	mi := &file_mirai_v1_billing_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,7 +149,7 @@ func (x *GetBillingInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetBillingInfoRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{0}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{1}
 }
 
 // GetBillingInfoResponse contains the current billing status.
@@ -67,13 +161,14 @@ type GetBillingInfoResponse struct {
 	PricePerSeat      int32                  `protobuf:"varint,4,opt,name=price_per_seat,json=pricePerSeat,proto3" json:"price_per_seat,omitempty"`                   // cents
 	CurrentPeriodEnd  *int64                 `protobuf:"varint,5,opt,name=current_period_end,json=currentPeriodEnd,proto3,oneof" json:"current_period_end,omitempty"` // unix timestamp
 	CancelAtPeriodEnd bool                   `protobuf:"varint,6,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`
+	BillingEmail      *string                `protobuf:"bytes,7,opt,name=billing_email,json=billingEmail,proto3,oneof" json:"billing_email,omitempty"` // Where Stripe sends invoices
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
 
 func (x *GetBillingInfoResponse) Reset() {
 	*x = GetBillingInfoResponse{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[1]
+	mi := &file_mirai_v1_billing_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -85,7 +180,7 @@ func (x *GetBillingInfoResponse) String() string {
 func (*GetBillingInfoResponse) ProtoMessage() {}
 
 func (x *GetBillingInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[1]
+	mi := &file_mirai_v1_billing_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -98,7 +193,7 @@ func (x *GetBillingInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBillingInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetBillingInfoResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{1}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetBillingInfoResponse) GetPlan() Plan {
@@ -143,6 +238,13 @@ func (x *GetBillingInfoResponse) GetCancelAtPeriodEnd() bool {
 	return false
 }
 
+func (x *GetBillingInfoResponse) GetBillingEmail() string {
+	if x != nil && x.BillingEmail != nil {
+		return *x.BillingEmail
+	}
+	return ""
+}
+
 // CreateCheckoutSessionRequest contains the plan to subscribe to.
 type CreateCheckoutSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -153,7 +255,7 @@ type CreateCheckoutSessionRequest struct {
 
 func (x *CreateCheckoutSessionRequest) Reset() {
 	*x = CreateCheckoutSessionRequest{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[2]
+	mi := &file_mirai_v1_billing_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -165,7 +267,7 @@ func (x *CreateCheckoutSessionRequest) String() string {
 func (*CreateCheckoutSessionRequest) ProtoMessage() {}
 
 func (x *CreateCheckoutSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[2]
+	mi := &file_mirai_v1_billing_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -178,7 +280,7 @@ func (x *CreateCheckoutSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCheckoutSessionRequest.ProtoReflect.Descriptor instead.
 func (*CreateCheckoutSessionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{2}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CreateCheckoutSessionRequest) GetPlan() Plan {
@@ -198,7 +300,7 @@ type CreateCheckoutSessionResponse struct {
 
 func (x *CreateCheckoutSessionResponse) Reset() {
 	*x = CreateCheckoutSessionResponse{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[3]
+	mi := &file_mirai_v1_billing_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -210,7 +312,7 @@ func (x *CreateCheckoutSessionResponse) String() string {
 func (*CreateCheckoutSessionResponse) ProtoMessage() {}
 
 func (x *CreateCheckoutSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[3]
+	mi := &file_mirai_v1_billing_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -223,7 +325,7 @@ func (x *CreateCheckoutSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCheckoutSessionResponse.ProtoReflect.Descriptor instead.
 func (*CreateCheckoutSessionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateCheckoutSessionResponse) GetUrl() string {
@@ -242,7 +344,7 @@ type CreatePortalSessionRequest struct {
 
 func (x *CreatePortalSessionRequest) Reset() {
 	*x = CreatePortalSessionRequest{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[4]
+	mi := &file_mirai_v1_billing_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -254,7 +356,7 @@ func (x *CreatePortalSessionRequest) String() string {
 func (*CreatePortalSessionRequest) ProtoMessage() {}
 
 func (x *CreatePortalSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[4]
+	mi := &file_mirai_v1_billing_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -267,7 +369,7 @@ func (x *CreatePortalSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePortalSessionRequest.ProtoReflect.Descriptor instead.
 func (*CreatePortalSessionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{5}
 }
 
 // CreatePortalSessionResponse contains the Stripe Customer Portal URL.
@@ -280,7 +382,7 @@ type CreatePortalSessionResponse struct {
 
 func (x *CreatePortalSessionResponse) Reset() {
 	*x = CreatePortalSessionResponse{}
-	mi := &file_mirai_v1_billing_proto_msgTypes[5]
+	mi := &file_mirai_v1_billing_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +394,7 @@ func (x *CreatePortalSessionResponse) String() string {
 func (*CreatePortalSessionResponse) ProtoMessage() {}
 
 func (x *CreatePortalSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_billing_proto_msgTypes[5]
+	mi := &file_mirai_v1_billing_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +407,7 @@ func (x *CreatePortalSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePortalSessionResponse.ProtoReflect.Descriptor instead.
 func (*CreatePortalSessionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{5}
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreatePortalSessionResponse) GetUrl() string {
@@ -315,12 +417,387 @@ func (x *CreatePortalSessionResponse) GetUrl() string {
 	return ""
 }
 
+// PreviewSubscriptionChangeRequest contains the plan and seat count to move to.
+type PreviewSubscriptionChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          Plan                   `protobuf:"varint,1,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`         // Current plan when unspecified
+	SeatCount     int32                  `protobuf:"varint,2,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"` // Current seat count when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSubscriptionChangeRequest) Reset() {
+	*x = PreviewSubscriptionChangeRequest{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSubscriptionChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSubscriptionChangeRequest) ProtoMessage() {}
+
+func (x *PreviewSubscriptionChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscriptionChangeRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionChangeRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PreviewSubscriptionChangeRequest) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *PreviewSubscriptionChangeRequest) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+// PreviewSubscriptionChangeResponse contains the cost of the change.
+type PreviewSubscriptionChangeResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPlan       Plan                   `protobuf:"varint,1,opt,name=current_plan,json=currentPlan,proto3,enum=mirai.v1.Plan" json:"current_plan,omitempty"`
+	CurrentSeatCount  int32                  `protobuf:"varint,2,opt,name=current_seat_count,json=currentSeatCount,proto3" json:"current_seat_count,omitempty"`
+	Plan              Plan                   `protobuf:"varint,3,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`
+	SeatCount         int32                  `protobuf:"varint,4,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`
+	ProratedAmount    int64                  `protobuf:"varint,5,opt,name=prorated_amount,json=proratedAmount,proto3" json:"prorated_amount,omitempty"`            // cents, negative for a credit
+	NextInvoiceAmount int64                  `protobuf:"varint,6,opt,name=next_invoice_amount,json=nextInvoiceAmount,proto3" json:"next_invoice_amount,omitempty"` // cents, including the proration
+	NextInvoiceAt     int64                  `protobuf:"varint,7,opt,name=next_invoice_at,json=nextInvoiceAt,proto3" json:"next_invoice_at,omitempty"`             // unix timestamp
+	Currency          string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	ProrationDate     int64                  `protobuf:"varint,9,opt,name=proration_date,json=prorationDate,proto3" json:"proration_date,omitempty"` // unix timestamp, pass to ChangeSubscription to apply the previewed amount
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreviewSubscriptionChangeResponse) Reset() {
+	*x = PreviewSubscriptionChangeResponse{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSubscriptionChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSubscriptionChangeResponse) ProtoMessage() {}
+
+func (x *PreviewSubscriptionChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSubscriptionChangeResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSubscriptionChangeResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetCurrentPlan() Plan {
+	if x != nil {
+		return x.CurrentPlan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetCurrentSeatCount() int32 {
+	if x != nil {
+		return x.CurrentSeatCount
+	}
+	return 0
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetProratedAmount() int64 {
+	if x != nil {
+		return x.ProratedAmount
+	}
+	return 0
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetNextInvoiceAmount() int64 {
+	if x != nil {
+		return x.NextInvoiceAmount
+	}
+	return 0
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetNextInvoiceAt() int64 {
+	if x != nil {
+		return x.NextInvoiceAt
+	}
+	return 0
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PreviewSubscriptionChangeResponse) GetProrationDate() int64 {
+	if x != nil {
+		return x.ProrationDate
+	}
+	return 0
+}
+
+// ChangeSubscriptionRequest contains the plan and seat count to move to.
+type ChangeSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          Plan                   `protobuf:"varint,1,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`                           // Current plan when unspecified
+	SeatCount     int32                  `protobuf:"varint,2,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`                   // Current seat count when 0
+	ProrationDate *int64                 `protobuf:"varint,3,opt,name=proration_date,json=prorationDate,proto3,oneof" json:"proration_date,omitempty"` // From a recent preview; prorates as of now when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSubscriptionRequest) Reset() {
+	*x = ChangeSubscriptionRequest{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscriptionRequest) ProtoMessage() {}
+
+func (x *ChangeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ChangeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeSubscriptionRequest) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *ChangeSubscriptionRequest) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *ChangeSubscriptionRequest) GetProrationDate() int64 {
+	if x != nil && x.ProrationDate != nil {
+		return *x.ProrationDate
+	}
+	return 0
+}
+
+// ChangeSubscriptionResponse contains the updated billing status.
+type ChangeSubscriptionResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	BillingInfo   *GetBillingInfoResponse `protobuf:"bytes,1,opt,name=billing_info,json=billingInfo,proto3" json:"billing_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeSubscriptionResponse) Reset() {
+	*x = ChangeSubscriptionResponse{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeSubscriptionResponse) ProtoMessage() {}
+
+func (x *ChangeSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ChangeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ChangeSubscriptionResponse) GetBillingInfo() *GetBillingInfoResponse {
+	if x != nil {
+		return x.BillingInfo
+	}
+	return nil
+}
+
+// GetEntitlementsRequest is empty as company is identified by auth context.
+type GetEntitlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitlementsRequest) Reset() {
+	*x = GetEntitlementsRequest{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsRequest) ProtoMessage() {}
+
+func (x *GetEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{11}
+}
+
+// GetEntitlementsResponse contains the entitlements and current usage.
+type GetEntitlementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entitlements  *Entitlements          `protobuf:"bytes,1,opt,name=entitlements,proto3" json:"entitlements,omitempty"`
+	SmeCount      int32                  `protobuf:"varint,2,opt,name=sme_count,json=smeCount,proto3" json:"sme_count,omitempty"`          // Active SMEs, counted against max_smes
+	CourseCount   int32                  `protobuf:"varint,3,opt,name=course_count,json=courseCount,proto3" json:"course_count,omitempty"` // Counted against max_courses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEntitlementsResponse) Reset() {
+	*x = GetEntitlementsResponse{}
+	mi := &file_mirai_v1_billing_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEntitlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEntitlementsResponse) ProtoMessage() {}
+
+func (x *GetEntitlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_billing_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEntitlementsResponse.ProtoReflect.Descriptor instead.
+func (*GetEntitlementsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_billing_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetEntitlementsResponse) GetEntitlements() *Entitlements {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+func (x *GetEntitlementsResponse) GetSmeCount() int32 {
+	if x != nil {
+		return x.SmeCount
+	}
+	return 0
+}
+
+func (x *GetEntitlementsResponse) GetCourseCount() int32 {
+	if x != nil {
+		return x.CourseCount
+	}
+	return 0
+}
+
 var File_mirai_v1_billing_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_billing_proto_rawDesc = "" +
 	"\n" +
-	"\x16mirai/v1/billing.proto\x12\bmirai.v1\x1a\x15mirai/v1/common.proto\"\x17\n" +
-	"\x15GetBillingInfoRequest\"\xb2\x02\n" +
+	"\x16mirai/v1/billing.proto\x12\bmirai.v1\x1a\x15mirai/v1/common.proto\x1a\x15mirai/v1/course.proto\"\x94\x02\n" +
+	"\fEntitlements\x12\"\n" +
+	"\x04plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\x19\n" +
+	"\bmax_smes\x18\x02 \x01(\x05R\amaxSmes\x12\x1f\n" +
+	"\vmax_courses\x18\x03 \x01(\x05R\n" +
+	"maxCourses\x12*\n" +
+	"\x11monthly_ai_tokens\x18\x04 \x01(\x03R\x0fmonthlyAiTokens\x12=\n" +
+	"\x0eexport_formats\x18\x05 \x03(\x0e2\x16.mirai.v1.ExportFormatR\rexportFormats\x12\x10\n" +
+	"\x03sso\x18\x06 \x01(\bR\x03sso\x12'\n" +
+	"\x0fcustom_branding\x18\a \x01(\bR\x0ecustomBranding\"\x17\n" +
+	"\x15GetBillingInfoRequest\"\xee\x02\n" +
 	"\x16GetBillingInfoResponse\x12\"\n" +
 	"\x04plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x124\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1c.mirai.v1.SubscriptionStatusR\x06status\x12\x1d\n" +
@@ -328,19 +805,52 @@ const file_mirai_v1_billing_proto_rawDesc = "" +
 	"seat_count\x18\x03 \x01(\x05R\tseatCount\x12$\n" +
 	"\x0eprice_per_seat\x18\x04 \x01(\x05R\fpricePerSeat\x121\n" +
 	"\x12current_period_end\x18\x05 \x01(\x03H\x00R\x10currentPeriodEnd\x88\x01\x01\x12/\n" +
-	"\x14cancel_at_period_end\x18\x06 \x01(\bR\x11cancelAtPeriodEndB\x15\n" +
-	"\x13_current_period_end\"B\n" +
+	"\x14cancel_at_period_end\x18\x06 \x01(\bR\x11cancelAtPeriodEnd\x12(\n" +
+	"\rbilling_email\x18\a \x01(\tH\x01R\fbillingEmail\x88\x01\x01B\x15\n" +
+	"\x13_current_period_endB\x10\n" +
+	"\x0e_billing_email\"B\n" +
 	"\x1cCreateCheckoutSessionRequest\x12\"\n" +
 	"\x04plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\"1\n" +
 	"\x1dCreateCheckoutSessionResponse\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\"\x1c\n" +
 	"\x1aCreatePortalSessionRequest\"/\n" +
 	"\x1bCreatePortalSessionResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xb3\x02\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"e\n" +
+	" PreviewSubscriptionChangeRequest\x12\"\n" +
+	"\x04plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x02 \x01(\x05R\tseatCount\"\x8b\x03\n" +
+	"!PreviewSubscriptionChangeResponse\x121\n" +
+	"\fcurrent_plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\vcurrentPlan\x12,\n" +
+	"\x12current_seat_count\x18\x02 \x01(\x05R\x10currentSeatCount\x12\"\n" +
+	"\x04plan\x18\x03 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x04 \x01(\x05R\tseatCount\x12'\n" +
+	"\x0fprorated_amount\x18\x05 \x01(\x03R\x0eproratedAmount\x12.\n" +
+	"\x13next_invoice_amount\x18\x06 \x01(\x03R\x11nextInvoiceAmount\x12&\n" +
+	"\x0fnext_invoice_at\x18\a \x01(\x03R\rnextInvoiceAt\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x12%\n" +
+	"\x0eproration_date\x18\t \x01(\x03R\rprorationDate\"\x9d\x01\n" +
+	"\x19ChangeSubscriptionRequest\x12\"\n" +
+	"\x04plan\x18\x01 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x02 \x01(\x05R\tseatCount\x12*\n" +
+	"\x0eproration_date\x18\x03 \x01(\x03H\x00R\rprorationDate\x88\x01\x01B\x11\n" +
+	"\x0f_proration_date\"a\n" +
+	"\x1aChangeSubscriptionResponse\x12C\n" +
+	"\fbilling_info\x18\x01 \x01(\v2 .mirai.v1.GetBillingInfoResponseR\vbillingInfo\"\x18\n" +
+	"\x16GetEntitlementsRequest\"\x95\x01\n" +
+	"\x17GetEntitlementsResponse\x12:\n" +
+	"\fentitlements\x18\x01 \x01(\v2\x16.mirai.v1.EntitlementsR\fentitlements\x12\x1b\n" +
+	"\tsme_count\x18\x02 \x01(\x05R\bsmeCount\x12!\n" +
+	"\fcourse_count\x18\x03 \x01(\x05R\vcourseCount2\xe2\x04\n" +
 	"\x0eBillingService\x12S\n" +
 	"\x0eGetBillingInfo\x12\x1f.mirai.v1.GetBillingInfoRequest\x1a .mirai.v1.GetBillingInfoResponse\x12h\n" +
 	"\x15CreateCheckoutSession\x12&.mirai.v1.CreateCheckoutSessionRequest\x1a'.mirai.v1.CreateCheckoutSessionResponse\x12b\n" +
-	"\x13CreatePortalSession\x12$.mirai.v1.CreatePortalSessionRequest\x1a%.mirai.v1.CreatePortalSessionResponseB\x92\x01\n" +
+	"\x13CreatePortalSession\x12$.mirai.v1.CreatePortalSessionRequest\x1a%.mirai.v1.CreatePortalSessionResponse\x12t\n" +
+	"\x19PreviewSubscriptionChange\x12*.mirai.v1.PreviewSubscriptionChangeRequest\x1a+.mirai.v1.PreviewSubscriptionChangeResponse\x12_\n" +
+	"\x12ChangeSubscription\x12#.mirai.v1.ChangeSubscriptionRequest\x1a$.mirai.v1.ChangeSubscriptionResponse\x12V\n" +
+	"\x0fGetEntitlements\x12 .mirai.v1.GetEntitlementsRequest\x1a!.mirai.v1.GetEntitlementsResponseB\x92\x01\n" +
 	"\fcom.mirai.v1B\fBillingProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -355,32 +865,54 @@ func file_mirai_v1_billing_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_billing_proto_rawDescData
 }
 
-var file_mirai_v1_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_mirai_v1_billing_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_mirai_v1_billing_proto_goTypes = []any{
-	(*GetBillingInfoRequest)(nil),         // 0: mirai.v1.GetBillingInfoRequest
-	(*GetBillingInfoResponse)(nil),        // 1: mirai.v1.GetBillingInfoResponse
-	(*CreateCheckoutSessionRequest)(nil),  // 2: mirai.v1.CreateCheckoutSessionRequest
-	(*CreateCheckoutSessionResponse)(nil), // 3: mirai.v1.CreateCheckoutSessionResponse
-	(*CreatePortalSessionRequest)(nil),    // 4: mirai.v1.CreatePortalSessionRequest
-	(*CreatePortalSessionResponse)(nil),   // 5: mirai.v1.CreatePortalSessionResponse
-	(Plan)(0),                             // 6: mirai.v1.Plan
-	(SubscriptionStatus)(0),               // 7: mirai.v1.SubscriptionStatus
+	(*Entitlements)(nil),                      // 0: mirai.v1.Entitlements
+	(*GetBillingInfoRequest)(nil),             // 1: mirai.v1.GetBillingInfoRequest
+	(*GetBillingInfoResponse)(nil),            // 2: mirai.v1.GetBillingInfoResponse
+	(*CreateCheckoutSessionRequest)(nil),      // 3: mirai.v1.CreateCheckoutSessionRequest
+	(*CreateCheckoutSessionResponse)(nil),     // 4: mirai.v1.CreateCheckoutSessionResponse
+	(*CreatePortalSessionRequest)(nil),        // 5: mirai.v1.CreatePortalSessionRequest
+	(*CreatePortalSessionResponse)(nil),       // 6: mirai.v1.CreatePortalSessionResponse
+	(*PreviewSubscriptionChangeRequest)(nil),  // 7: mirai.v1.PreviewSubscriptionChangeRequest
+	(*PreviewSubscriptionChangeResponse)(nil), // 8: mirai.v1.PreviewSubscriptionChangeResponse
+	(*ChangeSubscriptionRequest)(nil),         // 9: mirai.v1.ChangeSubscriptionRequest
+	(*ChangeSubscriptionResponse)(nil),        // 10: mirai.v1.ChangeSubscriptionResponse
+	(*GetEntitlementsRequest)(nil),            // 11: mirai.v1.GetEntitlementsRequest
+	(*GetEntitlementsResponse)(nil),           // 12: mirai.v1.GetEntitlementsResponse
+	(Plan)(0),                                 // 13: mirai.v1.Plan
+	(ExportFormat)(0),                         // 14: mirai.v1.ExportFormat
+	(SubscriptionStatus)(0),                   // 15: mirai.v1.SubscriptionStatus
 }
 var file_mirai_v1_billing_proto_depIdxs = []int32{
-	6, // 0: mirai.v1.GetBillingInfoResponse.plan:type_name -> mirai.v1.Plan
-	7, // 1: mirai.v1.GetBillingInfoResponse.status:type_name -> mirai.v1.SubscriptionStatus
-	6, // 2: mirai.v1.CreateCheckoutSessionRequest.plan:type_name -> mirai.v1.Plan
-	0, // 3: mirai.v1.BillingService.GetBillingInfo:input_type -> mirai.v1.GetBillingInfoRequest
-	2, // 4: mirai.v1.BillingService.CreateCheckoutSession:input_type -> mirai.v1.CreateCheckoutSessionRequest
-	4, // 5: mirai.v1.BillingService.CreatePortalSession:input_type -> mirai.v1.CreatePortalSessionRequest
-	1, // 6: mirai.v1.BillingService.GetBillingInfo:output_type -> mirai.v1.GetBillingInfoResponse
-	3, // 7: mirai.v1.BillingService.CreateCheckoutSession:output_type -> mirai.v1.CreateCheckoutSessionResponse
-	5, // 8: mirai.v1.BillingService.CreatePortalSession:output_type -> mirai.v1.CreatePortalSessionResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	13, // 0: mirai.v1.Entitlements.plan:type_name -> mirai.v1.Plan
+	14, // 1: mirai.v1.Entitlements.export_formats:type_name -> mirai.v1.ExportFormat
+	13, // 2: mirai.v1.GetBillingInfoResponse.plan:type_name -> mirai.v1.Plan
+	15, // 3: mirai.v1.GetBillingInfoResponse.status:type_name -> mirai.v1.SubscriptionStatus
+	13, // 4: mirai.v1.CreateCheckoutSessionRequest.plan:type_name -> mirai.v1.Plan
+	13, // 5: mirai.v1.PreviewSubscriptionChangeRequest.plan:type_name -> mirai.v1.Plan
+	13, // 6: mirai.v1.PreviewSubscriptionChangeResponse.current_plan:type_name -> mirai.v1.Plan
+	13, // 7: mirai.v1.PreviewSubscriptionChangeResponse.plan:type_name -> mirai.v1.Plan
+	13, // 8: mirai.v1.ChangeSubscriptionRequest.plan:type_name -> mirai.v1.Plan
+	2,  // 9: mirai.v1.ChangeSubscriptionResponse.billing_info:type_name -> mirai.v1.GetBillingInfoResponse
+	0,  // 10: mirai.v1.GetEntitlementsResponse.entitlements:type_name -> mirai.v1.Entitlements
+	1,  // 11: mirai.v1.BillingService.GetBillingInfo:input_type -> mirai.v1.GetBillingInfoRequest
+	3,  // 12: mirai.v1.BillingService.CreateCheckoutSession:input_type -> mirai.v1.CreateCheckoutSessionRequest
+	5,  // 13: mirai.v1.BillingService.CreatePortalSession:input_type -> mirai.v1.CreatePortalSessionRequest
+	7,  // 14: mirai.v1.BillingService.PreviewSubscriptionChange:input_type -> mirai.v1.PreviewSubscriptionChangeRequest
+	9,  // 15: mirai.v1.BillingService.ChangeSubscription:input_type -> mirai.v1.ChangeSubscriptionRequest
+	11, // 16: mirai.v1.BillingService.GetEntitlements:input_type -> mirai.v1.GetEntitlementsRequest
+	2,  // 17: mirai.v1.BillingService.GetBillingInfo:output_type -> mirai.v1.GetBillingInfoResponse
+	4,  // 18: mirai.v1.BillingService.CreateCheckoutSession:output_type -> mirai.v1.CreateCheckoutSessionResponse
+	6,  // 19: mirai.v1.BillingService.CreatePortalSession:output_type -> mirai.v1.CreatePortalSessionResponse
+	8,  // 20: mirai.v1.BillingService.PreviewSubscriptionChange:output_type -> mirai.v1.PreviewSubscriptionChangeResponse
+	10, // 21: mirai.v1.BillingService.ChangeSubscription:output_type -> mirai.v1.ChangeSubscriptionResponse
+	12, // 22: mirai.v1.BillingService.GetEntitlements:output_type -> mirai.v1.GetEntitlementsResponse
+	17, // [17:23] is the sub-list for method output_type
+	11, // [11:17] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_billing_proto_init() }
@@ -389,14 +921,16 @@ func file_mirai_v1_billing_proto_init() {
 		return
 	}
 	file_mirai_v1_common_proto_init()
-	file_mirai_v1_billing_proto_msgTypes[1].OneofWrappers = []any{}
+	file_mirai_v1_course_proto_init()
+	file_mirai_v1_billing_proto_msgTypes[2].OneofWrappers = []any{}
+	file_mirai_v1_billing_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_billing_proto_rawDesc), len(file_mirai_v1_billing_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,1666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: mirai/v1/job_admin.proto
+
+package miraiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BackgroundTaskState is the state of a task in the background job queues.
+type BackgroundTaskState int32
+
+const (
+	BackgroundTaskState_BACKGROUND_TASK_STATE_UNSPECIFIED BackgroundTaskState = 0
+	BackgroundTaskState_BACKGROUND_TASK_STATE_PENDING     BackgroundTaskState = 1 // Waiting for a worker
+	BackgroundTaskState_BACKGROUND_TASK_STATE_ACTIVE      BackgroundTaskState = 2 // Being processed
+	BackgroundTaskState_BACKGROUND_TASK_STATE_SCHEDULED   BackgroundTaskState = 3 // Enqueued to run later
+	BackgroundTaskState_BACKGROUND_TASK_STATE_RETRY       BackgroundTaskState = 4 // Failed, waiting for its next automatic retry
+	BackgroundTaskState_BACKGROUND_TASK_STATE_ARCHIVED    BackgroundTaskState = 5 // Out of retries; only runs again when replayed
+	BackgroundTaskState_BACKGROUND_TASK_STATE_COMPLETED   BackgroundTaskState = 6 // Finished and kept for retention
+)
+
+// Enum value maps for BackgroundTaskState.
+var (
+	BackgroundTaskState_name = map[int32]string{
+		0: "BACKGROUND_TASK_STATE_UNSPECIFIED",
+		1: "BACKGROUND_TASK_STATE_PENDING",
+		2: "BACKGROUND_TASK_STATE_ACTIVE",
+		3: "BACKGROUND_TASK_STATE_SCHEDULED",
+		4: "BACKGROUND_TASK_STATE_RETRY",
+		5: "BACKGROUND_TASK_STATE_ARCHIVED",
+		6: "BACKGROUND_TASK_STATE_COMPLETED",
+	}
+	BackgroundTaskState_value = map[string]int32{
+		"BACKGROUND_TASK_STATE_UNSPECIFIED": 0,
+		"BACKGROUND_TASK_STATE_PENDING":     1,
+		"BACKGROUND_TASK_STATE_ACTIVE":      2,
+		"BACKGROUND_TASK_STATE_SCHEDULED":   3,
+		"BACKGROUND_TASK_STATE_RETRY":       4,
+		"BACKGROUND_TASK_STATE_ARCHIVED":    5,
+		"BACKGROUND_TASK_STATE_COMPLETED":   6,
+	}
+)
+
+func (x BackgroundTaskState) Enum() *BackgroundTaskState {
+	p := new(BackgroundTaskState)
+	*p = x
+	return p
+}
+
+func (x BackgroundTaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackgroundTaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_job_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (BackgroundTaskState) Type() protoreflect.EnumType {
+	return &file_mirai_v1_job_admin_proto_enumTypes[0]
+}
+
+func (x BackgroundTaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackgroundTaskState.Descriptor instead.
+func (BackgroundTaskState) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{0}
+}
+
+// WebhookEventStatus is the processing status of a stored Stripe webhook event.
+type WebhookEventStatus int32
+
+const (
+	WebhookEventStatus_WEBHOOK_EVENT_STATUS_UNSPECIFIED WebhookEventStatus = 0
+	WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSING  WebhookEventStatus = 1 // A delivery is being applied
+	WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSED   WebhookEventStatus = 2 // Applied; redeliveries are skipped
+	WebhookEventStatus_WEBHOOK_EVENT_STATUS_IGNORED     WebhookEventStatus = 3 // Nothing to apply, e.g. superseded by a newer event
+	WebhookEventStatus_WEBHOOK_EVENT_STATUS_FAILED      WebhookEventStatus = 4 // Applying failed; applied again on redelivery or replay
+)
+
+// Enum value maps for WebhookEventStatus.
+var (
+	WebhookEventStatus_name = map[int32]string{
+		0: "WEBHOOK_EVENT_STATUS_UNSPECIFIED",
+		1: "WEBHOOK_EVENT_STATUS_PROCESSING",
+		2: "WEBHOOK_EVENT_STATUS_PROCESSED",
+		3: "WEBHOOK_EVENT_STATUS_IGNORED",
+		4: "WEBHOOK_EVENT_STATUS_FAILED",
+	}
+	WebhookEventStatus_value = map[string]int32{
+		"WEBHOOK_EVENT_STATUS_UNSPECIFIED": 0,
+		"WEBHOOK_EVENT_STATUS_PROCESSING":  1,
+		"WEBHOOK_EVENT_STATUS_PROCESSED":   2,
+		"WEBHOOK_EVENT_STATUS_IGNORED":     3,
+		"WEBHOOK_EVENT_STATUS_FAILED":      4,
+	}
+)
+
+func (x WebhookEventStatus) Enum() *WebhookEventStatus {
+	p := new(WebhookEventStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookEventStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookEventStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_job_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (WebhookEventStatus) Type() protoreflect.EnumType {
+	return &file_mirai_v1_job_admin_proto_enumTypes[1]
+}
+
+func (x WebhookEventStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookEventStatus.Descriptor instead.
+func (WebhookEventStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{1}
+}
+
+// BackgroundTask is a task in the background job queues.
+type BackgroundTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue string                 `protobuf:"bytes,2,opt,name=queue,proto3" json:"queue,omitempty"`
+	Type  string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // Task type, e.g. "ai:generation"
+	State BackgroundTaskState    `protobuf:"varint,4,opt,name=state,proto3,enum=mirai.v1.BackgroundTaskState" json:"state,omitempty"`
+	// JSON payload the task was enqueued with
+	Payload       string                 `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Retried       int32                  `protobuf:"varint,6,opt,name=retried,proto3" json:"retried,omitempty"`
+	MaxRetry      int32                  `protobuf:"varint,7,opt,name=max_retry,json=maxRetry,proto3" json:"max_retry,omitempty"`
+	LastError     string                 `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastFailedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_failed_at,json=lastFailedAt,proto3,oneof" json:"last_failed_at,omitempty"`
+	NextProcessAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=next_process_at,json=nextProcessAt,proto3,oneof" json:"next_process_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackgroundTask) Reset() {
+	*x = BackgroundTask{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackgroundTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackgroundTask) ProtoMessage() {}
+
+func (x *BackgroundTask) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackgroundTask.ProtoReflect.Descriptor instead.
+func (*BackgroundTask) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BackgroundTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackgroundTask) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *BackgroundTask) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BackgroundTask) GetState() BackgroundTaskState {
+	if x != nil {
+		return x.State
+	}
+	return BackgroundTaskState_BACKGROUND_TASK_STATE_UNSPECIFIED
+}
+
+func (x *BackgroundTask) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *BackgroundTask) GetRetried() int32 {
+	if x != nil {
+		return x.Retried
+	}
+	return 0
+}
+
+func (x *BackgroundTask) GetMaxRetry() int32 {
+	if x != nil {
+		return x.MaxRetry
+	}
+	return 0
+}
+
+func (x *BackgroundTask) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *BackgroundTask) GetLastFailedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailedAt
+	}
+	return nil
+}
+
+func (x *BackgroundTask) GetNextProcessAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextProcessAt
+	}
+	return nil
+}
+
+// StuckRegistration is a paid registration whose account was not provisioned.
+type StuckRegistration struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email             string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	CompanyName       string                 `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	Plan              Plan                   `protobuf:"varint,4,opt,name=plan,proto3,enum=mirai.v1.Plan" json:"plan,omitempty"`
+	SeatCount         int32                  `protobuf:"varint,5,opt,name=seat_count,json=seatCount,proto3" json:"seat_count,omitempty"`
+	Status            string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // "paid" while waiting, "failed" once provisioning failed
+	CheckoutSessionId string                 `protobuf:"bytes,7,opt,name=checkout_session_id,json=checkoutSessionId,proto3" json:"checkout_session_id,omitempty"`
+	ErrorMessage      *string                `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *StuckRegistration) Reset() {
+	*x = StuckRegistration{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StuckRegistration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StuckRegistration) ProtoMessage() {}
+
+func (x *StuckRegistration) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StuckRegistration.ProtoReflect.Descriptor instead.
+func (*StuckRegistration) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StuckRegistration) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetPlan() Plan {
+	if x != nil {
+		return x.Plan
+	}
+	return Plan_PLAN_UNSPECIFIED
+}
+
+func (x *StuckRegistration) GetSeatCount() int32 {
+	if x != nil {
+		return x.SeatCount
+	}
+	return 0
+}
+
+func (x *StuckRegistration) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetCheckoutSessionId() string {
+	if x != nil {
+		return x.CheckoutSessionId
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *StuckRegistration) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// WebhookEvent is a stored Stripe webhook event.
+type WebhookEvent struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // Stripe event ID
+	Type         string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // e.g. "customer.subscription.updated"
+	Status       WebhookEventStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=mirai.v1.WebhookEventStatus" json:"status,omitempty"`
+	Attempts     int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	ErrorMessage *string                `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"` // Failure, or why the event was ignored
+	// Full event JSON as delivered
+	Payload         string                 `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	StripeCreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=stripe_created_at,json=stripeCreatedAt,proto3" json:"stripe_created_at,omitempty"`
+	ReceivedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	ProcessedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=processed_at,json=processedAt,proto3,oneof" json:"processed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetStatus() WebhookEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookEventStatus_WEBHOOK_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *WebhookEvent) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookEvent) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetStripeCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StripeCreatedAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *WebhookEvent) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+// ListFailedTasksRequest filters failed tasks.
+type ListFailedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	State         BackgroundTaskState    `protobuf:"varint,1,opt,name=state,proto3,enum=mirai.v1.BackgroundTaskState" json:"state,omitempty"` // RETRY or ARCHIVED
+	Queue         *string                `protobuf:"bytes,2,opt,name=queue,proto3,oneof" json:"queue,omitempty"`                              // All queues when unset
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                     // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`             // Per queue, defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedTasksRequest) Reset() {
+	*x = ListFailedTasksRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedTasksRequest) ProtoMessage() {}
+
+func (x *ListFailedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListFailedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListFailedTasksRequest) GetState() BackgroundTaskState {
+	if x != nil {
+		return x.State
+	}
+	return BackgroundTaskState_BACKGROUND_TASK_STATE_UNSPECIFIED
+}
+
+func (x *ListFailedTasksRequest) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *ListFailedTasksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFailedTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListFailedTasksResponse contains the tasks.
+type ListFailedTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*BackgroundTask      `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFailedTasksResponse) Reset() {
+	*x = ListFailedTasksResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFailedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFailedTasksResponse) ProtoMessage() {}
+
+func (x *ListFailedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFailedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListFailedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFailedTasksResponse) GetTasks() []*BackgroundTask {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// GetFailedTaskRequest identifies a task.
+type GetFailedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFailedTaskRequest) Reset() {
+	*x = GetFailedTaskRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFailedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailedTaskRequest) ProtoMessage() {}
+
+func (x *GetFailedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailedTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetFailedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetFailedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *GetFailedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// GetFailedTaskResponse contains the task.
+type GetFailedTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *BackgroundTask        `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFailedTaskResponse) Reset() {
+	*x = GetFailedTaskResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFailedTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFailedTaskResponse) ProtoMessage() {}
+
+func (x *GetFailedTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFailedTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetFailedTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetFailedTaskResponse) GetTask() *BackgroundTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ReplayFailedTaskRequest identifies the task to replay.
+type ReplayFailedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayFailedTaskRequest) Reset() {
+	*x = ReplayFailedTaskRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayFailedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayFailedTaskRequest) ProtoMessage() {}
+
+func (x *ReplayFailedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayFailedTaskRequest.ProtoReflect.Descriptor instead.
+func (*ReplayFailedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReplayFailedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *ReplayFailedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ReplayFailedTaskResponse contains the replayed task.
+type ReplayFailedTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *BackgroundTask        `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayFailedTaskResponse) Reset() {
+	*x = ReplayFailedTaskResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayFailedTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayFailedTaskResponse) ProtoMessage() {}
+
+func (x *ReplayFailedTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayFailedTaskResponse.ProtoReflect.Descriptor instead.
+func (*ReplayFailedTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReplayFailedTaskResponse) GetTask() *BackgroundTask {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// DeleteFailedTaskRequest identifies the task to delete.
+type DeleteFailedTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queue         string                 `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFailedTaskRequest) Reset() {
+	*x = DeleteFailedTaskRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFailedTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFailedTaskRequest) ProtoMessage() {}
+
+func (x *DeleteFailedTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFailedTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFailedTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteFailedTaskRequest) GetQueue() string {
+	if x != nil {
+		return x.Queue
+	}
+	return ""
+}
+
+func (x *DeleteFailedTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// DeleteFailedTaskResponse is empty on success.
+type DeleteFailedTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFailedTaskResponse) Reset() {
+	*x = DeleteFailedTaskResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFailedTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFailedTaskResponse) ProtoMessage() {}
+
+func (x *DeleteFailedTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFailedTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFailedTaskResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{10}
+}
+
+// ListStuckProvisioningRequest has no parameters.
+type ListStuckProvisioningRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckProvisioningRequest) Reset() {
+	*x = ListStuckProvisioningRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckProvisioningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckProvisioningRequest) ProtoMessage() {}
+
+func (x *ListStuckProvisioningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckProvisioningRequest.ProtoReflect.Descriptor instead.
+func (*ListStuckProvisioningRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{11}
+}
+
+// ListStuckProvisioningResponse contains the registrations.
+type ListStuckProvisioningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registrations []*StuckRegistration   `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStuckProvisioningResponse) Reset() {
+	*x = ListStuckProvisioningResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStuckProvisioningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStuckProvisioningResponse) ProtoMessage() {}
+
+func (x *ListStuckProvisioningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStuckProvisioningResponse.ProtoReflect.Descriptor instead.
+func (*ListStuckProvisioningResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListStuckProvisioningResponse) GetRegistrations() []*StuckRegistration {
+	if x != nil {
+		return x.Registrations
+	}
+	return nil
+}
+
+// RetryProvisioningRequest identifies the registration to provision.
+type RetryProvisioningRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegistrationId string                 `protobuf:"bytes,1,opt,name=registration_id,json=registrationId,proto3" json:"registration_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RetryProvisioningRequest) Reset() {
+	*x = RetryProvisioningRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryProvisioningRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryProvisioningRequest) ProtoMessage() {}
+
+func (x *RetryProvisioningRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryProvisioningRequest.ProtoReflect.Descriptor instead.
+func (*RetryProvisioningRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RetryProvisioningRequest) GetRegistrationId() string {
+	if x != nil {
+		return x.RegistrationId
+	}
+	return ""
+}
+
+// RetryProvisioningResponse contains the registration.
+type RetryProvisioningResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Registration  *StuckRegistration     `protobuf:"bytes,1,opt,name=registration,proto3" json:"registration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryProvisioningResponse) Reset() {
+	*x = RetryProvisioningResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryProvisioningResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryProvisioningResponse) ProtoMessage() {}
+
+func (x *RetryProvisioningResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryProvisioningResponse.ProtoReflect.Descriptor instead.
+func (*RetryProvisioningResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RetryProvisioningResponse) GetRegistration() *StuckRegistration {
+	if x != nil {
+		return x.Registration
+	}
+	return nil
+}
+
+// ListWebhookEventsRequest filters webhook events.
+type ListWebhookEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        WebhookEventStatus     `protobuf:"varint,1,opt,name=status,proto3,enum=mirai.v1.WebhookEventStatus" json:"status,omitempty"` // All statuses when unspecified
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`                                 // All types when unset
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                      // 1-based, defaults to 1
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`              // Defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEventsRequest) Reset() {
+	*x = ListWebhookEventsRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEventsRequest) ProtoMessage() {}
+
+func (x *ListWebhookEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookEventsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListWebhookEventsRequest) GetStatus() WebhookEventStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookEventStatus_WEBHOOK_EVENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListWebhookEventsRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ListWebhookEventsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListWebhookEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListWebhookEventsResponse contains the events.
+type ListWebhookEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*WebhookEvent        `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookEventsResponse) Reset() {
+	*x = ListWebhookEventsResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookEventsResponse) ProtoMessage() {}
+
+func (x *ListWebhookEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookEventsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListWebhookEventsResponse) GetEvents() []*WebhookEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// ReplayWebhookEventRequest identifies the event to replay.
+type ReplayWebhookEventRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	EventId string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Replay an event that was already processed. Its handler runs again,
+	// e.g. a refund alert is sent a second time.
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookEventRequest) Reset() {
+	*x = ReplayWebhookEventRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookEventRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookEventRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookEventRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReplayWebhookEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *ReplayWebhookEventRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// ReplayWebhookEventResponse contains the event with the outcome of the replay.
+type ReplayWebhookEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *WebhookEvent          `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookEventResponse) Reset() {
+	*x = ReplayWebhookEventResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookEventResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookEventResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookEventResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReplayWebhookEventResponse) GetEvent() *WebhookEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+// SetEntitlementOverridesRequest replaces a company's overrides. Unset fields
+// keep the enterprise plan default.
+type SetEntitlementOverridesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId       string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	MaxSmes         *int32                 `protobuf:"varint,2,opt,name=max_smes,json=maxSmes,proto3,oneof" json:"max_smes,omitempty"`                                               // 0 for unlimited
+	MaxCourses      *int32                 `protobuf:"varint,3,opt,name=max_courses,json=maxCourses,proto3,oneof" json:"max_courses,omitempty"`                                      // 0 for unlimited
+	MonthlyAiTokens *int64                 `protobuf:"varint,4,opt,name=monthly_ai_tokens,json=monthlyAiTokens,proto3,oneof" json:"monthly_ai_tokens,omitempty"`                     // 0 for unlimited
+	ExportFormats   []ExportFormat         `protobuf:"varint,5,rep,packed,name=export_formats,json=exportFormats,proto3,enum=mirai.v1.ExportFormat" json:"export_formats,omitempty"` // Plan default when empty
+	Sso             *bool                  `protobuf:"varint,6,opt,name=sso,proto3,oneof" json:"sso,omitempty"`
+	CustomBranding  *bool                  `protobuf:"varint,7,opt,name=custom_branding,json=customBranding,proto3,oneof" json:"custom_branding,omitempty"`
+	Clear           bool                   `protobuf:"varint,8,opt,name=clear,proto3" json:"clear,omitempty"` // Remove all overrides instead
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetEntitlementOverridesRequest) Reset() {
+	*x = SetEntitlementOverridesRequest{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntitlementOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntitlementOverridesRequest) ProtoMessage() {}
+
+func (x *SetEntitlementOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntitlementOverridesRequest.ProtoReflect.Descriptor instead.
+func (*SetEntitlementOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetEntitlementOverridesRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *SetEntitlementOverridesRequest) GetMaxSmes() int32 {
+	if x != nil && x.MaxSmes != nil {
+		return *x.MaxSmes
+	}
+	return 0
+}
+
+func (x *SetEntitlementOverridesRequest) GetMaxCourses() int32 {
+	if x != nil && x.MaxCourses != nil {
+		return *x.MaxCourses
+	}
+	return 0
+}
+
+func (x *SetEntitlementOverridesRequest) GetMonthlyAiTokens() int64 {
+	if x != nil && x.MonthlyAiTokens != nil {
+		return *x.MonthlyAiTokens
+	}
+	return 0
+}
+
+func (x *SetEntitlementOverridesRequest) GetExportFormats() []ExportFormat {
+	if x != nil {
+		return x.ExportFormats
+	}
+	return nil
+}
+
+func (x *SetEntitlementOverridesRequest) GetSso() bool {
+	if x != nil && x.Sso != nil {
+		return *x.Sso
+	}
+	return false
+}
+
+func (x *SetEntitlementOverridesRequest) GetCustomBranding() bool {
+	if x != nil && x.CustomBranding != nil {
+		return *x.CustomBranding
+	}
+	return false
+}
+
+func (x *SetEntitlementOverridesRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+// SetEntitlementOverridesResponse contains the company's resulting entitlements.
+type SetEntitlementOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entitlements  *Entitlements          `protobuf:"bytes,1,opt,name=entitlements,proto3" json:"entitlements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetEntitlementOverridesResponse) Reset() {
+	*x = SetEntitlementOverridesResponse{}
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetEntitlementOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetEntitlementOverridesResponse) ProtoMessage() {}
+
+func (x *SetEntitlementOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_job_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetEntitlementOverridesResponse.ProtoReflect.Descriptor instead.
+func (*SetEntitlementOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_job_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetEntitlementOverridesResponse) GetEntitlements() *Entitlements {
+	if x != nil {
+		return x.Entitlements
+	}
+	return nil
+}
+
+var File_mirai_v1_job_admin_proto protoreflect.FileDescriptor
+
+const file_mirai_v1_job_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x18mirai/v1/job_admin.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16mirai/v1/billing.proto\x1a\x15mirai/v1/common.proto\x1a\x15mirai/v1/course.proto\"\xa6\x03\n" +
+	"\x0eBackgroundTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05queue\x18\x02 \x01(\tR\x05queue\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x123\n" +
+	"\x05state\x18\x04 \x01(\x0e2\x1d.mirai.v1.BackgroundTaskStateR\x05state\x12\x18\n" +
+	"\apayload\x18\x05 \x01(\tR\apayload\x12\x18\n" +
+	"\aretried\x18\x06 \x01(\x05R\aretried\x12\x1b\n" +
+	"\tmax_retry\x18\a \x01(\x05R\bmaxRetry\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12E\n" +
+	"\x0elast_failed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x00R\flastFailedAt\x88\x01\x01\x12G\n" +
+	"\x0fnext_process_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x01R\rnextProcessAt\x88\x01\x01B\x11\n" +
+	"\x0f_last_failed_atB\x12\n" +
+	"\x10_next_process_at\"\xde\x02\n" +
+	"\x11StuckRegistration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12!\n" +
+	"\fcompany_name\x18\x03 \x01(\tR\vcompanyName\x12\"\n" +
+	"\x04plan\x18\x04 \x01(\x0e2\x0e.mirai.v1.PlanR\x04plan\x12\x1d\n" +
+	"\n" +
+	"seat_count\x18\x05 \x01(\x05R\tseatCount\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12.\n" +
+	"\x13checkout_session_id\x18\a \x01(\tR\x11checkoutSessionId\x12(\n" +
+	"\rerror_message\x18\b \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x10\n" +
+	"\x0e_error_message\"\xb4\x03\n" +
+	"\fWebhookEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x124\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1c.mirai.v1.WebhookEventStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x04 \x01(\x05R\battempts\x12(\n" +
+	"\rerror_message\x18\x05 \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x12\x18\n" +
+	"\apayload\x18\x06 \x01(\tR\apayload\x12F\n" +
+	"\x11stripe_created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0fstripeCreatedAt\x12;\n" +
+	"\vreceived_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12B\n" +
+	"\fprocessed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x01R\vprocessedAt\x88\x01\x01B\x10\n" +
+	"\x0e_error_messageB\x0f\n" +
+	"\r_processed_at\"\xa3\x01\n" +
+	"\x16ListFailedTasksRequest\x123\n" +
+	"\x05state\x18\x01 \x01(\x0e2\x1d.mirai.v1.BackgroundTaskStateR\x05state\x12\x19\n" +
+	"\x05queue\x18\x02 \x01(\tH\x00R\x05queue\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSizeB\b\n" +
+	"\x06_queue\"I\n" +
+	"\x17ListFailedTasksResponse\x12.\n" +
+	"\x05tasks\x18\x01 \x03(\v2\x18.mirai.v1.BackgroundTaskR\x05tasks\"E\n" +
+	"\x14GetFailedTaskRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"E\n" +
+	"\x15GetFailedTaskResponse\x12,\n" +
+	"\x04task\x18\x01 \x01(\v2\x18.mirai.v1.BackgroundTaskR\x04task\"H\n" +
+	"\x17ReplayFailedTaskRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"H\n" +
+	"\x18ReplayFailedTaskResponse\x12,\n" +
+	"\x04task\x18\x01 \x01(\v2\x18.mirai.v1.BackgroundTaskR\x04task\"H\n" +
+	"\x17DeleteFailedTaskRequest\x12\x14\n" +
+	"\x05queue\x18\x01 \x01(\tR\x05queue\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\"\x1a\n" +
+	"\x18DeleteFailedTaskResponse\"\x1e\n" +
+	"\x1cListStuckProvisioningRequest\"b\n" +
+	"\x1dListStuckProvisioningResponse\x12A\n" +
+	"\rregistrations\x18\x01 \x03(\v2\x1b.mirai.v1.StuckRegistrationR\rregistrations\"C\n" +
+	"\x18RetryProvisioningRequest\x12'\n" +
+	"\x0fregistration_id\x18\x01 \x01(\tR\x0eregistrationId\"\\\n" +
+	"\x19RetryProvisioningResponse\x12?\n" +
+	"\fregistration\x18\x01 \x01(\v2\x1b.mirai.v1.StuckRegistrationR\fregistration\"\xa3\x01\n" +
+	"\x18ListWebhookEventsRequest\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.mirai.v1.WebhookEventStatusR\x06status\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x00R\x04type\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSizeB\a\n" +
+	"\x05_type\"K\n" +
+	"\x19ListWebhookEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.mirai.v1.WebhookEventR\x06events\"L\n" +
+	"\x19ReplayWebhookEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"J\n" +
+	"\x1aReplayWebhookEventResponse\x12,\n" +
+	"\x05event\x18\x01 \x01(\v2\x16.mirai.v1.WebhookEventR\x05event\"\x9f\x03\n" +
+	"\x1eSetEntitlementOverridesRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1e\n" +
+	"\bmax_smes\x18\x02 \x01(\x05H\x00R\amaxSmes\x88\x01\x01\x12$\n" +
+	"\vmax_courses\x18\x03 \x01(\x05H\x01R\n" +
+	"maxCourses\x88\x01\x01\x12/\n" +
+	"\x11monthly_ai_tokens\x18\x04 \x01(\x03H\x02R\x0fmonthlyAiTokens\x88\x01\x01\x12=\n" +
+	"\x0eexport_formats\x18\x05 \x03(\x0e2\x16.mirai.v1.ExportFormatR\rexportFormats\x12\x15\n" +
+	"\x03sso\x18\x06 \x01(\bH\x03R\x03sso\x88\x01\x01\x12,\n" +
+	"\x0fcustom_branding\x18\a \x01(\bH\x04R\x0ecustomBranding\x88\x01\x01\x12\x14\n" +
+	"\x05clear\x18\b \x01(\bR\x05clearB\v\n" +
+	"\t_max_smesB\x0e\n" +
+	"\f_max_coursesB\x14\n" +
+	"\x12_monthly_ai_tokensB\x06\n" +
+	"\x04_ssoB\x12\n" +
+	"\x10_custom_branding\"]\n" +
+	"\x1fSetEntitlementOverridesResponse\x12:\n" +
+	"\fentitlements\x18\x01 \x01(\v2\x16.mirai.v1.EntitlementsR\fentitlements*\x90\x02\n" +
+	"\x13BackgroundTaskState\x12%\n" +
+	"!BACKGROUND_TASK_STATE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dBACKGROUND_TASK_STATE_PENDING\x10\x01\x12 \n" +
+	"\x1cBACKGROUND_TASK_STATE_ACTIVE\x10\x02\x12#\n" +
+	"\x1fBACKGROUND_TASK_STATE_SCHEDULED\x10\x03\x12\x1f\n" +
+	"\x1bBACKGROUND_TASK_STATE_RETRY\x10\x04\x12\"\n" +
+	"\x1eBACKGROUND_TASK_STATE_ARCHIVED\x10\x05\x12#\n" +
+	"\x1fBACKGROUND_TASK_STATE_COMPLETED\x10\x06*\xc6\x01\n" +
+	"\x12WebhookEventStatus\x12$\n" +
+	" WEBHOOK_EVENT_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_EVENT_STATUS_PROCESSING\x10\x01\x12\"\n" +
+	"\x1eWEBHOOK_EVENT_STATUS_PROCESSED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_EVENT_STATUS_IGNORED\x10\x03\x12\x1f\n" +
+	"\x1bWEBHOOK_EVENT_STATUS_FAILED\x10\x042\xe8\x06\n" +
+	"\x0fJobAdminService\x12V\n" +
+	"\x0fListFailedTasks\x12 .mirai.v1.ListFailedTasksRequest\x1a!.mirai.v1.ListFailedTasksResponse\x12P\n" +
+	"\rGetFailedTask\x12\x1e.mirai.v1.GetFailedTaskRequest\x1a\x1f.mirai.v1.GetFailedTaskResponse\x12Y\n" +
+	"\x10ReplayFailedTask\x12!.mirai.v1.ReplayFailedTaskRequest\x1a\".mirai.v1.ReplayFailedTaskResponse\x12Y\n" +
+	"\x10DeleteFailedTask\x12!.mirai.v1.DeleteFailedTaskRequest\x1a\".mirai.v1.DeleteFailedTaskResponse\x12h\n" +
+	"\x15ListStuckProvisioning\x12&.mirai.v1.ListStuckProvisioningRequest\x1a'.mirai.v1.ListStuckProvisioningResponse\x12\\\n" +
+	"\x11RetryProvisioning\x12\".mirai.v1.RetryProvisioningRequest\x1a#.mirai.v1.RetryProvisioningResponse\x12\\\n" +
+	"\x11ListWebhookEvents\x12\".mirai.v1.ListWebhookEventsRequest\x1a#.mirai.v1.ListWebhookEventsResponse\x12_\n" +
+	"\x12ReplayWebhookEvent\x12#.mirai.v1.ReplayWebhookEventRequest\x1a$.mirai.v1.ReplayWebhookEventResponse\x12n\n" +
+	"\x17SetEntitlementOverrides\x12(.mirai.v1.SetEntitlementOverridesRequest\x1a).mirai.v1.SetEntitlementOverridesResponseB\x93\x01\n" +
+	"\fcom.mirai.v1B\rJobAdminProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
+
+var (
+	file_mirai_v1_job_admin_proto_rawDescOnce sync.Once
+	file_mirai_v1_job_admin_proto_rawDescData []byte
+)
+
+func file_mirai_v1_job_admin_proto_rawDescGZIP() []byte {
+	file_mirai_v1_job_admin_proto_rawDescOnce.Do(func() {
+		file_mirai_v1_job_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mirai_v1_job_admin_proto_rawDesc), len(file_mirai_v1_job_admin_proto_rawDesc)))
+	})
+	return file_mirai_v1_job_admin_proto_rawDescData
+}
+
+var file_mirai_v1_job_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mirai_v1_job_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_mirai_v1_job_admin_proto_goTypes = []any{
+	(BackgroundTaskState)(0),                // 0: mirai.v1.BackgroundTaskState
+	(WebhookEventStatus)(0),                 // 1: mirai.v1.WebhookEventStatus
+	(*BackgroundTask)(nil),                  // 2: mirai.v1.BackgroundTask
+	(*StuckRegistration)(nil),               // 3: mirai.v1.StuckRegistration
+	(*WebhookEvent)(nil),                    // 4: mirai.v1.WebhookEvent
+	(*ListFailedTasksRequest)(nil),          // 5: mirai.v1.ListFailedTasksRequest
+	(*ListFailedTasksResponse)(nil),         // 6: mirai.v1.ListFailedTasksResponse
+	(*GetFailedTaskRequest)(nil),            // 7: mirai.v1.GetFailedTaskRequest
+	(*GetFailedTaskResponse)(nil),           // 8: mirai.v1.GetFailedTaskResponse
+	(*ReplayFailedTaskRequest)(nil),         // 9: mirai.v1.ReplayFailedTaskRequest
+	(*ReplayFailedTaskResponse)(nil),        // 10: mirai.v1.ReplayFailedTaskResponse
+	(*DeleteFailedTaskRequest)(nil),         // 11: mirai.v1.DeleteFailedTaskRequest
+	(*DeleteFailedTaskResponse)(nil),        // 12: mirai.v1.DeleteFailedTaskResponse
+	(*ListStuckProvisioningRequest)(nil),    // 13: mirai.v1.ListStuckProvisioningRequest
+	(*ListStuckProvisioningResponse)(nil),   // 14: mirai.v1.ListStuckProvisioningResponse
+	(*RetryProvisioningRequest)(nil),        // 15: mirai.v1.RetryProvisioningRequest
+	(*RetryProvisioningResponse)(nil),       // 16: mirai.v1.RetryProvisioningResponse
+	(*ListWebhookEventsRequest)(nil),        // 17: mirai.v1.ListWebhookEventsRequest
+	(*ListWebhookEventsResponse)(nil),       // 18: mirai.v1.ListWebhookEventsResponse
+	(*ReplayWebhookEventRequest)(nil),       // 19: mirai.v1.ReplayWebhookEventRequest
+	(*ReplayWebhookEventResponse)(nil),      // 20: mirai.v1.ReplayWebhookEventResponse
+	(*SetEntitlementOverridesRequest)(nil),  // 21: mirai.v1.SetEntitlementOverridesRequest
+	(*SetEntitlementOverridesResponse)(nil), // 22: mirai.v1.SetEntitlementOverridesResponse
+	(*timestamppb.Timestamp)(nil),           // 23: google.protobuf.Timestamp
+	(Plan)(0),                               // 24: mirai.v1.Plan
+	(ExportFormat)(0),                       // 25: mirai.v1.ExportFormat
+	(*Entitlements)(nil),                    // 26: mirai.v1.Entitlements
+}
+var file_mirai_v1_job_admin_proto_depIdxs = []int32{
+	0,  // 0: mirai.v1.BackgroundTask.state:type_name -> mirai.v1.BackgroundTaskState
+	23, // 1: mirai.v1.BackgroundTask.last_failed_at:type_name -> google.protobuf.Timestamp
+	23, // 2: mirai.v1.BackgroundTask.next_process_at:type_name -> google.protobuf.Timestamp
+	24, // 3: mirai.v1.StuckRegistration.plan:type_name -> mirai.v1.Plan
+	23, // 4: mirai.v1.StuckRegistration.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: mirai.v1.WebhookEvent.status:type_name -> mirai.v1.WebhookEventStatus
+	23, // 6: mirai.v1.WebhookEvent.stripe_created_at:type_name -> google.protobuf.Timestamp
+	23, // 7: mirai.v1.WebhookEvent.received_at:type_name -> google.protobuf.Timestamp
+	23, // 8: mirai.v1.WebhookEvent.processed_at:type_name -> google.protobuf.Timestamp
+	0,  // 9: mirai.v1.ListFailedTasksRequest.state:type_name -> mirai.v1.BackgroundTaskState
+	2,  // 10: mirai.v1.ListFailedTasksResponse.tasks:type_name -> mirai.v1.BackgroundTask
+	2,  // 11: mirai.v1.GetFailedTaskResponse.task:type_name -> mirai.v1.BackgroundTask
+	2,  // 12: mirai.v1.ReplayFailedTaskResponse.task:type_name -> mirai.v1.BackgroundTask
+	3,  // 13: mirai.v1.ListStuckProvisioningResponse.registrations:type_name -> mirai.v1.StuckRegistration
+	3,  // 14: mirai.v1.RetryProvisioningResponse.registration:type_name -> mirai.v1.StuckRegistration
+	1,  // 15: mirai.v1.ListWebhookEventsRequest.status:type_name -> mirai.v1.WebhookEventStatus
+	4,  // 16: mirai.v1.ListWebhookEventsResponse.events:type_name -> mirai.v1.WebhookEvent
+	4,  // 17: mirai.v1.ReplayWebhookEventResponse.event:type_name -> mirai.v1.WebhookEvent
+	25, // 18: mirai.v1.SetEntitlementOverridesRequest.export_formats:type_name -> mirai.v1.ExportFormat
+	26, // 19: mirai.v1.SetEntitlementOverridesResponse.entitlements:type_name -> mirai.v1.Entitlements
+	5,  // 20: mirai.v1.JobAdminService.ListFailedTasks:input_type -> mirai.v1.ListFailedTasksRequest
+	7,  // 21: mirai.v1.JobAdminService.GetFailedTask:input_type -> mirai.v1.GetFailedTaskRequest
+	9,  // 22: mirai.v1.JobAdminService.ReplayFailedTask:input_type -> mirai.v1.ReplayFailedTaskRequest
+	11, // 23: mirai.v1.JobAdminService.DeleteFailedTask:input_type -> mirai.v1.DeleteFailedTaskRequest
+	13, // 24: mirai.v1.JobAdminService.ListStuckProvisioning:input_type -> mirai.v1.ListStuckProvisioningRequest
+	15, // 25: mirai.v1.JobAdminService.RetryProvisioning:input_type -> mirai.v1.RetryProvisioningRequest
+	17, // 26: mirai.v1.JobAdminService.ListWebhookEvents:input_type -> mirai.v1.ListWebhookEventsRequest
+	19, // 27: mirai.v1.JobAdminService.ReplayWebhookEvent:input_type -> mirai.v1.ReplayWebhookEventRequest
+	21, // 28: mirai.v1.JobAdminService.SetEntitlementOverrides:input_type -> mirai.v1.SetEntitlementOverridesRequest
+	6,  // 29: mirai.v1.JobAdminService.ListFailedTasks:output_type -> mirai.v1.ListFailedTasksResponse
+	8,  // 30: mirai.v1.JobAdminService.GetFailedTask:output_type -> mirai.v1.GetFailedTaskResponse
+	10, // 31: mirai.v1.JobAdminService.ReplayFailedTask:output_type -> mirai.v1.ReplayFailedTaskResponse
+	12, // 32: mirai.v1.JobAdminService.DeleteFailedTask:output_type -> mirai.v1.DeleteFailedTaskResponse
+	14, // 33: mirai.v1.JobAdminService.ListStuckProvisioning:output_type -> mirai.v1.ListStuckProvisioningResponse
+	16, // 34: mirai.v1.JobAdminService.RetryProvisioning:output_type -> mirai.v1.RetryProvisioningResponse
+	18, // 35: mirai.v1.JobAdminService.ListWebhookEvents:output_type -> mirai.v1.ListWebhookEventsResponse
+	20, // 36: mirai.v1.JobAdminService.ReplayWebhookEvent:output_type -> mirai.v1.ReplayWebhookEventResponse
+	22, // 37: mirai.v1.JobAdminService.SetEntitlementOverrides:output_type -> mirai.v1.SetEntitlementOverridesResponse
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_mirai_v1_job_admin_proto_init() }
+func file_mirai_v1_job_admin_proto_init() {
+	if File_mirai_v1_job_admin_proto != nil {
+		return
+	}
+	file_mirai_v1_billing_proto_init()
+	file_mirai_v1_common_proto_init()
+	file_mirai_v1_course_proto_init()
+	file_mirai_v1_job_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	file_mirai_v1_job_admin_proto_msgTypes[1].OneofWrappers = []any{}
+	file_mirai_v1_job_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	file_mirai_v1_job_admin_proto_msgTypes[3].OneofWrappers = []any{}
+	file_mirai_v1_job_admin_proto_msgTypes[15].OneofWrappers = []any{}
+	file_mirai_v1_job_admin_proto_msgTypes[19].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_job_admin_proto_rawDesc), len(file_mirai_v1_job_admin_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mirai_v1_job_admin_proto_goTypes,
+		DependencyIndexes: file_mirai_v1_job_admin_proto_depIdxs,
+		EnumInfos:         file_mirai_v1_job_admin_proto_enumTypes,
+		MessageInfos:      file_mirai_v1_job_admin_proto_msgTypes,
+	}.Build()
+	File_mirai_v1_job_admin_proto = out.File
+	file_mirai_v1_job_admin_proto_goTypes = nil
+	file_mirai_v1_job_admin_proto_depIdxs = nil
+}
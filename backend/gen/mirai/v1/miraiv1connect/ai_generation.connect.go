@@ -66,12 +66,24 @@ const (
 	// AIGenerationServiceCancelJobProcedure is the fully-qualified name of the AIGenerationService's
 	// CancelJob RPC.
 	AIGenerationServiceCancelJobProcedure = "/mirai.v1.AIGenerationService/CancelJob"
+	// AIGenerationServiceResumeJobProcedure is the fully-qualified name of the AIGenerationService's
+	// ResumeJob RPC.
+	AIGenerationServiceResumeJobProcedure = "/mirai.v1.AIGenerationService/ResumeJob"
+	// AIGenerationServiceWatchJobProcedure is the fully-qualified name of the AIGenerationService's
+	// WatchJob RPC.
+	AIGenerationServiceWatchJobProcedure = "/mirai.v1.AIGenerationService/WatchJob"
 	// AIGenerationServiceGetGeneratedLessonProcedure is the fully-qualified name of the
 	// AIGenerationService's GetGeneratedLesson RPC.
 	AIGenerationServiceGetGeneratedLessonProcedure = "/mirai.v1.AIGenerationService/GetGeneratedLesson"
 	// AIGenerationServiceListGeneratedLessonsProcedure is the fully-qualified name of the
 	// AIGenerationService's ListGeneratedLessons RPC.
 	AIGenerationServiceListGeneratedLessonsProcedure = "/mirai.v1.AIGenerationService/ListGeneratedLessons"
+	// AIGenerationServiceListStaleLessonsProcedure is the fully-qualified name of the
+	// AIGenerationService's ListStaleLessons RPC.
+	AIGenerationServiceListStaleLessonsProcedure = "/mirai.v1.AIGenerationService/ListStaleLessons"
+	// AIGenerationServiceRegenerateStaleLessonsProcedure is the fully-qualified name of the
+	// AIGenerationService's RegenerateStaleLessons RPC.
+	AIGenerationServiceRegenerateStaleLessonsProcedure = "/mirai.v1.AIGenerationService/RegenerateStaleLessons"
 )
 
 // AIGenerationServiceClient is a client for the mirai.v1.AIGenerationService service.
@@ -98,10 +110,20 @@ type AIGenerationServiceClient interface {
 	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
 	// CancelJob cancels a queued or processing job.
 	CancelJob(context.Context, *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error)
+	// ResumeJob continues a failed course generation job from the lessons that
+	// did not complete.
+	ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.ResumeJobResponse], error)
+	// WatchJob streams progress, finished sections and lessons, and final status
+	// for a job and its child jobs. The stream ends when the job finishes.
+	WatchJob(context.Context, *connect.Request[v1.WatchJobRequest]) (*connect.ServerStreamForClient[v1.WatchJobResponse], error)
 	// GetGeneratedLesson returns generated lesson content.
 	GetGeneratedLesson(context.Context, *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error)
 	// ListGeneratedLessons returns all generated lessons for a course.
 	ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error)
+	// ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+	ListStaleLessons(context.Context, *connect.Request[v1.ListStaleLessonsRequest]) (*connect.Response[v1.ListStaleLessonsResponse], error)
+	// RegenerateStaleLessons regenerates all stale lessons of a course.
+	RegenerateStaleLessons(context.Context, *connect.Request[v1.RegenerateStaleLessonsRequest]) (*connect.Response[v1.RegenerateStaleLessonsResponse], error)
 }
 
 // NewAIGenerationServiceClient constructs a client for the mirai.v1.AIGenerationService service. By
@@ -181,6 +203,18 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("CancelJob")),
 			connect.WithClientOptions(opts...),
 		),
+		resumeJob: connect.NewClient[v1.ResumeJobRequest, v1.ResumeJobResponse](
+			httpClient,
+			baseURL+AIGenerationServiceResumeJobProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ResumeJob")),
+			connect.WithClientOptions(opts...),
+		),
+		watchJob: connect.NewClient[v1.WatchJobRequest, v1.WatchJobResponse](
+			httpClient,
+			baseURL+AIGenerationServiceWatchJobProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("WatchJob")),
+			connect.WithClientOptions(opts...),
+		),
 		getGeneratedLesson: connect.NewClient[v1.GetGeneratedLessonRequest, v1.GetGeneratedLessonResponse](
 			httpClient,
 			baseURL+AIGenerationServiceGetGeneratedLessonProcedure,
@@ -193,24 +227,40 @@ func NewAIGenerationServiceClient(httpClient connect.HTTPClient, baseURL string,
 			connect.WithSchema(aIGenerationServiceMethods.ByName("ListGeneratedLessons")),
 			connect.WithClientOptions(opts...),
 		),
+		listStaleLessons: connect.NewClient[v1.ListStaleLessonsRequest, v1.ListStaleLessonsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceListStaleLessonsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("ListStaleLessons")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateStaleLessons: connect.NewClient[v1.RegenerateStaleLessonsRequest, v1.RegenerateStaleLessonsResponse](
+			httpClient,
+			baseURL+AIGenerationServiceRegenerateStaleLessonsProcedure,
+			connect.WithSchema(aIGenerationServiceMethods.ByName("RegenerateStaleLessons")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // aIGenerationServiceClient implements AIGenerationServiceClient.
 type aIGenerationServiceClient struct {
-	generateCourseOutline *connect.Client[v1.GenerateCourseOutlineRequest, v1.GenerateCourseOutlineResponse]
-	getCourseOutline      *connect.Client[v1.GetCourseOutlineRequest, v1.GetCourseOutlineResponse]
-	approveCourseOutline  *connect.Client[v1.ApproveCourseOutlineRequest, v1.ApproveCourseOutlineResponse]
-	rejectCourseOutline   *connect.Client[v1.RejectCourseOutlineRequest, v1.RejectCourseOutlineResponse]
-	updateCourseOutline   *connect.Client[v1.UpdateCourseOutlineRequest, v1.UpdateCourseOutlineResponse]
-	generateLessonContent *connect.Client[v1.GenerateLessonContentRequest, v1.GenerateLessonContentResponse]
-	generateAllLessons    *connect.Client[v1.GenerateAllLessonsRequest, v1.GenerateAllLessonsResponse]
-	regenerateComponent   *connect.Client[v1.RegenerateComponentRequest, v1.RegenerateComponentResponse]
-	getJob                *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
-	listJobs              *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
-	cancelJob             *connect.Client[v1.CancelJobRequest, v1.CancelJobResponse]
-	getGeneratedLesson    *connect.Client[v1.GetGeneratedLessonRequest, v1.GetGeneratedLessonResponse]
-	listGeneratedLessons  *connect.Client[v1.ListGeneratedLessonsRequest, v1.ListGeneratedLessonsResponse]
+	generateCourseOutline  *connect.Client[v1.GenerateCourseOutlineRequest, v1.GenerateCourseOutlineResponse]
+	getCourseOutline       *connect.Client[v1.GetCourseOutlineRequest, v1.GetCourseOutlineResponse]
+	approveCourseOutline   *connect.Client[v1.ApproveCourseOutlineRequest, v1.ApproveCourseOutlineResponse]
+	rejectCourseOutline    *connect.Client[v1.RejectCourseOutlineRequest, v1.RejectCourseOutlineResponse]
+	updateCourseOutline    *connect.Client[v1.UpdateCourseOutlineRequest, v1.UpdateCourseOutlineResponse]
+	generateLessonContent  *connect.Client[v1.GenerateLessonContentRequest, v1.GenerateLessonContentResponse]
+	generateAllLessons     *connect.Client[v1.GenerateAllLessonsRequest, v1.GenerateAllLessonsResponse]
+	regenerateComponent    *connect.Client[v1.RegenerateComponentRequest, v1.RegenerateComponentResponse]
+	getJob                 *connect.Client[v1.GetJobRequest, v1.GetJobResponse]
+	listJobs               *connect.Client[v1.ListJobsRequest, v1.ListJobsResponse]
+	cancelJob              *connect.Client[v1.CancelJobRequest, v1.CancelJobResponse]
+	resumeJob              *connect.Client[v1.ResumeJobRequest, v1.ResumeJobResponse]
+	watchJob               *connect.Client[v1.WatchJobRequest, v1.WatchJobResponse]
+	getGeneratedLesson     *connect.Client[v1.GetGeneratedLessonRequest, v1.GetGeneratedLessonResponse]
+	listGeneratedLessons   *connect.Client[v1.ListGeneratedLessonsRequest, v1.ListGeneratedLessonsResponse]
+	listStaleLessons       *connect.Client[v1.ListStaleLessonsRequest, v1.ListStaleLessonsResponse]
+	regenerateStaleLessons *connect.Client[v1.RegenerateStaleLessonsRequest, v1.RegenerateStaleLessonsResponse]
 }
 
 // GenerateCourseOutline calls mirai.v1.AIGenerationService.GenerateCourseOutline.
@@ -268,6 +318,16 @@ func (c *aIGenerationServiceClient) CancelJob(ctx context.Context, req *connect.
 	return c.cancelJob.CallUnary(ctx, req)
 }
 
+// ResumeJob calls mirai.v1.AIGenerationService.ResumeJob.
+func (c *aIGenerationServiceClient) ResumeJob(ctx context.Context, req *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.ResumeJobResponse], error) {
+	return c.resumeJob.CallUnary(ctx, req)
+}
+
+// WatchJob calls mirai.v1.AIGenerationService.WatchJob.
+func (c *aIGenerationServiceClient) WatchJob(ctx context.Context, req *connect.Request[v1.WatchJobRequest]) (*connect.ServerStreamForClient[v1.WatchJobResponse], error) {
+	return c.watchJob.CallServerStream(ctx, req)
+}
+
 // GetGeneratedLesson calls mirai.v1.AIGenerationService.GetGeneratedLesson.
 func (c *aIGenerationServiceClient) GetGeneratedLesson(ctx context.Context, req *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error) {
 	return c.getGeneratedLesson.CallUnary(ctx, req)
@@ -278,6 +338,16 @@ func (c *aIGenerationServiceClient) ListGeneratedLessons(ctx context.Context, re
 	return c.listGeneratedLessons.CallUnary(ctx, req)
 }
 
+// ListStaleLessons calls mirai.v1.AIGenerationService.ListStaleLessons.
+func (c *aIGenerationServiceClient) ListStaleLessons(ctx context.Context, req *connect.Request[v1.ListStaleLessonsRequest]) (*connect.Response[v1.ListStaleLessonsResponse], error) {
+	return c.listStaleLessons.CallUnary(ctx, req)
+}
+
+// RegenerateStaleLessons calls mirai.v1.AIGenerationService.RegenerateStaleLessons.
+func (c *aIGenerationServiceClient) RegenerateStaleLessons(ctx context.Context, req *connect.Request[v1.RegenerateStaleLessonsRequest]) (*connect.Response[v1.RegenerateStaleLessonsResponse], error) {
+	return c.regenerateStaleLessons.CallUnary(ctx, req)
+}
+
 // AIGenerationServiceHandler is an implementation of the mirai.v1.AIGenerationService service.
 type AIGenerationServiceHandler interface {
 	// GenerateCourseOutline starts outline generation job.
@@ -302,10 +372,20 @@ type AIGenerationServiceHandler interface {
 	ListJobs(context.Context, *connect.Request[v1.ListJobsRequest]) (*connect.Response[v1.ListJobsResponse], error)
 	// CancelJob cancels a queued or processing job.
 	CancelJob(context.Context, *connect.Request[v1.CancelJobRequest]) (*connect.Response[v1.CancelJobResponse], error)
+	// ResumeJob continues a failed course generation job from the lessons that
+	// did not complete.
+	ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.ResumeJobResponse], error)
+	// WatchJob streams progress, finished sections and lessons, and final status
+	// for a job and its child jobs. The stream ends when the job finishes.
+	WatchJob(context.Context, *connect.Request[v1.WatchJobRequest], *connect.ServerStream[v1.WatchJobResponse]) error
 	// GetGeneratedLesson returns generated lesson content.
 	GetGeneratedLesson(context.Context, *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error)
 	// ListGeneratedLessons returns all generated lessons for a course.
 	ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error)
+	// ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+	ListStaleLessons(context.Context, *connect.Request[v1.ListStaleLessonsRequest]) (*connect.Response[v1.ListStaleLessonsResponse], error)
+	// RegenerateStaleLessons regenerates all stale lessons of a course.
+	RegenerateStaleLessons(context.Context, *connect.Request[v1.RegenerateStaleLessonsRequest]) (*connect.Response[v1.RegenerateStaleLessonsResponse], error)
 }
 
 // NewAIGenerationServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -381,6 +461,18 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("CancelJob")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceResumeJobHandler := connect.NewUnaryHandler(
+		AIGenerationServiceResumeJobProcedure,
+		svc.ResumeJob,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ResumeJob")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceWatchJobHandler := connect.NewServerStreamHandler(
+		AIGenerationServiceWatchJobProcedure,
+		svc.WatchJob,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("WatchJob")),
+		connect.WithHandlerOptions(opts...),
+	)
 	aIGenerationServiceGetGeneratedLessonHandler := connect.NewUnaryHandler(
 		AIGenerationServiceGetGeneratedLessonProcedure,
 		svc.GetGeneratedLesson,
@@ -393,6 +485,18 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 		connect.WithSchema(aIGenerationServiceMethods.ByName("ListGeneratedLessons")),
 		connect.WithHandlerOptions(opts...),
 	)
+	aIGenerationServiceListStaleLessonsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceListStaleLessonsProcedure,
+		svc.ListStaleLessons,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("ListStaleLessons")),
+		connect.WithHandlerOptions(opts...),
+	)
+	aIGenerationServiceRegenerateStaleLessonsHandler := connect.NewUnaryHandler(
+		AIGenerationServiceRegenerateStaleLessonsProcedure,
+		svc.RegenerateStaleLessons,
+		connect.WithSchema(aIGenerationServiceMethods.ByName("RegenerateStaleLessons")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.AIGenerationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AIGenerationServiceGenerateCourseOutlineProcedure:
@@ -417,10 +521,18 @@ func NewAIGenerationServiceHandler(svc AIGenerationServiceHandler, opts ...conne
 			aIGenerationServiceListJobsHandler.ServeHTTP(w, r)
 		case AIGenerationServiceCancelJobProcedure:
 			aIGenerationServiceCancelJobHandler.ServeHTTP(w, r)
+		case AIGenerationServiceResumeJobProcedure:
+			aIGenerationServiceResumeJobHandler.ServeHTTP(w, r)
+		case AIGenerationServiceWatchJobProcedure:
+			aIGenerationServiceWatchJobHandler.ServeHTTP(w, r)
 		case AIGenerationServiceGetGeneratedLessonProcedure:
 			aIGenerationServiceGetGeneratedLessonHandler.ServeHTTP(w, r)
 		case AIGenerationServiceListGeneratedLessonsProcedure:
 			aIGenerationServiceListGeneratedLessonsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceListStaleLessonsProcedure:
+			aIGenerationServiceListStaleLessonsHandler.ServeHTTP(w, r)
+		case AIGenerationServiceRegenerateStaleLessonsProcedure:
+			aIGenerationServiceRegenerateStaleLessonsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -474,6 +586,14 @@ func (UnimplementedAIGenerationServiceHandler) CancelJob(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.CancelJob is not implemented"))
 }
 
+func (UnimplementedAIGenerationServiceHandler) ResumeJob(context.Context, *connect.Request[v1.ResumeJobRequest]) (*connect.Response[v1.ResumeJobResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ResumeJob is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) WatchJob(context.Context, *connect.Request[v1.WatchJobRequest], *connect.ServerStream[v1.WatchJobResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.WatchJob is not implemented"))
+}
+
 func (UnimplementedAIGenerationServiceHandler) GetGeneratedLesson(context.Context, *connect.Request[v1.GetGeneratedLessonRequest]) (*connect.Response[v1.GetGeneratedLessonResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.GetGeneratedLesson is not implemented"))
 }
@@ -481,3 +601,11 @@ func (UnimplementedAIGenerationServiceHandler) GetGeneratedLesson(context.Contex
 func (UnimplementedAIGenerationServiceHandler) ListGeneratedLessons(context.Context, *connect.Request[v1.ListGeneratedLessonsRequest]) (*connect.Response[v1.ListGeneratedLessonsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ListGeneratedLessons is not implemented"))
 }
+
+func (UnimplementedAIGenerationServiceHandler) ListStaleLessons(context.Context, *connect.Request[v1.ListStaleLessonsRequest]) (*connect.Response[v1.ListStaleLessonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.ListStaleLessons is not implemented"))
+}
+
+func (UnimplementedAIGenerationServiceHandler) RegenerateStaleLessons(context.Context, *connect.Request[v1.RegenerateStaleLessonsRequest]) (*connect.Response[v1.RegenerateStaleLessonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.AIGenerationService.RegenerateStaleLessons is not implemented"))
+}
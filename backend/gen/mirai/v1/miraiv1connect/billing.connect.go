@@ -42,6 +42,15 @@ const (
 	// BillingServiceCreatePortalSessionProcedure is the fully-qualified name of the BillingService's
 	// CreatePortalSession RPC.
 	BillingServiceCreatePortalSessionProcedure = "/mirai.v1.BillingService/CreatePortalSession"
+	// BillingServicePreviewSubscriptionChangeProcedure is the fully-qualified name of the
+	// BillingService's PreviewSubscriptionChange RPC.
+	BillingServicePreviewSubscriptionChangeProcedure = "/mirai.v1.BillingService/PreviewSubscriptionChange"
+	// BillingServiceChangeSubscriptionProcedure is the fully-qualified name of the BillingService's
+	// ChangeSubscription RPC.
+	BillingServiceChangeSubscriptionProcedure = "/mirai.v1.BillingService/ChangeSubscription"
+	// BillingServiceGetEntitlementsProcedure is the fully-qualified name of the BillingService's
+	// GetEntitlements RPC.
+	BillingServiceGetEntitlementsProcedure = "/mirai.v1.BillingService/GetEntitlements"
 )
 
 // BillingServiceClient is a client for the mirai.v1.BillingService service.
@@ -52,6 +61,12 @@ type BillingServiceClient interface {
 	CreateCheckoutSession(context.Context, *connect.Request[v1.CreateCheckoutSessionRequest]) (*connect.Response[v1.CreateCheckoutSessionResponse], error)
 	// CreatePortalSession creates a Stripe Customer Portal session.
 	CreatePortalSession(context.Context, *connect.Request[v1.CreatePortalSessionRequest]) (*connect.Response[v1.CreatePortalSessionResponse], error)
+	// PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+	PreviewSubscriptionChange(context.Context, *connect.Request[v1.PreviewSubscriptionChangeRequest]) (*connect.Response[v1.PreviewSubscriptionChangeResponse], error)
+	// ChangeSubscription changes the plan or seat count of the existing subscription.
+	ChangeSubscription(context.Context, *connect.Request[v1.ChangeSubscriptionRequest]) (*connect.Response[v1.ChangeSubscriptionResponse], error)
+	// GetEntitlements returns what the company's plan includes and how much of it is used.
+	GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error)
 }
 
 // NewBillingServiceClient constructs a client for the mirai.v1.BillingService service. By default,
@@ -83,14 +98,35 @@ func NewBillingServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(billingServiceMethods.ByName("CreatePortalSession")),
 			connect.WithClientOptions(opts...),
 		),
+		previewSubscriptionChange: connect.NewClient[v1.PreviewSubscriptionChangeRequest, v1.PreviewSubscriptionChangeResponse](
+			httpClient,
+			baseURL+BillingServicePreviewSubscriptionChangeProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("PreviewSubscriptionChange")),
+			connect.WithClientOptions(opts...),
+		),
+		changeSubscription: connect.NewClient[v1.ChangeSubscriptionRequest, v1.ChangeSubscriptionResponse](
+			httpClient,
+			baseURL+BillingServiceChangeSubscriptionProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("ChangeSubscription")),
+			connect.WithClientOptions(opts...),
+		),
+		getEntitlements: connect.NewClient[v1.GetEntitlementsRequest, v1.GetEntitlementsResponse](
+			httpClient,
+			baseURL+BillingServiceGetEntitlementsProcedure,
+			connect.WithSchema(billingServiceMethods.ByName("GetEntitlements")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // billingServiceClient implements BillingServiceClient.
 type billingServiceClient struct {
-	getBillingInfo        *connect.Client[v1.GetBillingInfoRequest, v1.GetBillingInfoResponse]
-	createCheckoutSession *connect.Client[v1.CreateCheckoutSessionRequest, v1.CreateCheckoutSessionResponse]
-	createPortalSession   *connect.Client[v1.CreatePortalSessionRequest, v1.CreatePortalSessionResponse]
+	getBillingInfo            *connect.Client[v1.GetBillingInfoRequest, v1.GetBillingInfoResponse]
+	createCheckoutSession     *connect.Client[v1.CreateCheckoutSessionRequest, v1.CreateCheckoutSessionResponse]
+	createPortalSession       *connect.Client[v1.CreatePortalSessionRequest, v1.CreatePortalSessionResponse]
+	previewSubscriptionChange *connect.Client[v1.PreviewSubscriptionChangeRequest, v1.PreviewSubscriptionChangeResponse]
+	changeSubscription        *connect.Client[v1.ChangeSubscriptionRequest, v1.ChangeSubscriptionResponse]
+	getEntitlements           *connect.Client[v1.GetEntitlementsRequest, v1.GetEntitlementsResponse]
 }
 
 // GetBillingInfo calls mirai.v1.BillingService.GetBillingInfo.
@@ -108,6 +144,21 @@ func (c *billingServiceClient) CreatePortalSession(ctx context.Context, req *con
 	return c.createPortalSession.CallUnary(ctx, req)
 }
 
+// PreviewSubscriptionChange calls mirai.v1.BillingService.PreviewSubscriptionChange.
+func (c *billingServiceClient) PreviewSubscriptionChange(ctx context.Context, req *connect.Request[v1.PreviewSubscriptionChangeRequest]) (*connect.Response[v1.PreviewSubscriptionChangeResponse], error) {
+	return c.previewSubscriptionChange.CallUnary(ctx, req)
+}
+
+// ChangeSubscription calls mirai.v1.BillingService.ChangeSubscription.
+func (c *billingServiceClient) ChangeSubscription(ctx context.Context, req *connect.Request[v1.ChangeSubscriptionRequest]) (*connect.Response[v1.ChangeSubscriptionResponse], error) {
+	return c.changeSubscription.CallUnary(ctx, req)
+}
+
+// GetEntitlements calls mirai.v1.BillingService.GetEntitlements.
+func (c *billingServiceClient) GetEntitlements(ctx context.Context, req *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error) {
+	return c.getEntitlements.CallUnary(ctx, req)
+}
+
 // BillingServiceHandler is an implementation of the mirai.v1.BillingService service.
 type BillingServiceHandler interface {
 	// GetBillingInfo returns the current billing status for the user's company.
@@ -116,6 +167,12 @@ type BillingServiceHandler interface {
 	CreateCheckoutSession(context.Context, *connect.Request[v1.CreateCheckoutSessionRequest]) (*connect.Response[v1.CreateCheckoutSessionResponse], error)
 	// CreatePortalSession creates a Stripe Customer Portal session.
 	CreatePortalSession(context.Context, *connect.Request[v1.CreatePortalSessionRequest]) (*connect.Response[v1.CreatePortalSessionResponse], error)
+	// PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+	PreviewSubscriptionChange(context.Context, *connect.Request[v1.PreviewSubscriptionChangeRequest]) (*connect.Response[v1.PreviewSubscriptionChangeResponse], error)
+	// ChangeSubscription changes the plan or seat count of the existing subscription.
+	ChangeSubscription(context.Context, *connect.Request[v1.ChangeSubscriptionRequest]) (*connect.Response[v1.ChangeSubscriptionResponse], error)
+	// GetEntitlements returns what the company's plan includes and how much of it is used.
+	GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error)
 }
 
 // NewBillingServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -143,6 +200,24 @@ func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.Handler
 		connect.WithSchema(billingServiceMethods.ByName("CreatePortalSession")),
 		connect.WithHandlerOptions(opts...),
 	)
+	billingServicePreviewSubscriptionChangeHandler := connect.NewUnaryHandler(
+		BillingServicePreviewSubscriptionChangeProcedure,
+		svc.PreviewSubscriptionChange,
+		connect.WithSchema(billingServiceMethods.ByName("PreviewSubscriptionChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceChangeSubscriptionHandler := connect.NewUnaryHandler(
+		BillingServiceChangeSubscriptionProcedure,
+		svc.ChangeSubscription,
+		connect.WithSchema(billingServiceMethods.ByName("ChangeSubscription")),
+		connect.WithHandlerOptions(opts...),
+	)
+	billingServiceGetEntitlementsHandler := connect.NewUnaryHandler(
+		BillingServiceGetEntitlementsProcedure,
+		svc.GetEntitlements,
+		connect.WithSchema(billingServiceMethods.ByName("GetEntitlements")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.BillingService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BillingServiceGetBillingInfoProcedure:
@@ -151,6 +226,12 @@ func NewBillingServiceHandler(svc BillingServiceHandler, opts ...connect.Handler
 			billingServiceCreateCheckoutSessionHandler.ServeHTTP(w, r)
 		case BillingServiceCreatePortalSessionProcedure:
 			billingServiceCreatePortalSessionHandler.ServeHTTP(w, r)
+		case BillingServicePreviewSubscriptionChangeProcedure:
+			billingServicePreviewSubscriptionChangeHandler.ServeHTTP(w, r)
+		case BillingServiceChangeSubscriptionProcedure:
+			billingServiceChangeSubscriptionHandler.ServeHTTP(w, r)
+		case BillingServiceGetEntitlementsProcedure:
+			billingServiceGetEntitlementsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -171,3 +252,15 @@ func (UnimplementedBillingServiceHandler) CreateCheckoutSession(context.Context,
 func (UnimplementedBillingServiceHandler) CreatePortalSession(context.Context, *connect.Request[v1.CreatePortalSessionRequest]) (*connect.Response[v1.CreatePortalSessionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.BillingService.CreatePortalSession is not implemented"))
 }
+
+func (UnimplementedBillingServiceHandler) PreviewSubscriptionChange(context.Context, *connect.Request[v1.PreviewSubscriptionChangeRequest]) (*connect.Response[v1.PreviewSubscriptionChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.BillingService.PreviewSubscriptionChange is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) ChangeSubscription(context.Context, *connect.Request[v1.ChangeSubscriptionRequest]) (*connect.Response[v1.ChangeSubscriptionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.BillingService.ChangeSubscription is not implemented"))
+}
+
+func (UnimplementedBillingServiceHandler) GetEntitlements(context.Context, *connect.Request[v1.GetEntitlementsRequest]) (*connect.Response[v1.GetEntitlementsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.BillingService.GetEntitlements is not implemented"))
+}
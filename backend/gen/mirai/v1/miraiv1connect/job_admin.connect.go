@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: mirai/v1/job_admin.proto
+
+package miraiv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// JobAdminServiceName is the fully-qualified name of the JobAdminService service.
+	JobAdminServiceName = "mirai.v1.JobAdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// JobAdminServiceListFailedTasksProcedure is the fully-qualified name of the JobAdminService's
+	// ListFailedTasks RPC.
+	JobAdminServiceListFailedTasksProcedure = "/mirai.v1.JobAdminService/ListFailedTasks"
+	// JobAdminServiceGetFailedTaskProcedure is the fully-qualified name of the JobAdminService's
+	// GetFailedTask RPC.
+	JobAdminServiceGetFailedTaskProcedure = "/mirai.v1.JobAdminService/GetFailedTask"
+	// JobAdminServiceReplayFailedTaskProcedure is the fully-qualified name of the JobAdminService's
+	// ReplayFailedTask RPC.
+	JobAdminServiceReplayFailedTaskProcedure = "/mirai.v1.JobAdminService/ReplayFailedTask"
+	// JobAdminServiceDeleteFailedTaskProcedure is the fully-qualified name of the JobAdminService's
+	// DeleteFailedTask RPC.
+	JobAdminServiceDeleteFailedTaskProcedure = "/mirai.v1.JobAdminService/DeleteFailedTask"
+	// JobAdminServiceListStuckProvisioningProcedure is the fully-qualified name of the
+	// JobAdminService's ListStuckProvisioning RPC.
+	JobAdminServiceListStuckProvisioningProcedure = "/mirai.v1.JobAdminService/ListStuckProvisioning"
+	// JobAdminServiceRetryProvisioningProcedure is the fully-qualified name of the JobAdminService's
+	// RetryProvisioning RPC.
+	JobAdminServiceRetryProvisioningProcedure = "/mirai.v1.JobAdminService/RetryProvisioning"
+	// JobAdminServiceListWebhookEventsProcedure is the fully-qualified name of the JobAdminService's
+	// ListWebhookEvents RPC.
+	JobAdminServiceListWebhookEventsProcedure = "/mirai.v1.JobAdminService/ListWebhookEvents"
+	// JobAdminServiceReplayWebhookEventProcedure is the fully-qualified name of the JobAdminService's
+	// ReplayWebhookEvent RPC.
+	JobAdminServiceReplayWebhookEventProcedure = "/mirai.v1.JobAdminService/ReplayWebhookEvent"
+	// JobAdminServiceSetEntitlementOverridesProcedure is the fully-qualified name of the
+	// JobAdminService's SetEntitlementOverrides RPC.
+	JobAdminServiceSetEntitlementOverridesProcedure = "/mirai.v1.JobAdminService/SetEntitlementOverrides"
+)
+
+// JobAdminServiceClient is a client for the mirai.v1.JobAdminService service.
+type JobAdminServiceClient interface {
+	// ListFailedTasks returns tasks waiting to retry or archived after exhausting their retries.
+	ListFailedTasks(context.Context, *connect.Request[v1.ListFailedTasksRequest]) (*connect.Response[v1.ListFailedTasksResponse], error)
+	// GetFailedTask returns a task with its payload and last error.
+	GetFailedTask(context.Context, *connect.Request[v1.GetFailedTaskRequest]) (*connect.Response[v1.GetFailedTaskResponse], error)
+	// ReplayFailedTask runs a failed task again now, resetting the job or registration it works on.
+	ReplayFailedTask(context.Context, *connect.Request[v1.ReplayFailedTaskRequest]) (*connect.Response[v1.ReplayFailedTaskResponse], error)
+	// DeleteFailedTask removes a failed task from its queue.
+	DeleteFailedTask(context.Context, *connect.Request[v1.DeleteFailedTaskRequest]) (*connect.Response[v1.DeleteFailedTaskResponse], error)
+	// ListStuckProvisioning returns paid registrations still waiting for their account or whose provisioning failed.
+	ListStuckProvisioning(context.Context, *connect.Request[v1.ListStuckProvisioningRequest]) (*connect.Response[v1.ListStuckProvisioningResponse], error)
+	// RetryProvisioning enqueues provisioning of a stuck or failed registration.
+	RetryProvisioning(context.Context, *connect.Request[v1.RetryProvisioningRequest]) (*connect.Response[v1.RetryProvisioningResponse], error)
+	// ListWebhookEvents returns stored Stripe webhook events, most recently received first.
+	ListWebhookEvents(context.Context, *connect.Request[v1.ListWebhookEventsRequest]) (*connect.Response[v1.ListWebhookEventsResponse], error)
+	// ReplayWebhookEvent applies a stored Stripe webhook event again.
+	ReplayWebhookEvent(context.Context, *connect.Request[v1.ReplayWebhookEventRequest]) (*connect.Response[v1.ReplayWebhookEventResponse], error)
+	// SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+	SetEntitlementOverrides(context.Context, *connect.Request[v1.SetEntitlementOverridesRequest]) (*connect.Response[v1.SetEntitlementOverridesResponse], error)
+}
+
+// NewJobAdminServiceClient constructs a client for the mirai.v1.JobAdminService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJobAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JobAdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	jobAdminServiceMethods := v1.File_mirai_v1_job_admin_proto.Services().ByName("JobAdminService").Methods()
+	return &jobAdminServiceClient{
+		listFailedTasks: connect.NewClient[v1.ListFailedTasksRequest, v1.ListFailedTasksResponse](
+			httpClient,
+			baseURL+JobAdminServiceListFailedTasksProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ListFailedTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		getFailedTask: connect.NewClient[v1.GetFailedTaskRequest, v1.GetFailedTaskResponse](
+			httpClient,
+			baseURL+JobAdminServiceGetFailedTaskProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("GetFailedTask")),
+			connect.WithClientOptions(opts...),
+		),
+		replayFailedTask: connect.NewClient[v1.ReplayFailedTaskRequest, v1.ReplayFailedTaskResponse](
+			httpClient,
+			baseURL+JobAdminServiceReplayFailedTaskProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ReplayFailedTask")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFailedTask: connect.NewClient[v1.DeleteFailedTaskRequest, v1.DeleteFailedTaskResponse](
+			httpClient,
+			baseURL+JobAdminServiceDeleteFailedTaskProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("DeleteFailedTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listStuckProvisioning: connect.NewClient[v1.ListStuckProvisioningRequest, v1.ListStuckProvisioningResponse](
+			httpClient,
+			baseURL+JobAdminServiceListStuckProvisioningProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ListStuckProvisioning")),
+			connect.WithClientOptions(opts...),
+		),
+		retryProvisioning: connect.NewClient[v1.RetryProvisioningRequest, v1.RetryProvisioningResponse](
+			httpClient,
+			baseURL+JobAdminServiceRetryProvisioningProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("RetryProvisioning")),
+			connect.WithClientOptions(opts...),
+		),
+		listWebhookEvents: connect.NewClient[v1.ListWebhookEventsRequest, v1.ListWebhookEventsResponse](
+			httpClient,
+			baseURL+JobAdminServiceListWebhookEventsProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ListWebhookEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		replayWebhookEvent: connect.NewClient[v1.ReplayWebhookEventRequest, v1.ReplayWebhookEventResponse](
+			httpClient,
+			baseURL+JobAdminServiceReplayWebhookEventProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("ReplayWebhookEvent")),
+			connect.WithClientOptions(opts...),
+		),
+		setEntitlementOverrides: connect.NewClient[v1.SetEntitlementOverridesRequest, v1.SetEntitlementOverridesResponse](
+			httpClient,
+			baseURL+JobAdminServiceSetEntitlementOverridesProcedure,
+			connect.WithSchema(jobAdminServiceMethods.ByName("SetEntitlementOverrides")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// jobAdminServiceClient implements JobAdminServiceClient.
+type jobAdminServiceClient struct {
+	listFailedTasks         *connect.Client[v1.ListFailedTasksRequest, v1.ListFailedTasksResponse]
+	getFailedTask           *connect.Client[v1.GetFailedTaskRequest, v1.GetFailedTaskResponse]
+	replayFailedTask        *connect.Client[v1.ReplayFailedTaskRequest, v1.ReplayFailedTaskResponse]
+	deleteFailedTask        *connect.Client[v1.DeleteFailedTaskRequest, v1.DeleteFailedTaskResponse]
+	listStuckProvisioning   *connect.Client[v1.ListStuckProvisioningRequest, v1.ListStuckProvisioningResponse]
+	retryProvisioning       *connect.Client[v1.RetryProvisioningRequest, v1.RetryProvisioningResponse]
+	listWebhookEvents       *connect.Client[v1.ListWebhookEventsRequest, v1.ListWebhookEventsResponse]
+	replayWebhookEvent      *connect.Client[v1.ReplayWebhookEventRequest, v1.ReplayWebhookEventResponse]
+	setEntitlementOverrides *connect.Client[v1.SetEntitlementOverridesRequest, v1.SetEntitlementOverridesResponse]
+}
+
+// ListFailedTasks calls mirai.v1.JobAdminService.ListFailedTasks.
+func (c *jobAdminServiceClient) ListFailedTasks(ctx context.Context, req *connect.Request[v1.ListFailedTasksRequest]) (*connect.Response[v1.ListFailedTasksResponse], error) {
+	return c.listFailedTasks.CallUnary(ctx, req)
+}
+
+// GetFailedTask calls mirai.v1.JobAdminService.GetFailedTask.
+func (c *jobAdminServiceClient) GetFailedTask(ctx context.Context, req *connect.Request[v1.GetFailedTaskRequest]) (*connect.Response[v1.GetFailedTaskResponse], error) {
+	return c.getFailedTask.CallUnary(ctx, req)
+}
+
+// ReplayFailedTask calls mirai.v1.JobAdminService.ReplayFailedTask.
+func (c *jobAdminServiceClient) ReplayFailedTask(ctx context.Context, req *connect.Request[v1.ReplayFailedTaskRequest]) (*connect.Response[v1.ReplayFailedTaskResponse], error) {
+	return c.replayFailedTask.CallUnary(ctx, req)
+}
+
+// DeleteFailedTask calls mirai.v1.JobAdminService.DeleteFailedTask.
+func (c *jobAdminServiceClient) DeleteFailedTask(ctx context.Context, req *connect.Request[v1.DeleteFailedTaskRequest]) (*connect.Response[v1.DeleteFailedTaskResponse], error) {
+	return c.deleteFailedTask.CallUnary(ctx, req)
+}
+
+// ListStuckProvisioning calls mirai.v1.JobAdminService.ListStuckProvisioning.
+func (c *jobAdminServiceClient) ListStuckProvisioning(ctx context.Context, req *connect.Request[v1.ListStuckProvisioningRequest]) (*connect.Response[v1.ListStuckProvisioningResponse], error) {
+	return c.listStuckProvisioning.CallUnary(ctx, req)
+}
+
+// RetryProvisioning calls mirai.v1.JobAdminService.RetryProvisioning.
+func (c *jobAdminServiceClient) RetryProvisioning(ctx context.Context, req *connect.Request[v1.RetryProvisioningRequest]) (*connect.Response[v1.RetryProvisioningResponse], error) {
+	return c.retryProvisioning.CallUnary(ctx, req)
+}
+
+// ListWebhookEvents calls mirai.v1.JobAdminService.ListWebhookEvents.
+func (c *jobAdminServiceClient) ListWebhookEvents(ctx context.Context, req *connect.Request[v1.ListWebhookEventsRequest]) (*connect.Response[v1.ListWebhookEventsResponse], error) {
+	return c.listWebhookEvents.CallUnary(ctx, req)
+}
+
+// ReplayWebhookEvent calls mirai.v1.JobAdminService.ReplayWebhookEvent.
+func (c *jobAdminServiceClient) ReplayWebhookEvent(ctx context.Context, req *connect.Request[v1.ReplayWebhookEventRequest]) (*connect.Response[v1.ReplayWebhookEventResponse], error) {
+	return c.replayWebhookEvent.CallUnary(ctx, req)
+}
+
+// SetEntitlementOverrides calls mirai.v1.JobAdminService.SetEntitlementOverrides.
+func (c *jobAdminServiceClient) SetEntitlementOverrides(ctx context.Context, req *connect.Request[v1.SetEntitlementOverridesRequest]) (*connect.Response[v1.SetEntitlementOverridesResponse], error) {
+	return c.setEntitlementOverrides.CallUnary(ctx, req)
+}
+
+// JobAdminServiceHandler is an implementation of the mirai.v1.JobAdminService service.
+type JobAdminServiceHandler interface {
+	// ListFailedTasks returns tasks waiting to retry or archived after exhausting their retries.
+	ListFailedTasks(context.Context, *connect.Request[v1.ListFailedTasksRequest]) (*connect.Response[v1.ListFailedTasksResponse], error)
+	// GetFailedTask returns a task with its payload and last error.
+	GetFailedTask(context.Context, *connect.Request[v1.GetFailedTaskRequest]) (*connect.Response[v1.GetFailedTaskResponse], error)
+	// ReplayFailedTask runs a failed task again now, resetting the job or registration it works on.
+	ReplayFailedTask(context.Context, *connect.Request[v1.ReplayFailedTaskRequest]) (*connect.Response[v1.ReplayFailedTaskResponse], error)
+	// DeleteFailedTask removes a failed task from its queue.
+	DeleteFailedTask(context.Context, *connect.Request[v1.DeleteFailedTaskRequest]) (*connect.Response[v1.DeleteFailedTaskResponse], error)
+	// ListStuckProvisioning returns paid registrations still waiting for their account or whose provisioning failed.
+	ListStuckProvisioning(context.Context, *connect.Request[v1.ListStuckProvisioningRequest]) (*connect.Response[v1.ListStuckProvisioningResponse], error)
+	// RetryProvisioning enqueues provisioning of a stuck or failed registration.
+	RetryProvisioning(context.Context, *connect.Request[v1.RetryProvisioningRequest]) (*connect.Response[v1.RetryProvisioningResponse], error)
+	// ListWebhookEvents returns stored Stripe webhook events, most recently received first.
+	ListWebhookEvents(context.Context, *connect.Request[v1.ListWebhookEventsRequest]) (*connect.Response[v1.ListWebhookEventsResponse], error)
+	// ReplayWebhookEvent applies a stored Stripe webhook event again.
+	ReplayWebhookEvent(context.Context, *connect.Request[v1.ReplayWebhookEventRequest]) (*connect.Response[v1.ReplayWebhookEventResponse], error)
+	// SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+	SetEntitlementOverrides(context.Context, *connect.Request[v1.SetEntitlementOverridesRequest]) (*connect.Response[v1.SetEntitlementOverridesResponse], error)
+}
+
+// NewJobAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJobAdminServiceHandler(svc JobAdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	jobAdminServiceMethods := v1.File_mirai_v1_job_admin_proto.Services().ByName("JobAdminService").Methods()
+	jobAdminServiceListFailedTasksHandler := connect.NewUnaryHandler(
+		JobAdminServiceListFailedTasksProcedure,
+		svc.ListFailedTasks,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ListFailedTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceGetFailedTaskHandler := connect.NewUnaryHandler(
+		JobAdminServiceGetFailedTaskProcedure,
+		svc.GetFailedTask,
+		connect.WithSchema(jobAdminServiceMethods.ByName("GetFailedTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceReplayFailedTaskHandler := connect.NewUnaryHandler(
+		JobAdminServiceReplayFailedTaskProcedure,
+		svc.ReplayFailedTask,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ReplayFailedTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceDeleteFailedTaskHandler := connect.NewUnaryHandler(
+		JobAdminServiceDeleteFailedTaskProcedure,
+		svc.DeleteFailedTask,
+		connect.WithSchema(jobAdminServiceMethods.ByName("DeleteFailedTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceListStuckProvisioningHandler := connect.NewUnaryHandler(
+		JobAdminServiceListStuckProvisioningProcedure,
+		svc.ListStuckProvisioning,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ListStuckProvisioning")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceRetryProvisioningHandler := connect.NewUnaryHandler(
+		JobAdminServiceRetryProvisioningProcedure,
+		svc.RetryProvisioning,
+		connect.WithSchema(jobAdminServiceMethods.ByName("RetryProvisioning")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceListWebhookEventsHandler := connect.NewUnaryHandler(
+		JobAdminServiceListWebhookEventsProcedure,
+		svc.ListWebhookEvents,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ListWebhookEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceReplayWebhookEventHandler := connect.NewUnaryHandler(
+		JobAdminServiceReplayWebhookEventProcedure,
+		svc.ReplayWebhookEvent,
+		connect.WithSchema(jobAdminServiceMethods.ByName("ReplayWebhookEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
+	jobAdminServiceSetEntitlementOverridesHandler := connect.NewUnaryHandler(
+		JobAdminServiceSetEntitlementOverridesProcedure,
+		svc.SetEntitlementOverrides,
+		connect.WithSchema(jobAdminServiceMethods.ByName("SetEntitlementOverrides")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/mirai.v1.JobAdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JobAdminServiceListFailedTasksProcedure:
+			jobAdminServiceListFailedTasksHandler.ServeHTTP(w, r)
+		case JobAdminServiceGetFailedTaskProcedure:
+			jobAdminServiceGetFailedTaskHandler.ServeHTTP(w, r)
+		case JobAdminServiceReplayFailedTaskProcedure:
+			jobAdminServiceReplayFailedTaskHandler.ServeHTTP(w, r)
+		case JobAdminServiceDeleteFailedTaskProcedure:
+			jobAdminServiceDeleteFailedTaskHandler.ServeHTTP(w, r)
+		case JobAdminServiceListStuckProvisioningProcedure:
+			jobAdminServiceListStuckProvisioningHandler.ServeHTTP(w, r)
+		case JobAdminServiceRetryProvisioningProcedure:
+			jobAdminServiceRetryProvisioningHandler.ServeHTTP(w, r)
+		case JobAdminServiceListWebhookEventsProcedure:
+			jobAdminServiceListWebhookEventsHandler.ServeHTTP(w, r)
+		case JobAdminServiceReplayWebhookEventProcedure:
+			jobAdminServiceReplayWebhookEventHandler.ServeHTTP(w, r)
+		case JobAdminServiceSetEntitlementOverridesProcedure:
+			jobAdminServiceSetEntitlementOverridesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJobAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJobAdminServiceHandler struct{}
+
+func (UnimplementedJobAdminServiceHandler) ListFailedTasks(context.Context, *connect.Request[v1.ListFailedTasksRequest]) (*connect.Response[v1.ListFailedTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.ListFailedTasks is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) GetFailedTask(context.Context, *connect.Request[v1.GetFailedTaskRequest]) (*connect.Response[v1.GetFailedTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.GetFailedTask is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) ReplayFailedTask(context.Context, *connect.Request[v1.ReplayFailedTaskRequest]) (*connect.Response[v1.ReplayFailedTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.ReplayFailedTask is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) DeleteFailedTask(context.Context, *connect.Request[v1.DeleteFailedTaskRequest]) (*connect.Response[v1.DeleteFailedTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.DeleteFailedTask is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) ListStuckProvisioning(context.Context, *connect.Request[v1.ListStuckProvisioningRequest]) (*connect.Response[v1.ListStuckProvisioningResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.ListStuckProvisioning is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) RetryProvisioning(context.Context, *connect.Request[v1.RetryProvisioningRequest]) (*connect.Response[v1.RetryProvisioningResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.RetryProvisioning is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) ListWebhookEvents(context.Context, *connect.Request[v1.ListWebhookEventsRequest]) (*connect.Response[v1.ListWebhookEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.ListWebhookEvents is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) ReplayWebhookEvent(context.Context, *connect.Request[v1.ReplayWebhookEventRequest]) (*connect.Response[v1.ReplayWebhookEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.ReplayWebhookEvent is not implemented"))
+}
+
+func (UnimplementedJobAdminServiceHandler) SetEntitlementOverrides(context.Context, *connect.Request[v1.SetEntitlementOverridesRequest]) (*connect.Response[v1.SetEntitlementOverridesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.JobAdminService.SetEntitlementOverrides is not implemented"))
+}
@@ -86,6 +86,18 @@ const (
 	// SMEServiceDeleteKnowledgeChunkProcedure is the fully-qualified name of the SMEService's
 	// DeleteKnowledgeChunk RPC.
 	SMEServiceDeleteKnowledgeChunkProcedure = "/mirai.v1.SMEService/DeleteKnowledgeChunk"
+	// SMEServiceListKnowledgeChunkVersionsProcedure is the fully-qualified name of the SMEService's
+	// ListKnowledgeChunkVersions RPC.
+	SMEServiceListKnowledgeChunkVersionsProcedure = "/mirai.v1.SMEService/ListKnowledgeChunkVersions"
+	// SMEServiceRestoreKnowledgeChunkVersionProcedure is the fully-qualified name of the SMEService's
+	// RestoreKnowledgeChunkVersion RPC.
+	SMEServiceRestoreKnowledgeChunkVersionProcedure = "/mirai.v1.SMEService/RestoreKnowledgeChunkVersion"
+	// SMEServiceListDeletedKnowledgeChunksProcedure is the fully-qualified name of the SMEService's
+	// ListDeletedKnowledgeChunks RPC.
+	SMEServiceListDeletedKnowledgeChunksProcedure = "/mirai.v1.SMEService/ListDeletedKnowledgeChunks"
+	// SMEServiceListOutdatedLessonsProcedure is the fully-qualified name of the SMEService's
+	// ListOutdatedLessons RPC.
+	SMEServiceListOutdatedLessonsProcedure = "/mirai.v1.SMEService/ListOutdatedLessons"
 	// SMEServiceDeleteTaskProcedure is the fully-qualified name of the SMEService's DeleteTask RPC.
 	SMEServiceDeleteTaskProcedure = "/mirai.v1.SMEService/DeleteTask"
 )
@@ -136,6 +148,14 @@ type SMEServiceClient interface {
 	UpdateKnowledgeChunk(context.Context, *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error)
 	// DeleteKnowledgeChunk removes a knowledge chunk.
 	DeleteKnowledgeChunk(context.Context, *connect.Request[v1.DeleteKnowledgeChunkRequest]) (*connect.Response[v1.DeleteKnowledgeChunkResponse], error)
+	// ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+	ListKnowledgeChunkVersions(context.Context, *connect.Request[v1.ListKnowledgeChunkVersionsRequest]) (*connect.Response[v1.ListKnowledgeChunkVersionsResponse], error)
+	// RestoreKnowledgeChunkVersion returns a chunk to an earlier version, re-creating it if deleted.
+	RestoreKnowledgeChunkVersion(context.Context, *connect.Request[v1.RestoreKnowledgeChunkVersionRequest]) (*connect.Response[v1.RestoreKnowledgeChunkVersionResponse], error)
+	// ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+	ListDeletedKnowledgeChunks(context.Context, *connect.Request[v1.ListDeletedKnowledgeChunksRequest]) (*connect.Response[v1.ListDeletedKnowledgeChunksResponse], error)
+	// ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+	ListOutdatedLessons(context.Context, *connect.Request[v1.ListOutdatedLessonsRequest]) (*connect.Response[v1.ListOutdatedLessonsResponse], error)
 	// DeleteTask permanently removes a task.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
 }
@@ -283,6 +303,30 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			connect.WithSchema(sMEServiceMethods.ByName("DeleteKnowledgeChunk")),
 			connect.WithClientOptions(opts...),
 		),
+		listKnowledgeChunkVersions: connect.NewClient[v1.ListKnowledgeChunkVersionsRequest, v1.ListKnowledgeChunkVersionsResponse](
+			httpClient,
+			baseURL+SMEServiceListKnowledgeChunkVersionsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ListKnowledgeChunkVersions")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreKnowledgeChunkVersion: connect.NewClient[v1.RestoreKnowledgeChunkVersionRequest, v1.RestoreKnowledgeChunkVersionResponse](
+			httpClient,
+			baseURL+SMEServiceRestoreKnowledgeChunkVersionProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("RestoreKnowledgeChunkVersion")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedKnowledgeChunks: connect.NewClient[v1.ListDeletedKnowledgeChunksRequest, v1.ListDeletedKnowledgeChunksResponse](
+			httpClient,
+			baseURL+SMEServiceListDeletedKnowledgeChunksProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ListDeletedKnowledgeChunks")),
+			connect.WithClientOptions(opts...),
+		),
+		listOutdatedLessons: connect.NewClient[v1.ListOutdatedLessonsRequest, v1.ListOutdatedLessonsResponse](
+			httpClient,
+			baseURL+SMEServiceListOutdatedLessonsProcedure,
+			connect.WithSchema(sMEServiceMethods.ByName("ListOutdatedLessons")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
 			httpClient,
 			baseURL+SMEServiceDeleteTaskProcedure,
@@ -294,29 +338,33 @@ func NewSMEServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 
 // sMEServiceClient implements SMEServiceClient.
 type sMEServiceClient struct {
-	createSME                *connect.Client[v1.CreateSMERequest, v1.CreateSMEResponse]
-	getSME                   *connect.Client[v1.GetSMERequest, v1.GetSMEResponse]
-	listSMEs                 *connect.Client[v1.ListSMEsRequest, v1.ListSMEsResponse]
-	updateSME                *connect.Client[v1.UpdateSMERequest, v1.UpdateSMEResponse]
-	deleteSME                *connect.Client[v1.DeleteSMERequest, v1.DeleteSMEResponse]
-	restoreSME               *connect.Client[v1.RestoreSMERequest, v1.RestoreSMEResponse]
-	createTask               *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	getTask                  *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	listTasks                *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
-	updateTask               *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	cancelTask               *connect.Client[v1.CancelTaskRequest, v1.CancelTaskResponse]
-	getUploadURL             *connect.Client[v1.GetUploadURLRequest, v1.GetUploadURLResponse]
-	submitContent            *connect.Client[v1.SubmitContentRequest, v1.SubmitContentResponse]
-	listSubmissions          *connect.Client[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse]
-	getKnowledge             *connect.Client[v1.GetKnowledgeRequest, v1.GetKnowledgeResponse]
-	searchKnowledge          *connect.Client[v1.SearchKnowledgeRequest, v1.SearchKnowledgeResponse]
-	getSubmission            *connect.Client[v1.GetSubmissionRequest, v1.GetSubmissionResponse]
-	approveSubmission        *connect.Client[v1.ApproveSubmissionRequest, v1.ApproveSubmissionResponse]
-	requestSubmissionChanges *connect.Client[v1.RequestSubmissionChangesRequest, v1.RequestSubmissionChangesResponse]
-	enhanceSubmissionContent *connect.Client[v1.EnhanceSubmissionContentRequest, v1.EnhanceSubmissionContentResponse]
-	updateKnowledgeChunk     *connect.Client[v1.UpdateKnowledgeChunkRequest, v1.UpdateKnowledgeChunkResponse]
-	deleteKnowledgeChunk     *connect.Client[v1.DeleteKnowledgeChunkRequest, v1.DeleteKnowledgeChunkResponse]
-	deleteTask               *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	createSME                    *connect.Client[v1.CreateSMERequest, v1.CreateSMEResponse]
+	getSME                       *connect.Client[v1.GetSMERequest, v1.GetSMEResponse]
+	listSMEs                     *connect.Client[v1.ListSMEsRequest, v1.ListSMEsResponse]
+	updateSME                    *connect.Client[v1.UpdateSMERequest, v1.UpdateSMEResponse]
+	deleteSME                    *connect.Client[v1.DeleteSMERequest, v1.DeleteSMEResponse]
+	restoreSME                   *connect.Client[v1.RestoreSMERequest, v1.RestoreSMEResponse]
+	createTask                   *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	getTask                      *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	listTasks                    *connect.Client[v1.ListTasksRequest, v1.ListTasksResponse]
+	updateTask                   *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	cancelTask                   *connect.Client[v1.CancelTaskRequest, v1.CancelTaskResponse]
+	getUploadURL                 *connect.Client[v1.GetUploadURLRequest, v1.GetUploadURLResponse]
+	submitContent                *connect.Client[v1.SubmitContentRequest, v1.SubmitContentResponse]
+	listSubmissions              *connect.Client[v1.ListSubmissionsRequest, v1.ListSubmissionsResponse]
+	getKnowledge                 *connect.Client[v1.GetKnowledgeRequest, v1.GetKnowledgeResponse]
+	searchKnowledge              *connect.Client[v1.SearchKnowledgeRequest, v1.SearchKnowledgeResponse]
+	getSubmission                *connect.Client[v1.GetSubmissionRequest, v1.GetSubmissionResponse]
+	approveSubmission            *connect.Client[v1.ApproveSubmissionRequest, v1.ApproveSubmissionResponse]
+	requestSubmissionChanges     *connect.Client[v1.RequestSubmissionChangesRequest, v1.RequestSubmissionChangesResponse]
+	enhanceSubmissionContent     *connect.Client[v1.EnhanceSubmissionContentRequest, v1.EnhanceSubmissionContentResponse]
+	updateKnowledgeChunk         *connect.Client[v1.UpdateKnowledgeChunkRequest, v1.UpdateKnowledgeChunkResponse]
+	deleteKnowledgeChunk         *connect.Client[v1.DeleteKnowledgeChunkRequest, v1.DeleteKnowledgeChunkResponse]
+	listKnowledgeChunkVersions   *connect.Client[v1.ListKnowledgeChunkVersionsRequest, v1.ListKnowledgeChunkVersionsResponse]
+	restoreKnowledgeChunkVersion *connect.Client[v1.RestoreKnowledgeChunkVersionRequest, v1.RestoreKnowledgeChunkVersionResponse]
+	listDeletedKnowledgeChunks   *connect.Client[v1.ListDeletedKnowledgeChunksRequest, v1.ListDeletedKnowledgeChunksResponse]
+	listOutdatedLessons          *connect.Client[v1.ListOutdatedLessonsRequest, v1.ListOutdatedLessonsResponse]
+	deleteTask                   *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
 }
 
 // CreateSME calls mirai.v1.SMEService.CreateSME.
@@ -429,6 +477,26 @@ func (c *sMEServiceClient) DeleteKnowledgeChunk(ctx context.Context, req *connec
 	return c.deleteKnowledgeChunk.CallUnary(ctx, req)
 }
 
+// ListKnowledgeChunkVersions calls mirai.v1.SMEService.ListKnowledgeChunkVersions.
+func (c *sMEServiceClient) ListKnowledgeChunkVersions(ctx context.Context, req *connect.Request[v1.ListKnowledgeChunkVersionsRequest]) (*connect.Response[v1.ListKnowledgeChunkVersionsResponse], error) {
+	return c.listKnowledgeChunkVersions.CallUnary(ctx, req)
+}
+
+// RestoreKnowledgeChunkVersion calls mirai.v1.SMEService.RestoreKnowledgeChunkVersion.
+func (c *sMEServiceClient) RestoreKnowledgeChunkVersion(ctx context.Context, req *connect.Request[v1.RestoreKnowledgeChunkVersionRequest]) (*connect.Response[v1.RestoreKnowledgeChunkVersionResponse], error) {
+	return c.restoreKnowledgeChunkVersion.CallUnary(ctx, req)
+}
+
+// ListDeletedKnowledgeChunks calls mirai.v1.SMEService.ListDeletedKnowledgeChunks.
+func (c *sMEServiceClient) ListDeletedKnowledgeChunks(ctx context.Context, req *connect.Request[v1.ListDeletedKnowledgeChunksRequest]) (*connect.Response[v1.ListDeletedKnowledgeChunksResponse], error) {
+	return c.listDeletedKnowledgeChunks.CallUnary(ctx, req)
+}
+
+// ListOutdatedLessons calls mirai.v1.SMEService.ListOutdatedLessons.
+func (c *sMEServiceClient) ListOutdatedLessons(ctx context.Context, req *connect.Request[v1.ListOutdatedLessonsRequest]) (*connect.Response[v1.ListOutdatedLessonsResponse], error) {
+	return c.listOutdatedLessons.CallUnary(ctx, req)
+}
+
 // DeleteTask calls mirai.v1.SMEService.DeleteTask.
 func (c *sMEServiceClient) DeleteTask(ctx context.Context, req *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return c.deleteTask.CallUnary(ctx, req)
@@ -480,6 +548,14 @@ type SMEServiceHandler interface {
 	UpdateKnowledgeChunk(context.Context, *connect.Request[v1.UpdateKnowledgeChunkRequest]) (*connect.Response[v1.UpdateKnowledgeChunkResponse], error)
 	// DeleteKnowledgeChunk removes a knowledge chunk.
 	DeleteKnowledgeChunk(context.Context, *connect.Request[v1.DeleteKnowledgeChunkRequest]) (*connect.Response[v1.DeleteKnowledgeChunkResponse], error)
+	// ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+	ListKnowledgeChunkVersions(context.Context, *connect.Request[v1.ListKnowledgeChunkVersionsRequest]) (*connect.Response[v1.ListKnowledgeChunkVersionsResponse], error)
+	// RestoreKnowledgeChunkVersion returns a chunk to an earlier version, re-creating it if deleted.
+	RestoreKnowledgeChunkVersion(context.Context, *connect.Request[v1.RestoreKnowledgeChunkVersionRequest]) (*connect.Response[v1.RestoreKnowledgeChunkVersionResponse], error)
+	// ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+	ListDeletedKnowledgeChunks(context.Context, *connect.Request[v1.ListDeletedKnowledgeChunksRequest]) (*connect.Response[v1.ListDeletedKnowledgeChunksResponse], error)
+	// ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+	ListOutdatedLessons(context.Context, *connect.Request[v1.ListOutdatedLessonsRequest]) (*connect.Response[v1.ListOutdatedLessonsResponse], error)
 	// DeleteTask permanently removes a task.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
 }
@@ -623,6 +699,30 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 		connect.WithSchema(sMEServiceMethods.ByName("DeleteKnowledgeChunk")),
 		connect.WithHandlerOptions(opts...),
 	)
+	sMEServiceListKnowledgeChunkVersionsHandler := connect.NewUnaryHandler(
+		SMEServiceListKnowledgeChunkVersionsProcedure,
+		svc.ListKnowledgeChunkVersions,
+		connect.WithSchema(sMEServiceMethods.ByName("ListKnowledgeChunkVersions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceRestoreKnowledgeChunkVersionHandler := connect.NewUnaryHandler(
+		SMEServiceRestoreKnowledgeChunkVersionProcedure,
+		svc.RestoreKnowledgeChunkVersion,
+		connect.WithSchema(sMEServiceMethods.ByName("RestoreKnowledgeChunkVersion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceListDeletedKnowledgeChunksHandler := connect.NewUnaryHandler(
+		SMEServiceListDeletedKnowledgeChunksProcedure,
+		svc.ListDeletedKnowledgeChunks,
+		connect.WithSchema(sMEServiceMethods.ByName("ListDeletedKnowledgeChunks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	sMEServiceListOutdatedLessonsHandler := connect.NewUnaryHandler(
+		SMEServiceListOutdatedLessonsProcedure,
+		svc.ListOutdatedLessons,
+		connect.WithSchema(sMEServiceMethods.ByName("ListOutdatedLessons")),
+		connect.WithHandlerOptions(opts...),
+	)
 	sMEServiceDeleteTaskHandler := connect.NewUnaryHandler(
 		SMEServiceDeleteTaskProcedure,
 		svc.DeleteTask,
@@ -675,6 +775,14 @@ func NewSMEServiceHandler(svc SMEServiceHandler, opts ...connect.HandlerOption)
 			sMEServiceUpdateKnowledgeChunkHandler.ServeHTTP(w, r)
 		case SMEServiceDeleteKnowledgeChunkProcedure:
 			sMEServiceDeleteKnowledgeChunkHandler.ServeHTTP(w, r)
+		case SMEServiceListKnowledgeChunkVersionsProcedure:
+			sMEServiceListKnowledgeChunkVersionsHandler.ServeHTTP(w, r)
+		case SMEServiceRestoreKnowledgeChunkVersionProcedure:
+			sMEServiceRestoreKnowledgeChunkVersionHandler.ServeHTTP(w, r)
+		case SMEServiceListDeletedKnowledgeChunksProcedure:
+			sMEServiceListDeletedKnowledgeChunksHandler.ServeHTTP(w, r)
+		case SMEServiceListOutdatedLessonsProcedure:
+			sMEServiceListOutdatedLessonsHandler.ServeHTTP(w, r)
 		case SMEServiceDeleteTaskProcedure:
 			sMEServiceDeleteTaskHandler.ServeHTTP(w, r)
 		default:
@@ -774,6 +882,22 @@ func (UnimplementedSMEServiceHandler) DeleteKnowledgeChunk(context.Context, *con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.DeleteKnowledgeChunk is not implemented"))
 }
 
+func (UnimplementedSMEServiceHandler) ListKnowledgeChunkVersions(context.Context, *connect.Request[v1.ListKnowledgeChunkVersionsRequest]) (*connect.Response[v1.ListKnowledgeChunkVersionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ListKnowledgeChunkVersions is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) RestoreKnowledgeChunkVersion(context.Context, *connect.Request[v1.RestoreKnowledgeChunkVersionRequest]) (*connect.Response[v1.RestoreKnowledgeChunkVersionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.RestoreKnowledgeChunkVersion is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) ListDeletedKnowledgeChunks(context.Context, *connect.Request[v1.ListDeletedKnowledgeChunksRequest]) (*connect.Response[v1.ListDeletedKnowledgeChunksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ListDeletedKnowledgeChunks is not implemented"))
+}
+
+func (UnimplementedSMEServiceHandler) ListOutdatedLessons(context.Context, *connect.Request[v1.ListOutdatedLessonsRequest]) (*connect.Response[v1.ListOutdatedLessonsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.ListOutdatedLessons is not implemented"))
+}
+
 func (UnimplementedSMEServiceHandler) DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.SMEService.DeleteTask is not implemented"))
 }
@@ -48,13 +48,25 @@ const (
 	// TenantSettingsServiceGetUsageStatsProcedure is the fully-qualified name of the
 	// TenantSettingsService's GetUsageStats RPC.
 	TenantSettingsServiceGetUsageStatsProcedure = "/mirai.v1.TenantSettingsService/GetUsageStats"
+	// TenantSettingsServiceGetLRSSettingsProcedure is the fully-qualified name of the
+	// TenantSettingsService's GetLRSSettings RPC.
+	TenantSettingsServiceGetLRSSettingsProcedure = "/mirai.v1.TenantSettingsService/GetLRSSettings"
+	// TenantSettingsServiceSetLRSSettingsProcedure is the fully-qualified name of the
+	// TenantSettingsService's SetLRSSettings RPC.
+	TenantSettingsServiceSetLRSSettingsProcedure = "/mirai.v1.TenantSettingsService/SetLRSSettings"
+	// TenantSettingsServiceRemoveLRSSettingsProcedure is the fully-qualified name of the
+	// TenantSettingsService's RemoveLRSSettings RPC.
+	TenantSettingsServiceRemoveLRSSettingsProcedure = "/mirai.v1.TenantSettingsService/RemoveLRSSettings"
+	// TenantSettingsServiceTestLRSConnectionProcedure is the fully-qualified name of the
+	// TenantSettingsService's TestLRSConnection RPC.
+	TenantSettingsServiceTestLRSConnectionProcedure = "/mirai.v1.TenantSettingsService/TestLRSConnection"
 )
 
 // TenantSettingsServiceClient is a client for the mirai.v1.TenantSettingsService service.
 type TenantSettingsServiceClient interface {
 	// GetAISettings returns the current AI configuration.
 	GetAISettings(context.Context, *connect.Request[v1.GetAISettingsRequest]) (*connect.Response[v1.GetAISettingsResponse], error)
-	// SetAPIKey sets the Gemini API key for the tenant.
+	// SetAPIKey validates and sets the API key for the chosen provider.
 	SetAPIKey(context.Context, *connect.Request[v1.SetAPIKeyRequest]) (*connect.Response[v1.SetAPIKeyResponse], error)
 	// RemoveAPIKey removes the configured API key.
 	RemoveAPIKey(context.Context, *connect.Request[v1.RemoveAPIKeyRequest]) (*connect.Response[v1.RemoveAPIKeyResponse], error)
@@ -62,6 +74,14 @@ type TenantSettingsServiceClient interface {
 	TestAPIKey(context.Context, *connect.Request[v1.TestAPIKeyRequest]) (*connect.Response[v1.TestAPIKeyResponse], error)
 	// GetUsageStats returns AI usage statistics.
 	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+	// GetLRSSettings returns the current LRS configuration.
+	GetLRSSettings(context.Context, *connect.Request[v1.GetLRSSettingsRequest]) (*connect.Response[v1.GetLRSSettingsResponse], error)
+	// SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+	SetLRSSettings(context.Context, *connect.Request[v1.SetLRSSettingsRequest]) (*connect.Response[v1.SetLRSSettingsResponse], error)
+	// RemoveLRSSettings removes the configured LRS.
+	RemoveLRSSettings(context.Context, *connect.Request[v1.RemoveLRSSettingsRequest]) (*connect.Response[v1.RemoveLRSSettingsResponse], error)
+	// TestLRSConnection tests if the LRS accepts the credentials.
+	TestLRSConnection(context.Context, *connect.Request[v1.TestLRSConnectionRequest]) (*connect.Response[v1.TestLRSConnectionResponse], error)
 }
 
 // NewTenantSettingsServiceClient constructs a client for the mirai.v1.TenantSettingsService
@@ -105,16 +125,44 @@ func NewTenantSettingsServiceClient(httpClient connect.HTTPClient, baseURL strin
 			connect.WithSchema(tenantSettingsServiceMethods.ByName("GetUsageStats")),
 			connect.WithClientOptions(opts...),
 		),
+		getLRSSettings: connect.NewClient[v1.GetLRSSettingsRequest, v1.GetLRSSettingsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceGetLRSSettingsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("GetLRSSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		setLRSSettings: connect.NewClient[v1.SetLRSSettingsRequest, v1.SetLRSSettingsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceSetLRSSettingsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("SetLRSSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		removeLRSSettings: connect.NewClient[v1.RemoveLRSSettingsRequest, v1.RemoveLRSSettingsResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceRemoveLRSSettingsProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("RemoveLRSSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		testLRSConnection: connect.NewClient[v1.TestLRSConnectionRequest, v1.TestLRSConnectionResponse](
+			httpClient,
+			baseURL+TenantSettingsServiceTestLRSConnectionProcedure,
+			connect.WithSchema(tenantSettingsServiceMethods.ByName("TestLRSConnection")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // tenantSettingsServiceClient implements TenantSettingsServiceClient.
 type tenantSettingsServiceClient struct {
-	getAISettings *connect.Client[v1.GetAISettingsRequest, v1.GetAISettingsResponse]
-	setAPIKey     *connect.Client[v1.SetAPIKeyRequest, v1.SetAPIKeyResponse]
-	removeAPIKey  *connect.Client[v1.RemoveAPIKeyRequest, v1.RemoveAPIKeyResponse]
-	testAPIKey    *connect.Client[v1.TestAPIKeyRequest, v1.TestAPIKeyResponse]
-	getUsageStats *connect.Client[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse]
+	getAISettings     *connect.Client[v1.GetAISettingsRequest, v1.GetAISettingsResponse]
+	setAPIKey         *connect.Client[v1.SetAPIKeyRequest, v1.SetAPIKeyResponse]
+	removeAPIKey      *connect.Client[v1.RemoveAPIKeyRequest, v1.RemoveAPIKeyResponse]
+	testAPIKey        *connect.Client[v1.TestAPIKeyRequest, v1.TestAPIKeyResponse]
+	getUsageStats     *connect.Client[v1.GetUsageStatsRequest, v1.GetUsageStatsResponse]
+	getLRSSettings    *connect.Client[v1.GetLRSSettingsRequest, v1.GetLRSSettingsResponse]
+	setLRSSettings    *connect.Client[v1.SetLRSSettingsRequest, v1.SetLRSSettingsResponse]
+	removeLRSSettings *connect.Client[v1.RemoveLRSSettingsRequest, v1.RemoveLRSSettingsResponse]
+	testLRSConnection *connect.Client[v1.TestLRSConnectionRequest, v1.TestLRSConnectionResponse]
 }
 
 // GetAISettings calls mirai.v1.TenantSettingsService.GetAISettings.
@@ -142,11 +190,31 @@ func (c *tenantSettingsServiceClient) GetUsageStats(ctx context.Context, req *co
 	return c.getUsageStats.CallUnary(ctx, req)
 }
 
+// GetLRSSettings calls mirai.v1.TenantSettingsService.GetLRSSettings.
+func (c *tenantSettingsServiceClient) GetLRSSettings(ctx context.Context, req *connect.Request[v1.GetLRSSettingsRequest]) (*connect.Response[v1.GetLRSSettingsResponse], error) {
+	return c.getLRSSettings.CallUnary(ctx, req)
+}
+
+// SetLRSSettings calls mirai.v1.TenantSettingsService.SetLRSSettings.
+func (c *tenantSettingsServiceClient) SetLRSSettings(ctx context.Context, req *connect.Request[v1.SetLRSSettingsRequest]) (*connect.Response[v1.SetLRSSettingsResponse], error) {
+	return c.setLRSSettings.CallUnary(ctx, req)
+}
+
+// RemoveLRSSettings calls mirai.v1.TenantSettingsService.RemoveLRSSettings.
+func (c *tenantSettingsServiceClient) RemoveLRSSettings(ctx context.Context, req *connect.Request[v1.RemoveLRSSettingsRequest]) (*connect.Response[v1.RemoveLRSSettingsResponse], error) {
+	return c.removeLRSSettings.CallUnary(ctx, req)
+}
+
+// TestLRSConnection calls mirai.v1.TenantSettingsService.TestLRSConnection.
+func (c *tenantSettingsServiceClient) TestLRSConnection(ctx context.Context, req *connect.Request[v1.TestLRSConnectionRequest]) (*connect.Response[v1.TestLRSConnectionResponse], error) {
+	return c.testLRSConnection.CallUnary(ctx, req)
+}
+
 // TenantSettingsServiceHandler is an implementation of the mirai.v1.TenantSettingsService service.
 type TenantSettingsServiceHandler interface {
 	// GetAISettings returns the current AI configuration.
 	GetAISettings(context.Context, *connect.Request[v1.GetAISettingsRequest]) (*connect.Response[v1.GetAISettingsResponse], error)
-	// SetAPIKey sets the Gemini API key for the tenant.
+	// SetAPIKey validates and sets the API key for the chosen provider.
 	SetAPIKey(context.Context, *connect.Request[v1.SetAPIKeyRequest]) (*connect.Response[v1.SetAPIKeyResponse], error)
 	// RemoveAPIKey removes the configured API key.
 	RemoveAPIKey(context.Context, *connect.Request[v1.RemoveAPIKeyRequest]) (*connect.Response[v1.RemoveAPIKeyResponse], error)
@@ -154,6 +222,14 @@ type TenantSettingsServiceHandler interface {
 	TestAPIKey(context.Context, *connect.Request[v1.TestAPIKeyRequest]) (*connect.Response[v1.TestAPIKeyResponse], error)
 	// GetUsageStats returns AI usage statistics.
 	GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error)
+	// GetLRSSettings returns the current LRS configuration.
+	GetLRSSettings(context.Context, *connect.Request[v1.GetLRSSettingsRequest]) (*connect.Response[v1.GetLRSSettingsResponse], error)
+	// SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+	SetLRSSettings(context.Context, *connect.Request[v1.SetLRSSettingsRequest]) (*connect.Response[v1.SetLRSSettingsResponse], error)
+	// RemoveLRSSettings removes the configured LRS.
+	RemoveLRSSettings(context.Context, *connect.Request[v1.RemoveLRSSettingsRequest]) (*connect.Response[v1.RemoveLRSSettingsResponse], error)
+	// TestLRSConnection tests if the LRS accepts the credentials.
+	TestLRSConnection(context.Context, *connect.Request[v1.TestLRSConnectionRequest]) (*connect.Response[v1.TestLRSConnectionResponse], error)
 }
 
 // NewTenantSettingsServiceHandler builds an HTTP handler from the service implementation. It
@@ -193,6 +269,30 @@ func NewTenantSettingsServiceHandler(svc TenantSettingsServiceHandler, opts ...c
 		connect.WithSchema(tenantSettingsServiceMethods.ByName("GetUsageStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	tenantSettingsServiceGetLRSSettingsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceGetLRSSettingsProcedure,
+		svc.GetLRSSettings,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("GetLRSSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceSetLRSSettingsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceSetLRSSettingsProcedure,
+		svc.SetLRSSettings,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("SetLRSSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceRemoveLRSSettingsHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceRemoveLRSSettingsProcedure,
+		svc.RemoveLRSSettings,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("RemoveLRSSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	tenantSettingsServiceTestLRSConnectionHandler := connect.NewUnaryHandler(
+		TenantSettingsServiceTestLRSConnectionProcedure,
+		svc.TestLRSConnection,
+		connect.WithSchema(tenantSettingsServiceMethods.ByName("TestLRSConnection")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/mirai.v1.TenantSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TenantSettingsServiceGetAISettingsProcedure:
@@ -205,6 +305,14 @@ func NewTenantSettingsServiceHandler(svc TenantSettingsServiceHandler, opts ...c
 			tenantSettingsServiceTestAPIKeyHandler.ServeHTTP(w, r)
 		case TenantSettingsServiceGetUsageStatsProcedure:
 			tenantSettingsServiceGetUsageStatsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceGetLRSSettingsProcedure:
+			tenantSettingsServiceGetLRSSettingsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceSetLRSSettingsProcedure:
+			tenantSettingsServiceSetLRSSettingsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceRemoveLRSSettingsProcedure:
+			tenantSettingsServiceRemoveLRSSettingsHandler.ServeHTTP(w, r)
+		case TenantSettingsServiceTestLRSConnectionProcedure:
+			tenantSettingsServiceTestLRSConnectionHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -233,3 +341,19 @@ func (UnimplementedTenantSettingsServiceHandler) TestAPIKey(context.Context, *co
 func (UnimplementedTenantSettingsServiceHandler) GetUsageStats(context.Context, *connect.Request[v1.GetUsageStatsRequest]) (*connect.Response[v1.GetUsageStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.GetUsageStats is not implemented"))
 }
+
+func (UnimplementedTenantSettingsServiceHandler) GetLRSSettings(context.Context, *connect.Request[v1.GetLRSSettingsRequest]) (*connect.Response[v1.GetLRSSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.GetLRSSettings is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) SetLRSSettings(context.Context, *connect.Request[v1.SetLRSSettingsRequest]) (*connect.Response[v1.SetLRSSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.SetLRSSettings is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) RemoveLRSSettings(context.Context, *connect.Request[v1.RemoveLRSSettingsRequest]) (*connect.Response[v1.RemoveLRSSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.RemoveLRSSettings is not implemented"))
+}
+
+func (UnimplementedTenantSettingsServiceHandler) TestLRSConnection(context.Context, *connect.Request[v1.TestLRSConnectionRequest]) (*connect.Response[v1.TestLRSConnectionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mirai.v1.TenantSettingsService.TestLRSConnection is not implemented"))
+}
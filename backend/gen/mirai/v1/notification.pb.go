@@ -27,28 +27,32 @@ type NotificationType int32
 
 const (
 	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED         NotificationType = 0
-	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED       NotificationType = 1 // SME task assigned to user
-	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON       NotificationType = 2 // Task due date approaching
-	NotificationType_NOTIFICATION_TYPE_INGESTION_COMPLETE  NotificationType = 3 // SME content ingestion finished
-	NotificationType_NOTIFICATION_TYPE_INGESTION_FAILED    NotificationType = 4 // SME content ingestion failed
-	NotificationType_NOTIFICATION_TYPE_OUTLINE_READY       NotificationType = 5 // Course outline generation complete
-	NotificationType_NOTIFICATION_TYPE_GENERATION_COMPLETE NotificationType = 6 // Course content generation complete
-	NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED   NotificationType = 7 // Course generation failed
-	NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED  NotificationType = 8 // Content awaiting approval
+	NotificationType_NOTIFICATION_TYPE_TASK_ASSIGNED       NotificationType = 1  // SME task assigned to user
+	NotificationType_NOTIFICATION_TYPE_TASK_DUE_SOON       NotificationType = 2  // Task due date approaching
+	NotificationType_NOTIFICATION_TYPE_INGESTION_COMPLETE  NotificationType = 3  // SME content ingestion finished
+	NotificationType_NOTIFICATION_TYPE_INGESTION_FAILED    NotificationType = 4  // SME content ingestion failed
+	NotificationType_NOTIFICATION_TYPE_OUTLINE_READY       NotificationType = 5  // Course outline generation complete
+	NotificationType_NOTIFICATION_TYPE_GENERATION_COMPLETE NotificationType = 6  // Course content generation complete
+	NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED   NotificationType = 7  // Course generation failed
+	NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED  NotificationType = 8  // Content awaiting approval
+	NotificationType_NOTIFICATION_TYPE_LESSONS_STALE       NotificationType = 9  // SME knowledge behind generated lessons changed
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_PAST_DUE    NotificationType = 10 // Subscription payment overdue (dunning)
 )
 
 // Enum value maps for NotificationType.
 var (
 	NotificationType_name = map[int32]string{
-		0: "NOTIFICATION_TYPE_UNSPECIFIED",
-		1: "NOTIFICATION_TYPE_TASK_ASSIGNED",
-		2: "NOTIFICATION_TYPE_TASK_DUE_SOON",
-		3: "NOTIFICATION_TYPE_INGESTION_COMPLETE",
-		4: "NOTIFICATION_TYPE_INGESTION_FAILED",
-		5: "NOTIFICATION_TYPE_OUTLINE_READY",
-		6: "NOTIFICATION_TYPE_GENERATION_COMPLETE",
-		7: "NOTIFICATION_TYPE_GENERATION_FAILED",
-		8: "NOTIFICATION_TYPE_APPROVAL_REQUESTED",
+		0:  "NOTIFICATION_TYPE_UNSPECIFIED",
+		1:  "NOTIFICATION_TYPE_TASK_ASSIGNED",
+		2:  "NOTIFICATION_TYPE_TASK_DUE_SOON",
+		3:  "NOTIFICATION_TYPE_INGESTION_COMPLETE",
+		4:  "NOTIFICATION_TYPE_INGESTION_FAILED",
+		5:  "NOTIFICATION_TYPE_OUTLINE_READY",
+		6:  "NOTIFICATION_TYPE_GENERATION_COMPLETE",
+		7:  "NOTIFICATION_TYPE_GENERATION_FAILED",
+		8:  "NOTIFICATION_TYPE_APPROVAL_REQUESTED",
+		9:  "NOTIFICATION_TYPE_LESSONS_STALE",
+		10: "NOTIFICATION_TYPE_PAYMENT_PAST_DUE",
 	}
 	NotificationType_value = map[string]int32{
 		"NOTIFICATION_TYPE_UNSPECIFIED":         0,
@@ -60,6 +64,8 @@ var (
 		"NOTIFICATION_TYPE_GENERATION_COMPLETE": 6,
 		"NOTIFICATION_TYPE_GENERATION_FAILED":   7,
 		"NOTIFICATION_TYPE_APPROVAL_REQUESTED":  8,
+		"NOTIFICATION_TYPE_LESSONS_STALE":       9,
+		"NOTIFICATION_TYPE_PAYMENT_PAST_DUE":    10,
 	}
 )
 
@@ -992,7 +998,7 @@ const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"D\n" +
 	"\x19DeleteNotificationRequest\x12'\n" +
 	"\x0fnotification_id\x18\x01 \x01(\tR\x0enotificationId\"\x1c\n" +
-	"\x1aDeleteNotificationResponse*\xf4\x02\n" +
+	"\x1aDeleteNotificationResponse*\xc1\x03\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12#\n" +
 	"\x1fNOTIFICATION_TYPE_TASK_ASSIGNED\x10\x01\x12#\n" +
@@ -1002,7 +1008,10 @@ const file_mirai_v1_notification_proto_rawDesc = "" +
 	"\x1fNOTIFICATION_TYPE_OUTLINE_READY\x10\x05\x12)\n" +
 	"%NOTIFICATION_TYPE_GENERATION_COMPLETE\x10\x06\x12'\n" +
 	"#NOTIFICATION_TYPE_GENERATION_FAILED\x10\a\x12(\n" +
-	"$NOTIFICATION_TYPE_APPROVAL_REQUESTED\x10\b*\x9e\x01\n" +
+	"$NOTIFICATION_TYPE_APPROVAL_REQUESTED\x10\b\x12#\n" +
+	"\x1fNOTIFICATION_TYPE_LESSONS_STALE\x10\t\x12&\n" +
+	"\"NOTIFICATION_TYPE_PAYMENT_PAST_DUE\x10\n" +
+	"*\x9e\x01\n" +
 	"\x14NotificationPriority\x12%\n" +
 	"!NOTIFICATION_PRIORITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19NOTIFICATION_PRIORITY_LOW\x10\x01\x12 \n" +
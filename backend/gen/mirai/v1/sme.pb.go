@@ -308,6 +308,62 @@ func (ContentType) EnumDescriptor() ([]byte, []int) {
 	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
 }
 
+// KnowledgeChangeType describes a change recorded in a knowledge chunk's history.
+type KnowledgeChangeType int32
+
+const (
+	KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED KnowledgeChangeType = 0
+	KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_CREATED     KnowledgeChangeType = 1 // Extracted from a submission
+	KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_UPDATED     KnowledgeChangeType = 2 // Edited by a reviewer
+	KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_DELETED     KnowledgeChangeType = 3 // Deleted by a reviewer or replaced by a newer submission
+	KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_RESTORED    KnowledgeChangeType = 4 // Returned to an earlier version
+)
+
+// Enum value maps for KnowledgeChangeType.
+var (
+	KnowledgeChangeType_name = map[int32]string{
+		0: "KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED",
+		1: "KNOWLEDGE_CHANGE_TYPE_CREATED",
+		2: "KNOWLEDGE_CHANGE_TYPE_UPDATED",
+		3: "KNOWLEDGE_CHANGE_TYPE_DELETED",
+		4: "KNOWLEDGE_CHANGE_TYPE_RESTORED",
+	}
+	KnowledgeChangeType_value = map[string]int32{
+		"KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED": 0,
+		"KNOWLEDGE_CHANGE_TYPE_CREATED":     1,
+		"KNOWLEDGE_CHANGE_TYPE_UPDATED":     2,
+		"KNOWLEDGE_CHANGE_TYPE_DELETED":     3,
+		"KNOWLEDGE_CHANGE_TYPE_RESTORED":    4,
+	}
+)
+
+func (x KnowledgeChangeType) Enum() *KnowledgeChangeType {
+	p := new(KnowledgeChangeType)
+	*p = x
+	return p
+}
+
+func (x KnowledgeChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KnowledgeChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mirai_v1_sme_proto_enumTypes[5].Descriptor()
+}
+
+func (KnowledgeChangeType) Type() protoreflect.EnumType {
+	return &file_mirai_v1_sme_proto_enumTypes[5]
+}
+
+func (x KnowledgeChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KnowledgeChangeType.Descriptor instead.
+func (KnowledgeChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{5}
+}
+
 // SubjectMatterExpert represents a knowledge source entity.
 type SubjectMatterExpert struct {
 	state       protoimpl.MessageState `protogen:"open.v1"`
@@ -627,13 +683,16 @@ type SMETaskSubmission struct {
 	SubmittedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
 	ProcessedAt       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=processed_at,json=processedAt,proto3,oneof" json:"processed_at,omitempty"`
 	// Review/approval fields
-	ReviewerNotes    *string                `protobuf:"bytes,14,opt,name=reviewer_notes,json=reviewerNotes,proto3,oneof" json:"reviewer_notes,omitempty"`       // Feedback from reviewer
-	ApprovedContent  *string                `protobuf:"bytes,15,opt,name=approved_content,json=approvedContent,proto3,oneof" json:"approved_content,omitempty"` // Final approved text (may differ from original)
-	IsApproved       bool                   `protobuf:"varint,16,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`                     // Whether submission is approved
-	ApprovedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
-	ApprovedByUserId *string                `protobuf:"bytes,18,opt,name=approved_by_user_id,json=approvedByUserId,proto3,oneof" json:"approved_by_user_id,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	ReviewerNotes            *string                `protobuf:"bytes,14,opt,name=reviewer_notes,json=reviewerNotes,proto3,oneof" json:"reviewer_notes,omitempty"`       // Feedback from reviewer
+	ApprovedContent          *string                `protobuf:"bytes,15,opt,name=approved_content,json=approvedContent,proto3,oneof" json:"approved_content,omitempty"` // Final approved text (may differ from original)
+	IsApproved               bool                   `protobuf:"varint,16,opt,name=is_approved,json=isApproved,proto3" json:"is_approved,omitempty"`                     // Whether submission is approved
+	ApprovedAt               *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=approved_at,json=approvedAt,proto3,oneof" json:"approved_at,omitempty"`
+	ApprovedByUserId         *string                `protobuf:"bytes,18,opt,name=approved_by_user_id,json=approvedByUserId,proto3,oneof" json:"approved_by_user_id,omitempty"`
+	SourceUrl                *string                `protobuf:"bytes,19,opt,name=source_url,json=sourceUrl,proto3,oneof" json:"source_url,omitempty"`                                                  // Fetched web page (URL submissions); file_path holds its snapshot
+	SupersededBySubmissionId *string                `protobuf:"bytes,20,opt,name=superseded_by_submission_id,json=supersededBySubmissionId,proto3,oneof" json:"superseded_by_submission_id,omitempty"` // Newer submission whose knowledge replaced this one's
+	ReplacesSubmissionId     *string                `protobuf:"bytes,21,opt,name=replaces_submission_id,json=replacesSubmissionId,proto3,oneof" json:"replaces_submission_id,omitempty"`               // Earlier submission the submitter said this one replaces
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *SMETaskSubmission) Reset() {
@@ -792,19 +851,43 @@ func (x *SMETaskSubmission) GetApprovedByUserId() string {
 	return ""
 }
 
+func (x *SMETaskSubmission) GetSourceUrl() string {
+	if x != nil && x.SourceUrl != nil {
+		return *x.SourceUrl
+	}
+	return ""
+}
+
+func (x *SMETaskSubmission) GetSupersededBySubmissionId() string {
+	if x != nil && x.SupersededBySubmissionId != nil {
+		return *x.SupersededBySubmissionId
+	}
+	return ""
+}
+
+func (x *SMETaskSubmission) GetReplacesSubmissionId() string {
+	if x != nil && x.ReplacesSubmissionId != nil {
+		return *x.ReplacesSubmissionId
+	}
+	return ""
+}
+
 // SMEKnowledgeChunk represents a unit of distilled knowledge.
 type SMEKnowledgeChunk struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SmeId          string                 `protobuf:"bytes,2,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
-	SubmissionId   *string                `protobuf:"bytes,3,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`   // Source submission (if from task)
-	Content        string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                       // The knowledge text
-	Topic          string                 `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`                                           // Categorized topic
-	Keywords       []string               `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`                                     // Extracted keywords
-	RelevanceScore float32                `protobuf:"fixed32,7,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"` // For ranking in generation
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SmeId                  string                 `protobuf:"bytes,2,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	SubmissionId           *string                `protobuf:"bytes,3,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`   // Source submission (if from task)
+	Content                string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`                                       // The knowledge text
+	Topic                  string                 `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`                                           // Categorized topic
+	Keywords               []string               `protobuf:"bytes,6,rep,name=keywords,proto3" json:"keywords,omitempty"`                                     // Extracted keywords
+	RelevanceScore         float32                `protobuf:"fixed32,7,opt,name=relevance_score,json=relevanceScore,proto3" json:"relevance_score,omitempty"` // For ranking in generation
+	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SourceHeading          *string                `protobuf:"bytes,9,opt,name=source_heading,json=sourceHeading,proto3,oneof" json:"source_heading,omitempty"`                                // Heading of the source document section (if known)
+	SourcePage             *int32                 `protobuf:"varint,10,opt,name=source_page,json=sourcePage,proto3,oneof" json:"source_page,omitempty"`                                       // Page or slide number in the source document (if known)
+	SourceTimestampSeconds *int32                 `protobuf:"varint,11,opt,name=source_timestamp_seconds,json=sourceTimestampSeconds,proto3,oneof" json:"source_timestamp_seconds,omitempty"` // Position in the source recording (if from audio or video)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *SMEKnowledgeChunk) Reset() {
@@ -893,6 +976,262 @@ func (x *SMEKnowledgeChunk) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *SMEKnowledgeChunk) GetSourceHeading() string {
+	if x != nil && x.SourceHeading != nil {
+		return *x.SourceHeading
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunk) GetSourcePage() int32 {
+	if x != nil && x.SourcePage != nil {
+		return *x.SourcePage
+	}
+	return 0
+}
+
+func (x *SMEKnowledgeChunk) GetSourceTimestampSeconds() int32 {
+	if x != nil && x.SourceTimestampSeconds != nil {
+		return *x.SourceTimestampSeconds
+	}
+	return 0
+}
+
+// SMEKnowledgeChunkVersion is a snapshot of a knowledge chunk in its history.
+type SMEKnowledgeChunkVersion struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId      string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	SmeId        string                 `protobuf:"bytes,2,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	Version      int32                  `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"` // Starts at 1 and increases with each change
+	ChangeType   KnowledgeChangeType    `protobuf:"varint,4,opt,name=change_type,json=changeType,proto3,enum=mirai.v1.KnowledgeChangeType" json:"change_type,omitempty"`
+	ChangeReason *string                `protobuf:"bytes,5,opt,name=change_reason,json=changeReason,proto3,oneof" json:"change_reason,omitempty"`   // Why the change was made (if given)
+	AuthorUserId *string                `protobuf:"bytes,6,opt,name=author_user_id,json=authorUserId,proto3,oneof" json:"author_user_id,omitempty"` // Who made the change; unset for system changes
+	SubmissionId *string                `protobuf:"bytes,7,opt,name=submission_id,json=submissionId,proto3,oneof" json:"submission_id,omitempty"`   // Submission the knowledge came from
+	// Chunk state after the change (before it, for deletions)
+	Content       string                 `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	Topic         string                 `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+	Keywords      []string               `protobuf:"bytes,10,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	Diff          string                 `protobuf:"bytes,11,opt,name=diff,proto3" json:"diff,omitempty"` // Line diff from the previous version ("- " removed, "+ " added)
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SMEKnowledgeChunkVersion) Reset() {
+	*x = SMEKnowledgeChunkVersion{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SMEKnowledgeChunkVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SMEKnowledgeChunkVersion) ProtoMessage() {}
+
+func (x *SMEKnowledgeChunkVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SMEKnowledgeChunkVersion.ProtoReflect.Descriptor instead.
+func (*SMEKnowledgeChunkVersion) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SMEKnowledgeChunkVersion) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SMEKnowledgeChunkVersion) GetChangeType() KnowledgeChangeType {
+	if x != nil {
+		return x.ChangeType
+	}
+	return KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED
+}
+
+func (x *SMEKnowledgeChunkVersion) GetChangeReason() string {
+	if x != nil && x.ChangeReason != nil {
+		return *x.ChangeReason
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetAuthorUserId() string {
+	if x != nil && x.AuthorUserId != nil {
+		return *x.AuthorUserId
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetSubmissionId() string {
+	if x != nil && x.SubmissionId != nil {
+		return *x.SubmissionId
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetKeywords() []string {
+	if x != nil {
+		return x.Keywords
+	}
+	return nil
+}
+
+func (x *SMEKnowledgeChunkVersion) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+func (x *SMEKnowledgeChunkVersion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// OutdatedLesson is a generated lesson citing knowledge that changed after it was generated.
+type OutdatedLesson struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LessonId         string                 `protobuf:"bytes,1,opt,name=lesson_id,json=lessonId,proto3" json:"lesson_id,omitempty"`
+	CourseId         string                 `protobuf:"bytes,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	GeneratedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	ChunkId          string                 `protobuf:"bytes,5,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	GeneratedVersion int32                  `protobuf:"varint,6,opt,name=generated_version,json=generatedVersion,proto3" json:"generated_version,omitempty"` // Chunk version the lesson was generated from
+	CurrentVersion   int32                  `protobuf:"varint,7,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`       // Latest chunk version
+	ChunkDeleted     bool                   `protobuf:"varint,8,opt,name=chunk_deleted,json=chunkDeleted,proto3" json:"chunk_deleted,omitempty"`             // Whether the chunk has since been deleted
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OutdatedLesson) Reset() {
+	*x = OutdatedLesson{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutdatedLesson) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutdatedLesson) ProtoMessage() {}
+
+func (x *OutdatedLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutdatedLesson.ProtoReflect.Descriptor instead.
+func (*OutdatedLesson) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OutdatedLesson) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *OutdatedLesson) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *OutdatedLesson) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *OutdatedLesson) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+func (x *OutdatedLesson) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *OutdatedLesson) GetGeneratedVersion() int32 {
+	if x != nil {
+		return x.GeneratedVersion
+	}
+	return 0
+}
+
+func (x *OutdatedLesson) GetCurrentVersion() int32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+func (x *OutdatedLesson) GetChunkDeleted() bool {
+	if x != nil {
+		return x.ChunkDeleted
+	}
+	return false
+}
+
 // CreateSMERequest contains data for a new SME.
 type CreateSMERequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -907,7 +1246,7 @@ type CreateSMERequest struct {
 
 func (x *CreateSMERequest) Reset() {
 	*x = CreateSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[4]
+	mi := &file_mirai_v1_sme_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -919,7 +1258,7 @@ func (x *CreateSMERequest) String() string {
 func (*CreateSMERequest) ProtoMessage() {}
 
 func (x *CreateSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[4]
+	mi := &file_mirai_v1_sme_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1271,7 @@ func (x *CreateSMERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSMERequest.ProtoReflect.Descriptor instead.
 func (*CreateSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateSMERequest) GetName() string {
@@ -980,7 +1319,7 @@ type CreateSMEResponse struct {
 
 func (x *CreateSMEResponse) Reset() {
 	*x = CreateSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[5]
+	mi := &file_mirai_v1_sme_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -992,7 +1331,7 @@ func (x *CreateSMEResponse) String() string {
 func (*CreateSMEResponse) ProtoMessage() {}
 
 func (x *CreateSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[5]
+	mi := &file_mirai_v1_sme_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,7 +1344,7 @@ func (x *CreateSMEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSMEResponse.ProtoReflect.Descriptor instead.
 func (*CreateSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{5}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateSMEResponse) GetSme() *SubjectMatterExpert {
@@ -1025,7 +1364,7 @@ type GetSMERequest struct {
 
 func (x *GetSMERequest) Reset() {
 	*x = GetSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[6]
+	mi := &file_mirai_v1_sme_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1037,7 +1376,7 @@ func (x *GetSMERequest) String() string {
 func (*GetSMERequest) ProtoMessage() {}
 
 func (x *GetSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[6]
+	mi := &file_mirai_v1_sme_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,7 +1389,7 @@ func (x *GetSMERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSMERequest.ProtoReflect.Descriptor instead.
 func (*GetSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{6}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetSMERequest) GetSmeId() string {
@@ -1070,7 +1409,7 @@ type GetSMEResponse struct {
 
 func (x *GetSMEResponse) Reset() {
 	*x = GetSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[7]
+	mi := &file_mirai_v1_sme_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1082,7 +1421,7 @@ func (x *GetSMEResponse) String() string {
 func (*GetSMEResponse) ProtoMessage() {}
 
 func (x *GetSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[7]
+	mi := &file_mirai_v1_sme_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1095,7 +1434,7 @@ func (x *GetSMEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSMEResponse.ProtoReflect.Descriptor instead.
 func (*GetSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{7}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetSMEResponse) GetSme() *SubjectMatterExpert {
@@ -1118,7 +1457,7 @@ type ListSMEsRequest struct {
 
 func (x *ListSMEsRequest) Reset() {
 	*x = ListSMEsRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[8]
+	mi := &file_mirai_v1_sme_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1469,7 @@ func (x *ListSMEsRequest) String() string {
 func (*ListSMEsRequest) ProtoMessage() {}
 
 func (x *ListSMEsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[8]
+	mi := &file_mirai_v1_sme_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1482,7 @@ func (x *ListSMEsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSMEsRequest.ProtoReflect.Descriptor instead.
 func (*ListSMEsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{8}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListSMEsRequest) GetScope() SMEScope {
@@ -1184,7 +1523,7 @@ type ListSMEsResponse struct {
 
 func (x *ListSMEsResponse) Reset() {
 	*x = ListSMEsResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[9]
+	mi := &file_mirai_v1_sme_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1196,7 +1535,7 @@ func (x *ListSMEsResponse) String() string {
 func (*ListSMEsResponse) ProtoMessage() {}
 
 func (x *ListSMEsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[9]
+	mi := &file_mirai_v1_sme_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1548,7 @@ func (x *ListSMEsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSMEsResponse.ProtoReflect.Descriptor instead.
 func (*ListSMEsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ListSMEsResponse) GetSmes() []*SubjectMatterExpert {
@@ -1235,7 +1574,7 @@ type UpdateSMERequest struct {
 
 func (x *UpdateSMERequest) Reset() {
 	*x = UpdateSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[10]
+	mi := &file_mirai_v1_sme_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1247,7 +1586,7 @@ func (x *UpdateSMERequest) String() string {
 func (*UpdateSMERequest) ProtoMessage() {}
 
 func (x *UpdateSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[10]
+	mi := &file_mirai_v1_sme_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +1599,7 @@ func (x *UpdateSMERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSMERequest.ProtoReflect.Descriptor instead.
 func (*UpdateSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateSMERequest) GetSmeId() string {
@@ -1322,7 +1661,7 @@ type UpdateSMEResponse struct {
 
 func (x *UpdateSMEResponse) Reset() {
 	*x = UpdateSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+	mi := &file_mirai_v1_sme_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1673,7 @@ func (x *UpdateSMEResponse) String() string {
 func (*UpdateSMEResponse) ProtoMessage() {}
 
 func (x *UpdateSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[11]
+	mi := &file_mirai_v1_sme_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1686,7 @@ func (x *UpdateSMEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSMEResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateSMEResponse) GetSme() *SubjectMatterExpert {
@@ -1367,7 +1706,7 @@ type DeleteSMERequest struct {
 
 func (x *DeleteSMERequest) Reset() {
 	*x = DeleteSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+	mi := &file_mirai_v1_sme_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +1718,7 @@ func (x *DeleteSMERequest) String() string {
 func (*DeleteSMERequest) ProtoMessage() {}
 
 func (x *DeleteSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[12]
+	mi := &file_mirai_v1_sme_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1731,7 @@ func (x *DeleteSMERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSMERequest.ProtoReflect.Descriptor instead.
 func (*DeleteSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{12}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteSMERequest) GetSmeId() string {
@@ -1411,7 +1750,7 @@ type DeleteSMEResponse struct {
 
 func (x *DeleteSMEResponse) Reset() {
 	*x = DeleteSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+	mi := &file_mirai_v1_sme_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1423,7 +1762,7 @@ func (x *DeleteSMEResponse) String() string {
 func (*DeleteSMEResponse) ProtoMessage() {}
 
 func (x *DeleteSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[13]
+	mi := &file_mirai_v1_sme_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1436,7 +1775,7 @@ func (x *DeleteSMEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSMEResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{13}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{15}
 }
 
 // RestoreSMERequest contains the SME ID to restore.
@@ -1449,7 +1788,7 @@ type RestoreSMERequest struct {
 
 func (x *RestoreSMERequest) Reset() {
 	*x = RestoreSMERequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+	mi := &file_mirai_v1_sme_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1461,7 +1800,7 @@ func (x *RestoreSMERequest) String() string {
 func (*RestoreSMERequest) ProtoMessage() {}
 
 func (x *RestoreSMERequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[14]
+	mi := &file_mirai_v1_sme_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1474,7 +1813,7 @@ func (x *RestoreSMERequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreSMERequest.ProtoReflect.Descriptor instead.
 func (*RestoreSMERequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{14}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RestoreSMERequest) GetSmeId() string {
@@ -1494,7 +1833,7 @@ type RestoreSMEResponse struct {
 
 func (x *RestoreSMEResponse) Reset() {
 	*x = RestoreSMEResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+	mi := &file_mirai_v1_sme_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1506,7 +1845,7 @@ func (x *RestoreSMEResponse) String() string {
 func (*RestoreSMEResponse) ProtoMessage() {}
 
 func (x *RestoreSMEResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[15]
+	mi := &file_mirai_v1_sme_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1519,7 +1858,7 @@ func (x *RestoreSMEResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestoreSMEResponse.ProtoReflect.Descriptor instead.
 func (*RestoreSMEResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{15}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *RestoreSMEResponse) GetSme() *SubjectMatterExpert {
@@ -1545,7 +1884,7 @@ type CreateTaskRequest struct {
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+	mi := &file_mirai_v1_sme_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1557,7 +1896,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[16]
+	mi := &file_mirai_v1_sme_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1570,7 +1909,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{16}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *CreateTaskRequest) GetSmeId() string {
@@ -1632,7 +1971,7 @@ type CreateTaskResponse struct {
 
 func (x *CreateTaskResponse) Reset() {
 	*x = CreateTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+	mi := &file_mirai_v1_sme_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1644,7 +1983,7 @@ func (x *CreateTaskResponse) String() string {
 func (*CreateTaskResponse) ProtoMessage() {}
 
 func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[17]
+	mi := &file_mirai_v1_sme_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,7 +1996,7 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
 func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{17}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *CreateTaskResponse) GetTask() *SMETask {
@@ -1677,7 +2016,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+	mi := &file_mirai_v1_sme_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1689,7 +2028,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[18]
+	mi := &file_mirai_v1_sme_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1702,7 +2041,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{18}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetTaskRequest) GetTaskId() string {
@@ -1722,7 +2061,7 @@ type GetTaskResponse struct {
 
 func (x *GetTaskResponse) Reset() {
 	*x = GetTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+	mi := &file_mirai_v1_sme_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1734,7 +2073,7 @@ func (x *GetTaskResponse) String() string {
 func (*GetTaskResponse) ProtoMessage() {}
 
 func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[19]
+	mi := &file_mirai_v1_sme_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1747,7 +2086,7 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
 func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{19}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetTaskResponse) GetTask() *SMETask {
@@ -1769,7 +2108,7 @@ type ListTasksRequest struct {
 
 func (x *ListTasksRequest) Reset() {
 	*x = ListTasksRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+	mi := &file_mirai_v1_sme_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1781,7 +2120,7 @@ func (x *ListTasksRequest) String() string {
 func (*ListTasksRequest) ProtoMessage() {}
 
 func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[20]
+	mi := &file_mirai_v1_sme_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,7 +2133,7 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
 func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{20}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListTasksRequest) GetSmeId() string {
@@ -1828,7 +2167,7 @@ type ListTasksResponse struct {
 
 func (x *ListTasksResponse) Reset() {
 	*x = ListTasksResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+	mi := &file_mirai_v1_sme_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1840,7 +2179,7 @@ func (x *ListTasksResponse) String() string {
 func (*ListTasksResponse) ProtoMessage() {}
 
 func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[21]
+	mi := &file_mirai_v1_sme_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,7 +2192,7 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
 func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{21}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListTasksResponse) GetTasks() []*SMETask {
@@ -1877,7 +2216,7 @@ type UpdateTaskRequest struct {
 
 func (x *UpdateTaskRequest) Reset() {
 	*x = UpdateTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+	mi := &file_mirai_v1_sme_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1889,7 +2228,7 @@ func (x *UpdateTaskRequest) String() string {
 func (*UpdateTaskRequest) ProtoMessage() {}
 
 func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[22]
+	mi := &file_mirai_v1_sme_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1902,7 +2241,7 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{22}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *UpdateTaskRequest) GetTaskId() string {
@@ -1950,7 +2289,7 @@ type UpdateTaskResponse struct {
 
 func (x *UpdateTaskResponse) Reset() {
 	*x = UpdateTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+	mi := &file_mirai_v1_sme_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1962,7 +2301,7 @@ func (x *UpdateTaskResponse) String() string {
 func (*UpdateTaskResponse) ProtoMessage() {}
 
 func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[23]
+	mi := &file_mirai_v1_sme_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1975,7 +2314,7 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
 func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{23}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *UpdateTaskResponse) GetTask() *SMETask {
@@ -1995,7 +2334,7 @@ type CancelTaskRequest struct {
 
 func (x *CancelTaskRequest) Reset() {
 	*x = CancelTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+	mi := &file_mirai_v1_sme_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2007,7 +2346,7 @@ func (x *CancelTaskRequest) String() string {
 func (*CancelTaskRequest) ProtoMessage() {}
 
 func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[24]
+	mi := &file_mirai_v1_sme_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,7 +2359,7 @@ func (x *CancelTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskRequest.ProtoReflect.Descriptor instead.
 func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{24}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CancelTaskRequest) GetTaskId() string {
@@ -2040,7 +2379,7 @@ type CancelTaskResponse struct {
 
 func (x *CancelTaskResponse) Reset() {
 	*x = CancelTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+	mi := &file_mirai_v1_sme_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2052,7 +2391,7 @@ func (x *CancelTaskResponse) String() string {
 func (*CancelTaskResponse) ProtoMessage() {}
 
 func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[25]
+	mi := &file_mirai_v1_sme_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2065,7 +2404,7 @@ func (x *CancelTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelTaskResponse.ProtoReflect.Descriptor instead.
 func (*CancelTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{25}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *CancelTaskResponse) GetTask() *SMETask {
@@ -2088,7 +2427,7 @@ type GetUploadURLRequest struct {
 
 func (x *GetUploadURLRequest) Reset() {
 	*x = GetUploadURLRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+	mi := &file_mirai_v1_sme_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2100,7 +2439,7 @@ func (x *GetUploadURLRequest) String() string {
 func (*GetUploadURLRequest) ProtoMessage() {}
 
 func (x *GetUploadURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[26]
+	mi := &file_mirai_v1_sme_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +2452,7 @@ func (x *GetUploadURLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUploadURLRequest.ProtoReflect.Descriptor instead.
 func (*GetUploadURLRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{26}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetUploadURLRequest) GetTaskId() string {
@@ -2156,7 +2495,7 @@ type GetUploadURLResponse struct {
 
 func (x *GetUploadURLResponse) Reset() {
 	*x = GetUploadURLResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+	mi := &file_mirai_v1_sme_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2168,7 +2507,7 @@ func (x *GetUploadURLResponse) String() string {
 func (*GetUploadURLResponse) ProtoMessage() {}
 
 func (x *GetUploadURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[27]
+	mi := &file_mirai_v1_sme_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2181,7 +2520,7 @@ func (x *GetUploadURLResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUploadURLResponse.ProtoReflect.Descriptor instead.
 func (*GetUploadURLResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{27}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetUploadURLResponse) GetUploadUrl() string {
@@ -2207,20 +2546,22 @@ func (x *GetUploadURLResponse) GetExpiresAt() *timestamppb.Timestamp {
 
 // SubmitContentRequest records a content submission.
 type SubmitContentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Optional for text submissions
-	FilePath      string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path from GetUploadURL (optional for text)
-	ContentType   ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
-	FileSizeBytes int64                  `protobuf:"varint,5,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"` // Optional for text submissions
-	TextContent   *string                `protobuf:"bytes,6,opt,name=text_content,json=textContent,proto3,oneof" json:"text_content,omitempty"`    // Direct text content (for CONTENT_TYPE_TEXT)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TaskId               string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	FileName             string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Optional for text submissions
+	FilePath             string                 `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"` // S3 path from GetUploadURL (optional for text)
+	ContentType          ContentType            `protobuf:"varint,4,opt,name=content_type,json=contentType,proto3,enum=mirai.v1.ContentType" json:"content_type,omitempty"`
+	FileSizeBytes        int64                  `protobuf:"varint,5,opt,name=file_size_bytes,json=fileSizeBytes,proto3" json:"file_size_bytes,omitempty"`                           // Optional for text submissions
+	TextContent          *string                `protobuf:"bytes,6,opt,name=text_content,json=textContent,proto3,oneof" json:"text_content,omitempty"`                              // Direct text content (for CONTENT_TYPE_TEXT)
+	SourceUrl            *string                `protobuf:"bytes,7,opt,name=source_url,json=sourceUrl,proto3,oneof" json:"source_url,omitempty"`                                    // Web page to fetch (for CONTENT_TYPE_URL)
+	ReplacesSubmissionId *string                `protobuf:"bytes,8,opt,name=replaces_submission_id,json=replacesSubmissionId,proto3,oneof" json:"replaces_submission_id,omitempty"` // Earlier submission this one replaces, when it isn't the same file or URL
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *SubmitContentRequest) Reset() {
 	*x = SubmitContentRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+	mi := &file_mirai_v1_sme_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2232,7 +2573,7 @@ func (x *SubmitContentRequest) String() string {
 func (*SubmitContentRequest) ProtoMessage() {}
 
 func (x *SubmitContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[28]
+	mi := &file_mirai_v1_sme_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2245,7 +2586,7 @@ func (x *SubmitContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitContentRequest.ProtoReflect.Descriptor instead.
 func (*SubmitContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{28}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SubmitContentRequest) GetTaskId() string {
@@ -2290,6 +2631,20 @@ func (x *SubmitContentRequest) GetTextContent() string {
 	return ""
 }
 
+func (x *SubmitContentRequest) GetSourceUrl() string {
+	if x != nil && x.SourceUrl != nil {
+		return *x.SourceUrl
+	}
+	return ""
+}
+
+func (x *SubmitContentRequest) GetReplacesSubmissionId() string {
+	if x != nil && x.ReplacesSubmissionId != nil {
+		return *x.ReplacesSubmissionId
+	}
+	return ""
+}
+
 // SubmitContentResponse contains the created submission.
 type SubmitContentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2300,7 +2655,7 @@ type SubmitContentResponse struct {
 
 func (x *SubmitContentResponse) Reset() {
 	*x = SubmitContentResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+	mi := &file_mirai_v1_sme_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2312,7 +2667,7 @@ func (x *SubmitContentResponse) String() string {
 func (*SubmitContentResponse) ProtoMessage() {}
 
 func (x *SubmitContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[29]
+	mi := &file_mirai_v1_sme_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2325,7 +2680,7 @@ func (x *SubmitContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitContentResponse.ProtoReflect.Descriptor instead.
 func (*SubmitContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{29}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SubmitContentResponse) GetSubmission() *SMETaskSubmission {
@@ -2345,7 +2700,7 @@ type ListSubmissionsRequest struct {
 
 func (x *ListSubmissionsRequest) Reset() {
 	*x = ListSubmissionsRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+	mi := &file_mirai_v1_sme_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2357,7 +2712,7 @@ func (x *ListSubmissionsRequest) String() string {
 func (*ListSubmissionsRequest) ProtoMessage() {}
 
 func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[30]
+	mi := &file_mirai_v1_sme_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,7 +2725,7 @@ func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
 func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{30}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ListSubmissionsRequest) GetTaskId() string {
@@ -2390,7 +2745,7 @@ type ListSubmissionsResponse struct {
 
 func (x *ListSubmissionsResponse) Reset() {
 	*x = ListSubmissionsResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+	mi := &file_mirai_v1_sme_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2402,7 +2757,7 @@ func (x *ListSubmissionsResponse) String() string {
 func (*ListSubmissionsResponse) ProtoMessage() {}
 
 func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[31]
+	mi := &file_mirai_v1_sme_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,7 +2770,7 @@ func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
 func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{31}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ListSubmissionsResponse) GetSubmissions() []*SMETaskSubmission {
@@ -2435,7 +2790,7 @@ type GetKnowledgeRequest struct {
 
 func (x *GetKnowledgeRequest) Reset() {
 	*x = GetKnowledgeRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+	mi := &file_mirai_v1_sme_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2447,7 +2802,7 @@ func (x *GetKnowledgeRequest) String() string {
 func (*GetKnowledgeRequest) ProtoMessage() {}
 
 func (x *GetKnowledgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[32]
+	mi := &file_mirai_v1_sme_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2460,7 +2815,7 @@ func (x *GetKnowledgeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnowledgeRequest.ProtoReflect.Descriptor instead.
 func (*GetKnowledgeRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{32}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetKnowledgeRequest) GetSmeId() string {
@@ -2481,7 +2836,7 @@ type GetKnowledgeResponse struct {
 
 func (x *GetKnowledgeResponse) Reset() {
 	*x = GetKnowledgeResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+	mi := &file_mirai_v1_sme_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2493,7 +2848,7 @@ func (x *GetKnowledgeResponse) String() string {
 func (*GetKnowledgeResponse) ProtoMessage() {}
 
 func (x *GetKnowledgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[33]
+	mi := &file_mirai_v1_sme_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2506,7 +2861,7 @@ func (x *GetKnowledgeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKnowledgeResponse.ProtoReflect.Descriptor instead.
 func (*GetKnowledgeResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{33}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetKnowledgeResponse) GetSme() *SubjectMatterExpert {
@@ -2535,7 +2890,7 @@ type SearchKnowledgeRequest struct {
 
 func (x *SearchKnowledgeRequest) Reset() {
 	*x = SearchKnowledgeRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+	mi := &file_mirai_v1_sme_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2547,7 +2902,7 @@ func (x *SearchKnowledgeRequest) String() string {
 func (*SearchKnowledgeRequest) ProtoMessage() {}
 
 func (x *SearchKnowledgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[34]
+	mi := &file_mirai_v1_sme_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2560,7 +2915,7 @@ func (x *SearchKnowledgeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchKnowledgeRequest.ProtoReflect.Descriptor instead.
 func (*SearchKnowledgeRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{34}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SearchKnowledgeRequest) GetSmeIds() []string {
@@ -2594,7 +2949,7 @@ type SearchKnowledgeResponse struct {
 
 func (x *SearchKnowledgeResponse) Reset() {
 	*x = SearchKnowledgeResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+	mi := &file_mirai_v1_sme_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2606,7 +2961,7 @@ func (x *SearchKnowledgeResponse) String() string {
 func (*SearchKnowledgeResponse) ProtoMessage() {}
 
 func (x *SearchKnowledgeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[35]
+	mi := &file_mirai_v1_sme_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2619,7 +2974,7 @@ func (x *SearchKnowledgeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchKnowledgeResponse.ProtoReflect.Descriptor instead.
 func (*SearchKnowledgeResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{35}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SearchKnowledgeResponse) GetChunks() []*SMEKnowledgeChunk {
@@ -2639,7 +2994,7 @@ type GetSubmissionRequest struct {
 
 func (x *GetSubmissionRequest) Reset() {
 	*x = GetSubmissionRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+	mi := &file_mirai_v1_sme_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2651,7 +3006,7 @@ func (x *GetSubmissionRequest) String() string {
 func (*GetSubmissionRequest) ProtoMessage() {}
 
 func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[36]
+	mi := &file_mirai_v1_sme_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2664,7 +3019,7 @@ func (x *GetSubmissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubmissionRequest.ProtoReflect.Descriptor instead.
 func (*GetSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{36}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetSubmissionRequest) GetSubmissionId() string {
@@ -2684,7 +3039,7 @@ type GetSubmissionResponse struct {
 
 func (x *GetSubmissionResponse) Reset() {
 	*x = GetSubmissionResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+	mi := &file_mirai_v1_sme_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2696,7 +3051,7 @@ func (x *GetSubmissionResponse) String() string {
 func (*GetSubmissionResponse) ProtoMessage() {}
 
 func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[37]
+	mi := &file_mirai_v1_sme_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2709,7 +3064,7 @@ func (x *GetSubmissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSubmissionResponse.ProtoReflect.Descriptor instead.
 func (*GetSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{37}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetSubmissionResponse) GetSubmission() *SMETaskSubmission {
@@ -2730,7 +3085,7 @@ type ApproveSubmissionRequest struct {
 
 func (x *ApproveSubmissionRequest) Reset() {
 	*x = ApproveSubmissionRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+	mi := &file_mirai_v1_sme_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2742,7 +3097,7 @@ func (x *ApproveSubmissionRequest) String() string {
 func (*ApproveSubmissionRequest) ProtoMessage() {}
 
 func (x *ApproveSubmissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[38]
+	mi := &file_mirai_v1_sme_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,7 +3110,7 @@ func (x *ApproveSubmissionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveSubmissionRequest.ProtoReflect.Descriptor instead.
 func (*ApproveSubmissionRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{38}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ApproveSubmissionRequest) GetSubmissionId() string {
@@ -2783,7 +3138,7 @@ type ApproveSubmissionResponse struct {
 
 func (x *ApproveSubmissionResponse) Reset() {
 	*x = ApproveSubmissionResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+	mi := &file_mirai_v1_sme_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2795,7 +3150,7 @@ func (x *ApproveSubmissionResponse) String() string {
 func (*ApproveSubmissionResponse) ProtoMessage() {}
 
 func (x *ApproveSubmissionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[39]
+	mi := &file_mirai_v1_sme_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2808,7 +3163,7 @@ func (x *ApproveSubmissionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApproveSubmissionResponse.ProtoReflect.Descriptor instead.
 func (*ApproveSubmissionResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{39}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ApproveSubmissionResponse) GetSubmission() *SMETaskSubmission {
@@ -2836,7 +3191,7 @@ type RequestSubmissionChangesRequest struct {
 
 func (x *RequestSubmissionChangesRequest) Reset() {
 	*x = RequestSubmissionChangesRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+	mi := &file_mirai_v1_sme_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2848,7 +3203,7 @@ func (x *RequestSubmissionChangesRequest) String() string {
 func (*RequestSubmissionChangesRequest) ProtoMessage() {}
 
 func (x *RequestSubmissionChangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[40]
+	mi := &file_mirai_v1_sme_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +3216,7 @@ func (x *RequestSubmissionChangesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSubmissionChangesRequest.ProtoReflect.Descriptor instead.
 func (*RequestSubmissionChangesRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{40}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *RequestSubmissionChangesRequest) GetSubmissionId() string {
@@ -2888,7 +3243,7 @@ type RequestSubmissionChangesResponse struct {
 
 func (x *RequestSubmissionChangesResponse) Reset() {
 	*x = RequestSubmissionChangesResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+	mi := &file_mirai_v1_sme_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2900,7 +3255,7 @@ func (x *RequestSubmissionChangesResponse) String() string {
 func (*RequestSubmissionChangesResponse) ProtoMessage() {}
 
 func (x *RequestSubmissionChangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[41]
+	mi := &file_mirai_v1_sme_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2913,7 +3268,7 @@ func (x *RequestSubmissionChangesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestSubmissionChangesResponse.ProtoReflect.Descriptor instead.
 func (*RequestSubmissionChangesResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{41}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *RequestSubmissionChangesResponse) GetSubmission() *SMETaskSubmission {
@@ -2934,7 +3289,7 @@ type EnhanceSubmissionContentRequest struct {
 
 func (x *EnhanceSubmissionContentRequest) Reset() {
 	*x = EnhanceSubmissionContentRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+	mi := &file_mirai_v1_sme_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2946,7 +3301,7 @@ func (x *EnhanceSubmissionContentRequest) String() string {
 func (*EnhanceSubmissionContentRequest) ProtoMessage() {}
 
 func (x *EnhanceSubmissionContentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[42]
+	mi := &file_mirai_v1_sme_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2959,7 +3314,7 @@ func (x *EnhanceSubmissionContentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnhanceSubmissionContentRequest.ProtoReflect.Descriptor instead.
 func (*EnhanceSubmissionContentRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{42}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EnhanceSubmissionContentRequest) GetSubmissionId() string {
@@ -2987,7 +3342,7 @@ type EnhanceSubmissionContentResponse struct {
 
 func (x *EnhanceSubmissionContentResponse) Reset() {
 	*x = EnhanceSubmissionContentResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+	mi := &file_mirai_v1_sme_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2999,7 +3354,7 @@ func (x *EnhanceSubmissionContentResponse) String() string {
 func (*EnhanceSubmissionContentResponse) ProtoMessage() {}
 
 func (x *EnhanceSubmissionContentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[43]
+	mi := &file_mirai_v1_sme_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3012,7 +3367,7 @@ func (x *EnhanceSubmissionContentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnhanceSubmissionContentResponse.ProtoReflect.Descriptor instead.
 func (*EnhanceSubmissionContentResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{43}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *EnhanceSubmissionContentResponse) GetEnhancedContent() string {
@@ -3036,13 +3391,14 @@ type UpdateKnowledgeChunkRequest struct {
 	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 	Topic         *string                `protobuf:"bytes,3,opt,name=topic,proto3,oneof" json:"topic,omitempty"`
 	Keywords      []string               `protobuf:"bytes,4,rep,name=keywords,proto3" json:"keywords,omitempty"`
+	ChangeReason  *string                `protobuf:"bytes,5,opt,name=change_reason,json=changeReason,proto3,oneof" json:"change_reason,omitempty"` // Recorded in the chunk's history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateKnowledgeChunkRequest) Reset() {
 	*x = UpdateKnowledgeChunkRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+	mi := &file_mirai_v1_sme_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3054,7 +3410,7 @@ func (x *UpdateKnowledgeChunkRequest) String() string {
 func (*UpdateKnowledgeChunkRequest) ProtoMessage() {}
 
 func (x *UpdateKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[44]
+	mi := &file_mirai_v1_sme_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3067,7 +3423,7 @@ func (x *UpdateKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
 func (*UpdateKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{44}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdateKnowledgeChunkRequest) GetChunkId() string {
@@ -3098,6 +3454,13 @@ func (x *UpdateKnowledgeChunkRequest) GetKeywords() []string {
 	return nil
 }
 
+func (x *UpdateKnowledgeChunkRequest) GetChangeReason() string {
+	if x != nil && x.ChangeReason != nil {
+		return *x.ChangeReason
+	}
+	return ""
+}
+
 // UpdateKnowledgeChunkResponse contains the updated chunk.
 type UpdateKnowledgeChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3108,7 +3471,7 @@ type UpdateKnowledgeChunkResponse struct {
 
 func (x *UpdateKnowledgeChunkResponse) Reset() {
 	*x = UpdateKnowledgeChunkResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+	mi := &file_mirai_v1_sme_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3120,7 +3483,7 @@ func (x *UpdateKnowledgeChunkResponse) String() string {
 func (*UpdateKnowledgeChunkResponse) ProtoMessage() {}
 
 func (x *UpdateKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[45]
+	mi := &file_mirai_v1_sme_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3133,7 +3496,7 @@ func (x *UpdateKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
 func (*UpdateKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{45}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UpdateKnowledgeChunkResponse) GetChunk() *SMEKnowledgeChunk {
@@ -3147,13 +3510,14 @@ func (x *UpdateKnowledgeChunkResponse) GetChunk() *SMEKnowledgeChunk {
 type DeleteKnowledgeChunkRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	ChangeReason  *string                `protobuf:"bytes,2,opt,name=change_reason,json=changeReason,proto3,oneof" json:"change_reason,omitempty"` // Recorded in the chunk's history
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DeleteKnowledgeChunkRequest) Reset() {
 	*x = DeleteKnowledgeChunkRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+	mi := &file_mirai_v1_sme_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3165,7 +3529,7 @@ func (x *DeleteKnowledgeChunkRequest) String() string {
 func (*DeleteKnowledgeChunkRequest) ProtoMessage() {}
 
 func (x *DeleteKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[46]
+	mi := &file_mirai_v1_sme_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3178,7 +3542,7 @@ func (x *DeleteKnowledgeChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKnowledgeChunkRequest.ProtoReflect.Descriptor instead.
 func (*DeleteKnowledgeChunkRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{46}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *DeleteKnowledgeChunkRequest) GetChunkId() string {
@@ -3188,6 +3552,13 @@ func (x *DeleteKnowledgeChunkRequest) GetChunkId() string {
 	return ""
 }
 
+func (x *DeleteKnowledgeChunkRequest) GetChangeReason() string {
+	if x != nil && x.ChangeReason != nil {
+		return *x.ChangeReason
+	}
+	return ""
+}
+
 // DeleteKnowledgeChunkResponse confirms deletion.
 type DeleteKnowledgeChunkResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3197,7 +3568,7 @@ type DeleteKnowledgeChunkResponse struct {
 
 func (x *DeleteKnowledgeChunkResponse) Reset() {
 	*x = DeleteKnowledgeChunkResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+	mi := &file_mirai_v1_sme_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3209,7 +3580,7 @@ func (x *DeleteKnowledgeChunkResponse) String() string {
 func (*DeleteKnowledgeChunkResponse) ProtoMessage() {}
 
 func (x *DeleteKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[47]
+	mi := &file_mirai_v1_sme_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3222,32 +3593,32 @@ func (x *DeleteKnowledgeChunkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteKnowledgeChunkResponse.ProtoReflect.Descriptor instead.
 func (*DeleteKnowledgeChunkResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{47}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{49}
 }
 
-// DeleteTaskRequest permanently deletes a task.
-type DeleteTaskRequest struct {
+// ListKnowledgeChunkVersionsRequest requests a chunk's history.
+type ListKnowledgeChunkVersionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+func (x *ListKnowledgeChunkVersionsRequest) Reset() {
+	*x = ListKnowledgeChunkVersionsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *ListKnowledgeChunkVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*ListKnowledgeChunkVersionsRequest) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[48]
+func (x *ListKnowledgeChunkVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3258,9 +3629,393 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use ListKnowledgeChunkVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeChunkVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListKnowledgeChunkVersionsRequest) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+// ListKnowledgeChunkVersionsResponse contains the chunk's versions, oldest first.
+type ListKnowledgeChunkVersionsResponse struct {
+	state           protoimpl.MessageState      `protogen:"open.v1"`
+	Versions        []*SMEKnowledgeChunkVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	OutdatedLessons []*OutdatedLesson           `protobuf:"bytes,2,rep,name=outdated_lessons,json=outdatedLessons,proto3" json:"outdated_lessons,omitempty"` // Lessons generated from an older version
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListKnowledgeChunkVersionsResponse) Reset() {
+	*x = ListKnowledgeChunkVersionsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKnowledgeChunkVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKnowledgeChunkVersionsResponse) ProtoMessage() {}
+
+func (x *ListKnowledgeChunkVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKnowledgeChunkVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListKnowledgeChunkVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListKnowledgeChunkVersionsResponse) GetVersions() []*SMEKnowledgeChunkVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListKnowledgeChunkVersionsResponse) GetOutdatedLessons() []*OutdatedLesson {
+	if x != nil {
+		return x.OutdatedLessons
+	}
+	return nil
+}
+
+// RestoreKnowledgeChunkVersionRequest restores a chunk version.
+type RestoreKnowledgeChunkVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChunkId       string                 `protobuf:"bytes,1,opt,name=chunk_id,json=chunkId,proto3" json:"chunk_id,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	ChangeReason  *string                `protobuf:"bytes,3,opt,name=change_reason,json=changeReason,proto3,oneof" json:"change_reason,omitempty"` // Defaults to naming the restored version
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreKnowledgeChunkVersionRequest) Reset() {
+	*x = RestoreKnowledgeChunkVersionRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreKnowledgeChunkVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreKnowledgeChunkVersionRequest) ProtoMessage() {}
+
+func (x *RestoreKnowledgeChunkVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreKnowledgeChunkVersionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreKnowledgeChunkVersionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RestoreKnowledgeChunkVersionRequest) GetChunkId() string {
+	if x != nil {
+		return x.ChunkId
+	}
+	return ""
+}
+
+func (x *RestoreKnowledgeChunkVersionRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestoreKnowledgeChunkVersionRequest) GetChangeReason() string {
+	if x != nil && x.ChangeReason != nil {
+		return *x.ChangeReason
+	}
+	return ""
+}
+
+// RestoreKnowledgeChunkVersionResponse contains the restored chunk.
+type RestoreKnowledgeChunkVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chunk         *SMEKnowledgeChunk     `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreKnowledgeChunkVersionResponse) Reset() {
+	*x = RestoreKnowledgeChunkVersionResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreKnowledgeChunkVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreKnowledgeChunkVersionResponse) ProtoMessage() {}
+
+func (x *RestoreKnowledgeChunkVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreKnowledgeChunkVersionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreKnowledgeChunkVersionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RestoreKnowledgeChunkVersionResponse) GetChunk() *SMEKnowledgeChunk {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// ListDeletedKnowledgeChunksRequest requests an SME's deleted knowledge.
+type ListDeletedKnowledgeChunksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedKnowledgeChunksRequest) Reset() {
+	*x = ListDeletedKnowledgeChunksRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedKnowledgeChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedKnowledgeChunksRequest) ProtoMessage() {}
+
+func (x *ListDeletedKnowledgeChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedKnowledgeChunksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedKnowledgeChunksRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListDeletedKnowledgeChunksRequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+// ListDeletedKnowledgeChunksResponse contains the last version of each deleted chunk.
+type ListDeletedKnowledgeChunksResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Versions      []*SMEKnowledgeChunkVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedKnowledgeChunksResponse) Reset() {
+	*x = ListDeletedKnowledgeChunksResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedKnowledgeChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedKnowledgeChunksResponse) ProtoMessage() {}
+
+func (x *ListDeletedKnowledgeChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedKnowledgeChunksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedKnowledgeChunksResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDeletedKnowledgeChunksResponse) GetVersions() []*SMEKnowledgeChunkVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// ListOutdatedLessonsRequest requests lessons outdated by changes to an SME's knowledge.
+type ListOutdatedLessonsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SmeId         string                 `protobuf:"bytes,1,opt,name=sme_id,json=smeId,proto3" json:"sme_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutdatedLessonsRequest) Reset() {
+	*x = ListOutdatedLessonsRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutdatedLessonsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutdatedLessonsRequest) ProtoMessage() {}
+
+func (x *ListOutdatedLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutdatedLessonsRequest.ProtoReflect.Descriptor instead.
+func (*ListOutdatedLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListOutdatedLessonsRequest) GetSmeId() string {
+	if x != nil {
+		return x.SmeId
+	}
+	return ""
+}
+
+// ListOutdatedLessonsResponse contains the outdated lessons, newest first.
+type ListOutdatedLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*OutdatedLesson      `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutdatedLessonsResponse) Reset() {
+	*x = ListOutdatedLessonsResponse{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutdatedLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutdatedLessonsResponse) ProtoMessage() {}
+
+func (x *ListOutdatedLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutdatedLessonsResponse.ProtoReflect.Descriptor instead.
+func (*ListOutdatedLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListOutdatedLessonsResponse) GetLessons() []*OutdatedLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
+}
+
+// DeleteTaskRequest permanently deletes a task.
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_mirai_v1_sme_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_sme_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *DeleteTaskRequest) GetTaskId() string {
@@ -3279,7 +4034,7 @@ type DeleteTaskResponse struct {
 
 func (x *DeleteTaskResponse) Reset() {
 	*x = DeleteTaskResponse{}
-	mi := &file_mirai_v1_sme_proto_msgTypes[49]
+	mi := &file_mirai_v1_sme_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3291,7 +4046,7 @@ func (x *DeleteTaskResponse) String() string {
 func (*DeleteTaskResponse) ProtoMessage() {}
 
 func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_sme_proto_msgTypes[49]
+	mi := &file_mirai_v1_sme_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3304,7 +4059,7 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
 func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{49}
+	return file_mirai_v1_sme_proto_rawDescGZIP(), []int{59}
 }
 
 var File_mirai_v1_sme_proto protoreflect.FileDescriptor
@@ -3354,7 +4109,7 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\n" +
 	"\b_team_idB\v\n" +
 	"\t_due_dateB\x0f\n" +
-	"\r_completed_at\"\xb1\a\n" +
+	"\r_completed_at\"\x9e\t\n" +
 	"\x11SMETaskSubmission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
 	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12\x17\n" +
@@ -3377,7 +4132,12 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"isApproved\x12@\n" +
 	"\vapproved_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
 	"approvedAt\x88\x01\x01\x122\n" +
-	"\x13approved_by_user_id\x18\x12 \x01(\tH\aR\x10approvedByUserId\x88\x01\x01B\x11\n" +
+	"\x13approved_by_user_id\x18\x12 \x01(\tH\aR\x10approvedByUserId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"source_url\x18\x13 \x01(\tH\bR\tsourceUrl\x88\x01\x01\x12B\n" +
+	"\x1bsuperseded_by_submission_id\x18\x14 \x01(\tH\tR\x18supersededBySubmissionId\x88\x01\x01\x129\n" +
+	"\x16replaces_submission_id\x18\x15 \x01(\tH\n" +
+	"R\x14replacesSubmissionId\x88\x01\x01B\x11\n" +
 	"\x0f_extracted_textB\r\n" +
 	"\v_ai_summaryB\x12\n" +
 	"\x10_ingestion_errorB\x0f\n" +
@@ -3385,7 +4145,10 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x0f_reviewer_notesB\x13\n" +
 	"\x11_approved_contentB\x0e\n" +
 	"\f_approved_atB\x16\n" +
-	"\x14_approved_by_user_id\"\xa6\x02\n" +
+	"\x14_approved_by_user_idB\r\n" +
+	"\v_source_urlB\x1e\n" +
+	"\x1c_superseded_by_submission_idB\x19\n" +
+	"\x17_replaces_submission_id\"\xf7\x03\n" +
 	"\x11SMEKnowledgeChunk\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x15\n" +
 	"\x06sme_id\x18\x02 \x01(\tR\x05smeId\x12(\n" +
@@ -3395,8 +4158,44 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\bkeywords\x18\x06 \x03(\tR\bkeywords\x12'\n" +
 	"\x0frelevance_score\x18\a \x01(\x02R\x0erelevanceScore\x129\n" +
 	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
-	"\x0e_submission_id\"\xa5\x01\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12*\n" +
+	"\x0esource_heading\x18\t \x01(\tH\x01R\rsourceHeading\x88\x01\x01\x12$\n" +
+	"\vsource_page\x18\n" +
+	" \x01(\x05H\x02R\n" +
+	"sourcePage\x88\x01\x01\x12=\n" +
+	"\x18source_timestamp_seconds\x18\v \x01(\x05H\x03R\x16sourceTimestampSeconds\x88\x01\x01B\x10\n" +
+	"\x0e_submission_idB\x11\n" +
+	"\x0f_source_headingB\x0e\n" +
+	"\f_source_pageB\x1b\n" +
+	"\x19_source_timestamp_seconds\"\xf7\x03\n" +
+	"\x18SMEKnowledgeChunkVersion\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x15\n" +
+	"\x06sme_id\x18\x02 \x01(\tR\x05smeId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x05R\aversion\x12>\n" +
+	"\vchange_type\x18\x04 \x01(\x0e2\x1d.mirai.v1.KnowledgeChangeTypeR\n" +
+	"changeType\x12(\n" +
+	"\rchange_reason\x18\x05 \x01(\tH\x00R\fchangeReason\x88\x01\x01\x12)\n" +
+	"\x0eauthor_user_id\x18\x06 \x01(\tH\x01R\fauthorUserId\x88\x01\x01\x12(\n" +
+	"\rsubmission_id\x18\a \x01(\tH\x02R\fsubmissionId\x88\x01\x01\x12\x18\n" +
+	"\acontent\x18\b \x01(\tR\acontent\x12\x14\n" +
+	"\x05topic\x18\t \x01(\tR\x05topic\x12\x1a\n" +
+	"\bkeywords\x18\n" +
+	" \x03(\tR\bkeywords\x12\x12\n" +
+	"\x04diff\x18\v \x01(\tR\x04diff\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x10\n" +
+	"\x0e_change_reasonB\x11\n" +
+	"\x0f_author_user_idB\x10\n" +
+	"\x0e_submission_id\"\xb5\x02\n" +
+	"\x0eOutdatedLesson\x12\x1b\n" +
+	"\tlesson_id\x18\x01 \x01(\tR\blessonId\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12=\n" +
+	"\fgenerated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\x12\x19\n" +
+	"\bchunk_id\x18\x05 \x01(\tR\achunkId\x12+\n" +
+	"\x11generated_version\x18\x06 \x01(\x05R\x10generatedVersion\x12'\n" +
+	"\x0fcurrent_version\x18\a \x01(\x05R\x0ecurrentVersion\x12#\n" +
+	"\rchunk_deleted\x18\b \x01(\bR\fchunkDeleted\"\xa5\x01\n" +
 	"\x10CreateSMERequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
@@ -3495,15 +4294,20 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"upload_url\x18\x01 \x01(\tR\tuploadUrl\x12\x1b\n" +
 	"\tfile_path\x18\x02 \x01(\tR\bfilePath\x129\n" +
 	"\n" +
-	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x84\x02\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x8d\x03\n" +
 	"\x14SubmitContentRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
 	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
 	"\tfile_path\x18\x03 \x01(\tR\bfilePath\x128\n" +
 	"\fcontent_type\x18\x04 \x01(\x0e2\x15.mirai.v1.ContentTypeR\vcontentType\x12&\n" +
 	"\x0ffile_size_bytes\x18\x05 \x01(\x03R\rfileSizeBytes\x12&\n" +
-	"\ftext_content\x18\x06 \x01(\tH\x00R\vtextContent\x88\x01\x01B\x0f\n" +
-	"\r_text_content\"T\n" +
+	"\ftext_content\x18\x06 \x01(\tH\x00R\vtextContent\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"source_url\x18\a \x01(\tH\x01R\tsourceUrl\x88\x01\x01\x129\n" +
+	"\x16replaces_submission_id\x18\b \x01(\tH\x02R\x14replacesSubmissionId\x88\x01\x01B\x0f\n" +
+	"\r_text_contentB\r\n" +
+	"\v_source_urlB\x19\n" +
+	"\x17_replaces_submission_id\"T\n" +
 	"\x15SubmitContentResponse\x12;\n" +
 	"\n" +
 	"submission\x18\x01 \x01(\v2\x1b.mirai.v1.SMETaskSubmissionR\n" +
@@ -3549,18 +4353,42 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\fenhance_type\x18\x02 \x01(\x0e2\x15.mirai.v1.EnhanceTypeR\venhanceType\"x\n" +
 	" EnhanceSubmissionContentResponse\x12)\n" +
 	"\x10enhanced_content\x18\x01 \x01(\tR\x0fenhancedContent\x12)\n" +
-	"\x10original_content\x18\x02 \x01(\tR\x0foriginalContent\"\x93\x01\n" +
+	"\x10original_content\x18\x02 \x01(\tR\x0foriginalContent\"\xcf\x01\n" +
 	"\x1bUpdateKnowledgeChunkRequest\x12\x19\n" +
 	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x18\n" +
 	"\acontent\x18\x02 \x01(\tR\acontent\x12\x19\n" +
 	"\x05topic\x18\x03 \x01(\tH\x00R\x05topic\x88\x01\x01\x12\x1a\n" +
-	"\bkeywords\x18\x04 \x03(\tR\bkeywordsB\b\n" +
-	"\x06_topic\"Q\n" +
+	"\bkeywords\x18\x04 \x03(\tR\bkeywords\x12(\n" +
+	"\rchange_reason\x18\x05 \x01(\tH\x01R\fchangeReason\x88\x01\x01B\b\n" +
+	"\x06_topicB\x10\n" +
+	"\x0e_change_reason\"Q\n" +
 	"\x1cUpdateKnowledgeChunkResponse\x121\n" +
-	"\x05chunk\x18\x01 \x01(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x05chunk\"8\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x05chunk\"t\n" +
 	"\x1bDeleteKnowledgeChunkRequest\x12\x19\n" +
-	"\bchunk_id\x18\x01 \x01(\tR\achunkId\"\x1e\n" +
-	"\x1cDeleteKnowledgeChunkResponse\",\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12(\n" +
+	"\rchange_reason\x18\x02 \x01(\tH\x00R\fchangeReason\x88\x01\x01B\x10\n" +
+	"\x0e_change_reason\"\x1e\n" +
+	"\x1cDeleteKnowledgeChunkResponse\">\n" +
+	"!ListKnowledgeChunkVersionsRequest\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\"\xa9\x01\n" +
+	"\"ListKnowledgeChunkVersionsResponse\x12>\n" +
+	"\bversions\x18\x01 \x03(\v2\".mirai.v1.SMEKnowledgeChunkVersionR\bversions\x12C\n" +
+	"\x10outdated_lessons\x18\x02 \x03(\v2\x18.mirai.v1.OutdatedLessonR\x0foutdatedLessons\"\x96\x01\n" +
+	"#RestoreKnowledgeChunkVersionRequest\x12\x19\n" +
+	"\bchunk_id\x18\x01 \x01(\tR\achunkId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12(\n" +
+	"\rchange_reason\x18\x03 \x01(\tH\x00R\fchangeReason\x88\x01\x01B\x10\n" +
+	"\x0e_change_reason\"Y\n" +
+	"$RestoreKnowledgeChunkVersionResponse\x121\n" +
+	"\x05chunk\x18\x01 \x01(\v2\x1b.mirai.v1.SMEKnowledgeChunkR\x05chunk\":\n" +
+	"!ListDeletedKnowledgeChunksRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"d\n" +
+	"\"ListDeletedKnowledgeChunksResponse\x12>\n" +
+	"\bversions\x18\x01 \x03(\v2\".mirai.v1.SMEKnowledgeChunkVersionR\bversions\"3\n" +
+	"\x1aListOutdatedLessonsRequest\x12\x15\n" +
+	"\x06sme_id\x18\x01 \x01(\tR\x05smeId\"Q\n" +
+	"\x1bListOutdatedLessonsResponse\x122\n" +
+	"\alessons\x18\x01 \x03(\v2\x18.mirai.v1.OutdatedLessonR\alessons\",\n" +
 	"\x11DeleteTaskRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x14\n" +
 	"\x12DeleteTaskResponse*O\n" +
@@ -3595,7 +4423,13 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x12CONTENT_TYPE_VIDEO\x10\x03\x12\x16\n" +
 	"\x12CONTENT_TYPE_AUDIO\x10\x04\x12\x14\n" +
 	"\x10CONTENT_TYPE_URL\x10\x05\x12\x15\n" +
-	"\x11CONTENT_TYPE_TEXT\x10\x062\xd5\x0e\n" +
+	"\x11CONTENT_TYPE_TEXT\x10\x06*\xc9\x01\n" +
+	"\x13KnowledgeChangeType\x12%\n" +
+	"!KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dKNOWLEDGE_CHANGE_TYPE_CREATED\x10\x01\x12!\n" +
+	"\x1dKNOWLEDGE_CHANGE_TYPE_UPDATED\x10\x02\x12!\n" +
+	"\x1dKNOWLEDGE_CHANGE_TYPE_DELETED\x10\x03\x12\"\n" +
+	"\x1eKNOWLEDGE_CHANGE_TYPE_RESTORED\x10\x042\xaa\x12\n" +
 	"\n" +
 	"SMEService\x12D\n" +
 	"\tCreateSME\x12\x1a.mirai.v1.CreateSMERequest\x1a\x1b.mirai.v1.CreateSMEResponse\x12;\n" +
@@ -3623,7 +4457,11 @@ const file_mirai_v1_sme_proto_rawDesc = "" +
 	"\x18RequestSubmissionChanges\x12).mirai.v1.RequestSubmissionChangesRequest\x1a*.mirai.v1.RequestSubmissionChangesResponse\x12q\n" +
 	"\x18EnhanceSubmissionContent\x12).mirai.v1.EnhanceSubmissionContentRequest\x1a*.mirai.v1.EnhanceSubmissionContentResponse\x12e\n" +
 	"\x14UpdateKnowledgeChunk\x12%.mirai.v1.UpdateKnowledgeChunkRequest\x1a&.mirai.v1.UpdateKnowledgeChunkResponse\x12e\n" +
-	"\x14DeleteKnowledgeChunk\x12%.mirai.v1.DeleteKnowledgeChunkRequest\x1a&.mirai.v1.DeleteKnowledgeChunkResponse\x12G\n" +
+	"\x14DeleteKnowledgeChunk\x12%.mirai.v1.DeleteKnowledgeChunkRequest\x1a&.mirai.v1.DeleteKnowledgeChunkResponse\x12w\n" +
+	"\x1aListKnowledgeChunkVersions\x12+.mirai.v1.ListKnowledgeChunkVersionsRequest\x1a,.mirai.v1.ListKnowledgeChunkVersionsResponse\x12}\n" +
+	"\x1cRestoreKnowledgeChunkVersion\x12-.mirai.v1.RestoreKnowledgeChunkVersionRequest\x1a..mirai.v1.RestoreKnowledgeChunkVersionResponse\x12w\n" +
+	"\x1aListDeletedKnowledgeChunks\x12+.mirai.v1.ListDeletedKnowledgeChunksRequest\x1a,.mirai.v1.ListDeletedKnowledgeChunksResponse\x12b\n" +
+	"\x13ListOutdatedLessons\x12$.mirai.v1.ListOutdatedLessonsRequest\x1a%.mirai.v1.ListOutdatedLessonsResponse\x12G\n" +
 	"\n" +
 	"DeleteTask\x12\x1b.mirai.v1.DeleteTaskRequest\x1a\x1c.mirai.v1.DeleteTaskResponseB\x8e\x01\n" +
 	"\fcom.mirai.v1B\bSmeProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
@@ -3640,167 +4478,194 @@ func file_mirai_v1_sme_proto_rawDescGZIP() []byte {
 	return file_mirai_v1_sme_proto_rawDescData
 }
 
-var file_mirai_v1_sme_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_mirai_v1_sme_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_mirai_v1_sme_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_mirai_v1_sme_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
 var file_mirai_v1_sme_proto_goTypes = []any{
-	(SMEScope)(0),                            // 0: mirai.v1.SMEScope
-	(SMEStatus)(0),                           // 1: mirai.v1.SMEStatus
-	(SMETaskStatus)(0),                       // 2: mirai.v1.SMETaskStatus
-	(EnhanceType)(0),                         // 3: mirai.v1.EnhanceType
-	(ContentType)(0),                         // 4: mirai.v1.ContentType
-	(*SubjectMatterExpert)(nil),              // 5: mirai.v1.SubjectMatterExpert
-	(*SMETask)(nil),                          // 6: mirai.v1.SMETask
-	(*SMETaskSubmission)(nil),                // 7: mirai.v1.SMETaskSubmission
-	(*SMEKnowledgeChunk)(nil),                // 8: mirai.v1.SMEKnowledgeChunk
-	(*CreateSMERequest)(nil),                 // 9: mirai.v1.CreateSMERequest
-	(*CreateSMEResponse)(nil),                // 10: mirai.v1.CreateSMEResponse
-	(*GetSMERequest)(nil),                    // 11: mirai.v1.GetSMERequest
-	(*GetSMEResponse)(nil),                   // 12: mirai.v1.GetSMEResponse
-	(*ListSMEsRequest)(nil),                  // 13: mirai.v1.ListSMEsRequest
-	(*ListSMEsResponse)(nil),                 // 14: mirai.v1.ListSMEsResponse
-	(*UpdateSMERequest)(nil),                 // 15: mirai.v1.UpdateSMERequest
-	(*UpdateSMEResponse)(nil),                // 16: mirai.v1.UpdateSMEResponse
-	(*DeleteSMERequest)(nil),                 // 17: mirai.v1.DeleteSMERequest
-	(*DeleteSMEResponse)(nil),                // 18: mirai.v1.DeleteSMEResponse
-	(*RestoreSMERequest)(nil),                // 19: mirai.v1.RestoreSMERequest
-	(*RestoreSMEResponse)(nil),               // 20: mirai.v1.RestoreSMEResponse
-	(*CreateTaskRequest)(nil),                // 21: mirai.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),               // 22: mirai.v1.CreateTaskResponse
-	(*GetTaskRequest)(nil),                   // 23: mirai.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),                  // 24: mirai.v1.GetTaskResponse
-	(*ListTasksRequest)(nil),                 // 25: mirai.v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                // 26: mirai.v1.ListTasksResponse
-	(*UpdateTaskRequest)(nil),                // 27: mirai.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),               // 28: mirai.v1.UpdateTaskResponse
-	(*CancelTaskRequest)(nil),                // 29: mirai.v1.CancelTaskRequest
-	(*CancelTaskResponse)(nil),               // 30: mirai.v1.CancelTaskResponse
-	(*GetUploadURLRequest)(nil),              // 31: mirai.v1.GetUploadURLRequest
-	(*GetUploadURLResponse)(nil),             // 32: mirai.v1.GetUploadURLResponse
-	(*SubmitContentRequest)(nil),             // 33: mirai.v1.SubmitContentRequest
-	(*SubmitContentResponse)(nil),            // 34: mirai.v1.SubmitContentResponse
-	(*ListSubmissionsRequest)(nil),           // 35: mirai.v1.ListSubmissionsRequest
-	(*ListSubmissionsResponse)(nil),          // 36: mirai.v1.ListSubmissionsResponse
-	(*GetKnowledgeRequest)(nil),              // 37: mirai.v1.GetKnowledgeRequest
-	(*GetKnowledgeResponse)(nil),             // 38: mirai.v1.GetKnowledgeResponse
-	(*SearchKnowledgeRequest)(nil),           // 39: mirai.v1.SearchKnowledgeRequest
-	(*SearchKnowledgeResponse)(nil),          // 40: mirai.v1.SearchKnowledgeResponse
-	(*GetSubmissionRequest)(nil),             // 41: mirai.v1.GetSubmissionRequest
-	(*GetSubmissionResponse)(nil),            // 42: mirai.v1.GetSubmissionResponse
-	(*ApproveSubmissionRequest)(nil),         // 43: mirai.v1.ApproveSubmissionRequest
-	(*ApproveSubmissionResponse)(nil),        // 44: mirai.v1.ApproveSubmissionResponse
-	(*RequestSubmissionChangesRequest)(nil),  // 45: mirai.v1.RequestSubmissionChangesRequest
-	(*RequestSubmissionChangesResponse)(nil), // 46: mirai.v1.RequestSubmissionChangesResponse
-	(*EnhanceSubmissionContentRequest)(nil),  // 47: mirai.v1.EnhanceSubmissionContentRequest
-	(*EnhanceSubmissionContentResponse)(nil), // 48: mirai.v1.EnhanceSubmissionContentResponse
-	(*UpdateKnowledgeChunkRequest)(nil),      // 49: mirai.v1.UpdateKnowledgeChunkRequest
-	(*UpdateKnowledgeChunkResponse)(nil),     // 50: mirai.v1.UpdateKnowledgeChunkResponse
-	(*DeleteKnowledgeChunkRequest)(nil),      // 51: mirai.v1.DeleteKnowledgeChunkRequest
-	(*DeleteKnowledgeChunkResponse)(nil),     // 52: mirai.v1.DeleteKnowledgeChunkResponse
-	(*DeleteTaskRequest)(nil),                // 53: mirai.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),               // 54: mirai.v1.DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil),            // 55: google.protobuf.Timestamp
+	(SMEScope)(0),                                // 0: mirai.v1.SMEScope
+	(SMEStatus)(0),                               // 1: mirai.v1.SMEStatus
+	(SMETaskStatus)(0),                           // 2: mirai.v1.SMETaskStatus
+	(EnhanceType)(0),                             // 3: mirai.v1.EnhanceType
+	(ContentType)(0),                             // 4: mirai.v1.ContentType
+	(KnowledgeChangeType)(0),                     // 5: mirai.v1.KnowledgeChangeType
+	(*SubjectMatterExpert)(nil),                  // 6: mirai.v1.SubjectMatterExpert
+	(*SMETask)(nil),                              // 7: mirai.v1.SMETask
+	(*SMETaskSubmission)(nil),                    // 8: mirai.v1.SMETaskSubmission
+	(*SMEKnowledgeChunk)(nil),                    // 9: mirai.v1.SMEKnowledgeChunk
+	(*SMEKnowledgeChunkVersion)(nil),             // 10: mirai.v1.SMEKnowledgeChunkVersion
+	(*OutdatedLesson)(nil),                       // 11: mirai.v1.OutdatedLesson
+	(*CreateSMERequest)(nil),                     // 12: mirai.v1.CreateSMERequest
+	(*CreateSMEResponse)(nil),                    // 13: mirai.v1.CreateSMEResponse
+	(*GetSMERequest)(nil),                        // 14: mirai.v1.GetSMERequest
+	(*GetSMEResponse)(nil),                       // 15: mirai.v1.GetSMEResponse
+	(*ListSMEsRequest)(nil),                      // 16: mirai.v1.ListSMEsRequest
+	(*ListSMEsResponse)(nil),                     // 17: mirai.v1.ListSMEsResponse
+	(*UpdateSMERequest)(nil),                     // 18: mirai.v1.UpdateSMERequest
+	(*UpdateSMEResponse)(nil),                    // 19: mirai.v1.UpdateSMEResponse
+	(*DeleteSMERequest)(nil),                     // 20: mirai.v1.DeleteSMERequest
+	(*DeleteSMEResponse)(nil),                    // 21: mirai.v1.DeleteSMEResponse
+	(*RestoreSMERequest)(nil),                    // 22: mirai.v1.RestoreSMERequest
+	(*RestoreSMEResponse)(nil),                   // 23: mirai.v1.RestoreSMEResponse
+	(*CreateTaskRequest)(nil),                    // 24: mirai.v1.CreateTaskRequest
+	(*CreateTaskResponse)(nil),                   // 25: mirai.v1.CreateTaskResponse
+	(*GetTaskRequest)(nil),                       // 26: mirai.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),                      // 27: mirai.v1.GetTaskResponse
+	(*ListTasksRequest)(nil),                     // 28: mirai.v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                    // 29: mirai.v1.ListTasksResponse
+	(*UpdateTaskRequest)(nil),                    // 30: mirai.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),                   // 31: mirai.v1.UpdateTaskResponse
+	(*CancelTaskRequest)(nil),                    // 32: mirai.v1.CancelTaskRequest
+	(*CancelTaskResponse)(nil),                   // 33: mirai.v1.CancelTaskResponse
+	(*GetUploadURLRequest)(nil),                  // 34: mirai.v1.GetUploadURLRequest
+	(*GetUploadURLResponse)(nil),                 // 35: mirai.v1.GetUploadURLResponse
+	(*SubmitContentRequest)(nil),                 // 36: mirai.v1.SubmitContentRequest
+	(*SubmitContentResponse)(nil),                // 37: mirai.v1.SubmitContentResponse
+	(*ListSubmissionsRequest)(nil),               // 38: mirai.v1.ListSubmissionsRequest
+	(*ListSubmissionsResponse)(nil),              // 39: mirai.v1.ListSubmissionsResponse
+	(*GetKnowledgeRequest)(nil),                  // 40: mirai.v1.GetKnowledgeRequest
+	(*GetKnowledgeResponse)(nil),                 // 41: mirai.v1.GetKnowledgeResponse
+	(*SearchKnowledgeRequest)(nil),               // 42: mirai.v1.SearchKnowledgeRequest
+	(*SearchKnowledgeResponse)(nil),              // 43: mirai.v1.SearchKnowledgeResponse
+	(*GetSubmissionRequest)(nil),                 // 44: mirai.v1.GetSubmissionRequest
+	(*GetSubmissionResponse)(nil),                // 45: mirai.v1.GetSubmissionResponse
+	(*ApproveSubmissionRequest)(nil),             // 46: mirai.v1.ApproveSubmissionRequest
+	(*ApproveSubmissionResponse)(nil),            // 47: mirai.v1.ApproveSubmissionResponse
+	(*RequestSubmissionChangesRequest)(nil),      // 48: mirai.v1.RequestSubmissionChangesRequest
+	(*RequestSubmissionChangesResponse)(nil),     // 49: mirai.v1.RequestSubmissionChangesResponse
+	(*EnhanceSubmissionContentRequest)(nil),      // 50: mirai.v1.EnhanceSubmissionContentRequest
+	(*EnhanceSubmissionContentResponse)(nil),     // 51: mirai.v1.EnhanceSubmissionContentResponse
+	(*UpdateKnowledgeChunkRequest)(nil),          // 52: mirai.v1.UpdateKnowledgeChunkRequest
+	(*UpdateKnowledgeChunkResponse)(nil),         // 53: mirai.v1.UpdateKnowledgeChunkResponse
+	(*DeleteKnowledgeChunkRequest)(nil),          // 54: mirai.v1.DeleteKnowledgeChunkRequest
+	(*DeleteKnowledgeChunkResponse)(nil),         // 55: mirai.v1.DeleteKnowledgeChunkResponse
+	(*ListKnowledgeChunkVersionsRequest)(nil),    // 56: mirai.v1.ListKnowledgeChunkVersionsRequest
+	(*ListKnowledgeChunkVersionsResponse)(nil),   // 57: mirai.v1.ListKnowledgeChunkVersionsResponse
+	(*RestoreKnowledgeChunkVersionRequest)(nil),  // 58: mirai.v1.RestoreKnowledgeChunkVersionRequest
+	(*RestoreKnowledgeChunkVersionResponse)(nil), // 59: mirai.v1.RestoreKnowledgeChunkVersionResponse
+	(*ListDeletedKnowledgeChunksRequest)(nil),    // 60: mirai.v1.ListDeletedKnowledgeChunksRequest
+	(*ListDeletedKnowledgeChunksResponse)(nil),   // 61: mirai.v1.ListDeletedKnowledgeChunksResponse
+	(*ListOutdatedLessonsRequest)(nil),           // 62: mirai.v1.ListOutdatedLessonsRequest
+	(*ListOutdatedLessonsResponse)(nil),          // 63: mirai.v1.ListOutdatedLessonsResponse
+	(*DeleteTaskRequest)(nil),                    // 64: mirai.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),                   // 65: mirai.v1.DeleteTaskResponse
+	(*timestamppb.Timestamp)(nil),                // 66: google.protobuf.Timestamp
 }
 var file_mirai_v1_sme_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.SubjectMatterExpert.scope:type_name -> mirai.v1.SMEScope
 	1,  // 1: mirai.v1.SubjectMatterExpert.status:type_name -> mirai.v1.SMEStatus
-	55, // 2: mirai.v1.SubjectMatterExpert.created_at:type_name -> google.protobuf.Timestamp
-	55, // 3: mirai.v1.SubjectMatterExpert.updated_at:type_name -> google.protobuf.Timestamp
+	66, // 2: mirai.v1.SubjectMatterExpert.created_at:type_name -> google.protobuf.Timestamp
+	66, // 3: mirai.v1.SubjectMatterExpert.updated_at:type_name -> google.protobuf.Timestamp
 	4,  // 4: mirai.v1.SMETask.expected_content_type:type_name -> mirai.v1.ContentType
 	2,  // 5: mirai.v1.SMETask.status:type_name -> mirai.v1.SMETaskStatus
-	55, // 6: mirai.v1.SMETask.due_date:type_name -> google.protobuf.Timestamp
-	55, // 7: mirai.v1.SMETask.created_at:type_name -> google.protobuf.Timestamp
-	55, // 8: mirai.v1.SMETask.updated_at:type_name -> google.protobuf.Timestamp
-	55, // 9: mirai.v1.SMETask.completed_at:type_name -> google.protobuf.Timestamp
+	66, // 6: mirai.v1.SMETask.due_date:type_name -> google.protobuf.Timestamp
+	66, // 7: mirai.v1.SMETask.created_at:type_name -> google.protobuf.Timestamp
+	66, // 8: mirai.v1.SMETask.updated_at:type_name -> google.protobuf.Timestamp
+	66, // 9: mirai.v1.SMETask.completed_at:type_name -> google.protobuf.Timestamp
 	4,  // 10: mirai.v1.SMETaskSubmission.content_type:type_name -> mirai.v1.ContentType
-	55, // 11: mirai.v1.SMETaskSubmission.submitted_at:type_name -> google.protobuf.Timestamp
-	55, // 12: mirai.v1.SMETaskSubmission.processed_at:type_name -> google.protobuf.Timestamp
-	55, // 13: mirai.v1.SMETaskSubmission.approved_at:type_name -> google.protobuf.Timestamp
-	55, // 14: mirai.v1.SMEKnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
-	0,  // 15: mirai.v1.CreateSMERequest.scope:type_name -> mirai.v1.SMEScope
-	5,  // 16: mirai.v1.CreateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	5,  // 17: mirai.v1.GetSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	0,  // 18: mirai.v1.ListSMEsRequest.scope:type_name -> mirai.v1.SMEScope
-	1,  // 19: mirai.v1.ListSMEsRequest.status:type_name -> mirai.v1.SMEStatus
-	5,  // 20: mirai.v1.ListSMEsResponse.smes:type_name -> mirai.v1.SubjectMatterExpert
-	0,  // 21: mirai.v1.UpdateSMERequest.scope:type_name -> mirai.v1.SMEScope
-	1,  // 22: mirai.v1.UpdateSMERequest.status:type_name -> mirai.v1.SMEStatus
-	5,  // 23: mirai.v1.UpdateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	5,  // 24: mirai.v1.RestoreSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	4,  // 25: mirai.v1.CreateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
-	55, // 26: mirai.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	6,  // 27: mirai.v1.CreateTaskResponse.task:type_name -> mirai.v1.SMETask
-	6,  // 28: mirai.v1.GetTaskResponse.task:type_name -> mirai.v1.SMETask
-	2,  // 29: mirai.v1.ListTasksRequest.status:type_name -> mirai.v1.SMETaskStatus
-	6,  // 30: mirai.v1.ListTasksResponse.tasks:type_name -> mirai.v1.SMETask
-	4,  // 31: mirai.v1.UpdateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
-	55, // 32: mirai.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	6,  // 33: mirai.v1.UpdateTaskResponse.task:type_name -> mirai.v1.SMETask
-	6,  // 34: mirai.v1.CancelTaskResponse.task:type_name -> mirai.v1.SMETask
-	4,  // 35: mirai.v1.GetUploadURLRequest.content_type:type_name -> mirai.v1.ContentType
-	55, // 36: mirai.v1.GetUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
-	4,  // 37: mirai.v1.SubmitContentRequest.content_type:type_name -> mirai.v1.ContentType
-	7,  // 38: mirai.v1.SubmitContentResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	7,  // 39: mirai.v1.ListSubmissionsResponse.submissions:type_name -> mirai.v1.SMETaskSubmission
-	5,  // 40: mirai.v1.GetKnowledgeResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
-	8,  // 41: mirai.v1.GetKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	8,  // 42: mirai.v1.SearchKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	7,  // 43: mirai.v1.GetSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	7,  // 44: mirai.v1.ApproveSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	8,  // 45: mirai.v1.ApproveSubmissionResponse.created_chunks:type_name -> mirai.v1.SMEKnowledgeChunk
-	7,  // 46: mirai.v1.RequestSubmissionChangesResponse.submission:type_name -> mirai.v1.SMETaskSubmission
-	3,  // 47: mirai.v1.EnhanceSubmissionContentRequest.enhance_type:type_name -> mirai.v1.EnhanceType
-	8,  // 48: mirai.v1.UpdateKnowledgeChunkResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
-	9,  // 49: mirai.v1.SMEService.CreateSME:input_type -> mirai.v1.CreateSMERequest
-	11, // 50: mirai.v1.SMEService.GetSME:input_type -> mirai.v1.GetSMERequest
-	13, // 51: mirai.v1.SMEService.ListSMEs:input_type -> mirai.v1.ListSMEsRequest
-	15, // 52: mirai.v1.SMEService.UpdateSME:input_type -> mirai.v1.UpdateSMERequest
-	17, // 53: mirai.v1.SMEService.DeleteSME:input_type -> mirai.v1.DeleteSMERequest
-	19, // 54: mirai.v1.SMEService.RestoreSME:input_type -> mirai.v1.RestoreSMERequest
-	21, // 55: mirai.v1.SMEService.CreateTask:input_type -> mirai.v1.CreateTaskRequest
-	23, // 56: mirai.v1.SMEService.GetTask:input_type -> mirai.v1.GetTaskRequest
-	25, // 57: mirai.v1.SMEService.ListTasks:input_type -> mirai.v1.ListTasksRequest
-	27, // 58: mirai.v1.SMEService.UpdateTask:input_type -> mirai.v1.UpdateTaskRequest
-	29, // 59: mirai.v1.SMEService.CancelTask:input_type -> mirai.v1.CancelTaskRequest
-	31, // 60: mirai.v1.SMEService.GetUploadURL:input_type -> mirai.v1.GetUploadURLRequest
-	33, // 61: mirai.v1.SMEService.SubmitContent:input_type -> mirai.v1.SubmitContentRequest
-	35, // 62: mirai.v1.SMEService.ListSubmissions:input_type -> mirai.v1.ListSubmissionsRequest
-	37, // 63: mirai.v1.SMEService.GetKnowledge:input_type -> mirai.v1.GetKnowledgeRequest
-	39, // 64: mirai.v1.SMEService.SearchKnowledge:input_type -> mirai.v1.SearchKnowledgeRequest
-	41, // 65: mirai.v1.SMEService.GetSubmission:input_type -> mirai.v1.GetSubmissionRequest
-	43, // 66: mirai.v1.SMEService.ApproveSubmission:input_type -> mirai.v1.ApproveSubmissionRequest
-	45, // 67: mirai.v1.SMEService.RequestSubmissionChanges:input_type -> mirai.v1.RequestSubmissionChangesRequest
-	47, // 68: mirai.v1.SMEService.EnhanceSubmissionContent:input_type -> mirai.v1.EnhanceSubmissionContentRequest
-	49, // 69: mirai.v1.SMEService.UpdateKnowledgeChunk:input_type -> mirai.v1.UpdateKnowledgeChunkRequest
-	51, // 70: mirai.v1.SMEService.DeleteKnowledgeChunk:input_type -> mirai.v1.DeleteKnowledgeChunkRequest
-	53, // 71: mirai.v1.SMEService.DeleteTask:input_type -> mirai.v1.DeleteTaskRequest
-	10, // 72: mirai.v1.SMEService.CreateSME:output_type -> mirai.v1.CreateSMEResponse
-	12, // 73: mirai.v1.SMEService.GetSME:output_type -> mirai.v1.GetSMEResponse
-	14, // 74: mirai.v1.SMEService.ListSMEs:output_type -> mirai.v1.ListSMEsResponse
-	16, // 75: mirai.v1.SMEService.UpdateSME:output_type -> mirai.v1.UpdateSMEResponse
-	18, // 76: mirai.v1.SMEService.DeleteSME:output_type -> mirai.v1.DeleteSMEResponse
-	20, // 77: mirai.v1.SMEService.RestoreSME:output_type -> mirai.v1.RestoreSMEResponse
-	22, // 78: mirai.v1.SMEService.CreateTask:output_type -> mirai.v1.CreateTaskResponse
-	24, // 79: mirai.v1.SMEService.GetTask:output_type -> mirai.v1.GetTaskResponse
-	26, // 80: mirai.v1.SMEService.ListTasks:output_type -> mirai.v1.ListTasksResponse
-	28, // 81: mirai.v1.SMEService.UpdateTask:output_type -> mirai.v1.UpdateTaskResponse
-	30, // 82: mirai.v1.SMEService.CancelTask:output_type -> mirai.v1.CancelTaskResponse
-	32, // 83: mirai.v1.SMEService.GetUploadURL:output_type -> mirai.v1.GetUploadURLResponse
-	34, // 84: mirai.v1.SMEService.SubmitContent:output_type -> mirai.v1.SubmitContentResponse
-	36, // 85: mirai.v1.SMEService.ListSubmissions:output_type -> mirai.v1.ListSubmissionsResponse
-	38, // 86: mirai.v1.SMEService.GetKnowledge:output_type -> mirai.v1.GetKnowledgeResponse
-	40, // 87: mirai.v1.SMEService.SearchKnowledge:output_type -> mirai.v1.SearchKnowledgeResponse
-	42, // 88: mirai.v1.SMEService.GetSubmission:output_type -> mirai.v1.GetSubmissionResponse
-	44, // 89: mirai.v1.SMEService.ApproveSubmission:output_type -> mirai.v1.ApproveSubmissionResponse
-	46, // 90: mirai.v1.SMEService.RequestSubmissionChanges:output_type -> mirai.v1.RequestSubmissionChangesResponse
-	48, // 91: mirai.v1.SMEService.EnhanceSubmissionContent:output_type -> mirai.v1.EnhanceSubmissionContentResponse
-	50, // 92: mirai.v1.SMEService.UpdateKnowledgeChunk:output_type -> mirai.v1.UpdateKnowledgeChunkResponse
-	52, // 93: mirai.v1.SMEService.DeleteKnowledgeChunk:output_type -> mirai.v1.DeleteKnowledgeChunkResponse
-	54, // 94: mirai.v1.SMEService.DeleteTask:output_type -> mirai.v1.DeleteTaskResponse
-	72, // [72:95] is the sub-list for method output_type
-	49, // [49:72] is the sub-list for method input_type
-	49, // [49:49] is the sub-list for extension type_name
-	49, // [49:49] is the sub-list for extension extendee
-	0,  // [0:49] is the sub-list for field type_name
+	66, // 11: mirai.v1.SMETaskSubmission.submitted_at:type_name -> google.protobuf.Timestamp
+	66, // 12: mirai.v1.SMETaskSubmission.processed_at:type_name -> google.protobuf.Timestamp
+	66, // 13: mirai.v1.SMETaskSubmission.approved_at:type_name -> google.protobuf.Timestamp
+	66, // 14: mirai.v1.SMEKnowledgeChunk.created_at:type_name -> google.protobuf.Timestamp
+	5,  // 15: mirai.v1.SMEKnowledgeChunkVersion.change_type:type_name -> mirai.v1.KnowledgeChangeType
+	66, // 16: mirai.v1.SMEKnowledgeChunkVersion.created_at:type_name -> google.protobuf.Timestamp
+	66, // 17: mirai.v1.OutdatedLesson.generated_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: mirai.v1.CreateSMERequest.scope:type_name -> mirai.v1.SMEScope
+	6,  // 19: mirai.v1.CreateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	6,  // 20: mirai.v1.GetSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	0,  // 21: mirai.v1.ListSMEsRequest.scope:type_name -> mirai.v1.SMEScope
+	1,  // 22: mirai.v1.ListSMEsRequest.status:type_name -> mirai.v1.SMEStatus
+	6,  // 23: mirai.v1.ListSMEsResponse.smes:type_name -> mirai.v1.SubjectMatterExpert
+	0,  // 24: mirai.v1.UpdateSMERequest.scope:type_name -> mirai.v1.SMEScope
+	1,  // 25: mirai.v1.UpdateSMERequest.status:type_name -> mirai.v1.SMEStatus
+	6,  // 26: mirai.v1.UpdateSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	6,  // 27: mirai.v1.RestoreSMEResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	4,  // 28: mirai.v1.CreateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
+	66, // 29: mirai.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	7,  // 30: mirai.v1.CreateTaskResponse.task:type_name -> mirai.v1.SMETask
+	7,  // 31: mirai.v1.GetTaskResponse.task:type_name -> mirai.v1.SMETask
+	2,  // 32: mirai.v1.ListTasksRequest.status:type_name -> mirai.v1.SMETaskStatus
+	7,  // 33: mirai.v1.ListTasksResponse.tasks:type_name -> mirai.v1.SMETask
+	4,  // 34: mirai.v1.UpdateTaskRequest.expected_content_type:type_name -> mirai.v1.ContentType
+	66, // 35: mirai.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	7,  // 36: mirai.v1.UpdateTaskResponse.task:type_name -> mirai.v1.SMETask
+	7,  // 37: mirai.v1.CancelTaskResponse.task:type_name -> mirai.v1.SMETask
+	4,  // 38: mirai.v1.GetUploadURLRequest.content_type:type_name -> mirai.v1.ContentType
+	66, // 39: mirai.v1.GetUploadURLResponse.expires_at:type_name -> google.protobuf.Timestamp
+	4,  // 40: mirai.v1.SubmitContentRequest.content_type:type_name -> mirai.v1.ContentType
+	8,  // 41: mirai.v1.SubmitContentResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	8,  // 42: mirai.v1.ListSubmissionsResponse.submissions:type_name -> mirai.v1.SMETaskSubmission
+	6,  // 43: mirai.v1.GetKnowledgeResponse.sme:type_name -> mirai.v1.SubjectMatterExpert
+	9,  // 44: mirai.v1.GetKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	9,  // 45: mirai.v1.SearchKnowledgeResponse.chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	8,  // 46: mirai.v1.GetSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	8,  // 47: mirai.v1.ApproveSubmissionResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	9,  // 48: mirai.v1.ApproveSubmissionResponse.created_chunks:type_name -> mirai.v1.SMEKnowledgeChunk
+	8,  // 49: mirai.v1.RequestSubmissionChangesResponse.submission:type_name -> mirai.v1.SMETaskSubmission
+	3,  // 50: mirai.v1.EnhanceSubmissionContentRequest.enhance_type:type_name -> mirai.v1.EnhanceType
+	9,  // 51: mirai.v1.UpdateKnowledgeChunkResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
+	10, // 52: mirai.v1.ListKnowledgeChunkVersionsResponse.versions:type_name -> mirai.v1.SMEKnowledgeChunkVersion
+	11, // 53: mirai.v1.ListKnowledgeChunkVersionsResponse.outdated_lessons:type_name -> mirai.v1.OutdatedLesson
+	9,  // 54: mirai.v1.RestoreKnowledgeChunkVersionResponse.chunk:type_name -> mirai.v1.SMEKnowledgeChunk
+	10, // 55: mirai.v1.ListDeletedKnowledgeChunksResponse.versions:type_name -> mirai.v1.SMEKnowledgeChunkVersion
+	11, // 56: mirai.v1.ListOutdatedLessonsResponse.lessons:type_name -> mirai.v1.OutdatedLesson
+	12, // 57: mirai.v1.SMEService.CreateSME:input_type -> mirai.v1.CreateSMERequest
+	14, // 58: mirai.v1.SMEService.GetSME:input_type -> mirai.v1.GetSMERequest
+	16, // 59: mirai.v1.SMEService.ListSMEs:input_type -> mirai.v1.ListSMEsRequest
+	18, // 60: mirai.v1.SMEService.UpdateSME:input_type -> mirai.v1.UpdateSMERequest
+	20, // 61: mirai.v1.SMEService.DeleteSME:input_type -> mirai.v1.DeleteSMERequest
+	22, // 62: mirai.v1.SMEService.RestoreSME:input_type -> mirai.v1.RestoreSMERequest
+	24, // 63: mirai.v1.SMEService.CreateTask:input_type -> mirai.v1.CreateTaskRequest
+	26, // 64: mirai.v1.SMEService.GetTask:input_type -> mirai.v1.GetTaskRequest
+	28, // 65: mirai.v1.SMEService.ListTasks:input_type -> mirai.v1.ListTasksRequest
+	30, // 66: mirai.v1.SMEService.UpdateTask:input_type -> mirai.v1.UpdateTaskRequest
+	32, // 67: mirai.v1.SMEService.CancelTask:input_type -> mirai.v1.CancelTaskRequest
+	34, // 68: mirai.v1.SMEService.GetUploadURL:input_type -> mirai.v1.GetUploadURLRequest
+	36, // 69: mirai.v1.SMEService.SubmitContent:input_type -> mirai.v1.SubmitContentRequest
+	38, // 70: mirai.v1.SMEService.ListSubmissions:input_type -> mirai.v1.ListSubmissionsRequest
+	40, // 71: mirai.v1.SMEService.GetKnowledge:input_type -> mirai.v1.GetKnowledgeRequest
+	42, // 72: mirai.v1.SMEService.SearchKnowledge:input_type -> mirai.v1.SearchKnowledgeRequest
+	44, // 73: mirai.v1.SMEService.GetSubmission:input_type -> mirai.v1.GetSubmissionRequest
+	46, // 74: mirai.v1.SMEService.ApproveSubmission:input_type -> mirai.v1.ApproveSubmissionRequest
+	48, // 75: mirai.v1.SMEService.RequestSubmissionChanges:input_type -> mirai.v1.RequestSubmissionChangesRequest
+	50, // 76: mirai.v1.SMEService.EnhanceSubmissionContent:input_type -> mirai.v1.EnhanceSubmissionContentRequest
+	52, // 77: mirai.v1.SMEService.UpdateKnowledgeChunk:input_type -> mirai.v1.UpdateKnowledgeChunkRequest
+	54, // 78: mirai.v1.SMEService.DeleteKnowledgeChunk:input_type -> mirai.v1.DeleteKnowledgeChunkRequest
+	56, // 79: mirai.v1.SMEService.ListKnowledgeChunkVersions:input_type -> mirai.v1.ListKnowledgeChunkVersionsRequest
+	58, // 80: mirai.v1.SMEService.RestoreKnowledgeChunkVersion:input_type -> mirai.v1.RestoreKnowledgeChunkVersionRequest
+	60, // 81: mirai.v1.SMEService.ListDeletedKnowledgeChunks:input_type -> mirai.v1.ListDeletedKnowledgeChunksRequest
+	62, // 82: mirai.v1.SMEService.ListOutdatedLessons:input_type -> mirai.v1.ListOutdatedLessonsRequest
+	64, // 83: mirai.v1.SMEService.DeleteTask:input_type -> mirai.v1.DeleteTaskRequest
+	13, // 84: mirai.v1.SMEService.CreateSME:output_type -> mirai.v1.CreateSMEResponse
+	15, // 85: mirai.v1.SMEService.GetSME:output_type -> mirai.v1.GetSMEResponse
+	17, // 86: mirai.v1.SMEService.ListSMEs:output_type -> mirai.v1.ListSMEsResponse
+	19, // 87: mirai.v1.SMEService.UpdateSME:output_type -> mirai.v1.UpdateSMEResponse
+	21, // 88: mirai.v1.SMEService.DeleteSME:output_type -> mirai.v1.DeleteSMEResponse
+	23, // 89: mirai.v1.SMEService.RestoreSME:output_type -> mirai.v1.RestoreSMEResponse
+	25, // 90: mirai.v1.SMEService.CreateTask:output_type -> mirai.v1.CreateTaskResponse
+	27, // 91: mirai.v1.SMEService.GetTask:output_type -> mirai.v1.GetTaskResponse
+	29, // 92: mirai.v1.SMEService.ListTasks:output_type -> mirai.v1.ListTasksResponse
+	31, // 93: mirai.v1.SMEService.UpdateTask:output_type -> mirai.v1.UpdateTaskResponse
+	33, // 94: mirai.v1.SMEService.CancelTask:output_type -> mirai.v1.CancelTaskResponse
+	35, // 95: mirai.v1.SMEService.GetUploadURL:output_type -> mirai.v1.GetUploadURLResponse
+	37, // 96: mirai.v1.SMEService.SubmitContent:output_type -> mirai.v1.SubmitContentResponse
+	39, // 97: mirai.v1.SMEService.ListSubmissions:output_type -> mirai.v1.ListSubmissionsResponse
+	41, // 98: mirai.v1.SMEService.GetKnowledge:output_type -> mirai.v1.GetKnowledgeResponse
+	43, // 99: mirai.v1.SMEService.SearchKnowledge:output_type -> mirai.v1.SearchKnowledgeResponse
+	45, // 100: mirai.v1.SMEService.GetSubmission:output_type -> mirai.v1.GetSubmissionResponse
+	47, // 101: mirai.v1.SMEService.ApproveSubmission:output_type -> mirai.v1.ApproveSubmissionResponse
+	49, // 102: mirai.v1.SMEService.RequestSubmissionChanges:output_type -> mirai.v1.RequestSubmissionChangesResponse
+	51, // 103: mirai.v1.SMEService.EnhanceSubmissionContent:output_type -> mirai.v1.EnhanceSubmissionContentResponse
+	53, // 104: mirai.v1.SMEService.UpdateKnowledgeChunk:output_type -> mirai.v1.UpdateKnowledgeChunkResponse
+	55, // 105: mirai.v1.SMEService.DeleteKnowledgeChunk:output_type -> mirai.v1.DeleteKnowledgeChunkResponse
+	57, // 106: mirai.v1.SMEService.ListKnowledgeChunkVersions:output_type -> mirai.v1.ListKnowledgeChunkVersionsResponse
+	59, // 107: mirai.v1.SMEService.RestoreKnowledgeChunkVersion:output_type -> mirai.v1.RestoreKnowledgeChunkVersionResponse
+	61, // 108: mirai.v1.SMEService.ListDeletedKnowledgeChunks:output_type -> mirai.v1.ListDeletedKnowledgeChunksResponse
+	63, // 109: mirai.v1.SMEService.ListOutdatedLessons:output_type -> mirai.v1.ListOutdatedLessonsResponse
+	65, // 110: mirai.v1.SMEService.DeleteTask:output_type -> mirai.v1.DeleteTaskResponse
+	84, // [84:111] is the sub-list for method output_type
+	57, // [57:84] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_sme_proto_init() }
@@ -3812,20 +4677,23 @@ func file_mirai_v1_sme_proto_init() {
 	file_mirai_v1_sme_proto_msgTypes[1].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[2].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[3].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[8].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[4].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[10].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[16].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[20].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[12].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[18].OneofWrappers = []any{}
 	file_mirai_v1_sme_proto_msgTypes[22].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[28].OneofWrappers = []any{}
-	file_mirai_v1_sme_proto_msgTypes[44].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[24].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[30].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[46].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[48].OneofWrappers = []any{}
+	file_mirai_v1_sme_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_sme_proto_rawDesc), len(file_mirai_v1_sme_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   50,
+			NumEnums:      6,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,8 +26,11 @@ const (
 type AIProvider int32
 
 const (
-	AIProvider_AI_PROVIDER_UNSPECIFIED AIProvider = 0
-	AIProvider_AI_PROVIDER_GEMINI      AIProvider = 1
+	AIProvider_AI_PROVIDER_UNSPECIFIED       AIProvider = 0
+	AIProvider_AI_PROVIDER_GEMINI            AIProvider = 1
+	AIProvider_AI_PROVIDER_OPENAI            AIProvider = 2
+	AIProvider_AI_PROVIDER_ANTHROPIC         AIProvider = 3
+	AIProvider_AI_PROVIDER_OPENAI_COMPATIBLE AIProvider = 4 // Self-hosted server with an OpenAI-compatible API (Ollama, vLLM)
 )
 
 // Enum value maps for AIProvider.
@@ -35,10 +38,16 @@ var (
 	AIProvider_name = map[int32]string{
 		0: "AI_PROVIDER_UNSPECIFIED",
 		1: "AI_PROVIDER_GEMINI",
+		2: "AI_PROVIDER_OPENAI",
+		3: "AI_PROVIDER_ANTHROPIC",
+		4: "AI_PROVIDER_OPENAI_COMPATIBLE",
 	}
 	AIProvider_value = map[string]int32{
-		"AI_PROVIDER_UNSPECIFIED": 0,
-		"AI_PROVIDER_GEMINI":      1,
+		"AI_PROVIDER_UNSPECIFIED":       0,
+		"AI_PROVIDER_GEMINI":            1,
+		"AI_PROVIDER_OPENAI":            2,
+		"AI_PROVIDER_ANTHROPIC":         3,
+		"AI_PROVIDER_OPENAI_COMPATIBLE": 4,
 	}
 )
 
@@ -81,8 +90,13 @@ type TenantAISettings struct {
 	MonthlyTokenLimit *int64                 `protobuf:"varint,5,opt,name=monthly_token_limit,json=monthlyTokenLimit,proto3,oneof" json:"monthly_token_limit,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedByUserId   *string                `protobuf:"bytes,7,opt,name=updated_by_user_id,json=updatedByUserId,proto3,oneof" json:"updated_by_user_id,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Self-hosted provider connection (AI_PROVIDER_OPENAI_COMPATIBLE only)
+	BaseUrl *string `protobuf:"bytes,8,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	Model   *string `protobuf:"bytes,9,opt,name=model,proto3,oneof" json:"model,omitempty"`
+	// False when the provider has no embeddings API; SME knowledge is then searched by keywords only
+	SemanticSearchAvailable bool `protobuf:"varint,10,opt,name=semantic_search_available,json=semanticSearchAvailable,proto3" json:"semantic_search_available,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *TenantAISettings) Reset() {
@@ -164,6 +178,113 @@ func (x *TenantAISettings) GetUpdatedByUserId() string {
 	return ""
 }
 
+func (x *TenantAISettings) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
+	}
+	return ""
+}
+
+func (x *TenantAISettings) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
+func (x *TenantAISettings) GetSemanticSearchAvailable() bool {
+	if x != nil {
+		return x.SemanticSearchAvailable
+	}
+	return false
+}
+
+// TenantLRSSettings contains the xAPI Learning Record Store used by cmi5 exports.
+// Only ADMIN/OWNER roles can access these settings.
+type TenantLRSSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	Endpoint         string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                                          // xAPI endpoint, e.g. https://lrs.example.com/xapi/
+	Key              string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`                                                    // Basic auth key (username)
+	SecretConfigured bool                   `protobuf:"varint,4,opt,name=secret_configured,json=secretConfigured,proto3" json:"secret_configured,omitempty"` // True if secret is set (never expose actual secret)
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedByUserId  *string                `protobuf:"bytes,6,opt,name=updated_by_user_id,json=updatedByUserId,proto3,oneof" json:"updated_by_user_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TenantLRSSettings) Reset() {
+	*x = TenantLRSSettings{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantLRSSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantLRSSettings) ProtoMessage() {}
+
+func (x *TenantLRSSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantLRSSettings.ProtoReflect.Descriptor instead.
+func (*TenantLRSSettings) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantLRSSettings) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *TenantLRSSettings) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *TenantLRSSettings) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TenantLRSSettings) GetSecretConfigured() bool {
+	if x != nil {
+		return x.SecretConfigured
+	}
+	return false
+}
+
+func (x *TenantLRSSettings) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TenantLRSSettings) GetUpdatedByUserId() string {
+	if x != nil && x.UpdatedByUserId != nil {
+		return *x.UpdatedByUserId
+	}
+	return ""
+}
+
 // GetAISettingsRequest is empty as tenant is from auth context.
 type GetAISettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -173,7 +294,7 @@ type GetAISettingsRequest struct {
 
 func (x *GetAISettingsRequest) Reset() {
 	*x = GetAISettingsRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -185,7 +306,7 @@ func (x *GetAISettingsRequest) String() string {
 func (*GetAISettingsRequest) ProtoMessage() {}
 
 func (x *GetAISettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[1]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,7 +319,7 @@ func (x *GetAISettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAISettingsRequest.ProtoReflect.Descriptor instead.
 func (*GetAISettingsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{1}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{2}
 }
 
 // GetAISettingsResponse contains the AI settings.
@@ -211,7 +332,7 @@ type GetAISettingsResponse struct {
 
 func (x *GetAISettingsResponse) Reset() {
 	*x = GetAISettingsResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -223,7 +344,7 @@ func (x *GetAISettingsResponse) String() string {
 func (*GetAISettingsResponse) ProtoMessage() {}
 
 func (x *GetAISettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[2]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -236,7 +357,7 @@ func (x *GetAISettingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAISettingsResponse.ProtoReflect.Descriptor instead.
 func (*GetAISettingsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{2}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetAISettingsResponse) GetSettings() *TenantAISettings {
@@ -250,14 +371,16 @@ func (x *GetAISettingsResponse) GetSettings() *TenantAISettings {
 type SetAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Provider      AIProvider             `protobuf:"varint,1,opt,name=provider,proto3,enum=mirai.v1.AIProvider" json:"provider,omitempty"`
-	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"` // Plain text, will be encrypted server-side
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`          // Plain text, will be encrypted server-side; optional for self-hosted
+	BaseUrl       *string                `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"` // Required for AI_PROVIDER_OPENAI_COMPATIBLE
+	Model         *string                `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`                    // Required for AI_PROVIDER_OPENAI_COMPATIBLE
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetAPIKeyRequest) Reset() {
 	*x = SetAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -269,7 +392,7 @@ func (x *SetAPIKeyRequest) String() string {
 func (*SetAPIKeyRequest) ProtoMessage() {}
 
 func (x *SetAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[3]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -282,7 +405,7 @@ func (x *SetAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*SetAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{3}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SetAPIKeyRequest) GetProvider() AIProvider {
@@ -299,6 +422,20 @@ func (x *SetAPIKeyRequest) GetApiKey() string {
 	return ""
 }
 
+func (x *SetAPIKeyRequest) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
+	}
+	return ""
+}
+
+func (x *SetAPIKeyRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
 // SetAPIKeyResponse confirms the key was set.
 type SetAPIKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -309,7 +446,7 @@ type SetAPIKeyResponse struct {
 
 func (x *SetAPIKeyResponse) Reset() {
 	*x = SetAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -321,7 +458,7 @@ func (x *SetAPIKeyResponse) String() string {
 func (*SetAPIKeyResponse) ProtoMessage() {}
 
 func (x *SetAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[4]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -334,7 +471,7 @@ func (x *SetAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*SetAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{4}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SetAPIKeyResponse) GetSettings() *TenantAISettings {
@@ -353,7 +490,7 @@ type RemoveAPIKeyRequest struct {
 
 func (x *RemoveAPIKeyRequest) Reset() {
 	*x = RemoveAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -365,7 +502,7 @@ func (x *RemoveAPIKeyRequest) String() string {
 func (*RemoveAPIKeyRequest) ProtoMessage() {}
 
 func (x *RemoveAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[5]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -378,7 +515,7 @@ func (x *RemoveAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*RemoveAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{5}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{6}
 }
 
 // RemoveAPIKeyResponse confirms removal.
@@ -391,7 +528,7 @@ type RemoveAPIKeyResponse struct {
 
 func (x *RemoveAPIKeyResponse) Reset() {
 	*x = RemoveAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +540,7 @@ func (x *RemoveAPIKeyResponse) String() string {
 func (*RemoveAPIKeyResponse) ProtoMessage() {}
 
 func (x *RemoveAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[6]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +553,7 @@ func (x *RemoveAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*RemoveAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{6}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *RemoveAPIKeyResponse) GetSettings() *TenantAISettings {
@@ -427,17 +564,20 @@ func (x *RemoveAPIKeyResponse) GetSettings() *TenantAISettings {
 }
 
 // TestAPIKeyRequest tests an API key without saving.
+// If api_key and base_url are empty, the stored configuration is tested.
 type TestAPIKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Provider      AIProvider             `protobuf:"varint,1,opt,name=provider,proto3,enum=mirai.v1.AIProvider" json:"provider,omitempty"`
 	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	BaseUrl       *string                `protobuf:"bytes,3,opt,name=base_url,json=baseUrl,proto3,oneof" json:"base_url,omitempty"`
+	Model         *string                `protobuf:"bytes,4,opt,name=model,proto3,oneof" json:"model,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TestAPIKeyRequest) Reset() {
 	*x = TestAPIKeyRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +589,7 @@ func (x *TestAPIKeyRequest) String() string {
 func (*TestAPIKeyRequest) ProtoMessage() {}
 
 func (x *TestAPIKeyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[7]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +602,7 @@ func (x *TestAPIKeyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAPIKeyRequest.ProtoReflect.Descriptor instead.
 func (*TestAPIKeyRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{7}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TestAPIKeyRequest) GetProvider() AIProvider {
@@ -479,6 +619,20 @@ func (x *TestAPIKeyRequest) GetApiKey() string {
 	return ""
 }
 
+func (x *TestAPIKeyRequest) GetBaseUrl() string {
+	if x != nil && x.BaseUrl != nil {
+		return *x.BaseUrl
+	}
+	return ""
+}
+
+func (x *TestAPIKeyRequest) GetModel() string {
+	if x != nil && x.Model != nil {
+		return *x.Model
+	}
+	return ""
+}
+
 // TestAPIKeyResponse indicates if the key is valid.
 type TestAPIKeyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -490,7 +644,7 @@ type TestAPIKeyResponse struct {
 
 func (x *TestAPIKeyResponse) Reset() {
 	*x = TestAPIKeyResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -502,7 +656,7 @@ func (x *TestAPIKeyResponse) String() string {
 func (*TestAPIKeyResponse) ProtoMessage() {}
 
 func (x *TestAPIKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[8]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -515,7 +669,7 @@ func (x *TestAPIKeyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TestAPIKeyResponse.ProtoReflect.Descriptor instead.
 func (*TestAPIKeyResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{8}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TestAPIKeyResponse) GetValid() bool {
@@ -543,7 +697,7 @@ type GetUsageStatsRequest struct {
 
 func (x *GetUsageStatsRequest) Reset() {
 	*x = GetUsageStatsRequest{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -555,7 +709,7 @@ func (x *GetUsageStatsRequest) String() string {
 func (*GetUsageStatsRequest) ProtoMessage() {}
 
 func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[9]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -568,7 +722,7 @@ func (x *GetUsageStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsRequest) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{9}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetUsageStatsRequest) GetFromDate() *timestamppb.Timestamp {
@@ -597,7 +751,7 @@ type UsageByType struct {
 
 func (x *UsageByType) Reset() {
 	*x = UsageByType{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +763,7 @@ func (x *UsageByType) String() string {
 func (*UsageByType) ProtoMessage() {}
 
 func (x *UsageByType) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[10]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +776,7 @@ func (x *UsageByType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsageByType.ProtoReflect.Descriptor instead.
 func (*UsageByType) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{10}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UsageByType) GetJobType() string {
@@ -646,20 +800,76 @@ func (x *UsageByType) GetJobCount() int32 {
 	return 0
 }
 
+// DailyUsage is the tokens used on one day (UTC).
+type DailyUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TokensUsed    int64                  `protobuf:"varint,2,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyUsage) Reset() {
+	*x = DailyUsage{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyUsage) ProtoMessage() {}
+
+func (x *DailyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyUsage.ProtoReflect.Descriptor instead.
+func (*DailyUsage) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DailyUsage) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *DailyUsage) GetTokensUsed() int64 {
+	if x != nil {
+		return x.TokensUsed
+	}
+	return 0
+}
+
 // GetUsageStatsResponse contains usage statistics.
+// Monthly figures cover the current calendar month (UTC).
 type GetUsageStatsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	TotalTokensUsed int64                  `protobuf:"varint,1,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
-	TokensThisMonth int64                  `protobuf:"varint,2,opt,name=tokens_this_month,json=tokensThisMonth,proto3" json:"tokens_this_month,omitempty"`
-	MonthlyLimit    *int64                 `protobuf:"varint,3,opt,name=monthly_limit,json=monthlyLimit,proto3,oneof" json:"monthly_limit,omitempty"`
-	UsageByType     []*UsageByType         `protobuf:"bytes,4,rep,name=usage_by_type,json=usageByType,proto3" json:"usage_by_type,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	TotalTokensUsed         int64                  `protobuf:"varint,1,opt,name=total_tokens_used,json=totalTokensUsed,proto3" json:"total_tokens_used,omitempty"`
+	TokensThisMonth         int64                  `protobuf:"varint,2,opt,name=tokens_this_month,json=tokensThisMonth,proto3" json:"tokens_this_month,omitempty"`
+	MonthlyLimit            *int64                 `protobuf:"varint,3,opt,name=monthly_limit,json=monthlyLimit,proto3,oneof" json:"monthly_limit,omitempty"`
+	UsageByType             []*UsageByType         `protobuf:"bytes,4,rep,name=usage_by_type,json=usageByType,proto3" json:"usage_by_type,omitempty"`                                        // This month, by job type
+	DailyUsage              []*DailyUsage          `protobuf:"bytes,5,rep,name=daily_usage,json=dailyUsage,proto3" json:"daily_usage,omitempty"`                                             // This month, days without usage omitted
+	ProjectedMonthEndTokens int64                  `protobuf:"varint,6,opt,name=projected_month_end_tokens,json=projectedMonthEndTokens,proto3" json:"projected_month_end_tokens,omitempty"` // Month-to-date usage extrapolated to month end
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
 func (x *GetUsageStatsResponse) Reset() {
 	*x = GetUsageStatsResponse{}
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +881,7 @@ func (x *GetUsageStatsResponse) String() string {
 func (*GetUsageStatsResponse) ProtoMessage() {}
 
 func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[11]
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +894,7 @@ func (x *GetUsageStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUsageStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetUsageStatsResponse) Descriptor() ([]byte, []int) {
-	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{11}
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUsageStatsResponse) GetTotalTokensUsed() int64 {
@@ -715,11 +925,402 @@ func (x *GetUsageStatsResponse) GetUsageByType() []*UsageByType {
 	return nil
 }
 
+func (x *GetUsageStatsResponse) GetDailyUsage() []*DailyUsage {
+	if x != nil {
+		return x.DailyUsage
+	}
+	return nil
+}
+
+func (x *GetUsageStatsResponse) GetProjectedMonthEndTokens() int64 {
+	if x != nil {
+		return x.ProjectedMonthEndTokens
+	}
+	return 0
+}
+
+// GetLRSSettingsRequest is empty as tenant is from auth context.
+type GetLRSSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLRSSettingsRequest) Reset() {
+	*x = GetLRSSettingsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLRSSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLRSSettingsRequest) ProtoMessage() {}
+
+func (x *GetLRSSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLRSSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetLRSSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{14}
+}
+
+// GetLRSSettingsResponse contains the LRS settings.
+type GetLRSSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantLRSSettings     `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLRSSettingsResponse) Reset() {
+	*x = GetLRSSettingsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLRSSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLRSSettingsResponse) ProtoMessage() {}
+
+func (x *GetLRSSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLRSSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetLRSSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetLRSSettingsResponse) GetSettings() *TenantLRSSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// SetLRSSettingsRequest contains the LRS endpoint and credentials to set.
+type SetLRSSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"` // Plain text, encrypted server-side; empty keeps the current secret
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLRSSettingsRequest) Reset() {
+	*x = SetLRSSettingsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLRSSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLRSSettingsRequest) ProtoMessage() {}
+
+func (x *SetLRSSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLRSSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetLRSSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetLRSSettingsRequest) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SetLRSSettingsRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetLRSSettingsRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// SetLRSSettingsResponse confirms the settings were saved.
+type SetLRSSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *TenantLRSSettings     `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLRSSettingsResponse) Reset() {
+	*x = SetLRSSettingsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLRSSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLRSSettingsResponse) ProtoMessage() {}
+
+func (x *SetLRSSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLRSSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetLRSSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetLRSSettingsResponse) GetSettings() *TenantLRSSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// RemoveLRSSettingsRequest removes the LRS configuration.
+type RemoveLRSSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLRSSettingsRequest) Reset() {
+	*x = RemoveLRSSettingsRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLRSSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLRSSettingsRequest) ProtoMessage() {}
+
+func (x *RemoveLRSSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLRSSettingsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveLRSSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{18}
+}
+
+// RemoveLRSSettingsResponse confirms removal.
+type RemoveLRSSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveLRSSettingsResponse) Reset() {
+	*x = RemoveLRSSettingsResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLRSSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLRSSettingsResponse) ProtoMessage() {}
+
+func (x *RemoveLRSSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLRSSettingsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveLRSSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{19}
+}
+
+// TestLRSConnectionRequest tests LRS settings without saving.
+// When endpoint is omitted the stored settings are tested.
+type TestLRSConnectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint      *string                `protobuf:"bytes,1,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	Key           *string                `protobuf:"bytes,2,opt,name=key,proto3,oneof" json:"key,omitempty"`
+	Secret        *string                `protobuf:"bytes,3,opt,name=secret,proto3,oneof" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestLRSConnectionRequest) Reset() {
+	*x = TestLRSConnectionRequest{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestLRSConnectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestLRSConnectionRequest) ProtoMessage() {}
+
+func (x *TestLRSConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestLRSConnectionRequest.ProtoReflect.Descriptor instead.
+func (*TestLRSConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TestLRSConnectionRequest) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+func (x *TestLRSConnectionRequest) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *TestLRSConnectionRequest) GetSecret() string {
+	if x != nil && x.Secret != nil {
+		return *x.Secret
+	}
+	return ""
+}
+
+// TestLRSConnectionResponse indicates if the LRS accepted the connection.
+type TestLRSConnectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	ErrorMessage  *string                `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestLRSConnectionResponse) Reset() {
+	*x = TestLRSConnectionResponse{}
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestLRSConnectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestLRSConnectionResponse) ProtoMessage() {}
+
+func (x *TestLRSConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mirai_v1_tenant_settings_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestLRSConnectionResponse.ProtoReflect.Descriptor instead.
+func (*TestLRSConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_mirai_v1_tenant_settings_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TestLRSConnectionResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *TestLRSConnectionResponse) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
 var File_mirai_v1_tenant_settings_proto protoreflect.FileDescriptor
 
 const file_mirai_v1_tenant_settings_proto_rawDesc = "" +
 	"\n" +
-	"\x1emirai/v1/tenant_settings.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8c\x03\n" +
+	"\x1emirai/v1/tenant_settings.proto\x12\bmirai.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\x04\n" +
 	"\x10TenantAISettings\x12\x1b\n" +
 	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x120\n" +
 	"\bprovider\x18\x02 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12,\n" +
@@ -728,23 +1329,46 @@ const file_mirai_v1_tenant_settings_proto_rawDesc = "" +
 	"\x13monthly_token_limit\x18\x05 \x01(\x03H\x00R\x11monthlyTokenLimit\x88\x01\x01\x129\n" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
-	"\x12updated_by_user_id\x18\a \x01(\tH\x01R\x0fupdatedByUserId\x88\x01\x01B\x16\n" +
+	"\x12updated_by_user_id\x18\a \x01(\tH\x01R\x0fupdatedByUserId\x88\x01\x01\x12\x1e\n" +
+	"\bbase_url\x18\b \x01(\tH\x02R\abaseUrl\x88\x01\x01\x12\x19\n" +
+	"\x05model\x18\t \x01(\tH\x03R\x05model\x88\x01\x01\x12:\n" +
+	"\x19semantic_search_available\x18\n" +
+	" \x01(\bR\x17semanticSearchAvailableB\x16\n" +
 	"\x14_monthly_token_limitB\x15\n" +
+	"\x13_updated_by_user_idB\v\n" +
+	"\t_base_urlB\b\n" +
+	"\x06_model\"\x8f\x02\n" +
+	"\x11TenantLRSSettings\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\tR\x03key\x12+\n" +
+	"\x11secret_configured\x18\x04 \x01(\bR\x10secretConfigured\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x12updated_by_user_id\x18\x06 \x01(\tH\x00R\x0fupdatedByUserId\x88\x01\x01B\x15\n" +
 	"\x13_updated_by_user_id\"\x16\n" +
 	"\x14GetAISettingsRequest\"O\n" +
 	"\x15GetAISettingsResponse\x126\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"]\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\xaf\x01\n" +
 	"\x10SetAPIKeyRequest\x120\n" +
 	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"K\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x1e\n" +
+	"\bbase_url\x18\x03 \x01(\tH\x00R\abaseUrl\x88\x01\x01\x12\x19\n" +
+	"\x05model\x18\x04 \x01(\tH\x01R\x05model\x88\x01\x01B\v\n" +
+	"\t_base_urlB\b\n" +
+	"\x06_model\"K\n" +
 	"\x11SetAPIKeyResponse\x126\n" +
 	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\x15\n" +
 	"\x13RemoveAPIKeyRequest\"N\n" +
 	"\x14RemoveAPIKeyResponse\x126\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"^\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.mirai.v1.TenantAISettingsR\bsettings\"\xb0\x01\n" +
 	"\x11TestAPIKeyRequest\x120\n" +
 	"\bprovider\x18\x01 \x01(\x0e2\x14.mirai.v1.AIProviderR\bprovider\x12\x17\n" +
-	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\"f\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x1e\n" +
+	"\bbase_url\x18\x03 \x01(\tH\x00R\abaseUrl\x88\x01\x01\x12\x19\n" +
+	"\x05model\x18\x04 \x01(\tH\x01R\x05model\x88\x01\x01B\v\n" +
+	"\t_base_urlB\b\n" +
+	"\x06_model\"f\n" +
 	"\x12TestAPIKeyResponse\x12\x14\n" +
 	"\x05valid\x18\x01 \x01(\bR\x05valid\x12(\n" +
 	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
@@ -760,24 +1384,61 @@ const file_mirai_v1_tenant_settings_proto_rawDesc = "" +
 	"\bjob_type\x18\x01 \x01(\tR\ajobType\x12\x1f\n" +
 	"\vtokens_used\x18\x02 \x01(\x03R\n" +
 	"tokensUsed\x12\x1b\n" +
-	"\tjob_count\x18\x03 \x01(\x05R\bjobCount\"\xe6\x01\n" +
+	"\tjob_count\x18\x03 \x01(\x05R\bjobCount\"]\n" +
+	"\n" +
+	"DailyUsage\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1f\n" +
+	"\vtokens_used\x18\x02 \x01(\x03R\n" +
+	"tokensUsed\"\xda\x02\n" +
 	"\x15GetUsageStatsResponse\x12*\n" +
 	"\x11total_tokens_used\x18\x01 \x01(\x03R\x0ftotalTokensUsed\x12*\n" +
 	"\x11tokens_this_month\x18\x02 \x01(\x03R\x0ftokensThisMonth\x12(\n" +
 	"\rmonthly_limit\x18\x03 \x01(\x03H\x00R\fmonthlyLimit\x88\x01\x01\x129\n" +
-	"\rusage_by_type\x18\x04 \x03(\v2\x15.mirai.v1.UsageByTypeR\vusageByTypeB\x10\n" +
-	"\x0e_monthly_limit*A\n" +
+	"\rusage_by_type\x18\x04 \x03(\v2\x15.mirai.v1.UsageByTypeR\vusageByType\x125\n" +
+	"\vdaily_usage\x18\x05 \x03(\v2\x14.mirai.v1.DailyUsageR\n" +
+	"dailyUsage\x12;\n" +
+	"\x1aprojected_month_end_tokens\x18\x06 \x01(\x03R\x17projectedMonthEndTokensB\x10\n" +
+	"\x0e_monthly_limit\"\x17\n" +
+	"\x15GetLRSSettingsRequest\"Q\n" +
+	"\x16GetLRSSettingsResponse\x127\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.mirai.v1.TenantLRSSettingsR\bsettings\"]\n" +
+	"\x15SetLRSSettingsRequest\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"Q\n" +
+	"\x16SetLRSSettingsResponse\x127\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.mirai.v1.TenantLRSSettingsR\bsettings\"\x1a\n" +
+	"\x18RemoveLRSSettingsRequest\"\x1b\n" +
+	"\x19RemoveLRSSettingsResponse\"\x8f\x01\n" +
+	"\x18TestLRSConnectionRequest\x12\x1f\n" +
+	"\bendpoint\x18\x01 \x01(\tH\x00R\bendpoint\x88\x01\x01\x12\x15\n" +
+	"\x03key\x18\x02 \x01(\tH\x01R\x03key\x88\x01\x01\x12\x1b\n" +
+	"\x06secret\x18\x03 \x01(\tH\x02R\x06secret\x88\x01\x01B\v\n" +
+	"\t_endpointB\x06\n" +
+	"\x04_keyB\t\n" +
+	"\a_secret\"m\n" +
+	"\x19TestLRSConnectionResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12(\n" +
+	"\rerror_message\x18\x02 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\x0e_error_message*\x97\x01\n" +
 	"\n" +
 	"AIProvider\x12\x1b\n" +
 	"\x17AI_PROVIDER_UNSPECIFIED\x10\x00\x12\x16\n" +
-	"\x12AI_PROVIDER_GEMINI\x10\x012\x99\x03\n" +
+	"\x12AI_PROVIDER_GEMINI\x10\x01\x12\x16\n" +
+	"\x12AI_PROVIDER_OPENAI\x10\x02\x12\x19\n" +
+	"\x15AI_PROVIDER_ANTHROPIC\x10\x03\x12!\n" +
+	"\x1dAI_PROVIDER_OPENAI_COMPATIBLE\x10\x042\xff\x05\n" +
 	"\x15TenantSettingsService\x12P\n" +
 	"\rGetAISettings\x12\x1e.mirai.v1.GetAISettingsRequest\x1a\x1f.mirai.v1.GetAISettingsResponse\x12D\n" +
 	"\tSetAPIKey\x12\x1a.mirai.v1.SetAPIKeyRequest\x1a\x1b.mirai.v1.SetAPIKeyResponse\x12M\n" +
 	"\fRemoveAPIKey\x12\x1d.mirai.v1.RemoveAPIKeyRequest\x1a\x1e.mirai.v1.RemoveAPIKeyResponse\x12G\n" +
 	"\n" +
 	"TestAPIKey\x12\x1b.mirai.v1.TestAPIKeyRequest\x1a\x1c.mirai.v1.TestAPIKeyResponse\x12P\n" +
-	"\rGetUsageStats\x12\x1e.mirai.v1.GetUsageStatsRequest\x1a\x1f.mirai.v1.GetUsageStatsResponseB\x99\x01\n" +
+	"\rGetUsageStats\x12\x1e.mirai.v1.GetUsageStatsRequest\x1a\x1f.mirai.v1.GetUsageStatsResponse\x12S\n" +
+	"\x0eGetLRSSettings\x12\x1f.mirai.v1.GetLRSSettingsRequest\x1a .mirai.v1.GetLRSSettingsResponse\x12S\n" +
+	"\x0eSetLRSSettings\x12\x1f.mirai.v1.SetLRSSettingsRequest\x1a .mirai.v1.SetLRSSettingsResponse\x12\\\n" +
+	"\x11RemoveLRSSettings\x12\".mirai.v1.RemoveLRSSettingsRequest\x1a#.mirai.v1.RemoveLRSSettingsResponse\x12\\\n" +
+	"\x11TestLRSConnection\x12\".mirai.v1.TestLRSConnectionRequest\x1a#.mirai.v1.TestLRSConnectionResponseB\x99\x01\n" +
 	"\fcom.mirai.v1B\x13TenantSettingsProtoP\x01Z3github.com/sogos/mirai-backend/gen/mirai/v1;miraiv1\xa2\x02\x03MXX\xaa\x02\bMirai.V1\xca\x02\bMirai\\V1\xe2\x02\x14Mirai\\V1\\GPBMetadata\xea\x02\tMirai::V1b\x06proto3"
 
 var (
@@ -793,49 +1454,72 @@ func file_mirai_v1_tenant_settings_proto_rawDescGZIP() []byte {
 }
 
 var file_mirai_v1_tenant_settings_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mirai_v1_tenant_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_mirai_v1_tenant_settings_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_mirai_v1_tenant_settings_proto_goTypes = []any{
-	(AIProvider)(0),               // 0: mirai.v1.AIProvider
-	(*TenantAISettings)(nil),      // 1: mirai.v1.TenantAISettings
-	(*GetAISettingsRequest)(nil),  // 2: mirai.v1.GetAISettingsRequest
-	(*GetAISettingsResponse)(nil), // 3: mirai.v1.GetAISettingsResponse
-	(*SetAPIKeyRequest)(nil),      // 4: mirai.v1.SetAPIKeyRequest
-	(*SetAPIKeyResponse)(nil),     // 5: mirai.v1.SetAPIKeyResponse
-	(*RemoveAPIKeyRequest)(nil),   // 6: mirai.v1.RemoveAPIKeyRequest
-	(*RemoveAPIKeyResponse)(nil),  // 7: mirai.v1.RemoveAPIKeyResponse
-	(*TestAPIKeyRequest)(nil),     // 8: mirai.v1.TestAPIKeyRequest
-	(*TestAPIKeyResponse)(nil),    // 9: mirai.v1.TestAPIKeyResponse
-	(*GetUsageStatsRequest)(nil),  // 10: mirai.v1.GetUsageStatsRequest
-	(*UsageByType)(nil),           // 11: mirai.v1.UsageByType
-	(*GetUsageStatsResponse)(nil), // 12: mirai.v1.GetUsageStatsResponse
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(AIProvider)(0),                   // 0: mirai.v1.AIProvider
+	(*TenantAISettings)(nil),          // 1: mirai.v1.TenantAISettings
+	(*TenantLRSSettings)(nil),         // 2: mirai.v1.TenantLRSSettings
+	(*GetAISettingsRequest)(nil),      // 3: mirai.v1.GetAISettingsRequest
+	(*GetAISettingsResponse)(nil),     // 4: mirai.v1.GetAISettingsResponse
+	(*SetAPIKeyRequest)(nil),          // 5: mirai.v1.SetAPIKeyRequest
+	(*SetAPIKeyResponse)(nil),         // 6: mirai.v1.SetAPIKeyResponse
+	(*RemoveAPIKeyRequest)(nil),       // 7: mirai.v1.RemoveAPIKeyRequest
+	(*RemoveAPIKeyResponse)(nil),      // 8: mirai.v1.RemoveAPIKeyResponse
+	(*TestAPIKeyRequest)(nil),         // 9: mirai.v1.TestAPIKeyRequest
+	(*TestAPIKeyResponse)(nil),        // 10: mirai.v1.TestAPIKeyResponse
+	(*GetUsageStatsRequest)(nil),      // 11: mirai.v1.GetUsageStatsRequest
+	(*UsageByType)(nil),               // 12: mirai.v1.UsageByType
+	(*DailyUsage)(nil),                // 13: mirai.v1.DailyUsage
+	(*GetUsageStatsResponse)(nil),     // 14: mirai.v1.GetUsageStatsResponse
+	(*GetLRSSettingsRequest)(nil),     // 15: mirai.v1.GetLRSSettingsRequest
+	(*GetLRSSettingsResponse)(nil),    // 16: mirai.v1.GetLRSSettingsResponse
+	(*SetLRSSettingsRequest)(nil),     // 17: mirai.v1.SetLRSSettingsRequest
+	(*SetLRSSettingsResponse)(nil),    // 18: mirai.v1.SetLRSSettingsResponse
+	(*RemoveLRSSettingsRequest)(nil),  // 19: mirai.v1.RemoveLRSSettingsRequest
+	(*RemoveLRSSettingsResponse)(nil), // 20: mirai.v1.RemoveLRSSettingsResponse
+	(*TestLRSConnectionRequest)(nil),  // 21: mirai.v1.TestLRSConnectionRequest
+	(*TestLRSConnectionResponse)(nil), // 22: mirai.v1.TestLRSConnectionResponse
+	(*timestamppb.Timestamp)(nil),     // 23: google.protobuf.Timestamp
 }
 var file_mirai_v1_tenant_settings_proto_depIdxs = []int32{
 	0,  // 0: mirai.v1.TenantAISettings.provider:type_name -> mirai.v1.AIProvider
-	13, // 1: mirai.v1.TenantAISettings.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 2: mirai.v1.GetAISettingsResponse.settings:type_name -> mirai.v1.TenantAISettings
-	0,  // 3: mirai.v1.SetAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
-	1,  // 4: mirai.v1.SetAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
-	1,  // 5: mirai.v1.RemoveAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
-	0,  // 6: mirai.v1.TestAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
-	13, // 7: mirai.v1.GetUsageStatsRequest.from_date:type_name -> google.protobuf.Timestamp
-	13, // 8: mirai.v1.GetUsageStatsRequest.to_date:type_name -> google.protobuf.Timestamp
-	11, // 9: mirai.v1.GetUsageStatsResponse.usage_by_type:type_name -> mirai.v1.UsageByType
-	2,  // 10: mirai.v1.TenantSettingsService.GetAISettings:input_type -> mirai.v1.GetAISettingsRequest
-	4,  // 11: mirai.v1.TenantSettingsService.SetAPIKey:input_type -> mirai.v1.SetAPIKeyRequest
-	6,  // 12: mirai.v1.TenantSettingsService.RemoveAPIKey:input_type -> mirai.v1.RemoveAPIKeyRequest
-	8,  // 13: mirai.v1.TenantSettingsService.TestAPIKey:input_type -> mirai.v1.TestAPIKeyRequest
-	10, // 14: mirai.v1.TenantSettingsService.GetUsageStats:input_type -> mirai.v1.GetUsageStatsRequest
-	3,  // 15: mirai.v1.TenantSettingsService.GetAISettings:output_type -> mirai.v1.GetAISettingsResponse
-	5,  // 16: mirai.v1.TenantSettingsService.SetAPIKey:output_type -> mirai.v1.SetAPIKeyResponse
-	7,  // 17: mirai.v1.TenantSettingsService.RemoveAPIKey:output_type -> mirai.v1.RemoveAPIKeyResponse
-	9,  // 18: mirai.v1.TenantSettingsService.TestAPIKey:output_type -> mirai.v1.TestAPIKeyResponse
-	12, // 19: mirai.v1.TenantSettingsService.GetUsageStats:output_type -> mirai.v1.GetUsageStatsResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	23, // 1: mirai.v1.TenantAISettings.updated_at:type_name -> google.protobuf.Timestamp
+	23, // 2: mirai.v1.TenantLRSSettings.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 3: mirai.v1.GetAISettingsResponse.settings:type_name -> mirai.v1.TenantAISettings
+	0,  // 4: mirai.v1.SetAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
+	1,  // 5: mirai.v1.SetAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
+	1,  // 6: mirai.v1.RemoveAPIKeyResponse.settings:type_name -> mirai.v1.TenantAISettings
+	0,  // 7: mirai.v1.TestAPIKeyRequest.provider:type_name -> mirai.v1.AIProvider
+	23, // 8: mirai.v1.GetUsageStatsRequest.from_date:type_name -> google.protobuf.Timestamp
+	23, // 9: mirai.v1.GetUsageStatsRequest.to_date:type_name -> google.protobuf.Timestamp
+	23, // 10: mirai.v1.DailyUsage.date:type_name -> google.protobuf.Timestamp
+	12, // 11: mirai.v1.GetUsageStatsResponse.usage_by_type:type_name -> mirai.v1.UsageByType
+	13, // 12: mirai.v1.GetUsageStatsResponse.daily_usage:type_name -> mirai.v1.DailyUsage
+	2,  // 13: mirai.v1.GetLRSSettingsResponse.settings:type_name -> mirai.v1.TenantLRSSettings
+	2,  // 14: mirai.v1.SetLRSSettingsResponse.settings:type_name -> mirai.v1.TenantLRSSettings
+	3,  // 15: mirai.v1.TenantSettingsService.GetAISettings:input_type -> mirai.v1.GetAISettingsRequest
+	5,  // 16: mirai.v1.TenantSettingsService.SetAPIKey:input_type -> mirai.v1.SetAPIKeyRequest
+	7,  // 17: mirai.v1.TenantSettingsService.RemoveAPIKey:input_type -> mirai.v1.RemoveAPIKeyRequest
+	9,  // 18: mirai.v1.TenantSettingsService.TestAPIKey:input_type -> mirai.v1.TestAPIKeyRequest
+	11, // 19: mirai.v1.TenantSettingsService.GetUsageStats:input_type -> mirai.v1.GetUsageStatsRequest
+	15, // 20: mirai.v1.TenantSettingsService.GetLRSSettings:input_type -> mirai.v1.GetLRSSettingsRequest
+	17, // 21: mirai.v1.TenantSettingsService.SetLRSSettings:input_type -> mirai.v1.SetLRSSettingsRequest
+	19, // 22: mirai.v1.TenantSettingsService.RemoveLRSSettings:input_type -> mirai.v1.RemoveLRSSettingsRequest
+	21, // 23: mirai.v1.TenantSettingsService.TestLRSConnection:input_type -> mirai.v1.TestLRSConnectionRequest
+	4,  // 24: mirai.v1.TenantSettingsService.GetAISettings:output_type -> mirai.v1.GetAISettingsResponse
+	6,  // 25: mirai.v1.TenantSettingsService.SetAPIKey:output_type -> mirai.v1.SetAPIKeyResponse
+	8,  // 26: mirai.v1.TenantSettingsService.RemoveAPIKey:output_type -> mirai.v1.RemoveAPIKeyResponse
+	10, // 27: mirai.v1.TenantSettingsService.TestAPIKey:output_type -> mirai.v1.TestAPIKeyResponse
+	14, // 28: mirai.v1.TenantSettingsService.GetUsageStats:output_type -> mirai.v1.GetUsageStatsResponse
+	16, // 29: mirai.v1.TenantSettingsService.GetLRSSettings:output_type -> mirai.v1.GetLRSSettingsResponse
+	18, // 30: mirai.v1.TenantSettingsService.SetLRSSettings:output_type -> mirai.v1.SetLRSSettingsResponse
+	20, // 31: mirai.v1.TenantSettingsService.RemoveLRSSettings:output_type -> mirai.v1.RemoveLRSSettingsResponse
+	22, // 32: mirai.v1.TenantSettingsService.TestLRSConnection:output_type -> mirai.v1.TestLRSConnectionResponse
+	24, // [24:33] is the sub-list for method output_type
+	15, // [15:24] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_mirai_v1_tenant_settings_proto_init() }
@@ -844,16 +1528,21 @@ func file_mirai_v1_tenant_settings_proto_init() {
 		return
 	}
 	file_mirai_v1_tenant_settings_proto_msgTypes[0].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[1].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[4].OneofWrappers = []any{}
 	file_mirai_v1_tenant_settings_proto_msgTypes[8].OneofWrappers = []any{}
 	file_mirai_v1_tenant_settings_proto_msgTypes[9].OneofWrappers = []any{}
-	file_mirai_v1_tenant_settings_proto_msgTypes[11].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[10].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[13].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[20].OneofWrappers = []any{}
+	file_mirai_v1_tenant_settings_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mirai_v1_tenant_settings_proto_rawDesc), len(file_mirai_v1_tenant_settings_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -178,6 +178,27 @@ type BillingInfoResponse struct {
 	PricePerSeat      int                            `json:"price_per_seat"`
 	CurrentPeriodEnd  *int64                         `json:"current_period_end,omitempty"`
 	CancelAtPeriodEnd bool                           `json:"cancel_at_period_end"`
+	BillingEmail      *string                        `json:"billing_email,omitempty"`
+}
+
+// SubscriptionChangePreviewResponse contains the prorated cost of a plan or seat change.
+type SubscriptionChangePreviewResponse struct {
+	CurrentPlan       valueobject.Plan `json:"current_plan"`
+	CurrentSeatCount  int              `json:"current_seat_count"`
+	Plan              valueobject.Plan `json:"plan"`
+	SeatCount         int              `json:"seat_count"`
+	ProratedAmount    int64            `json:"prorated_amount"` // cents, negative for a credit
+	NextInvoiceAmount int64            `json:"next_invoice_amount"`
+	NextInvoiceAt     int64            `json:"next_invoice_at"` // unix timestamp
+	Currency          string           `json:"currency"`
+	ProrationDate     int64            `json:"proration_date"` // unix timestamp, passed back to apply the previewed amount
+}
+
+// EntitlementsResponse contains what a company's plan includes and how much of it is used.
+type EntitlementsResponse struct {
+	Entitlements entity.Entitlements `json:"entitlements"`
+	SMECount     int                 `json:"sme_count"`
+	CourseCount  int                 `json:"course_count"`
 }
 
 // EmailExistsResponse contains the result of email check.
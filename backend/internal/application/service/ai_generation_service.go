@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +24,12 @@ type AIProviderFactory interface {
 	GetProvider(ctx context.Context, tenantID uuid.UUID) (service.AIProvider, error)
 }
 
+// EmbedderFactory creates Embedder instances per-tenant, using the same
+// per-tenant API keys as AIProviderFactory.
+type EmbedderFactory interface {
+	GetEmbedder(ctx context.Context, tenantID uuid.UUID) (service.Embedder, error)
+}
+
 // JobNotifier sends notifications about generation job status changes.
 type JobNotifier interface {
 	NotifyJobProgress(ctx context.Context, userID uuid.UUID, jobID uuid.UUID, jobType string, status string, progress int) error
@@ -44,11 +51,28 @@ type OutlineCompletionNotifier interface {
 	NotifyOutlineFailed(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, errorMsg string) error
 }
 
+// StaleLessonNotifier tells course owners when generated lessons go out of date.
+type StaleLessonNotifier interface {
+	// NotifyLessonsStale sends notification when SME knowledge changes make a course's lessons stale.
+	NotifyLessonsStale(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, staleCount int) error
+}
+
 // TaskEnqueuer enqueues background tasks for processing.
 // This enables event-driven job processing (push) in addition to polling (sweep).
 type TaskEnqueuer interface {
-	// EnqueueAIGeneration enqueues an AI generation job for immediate processing.
-	EnqueueAIGeneration(jobID, jobType string) error
+	// EnqueueAIGeneration enqueues an AI generation job for immediate processing
+	// in the lane of the given priority.
+	EnqueueAIGeneration(jobID, jobType string, priority valueobject.GenerationJobPriority) error
+}
+
+// JobEventBroker carries live generation job events between the worker and
+// clients watching the job. Events about a child job also reach subscribers
+// of its parent.
+type JobEventBroker interface {
+	// PublishJobEvent publishes an event to the job's watchers.
+	PublishJobEvent(ctx context.Context, event *entity.GenerationJobEvent) error
+	// SubscribeJobEvents returns a channel of the job's events and a cleanup function.
+	SubscribeJobEvents(ctx context.Context, jobID uuid.UUID) (<-chan *entity.GenerationJobEvent, func(), error)
 }
 
 // AIGenerationService handles AI-powered content generation.
@@ -56,6 +80,8 @@ type AIGenerationService struct {
 	userRepo            repository.UserRepository
 	smeRepo             repository.SMERepository
 	smeKnowledgeRepo    repository.SMEKnowledgeRepository
+	smeVersionRepo      repository.SMEKnowledgeVersionRepository
+	smeSubmissionRepo   repository.SMESubmissionRepository
 	audienceRepo        repository.TargetAudienceRepository
 	jobRepo             repository.GenerationJobRepository
 	outlineRepo         repository.CourseOutlineRepository
@@ -64,12 +90,16 @@ type AIGenerationService struct {
 	genLessonRepo       repository.GeneratedLessonRepository
 	componentRepo       repository.LessonComponentRepository
 	genInputRepo        repository.CourseGenerationInputRepository
-	aiSettingsRepo      repository.TenantAISettingsRepository
+	courseRepo          repository.CourseRepository
+	tokenBudget         *TokenBudget
 	aiProviderFactory   AIProviderFactory
+	embedderFactory     EmbedderFactory
 	notifier            JobNotifier
 	completionNotifier  CourseCompletionNotifier
 	outlineNotifier     OutlineCompletionNotifier
-	taskEnqueuer        TaskEnqueuer // For event-driven job processing (optional, falls back to polling)
+	staleNotifier       StaleLessonNotifier
+	taskEnqueuer        TaskEnqueuer   // For event-driven job processing (optional, falls back to polling)
+	jobEvents           JobEventBroker // For live job progress (optional)
 	logger              service.Logger
 }
 
@@ -78,6 +108,8 @@ func NewAIGenerationService(
 	userRepo repository.UserRepository,
 	smeRepo repository.SMERepository,
 	smeKnowledgeRepo repository.SMEKnowledgeRepository,
+	smeVersionRepo repository.SMEKnowledgeVersionRepository,
+	smeSubmissionRepo repository.SMESubmissionRepository,
 	audienceRepo repository.TargetAudienceRepository,
 	jobRepo repository.GenerationJobRepository,
 	outlineRepo repository.CourseOutlineRepository,
@@ -86,18 +118,24 @@ func NewAIGenerationService(
 	genLessonRepo repository.GeneratedLessonRepository,
 	componentRepo repository.LessonComponentRepository,
 	genInputRepo repository.CourseGenerationInputRepository,
-	aiSettingsRepo repository.TenantAISettingsRepository,
+	courseRepo repository.CourseRepository,
+	tokenBudget *TokenBudget,
 	aiProviderFactory AIProviderFactory,
+	embedderFactory EmbedderFactory, // Can be nil - lesson retrieval then uses keywords only
 	notifier JobNotifier,
 	completionNotifier CourseCompletionNotifier,
 	outlineNotifier OutlineCompletionNotifier,
+	staleNotifier StaleLessonNotifier,
 	taskEnqueuer TaskEnqueuer, // Can be nil - falls back to polling
+	jobEvents JobEventBroker, // Can be nil - WatchJob then reports only the current state
 	logger service.Logger,
 ) *AIGenerationService {
 	return &AIGenerationService{
 		userRepo:            userRepo,
 		smeRepo:             smeRepo,
 		smeKnowledgeRepo:    smeKnowledgeRepo,
+		smeVersionRepo:      smeVersionRepo,
+		smeSubmissionRepo:   smeSubmissionRepo,
 		audienceRepo:        audienceRepo,
 		jobRepo:             jobRepo,
 		outlineRepo:         outlineRepo,
@@ -106,12 +144,16 @@ func NewAIGenerationService(
 		genLessonRepo:       genLessonRepo,
 		componentRepo:       componentRepo,
 		genInputRepo:        genInputRepo,
-		aiSettingsRepo:      aiSettingsRepo,
+		courseRepo:          courseRepo,
+		tokenBudget:         tokenBudget,
 		aiProviderFactory:   aiProviderFactory,
+		embedderFactory:     embedderFactory,
 		notifier:            notifier,
 		completionNotifier:  completionNotifier,
 		outlineNotifier:     outlineNotifier,
+		staleNotifier:       staleNotifier,
 		taskEnqueuer:        taskEnqueuer,
+		jobEvents:           jobEvents,
 		logger:              logger,
 	}
 }
@@ -160,6 +202,11 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 		}
 	}
 
+	// Refuse before queueing if the tenant is out of monthly tokens
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeCourseOutline, 1); err != nil {
+		return nil, err
+	}
+
 	// Store generation input
 	genInput := &entity.CourseGenerationInput{
 		ID:                uuid.New(),
@@ -203,7 +250,7 @@ func (s *AIGenerationService) GenerateCourseOutline(ctx context.Context, kratosI
 	// Push: Enqueue for immediate processing (if task enqueuer available)
 	// Sweep: Poll task will pick it up if enqueue fails or enqueuer is nil
 	if s.taskEnqueuer != nil {
-		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type), job.Priority()); err != nil {
 			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
 		}
 	}
@@ -227,7 +274,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	// Just update the progress message
 	progressMsg := "Gathering SME knowledge..."
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress message", "error", err)
 	}
 
@@ -237,7 +284,15 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		return s.failJob(ctx, job, "failed to get generation input")
 	}
 
-	// Gather SME knowledge
+	courseTitle := ""
+	if course, err := s.courseRepo.GetByID(ctx, *job.CourseID); err == nil && course != nil {
+		courseTitle = course.Title
+	}
+
+	// Gather the SME knowledge most relevant to the course. Each SME's summary
+	// covers the rest, so large knowledge bases don't overflow the prompt.
+	query := outlineRetrievalQuery(courseTitle, genInput)
+	queryEmbedding, embeddingModel := s.embedRetrievalQuery(ctx, job, query)
 	smeKnowledge := make([]service.SMEKnowledgeInput, 0, len(genInput.SMEIDs))
 	for _, smeID := range genInput.SMEIDs {
 		sme, err := s.smeRepo.GetByID(ctx, smeID)
@@ -245,16 +300,14 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 			continue
 		}
 
-		chunks, err := s.smeKnowledgeRepo.ListBySMEID(ctx, smeID)
-		if err != nil {
-			log.Warn("failed to get SME knowledge chunks", "smeID", smeID, "error", err)
-			continue
-		}
+		chunks := s.searchKnowledge(ctx, []uuid.UUID{smeID}, query, queryEmbedding, embeddingModel, outlineRetrievalLimit)
 
 		chunkTexts := make([]string, len(chunks))
+		chunkIDs := make([]string, len(chunks))
 		keywords := make([]string, 0)
 		for i, chunk := range chunks {
 			chunkTexts[i] = chunk.Content
+			chunkIDs[i] = chunk.ID.String()
 			keywords = append(keywords, chunk.Keywords...)
 		}
 
@@ -268,6 +321,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 			Domain:   sme.Domain,
 			Summary:  summary,
 			Chunks:   chunkTexts,
+			ChunkIDs: chunkIDs,
 			Keywords: keywords,
 		})
 	}
@@ -280,7 +334,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	job.ProgressPercent = 20
 	progressMsg = "Analyzing target audience..."
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 20, "error", err)
 	}
 
@@ -310,7 +364,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	job.ProgressPercent = 40
 	progressMsg = "Generating course outline with AI..."
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 40, "error", err)
 	}
 
@@ -334,7 +388,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	}
 
 	outlineResult, err := aiProvider.GenerateCourseOutline(ctx, service.GenerateOutlineRequest{
-		CourseTitle:       "", // Will be fetched or passed
+		CourseTitle:       courseTitle,
 		DesiredOutcome:    genInput.DesiredOutcome,
 		SMEKnowledge:      smeKnowledge,
 		TargetAudience:    targetAudience,
@@ -342,15 +396,18 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	})
 	if err != nil {
 		log.Error("AI outline generation failed", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("AI generation failed: %v", err))
+		return s.failJobWithUsage(ctx, job, err, fmt.Sprintf("AI generation failed: %v", err))
 	}
 
+	// Update token usage now, so it is billed even if storing the outline fails
+	s.tokenBudget.RecordUsage(ctx, job, outlineResult.TokensUsed)
+
 	// Update progress
 	job.ProgressPercent = 70
 	progressMsg = "Storing outline..."
 	job.ProgressMessage = &progressMsg
 	job.TokensUsed = outlineResult.TokensUsed
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 70, "error", err)
 	}
 
@@ -412,8 +469,20 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 		return s.failJob(ctx, job, "failed to store outline")
 	}
 
-	// Update token usage
-	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, outlineResult.TokensUsed)
+	// Stream each stored section, with its lessons, to watchers
+	for i := range sections {
+		section := sections[i]
+		for _, lesson := range lessons {
+			if lesson.SectionID == section.ID {
+				section.Lessons = append(section.Lessons, lesson)
+			}
+		}
+		s.publishJobEvent(ctx, &entity.GenerationJobEvent{
+			Type:    valueobject.GenerationJobEventSectionReady,
+			Job:     job,
+			Section: &section,
+		})
+	}
 
 	// Count sections and lessons for notification
 	sectionCount := len(outlineResult.Sections)
@@ -429,7 +498,7 @@ func (s *AIGenerationService) ProcessOutlineGenerationJob(ctx context.Context, j
 	job.CompletedAt = &completedAt
 	progressMsg = "Outline generation complete"
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to mark job as completed", "error", err)
 	}
 
@@ -698,6 +767,10 @@ func (s *AIGenerationService) GenerateLessonContent(ctx context.Context, kratosI
 		return nil, domainerrors.ErrInvalidInput.WithMessage("outline must be approved before generating content")
 	}
 
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeLessonContent, 1); err != nil {
+		return nil, err
+	}
+
 	// Create the job
 	job := &entity.GenerationJob{
 		ID:              uuid.New(),
@@ -721,7 +794,7 @@ func (s *AIGenerationService) GenerateLessonContent(ctx context.Context, kratosI
 
 	// Push: Enqueue for immediate processing (if task enqueuer available)
 	if s.taskEnqueuer != nil {
-		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type), job.Priority()); err != nil {
 			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
 		}
 	}
@@ -745,7 +818,7 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 	// Just update the progress message
 	progressMsg := "Loading lesson context..."
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress message", "error", err)
 	}
 
@@ -770,32 +843,14 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		return s.failJob(ctx, job, "generation input not found")
 	}
 
-	// Gather SME knowledge (similar to outline generation)
-	smeKnowledge := make([]service.SMEKnowledgeInput, 0)
-	for _, smeID := range genInput.SMEIDs {
-		sme, err := s.smeRepo.GetByID(ctx, smeID)
-		if err != nil || sme == nil {
-			continue
-		}
-
-		chunks, _ := s.smeKnowledgeRepo.ListBySMEID(ctx, smeID)
-		chunkTexts := make([]string, len(chunks))
-		for i, chunk := range chunks {
-			chunkTexts[i] = chunk.Content
-		}
-
-		summary := ""
-		if sme.KnowledgeSummary != nil {
-			summary = *sme.KnowledgeSummary
-		}
-
-		smeKnowledge = append(smeKnowledge, service.SMEKnowledgeInput{
-			SMEName: sme.Name,
-			Domain:  sme.Domain,
-			Summary: summary,
-			Chunks:  chunkTexts,
-		})
+	// Retrieve the SME knowledge most relevant to this lesson
+	smeKnowledge, retrievedChunkIDs := s.retrieveLessonKnowledge(ctx, job, genInput.SMEIDs, outlineLesson)
+	knownChunkIDs := make(map[uuid.UUID]bool, len(retrievedChunkIDs))
+	for _, id := range retrievedChunkIDs {
+		knownChunkIDs[id] = true
 	}
+	job.RetrievedChunkIDs = retrievedChunkIDs
+	log.Info("retrieved lesson knowledge", "chunks", len(retrievedChunkIDs))
 
 	// Get target audience
 	var targetAudience service.TargetAudienceInput
@@ -817,7 +872,7 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 	job.ProgressPercent = 30
 	progressMsg = "Generating lesson content with AI..."
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 30, "error", err)
 	}
 
@@ -834,73 +889,149 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 		return s.failJob(ctx, job, fmt.Sprintf("failed to get AI provider: %v", err))
 	}
 
-	// Generate lesson content
-	lessonResult, err := aiProvider.GenerateLessonContent(ctx, service.GenerateLessonRequest{
-		CourseTitle:        "", // Could be fetched
-		SectionTitle:       section.Title,
-		LessonTitle:        outlineLesson.Title,
-		LessonDescription:  outlineLesson.Description,
-		LearningObjectives: outlineLesson.LearningObjectives,
-		SMEKnowledge:       smeKnowledge,
-		TargetAudience:     targetAudience,
-		IsLastInSection:    outlineLesson.IsLastInSection,
-		IsLastInCourse:     outlineLesson.IsLastInCourse,
+	// Resume the lesson an earlier attempt of this job started: the components
+	// it already stored are kept and generation continues after them
+	var genLesson *entity.GeneratedLesson
+	var components []*entity.LessonComponent
+	var completedComponents []service.LessonComponentResult
+	var lastPosition int32
+	if job.LessonID != nil {
+		genLesson, err = s.genLessonRepo.GetByID(ctx, *job.LessonID)
+		if err != nil {
+			log.Warn("failed to get partially generated lesson", "lessonID", job.LessonID, "error", err)
+		}
+		if genLesson != nil {
+			components, err = s.componentRepo.ListByLessonID(ctx, genLesson.ID)
+			if err != nil {
+				return s.failJob(ctx, job, "failed to load partially generated lesson")
+			}
+			for _, component := range components {
+				lastPosition = max(lastPosition, component.Position)
+				completedComponents = append(completedComponents, service.LessonComponentResult{
+					Type:        component.Type.String(),
+					Order:       int(component.Position),
+					ContentJSON: string(component.ContentJSON),
+				})
+			}
+			log.Info("resuming partially generated lesson", "lessonID", genLesson.ID, "components", len(components))
+		}
+	}
+
+	// storeLesson creates the generated lesson on first use, so components
+	// can be stored as they arrive
+	storeLesson := func() error {
+		if genLesson != nil {
+			return nil
+		}
+		lesson := &entity.GeneratedLesson{
+			ID:              uuid.New(),
+			TenantID:        job.TenantID,
+			CourseID:        *job.CourseID,
+			SectionID:       section.ID,
+			OutlineLessonID: outlineLesson.ID,
+			Title:           outlineLesson.Title,
+			GeneratedAt:     time.Now(),
+		}
+		if err := s.genLessonRepo.Create(ctx, lesson); err != nil {
+			return err
+		}
+		genLesson = lesson
+		job.LessonID = &lesson.ID
+		if err := s.updateJob(ctx, job); err != nil {
+			log.Error("failed to link job to generated lesson", "error", err)
+		}
+		return nil
+	}
+
+	// Generate lesson content, storing each component as soon as it is complete
+	lessonResult, err := aiProvider.StreamLessonContent(ctx, service.GenerateLessonRequest{
+		CourseTitle:         "", // Could be fetched
+		SectionTitle:        section.Title,
+		LessonTitle:         outlineLesson.Title,
+		LessonDescription:   outlineLesson.Description,
+		LearningObjectives:  outlineLesson.LearningObjectives,
+		SMEKnowledge:        smeKnowledge,
+		TargetAudience:      targetAudience,
+		IsLastInSection:     outlineLesson.IsLastInSection,
+		IsLastInCourse:      outlineLesson.IsLastInCourse,
+		CompletedComponents: completedComponents,
+	}, func(compResult service.LessonComponentResult) error {
+		if err := storeLesson(); err != nil {
+			return fmt.Errorf("failed to store lesson: %w", err)
+		}
+
+		compType, _ := valueobject.ParseLessonComponentType(compResult.Type)
+		component := &entity.LessonComponent{
+			ID:          uuid.New(),
+			TenantID:    job.TenantID,
+			LessonID:    genLesson.ID,
+			Type:        compType,
+			Position:    lastPosition + int32(compResult.Order),
+			ContentJSON: json.RawMessage(compResult.ContentJSON),
+			SMEChunkIDs: citedChunkIDs(compResult.SourceChunkIDs, knownChunkIDs),
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+		if err := s.componentRepo.Create(ctx, component); err != nil {
+			log.Error("failed to create component", "error", err)
+			return fmt.Errorf("failed to store component: %w", err)
+		}
+		components = append(components, component)
+
+		progressMsg := fmt.Sprintf("Generated %d components...", len(components))
+		job.ProgressMessage = &progressMsg
+		if err := s.updateJob(ctx, job); err != nil {
+			log.Error("failed to update job progress message", "error", err)
+		}
+		return nil
 	})
 	if err != nil {
-		log.Error("AI lesson generation failed", "error", err)
-		return s.failJob(ctx, job, fmt.Sprintf("AI generation failed: %v", err))
+		// Components stored before the failure are kept, and the tokens the
+		// stream used up to that point are billed
+		log.Error("AI lesson generation failed", "error", err, "storedComponents", len(components))
+		return s.failJobWithUsage(ctx, job, err, fmt.Sprintf("AI generation failed: %v", err))
 	}
 
+	// Update token usage now, so it is billed even if storing the lesson fails
+	s.tokenBudget.RecordUsage(ctx, job, lessonResult.TokensUsed)
+
 	// Update progress
 	job.ProgressPercent = 70
 	progressMsg = "Storing lesson content..."
 	job.ProgressMessage = &progressMsg
 	job.TokensUsed = lessonResult.TokensUsed
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to update job progress", "progress", 70, "error", err)
 	}
 
-	// Create generated lesson
-	genLesson := &entity.GeneratedLesson{
-		ID:              uuid.New(),
-		TenantID:        job.TenantID,
-		CourseID:        *job.CourseID,
-		SectionID:       section.ID,
-		OutlineLessonID: outlineLesson.ID,
-		Title:           outlineLesson.Title,
-		GeneratedAt:     time.Now(),
+	// A lesson without components has not been stored yet
+	if err := storeLesson(); err != nil {
+		log.Error("failed to create generated lesson", "error", err)
+		return s.failJob(ctx, job, "failed to store lesson")
 	}
 	if lessonResult.SegueText != "" {
 		genLesson.SegueText = &lessonResult.SegueText
+		if err := s.genLessonRepo.Update(ctx, genLesson); err != nil {
+			log.Error("failed to store segue text", "error", err)
+		}
 	}
 
-	if err := s.genLessonRepo.Create(ctx, genLesson); err != nil {
-		log.Error("failed to create generated lesson", "error", err)
-		return s.failJob(ctx, job, "failed to store lesson")
+	genLesson.Components = make([]entity.LessonComponent, 0, len(components))
+	for _, component := range components {
+		genLesson.Components = append(genLesson.Components, *component)
 	}
 
-	// Create components
-	for _, compResult := range lessonResult.Components {
-		compType, _ := valueobject.ParseLessonComponentType(compResult.Type)
-		component := &entity.LessonComponent{
-			ID:          uuid.New(),
-			TenantID:    job.TenantID,
-			LessonID:    genLesson.ID,
-			Type:        compType,
-			Position:    int32(compResult.Order),
-			ContentJSON: json.RawMessage(compResult.ContentJSON),
-			CreatedAt:   time.Now(),
-			UpdatedAt:   time.Now(),
-		}
+	s.publishJobEvent(ctx, &entity.GenerationJobEvent{
+		Type:   valueobject.GenerationJobEventLessonReady,
+		Job:    job,
+		Lesson: genLesson,
+	})
 
-		if err := s.componentRepo.Create(ctx, component); err != nil {
-			log.Error("failed to create component", "error", err)
-		}
+	// The fresh lesson replaces any copies flagged stale
+	if err := s.genLessonRepo.DeleteStaleByOutlineLessonID(ctx, outlineLesson.ID); err != nil {
+		log.Error("failed to delete stale lessons", "error", err)
 	}
 
-	// Update token usage
-	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, lessonResult.TokensUsed)
-
 	// Complete the job
 	job.Status = valueobject.GenerationJobStatusCompleted
 	job.ProgressPercent = 100
@@ -908,7 +1039,7 @@ func (s *AIGenerationService) ProcessLessonGenerationJob(ctx context.Context, jo
 	job.CompletedAt = &completedAt
 	progressMsg = "Lesson generation complete"
 	job.ProgressMessage = &progressMsg
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to mark job as completed", "error", err)
 	}
 
@@ -982,7 +1113,7 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 		parentJob.ProgressMessage = &progressMsg
 		parentJob.TokensUsed = result.TotalTokens
 
-		if err := s.jobRepo.Update(ctx, parentJob); err != nil {
+		if err := s.updateJob(ctx, parentJob); err != nil {
 			log.Error("failed to update parent job progress", "progress", progressPercent, "error", err)
 		} else {
 			log.Info("parent job progress updated", "progress", progressPercent, "completed", result.CompletedCount, "total", result.TotalCount)
@@ -999,6 +1130,7 @@ func (s *AIGenerationService) checkAndCompleteParentJob(ctx context.Context, par
 		log.Error("failed to get parent job for notification", "error", err)
 		return nil // Status already updated, notification failure is non-fatal
 	}
+	s.publishJobEvent(ctx, &entity.GenerationJobEvent{Type: valueobject.GenerationJobEventStatus, Job: parentJob})
 
 	// Get course title for notification
 	courseTitle := "Course"
@@ -1082,6 +1214,11 @@ func (s *AIGenerationService) GenerateAllLessons(ctx context.Context, kratosID u
 		return nil, domainerrors.ErrInvalidInput.WithMessage("no lessons in outline")
 	}
 
+	// Refuse the whole batch up front rather than failing lessons part-way through
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeLessonContent, totalLessons); err != nil {
+		return nil, err
+	}
+
 	// Create a FULL_COURSE parent job to track overall completion
 	parentJob := &entity.GenerationJob{
 		ID:              uuid.New(),
@@ -1178,6 +1315,10 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 		return nil, domainerrors.ErrNotFound.WithMessage("lesson not found")
 	}
 
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeComponentRegen, 1); err != nil {
+		return nil, err
+	}
+
 	// Create the regeneration job
 	job := &entity.GenerationJob{
 		ID:              uuid.New(),
@@ -1213,7 +1354,7 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 
 	// Push: Enqueue for immediate processing (if task enqueuer available)
 	if s.taskEnqueuer != nil {
-		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type)); err != nil {
+		if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type), job.Priority()); err != nil {
 			log.Warn("failed to enqueue job for immediate processing, will be picked up by poll", "error", err)
 		}
 	}
@@ -1221,8 +1362,144 @@ func (s *AIGenerationService) RegenerateComponent(ctx context.Context, kratosID
 	return &RegenerateComponentResult{Job: job}, nil
 }
 
+// ProcessComponentRegenJob processes a component regeneration job.
+// This is called by the background worker.
+// Note: Job is already claimed as 'processing' with started_at set by GetNextQueued.
+func (s *AIGenerationService) ProcessComponentRegenJob(ctx context.Context, job *entity.GenerationJob) error {
+	log := s.logger.With("jobID", job.ID, "lessonID", job.LessonID)
+
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job already cancelled, skipping processing")
+		return nil
+	}
+
+	// RegenerateComponent stores the job's input in its result path
+	if job.ResultPath == nil || job.LessonID == nil || job.CourseID == nil {
+		return s.failJob(ctx, job, "regeneration input not set")
+	}
+	var input struct {
+		ComponentID        string `json:"component_id"`
+		ModificationPrompt string `json:"modification_prompt"`
+	}
+	if err := json.Unmarshal([]byte(*job.ResultPath), &input); err != nil {
+		return s.failJob(ctx, job, "invalid regeneration input")
+	}
+	componentID, err := uuid.Parse(input.ComponentID)
+	if err != nil {
+		return s.failJob(ctx, job, "invalid regeneration input")
+	}
+	log = log.With("componentID", componentID)
+
+	component, err := s.componentRepo.GetByID(ctx, componentID)
+	if err != nil || component == nil || component.LessonID != *job.LessonID {
+		return s.failJob(ctx, job, "component not found")
+	}
+	lesson, err := s.genLessonRepo.GetByID(ctx, *job.LessonID)
+	if err != nil || lesson == nil {
+		return s.failJob(ctx, job, "lesson not found")
+	}
+
+	lessonContext := fmt.Sprintf("Lesson: %s", lesson.Title)
+	if section, err := s.sectionRepo.GetByID(ctx, lesson.SectionID); err == nil && section != nil {
+		lessonContext = fmt.Sprintf("Section: %s\n%s", section.Title, lessonContext)
+	}
+
+	var targetAudience service.TargetAudienceInput
+	genInput, err := s.genInputRepo.GetByCourseID(ctx, *job.CourseID)
+	if err == nil && genInput != nil && len(genInput.TargetAudienceIDs) > 0 {
+		audience, _ := s.audienceRepo.GetByID(ctx, genInput.TargetAudienceIDs[0])
+		if audience != nil {
+			targetAudience = service.TargetAudienceInput{
+				Role:            audience.Role,
+				ExperienceLevel: string(audience.ExperienceLevel),
+				LearningGoals:   audience.LearningGoals,
+				Prerequisites:   audience.Prerequisites,
+				Challenges:      audience.Challenges,
+				Motivations:     audience.Motivations,
+			}
+		}
+	}
+
+	job.ProgressPercent = 30
+	progressMsg := "Regenerating component with AI..."
+	job.ProgressMessage = &progressMsg
+	if err := s.updateJob(ctx, job); err != nil {
+		log.Error("failed to update job progress", "progress", 30, "error", err)
+	}
+
+	aiProvider, err := s.aiProviderFactory.GetProvider(ctx, job.TenantID)
+	if err != nil {
+		log.Error("failed to get AI provider", "error", err)
+		return s.failJob(ctx, job, fmt.Sprintf("failed to get AI provider: %v", err))
+	}
+
+	result, err := aiProvider.RegenerateComponent(ctx, service.RegenerateComponentRequest{
+		ComponentType:      component.Type.String(),
+		CurrentContentJSON: string(component.ContentJSON),
+		ModificationPrompt: input.ModificationPrompt,
+		LessonContext:      lessonContext,
+		TargetAudience:     targetAudience,
+	})
+	if err != nil {
+		log.Error("AI component regeneration failed", "error", err)
+		return s.failJobWithUsage(ctx, job, err, fmt.Sprintf("AI generation failed: %v", err))
+	}
+	s.tokenBudget.RecordUsage(ctx, job, result.TokensUsed)
+	job.TokensUsed = result.TokensUsed
+
+	// A cancelled job leaves the component as it was
+	if s.checkJobCancelled(ctx, job.ID) {
+		log.Info("job cancelled during AI generation")
+		return s.markJobCancelled(ctx, job)
+	}
+
+	component.ContentJSON = json.RawMessage(result.ContentJSON)
+	component.UpdatedAt = time.Now()
+	if err := s.componentRepo.Update(ctx, component); err != nil {
+		log.Error("failed to store regenerated component", "error", err)
+		return s.failJob(ctx, job, "failed to store component")
+	}
+
+	if components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID); err == nil {
+		lesson.Components = make([]entity.LessonComponent, 0, len(components))
+		for _, c := range components {
+			lesson.Components = append(lesson.Components, *c)
+		}
+		s.publishJobEvent(ctx, &entity.GenerationJobEvent{
+			Type:   valueobject.GenerationJobEventLessonReady,
+			Job:    job,
+			Lesson: lesson,
+		})
+	}
+
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	completedAt := time.Now()
+	job.CompletedAt = &completedAt
+	progressMsg = "Component regeneration complete"
+	job.ProgressMessage = &progressMsg
+	if err := s.updateJob(ctx, job); err != nil {
+		log.Error("failed to mark job as completed", "error", err)
+	}
+
+	if s.notifier != nil {
+		if err := s.notifier.NotifyJobProgress(ctx, job.CreatedByUserID, job.ID, "Component Regeneration", "completed", 100); err != nil {
+			log.Error("failed to send completion notification", "error", err)
+		}
+	}
+
+	log.Info("component regeneration completed", "tokensUsed", result.TokensUsed)
+	return nil
+}
+
+// GetJobResult contains a job and, while it waits, its place in the queue.
+type GetJobResult struct {
+	Job           *entity.GenerationJob
+	QueuePosition *entity.GenerationJobQueuePosition // Nil unless the job is queued
+}
+
 // GetJob retrieves a generation job by ID.
-func (s *AIGenerationService) GetJob(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*entity.GenerationJob, error) {
+func (s *AIGenerationService) GetJob(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*GetJobResult, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
@@ -1233,7 +1510,16 @@ func (s *AIGenerationService) GetJob(ctx context.Context, kratosID uuid.UUID, jo
 		return nil, domainerrors.ErrNotFound.WithMessage("job not found")
 	}
 
-	return job, nil
+	result := &GetJobResult{Job: job}
+	if job.Status == valueobject.GenerationJobStatusQueued {
+		// The queue is shared by all tenants; only counts leave this call
+		result.QueuePosition, err = s.jobRepo.GetQueuePosition(tenant.WithSuperAdmin(ctx, true), job.ID)
+		if err != nil {
+			s.logger.Warn("failed to get queue position", "jobID", job.ID, "error", err)
+		}
+	}
+
+	return result, nil
 }
 
 // ListJobs retrieves generation jobs with optional filtering.
@@ -1273,8 +1559,8 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 	now := time.Now()
 	cancelMsg := "Cancelled by user"
 
-	// If this is a parent job (full_course), cancel all child jobs first
-	if job.Type == valueobject.GenerationJobTypeFullCourse {
+	// If this is a parent job (full_course, stale_lesson_regen), cancel all child jobs first
+	if job.Type.IsParent() {
 		children, err := s.jobRepo.ListByParentID(ctx, jobID)
 		if err == nil {
 			cancelledChildren := 0
@@ -1286,7 +1572,7 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 					child.CompletedAt = &now
 					childMsg := "Cancelled: parent job cancelled"
 					child.ProgressMessage = &childMsg
-					if err := s.jobRepo.Update(ctx, child); err != nil {
+					if err := s.updateJob(ctx, child); err != nil {
 						log.Warn("failed to cancel child job", "childJobID", child.ID, "error", err)
 					} else {
 						cancelledChildren++
@@ -1302,7 +1588,7 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 	job.CompletedAt = &now
 	job.ProgressMessage = &cancelMsg
 
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		log.Error("failed to cancel job", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
@@ -1311,6 +1597,125 @@ func (s *AIGenerationService) CancelJob(ctx context.Context, kratosID uuid.UUID,
 	return job, nil
 }
 
+// ResumeJob continues a failed course generation job. The lessons that did not
+// complete are queued again, each picking up after the components it already
+// stored; completed lessons are kept.
+func (s *AIGenerationService) ResumeJob(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*entity.GenerationJob, error) {
+	log := s.logger.With("kratosID", kratosID, "jobID", jobID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("job not found")
+	}
+
+	if !job.Type.IsParent() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("only course generation jobs can be resumed")
+	}
+	if job.Status != valueobject.GenerationJobStatusFailed {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("can only resume failed jobs")
+	}
+
+	children, err := s.jobRepo.ListByParentID(ctx, jobID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var unfinished []*entity.GenerationJob
+	for _, child := range children {
+		if child.Status == valueobject.GenerationJobStatusFailed || child.Status == valueobject.GenerationJobStatusCancelled {
+			unfinished = append(unfinished, child)
+		}
+	}
+	if len(unfinished) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("no lessons left to resume")
+	}
+
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeLessonContent, len(unfinished)); err != nil {
+		return nil, err
+	}
+
+	// Reopen the parent before queuing children so their completion finalizes it
+	job.Status = valueobject.GenerationJobStatusProcessing
+	job.ErrorMessage = nil
+	job.CompletedAt = nil
+	progressMsg := fmt.Sprintf("Resuming %d of %d lessons...", len(unfinished), len(children))
+	job.ProgressMessage = &progressMsg
+	if err := s.updateJob(ctx, job); err != nil {
+		log.Error("failed to reopen job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	for _, child := range unfinished {
+		child.Status = valueobject.GenerationJobStatusQueued
+		child.RetryCount = 0
+		child.ProgressPercent = 0
+		child.ErrorMessage = nil
+		child.StartedAt = nil
+		child.CompletedAt = nil
+		childMsg := "Queued to resume"
+		child.ProgressMessage = &childMsg
+		if err := s.updateJob(ctx, child); err != nil {
+			log.Error("failed to requeue lesson job", "childJobID", child.ID, "error", err)
+			_ = s.failJob(ctx, child, fmt.Sprintf("failed to resume: %v", err))
+			continue
+		}
+
+		if s.taskEnqueuer != nil {
+			if err := s.taskEnqueuer.EnqueueAIGeneration(child.ID.String(), string(child.Type), child.Priority()); err != nil {
+				log.Warn("failed to enqueue resumed job, will be picked up by polling", "childJobID", child.ID, "error", err)
+			}
+		}
+	}
+
+	log.Info("resumed course generation job", "lessons", len(unfinished), "total", len(children))
+	return job, nil
+}
+
+// RecoverStaleJobs puts jobs a stopped worker left processing back in the
+// queue, or fails them once their retries are used up. Runs across tenants.
+func (s *AIGenerationService) RecoverStaleJobs(ctx context.Context) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	jobs, err := s.jobRepo.RequeueStaleJobs(adminCtx)
+	if err != nil {
+		s.logger.Error("failed to requeue stale jobs", "error", err)
+		return err
+	}
+
+	for _, job := range jobs {
+		tenantCtx := tenant.WithTenantID(adminCtx, job.TenantID)
+		log := s.logger.With("jobID", job.ID, "type", job.Type, "retryCount", job.RetryCount)
+
+		if job.Status != valueobject.GenerationJobStatusQueued {
+			log.Warn("stale job has no retries left, marking as failed")
+			errMsg := "Worker stopped responding and no retries are left"
+			if job.ErrorMessage != nil {
+				errMsg = *job.ErrorMessage
+			}
+			_ = s.failJob(tenantCtx, job, errMsg)
+			continue
+		}
+
+		log.Info("requeued stale job")
+		s.publishJobEvent(tenantCtx, jobStateEvent(job))
+		if s.taskEnqueuer != nil {
+			if err := s.taskEnqueuer.EnqueueAIGeneration(job.ID.String(), string(job.Type), job.Priority()); err != nil {
+				log.Warn("failed to enqueue requeued job, will be picked up by polling", "error", err)
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetGeneratedLesson retrieves a generated lesson by ID.
 func (s *AIGenerationService) GetGeneratedLesson(ctx context.Context, kratosID uuid.UUID, lessonID uuid.UUID) (*entity.GeneratedLesson, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -1334,9 +1739,247 @@ func (s *AIGenerationService) GetGeneratedLesson(ctx context.Context, kratosID u
 		lesson.Components[i] = *c
 	}
 
+	sources, err := s.loadLessonSources(ctx, lesson.Components, lesson.GeneratedAt)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	lesson.Sources = sources
+
 	return lesson, nil
 }
 
+// loadLessonSources resolves the SME chunks cited by a lesson's components to
+// their submissions and files. Chunks edited or deleted since the lesson was
+// generated are flagged outdated; deleted chunks are resolved from their history.
+func (s *AIGenerationService) loadLessonSources(ctx context.Context, components []entity.LessonComponent, generatedAt time.Time) ([]entity.LessonSource, error) {
+	seen := make(map[uuid.UUID]bool)
+	var chunkIDs []uuid.UUID
+	for _, c := range components {
+		for _, id := range c.SMEChunkIDs {
+			if !seen[id] {
+				seen[id] = true
+				chunkIDs = append(chunkIDs, id)
+			}
+		}
+	}
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+
+	chunks, err := s.smeKnowledgeRepo.ListByIDs(ctx, chunkIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.smeVersionRepo.ListByChunkIDs(ctx, chunkIDs)
+	if err != nil {
+		return nil, err
+	}
+	history := groupVersionsByChunk(versions)
+
+	found := make(map[uuid.UUID]bool, len(chunks))
+	for _, chunk := range chunks {
+		found[chunk.ID] = true
+	}
+	for _, id := range chunkIDs {
+		if chunkVersions := history[id]; !found[id] && len(chunkVersions) > 0 {
+			chunks = append(chunks, chunkVersions[len(chunkVersions)-1].Chunk())
+		}
+	}
+
+	fileNames := make(map[uuid.UUID]*string)
+	sources := make([]entity.LessonSource, 0, len(chunks))
+	for _, chunk := range chunks {
+		source := entity.LessonSource{
+			ChunkID:         chunk.ID,
+			SMEID:           chunk.SMEID,
+			Topic:           chunk.Topic,
+			SubmissionID:    chunk.SubmissionID,
+			SourceHeading:   chunk.SourceHeading,
+			SourcePage:      chunk.SourcePage,
+			SourceTimestamp: chunk.SourceTimestamp,
+		}
+		if chunkVersions := history[chunk.ID]; len(chunkVersions) > 0 {
+			generated := versionAt(chunkVersions, generatedAt)
+			source.Outdated = generated != nil && knowledgeChangedSince(generated, chunkVersions[len(chunkVersions)-1])
+		}
+
+		if chunk.SubmissionID != nil && s.smeSubmissionRepo != nil {
+			fileName, ok := fileNames[*chunk.SubmissionID]
+			if !ok {
+				submission, err := s.smeSubmissionRepo.GetByID(ctx, *chunk.SubmissionID)
+				if err == nil && submission != nil && submission.FileName != "" {
+					fileName = &submission.FileName
+				}
+				fileNames[*chunk.SubmissionID] = fileName
+			}
+			source.FileName = fileName
+		}
+
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+// lessonRetrievalLimit is how many knowledge chunks are retrieved as
+// context for a single lesson.
+const lessonRetrievalLimit = 8
+
+// outlineRetrievalLimit is how many knowledge chunks each SME contributes to
+// an outline prompt, next to its summary.
+const outlineRetrievalLimit = 20
+
+// retrieveLessonKnowledge selects the knowledge chunks most relevant to a lesson,
+// using its title, description and learning objectives as the search query.
+// Falls back to the highest-relevance chunks when search finds nothing (e.g.
+// chunks without embeddings and no keyword overlap). The query embedding is
+// billed to job. Returns the knowledge grouped per SME and the retrieved chunk
+// IDs in rank order.
+func (s *AIGenerationService) retrieveLessonKnowledge(ctx context.Context, job *entity.GenerationJob, smeIDs []uuid.UUID, lesson *entity.OutlineLesson) ([]service.SMEKnowledgeInput, []uuid.UUID) {
+	smes := make(map[uuid.UUID]*entity.SubjectMatterExpert, len(smeIDs))
+	validIDs := make([]uuid.UUID, 0, len(smeIDs))
+	for _, smeID := range smeIDs {
+		sme, err := s.smeRepo.GetByID(ctx, smeID)
+		if err != nil || sme == nil {
+			continue
+		}
+		smes[smeID] = sme
+		validIDs = append(validIDs, smeID)
+	}
+	if len(validIDs) == 0 {
+		return []service.SMEKnowledgeInput{}, nil
+	}
+
+	query := lessonRetrievalQuery(lesson)
+	queryEmbedding, embeddingModel := s.embedRetrievalQuery(ctx, job, query)
+	chunks := s.searchKnowledge(ctx, validIDs, query, queryEmbedding, embeddingModel, lessonRetrievalLimit)
+
+	bySME := make(map[uuid.UUID][]*entity.SMEKnowledgeChunk)
+	retrievedIDs := make([]uuid.UUID, 0, len(chunks))
+	for _, chunk := range chunks {
+		bySME[chunk.SMEID] = append(bySME[chunk.SMEID], chunk)
+		retrievedIDs = append(retrievedIDs, chunk.ID)
+	}
+
+	knowledge := make([]service.SMEKnowledgeInput, 0, len(validIDs))
+	for _, smeID := range validIDs {
+		sme := smes[smeID]
+		chunkTexts := make([]string, len(bySME[smeID]))
+		chunkIDs := make([]string, len(bySME[smeID]))
+		chunkTimes := make([]string, len(bySME[smeID]))
+		for i, chunk := range bySME[smeID] {
+			chunkTexts[i] = chunk.Content
+			chunkIDs[i] = chunk.ID.String()
+			if chunk.SourceTimestamp != nil {
+				chunkTimes[i] = entity.FormatTimestamp(*chunk.SourceTimestamp)
+			}
+		}
+
+		summary := ""
+		if sme.KnowledgeSummary != nil {
+			summary = *sme.KnowledgeSummary
+		}
+
+		knowledge = append(knowledge, service.SMEKnowledgeInput{
+			SMEName:    sme.Name,
+			Domain:     sme.Domain,
+			Summary:    summary,
+			Chunks:     chunkTexts,
+			ChunkIDs:   chunkIDs,
+			ChunkTimes: chunkTimes,
+		})
+	}
+
+	return knowledge, retrievedIDs
+}
+
+// embedRetrievalQuery embeds a knowledge search query with the tenant's
+// embedder, billing the tokens to job. Returns a nil embedding when no
+// embedder is available, so search falls back to keywords.
+func (s *AIGenerationService) embedRetrievalQuery(ctx context.Context, job *entity.GenerationJob, query string) ([]float32, string) {
+	if s.embedderFactory == nil || query == "" {
+		return nil, ""
+	}
+	embedder, err := s.embedderFactory.GetEmbedder(ctx, job.TenantID)
+	if err != nil {
+		s.logger.Warn("failed to get embedder, using keyword retrieval", "jobID", job.ID, "error", err)
+		return nil, ""
+	}
+	queryEmbedding, tokensUsed, err := embedder.EmbedQuery(ctx, query)
+	if tokensUsed > 0 {
+		s.tokenBudget.RecordUsage(ctx, job, tokensUsed)
+	}
+	if err != nil {
+		s.logger.Warn("failed to embed retrieval query, using keyword retrieval", "jobID", job.ID, "error", err)
+		return nil, ""
+	}
+	return queryEmbedding, embedder.EmbeddingModel()
+}
+
+// searchKnowledge returns at most limit chunks of the given SMEs, ranked
+// against query. Falls back to the highest-relevance chunks when there is no
+// query or search finds nothing (e.g. chunks without embeddings and no
+// keyword overlap).
+func (s *AIGenerationService) searchKnowledge(ctx context.Context, smeIDs []uuid.UUID, query string, queryEmbedding []float32, embeddingModel string, limit int) []*entity.SMEKnowledgeChunk {
+	var chunks []*entity.SMEKnowledgeChunk
+	var err error
+	if strings.TrimSpace(query) != "" {
+		chunks, err = s.smeKnowledgeRepo.Search(ctx, smeIDs, query, queryEmbedding, embeddingModel, limit)
+		if err != nil {
+			s.logger.Warn("knowledge search failed, using top-relevance chunks", "error", err)
+			chunks = nil
+		}
+	}
+	if len(chunks) == 0 {
+		chunks, err = s.smeKnowledgeRepo.ListTopBySMEIDs(ctx, smeIDs, limit)
+		if err != nil {
+			s.logger.Warn("failed to list top-relevance chunks", "error", err)
+			return nil
+		}
+	}
+	return chunks
+}
+
+// outlineRetrievalQuery builds the knowledge search query for a course outline.
+func outlineRetrievalQuery(courseTitle string, genInput *entity.CourseGenerationInput) string {
+	parts := make([]string, 0, 2)
+	if courseTitle != "" {
+		parts = append(parts, courseTitle)
+	}
+	if genInput.DesiredOutcome != "" {
+		parts = append(parts, genInput.DesiredOutcome)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// lessonRetrievalQuery builds the knowledge search query for a lesson.
+func lessonRetrievalQuery(lesson *entity.OutlineLesson) string {
+	parts := make([]string, 0, 2+len(lesson.LearningObjectives))
+	parts = append(parts, lesson.Title)
+	if lesson.Description != "" {
+		parts = append(parts, lesson.Description)
+	}
+	parts = append(parts, lesson.LearningObjectives...)
+	return strings.Join(parts, "\n")
+}
+
+// citedChunkIDs parses the chunk IDs an AI response cited, keeping only IDs
+// that were actually offered in the prompt so hallucinated IDs are dropped.
+func citedChunkIDs(ids []string, known map[uuid.UUID]bool) []uuid.UUID {
+	var out []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, raw := range ids {
+		id, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil || !known[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+		out = append(out, id)
+	}
+	return out
+}
+
 // ListGeneratedLessons retrieves all generated lessons for a course.
 func (s *AIGenerationService) ListGeneratedLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -1364,6 +2007,299 @@ func (s *AIGenerationService) ListGeneratedLessons(ctx context.Context, kratosID
 	return lessons, nil
 }
 
+// staleLessonLookback bounds how far back each stale lesson scan looks for
+// knowledge changes. Scans run far more often, so a missed scan is covered
+// by the next one.
+const staleLessonLookback = 24 * time.Hour
+
+// DetectStaleLessons flags generated lessons whose cited SME knowledge was
+// edited or deleted after they were generated, and notifies each affected
+// course's owner once. Only knowledge of the SMEs the course draws on counts.
+// This is called periodically by the Asynq worker scheduler.
+func (s *AIGenerationService) DetectStaleLessons(ctx context.Context) error {
+	log := s.logger.With("job", "stale-lesson-scan")
+
+	// Candidates come from every tenant; each is then checked in its own tenant context
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	candidates, err := s.genLessonRepo.ListStaleCandidates(adminCtx, time.Now().Add(-staleLessonLookback))
+	if err != nil {
+		return fmt.Errorf("failed to list stale lesson candidates: %w", err)
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Candidates are ordered by course, so each course's lessons are contiguous
+	for start := 0; start < len(candidates); {
+		end := start + 1
+		for end < len(candidates) && candidates[end].CourseID == candidates[start].CourseID {
+			end++
+		}
+		lessons := candidates[start:end]
+		start = end
+
+		tenantCtx := tenant.WithTenantID(adminCtx, lessons[0].TenantID)
+		marked, err := s.markStaleLessons(tenantCtx, lessons)
+		if err != nil {
+			log.Error("failed to check course for stale lessons", "courseID", lessons[0].CourseID, "error", err)
+			continue
+		}
+		if marked == 0 {
+			continue
+		}
+		log.Info("flagged stale lessons", "courseID", lessons[0].CourseID, "count", marked)
+
+		if s.staleNotifier != nil {
+			course, err := s.courseRepo.GetByID(tenantCtx, lessons[0].CourseID)
+			if err != nil || course == nil {
+				log.Error("failed to get course for stale lesson notification", "courseID", lessons[0].CourseID, "error", err)
+				continue
+			}
+			if err := s.staleNotifier.NotifyLessonsStale(tenantCtx, course.CreatedByUserID, course.ID, course.Title, marked); err != nil {
+				log.Error("failed to send stale lesson notification", "courseID", course.ID, "error", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// markStaleLessons flags the given lessons of one course whose cited
+// knowledge changed since generation. Returns how many were flagged.
+func (s *AIGenerationService) markStaleLessons(ctx context.Context, lessons []*entity.GeneratedLesson) (int, error) {
+	genInput, err := s.genInputRepo.GetByCourseID(ctx, lessons[0].CourseID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get generation input: %w", err)
+	}
+	if genInput == nil {
+		return 0, nil
+	}
+
+	marked := 0
+	for _, lesson := range lessons {
+		components, err := s.componentRepo.ListByLessonID(ctx, lesson.ID)
+		if err != nil {
+			return marked, fmt.Errorf("failed to list components of lesson %s: %w", lesson.ID, err)
+		}
+		var chunkIDs []uuid.UUID
+		for _, c := range components {
+			chunkIDs = append(chunkIDs, c.SMEChunkIDs...)
+		}
+
+		versions, err := s.smeVersionRepo.ListByChunkIDs(ctx, chunkIDs)
+		if err != nil {
+			return marked, fmt.Errorf("failed to list chunk versions: %w", err)
+		}
+
+		reason := staleLessonReason(chunkIDs, groupVersionsByChunk(versions), genInput.SMEIDs, lesson.GeneratedAt)
+		if reason == "" {
+			continue
+		}
+		if err := s.genLessonRepo.MarkStale(ctx, lesson.ID, reason); err != nil {
+			return marked, fmt.Errorf("failed to mark lesson %s stale: %w", lesson.ID, err)
+		}
+		marked++
+	}
+	return marked, nil
+}
+
+// ListStaleLessons retrieves the stale lessons of a course.
+func (s *AIGenerationService) ListStaleLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	lessons, err := s.genLessonRepo.ListStaleByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return lessons, nil
+}
+
+// RegenerateStaleLessonsResult contains the created job.
+type RegenerateStaleLessonsResult struct {
+	Job *entity.GenerationJob
+}
+
+// RegenerateStaleLessons starts lesson content generation jobs for every
+// stale lesson in the course, tracked by a STALE_LESSON_REGEN parent job.
+// Each fresh lesson replaces its stale copy once generated.
+func (s *AIGenerationService) RegenerateStaleLessons(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) (*RegenerateStaleLessonsResult, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	staleLessons, err := s.genLessonRepo.ListStaleByCourseID(ctx, courseID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Several stale copies of one outline lesson need only one regeneration
+	var outlineLessonIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, lesson := range staleLessons {
+		if !seen[lesson.OutlineLessonID] {
+			seen[lesson.OutlineLessonID] = true
+			outlineLessonIDs = append(outlineLessonIDs, lesson.OutlineLessonID)
+		}
+	}
+
+	if len(outlineLessonIDs) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("course has no stale lessons")
+	}
+
+	if err := s.tokenBudget.CheckBudget(ctx, *user.TenantID, valueobject.GenerationJobTypeLessonContent, len(outlineLessonIDs)); err != nil {
+		return nil, err
+	}
+
+	// Create a STALE_LESSON_REGEN parent job to track overall completion
+	now := time.Now()
+	progressMsg := fmt.Sprintf("Regenerating %d stale lessons...", len(outlineLessonIDs))
+	parentJob := &entity.GenerationJob{
+		ID:              uuid.New(),
+		TenantID:        *user.TenantID,
+		Type:            valueobject.GenerationJobTypeStaleRegen,
+		Status:          valueobject.GenerationJobStatusProcessing, // Parent is processing while children are queued
+		CourseID:        &courseID,
+		ProgressPercent: 0,
+		ProgressMessage: &progressMsg,
+		MaxRetries:      0, // Parent job doesn't retry
+		CreatedByUserID: user.ID,
+		CreatedAt:       now,
+		StartedAt:       &now,
+	}
+
+	if err := s.jobRepo.Create(ctx, parentJob); err != nil {
+		log.Error("failed to create parent job", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	childJobs := make([]*entity.GenerationJob, 0, len(outlineLessonIDs))
+	for _, id := range outlineLessonIDs {
+		outlineLessonID := id
+		childJobs = append(childJobs, &entity.GenerationJob{
+			ID:              uuid.New(),
+			TenantID:        *user.TenantID,
+			Type:            valueobject.GenerationJobTypeLessonContent,
+			Status:          valueobject.GenerationJobStatusQueued,
+			CourseID:        &courseID,
+			OutlineLessonID: &outlineLessonID,
+			ParentJobID:     &parentJob.ID,
+			ProgressPercent: 0,
+			MaxRetries:      3,
+			CreatedByUserID: user.ID,
+			CreatedAt:       time.Now(),
+		})
+	}
+
+	if err := s.jobRepo.CreateBatch(ctx, childJobs); err != nil {
+		log.Error("failed to create lesson jobs atomically", "error", err)
+		_ = s.failJob(ctx, parentJob, fmt.Sprintf("failed to queue lesson jobs: %v", err))
+		return nil, domainerrors.ErrInternal.WithMessage("failed to queue lesson generation jobs")
+	}
+
+	log.Info("queued stale lesson regeneration jobs", "lessons", len(childJobs), "parentJobID", parentJob.ID)
+	return &RegenerateStaleLessonsResult{Job: parentJob}, nil
+}
+
+// JobWatch is a live view of a generation job and its child jobs.
+type JobWatch struct {
+	// Current state of the job and its children, as status or progress events
+	Snapshot []*entity.GenerationJobEvent
+	// Events published after the snapshot was taken; closed when the watch ends
+	Events <-chan *entity.GenerationJobEvent
+	// Close stops the watch
+	Close func()
+}
+
+// WatchJob starts following a generation job. Events about its child jobs
+// (ParentJobID) are included, so watching a full course job reports each
+// lesson as it finishes.
+func (s *AIGenerationService) WatchJob(ctx context.Context, kratosID uuid.UUID, jobID uuid.UUID) (*JobWatch, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("job not found")
+	}
+
+	// Subscribe before reading state so no update falls between the two
+	watch := &JobWatch{Close: func() {}}
+	if s.jobEvents != nil {
+		events, cleanup, err := s.jobEvents.SubscribeJobEvents(ctx, jobID)
+		if err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		watch.Events, watch.Close = events, cleanup
+	} else {
+		events := make(chan *entity.GenerationJobEvent)
+		close(events)
+		watch.Events = events
+	}
+
+	// Re-read the job now that updates are being received
+	job, err = s.jobRepo.GetByID(ctx, jobID)
+	if err != nil || job == nil {
+		watch.Close()
+		return nil, domainerrors.ErrNotFound.WithMessage("job not found")
+	}
+	watch.Snapshot = append(watch.Snapshot, jobStateEvent(job))
+
+	if job.Type.IsParent() {
+		children, err := s.jobRepo.ListByParentID(ctx, jobID)
+		if err != nil {
+			watch.Close()
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		for _, child := range children {
+			watch.Snapshot = append(watch.Snapshot, jobStateEvent(child))
+		}
+	}
+
+	return watch, nil
+}
+
+// jobStateEvent reports a job's current state: a status event once it has
+// finished, a progress event while it is queued or running.
+func jobStateEvent(job *entity.GenerationJob) *entity.GenerationJobEvent {
+	eventType := valueobject.GenerationJobEventProgress
+	if job.Status.IsTerminal() {
+		eventType = valueobject.GenerationJobEventStatus
+	}
+	return &entity.GenerationJobEvent{Type: eventType, Job: job}
+}
+
+// updateJob saves a job and publishes its new state to watchers.
+func (s *AIGenerationService) updateJob(ctx context.Context, job *entity.GenerationJob) error {
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		return err
+	}
+	s.publishJobEvent(ctx, jobStateEvent(job))
+	return nil
+}
+
+// publishJobEvent publishes a live job event. This is fire-and-forget -
+// errors are logged but don't fail the job.
+func (s *AIGenerationService) publishJobEvent(ctx context.Context, event *entity.GenerationJobEvent) {
+	if s.jobEvents == nil {
+		return
+	}
+	if err := s.jobEvents.PublishJobEvent(ctx, event); err != nil {
+		s.logger.Warn("failed to publish job event", "jobID", event.Job.ID, "eventType", event.Type, "error", err)
+	}
+}
+
 // Helper to fail a job with an error message.
 func (s *AIGenerationService) failJob(ctx context.Context, job *entity.GenerationJob, errMsg string) error {
 	job.Status = valueobject.GenerationJobStatusFailed
@@ -1371,7 +2307,7 @@ func (s *AIGenerationService) failJob(ctx context.Context, job *entity.Generatio
 	now := time.Now()
 	job.CompletedAt = &now
 
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		s.logger.Error("failed to mark job as failed", "jobID", job.ID, "error", err)
 	}
 
@@ -1391,9 +2327,26 @@ func (s *AIGenerationService) failJob(ctx context.Context, job *entity.Generatio
 		}
 	}
 
+	// A failed lesson still counts towards finishing its parent job
+	if job.ParentJobID != nil {
+		if err := s.checkAndCompleteParentJob(ctx, *job.ParentJobID); err != nil {
+			s.logger.Error("failed to check parent job completion", "jobID", job.ID, "parentJobID", job.ParentJobID, "error", err)
+		}
+	}
+
 	return fmt.Errorf("%s", errMsg)
 }
 
+// failJobWithUsage fails a job whose AI call returned aiErr, first recording
+// the tokens the provider reported using before it failed.
+func (s *AIGenerationService) failJobWithUsage(ctx context.Context, job *entity.GenerationJob, aiErr error, errMsg string) error {
+	if tokens := service.TokensUsedBy(aiErr); tokens > 0 {
+		job.TokensUsed = tokens
+		s.tokenBudget.RecordUsage(ctx, job, tokens)
+	}
+	return s.failJob(ctx, job, errMsg)
+}
+
 // checkJobCancelled checks if a job has been cancelled by re-fetching its status from the database.
 // Returns true if the job was cancelled, false otherwise.
 // This should be called at key points during long-running operations to allow early termination.
@@ -1421,7 +2374,7 @@ func (s *AIGenerationService) markJobCancelled(ctx context.Context, job *entity.
 	msg := "Cancelled during processing"
 	job.ProgressMessage = &msg
 
-	if err := s.jobRepo.Update(ctx, job); err != nil {
+	if err := s.updateJob(ctx, job); err != nil {
 		s.logger.Error("failed to mark job as cancelled", "jobID", job.ID, "error", err)
 		return err
 	}
@@ -1456,6 +2409,14 @@ func (s *AIGenerationService) ProcessNextQueuedJob(ctx context.Context) error {
 	return s.processNextJob(ctx)
 }
 
+// NextQueuedJob returns the queued job of any tenant that should run next,
+// or nil if none can start. Jobs held back by their tenant's concurrency
+// limit have no task left to run them, so the worker dispatches this job
+// whenever a running job finishes.
+func (s *AIGenerationService) NextQueuedJob(ctx context.Context) (*entity.GenerationJob, error) {
+	return s.jobRepo.PeekNextQueued(tenant.WithSuperAdmin(ctx, true))
+}
+
 // processNextJob processes the next queued generation job.
 // Sets up tenant context from the job for proper RLS isolation.
 func (s *AIGenerationService) processNextJob(ctx context.Context) error {
@@ -1464,7 +2425,11 @@ func (s *AIGenerationService) processNextJob(ctx context.Context) error {
 	// GetNextQueued uses FOR UPDATE SKIP LOCKED and runs with superadmin context
 	// to allow picking up jobs from any tenant
 	adminCtx := tenant.WithSuperAdmin(ctx, true)
-	job, err := s.jobRepo.GetNextQueued(adminCtx)
+	job, err := s.jobRepo.GetNextQueued(adminCtx, []valueobject.GenerationJobType{
+		valueobject.GenerationJobTypeCourseOutline,
+		valueobject.GenerationJobTypeLessonContent,
+		valueobject.GenerationJobTypeComponentRegen,
+	})
 	if err != nil {
 		s.logger.Error("failed to get next queued job", "error", err)
 		return err
@@ -1488,6 +2453,8 @@ func (s *AIGenerationService) processNextJob(ctx context.Context) error {
 		return s.ProcessOutlineGenerationJob(tenantCtx, job)
 	case valueobject.GenerationJobTypeLessonContent:
 		return s.ProcessLessonGenerationJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeComponentRegen:
+		return s.ProcessComponentRegenJob(tenantCtx, job)
 	default:
 		// Unknown/unsupported job type - fail it so it doesn't stay stuck in 'processing'
 		// This handles bad data in DB or enum parse failures from repository
@@ -1521,8 +2488,10 @@ func (s *AIGenerationService) ProcessJobByID(ctx context.Context, jobID string)
 
 	if job == nil {
 		// Job doesn't exist or already claimed/processed - this is expected
-		// with Asynq retries or duplicate deliveries
-		log.Info("job not available for claim, may already be processed")
+		// with Asynq retries or duplicate deliveries. A job whose tenant is at
+		// its concurrency limit stays queued until one of its jobs finishes, and
+		// a job of a suspended tenant until the tenant is reactivated.
+		log.Info("job not available for claim, may already be processed, waiting for a free slot or its tenant is suspended")
 		return nil
 	}
 
@@ -1539,6 +2508,8 @@ func (s *AIGenerationService) ProcessJobByID(ctx context.Context, jobID string)
 		return s.ProcessOutlineGenerationJob(tenantCtx, job)
 	case valueobject.GenerationJobTypeLessonContent:
 		return s.ProcessLessonGenerationJob(tenantCtx, job)
+	case valueobject.GenerationJobTypeComponentRegen:
+		return s.ProcessComponentRegenJob(tenantCtx, job)
 	default:
 		// Unknown/unsupported job type - fail it so it doesn't stay stuck in 'processing'
 		// This handles bad data in DB or enum parse failures from repository
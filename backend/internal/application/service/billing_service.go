@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/application/dto"
@@ -12,13 +15,26 @@ import (
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
+// prorationPreviewValidity is how long a previewed proration can be applied
+// at the previewed amount.
+const prorationPreviewValidity = 15 * time.Minute
+
+// SeatUsageCounter reports how many of a company's seats are taken.
+type SeatUsageCounter interface {
+	// GetCompanySeatInfo returns seat usage, counting pending invitations as taken.
+	GetCompanySeatInfo(ctx context.Context, company *entity.Company) (*dto.SeatInfoResponse, error)
+}
+
 // BillingService handles billing-related business logic.
 type BillingService struct {
-	userRepo    repository.UserRepository
-	companyRepo repository.CompanyRepository
-	payments    service.PaymentProvider
-	logger      service.Logger
-	frontendURL string
+	userRepo     repository.UserRepository
+	companyRepo  repository.CompanyRepository
+	payments     service.PaymentProvider
+	suspension   *TenantSuspensionService
+	entitlements *EntitlementService
+	seats        SeatUsageCounter
+	logger       service.Logger
+	frontendURL  string
 }
 
 // NewBillingService creates a new billing service.
@@ -26,15 +42,21 @@ func NewBillingService(
 	userRepo repository.UserRepository,
 	companyRepo repository.CompanyRepository,
 	payments service.PaymentProvider,
+	suspension *TenantSuspensionService,
+	entitlements *EntitlementService,
+	seats SeatUsageCounter,
 	logger service.Logger,
 	frontendURL string,
 ) *BillingService {
 	return &BillingService{
-		userRepo:    userRepo,
-		companyRepo: companyRepo,
-		payments:    payments,
-		logger:      logger,
-		frontendURL: frontendURL,
+		userRepo:     userRepo,
+		companyRepo:  companyRepo,
+		payments:     payments,
+		suspension:   suspension,
+		entitlements: entitlements,
+		seats:        seats,
+		logger:       logger,
+		frontendURL:  frontendURL,
 	}
 }
 
@@ -58,6 +80,7 @@ func (s *BillingService) GetBillingInfo(ctx context.Context, kratosID uuid.UUID)
 		SeatCount:         seatCount,
 		PricePerSeat:      company.Plan.PricePerSeatCents(),
 		CancelAtPeriodEnd: false,
+		BillingEmail:      company.BillingEmail,
 	}
 
 	// If there's an active subscription, get more details from Stripe
@@ -72,6 +95,26 @@ func (s *BillingService) GetBillingInfo(ctx context.Context, kratosID uuid.UUID)
 	return info, nil
 }
 
+// GetEntitlements returns what the user's company plan includes and how much of it is used.
+func (s *BillingService) GetEntitlements(ctx context.Context, kratosID uuid.UUID) (*dto.EntitlementsResponse, error) {
+	_, company, err := s.getUserAndCompany(ctx, kratosID)
+	if err != nil {
+		return nil, err
+	}
+
+	smes, courses, err := s.entitlements.GetUsage(ctx, company.ID)
+	if err != nil {
+		s.logger.Error("failed to count entitlement usage", "companyID", company.ID, "error", err)
+		return nil, err
+	}
+
+	return &dto.EntitlementsResponse{
+		Entitlements: company.Entitlements(),
+		SMECount:     smes,
+		CourseCount:  courses,
+	}, nil
+}
+
 // CreateCheckoutSession creates a Stripe checkout session for plan upgrade/subscription.
 func (s *BillingService) CreateCheckoutSession(ctx context.Context, kratosID uuid.UUID, plan valueobject.Plan, email string) (*dto.CheckoutResponse, error) {
 	log := s.logger.With("kratosID", kratosID, "plan", plan)
@@ -161,6 +204,184 @@ func (s *BillingService) CreatePortalSession(ctx context.Context, kratosID uuid.
 	return &dto.PortalResponse{URL: sess.URL}, nil
 }
 
+// PreviewSubscriptionChange returns the prorated cost of moving the user's
+// company subscription to plan and seatCount. An empty plan or a zero seat
+// count keeps the current one.
+func (s *BillingService) PreviewSubscriptionChange(ctx context.Context, kratosID uuid.UUID, plan valueobject.Plan, seatCount int) (*dto.SubscriptionChangePreviewResponse, error) {
+	company, current, change, err := s.prepareSubscriptionChange(ctx, kratosID, plan, seatCount)
+	if err != nil {
+		return nil, err
+	}
+	change.ProrationDate = time.Now()
+
+	preview, err := s.payments.PreviewSubscriptionChange(ctx, change)
+	if err != nil {
+		s.logger.Error("failed to preview subscription change", "companyID", company.ID, "error", err)
+		return nil, domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	return &dto.SubscriptionChangePreviewResponse{
+		CurrentPlan:       company.Plan,
+		CurrentSeatCount:  current.SeatCount,
+		Plan:              change.Plan,
+		SeatCount:         change.SeatCount,
+		ProratedAmount:    preview.ProratedAmount,
+		NextInvoiceAmount: preview.NextInvoiceAmount,
+		NextInvoiceAt:     preview.NextInvoiceAt.Unix(),
+		Currency:          preview.Currency,
+		ProrationDate:     preview.ProrationDate.Unix(),
+	}, nil
+}
+
+// ChangeSubscription moves the user's company subscription to plan and
+// seatCount. Passing the proration date of a recent preview charges the
+// previewed amount; a zero date prorates as of now.
+func (s *BillingService) ChangeSubscription(ctx context.Context, kratosID uuid.UUID, plan valueobject.Plan, seatCount int, prorationDate time.Time) (*dto.BillingInfoResponse, error) {
+	company, _, change, err := s.prepareSubscriptionChange(ctx, kratosID, plan, seatCount)
+	if err != nil {
+		return nil, err
+	}
+	log := s.logger.With("companyID", company.ID, "plan", change.Plan, "seatCount", change.SeatCount)
+
+	now := time.Now()
+	switch {
+	case prorationDate.IsZero():
+		prorationDate = now
+	case prorationDate.After(now) || now.Sub(prorationDate) > prorationPreviewValidity:
+		return nil, domainerrors.ErrInvalidInput.WithMessage("the preview has expired - preview the change again")
+	}
+	change.ProrationDate = prorationDate
+
+	sub, err := s.payments.ChangeSubscription(ctx, change)
+	if err != nil {
+		log.Error("failed to change subscription", "error", err)
+		return nil, domainerrors.ErrPaymentFailed.WithCause(err)
+	}
+
+	// Saved now so limits apply right away; the subscription webhook confirms it.
+	// The change counts as the latest subscription event, so an event Stripe
+	// created before it and delivers late can't revert it.
+	latest, err := s.companyRepo.ClaimSubscriptionEvent(ctx, company.ID, time.Now())
+	if err != nil {
+		log.Error("failed to record subscription change time", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if latest {
+		subID := sub.ID
+		if err := s.companyRepo.UpdateStripeFields(ctx, company.ID, entity.StripeFields{
+			CustomerID:     company.StripeCustomerID,
+			SubscriptionID: &subID,
+			Status:         sub.Status,
+			Plan:           change.Plan,
+			SeatCount:      sub.SeatCount,
+		}); err != nil {
+			log.Error("failed to save subscription change", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("subscription changed", "fromPlan", company.Plan, "fromSeatCount", company.SeatCount)
+	return s.GetBillingInfo(ctx, kratosID)
+}
+
+// prepareSubscriptionChange checks that the user may make the change and that
+// the company's current usage fits it, and returns the company, its current
+// subscription and the change to apply.
+func (s *BillingService) prepareSubscriptionChange(ctx context.Context, kratosID uuid.UUID, plan valueobject.Plan, seatCount int) (*entity.Company, *service.Subscription, service.SubscriptionChange, error) {
+	var change service.SubscriptionChange
+
+	user, company, err := s.getUserAndCompany(ctx, kratosID)
+	if err != nil {
+		return nil, nil, change, err
+	}
+
+	// Only owners can manage billing
+	if !user.CanManageBilling() {
+		return nil, nil, change, domainerrors.ErrForbidden.WithMessage("only company owners can manage billing")
+	}
+
+	if !company.HasStripeCustomer() || company.StripeSubscriptionID == nil || *company.StripeSubscriptionID == "" {
+		return nil, nil, change, domainerrors.ErrNoBillingAccount.WithMessage("no subscription to change - choose a plan to subscribe first")
+	}
+	if company.Plan == valueobject.PlanEnterprise {
+		return nil, nil, change, domainerrors.ErrPlanChangeBlocked.WithMessage("Enterprise subscriptions are changed through sales")
+	}
+	if company.SubscriptionStatus == valueobject.SubscriptionStatusCanceled {
+		return nil, nil, change, domainerrors.ErrPlanChangeBlocked.WithMessage("the subscription is canceled - choose a plan to subscribe again")
+	}
+
+	if plan == "" {
+		plan = company.Plan
+	}
+	if !plan.IsValid() || !plan.RequiresPayment() {
+		return nil, nil, change, domainerrors.ErrInvalidPlan.WithMessage(fmt.Sprintf("the %s plan can't be selected here - contact sales", plan.DisplayName()))
+	}
+	if seatCount < 0 {
+		return nil, nil, change, domainerrors.ErrInvalidInput.WithMessage("seat count must be positive")
+	}
+
+	current, err := s.payments.GetSubscription(ctx, *company.StripeSubscriptionID)
+	if err != nil {
+		s.logger.Error("failed to get subscription", "companyID", company.ID, "error", err)
+		return nil, nil, change, domainerrors.ErrExternalService.WithCause(err)
+	}
+	if seatCount == 0 {
+		seatCount = current.SeatCount
+	}
+	if plan == company.Plan && seatCount == current.SeatCount {
+		return nil, nil, change, domainerrors.ErrInvalidInput.WithMessage("the plan and seat count are unchanged")
+	}
+
+	if err := s.checkUsageFits(ctx, company, plan, seatCount); err != nil {
+		return nil, nil, change, err
+	}
+
+	change = service.SubscriptionChange{
+		SubscriptionID: *company.StripeSubscriptionID,
+		CustomerID:     *company.StripeCustomerID,
+		Plan:           plan,
+		SeatCount:      seatCount,
+	}
+	return company, current, change, nil
+}
+
+// checkUsageFits returns ErrPlanChangeBlocked, listing what must be removed
+// first, if the company uses more seats, SMEs or courses than the plan and
+// seat count allow.
+func (s *BillingService) checkUsageFits(ctx context.Context, company *entity.Company, plan valueobject.Plan, seatCount int) error {
+	var problems []string
+
+	seatInfo, err := s.seats.GetCompanySeatInfo(ctx, company)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if taken := seatInfo.UsedSeats + seatInfo.PendingInvitations; taken > seatCount {
+		problems = append(problems, fmt.Sprintf("%d seats are taken (%d members, %d pending invitations). Remove members or revoke invitations before going down to %d seats.",
+			taken, seatInfo.UsedSeats, seatInfo.PendingInvitations, seatCount))
+	}
+
+	if plan != company.Plan {
+		entitlements := entity.PlanEntitlements(plan)
+		smes, courses, err := s.entitlements.GetUsage(ctx, company.ID)
+		if err != nil {
+			return err
+		}
+		if !entitlements.AllowsSMEs(smes) {
+			problems = append(problems, fmt.Sprintf("The %s plan includes up to %d SMEs and %d are active. Archive %d SMEs before downgrading.",
+				plan.DisplayName(), entitlements.MaxSMEs, smes, smes-entitlements.MaxSMEs))
+		}
+		if !entitlements.AllowsCourses(courses) {
+			problems = append(problems, fmt.Sprintf("The %s plan includes up to %d courses and there are %d. Delete %d courses before downgrading.",
+				plan.DisplayName(), entitlements.MaxCourses, courses, courses-entitlements.MaxCourses))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return domainerrors.ErrPlanChangeBlocked.WithMessage(strings.Join(problems, " "))
+}
+
 // HandleCheckoutCompleted processes a checkout.session.completed webhook event.
 func (s *BillingService) HandleCheckoutCompleted(ctx context.Context, companyIDStr, plan, customerID, subscriptionID string) error {
 	log := s.logger.With("companyID", companyIDStr, "plan", plan)
@@ -201,6 +422,12 @@ func (s *BillingService) HandleCheckoutCompleted(ctx context.Context, companyIDS
 		return domainerrors.ErrInternal.WithCause(err)
 	}
 
+	if company, err := s.companyRepo.GetByID(ctx, companyID); err != nil || company == nil {
+		log.Warn("failed to load company to lift suspension", "error", err)
+	} else {
+		s.syncSuspension(ctx, company, valueobject.SubscriptionStatusActive)
+	}
+
 	log.Info("checkout completed", "seatCount", seatCount)
 	return nil
 }
@@ -233,6 +460,8 @@ func (s *BillingService) HandleSubscriptionUpdated(ctx context.Context, customer
 		return domainerrors.ErrInternal.WithCause(err)
 	}
 
+	s.syncSuspension(ctx, company, sub.Status)
+
 	log.Info("subscription updated", "companyID", company.ID, "status", sub.Status, "plan", plan, "seatCount", sub.SeatCount)
 	return nil
 }
@@ -259,10 +488,36 @@ func (s *BillingService) HandleSubscriptionDeleted(ctx context.Context, customer
 		return domainerrors.ErrInternal.WithCause(err)
 	}
 
+	s.syncSuspension(ctx, company, valueobject.SubscriptionStatusCanceled)
+
 	log.Info("subscription deleted, reverted to starter", "companyID", company.ID)
 	return nil
 }
 
+// HandleCustomerUpdated processes a customer.updated webhook event, keeping
+// the billing email in sync with the Stripe customer.
+func (s *BillingService) HandleCustomerUpdated(ctx context.Context, customer *service.Customer) error {
+	log := s.logger.With("customerID", customer.ID)
+
+	company, err := s.companyRepo.GetByStripeCustomerID(ctx, customer.ID)
+	if err != nil || company == nil {
+		log.Error("company not found for customer", "error", err)
+		return domainerrors.ErrCompanyNotFound
+	}
+
+	var email *string
+	if customer.Email != "" {
+		email = &customer.Email
+	}
+	if err := s.companyRepo.UpdateBillingEmail(ctx, company.ID, email); err != nil {
+		log.Error("failed to update billing email", "companyID", company.ID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("customer updated", "companyID", company.ID)
+	return nil
+}
+
 // UpdateSeatCount updates the Stripe subscription quantity when users are added/removed.
 func (s *BillingService) UpdateSeatCount(ctx context.Context, companyID uuid.UUID, newCount int) error {
 	company, err := s.companyRepo.GetByID(ctx, companyID)
@@ -285,6 +540,17 @@ func (s *BillingService) UpdateSeatCount(ctx context.Context, companyID uuid.UUI
 	return nil
 }
 
+// syncSuspension suspends or reactivates the company's tenant to match its
+// subscription status. Failures are logged; the Stripe fields are already saved.
+func (s *BillingService) syncSuspension(ctx context.Context, company *entity.Company, status valueobject.SubscriptionStatus) {
+	if s.suspension == nil {
+		return
+	}
+	if err := s.suspension.HandleSubscriptionStatus(ctx, company.TenantID, status); err != nil {
+		s.logger.Error("failed to sync tenant suspension", "companyID", company.ID, "tenantID", company.TenantID, "status", status, "error", err)
+	}
+}
+
 // getUserAndCompany is a helper to get user and their company.
 func (s *BillingService) getUserAndCompany(ctx context.Context, kratosID uuid.UUID) (*entity.User, *entity.Company, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// regenJobRepo hands out one job and keeps its latest state.
+type regenJobRepo struct {
+	repository.GenerationJobRepository
+	job *entity.GenerationJob
+}
+
+func (r *regenJobRepo) ClaimJobByID(_ context.Context, id uuid.UUID) (*entity.GenerationJob, error) {
+	if r.job == nil || r.job.ID != id || r.job.Status != valueobject.GenerationJobStatusQueued {
+		return nil, nil
+	}
+	r.job.Status = valueobject.GenerationJobStatusProcessing
+	return r.job, nil
+}
+
+func (r *regenJobRepo) GetByID(context.Context, uuid.UUID) (*entity.GenerationJob, error) {
+	return r.job, nil
+}
+
+func (r *regenJobRepo) Update(_ context.Context, job *entity.GenerationJob) error {
+	r.job = job
+	return nil
+}
+
+type regenComponentRepo struct {
+	repository.LessonComponentRepository
+	components map[uuid.UUID]*entity.LessonComponent
+}
+
+func (r *regenComponentRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.LessonComponent, error) {
+	return r.components[id], nil
+}
+
+func (r *regenComponentRepo) ListByLessonID(_ context.Context, lessonID uuid.UUID) ([]*entity.LessonComponent, error) {
+	var components []*entity.LessonComponent
+	for _, c := range r.components {
+		if c.LessonID == lessonID {
+			components = append(components, c)
+		}
+	}
+	return components, nil
+}
+
+func (r *regenComponentRepo) Update(_ context.Context, component *entity.LessonComponent) error {
+	r.components[component.ID] = component
+	return nil
+}
+
+type regenLessonRepo struct {
+	repository.GeneratedLessonRepository
+	lesson *entity.GeneratedLesson
+}
+
+func (r *regenLessonRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.GeneratedLesson, error) {
+	if r.lesson.ID != id {
+		return nil, nil
+	}
+	return r.lesson, nil
+}
+
+type regenSectionRepo struct {
+	repository.OutlineSectionRepository
+}
+
+func (regenSectionRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.OutlineSection, error) {
+	return &entity.OutlineSection{ID: id, Title: "Pool Care"}, nil
+}
+
+type regenGenInputRepo struct {
+	repository.CourseGenerationInputRepository
+}
+
+func (regenGenInputRepo) GetByCourseID(context.Context, uuid.UUID) (*entity.CourseGenerationInput, error) {
+	return nil, nil
+}
+
+// regenProvider returns fixed content and records the request it was given.
+type regenProvider struct {
+	service.AIProvider
+	req    service.RegenerateComponentRequest
+	result *service.RegenerateComponentResult
+	err    error
+}
+
+func (p *regenProvider) RegenerateComponent(_ context.Context, req service.RegenerateComponentRequest) (*service.RegenerateComponentResult, error) {
+	p.req = req
+	return p.result, p.err
+}
+
+type regenProviderFactory struct {
+	provider service.AIProvider
+}
+
+func (f regenProviderFactory) GetProvider(context.Context, uuid.UUID) (service.AIProvider, error) {
+	return f.provider, nil
+}
+
+func TestProcessJobByIDRegeneratesComponent(t *testing.T) {
+	tenantID := uuid.New()
+	lesson := &entity.GeneratedLesson{ID: uuid.New(), TenantID: tenantID, SectionID: uuid.New(), Title: "Balancing pH"}
+	component := &entity.LessonComponent{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		LessonID:    lesson.ID,
+		Type:        valueobject.LessonComponentTypeText,
+		ContentJSON: json.RawMessage(`{"text":"Old"}`),
+	}
+
+	tests := []struct {
+		name        string
+		componentID uuid.UUID
+		result      *service.RegenerateComponentResult
+		err         error
+		wantStatus  valueobject.GenerationJobStatus
+		wantContent string
+		wantTokens  int64 // Tokens recorded in the usage ledger
+	}{
+		{
+			name:        "regenerated",
+			componentID: component.ID,
+			result:      &service.RegenerateComponentResult{ContentJSON: `{"text":"New"}`, TokensUsed: 120},
+			wantStatus:  valueobject.GenerationJobStatusCompleted,
+			wantContent: `{"text":"New"}`,
+			wantTokens:  120,
+		},
+		{
+			name:        "provider error",
+			componentID: component.ID,
+			err:         errors.New("rate limited"),
+			wantStatus:  valueobject.GenerationJobStatusFailed,
+			wantContent: `{"text":"Old"}`,
+		},
+		{
+			name:        "provider error after usage",
+			componentID: component.ID,
+			err:         &service.UsageError{TokensUsed: 45, Err: errors.New("response was truncated")},
+			wantStatus:  valueobject.GenerationJobStatusFailed,
+			wantContent: `{"text":"Old"}`,
+			wantTokens:  45,
+		},
+		{
+			name:        "unknown component",
+			componentID: uuid.New(),
+			wantStatus:  valueobject.GenerationJobStatusFailed,
+			wantContent: `{"text":"Old"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored := *component
+			components := &regenComponentRepo{components: map[uuid.UUID]*entity.LessonComponent{component.ID: &stored}}
+			provider := &regenProvider{result: tt.result, err: tt.err}
+			usage := &recordingUsageRepo{}
+
+			input, _ := json.Marshal(map[string]string{
+				"component_id":        tt.componentID.String(),
+				"modification_prompt": "Make it shorter",
+			})
+			inputPath := string(input)
+			courseID := uuid.New()
+			jobs := &regenJobRepo{job: &entity.GenerationJob{
+				ID:         uuid.New(),
+				TenantID:   tenantID,
+				Type:       valueobject.GenerationJobTypeComponentRegen,
+				Status:     valueobject.GenerationJobStatusQueued,
+				CourseID:   &courseID,
+				LessonID:   &lesson.ID,
+				ResultPath: &inputPath,
+			}}
+
+			s := &AIGenerationService{
+				jobRepo:           jobs,
+				componentRepo:     components,
+				genLessonRepo:     &regenLessonRepo{lesson: lesson},
+				sectionRepo:       regenSectionRepo{},
+				genInputRepo:      regenGenInputRepo{},
+				aiProviderFactory: regenProviderFactory{provider: provider},
+				tokenBudget:       NewTokenBudget(&usageSettingsRepo{}, usage, nil, nopLogger{}),
+				logger:            nopLogger{},
+			}
+
+			_ = s.ProcessJobByID(context.Background(), jobs.job.ID.String())
+
+			if jobs.job.Status != tt.wantStatus {
+				t.Errorf("job status = %s, want %s", jobs.job.Status, tt.wantStatus)
+			}
+			if got := string(components.components[component.ID].ContentJSON); got != tt.wantContent {
+				t.Errorf("component content = %s, want %s", got, tt.wantContent)
+			}
+			var recorded int64
+			for _, entry := range usage.entries {
+				recorded += entry.TokensUsed
+			}
+			if recorded != tt.wantTokens {
+				t.Errorf("recorded %d tokens, want %d", recorded, tt.wantTokens)
+			}
+			if tt.result == nil {
+				return
+			}
+
+			if provider.req.ModificationPrompt != "Make it shorter" || provider.req.CurrentContentJSON != `{"text":"Old"}` {
+				t.Errorf("provider request = %+v", provider.req)
+			}
+			if provider.req.LessonContext != "Section: Pool Care\nLesson: Balancing pH" {
+				t.Errorf("lesson context = %q", provider.req.LessonContext)
+			}
+		})
+	}
+}
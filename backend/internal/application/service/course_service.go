@@ -17,12 +17,13 @@ import (
 // CourseService handles course and library operations.
 // Uses a hybrid model: metadata in PostgreSQL, content in S3.
 type CourseService struct {
-	courseRepo repository.CourseRepository
-	folderRepo repository.FolderRepository
-	userRepo   repository.UserRepository
-	storage    *storage.TenantAwareStorage
-	cache      cache.Cache
-	logger     service.Logger
+	courseRepo   repository.CourseRepository
+	folderRepo   repository.FolderRepository
+	userRepo     repository.UserRepository
+	storage      *storage.TenantAwareStorage
+	cache        cache.Cache
+	entitlements *EntitlementService
+	logger       service.Logger
 }
 
 // NewCourseService creates a new course service.
@@ -32,15 +33,17 @@ func NewCourseService(
 	userRepo repository.UserRepository,
 	storage *storage.TenantAwareStorage,
 	cache cache.Cache,
+	entitlements *EntitlementService,
 	logger service.Logger,
 ) *CourseService {
 	return &CourseService{
-		courseRepo: courseRepo,
-		folderRepo: folderRepo,
-		userRepo:   userRepo,
-		storage:    storage,
-		cache:      cache,
-		logger:     logger,
+		courseRepo:   courseRepo,
+		folderRepo:   folderRepo,
+		userRepo:     userRepo,
+		storage:      storage,
+		cache:        cache,
+		entitlements: entitlements,
+		logger:       logger,
 	}
 }
 
@@ -329,6 +332,10 @@ func (s *CourseService) CreateCourse(ctx context.Context, kratosID uuid.UUID, in
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.entitlements.CheckCanCreateCourse(ctx, *user.CompanyID); err != nil {
+		return nil, err
+	}
+
 	now := time.Now()
 	courseID := uuid.New()
 
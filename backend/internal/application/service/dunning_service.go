@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// DunningPolicy configures the follow-up on an overdue payment. Durations
+// count from the first failed payment.
+type DunningPolicy struct {
+	ReminderAfter []time.Duration // When reminder emails go out, e.g. 0, 3 and 7 days
+	RestrictAfter time.Duration   // When AI generation, exports, uploads and invitations are paused
+	SuspendAfter  time.Duration   // When the tenant is suspended
+}
+
+// PaymentPastDueNotifier tells a company's users about an overdue payment.
+type PaymentPastDueNotifier interface {
+	// NotifyPaymentPastDue creates an in-app notification and optionally emails the user.
+	NotifyPaymentPastDue(ctx context.Context, req NotifyPaymentPastDueRequest) error
+}
+
+// DunningService follows up on failed subscription payments: it reminds
+// owners by email and in-app, restricts costly features and finally suspends
+// the tenant until the payment is settled. Stripe invoice webhooks start and
+// end dunning; a scheduled task moves it along.
+type DunningService struct {
+	userRepo    repository.UserRepository
+	companyRepo repository.CompanyRepository
+	suspension  *TenantSuspensionService
+	notifier    PaymentPastDueNotifier
+	policy      DunningPolicy
+	logger      service.Logger
+}
+
+// NewDunningService creates a new dunning service.
+func NewDunningService(
+	userRepo repository.UserRepository,
+	companyRepo repository.CompanyRepository,
+	suspension *TenantSuspensionService,
+	notifier PaymentPastDueNotifier,
+	policy DunningPolicy,
+	logger service.Logger,
+) *DunningService {
+	return &DunningService{
+		userRepo:    userRepo,
+		companyRepo: companyRepo,
+		suspension:  suspension,
+		notifier:    notifier,
+		policy:      policy,
+		logger:      logger,
+	}
+}
+
+// HandlePaymentFailed processes an invoice.payment_failed webhook event.
+// The first failure starts dunning; later retries only update the invoice link.
+func (s *DunningService) HandlePaymentFailed(ctx context.Context, customerID, invoiceURL string) error {
+	log := s.logger.With("customerID", customerID)
+
+	company, err := s.companyRepo.GetByStripeCustomerID(ctx, customerID)
+	if err != nil || company == nil {
+		log.Error("company not found for customer", "error", err)
+		return domainerrors.ErrCompanyNotFound
+	}
+
+	now := time.Now()
+	fields := company.DunningFields()
+	if !company.IsInDunning() {
+		fields.PastDueSince = &now
+		log.Info("payment failed, dunning started", "companyID", company.ID)
+	}
+	if invoiceURL != "" {
+		fields.InvoiceURL = &invoiceURL
+	}
+
+	return s.advance(ctx, company, fields, now)
+}
+
+// HandleInvoicePaid processes an invoice.paid webhook event, ending dunning
+// and lifting any restriction or suspension it caused.
+func (s *DunningService) HandleInvoicePaid(ctx context.Context, customerID string) error {
+	log := s.logger.With("customerID", customerID)
+
+	company, err := s.companyRepo.GetByStripeCustomerID(ctx, customerID)
+	if err != nil || company == nil {
+		log.Error("company not found for customer", "error", err)
+		return domainerrors.ErrCompanyNotFound
+	}
+	if !company.IsInDunning() {
+		return nil // Regular renewal
+	}
+
+	if err := s.companyRepo.UpdateDunning(ctx, company.ID, entity.DunningFields{Stage: valueobject.DunningStageNone}); err != nil {
+		log.Error("failed to clear dunning", "companyID", company.ID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if err := s.suspension.HandleSubscriptionStatus(ctx, company.TenantID, valueobject.SubscriptionStatusActive); err != nil {
+		log.Error("failed to lift dunning restrictions", "companyID", company.ID, "tenantID", company.TenantID, "error", err)
+	}
+
+	log.Info("overdue payment settled, dunning ended", "companyID", company.ID, "stage", company.DunningStage)
+	return nil
+}
+
+// ProcessDunning sends reminders that fell due and restricts or suspends
+// tenants whose payment has been overdue long enough. Runs across tenants.
+func (s *DunningService) ProcessDunning(ctx context.Context) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	companies, err := s.companyRepo.ListInDunning(adminCtx)
+	if err != nil {
+		s.logger.Error("failed to list companies in dunning", "error", err)
+		return err
+	}
+
+	now := time.Now()
+	for _, company := range companies {
+		tenantCtx := tenant.WithTenantID(adminCtx, company.TenantID)
+		if err := s.advance(tenantCtx, company, company.DunningFields(), now); err != nil {
+			s.logger.Error("failed to advance dunning", "companyID", company.ID, "tenantID", company.TenantID, "error", err)
+		}
+	}
+	return nil
+}
+
+// advance moves the company's dunning fields to the stage due at now, sends at
+// most one notification covering any reminder or stage change, and saves them.
+func (s *DunningService) advance(ctx context.Context, company *entity.Company, fields entity.DunningFields, now time.Time) error {
+	log := s.logger.With("companyID", company.ID, "tenantID", company.TenantID)
+	overdue := now.Sub(*fields.PastDueSince)
+
+	if fields.Stage == valueobject.DunningStageNone {
+		fields.Stage = valueobject.DunningStageReminding
+	}
+
+	notify := false
+	switch {
+	case overdue >= s.policy.SuspendAfter && fields.Stage != valueobject.DunningStageSuspended:
+		if err := s.suspension.SuspendForNonPayment(ctx, company.TenantID); err != nil {
+			return err
+		}
+		fields.Stage = valueobject.DunningStageSuspended
+		notify = true
+	case overdue >= s.policy.RestrictAfter && fields.Stage == valueobject.DunningStageReminding:
+		if err := s.suspension.Restrict(ctx, company.TenantID); err != nil {
+			return err
+		}
+		fields.Stage = valueobject.DunningStageRestricted
+		notify = true
+	}
+
+	// Reminders missed while the worker was down are sent as one
+	remindersDue := 0
+	for _, after := range s.policy.ReminderAfter {
+		if overdue >= after {
+			remindersDue++
+		}
+	}
+	if remindersDue > fields.RemindersSent {
+		fields.RemindersSent = remindersDue
+		notify = true
+	}
+
+	if notify {
+		fields.LastReminderAt = &now
+		s.notify(ctx, company, fields, overdue)
+	}
+
+	if fields == company.DunningFields() {
+		return nil
+	}
+	if err := s.companyRepo.UpdateDunning(ctx, company.ID, fields); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if fields.Stage != company.DunningStage {
+		log.Warn("dunning stage changed", "from", company.DunningStage, "to", fields.Stage, "overdueFor", overdue.Round(time.Hour))
+	}
+	return nil
+}
+
+// notify reminds the company owner by email and in-app. Once features are
+// restricted every user of the company sees the in-app notification.
+func (s *DunningService) notify(ctx context.Context, company *entity.Company, fields entity.DunningFields, overdue time.Duration) {
+	log := s.logger.With("companyID", company.ID)
+	title, message := s.dunningMessage(fields.Stage, overdue)
+
+	invoiceURL := ""
+	if fields.InvoiceURL != nil {
+		invoiceURL = *fields.InvoiceURL
+	}
+
+	owner, err := s.userRepo.GetOwnerByCompanyID(ctx, company.ID)
+	if err != nil {
+		log.Error("failed to get company owner for payment reminder", "error", err)
+	}
+
+	var ownerID uuid.UUID
+	if owner != nil {
+		ownerID = owner.ID
+		if err := s.notifier.NotifyPaymentPastDue(ctx, NotifyPaymentPastDueRequest{
+			UserID:      owner.ID,
+			CompanyName: company.Name,
+			Title:       title,
+			Message:     message,
+			InvoiceURL:  invoiceURL,
+			SendEmail:   true,
+		}); err != nil {
+			log.Error("failed to notify owner of overdue payment", "userID", owner.ID, "error", err)
+		}
+	}
+
+	if fields.Stage == valueobject.DunningStageReminding {
+		return
+	}
+
+	users, err := s.userRepo.ListByCompanyID(ctx, company.ID)
+	if err != nil {
+		log.Error("failed to list company users for overdue payment", "error", err)
+		return
+	}
+	for _, user := range users {
+		if user.ID == ownerID {
+			continue
+		}
+		if err := s.notifier.NotifyPaymentPastDue(ctx, NotifyPaymentPastDueRequest{
+			UserID:      user.ID,
+			CompanyName: company.Name,
+			Title:       title,
+			Message:     message,
+		}); err != nil {
+			log.Error("failed to notify user of overdue payment", "userID", user.ID, "error", err)
+		}
+	}
+}
+
+// dunningMessage returns the notification title and message for a stage.
+func (s *DunningService) dunningMessage(stage valueobject.DunningStage, overdue time.Duration) (string, string) {
+	switch stage {
+	case valueobject.DunningStageSuspended:
+		return "Workspace suspended",
+			"The workspace is suspended because the subscription payment is still overdue. Update the payment method to restore access."
+	case valueobject.DunningStageRestricted:
+		return "Features paused",
+			fmt.Sprintf("AI generation, exports, uploads and invitations are paused until the overdue payment is settled. The workspace will be suspended in %s.",
+				formatDays(s.policy.SuspendAfter-overdue))
+	default:
+		return "Payment overdue",
+			fmt.Sprintf("The latest subscription payment failed. Update the payment method to avoid interruptions: features will be paused in %s.",
+				formatDays(s.policy.RestrictAfter-overdue))
+	}
+}
+
+// formatDays renders a duration as a whole number of days, rounding up.
+func formatDays(d time.Duration) string {
+	days := int((d + 24*time.Hour - 1) / (24 * time.Hour))
+	if days <= 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", days)
+}
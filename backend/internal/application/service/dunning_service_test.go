@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+const day = 24 * time.Hour
+
+var testDunningPolicy = DunningPolicy{
+	ReminderAfter: []time.Duration{0, 3 * day, 7 * day},
+	RestrictAfter: 10 * day,
+	SuspendAfter:  20 * day,
+}
+
+// dunningCompanyRepo records dunning updates. Other methods are not used by advance.
+type dunningCompanyRepo struct {
+	repository.CompanyRepository
+	updates []entity.DunningFields
+}
+
+func (r *dunningCompanyRepo) UpdateDunning(_ context.Context, _ uuid.UUID, fields entity.DunningFields) error {
+	r.updates = append(r.updates, fields)
+	return nil
+}
+
+// dunningUserRepo serves a company's owner and users.
+type dunningUserRepo struct {
+	repository.UserRepository
+	owner *entity.User
+	users []*entity.User
+}
+
+func (r *dunningUserRepo) GetOwnerByCompanyID(context.Context, uuid.UUID) (*entity.User, error) {
+	return r.owner, nil
+}
+
+func (r *dunningUserRepo) ListByCompanyID(context.Context, uuid.UUID) ([]*entity.User, error) {
+	return r.users, nil
+}
+
+// dunningTenantRepo holds tenants in memory.
+type dunningTenantRepo struct {
+	repository.TenantRepository
+	tenants map[uuid.UUID]*entity.Tenant
+}
+
+func (r *dunningTenantRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.Tenant, error) {
+	return r.tenants[id], nil
+}
+
+func (r *dunningTenantRepo) Update(_ context.Context, t *entity.Tenant) error {
+	r.tenants[t.ID] = t
+	return nil
+}
+
+// nopCache lets status invalidation succeed.
+type nopCache struct {
+	cache.Cache
+}
+
+func (nopCache) Delete(context.Context, string) error { return nil }
+
+// recordingNotifier records the notifications sent.
+type recordingNotifier struct {
+	sent []NotifyPaymentPastDueRequest
+}
+
+func (n *recordingNotifier) NotifyPaymentPastDue(_ context.Context, req NotifyPaymentPastDueRequest) error {
+	n.sent = append(n.sent, req)
+	return nil
+}
+
+func TestDunningAdvance(t *testing.T) {
+	tests := []struct {
+		name          string
+		stage         valueobject.DunningStage
+		remindersSent int
+		overdue       time.Duration
+
+		wantStage     valueobject.DunningStage
+		wantReminders int
+		wantSaved     bool
+		wantNotified  int // Notifications sent; the owner plus, once restricted, the other user
+		wantTenant    func(*entity.Tenant) bool
+	}{
+		{
+			name:          "first failure starts reminding",
+			stage:         valueobject.DunningStageNone,
+			overdue:       0,
+			wantStage:     valueobject.DunningStageReminding,
+			wantReminders: 1,
+			wantSaved:     true,
+			wantNotified:  1,
+		},
+		{
+			name:          "nothing due between reminders",
+			stage:         valueobject.DunningStageReminding,
+			remindersSent: 1,
+			overdue:       day,
+			wantStage:     valueobject.DunningStageReminding,
+			wantReminders: 1,
+		},
+		{
+			name:          "second reminder",
+			stage:         valueobject.DunningStageReminding,
+			remindersSent: 1,
+			overdue:       3 * day,
+			wantStage:     valueobject.DunningStageReminding,
+			wantReminders: 2,
+			wantSaved:     true,
+			wantNotified:  1,
+		},
+		{
+			name:          "missed reminders are sent as one",
+			stage:         valueobject.DunningStageReminding,
+			remindersSent: 1,
+			overdue:       8 * day,
+			wantStage:     valueobject.DunningStageReminding,
+			wantReminders: 3,
+			wantSaved:     true,
+			wantNotified:  1,
+		},
+		{
+			name:          "restricts after the restriction delay",
+			stage:         valueobject.DunningStageReminding,
+			remindersSent: 3,
+			overdue:       10 * day,
+			wantStage:     valueobject.DunningStageRestricted,
+			wantReminders: 3,
+			wantSaved:     true,
+			wantNotified:  2,
+			wantTenant:    func(t *entity.Tenant) bool { return t.IsRestricted() && !t.IsSuspended() },
+		},
+		{
+			name:          "stays restricted until the suspension delay",
+			stage:         valueobject.DunningStageRestricted,
+			remindersSent: 3,
+			overdue:       15 * day,
+			wantStage:     valueobject.DunningStageRestricted,
+			wantReminders: 3,
+		},
+		{
+			name:          "suspends after the suspension delay",
+			stage:         valueobject.DunningStageRestricted,
+			remindersSent: 3,
+			overdue:       20 * day,
+			wantStage:     valueobject.DunningStageSuspended,
+			wantReminders: 3,
+			wantSaved:     true,
+			wantNotified:  2,
+			wantTenant:    func(t *entity.Tenant) bool { return t.IsSuspendedForBilling() },
+		},
+		{
+			name:          "suspends directly when restriction was missed",
+			stage:         valueobject.DunningStageReminding,
+			remindersSent: 1,
+			overdue:       25 * day,
+			wantStage:     valueobject.DunningStageSuspended,
+			wantReminders: 3,
+			wantSaved:     true,
+			wantNotified:  2,
+			wantTenant:    func(t *entity.Tenant) bool { return t.IsSuspendedForBilling() },
+		},
+		{
+			name:          "suspended is final",
+			stage:         valueobject.DunningStageSuspended,
+			remindersSent: 3,
+			overdue:       40 * day,
+			wantStage:     valueobject.DunningStageSuspended,
+			wantReminders: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			pastDueSince := now.Add(-tt.overdue)
+			invoiceURL := "https://invoice.example/1"
+
+			tenantID := uuid.New()
+			ten := &entity.Tenant{ID: tenantID, Status: entity.TenantStatusActive}
+			if tt.stage == valueobject.DunningStageRestricted {
+				ten.Restrict()
+			}
+			company := &entity.Company{
+				ID:                   uuid.New(),
+				TenantID:             tenantID,
+				Name:                 "Acme Spas",
+				PastDueSince:         &pastDueSince,
+				DunningStage:         tt.stage,
+				DunningRemindersSent: tt.remindersSent,
+				DunningInvoiceURL:    &invoiceURL,
+			}
+			owner := &entity.User{ID: uuid.New()}
+			member := &entity.User{ID: uuid.New()}
+
+			companyRepo := &dunningCompanyRepo{}
+			tenantRepo := &dunningTenantRepo{tenants: map[uuid.UUID]*entity.Tenant{tenantID: ten}}
+			notifier := &recordingNotifier{}
+			s := NewDunningService(
+				&dunningUserRepo{owner: owner, users: []*entity.User{owner, member}},
+				companyRepo,
+				NewTenantSuspensionService(tenantRepo, nopCache{}, nopLogger{}),
+				notifier,
+				testDunningPolicy,
+				nopLogger{},
+			)
+
+			if err := s.advance(context.Background(), company, company.DunningFields(), now); err != nil {
+				t.Fatalf("advance() error = %v", err)
+			}
+
+			if !tt.wantSaved {
+				if len(companyRepo.updates) != 0 {
+					t.Errorf("saved %+v, want no update", companyRepo.updates)
+				}
+			} else {
+				if len(companyRepo.updates) != 1 {
+					t.Fatalf("saved %d updates, want 1", len(companyRepo.updates))
+				}
+				saved := companyRepo.updates[0]
+				if saved.Stage != tt.wantStage {
+					t.Errorf("stage = %s, want %s", saved.Stage, tt.wantStage)
+				}
+				if saved.RemindersSent != tt.wantReminders {
+					t.Errorf("reminders sent = %d, want %d", saved.RemindersSent, tt.wantReminders)
+				}
+				if saved.LastReminderAt == nil || !saved.LastReminderAt.Equal(now) {
+					t.Errorf("last reminder at = %v, want %v", saved.LastReminderAt, now)
+				}
+			}
+
+			if len(notifier.sent) != tt.wantNotified {
+				t.Fatalf("sent %d notifications, want %d", len(notifier.sent), tt.wantNotified)
+			}
+			for i, req := range notifier.sent {
+				isOwner := req.UserID == owner.ID
+				if isOwner != (i == 0) {
+					t.Errorf("notification %d went to %s, want the owner first and once", i, req.UserID)
+				}
+				if req.SendEmail != isOwner || (req.InvoiceURL != "") != isOwner {
+					t.Errorf("notification %d: email %v, invoice %q; only the owner is emailed the invoice", i, req.SendEmail, req.InvoiceURL)
+				}
+			}
+
+			if tt.wantTenant != nil && !tt.wantTenant(tenantRepo.tenants[tenantID]) {
+				t.Errorf("tenant = %+v, not in the expected state", tenantRepo.tenants[tenantID])
+			}
+		})
+	}
+}
+
+func TestDunningAdvanceKeepsStageWhenSuspensionFails(t *testing.T) {
+	now := time.Now()
+	pastDueSince := now.Add(-30 * day)
+	company := &entity.Company{
+		ID:                   uuid.New(),
+		TenantID:             uuid.New(), // Unknown to the tenant repository
+		PastDueSince:         &pastDueSince,
+		DunningStage:         valueobject.DunningStageRestricted,
+		DunningRemindersSent: 3,
+	}
+
+	companyRepo := &dunningCompanyRepo{}
+	notifier := &recordingNotifier{}
+	s := NewDunningService(
+		&dunningUserRepo{},
+		companyRepo,
+		NewTenantSuspensionService(&dunningTenantRepo{tenants: map[uuid.UUID]*entity.Tenant{}}, nopCache{}, nopLogger{}),
+		notifier,
+		testDunningPolicy,
+		nopLogger{},
+	)
+
+	if err := s.advance(context.Background(), company, company.DunningFields(), now); err == nil {
+		t.Fatal("advance() succeeded although the tenant could not be suspended")
+	}
+	if len(companyRepo.updates) != 0 || len(notifier.sent) != 0 {
+		t.Errorf("saved %d updates and sent %d notifications, want none", len(companyRepo.updates), len(notifier.sent))
+	}
+}
+
+func TestFormatDays(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{-day, "1 day"},
+		{time.Hour, "1 day"},
+		{day, "1 day"},
+		{day + time.Hour, "2 days"},
+		{10 * day, "10 days"},
+	}
+	for _, tt := range tests {
+		if got := formatDays(tt.d); got != tt.want {
+			t.Errorf("formatDays(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
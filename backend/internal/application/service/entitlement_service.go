@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// EntitlementService enforces what a company's plan includes: how many SMEs
+// and courses it may have, its monthly AI tokens, export formats and
+// features. Refusals tell the user which plan would allow the action.
+type EntitlementService struct {
+	companyRepo repository.CompanyRepository
+	smeRepo     repository.SMERepository
+	courseRepo  repository.CourseRepository
+	logger      service.Logger
+}
+
+// NewEntitlementService creates a new entitlement service.
+func NewEntitlementService(
+	companyRepo repository.CompanyRepository,
+	smeRepo repository.SMERepository,
+	courseRepo repository.CourseRepository,
+	logger service.Logger,
+) *EntitlementService {
+	return &EntitlementService{
+		companyRepo: companyRepo,
+		smeRepo:     smeRepo,
+		courseRepo:  courseRepo,
+		logger:      logger,
+	}
+}
+
+// GetForCompany returns the company's entitlements, including enterprise overrides.
+func (s *EntitlementService) GetForCompany(ctx context.Context, companyID uuid.UUID) (entity.Entitlements, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return entity.Entitlements{}, domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return entity.Entitlements{}, domainerrors.ErrCompanyNotFound
+	}
+	return company.Entitlements(), nil
+}
+
+// GetUsage returns how many active SMEs and courses count against the company's limits.
+func (s *EntitlementService) GetUsage(ctx context.Context, companyID uuid.UUID) (smes, courses int, err error) {
+	smes, err = s.smeRepo.CountActiveByCompanyID(ctx, companyID)
+	if err != nil {
+		return 0, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	courses, err = s.courseRepo.Count(ctx, entity.CourseListOptions{})
+	if err != nil {
+		return 0, 0, domainerrors.ErrInternal.WithCause(err)
+	}
+	return smes, courses, nil
+}
+
+// CheckCanCreateSME returns ErrPlanLimitReached if the company already has
+// as many SMEs as its plan includes. Archived SMEs don't count.
+func (s *EntitlementService) CheckCanCreateSME(ctx context.Context, companyID uuid.UUID) error {
+	entitlements, err := s.GetForCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if entitlements.MaxSMEs == 0 {
+		return nil
+	}
+
+	count, err := s.smeRepo.CountActiveByCompanyID(ctx, companyID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if entitlements.AllowsSMEs(count + 1) {
+		return nil
+	}
+
+	return domainerrors.ErrPlanLimitReached.WithMessage(fmt.Sprintf("The %s plan includes up to %d SMEs. %s",
+		entitlements.Plan.DisplayName(), entitlements.MaxSMEs,
+		upgradeHint(entitlements.Plan, func(e entity.Entitlements) (bool, string) {
+			return e.AllowsSMEs(count + 1), describeLimit(e.MaxSMEs, "SMEs")
+		})))
+}
+
+// CheckCanCreateCourse returns ErrPlanLimitReached if the company already has
+// as many courses as its plan includes.
+func (s *EntitlementService) CheckCanCreateCourse(ctx context.Context, companyID uuid.UUID) error {
+	entitlements, err := s.GetForCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if entitlements.MaxCourses == 0 {
+		return nil
+	}
+
+	// Courses are counted in the tenant in the context, which holds the company
+	count, err := s.courseRepo.Count(ctx, entity.CourseListOptions{})
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if entitlements.AllowsCourses(count + 1) {
+		return nil
+	}
+
+	return domainerrors.ErrPlanLimitReached.WithMessage(fmt.Sprintf("The %s plan includes up to %d courses. %s",
+		entitlements.Plan.DisplayName(), entitlements.MaxCourses,
+		upgradeHint(entitlements.Plan, func(e entity.Entitlements) (bool, string) {
+			return e.AllowsCourses(count + 1), describeLimit(e.MaxCourses, "courses")
+		})))
+}
+
+// CheckExportFormat returns ErrPlanFeatureUnavailable if the company's plan
+// doesn't include exports in the format.
+func (s *EntitlementService) CheckExportFormat(ctx context.Context, companyID uuid.UUID, format valueobject.ExportFormat) error {
+	entitlements, err := s.GetForCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if entitlements.AllowsExportFormat(format) {
+		return nil
+	}
+
+	return domainerrors.ErrPlanFeatureUnavailable.WithMessage(fmt.Sprintf("The %s plan doesn't include %s exports. %s",
+		entitlements.Plan.DisplayName(), format.DisplayName(),
+		upgradeHint(entitlements.Plan, func(e entity.Entitlements) (bool, string) {
+			return e.AllowsExportFormat(format), format.DisplayName() + " exports"
+		})))
+}
+
+// CheckFeature returns ErrPlanFeatureUnavailable if the company's plan
+// doesn't include the feature.
+func (s *EntitlementService) CheckFeature(ctx context.Context, companyID uuid.UUID, feature valueobject.PlanFeature) error {
+	entitlements, err := s.GetForCompany(ctx, companyID)
+	if err != nil {
+		return err
+	}
+	if entitlements.HasFeature(feature) {
+		return nil
+	}
+
+	return domainerrors.ErrPlanFeatureUnavailable.WithMessage(fmt.Sprintf("The %s plan doesn't include %s. %s",
+		entitlements.Plan.DisplayName(), feature.DisplayName(),
+		upgradeHint(entitlements.Plan, func(e entity.Entitlements) (bool, string) {
+			return e.HasFeature(feature), feature.DisplayName()
+		})))
+}
+
+// CheckMonthlyTokens returns ErrTokenLimitExceeded if the tenant has used the
+// AI tokens its plan includes this month, or needed more tokens would exceed them.
+func (s *EntitlementService) CheckMonthlyTokens(ctx context.Context, tenantID uuid.UUID, used, needed int64) error {
+	company, err := s.companyRepo.GetByTenantID(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return nil // Nothing to bill the usage to; the tenant's own limit still applies
+	}
+
+	entitlements := company.Entitlements()
+	allowance := entitlements.MonthlyAITokens
+	if allowance == 0 || used+needed <= allowance {
+		return nil
+	}
+
+	hint := upgradeHint(entitlements.Plan, func(e entity.Entitlements) (bool, string) {
+		if e.MonthlyAITokens == 0 {
+			return true, "unlimited AI tokens"
+		}
+		return used+needed <= e.MonthlyAITokens, fmt.Sprintf("%d AI tokens a month", e.MonthlyAITokens)
+	})
+	if used >= allowance {
+		return domainerrors.ErrTokenLimitExceeded.WithMessage(fmt.Sprintf("The %s plan includes %d AI tokens a month and %d were used this month. %s",
+			entitlements.Plan.DisplayName(), allowance, used, hint))
+	}
+	return domainerrors.ErrTokenLimitExceeded.WithMessage(fmt.Sprintf("The %s plan includes %d AI tokens a month: %d used this month, about %d more needed. %s",
+		entitlements.Plan.DisplayName(), allowance, used, needed, hint))
+}
+
+// SetOverrides stores enterprise contract terms for a company. Nil overrides
+// restore the plan's entitlements.
+func (s *EntitlementService) SetOverrides(ctx context.Context, companyID uuid.UUID, overrides *entity.EntitlementOverrides) (entity.Entitlements, error) {
+	company, err := s.companyRepo.GetByID(ctx, companyID)
+	if err != nil {
+		return entity.Entitlements{}, domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return entity.Entitlements{}, domainerrors.ErrCompanyNotFound
+	}
+	if overrides != nil && company.Plan != valueobject.PlanEnterprise {
+		return entity.Entitlements{}, domainerrors.ErrInvalidInput.WithMessage("entitlement overrides are only available on the enterprise plan")
+	}
+	if overrides != nil {
+		for _, format := range overrides.ExportFormats {
+			if !format.IsValid() {
+				return entity.Entitlements{}, domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("invalid export format: %s", format))
+			}
+		}
+	}
+
+	if err := s.companyRepo.UpdateEntitlementOverrides(ctx, companyID, overrides); err != nil {
+		return entity.Entitlements{}, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	company.EntitlementOverrides = overrides
+	return company.Entitlements(), nil
+}
+
+// upgradeHint names the first plan above current that allows what was refused,
+// using the description allows returns for that plan.
+func upgradeHint(current valueobject.Plan, allows func(entity.Entitlements) (bool, string)) string {
+	above := false
+	for _, plan := range valueobject.AllPlans() {
+		if plan == current {
+			above = true
+			continue
+		}
+		if !above {
+			continue
+		}
+		if ok, description := allows(entity.PlanEntitlements(plan)); ok {
+			return fmt.Sprintf("Upgrade to %s for %s.", plan.DisplayName(), description)
+		}
+	}
+	return "Contact sales to raise your plan's limits."
+}
+
+// describeLimit renders a limit for an upgrade hint, e.g. "up to 25 SMEs".
+func describeLimit(limit int, noun string) string {
+	if limit == 0 {
+		return "unlimited " + noun
+	}
+	return fmt.Sprintf("up to %d %s", limit, noun)
+}
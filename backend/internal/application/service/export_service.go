@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const (
+	// exportDownloadURLExpiry is how long a presigned export download URL stays valid.
+	exportDownloadURLExpiry = 15 * time.Minute
+
+	// exportStaleAfter is how long an export may stay processing before its
+	// worker is considered gone and another may take it over.
+	exportStaleAfter = 10 * time.Minute
+
+	// exportGiveUpAfter is how old a stale export may be and still be retried.
+	// Older ones are failed so an export that crashes its worker isn't retried forever.
+	exportGiveUpAfter = time.Hour
+)
+
+// ExportStorage stores rendered export artifacts and issues download URLs.
+type ExportStorage interface {
+	ExportPath(tenantID, exportID uuid.UUID, filename string) string
+	WriteExportFile(ctx context.Context, tenantID, exportID uuid.UUID, filename string, content []byte, contentType string) error
+	GenerateExportDownloadURL(ctx context.Context, tenantID, exportID uuid.UUID, filename string, expiry time.Duration) (string, error)
+}
+
+// ExportTaskEnqueuer enqueues course export tasks for background processing.
+type ExportTaskEnqueuer interface {
+	// EnqueueCourseExport enqueues an export for immediate processing.
+	EnqueueCourseExport(exportID string) error
+}
+
+// StatementForwarder issues the statement forwarding settings embedded in
+// xAPI-emitting packages.
+type StatementForwarder interface {
+	// StatementForwarding returns (nil, nil) if the tenant has no LRS configured.
+	StatementForwarding(ctx context.Context, export *entity.CourseExport) (*service.StatementForwarding, error)
+}
+
+// ExportService handles rendering courses into downloadable packages.
+// Exports are tracked in PostgreSQL and rendered by the Asynq worker;
+// the resulting artifact is stored in S3 under the tenant's exports path.
+type ExportService struct {
+	userRepo      repository.UserRepository
+	courseRepo    repository.CourseRepository
+	exportRepo    repository.CourseExportRepository
+	outlineRepo   repository.CourseOutlineRepository
+	sectionRepo   repository.OutlineSectionRepository
+	lessonRepo    repository.OutlineLessonRepository
+	genLessonRepo repository.GeneratedLessonRepository
+	componentRepo repository.LessonComponentRepository
+	storage       ExportStorage
+	exporters     map[valueobject.ExportFormat]service.CourseExporter
+	statements    StatementForwarder  // Optional, nil when LRS settings are unavailable
+	tenantAccess  TenantAccessChecker // Optional, nil renders exports of any tenant
+	taskEnqueuer  ExportTaskEnqueuer
+	entitlements  *EntitlementService
+	logger        service.Logger
+}
+
+// NewExportService creates a new export service.
+// Each exporter is registered under the format it reports.
+func NewExportService(
+	userRepo repository.UserRepository,
+	courseRepo repository.CourseRepository,
+	exportRepo repository.CourseExportRepository,
+	outlineRepo repository.CourseOutlineRepository,
+	sectionRepo repository.OutlineSectionRepository,
+	lessonRepo repository.OutlineLessonRepository,
+	genLessonRepo repository.GeneratedLessonRepository,
+	componentRepo repository.LessonComponentRepository,
+	storage ExportStorage,
+	exporters []service.CourseExporter,
+	statements StatementForwarder,
+	tenantAccess TenantAccessChecker,
+	taskEnqueuer ExportTaskEnqueuer,
+	entitlements *EntitlementService,
+	logger service.Logger,
+) *ExportService {
+	registry := make(map[valueobject.ExportFormat]service.CourseExporter, len(exporters))
+	for _, exporter := range exporters {
+		registry[exporter.Format()] = exporter
+	}
+
+	return &ExportService{
+		userRepo:      userRepo,
+		courseRepo:    courseRepo,
+		exportRepo:    exportRepo,
+		outlineRepo:   outlineRepo,
+		sectionRepo:   sectionRepo,
+		lessonRepo:    lessonRepo,
+		genLessonRepo: genLessonRepo,
+		componentRepo: componentRepo,
+		storage:       storage,
+		exporters:     registry,
+		statements:    statements,
+		tenantAccess:  tenantAccess,
+		taskEnqueuer:  taskEnqueuer,
+		entitlements:  entitlements,
+		logger:        logger,
+	}
+}
+
+// ExportCourse creates a pending export and enqueues it for rendering.
+func (s *ExportService) ExportCourse(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID, format valueobject.ExportFormat) (*entity.CourseExport, error) {
+	log := s.logger.With("kratosID", kratosID, "courseID", courseID, "format", format)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if user.TenantID == nil || user.CompanyID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if _, ok := s.exporters[format]; !ok {
+		return nil, domainerrors.ErrExportFormatUnsupported.WithMessage(fmt.Sprintf("export format %q is not supported", format))
+	}
+
+	if err := s.entitlements.CheckExportFormat(ctx, *user.CompanyID, format); err != nil {
+		return nil, err
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		log.Error("failed to get course", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrCourseNotFound
+	}
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, courseID)
+	if err != nil || outline == nil {
+		return nil, domainerrors.ErrCourseOutlineNotFound.WithMessage("course has no outline to export")
+	}
+
+	export := &entity.CourseExport{
+		TenantID:          course.TenantID,
+		CourseID:          course.ID,
+		Version:           course.Version,
+		Format:            format,
+		Status:            valueobject.ExportStatusPending,
+		RequestedByUserID: &user.ID,
+	}
+
+	if err := s.exportRepo.Create(ctx, export); err != nil {
+		log.Error("failed to create export", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("course export created", "exportID", export.ID)
+
+	// Exports have no polling sweep, so an enqueue failure fails the export
+	// immediately rather than leaving it pending forever.
+	if err := s.taskEnqueuer.EnqueueCourseExport(export.ID.String()); err != nil {
+		log.Error("failed to enqueue export", "exportID", export.ID, "error", err)
+		_ = s.failExport(ctx, export, "failed to queue export")
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return export, nil
+}
+
+// GetExport retrieves an export by ID.
+func (s *ExportService) GetExport(ctx context.Context, kratosID uuid.UUID, exportID uuid.UUID) (*entity.CourseExport, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	export, err := s.exportRepo.GetByID(ctx, exportID)
+	if err != nil {
+		s.logger.Error("failed to get export", "exportID", exportID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if export == nil {
+		return nil, domainerrors.ErrExportNotFound
+	}
+
+	return export, nil
+}
+
+// ListExports returns all exports for a course, newest first.
+func (s *ExportService) ListExports(ctx context.Context, kratosID uuid.UUID, courseID uuid.UUID) ([]*entity.CourseExport, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	course, err := s.courseRepo.GetByID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to get course", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if course == nil {
+		return nil, domainerrors.ErrCourseNotFound
+	}
+
+	exports, err := s.exportRepo.ListByCourseID(ctx, courseID)
+	if err != nil {
+		s.logger.Error("failed to list exports", "courseID", courseID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return exports, nil
+}
+
+// GetDownloadURL returns a presigned URL for a completed export and when it expires.
+func (s *ExportService) GetDownloadURL(ctx context.Context, kratosID uuid.UUID, exportID uuid.UUID) (string, time.Time, error) {
+	export, err := s.GetExport(ctx, kratosID, exportID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if export.Status != valueobject.ExportStatusCompleted || export.FileName == nil {
+		return "", time.Time{}, domainerrors.ErrExportNotReady
+	}
+
+	expiresAt := time.Now().Add(exportDownloadURLExpiry)
+	url, err := s.storage.GenerateExportDownloadURL(ctx, export.TenantID, export.ID, *export.FileName, exportDownloadURLExpiry)
+	if errors.Is(err, domainerrors.ErrTenantSuspended) {
+		return "", time.Time{}, err
+	}
+	if err != nil {
+		s.logger.Error("failed to generate export download URL", "exportID", exportID, "error", err)
+		return "", time.Time{}, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return url, expiresAt, nil
+}
+
+// ProcessExportByID renders a specific export.
+// This is used by the Asynq worker. Uses atomic claim so duplicate deliveries
+// are no-ops, while an export abandoned by a stopped worker can be taken over.
+func (s *ExportService) ProcessExportByID(ctx context.Context, exportID string) error {
+	log := s.logger.With("exportID", exportID)
+
+	id, err := uuid.Parse(exportID)
+	if err != nil {
+		log.Error("invalid export ID", "error", err)
+		return fmt.Errorf("invalid export ID: %w", err)
+	}
+
+	// Use superadmin context for atomic claim (before we know its tenant)
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	export, err := s.exportRepo.ClaimByID(adminCtx, id, exportStaleAfter)
+	if err != nil {
+		log.Error("failed to claim export", "error", err)
+		return err
+	}
+	if export == nil {
+		log.Info("export not available for claim, may already be processed")
+		return nil
+	}
+
+	// Scope all subsequent operations to the export's tenant
+	tenantCtx := tenant.WithTenantID(adminCtx, export.TenantID)
+
+	// Exports are not rendered for suspended tenants
+	if s.tenantAccess != nil {
+		if err := s.tenantAccess.CheckTenantActive(tenantCtx, export.TenantID); err != nil {
+			log.Warn("not rendering export", "tenantID", export.TenantID, "error", err)
+			return s.failExport(tenantCtx, export, "The workspace is suspended")
+		}
+	}
+
+	return s.processExport(tenantCtx, export)
+}
+
+// RecoverStaleExports enqueues exports a stopped worker left processing again,
+// or fails them once they are too old to retry. Runs across tenants.
+func (s *ExportService) RecoverStaleExports(ctx context.Context) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	exports, err := s.exportRepo.ListStale(adminCtx, exportStaleAfter)
+	if err != nil {
+		s.logger.Error("failed to list stale exports", "error", err)
+		return err
+	}
+
+	for _, export := range exports {
+		tenantCtx := tenant.WithTenantID(adminCtx, export.TenantID)
+		log := s.logger.With("exportID", export.ID, "tenantID", export.TenantID)
+
+		if time.Since(export.CreatedAt) > exportGiveUpAfter {
+			log.Warn("stale export is too old to retry, marking as failed")
+			_ = s.failExport(tenantCtx, export, "The export stopped unexpectedly. Please export the course again.")
+			continue
+		}
+
+		if err := s.taskEnqueuer.EnqueueCourseExport(export.ID.String()); err != nil {
+			log.Warn("failed to enqueue stale export", "error", err)
+			continue
+		}
+		log.Info("requeued stale export")
+	}
+
+	return nil
+}
+
+// processExport loads the course content, renders it and stores the artifact.
+func (s *ExportService) processExport(ctx context.Context, export *entity.CourseExport) error {
+	log := s.logger.With("exportID", export.ID, "courseID", export.CourseID, "format", export.Format)
+	log.Info("processing course export")
+
+	exporter, ok := s.exporters[export.Format]
+	if !ok {
+		return s.failExport(ctx, export, fmt.Sprintf("export format %q is not supported", export.Format))
+	}
+
+	req, err := s.buildExportRequest(ctx, export)
+	if err != nil {
+		log.Error("failed to load course content", "error", err)
+		return s.failExport(ctx, export, fmt.Sprintf("failed to load course content: %v", err))
+	}
+
+	// xAPI-emitting packages forward statements to the tenant's LRS through
+	// Mirai when one is configured
+	if s.statements != nil {
+		forwarding, err := s.statements.StatementForwarding(ctx, export)
+		if err != nil {
+			log.Warn("failed to load LRS settings, exporting without LRS", "error", err)
+		} else {
+			req.StatementForwarding = forwarding
+		}
+	}
+
+	result, err := exporter.Export(ctx, *req)
+	if err != nil {
+		log.Error("failed to render export", "error", err)
+		return s.failExport(ctx, export, fmt.Sprintf("failed to render export: %v", err))
+	}
+
+	if err := s.storage.WriteExportFile(ctx, export.TenantID, export.ID, result.FileName, result.Content, result.ContentType); err != nil {
+		log.Error("failed to store export", "error", err)
+		return s.failExport(ctx, export, fmt.Sprintf("failed to store export: %v", err))
+	}
+
+	storagePath := s.storage.ExportPath(export.TenantID, export.ID, result.FileName)
+	size := int64(len(result.Content))
+	now := time.Now()
+	export.Status = valueobject.ExportStatusCompleted
+	export.StoragePath = &storagePath
+	export.FileName = &result.FileName
+	export.SizeBytes = &size
+	export.ErrorMessage = nil
+	export.CompletedAt = &now
+
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		log.Error("failed to mark export completed", "error", err)
+		return err
+	}
+
+	log.Info("course export completed", "fileName", result.FileName, "sizeBytes", size)
+	return nil
+}
+
+// buildExportRequest assembles the outline and generated lessons for rendering.
+func (s *ExportService) buildExportRequest(ctx context.Context, export *entity.CourseExport) (*service.CourseExportRequest, error) {
+	course, err := s.courseRepo.GetByID(ctx, export.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if course == nil {
+		return nil, fmt.Errorf("course not found")
+	}
+
+	outline, err := s.outlineRepo.GetByCourseID(ctx, export.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if outline == nil {
+		return nil, fmt.Errorf("course outline not found")
+	}
+
+	sections, err := s.sectionRepo.ListByOutlineID(ctx, outline.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sections: %w", err)
+	}
+
+	req := &service.CourseExportRequest{
+		ExportID:    export.ID,
+		CourseID:    course.ID,
+		TenantID:    course.TenantID,
+		CourseTitle: course.Title,
+		Version:     export.Version,
+		Sections:    make([]service.ExportSection, 0, len(sections)),
+	}
+
+	for _, section := range sections {
+		lessons, err := s.lessonRepo.ListBySectionID(ctx, section.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list lessons for section %s: %w", section.ID, err)
+		}
+
+		exportSection := service.ExportSection{
+			ID:          section.ID,
+			Title:       section.Title,
+			Description: section.Description,
+			Lessons:     make([]service.ExportLesson, 0, len(lessons)),
+		}
+
+		for _, lesson := range lessons {
+			exportLesson := service.ExportLesson{
+				ID:                       lesson.ID,
+				Title:                    lesson.Title,
+				Description:              lesson.Description,
+				LearningObjectives:       lesson.LearningObjectives,
+				EstimatedDurationMinutes: lesson.EstimatedDurationMinutes,
+			}
+
+			// Lessons that haven't been generated yet are exported with their outline only
+			genLesson, err := s.genLessonRepo.GetByOutlineLessonID(ctx, lesson.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get generated lesson for %s: %w", lesson.ID, err)
+			}
+			if genLesson != nil {
+				components, err := s.componentRepo.ListByLessonID(ctx, genLesson.ID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to list components for lesson %s: %w", genLesson.ID, err)
+				}
+				exportLesson.Components = make([]entity.LessonComponent, len(components))
+				for i, c := range components {
+					exportLesson.Components[i] = *c
+				}
+				if genLesson.SegueText != nil {
+					exportLesson.SegueText = *genLesson.SegueText
+				}
+			}
+
+			exportSection.Lessons = append(exportSection.Lessons, exportLesson)
+		}
+
+		req.Sections = append(req.Sections, exportSection)
+	}
+
+	return req, nil
+}
+
+// failExport marks an export as failed with an error message.
+func (s *ExportService) failExport(ctx context.Context, export *entity.CourseExport, errMsg string) error {
+	now := time.Now()
+	export.Status = valueobject.ExportStatusFailed
+	export.ErrorMessage = &errMsg
+	export.CompletedAt = &now
+
+	if err := s.exportRepo.Update(ctx, export); err != nil {
+		s.logger.Error("failed to mark export as failed", "exportID", export.ID, "error", err)
+	}
+
+	return fmt.Errorf("%s", errMsg)
+}
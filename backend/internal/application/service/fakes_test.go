@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// nopLogger discards log output in tests.
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any)                         {}
+func (nopLogger) Info(string, ...any)                          {}
+func (nopLogger) Warn(string, ...any)                          {}
+func (nopLogger) Error(string, ...any)                         {}
+func (l nopLogger) With(...any) service.Logger                 { return l }
+func (l nopLogger) WithContext(context.Context) service.Logger { return l }
+
+// usageSettingsRepo holds one tenant's AI settings and counts usage increments.
+type usageSettingsRepo struct {
+	repository.TenantAISettingsRepository
+	settings    *entity.TenantAISettings
+	incremented int64
+}
+
+func (r *usageSettingsRepo) Get(context.Context, uuid.UUID) (*entity.TenantAISettings, error) {
+	return r.settings, nil
+}
+
+func (r *usageSettingsRepo) IncrementTokenUsage(_ context.Context, _ uuid.UUID, tokens int64) error {
+	r.incremented += tokens
+	return nil
+}
+
+// recordingUsageRepo keeps recorded usage entries in memory. Entries are
+// dated by CreatedAt and count against their UsageMonth.
+type recordingUsageRepo struct {
+	repository.TokenUsageRepository
+	entries []*entity.TokenUsageEntry
+}
+
+func (r *recordingUsageRepo) Record(_ context.Context, entry *entity.TokenUsageEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *recordingUsageRepo) GetMonthTotal(_ context.Context, _ uuid.UUID, month time.Time) (int64, error) {
+	var total int64
+	for _, entry := range r.entries {
+		if entry.UsageMonth.Equal(month) {
+			total += entry.TokensUsed
+		}
+	}
+	return total, nil
+}
+
+func (r *recordingUsageRepo) ListDailyUsage(_ context.Context, _ uuid.UUID, month time.Time) ([]entity.DailyTokenUsage, error) {
+	var daily []entity.DailyTokenUsage
+	for _, entry := range r.entries {
+		if !entry.UsageMonth.Equal(month) {
+			continue
+		}
+		date := entry.CreatedAt.UTC().Truncate(24 * time.Hour)
+		if n := len(daily); n > 0 && daily[n-1].Date.Equal(date) {
+			daily[n-1].TokensUsed += entry.TokensUsed
+			continue
+		}
+		daily = append(daily, entity.DailyTokenUsage{Date: date, TokensUsed: entry.TokensUsed})
+	}
+	return daily, nil
+}
+
+func (r *recordingUsageRepo) ListUsageByJobType(_ context.Context, _ uuid.UUID, month time.Time) ([]entity.TokenUsageByType, error) {
+	var byType []entity.TokenUsageByType
+	for _, entry := range r.entries {
+		if !entry.UsageMonth.Equal(month) {
+			continue
+		}
+		i := slices.IndexFunc(byType, func(u entity.TokenUsageByType) bool { return u.JobType == entry.JobType })
+		if i < 0 {
+			byType = append(byType, entity.TokenUsageByType{JobType: entry.JobType})
+			i = len(byType) - 1
+		}
+		byType[i].TokensUsed += entry.TokensUsed
+		byType[i].JobCount++
+	}
+	return byType, nil
+}
+
+func (r *recordingUsageRepo) GetAverageJobTokens(_ context.Context, _ uuid.UUID, jobType valueobject.GenerationJobType, sampleSize int) (int64, error) {
+	var total, count int64
+	for i := len(r.entries) - 1; i >= 0 && count < int64(sampleSize); i-- {
+		if entry := r.entries[i]; entry.JobID != nil && entry.JobType == jobType {
+			total += entry.TokensUsed
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	return total / count, nil
+}
@@ -91,7 +91,7 @@ func (s *InvitationService) CreateInvitation(
 	}
 
 	// 3. Check seat availability
-	seatInfo, err := s.getSeatInfo(ctx, company)
+	seatInfo, err := s.GetCompanySeatInfo(ctx, company)
 	if err != nil {
 		log.Error("failed to get seat info", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
@@ -459,11 +459,11 @@ func (s *InvitationService) GetSeatInfo(
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	return s.getSeatInfo(ctx, company)
+	return s.GetCompanySeatInfo(ctx, company)
 }
 
-// getSeatInfo calculates seat usage for a company.
-func (s *InvitationService) getSeatInfo(
+// GetCompanySeatInfo calculates seat usage for a company.
+func (s *InvitationService) GetCompanySeatInfo(
 	ctx context.Context,
 	company *entity.Company,
 ) (*dto.SeatInfoResponse, error) {
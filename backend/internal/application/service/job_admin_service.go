@@ -0,0 +1,416 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/domain/worker"
+)
+
+const (
+	// defaultTaskPageSize is used when a task listing gives no page size.
+	defaultTaskPageSize = 50
+
+	// maxTaskPageSize bounds the page size of task listings.
+	maxTaskPageSize = 200
+
+	// stuckProvisioningAfter is how long a paid registration may wait for
+	// provisioning before administrators see it as stuck.
+	stuckProvisioningAfter = 5 * time.Minute
+)
+
+// EntitlementOverrideSetter stores enterprise entitlement overrides.
+type EntitlementOverrideSetter interface {
+	// SetOverrides replaces a company's overrides, or clears them when nil, and returns its entitlements.
+	SetOverrides(ctx context.Context, companyID uuid.UUID, overrides *entity.EntitlementOverrides) (entity.Entitlements, error)
+}
+
+// TaskQueueInspector reads and manages tasks in the background job queues.
+type TaskQueueInspector interface {
+	// ListFailedTasks lists tasks in a retry or archived state, across all queues when none is given.
+	ListFailedTasks(ctx context.Context, opts entity.BackgroundTaskListOptions) ([]*entity.BackgroundTask, error)
+
+	// GetTask returns a task, or (nil, nil) if it doesn't exist.
+	GetTask(ctx context.Context, queue, id string) (*entity.BackgroundTask, error)
+
+	// RunTask moves a retry or archived task back to pending so it runs now.
+	RunTask(ctx context.Context, queue, id string) error
+
+	// DeleteTask removes a task from its queue.
+	DeleteTask(ctx context.Context, queue, id string) error
+}
+
+// ProvisionTaskEnqueuer enqueues account provisioning tasks.
+type ProvisionTaskEnqueuer interface {
+	// EnqueueStripeProvision enqueues provisioning of a paid registration.
+	EnqueueStripeProvision(sessionID, customer, subscriptionID string) error
+}
+
+// WebhookEventReplayer lists and replays stored Stripe webhook events.
+type WebhookEventReplayer interface {
+	// ListEvents lists stored events, most recently received first.
+	ListEvents(ctx context.Context, opts entity.StripeWebhookEventListOptions) ([]*entity.StripeWebhookEvent, error)
+
+	// ReplayEvent applies a stored event again and returns it with the outcome.
+	// Processed events are only applied again when force is set.
+	ReplayEvent(ctx context.Context, id string, force bool) (*entity.StripeWebhookEvent, error)
+}
+
+// JobAdminService lets platform administrators inspect background tasks that
+// failed across all tenants, replay or delete them, retry account
+// provisioning that the Stripe reconciliation only alerts on, replay
+// Stripe webhook events and set enterprise entitlement overrides.
+type JobAdminService struct {
+	inspector      TaskQueueInspector
+	jobRepo        repository.GenerationJobRepository
+	pendingRegRepo repository.PendingRegistrationRepository
+	enqueuer       ProvisionTaskEnqueuer
+	webhooks       WebhookEventReplayer
+	entitlements   EntitlementOverrideSetter
+	adminIDs       map[uuid.UUID]bool
+	logger         service.Logger
+}
+
+// NewJobAdminService creates a new job administration service.
+// Only sessions of the identities in adminIdentityIDs may use it. Emails are
+// not trusted for this, since anyone can register an address they don't own.
+func NewJobAdminService(
+	inspector TaskQueueInspector,
+	jobRepo repository.GenerationJobRepository,
+	pendingRegRepo repository.PendingRegistrationRepository,
+	enqueuer ProvisionTaskEnqueuer,
+	webhooks WebhookEventReplayer,
+	entitlements EntitlementOverrideSetter,
+	adminIdentityIDs []uuid.UUID,
+	logger service.Logger,
+) *JobAdminService {
+	admins := make(map[uuid.UUID]bool, len(adminIdentityIDs))
+	for _, id := range adminIdentityIDs {
+		admins[id] = true
+	}
+
+	return &JobAdminService{
+		inspector:      inspector,
+		jobRepo:        jobRepo,
+		pendingRegRepo: pendingRegRepo,
+		enqueuer:       enqueuer,
+		webhooks:       webhooks,
+		entitlements:   entitlements,
+		adminIDs:       admins,
+		logger:         logger,
+	}
+}
+
+// authorize checks that the session identity is a platform administrator.
+func (s *JobAdminService) authorize(kratosID uuid.UUID) error {
+	if !s.adminIDs[kratosID] {
+		return domainerrors.ErrForbidden.WithMessage("platform administrator access required")
+	}
+	return nil
+}
+
+// ListFailedTasks lists background tasks waiting to retry or archived after
+// exhausting their retries.
+func (s *JobAdminService) ListFailedTasks(ctx context.Context, kratosID uuid.UUID, opts entity.BackgroundTaskListOptions) ([]*entity.BackgroundTask, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+
+	if !opts.State.IsFailed() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("state must be retry or archived")
+	}
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultTaskPageSize
+	}
+	opts.PageSize = min(opts.PageSize, maxTaskPageSize)
+
+	tasks, err := s.inspector.ListFailedTasks(ctx, opts)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return tasks, nil
+}
+
+// GetFailedTask retrieves a background task with its payload and last error.
+func (s *JobAdminService) GetFailedTask(ctx context.Context, kratosID uuid.UUID, queue, taskID string) (*entity.BackgroundTask, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+	return s.getTask(ctx, queue, taskID)
+}
+
+// ReplayFailedTask runs a failed task again now. The generation job or pending
+// registration the task works on is reset first, since the task skips work
+// that already failed.
+func (s *JobAdminService) ReplayFailedTask(ctx context.Context, kratosID uuid.UUID, queue, taskID string) (*entity.BackgroundTask, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+
+	task, err := s.getTask(ctx, queue, taskID)
+	if err != nil {
+		return nil, err
+	}
+	if !task.State.IsFailed() {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("only failed tasks can be replayed")
+	}
+
+	log := s.logger.With("queue", queue, "taskID", taskID, "type", task.Type, "admin", kratosID)
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	switch task.Type {
+	case worker.TypeAIGeneration, worker.TypeSMEIngestion:
+		var payload struct {
+			JobID string `json:"job_id"`
+		}
+		if err := json.Unmarshal(task.Payload, &payload); err != nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("task payload is not valid JSON")
+		}
+		if err := s.requeueGenerationJob(adminCtx, payload.JobID); err != nil {
+			return nil, err
+		}
+	case worker.TypeStripeProvision:
+		var payload worker.StripeProvisionPayload
+		if err := json.Unmarshal(task.Payload, &payload); err != nil {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("task payload is not valid JSON")
+		}
+		reg, err := s.pendingRegRepo.GetByCheckoutSessionID(adminCtx, payload.CheckoutSessionID)
+		if err != nil {
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if reg != nil {
+			if err := s.resetRegistration(adminCtx, reg); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := s.inspector.RunTask(ctx, queue, taskID); err != nil {
+		log.Error("failed to replay task", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("replayed failed task")
+	return s.getTask(ctx, queue, taskID)
+}
+
+// DeleteFailedTask removes a failed task from its queue.
+func (s *JobAdminService) DeleteFailedTask(ctx context.Context, kratosID uuid.UUID, queue, taskID string) error {
+	if err := s.authorize(kratosID); err != nil {
+		return err
+	}
+
+	task, err := s.getTask(ctx, queue, taskID)
+	if err != nil {
+		return err
+	}
+	if !task.State.IsFailed() {
+		return domainerrors.ErrInvalidInput.WithMessage("only failed tasks can be deleted")
+	}
+
+	if err := s.inspector.DeleteTask(ctx, queue, taskID); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	s.logger.Info("deleted failed task", "queue", queue, "taskID", taskID, "type", task.Type, "admin", kratosID)
+	return nil
+}
+
+// ListStuckProvisioning lists paid registrations that have waited too long
+// for their account, and those whose provisioning failed.
+func (s *JobAdminService) ListStuckProvisioning(ctx context.Context, kratosID uuid.UUID) ([]*entity.PendingRegistration, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	stuck, err := s.pendingRegRepo.FindStuckPaid(adminCtx, stuckProvisioningAfter)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	failed, err := s.pendingRegRepo.ListByStatus(adminCtx, valueobject.PendingRegistrationStatusFailed)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	for _, reg := range failed {
+		// Registrations that never paid have nothing to provision
+		if reg.StripeSubscriptionID != nil {
+			stuck = append(stuck, reg)
+		}
+	}
+
+	return stuck, nil
+}
+
+// RetryProvisioning enqueues provisioning of a stuck or failed paid registration.
+func (s *JobAdminService) RetryProvisioning(ctx context.Context, kratosID uuid.UUID, registrationID uuid.UUID) (*entity.PendingRegistration, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	reg, err := s.pendingRegRepo.GetByID(adminCtx, registrationID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if reg == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("registration not found")
+	}
+	if reg.StripeSubscriptionID == nil {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("registration has not been paid")
+	}
+
+	if err := s.resetRegistration(adminCtx, reg); err != nil {
+		return nil, err
+	}
+
+	customerID := ""
+	if reg.StripeCustomerID != nil {
+		customerID = *reg.StripeCustomerID
+	}
+	if err := s.enqueuer.EnqueueStripeProvision(reg.CheckoutSessionID, customerID, *reg.StripeSubscriptionID); err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	s.logger.Info("retried provisioning", "registrationID", reg.ID, "checkoutSessionID", reg.CheckoutSessionID, "admin", kratosID)
+	return reg, nil
+}
+
+// ListWebhookEvents lists stored Stripe webhook events, most recently received first.
+func (s *JobAdminService) ListWebhookEvents(ctx context.Context, kratosID uuid.UUID, opts entity.StripeWebhookEventListOptions) ([]*entity.StripeWebhookEvent, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+
+	if opts.Limit <= 0 {
+		opts.Limit = defaultTaskPageSize
+	}
+	opts.Limit = min(opts.Limit, maxTaskPageSize)
+	opts.Offset = max(opts.Offset, 0)
+
+	return s.webhooks.ListEvents(ctx, opts)
+}
+
+// ReplayWebhookEvent applies a stored Stripe webhook event again. A processed
+// event is only applied again when force is set. A failure while applying it
+// is reported in the returned event's status and error.
+func (s *JobAdminService) ReplayWebhookEvent(ctx context.Context, kratosID uuid.UUID, eventID string, force bool) (*entity.StripeWebhookEvent, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return nil, err
+	}
+	if eventID == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("event ID is required")
+	}
+
+	event, err := s.webhooks.ReplayEvent(ctx, eventID, force)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("replayed webhook event", "eventID", event.ID, "type", event.Type, "status", event.Status, "force", force, "admin", kratosID)
+	return event, nil
+}
+
+// SetEntitlementOverrides stores the entitlements agreed in an enterprise
+// company's contract. Nil overrides restore the plan's entitlements.
+func (s *JobAdminService) SetEntitlementOverrides(ctx context.Context, kratosID uuid.UUID, companyID uuid.UUID, overrides *entity.EntitlementOverrides) (entity.Entitlements, error) {
+	if err := s.authorize(kratosID); err != nil {
+		return entity.Entitlements{}, err
+	}
+
+	entitlements, err := s.entitlements.SetOverrides(tenant.WithSuperAdmin(ctx, true), companyID, overrides)
+	if err != nil {
+		return entity.Entitlements{}, err
+	}
+
+	s.logger.Info("set entitlement overrides", "companyID", companyID, "cleared", overrides == nil, "admin", kratosID)
+	return entitlements, nil
+}
+
+// getTask retrieves a task, mapping a missing task to ErrNotFound.
+func (s *JobAdminService) getTask(ctx context.Context, queue, taskID string) (*entity.BackgroundTask, error) {
+	if queue == "" || taskID == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("queue and task ID are required")
+	}
+
+	task, err := s.inspector.GetTask(ctx, queue, taskID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if task == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("task not found")
+	}
+	return task, nil
+}
+
+// requeueGenerationJob puts a failed generation job back in the queue so a
+// replayed task can claim it, reopening its parent job if that finished.
+func (s *JobAdminService) requeueGenerationJob(ctx context.Context, jobID string) error {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return domainerrors.ErrInvalidInput.WithMessage("task payload has an invalid job ID")
+	}
+
+	job, err := s.jobRepo.GetByID(ctx, id)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if job == nil || job.Status != valueobject.GenerationJobStatusFailed {
+		// Missing, still queued or running, or done: the replayed task is a no-op
+		return nil
+	}
+
+	if job.ParentJobID != nil {
+		parent, err := s.jobRepo.GetByID(ctx, *job.ParentJobID)
+		if err != nil {
+			return domainerrors.ErrInternal.WithCause(err)
+		}
+		if parent != nil && parent.Status.IsTerminal() {
+			parent.Status = valueobject.GenerationJobStatusProcessing
+			parent.ErrorMessage = nil
+			parent.CompletedAt = nil
+			if err := s.jobRepo.Update(ctx, parent); err != nil {
+				return domainerrors.ErrInternal.WithCause(err)
+			}
+		}
+	}
+
+	job.Status = valueobject.GenerationJobStatusQueued
+	job.RetryCount = 0
+	job.ProgressPercent = 0
+	job.ErrorMessage = nil
+	job.StartedAt = nil
+	job.CompletedAt = nil
+	progressMsg := "Queued again by an administrator"
+	job.ProgressMessage = &progressMsg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	return nil
+}
+
+// resetRegistration puts a registration whose provisioning failed back to
+// paid, since provisioning skips registrations in any other state.
+func (s *JobAdminService) resetRegistration(ctx context.Context, reg *entity.PendingRegistration) error {
+	if reg.Status != valueobject.PendingRegistrationStatusFailed {
+		return nil
+	}
+	reg.MarkForRetry()
+	if err := s.pendingRegRepo.Update(ctx, reg); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	return nil
+}
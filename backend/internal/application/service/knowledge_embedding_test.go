@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// fakeEmbedder computes deterministic bag-of-words embeddings: each word
+// adds 1 to the dimension its hash selects.
+type fakeEmbedder struct {
+	err error
+}
+
+func (e *fakeEmbedder) embed(text string) []float32 {
+	v := make([]float32, service.EmbeddingDimensions)
+	for _, word := range strings.Fields(strings.ToLower(text)) {
+		h := fnv.New32a()
+		h.Write([]byte(word))
+		v[h.Sum32()%uint32(len(v))]++
+	}
+	return v
+}
+
+// EmbedDocuments reports one token per word.
+func (e *fakeEmbedder) EmbedDocuments(_ context.Context, texts []string) ([][]float32, int64, error) {
+	if e.err != nil {
+		return nil, 0, e.err
+	}
+	out := make([][]float32, len(texts))
+	var tokens int64
+	for i, text := range texts {
+		out[i] = e.embed(text)
+		tokens += int64(len(strings.Fields(text)))
+	}
+	return out, tokens, nil
+}
+
+func (e *fakeEmbedder) EmbedQuery(_ context.Context, text string) ([]float32, int64, error) {
+	if e.err != nil {
+		return nil, 0, e.err
+	}
+	return e.embed(text), int64(len(strings.Fields(text))), nil
+}
+
+func (e *fakeEmbedder) EmbeddingModel() string { return "fake-embedding" }
+
+type fakeEmbedderFactory struct {
+	embedder service.Embedder
+	err      error
+}
+
+func (f *fakeEmbedderFactory) GetEmbedder(context.Context, uuid.UUID) (service.Embedder, error) {
+	return f.embedder, f.err
+}
+
+// embeddingKnowledgeRepo records stored embeddings and search arguments.
+type embeddingKnowledgeRepo struct {
+	repository.SMEKnowledgeRepository
+	embeddings map[uuid.UUID][]float32
+	models     map[uuid.UUID]string
+
+	searchEmbedding []float32
+	searchModel     string
+}
+
+func (r *embeddingKnowledgeRepo) UpdateEmbedding(_ context.Context, chunkID uuid.UUID, embedding []float32, model string) error {
+	if r.embeddings == nil {
+		r.embeddings = make(map[uuid.UUID][]float32)
+		r.models = make(map[uuid.UUID]string)
+	}
+	r.embeddings[chunkID] = embedding
+	r.models[chunkID] = model
+	return nil
+}
+
+func (r *embeddingKnowledgeRepo) Search(_ context.Context, _ []uuid.UUID, _ string, queryEmbedding []float32, embeddingModel string, _ int) ([]*entity.SMEKnowledgeChunk, error) {
+	r.searchEmbedding = queryEmbedding
+	r.searchModel = embeddingModel
+	return nil, nil
+}
+
+func TestEmbedKnowledgeChunks(t *testing.T) {
+	embedder := &fakeEmbedder{}
+	chunks := []*entity.SMEKnowledgeChunk{
+		{ID: uuid.New(), Topic: "Water chemistry", Content: "Keep the pH between 7.2 and 7.8."},
+		{ID: uuid.New(), Topic: "Filters", Content: "Rinse the filter cartridge monthly."},
+	}
+
+	repo := &embeddingKnowledgeRepo{}
+	tokens, err := embedKnowledgeChunks(context.Background(), &fakeEmbedderFactory{embedder: embedder}, repo, uuid.New(), chunks)
+	if err != nil {
+		t.Fatalf("embedKnowledgeChunks() error = %v", err)
+	}
+	if tokens != 15 {
+		t.Errorf("embedKnowledgeChunks() tokens = %d, want the 15 the embedder reported", tokens)
+	}
+
+	for _, chunk := range chunks {
+		want := embedder.embed(chunk.Topic + "\n\n" + chunk.Content)
+		if !slices.Equal(repo.embeddings[chunk.ID], want) {
+			t.Errorf("chunk %q: stored embedding does not match its topic and content", chunk.Topic)
+		}
+		if repo.models[chunk.ID] != "fake-embedding" {
+			t.Errorf("chunk %q: model = %q, want fake-embedding", chunk.Topic, repo.models[chunk.ID])
+		}
+	}
+}
+
+func TestEmbedKnowledgeChunksWithoutEmbedder(t *testing.T) {
+	repo := &embeddingKnowledgeRepo{}
+	chunks := []*entity.SMEKnowledgeChunk{{ID: uuid.New(), Content: "Content"}}
+
+	if _, err := embedKnowledgeChunks(context.Background(), nil, repo, uuid.New(), chunks); err != nil {
+		t.Fatalf("embedKnowledgeChunks() without a factory error = %v", err)
+	}
+	if len(repo.embeddings) != 0 {
+		t.Error("stored embeddings without an embedder")
+	}
+
+	factory := &fakeEmbedderFactory{err: errors.New("no API key")}
+	if _, err := embedKnowledgeChunks(context.Background(), factory, repo, uuid.New(), chunks); err == nil {
+		t.Error("embedKnowledgeChunks() succeeded although no embedder was available")
+	}
+}
+
+// searchUserRepo returns one admin user.
+type searchUserRepo struct {
+	repository.UserRepository
+	user *entity.User
+}
+
+func (r *searchUserRepo) GetByKratosID(context.Context, uuid.UUID) (*entity.User, error) {
+	return r.user, nil
+}
+
+// searchSMERepo returns an SME for any ID.
+type searchSMERepo struct {
+	repository.SMERepository
+}
+
+func (searchSMERepo) GetByID(_ context.Context, id uuid.UUID) (*entity.SubjectMatterExpert, error) {
+	return &entity.SubjectMatterExpert{ID: id}, nil
+}
+
+func TestSearchKnowledgeEmbedsQuery(t *testing.T) {
+	tenantID := uuid.New()
+	user := &entity.User{ID: uuid.New(), TenantID: &tenantID, Role: valueobject.RoleAdmin}
+	req := SearchKnowledgeRequest{Query: "ideal pH", SMEIDs: []uuid.UUID{uuid.New()}}
+
+	tests := []struct {
+		name          string
+		factory       EmbedderFactory
+		wantEmbedding bool
+	}{
+		{"semantic", &fakeEmbedderFactory{embedder: &fakeEmbedder{}}, true},
+		{"embedding fails", &fakeEmbedderFactory{embedder: &fakeEmbedder{err: errors.New("quota exceeded")}}, false},
+		{"no embedder", &fakeEmbedderFactory{err: errors.New("provider has no embeddings")}, false},
+		{"no factory", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &embeddingKnowledgeRepo{}
+			usage := &recordingUsageRepo{}
+			s := NewSMEService(&searchUserRepo{user: user}, nil, nil, searchSMERepo{}, nil, nil, repo, nil, nil, nil, nil, nil, nil, nil, nopLogger{})
+			if tt.factory != nil {
+				s.SetEmbedderFactory(tt.factory, NewTokenBudget(&usageSettingsRepo{}, usage, nil, nopLogger{}))
+			}
+
+			if _, err := s.SearchKnowledge(context.Background(), uuid.New(), req); err != nil {
+				t.Fatalf("SearchKnowledge() error = %v", err)
+			}
+
+			if !tt.wantEmbedding {
+				if repo.searchEmbedding != nil {
+					t.Error("searched with a query embedding, want keyword search")
+				}
+				if len(usage.entries) != 0 {
+					t.Errorf("recorded usage %v without an embedding", usage.entries)
+				}
+				return
+			}
+			if len(usage.entries) != 1 || usage.entries[0].TokensUsed != int64(len(strings.Fields(req.Query))) {
+				t.Errorf("recorded usage %v, want the query's embedding tokens", usage.entries)
+			}
+			if !slices.Equal(repo.searchEmbedding, (&fakeEmbedder{}).embed(req.Query)) {
+				t.Error("searched with an embedding other than the query's")
+			}
+			if repo.searchModel != "fake-embedding" {
+				t.Errorf("searched model %q, want fake-embedding", repo.searchModel)
+			}
+		})
+	}
+}
@@ -765,6 +765,102 @@ func (s *NotificationService) NotifyOutlineFailed(ctx context.Context, userID uu
 	return nil
 }
 
+// NotifyLessonsStale sends an in-app notification when SME knowledge changes make a course's lessons stale.
+// Implements StaleLessonNotifier interface for AIGenerationService.
+func (s *NotificationService) NotifyLessonsStale(ctx context.Context, userID uuid.UUID, courseID uuid.UUID, courseTitle string, staleCount int) error {
+	log := s.logger.With("userID", userID, "courseID", courseID)
+
+	// Link to course preview page where the user can regenerate the stale lessons
+	actionURL := fmt.Sprintf("/course/%s/preview", courseID.String())
+
+	notifReq := CreateNotificationRequest{
+		UserID:    userID,
+		Type:      valueobject.NotificationTypeLessonsStale,
+		Priority:  valueobject.NotificationPriorityNormal,
+		Title:     "Lessons Out of Date",
+		Message:   fmt.Sprintf("SME knowledge used by %d lesson(s) in %q has changed. Regenerate them to bring the course up to date.", staleCount, courseTitle),
+		ActionURL: &actionURL,
+		CourseID:  &courseID,
+	}
+
+	if _, err := s.CreateNotification(ctx, notifReq); err != nil {
+		log.Error("failed to create in-app notification", "error", err)
+		return err
+	}
+
+	log.Info("in-app notification created for stale lessons", "staleCount", staleCount)
+	return nil
+}
+
+// NotifyPaymentPastDueRequest contains parameters for an overdue payment notification.
+type NotifyPaymentPastDueRequest struct {
+	UserID      uuid.UUID
+	CompanyName string
+	Title       string
+	Message     string
+	InvoiceURL  string // Hosted page of the failed invoice; empty if unknown
+	SendEmail   bool   // Also email the user; for owners who can settle the payment
+}
+
+// NotifyPaymentPastDue creates a high priority in-app notification, shown as a
+// banner, and optionally emails a reminder while a payment is overdue.
+// This method looks up the user's email from Kratos using their KratosID.
+// Implements PaymentPastDueNotifier interface for DunningService.
+func (s *NotificationService) NotifyPaymentPastDue(ctx context.Context, req NotifyPaymentPastDueRequest) error {
+	log := s.logger.With("userID", req.UserID)
+
+	// Link to billing settings where owners can update the payment method
+	actionURL := "/settings?tab=billing"
+
+	notifReq := CreateNotificationRequest{
+		UserID:    req.UserID,
+		Type:      valueobject.NotificationTypePaymentPastDue,
+		Priority:  valueobject.NotificationPriorityHigh,
+		Title:     req.Title,
+		Message:   req.Message,
+		ActionURL: &actionURL,
+	}
+
+	if _, err := s.CreateNotification(ctx, notifReq); err != nil {
+		log.Error("failed to create in-app notification", "error", err)
+		return err
+	}
+
+	if !req.SendEmail || s.emailProvider == nil || s.identityProvider == nil {
+		return nil
+	}
+
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil || user == nil {
+		log.Error("failed to get user for payment reminder", "error", err)
+		return domainerrors.ErrUserNotFound
+	}
+
+	identity, err := s.identityProvider.GetIdentity(ctx, user.KratosID.String())
+	if err != nil || identity == nil || identity.Email == "" {
+		log.Warn("failed to get identity for payment reminder", "error", err)
+		return nil
+	}
+
+	emailReq := service.SendPaymentReminderRequest{
+		To:          identity.Email,
+		UserName:    identity.FirstName,
+		CompanyName: req.CompanyName,
+		Title:       req.Title,
+		Message:     req.Message,
+		BillingURL:  s.baseURL + actionURL,
+		InvoiceURL:  req.InvoiceURL,
+	}
+
+	if err := s.emailProvider.SendPaymentReminder(ctx, emailReq); err != nil {
+		log.Error("failed to send payment reminder email", "error", err)
+	} else {
+		log.Info("payment reminder email sent", "to", identity.Email)
+	}
+
+	return nil
+}
+
 // publishNotificationEvent publishes a notification event to Redis for real-time delivery.
 // This is fire-and-forget - errors are logged but don't fail the operation.
 func (s *NotificationService) publishNotificationEvent(ctx context.Context, userID uuid.UUID, eventType v1.NotificationEventType, notification *entity.Notification) {
@@ -852,6 +948,10 @@ func notificationTypeToProto(t valueobject.NotificationType) v1.NotificationType
 		return v1.NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED
 	case valueobject.NotificationTypeApprovalRequested:
 		return v1.NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED
+	case valueobject.NotificationTypeLessonsStale:
+		return v1.NotificationType_NOTIFICATION_TYPE_LESSONS_STALE
+	case valueobject.NotificationTypePaymentPastDue:
+		return v1.NotificationType_NOTIFICATION_TYPE_PAYMENT_PAST_DUE
 	default:
 		return v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
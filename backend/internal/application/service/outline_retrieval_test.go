@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// rankedKnowledgeRepo holds many chunks per SME and answers only bounded
+// queries; listing an SME's whole knowledge panics on the nil interface.
+type rankedKnowledgeRepo struct {
+	repository.SMEKnowledgeRepository
+	chunks      map[uuid.UUID][]*entity.SMEKnowledgeChunk
+	matches     map[uuid.UUID]bool // SMEs whose chunks match the search query
+	queries     []string
+	listedLimit []int
+}
+
+func (r *rankedKnowledgeRepo) take(smeIDs []uuid.UUID, limit int) []*entity.SMEKnowledgeChunk {
+	var out []*entity.SMEKnowledgeChunk
+	for _, id := range smeIDs {
+		out = append(out, r.chunks[id]...)
+	}
+	return out[:min(limit, len(out))]
+}
+
+func (r *rankedKnowledgeRepo) Search(_ context.Context, smeIDs []uuid.UUID, query string, _ []float32, _ string, limit int) ([]*entity.SMEKnowledgeChunk, error) {
+	r.queries = append(r.queries, query)
+	var matching []uuid.UUID
+	for _, id := range smeIDs {
+		if r.matches[id] {
+			matching = append(matching, id)
+		}
+	}
+	return r.take(matching, limit), nil
+}
+
+func (r *rankedKnowledgeRepo) ListTopBySMEIDs(_ context.Context, smeIDs []uuid.UUID, limit int) ([]*entity.SMEKnowledgeChunk, error) {
+	r.listedLimit = append(r.listedLimit, limit)
+	return r.take(smeIDs, limit), nil
+}
+
+type outlineGenInputRepo struct {
+	repository.CourseGenerationInputRepository
+	input *entity.CourseGenerationInput
+}
+
+func (r outlineGenInputRepo) GetByCourseID(context.Context, uuid.UUID) (*entity.CourseGenerationInput, error) {
+	return r.input, nil
+}
+
+type outlineCourseRepo struct {
+	repository.CourseRepository
+}
+
+func (outlineCourseRepo) GetByID(_ context.Context, id uuid.UUID) (*entity.Course, error) {
+	return &entity.Course{ID: id, Title: "Pool Maintenance"}, nil
+}
+
+// outlineProvider records the outline request and fails it, ending the job
+// before anything is stored.
+type outlineProvider struct {
+	service.AIProvider
+	req service.GenerateOutlineRequest
+}
+
+func (p *outlineProvider) GenerateCourseOutline(_ context.Context, req service.GenerateOutlineRequest) (*service.GenerateOutlineResult, error) {
+	p.req = req
+	return nil, errors.New("stop here")
+}
+
+func TestProcessOutlineGenerationJobBoundsKnowledge(t *testing.T) {
+	matching, unmatched := uuid.New(), uuid.New()
+	knowledge := &rankedKnowledgeRepo{
+		chunks:  map[uuid.UUID][]*entity.SMEKnowledgeChunk{},
+		matches: map[uuid.UUID]bool{matching: true},
+	}
+	for _, smeID := range []uuid.UUID{matching, unmatched} {
+		for i := 0; i < 3*outlineRetrievalLimit; i++ {
+			knowledge.chunks[smeID] = append(knowledge.chunks[smeID], &entity.SMEKnowledgeChunk{
+				ID:      uuid.New(),
+				SMEID:   smeID,
+				Content: fmt.Sprintf("Chunk %d", i),
+			})
+		}
+	}
+
+	courseID := uuid.New()
+	job := &entity.GenerationJob{
+		ID:       uuid.New(),
+		TenantID: uuid.New(),
+		Type:     valueobject.GenerationJobTypeCourseOutline,
+		Status:   valueobject.GenerationJobStatusProcessing,
+		CourseID: &courseID,
+	}
+	provider := &outlineProvider{}
+	s := &AIGenerationService{
+		jobRepo: &regenJobRepo{job: job},
+		genInputRepo: outlineGenInputRepo{input: &entity.CourseGenerationInput{
+			CourseID:       courseID,
+			SMEIDs:         []uuid.UUID{matching, unmatched},
+			DesiredOutcome: "Keep a pool safe to swim in",
+		}},
+		courseRepo:        outlineCourseRepo{},
+		smeRepo:           searchSMERepo{},
+		smeKnowledgeRepo:  knowledge,
+		aiProviderFactory: regenProviderFactory{provider: provider},
+		tokenBudget:       NewTokenBudget(&usageSettingsRepo{}, &recordingUsageRepo{}, nil, nopLogger{}),
+		logger:            nopLogger{},
+	}
+	_ = s.ProcessOutlineGenerationJob(context.Background(), job)
+
+	if provider.req.CourseTitle != "Pool Maintenance" {
+		t.Errorf("course title = %q, want Pool Maintenance", provider.req.CourseTitle)
+	}
+	if len(provider.req.SMEKnowledge) != 2 {
+		t.Fatalf("got knowledge of %d SMEs, want 2", len(provider.req.SMEKnowledge))
+	}
+	for i, sme := range provider.req.SMEKnowledge {
+		if len(sme.Chunks) != outlineRetrievalLimit {
+			t.Errorf("SME %d contributed %d chunks, want %d", i, len(sme.Chunks), outlineRetrievalLimit)
+		}
+	}
+	for _, query := range knowledge.queries {
+		if query != "Pool Maintenance\nKeep a pool safe to swim in" {
+			t.Errorf("searched for %q, want the course title and outcome", query)
+		}
+	}
+	// Only the SME without matches falls back to its top-relevance chunks
+	if len(knowledge.listedLimit) != 1 || knowledge.listedLimit[0] != outlineRetrievalLimit {
+		t.Errorf("fallback listings = %v, want one of %d chunks", knowledge.listedLimit, outlineRetrievalLimit)
+	}
+}
+
+func TestSearchKnowledgeWithoutQuery(t *testing.T) {
+	smeID := uuid.New()
+	knowledge := &rankedKnowledgeRepo{chunks: map[uuid.UUID][]*entity.SMEKnowledgeChunk{
+		smeID: {{ID: uuid.New(), SMEID: smeID}, {ID: uuid.New(), SMEID: smeID}},
+	}}
+	s := &AIGenerationService{smeKnowledgeRepo: knowledge, logger: nopLogger{}}
+
+	chunks := s.searchKnowledge(context.Background(), []uuid.UUID{smeID}, " ", nil, "", 5)
+	if len(knowledge.queries) != 0 {
+		t.Errorf("searched for %q, want no search without a query", knowledge.queries)
+	}
+	if len(chunks) != 2 || len(knowledge.listedLimit) != 1 {
+		t.Errorf("got %d chunks from %d listings, want the top-relevance chunks", len(chunks), len(knowledge.listedLimit))
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,6 +14,7 @@ import (
 	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
@@ -21,10 +24,15 @@ type SMEIngestionService struct {
 	taskRepo          repository.SMETaskRepository
 	submissionRepo    repository.SMESubmissionRepository
 	knowledgeRepo     repository.SMEKnowledgeRepository
+	versionRepo       repository.SMEKnowledgeVersionRepository
 	jobRepo           repository.GenerationJobRepository
-	aiSettingsRepo    repository.TenantAISettingsRepository
+	tokenBudget       *TokenBudget
 	storage           ContentStorage
+	extractor         service.DocumentExtractor
 	aiProviderFactory AIProviderFactory
+	embedderFactory   EmbedderFactory
+	transcriber       service.Transcriber
+	crawler           service.WebCrawler
 	notifier          NotificationSender
 	logger            service.Logger
 }
@@ -53,10 +61,15 @@ func NewSMEIngestionService(
 	taskRepo repository.SMETaskRepository,
 	submissionRepo repository.SMESubmissionRepository,
 	knowledgeRepo repository.SMEKnowledgeRepository,
+	versionRepo repository.SMEKnowledgeVersionRepository,
 	jobRepo repository.GenerationJobRepository,
-	aiSettingsRepo repository.TenantAISettingsRepository,
+	tokenBudget *TokenBudget,
 	storage ContentStorage,
+	extractor service.DocumentExtractor,
 	aiProviderFactory AIProviderFactory,
+	embedderFactory EmbedderFactory, // Can be nil - chunks are then only keyword-searchable
+	transcriber service.Transcriber, // Can be nil - audio/video submissions then fail ingestion
+	crawler service.WebCrawler, // Can be nil - URL submissions then fail ingestion
 	notifier NotificationSender,
 	logger service.Logger,
 ) *SMEIngestionService {
@@ -65,10 +78,15 @@ func NewSMEIngestionService(
 		taskRepo:          taskRepo,
 		submissionRepo:    submissionRepo,
 		knowledgeRepo:     knowledgeRepo,
+		versionRepo:       versionRepo,
 		jobRepo:           jobRepo,
-		aiSettingsRepo:    aiSettingsRepo,
+		tokenBudget:       tokenBudget,
 		storage:           storage,
+		extractor:         extractor,
 		aiProviderFactory: aiProviderFactory,
+		embedderFactory:   embedderFactory,
+		transcriber:       transcriber,
+		crawler:           crawler,
 		notifier:          notifier,
 		logger:            logger,
 	}
@@ -77,8 +95,9 @@ func NewSMEIngestionService(
 // ProcessNextJob polls for and processes the next pending ingestion job.
 // Returns true if a job was processed, false if no jobs are pending.
 func (s *SMEIngestionService) ProcessNextJob(ctx context.Context) (bool, error) {
-	// Get next queued job
-	job, err := s.jobRepo.GetNextQueued(ctx)
+	// Claim the next queued ingestion job of any tenant
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+	job, err := s.jobRepo.GetNextQueued(adminCtx, []valueobject.GenerationJobType{valueobject.GenerationJobTypeSMEIngestion})
 	if err != nil {
 		return false, fmt.Errorf("failed to get next job: %w", err)
 	}
@@ -87,13 +106,8 @@ func (s *SMEIngestionService) ProcessNextJob(ctx context.Context) (bool, error)
 		return false, nil
 	}
 
-	// Only process SME ingestion jobs
-	if job.Type != valueobject.GenerationJobTypeSMEIngestion {
-		return false, nil
-	}
-
-	// Process the job
-	if err := s.processIngestionJob(ctx, job); err != nil {
+	// Process the job scoped to its tenant
+	if err := s.processIngestionJob(tenant.WithTenantID(adminCtx, job.TenantID), job); err != nil {
 		s.logger.Error("failed to process ingestion job", "jobID", job.ID, "error", err)
 		return true, err
 	}
@@ -112,7 +126,10 @@ func (s *SMEIngestionService) ProcessJobByID(ctx context.Context, jobID string)
 		return fmt.Errorf("invalid job ID: %w", err)
 	}
 
-	job, err := s.jobRepo.GetByID(ctx, id)
+	// Use superadmin context until the job's tenant is known
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	job, err := s.jobRepo.GetByID(adminCtx, id)
 	if err != nil {
 		log.Error("failed to get generation job", "error", err)
 		return err
@@ -135,8 +152,21 @@ func (s *SMEIngestionService) ProcessJobByID(ctx context.Context, jobID string)
 		return nil
 	}
 
-	// Process the job
-	return s.processIngestionJob(ctx, job)
+	// Claim the job atomically; it stays queued while its tenant is at its
+	// concurrency limit or suspended
+	job, err = s.jobRepo.ClaimJobByID(adminCtx, id)
+	if err != nil {
+		log.Error("failed to claim generation job", "error", err)
+		return err
+	}
+
+	if job == nil {
+		log.Info("job not available for claim, may already be processed, waiting for a free slot or its tenant is suspended")
+		return nil
+	}
+
+	// Process the job scoped to its tenant
+	return s.processIngestionJob(tenant.WithTenantID(adminCtx, job.TenantID), job)
 }
 
 // processIngestionJob processes a single SME content ingestion job.
@@ -187,6 +217,19 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		// Text already available (text submissions set this directly)
 		extractedText = *submission.ExtractedText
 		log.Info("using pre-populated extracted text", "length", len(extractedText))
+	} else if submission.ContentType == valueobject.ContentTypeURL {
+		// Web pages are fetched rather than uploaded
+		extractedText, err = s.crawlURL(ctx, submission, sme)
+		if err != nil {
+			log.Error("failed to crawl URL", "error", err)
+			return s.failJob(ctx, job, fmt.Sprintf("failed to fetch URL: %v", err))
+		}
+
+		// Update submission with extracted text and the snapshot path
+		submission.ExtractedText = &extractedText
+		if err := s.submissionRepo.Update(ctx, submission); err != nil {
+			log.Warn("failed to save extracted text", "error", err)
+		}
 	} else {
 		// Need to retrieve file and extract text
 		content, err := s.storage.GetContent(ctx, submission.FilePath)
@@ -196,7 +239,7 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		}
 
 		// Extract text based on content type
-		extractedText, err = s.extractText(submission.ContentType, content)
+		extractedText, err = s.extractText(ctx, submission, content)
 		if err != nil {
 			log.Error("failed to extract text", "contentType", submission.ContentType, "error", err)
 			return s.failJob(ctx, job, fmt.Sprintf("failed to extract text: %v", err))
@@ -209,6 +252,20 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		}
 	}
 
+	// Hash the content so unchanged re-uploads are not processed again
+	contentHash := submissionContentHash(extractedText)
+	submission.ContentHash = &contentHash
+
+	submissions, err := s.submissionRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		log.Error("failed to list SME submissions", "error", err)
+		return s.failJob(ctx, job, "failed to load SME submissions")
+	}
+	if unchanged := findUnchangedSubmission(submissions, submission); unchanged != nil {
+		log.Info("content unchanged, reusing existing knowledge", "matchingSubmissionID", unchanged.ID)
+		return s.completeUnchanged(ctx, job, submission, unchanged, sme, task)
+	}
+
 	// Update progress
 	job.ProgressPercent = 30
 	progressMsg = "Processing with AI..."
@@ -230,10 +287,16 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 	})
 	if err != nil {
 		log.Error("AI processing failed", "error", err)
+		if tokens := service.TokensUsedBy(err); tokens > 0 {
+			job.TokensUsed = tokens
+			s.tokenBudget.RecordUsage(ctx, job, tokens)
+		}
 		return s.failJob(ctx, job, fmt.Sprintf("AI processing failed: %v", err))
 	}
 
+	// Update token usage now, so it is billed even if storing the knowledge fails
 	job.TokensUsed = result.TokensUsed
+	s.tokenBudget.RecordUsage(ctx, job, result.TokensUsed)
 
 	// Update progress
 	job.ProgressPercent = 70
@@ -249,8 +312,38 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		log.Warn("failed to save AI summary", "error", err)
 	}
 
-	// Create knowledge chunks
+	// Replace the knowledge of submissions this one supersedes, and of this
+	// submission itself when it is being re-ingested
+	superseded, err := supersedeSubmissions(ctx, s.submissionRepo, s.knowledgeRepo, s.versionRepo, submissions, submission, nil)
+	if err != nil {
+		log.Error("failed to supersede older submissions", "error", err)
+		return s.failJob(ctx, job, "failed to replace superseded knowledge")
+	}
+	if err := deleteSubmissionKnowledge(ctx, s.knowledgeRepo, s.versionRepo, submission.ID, nil, "Submission re-processed"); err != nil {
+		log.Error("failed to delete previous knowledge chunks", "error", err)
+		return s.failJob(ctx, job, "failed to replace previous knowledge")
+	}
+
+	existingChunks, err := s.knowledgeRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		log.Error("failed to list knowledge chunks", "error", err)
+		return s.failJob(ctx, job, "failed to load existing knowledge")
+	}
+	index := newKnowledgeIndex(existingChunks)
+
+	// Timestamps after the last [Time M:SS] marker, or in text without any,
+	// are not positions in the recording
+	lastTimeMarker := entity.LastTimeMarker(extractedText)
+
+	// Create knowledge chunks, skipping near-duplicates of existing knowledge
+	createdChunks := make([]*entity.SMEKnowledgeChunk, 0, len(result.Chunks))
+	duplicates := 0
 	for _, chunkResult := range result.Chunks {
+		if !index.add(chunkResult.Content) {
+			duplicates++
+			continue
+		}
+
 		chunk := &entity.SMEKnowledgeChunk{
 			ID:             uuid.New(),
 			TenantID:       job.TenantID,
@@ -262,15 +355,41 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 			RelevanceScore: chunkResult.RelevanceScore,
 			CreatedAt:      time.Now(),
 		}
+		if chunkResult.SourceHeading != "" {
+			chunk.SourceHeading = &chunkResult.SourceHeading
+		}
+		if chunkResult.SourcePage > 0 {
+			chunk.SourcePage = &chunkResult.SourcePage
+		}
+		if chunkResult.SourceTimestamp > 0 && chunkResult.SourceTimestamp <= lastTimeMarker {
+			chunk.SourceTimestamp = &chunkResult.SourceTimestamp
+		}
 
 		if err := s.knowledgeRepo.Create(ctx, chunk); err != nil {
 			log.Warn("failed to create knowledge chunk", "error", err)
+			continue
 		}
+		createdChunks = append(createdChunks, chunk)
+	}
+
+	if err := recordChunkVersions(ctx, s.versionRepo, createdChunks, valueobject.KnowledgeChangeCreated, nil, nil); err != nil {
+		log.Warn("failed to record knowledge chunk history", "error", err)
+	}
+
+	// Embed chunks for semantic search; without embeddings they stay keyword-searchable
+	embedTokens, err := embedKnowledgeChunks(ctx, s.embedderFactory, s.knowledgeRepo, job.TenantID, createdChunks)
+	if err != nil {
+		log.Warn("failed to embed knowledge chunks", "error", err)
 	}
 
-	// Update SME with aggregated knowledge summary
-	if err := s.updateSMEKnowledge(ctx, sme, result.Summary); err != nil {
-		log.Warn("failed to update SME knowledge", "error", err)
+	// Regenerate the SME summary from its full current knowledge
+	summaryTokens, err := s.regenerateSMESummary(ctx, aiProvider, sme)
+	if err != nil {
+		log.Warn("failed to regenerate SME summary", "error", err)
+	}
+	if extraTokens := embedTokens + summaryTokens; extraTokens > 0 {
+		job.TokensUsed += extraTokens
+		s.tokenBudget.RecordUsage(ctx, job, extraTokens)
 	}
 
 	// Update task status
@@ -287,9 +406,6 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 		log.Warn("failed to update SME status", "error", err)
 	}
 
-	// Update token usage
-	_ = s.aiSettingsRepo.IncrementTokenUsage(ctx, job.TenantID, result.TokensUsed)
-
 	// Complete the job
 	job.Status = valueobject.GenerationJobStatusCompleted
 	job.ProgressPercent = 100
@@ -303,7 +419,43 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 	// Send notification
 	s.sendCompletionNotification(ctx, job, sme, task)
 
-	log.Info("ingestion completed", "tokensUsed", result.TokensUsed, "chunksCreated", len(result.Chunks))
+	log.Info("ingestion completed",
+		"tokensUsed", job.TokensUsed,
+		"chunksCreated", len(createdChunks),
+		"duplicatesSkipped", duplicates,
+		"submissionsSuperseded", superseded,
+	)
+	return nil
+}
+
+// completeUnchanged finishes an ingestion job whose content matches a
+// current submission. The existing knowledge is kept and no AI call is made.
+func (s *SMEIngestionService) completeUnchanged(ctx context.Context, job *entity.GenerationJob, submission, unchanged *entity.SMETaskSubmission, sme *entity.SubjectMatterExpert, task *entity.SMETask) error {
+	log := s.logger.With("jobID", job.ID, "submissionID", submission.ID)
+
+	now := time.Now()
+	submission.AISummary = unchanged.AISummary
+	submission.ProcessedAt = &now
+	if err := s.submissionRepo.Update(ctx, submission); err != nil {
+		log.Warn("failed to update submission", "error", err)
+	}
+
+	task.Status = valueobject.SMETaskStatusCompleted
+	task.CompletedAt = &now
+	if err := s.taskRepo.Update(ctx, task); err != nil {
+		log.Warn("failed to update task status", "error", err)
+	}
+
+	job.Status = valueobject.GenerationJobStatusCompleted
+	job.ProgressPercent = 100
+	job.CompletedAt = &now
+	progressMsg := "Content unchanged; existing knowledge kept"
+	job.ProgressMessage = &progressMsg
+	if err := s.jobRepo.Update(ctx, job); err != nil {
+		log.Error("failed to mark job as completed", "error", err)
+	}
+
+	s.sendCompletionNotification(ctx, job, sme, task)
 	return nil
 }
 
@@ -311,6 +463,10 @@ func (s *SMEIngestionService) processIngestionJob(ctx context.Context, job *enti
 func (s *SMEIngestionService) CreateIngestionJob(ctx context.Context, tenantID, submissionID, taskID, userID uuid.UUID) (*entity.GenerationJob, error) {
 	log := s.logger.With("submissionID", submissionID, "taskID", taskID)
 
+	if err := s.tokenBudget.CheckBudget(ctx, tenantID, valueobject.GenerationJobTypeSMEIngestion, 1); err != nil {
+		return nil, err
+	}
+
 	job := &entity.GenerationJob{
 		ID:              uuid.New(),
 		TenantID:        tenantID,
@@ -333,51 +489,235 @@ func (s *SMEIngestionService) CreateIngestionJob(ctx context.Context, tenantID,
 	return job, nil
 }
 
-// extractText extracts text from various file formats.
-func (s *SMEIngestionService) extractText(contentType valueobject.ContentType, content []byte) (string, error) {
-	switch contentType {
+// extractText extracts text from various file formats. Documents are passed
+// to the extractor, which detects the format from the file name and content
+// and renders headings and [Page N] markers so chunks can cite their source.
+// Recordings are transcribed with [Time M:SS] markers for the same reason.
+func (s *SMEIngestionService) extractText(ctx context.Context, submission *entity.SMETaskSubmission, content []byte) (string, error) {
+	switch submission.ContentType {
 	case valueobject.ContentTypeText:
 		return string(content), nil
 
-	case valueobject.ContentTypeDocument:
-		// For MVP, we'll extract raw text. In production, use a PDF/docx library.
-		// Check if content looks like PDF by magic bytes
-		if len(content) > 4 && string(content[:4]) == "%PDF" {
-			return s.extractPDFText(content)
-		}
-		// Otherwise treat as plain text (docx, txt, etc.)
-		return string(content), nil
-
 	case valueobject.ContentTypeAudio, valueobject.ContentTypeVideo:
-		// Audio/video would require transcription service
-		return "", fmt.Errorf("audio/video transcription not yet supported")
+		if s.transcriber == nil {
+			return "", fmt.Errorf("audio/video transcription is not configured")
+		}
+		transcript, err := s.transcriber.Transcribe(ctx, submission.FileName, content)
+		if err != nil {
+			return "", fmt.Errorf("failed to transcribe %s: %w", submission.FileName, err)
+		}
+		if strings.TrimSpace(transcript.Text) == "" {
+			return "", fmt.Errorf("no speech found in %s", submission.FileName)
+		}
+		s.logger.Info("transcribed recording",
+			"submissionID", submission.ID,
+			"segments", len(transcript.Segments),
+			"length", len(transcript.Text),
+		)
+		return transcript.Text, nil
 
 	default:
-		// Try to extract as plain text
-		return string(content), nil
+		if s.extractor == nil {
+			return string(content), nil
+		}
+		doc, err := s.extractor.Extract(ctx, submission.FileName, content)
+		if err != nil {
+			return "", err
+		}
+		s.logger.Info("extracted document text",
+			"submissionID", submission.ID,
+			"mimeType", doc.MIMEType,
+			"sections", len(doc.Sections),
+			"length", len(doc.Text),
+		)
+		return doc.Text, nil
 	}
 }
 
-// extractPDFText extracts text from PDF content.
-// This is a placeholder - in production, use a proper PDF library.
-func (s *SMEIngestionService) extractPDFText(content []byte) (string, error) {
-	// For MVP, we'll return an error indicating PDF processing needs implementation
-	// In production, use pdfcpu or similar library
-	return "", fmt.Errorf("PDF text extraction requires additional implementation")
+// urlSnapshot is the stored copy of the pages behind a URL submission, kept
+// so the knowledge extracted from it can be traced and reproduced after the
+// site changes.
+type urlSnapshot struct {
+	URL       string            `json:"url"`
+	FetchedAt time.Time         `json:"fetched_at"`
+	Pages     []urlSnapshotPage `json:"pages"`
 }
 
-// updateSMEKnowledge updates the SME's aggregated knowledge summary.
-func (s *SMEIngestionService) updateSMEKnowledge(ctx context.Context, sme *entity.SubjectMatterExpert, newSummary string) error {
-	// For MVP, just append the new summary
-	if sme.KnowledgeSummary == nil {
-		sme.KnowledgeSummary = &newSummary
-	} else {
-		combined := *sme.KnowledgeSummary + "\n\n---\n\n" + newSummary
-		sme.KnowledgeSummary = &combined
+type urlSnapshotPage struct {
+	URL      string `json:"url"`
+	Depth    int    `json:"depth"`
+	MIMEType string `json:"mime_type"`
+	Body     string `json:"body"`
+}
+
+// crawlURL fetches the submitted page and the same-site pages it links to,
+// stores a snapshot of them in tenant storage and returns their main text.
+// Each page is introduced with its URL so chunks can be traced to it.
+func (s *SMEIngestionService) crawlURL(ctx context.Context, submission *entity.SMETaskSubmission, sme *entity.SubjectMatterExpert) (string, error) {
+	if s.crawler == nil {
+		return "", fmt.Errorf("URL ingestion is not configured")
+	}
+	if submission.SourceURL == nil || *submission.SourceURL == "" {
+		return "", fmt.Errorf("submission has no URL")
+	}
+
+	result, err := s.crawler.Crawl(ctx, *submission.SourceURL)
+	if err != nil {
+		return "", err
+	}
+
+	snapshot := urlSnapshot{
+		URL:       result.URL,
+		FetchedAt: result.FetchedAt,
+		Pages:     make([]urlSnapshotPage, 0, len(result.Pages)),
+	}
+	var sb strings.Builder
+	for _, page := range result.Pages {
+		snapshot.Pages = append(snapshot.Pages, urlSnapshotPage{
+			URL:      page.URL,
+			Depth:    page.Depth,
+			MIMEType: page.MIMEType,
+			Body:     string(page.Body),
+		})
+
+		text, err := s.extractPageText(ctx, page)
+		if err != nil {
+			s.logger.Warn("failed to extract page text", "submissionID", submission.ID, "url", page.URL, "error", err)
+			continue
+		}
+		if text == "" {
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n\n---\n\n")
+		}
+		fmt.Fprintf(&sb, "Source: %s\n\n%s", page.URL, text)
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+	path := fmt.Sprintf("tenants/%s/sme/%s/submissions/%s/snapshot.json", submission.TenantID, sme.ID, submission.ID)
+	if err := s.storage.PutContent(ctx, path, data, "application/json"); err != nil {
+		return "", fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	submission.FilePath = path
+	submission.FileSizeBytes = int64(len(data))
+
+	if sb.Len() == 0 {
+		return "", fmt.Errorf("no text found at %s", result.URL)
+	}
+
+	s.logger.Info("crawled URL",
+		"submissionID", submission.ID,
+		"url", result.URL,
+		"pages", len(result.Pages),
+		"length", sb.Len(),
+	)
+	return sb.String(), nil
+}
+
+// extractPageText extracts the main text of a crawled page.
+func (s *SMEIngestionService) extractPageText(ctx context.Context, page service.CrawledPage) (string, error) {
+	if page.MIMEType != "text/html" || s.extractor == nil {
+		return strings.TrimSpace(string(page.Body)), nil
+	}
+	doc, err := s.extractor.Extract(ctx, "page.html", page.Body)
+	if err != nil {
+		return "", err
+	}
+	return doc.Text, nil
+}
+
+// embedKnowledgeChunks computes and stores embeddings for the given chunks
+// using the tenant's embedder. It is a no-op when no embedder is configured.
+// Returns the tokens the embedder reported using, also when it fails.
+func embedKnowledgeChunks(ctx context.Context, embedderFactory EmbedderFactory, knowledgeRepo repository.SMEKnowledgeRepository, tenantID uuid.UUID, chunks []*entity.SMEKnowledgeChunk) (int64, error) {
+	if embedderFactory == nil || len(chunks) == 0 {
+		return 0, nil
+	}
+
+	embedder, err := embedderFactory.GetEmbedder(ctx, tenantID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get embedder: %w", err)
+	}
+
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Topic + "\n\n" + chunk.Content
+	}
+
+	embeddings, tokensUsed, err := embedder.EmbedDocuments(ctx, texts)
+	if err != nil {
+		return tokensUsed, err
+	}
+	if len(embeddings) != len(chunks) {
+		return tokensUsed, fmt.Errorf("expected %d embeddings, got %d", len(chunks), len(embeddings))
+	}
+
+	for i, chunk := range chunks {
+		if err := knowledgeRepo.UpdateEmbedding(ctx, chunk.ID, embeddings[i], embedder.EmbeddingModel()); err != nil {
+			return tokensUsed, fmt.Errorf("failed to store embedding for chunk %s: %w", chunk.ID, err)
+		}
+	}
+	return tokensUsed, nil
+}
+
+// summaryInputLimit bounds how much chunk text is sent when regenerating
+// an SME summary. Chunks are included most relevant first.
+const summaryInputLimit = 60000
+
+// regenerateSMESummary rewrites the SME's knowledge summary from its full
+// current chunk set, so the summary reflects superseded and removed knowledge
+// rather than accumulating per-submission summaries. Falls back to a plain
+// digest if the AI call fails. Returns the tokens used, including those a
+// failed call reported.
+func (s *SMEIngestionService) regenerateSMESummary(ctx context.Context, aiProvider service.AIProvider, sme *entity.SubjectMatterExpert) (int64, error) {
+	chunks, err := s.knowledgeRepo.ListBySMEID(ctx, sme.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list knowledge chunks: %w", err)
+	}
+
+	ranked := make([]*entity.SMEKnowledgeChunk, len(chunks))
+	copy(ranked, chunks)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].RelevanceScore > ranked[j].RelevanceScore
+	})
+
+	req := service.SummarizeSMEKnowledgeRequest{
+		SMEName:   sme.Name,
+		SMEDomain: sme.Domain,
+	}
+	size := 0
+	for _, chunk := range ranked {
+		if size+len(chunk.Content) > summaryInputLimit && len(req.Chunks) > 0 {
+			break
+		}
+		size += len(chunk.Content)
+		req.Topics = append(req.Topics, chunk.Topic)
+		req.Chunks = append(req.Chunks, chunk.Content)
 	}
 
+	var tokensUsed int64
+	var summary string
+	if len(chunks) > 0 {
+		result, err := aiProvider.SummarizeSMEKnowledge(ctx, req)
+		if err != nil {
+			s.logger.Warn("failed to summarize SME knowledge, using digest", "smeID", sme.ID, "error", err)
+			tokensUsed = service.TokensUsedBy(err)
+		} else {
+			tokensUsed = result.TokensUsed
+			summary = result.Summary
+		}
+	}
+	if summary == "" {
+		summary = buildKnowledgeDigest(chunks)
+	}
+
+	sme.KnowledgeSummary = &summary
 	sme.UpdatedAt = time.Now()
-	return s.smeRepo.Update(ctx, sme)
+	return tokensUsed, s.smeRepo.Update(ctx, sme)
 }
 
 // sendCompletionNotification sends notifications when ingestion completes.
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// nearDuplicateSimilarity is the Jaccard similarity of word pairs at or
+// above which two chunks count as the same knowledge. It catches rewording
+// of a few words while keeping related but distinct passages apart.
+const nearDuplicateSimilarity = 0.6
+
+// submissionContentHash returns the SHA-256 of text with whitespace
+// normalized, so re-uploads that differ only in formatting hash the same.
+func submissionContentHash(text string) string {
+	sum := sha256.Sum256([]byte(strings.Join(strings.Fields(text), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// chunkShingles returns the set of adjacent word pairs in text, ignoring
+// case and punctuation. Single-word texts yield the word itself.
+func chunkShingles(text string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	shingles := make(map[string]struct{}, len(words))
+	if len(words) == 1 {
+		shingles[words[0]] = struct{}{}
+	}
+	for i := 0; i+1 < len(words); i++ {
+		shingles[words[i]+" "+words[i+1]] = struct{}{}
+	}
+	return shingles
+}
+
+// jaccard returns the Jaccard similarity of two shingle sets.
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	shared := 0
+	for shingle := range a {
+		if _, ok := b[shingle]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// knowledgeIndex detects near-duplicate chunks within an SME's knowledge.
+type knowledgeIndex struct {
+	shingles []map[string]struct{}
+}
+
+// newKnowledgeIndex creates an index of the given chunks.
+func newKnowledgeIndex(chunks []*entity.SMEKnowledgeChunk) *knowledgeIndex {
+	idx := &knowledgeIndex{shingles: make([]map[string]struct{}, 0, len(chunks))}
+	for _, chunk := range chunks {
+		idx.shingles = append(idx.shingles, chunkShingles(chunk.Content))
+	}
+	return idx
+}
+
+// add records text unless it nearly duplicates indexed knowledge, and
+// reports whether it was new.
+func (idx *knowledgeIndex) add(text string) bool {
+	shingles := chunkShingles(text)
+	for _, existing := range idx.shingles {
+		if jaccard(shingles, existing) >= nearDuplicateSimilarity {
+			return false
+		}
+	}
+	idx.shingles = append(idx.shingles, shingles)
+	return true
+}
+
+// supersedes reports whether newer replaces older's knowledge: the same web
+// page or file submitted again, or the submission newer says it replaces.
+// Other submissions, even for the same task, add to the knowledge.
+func supersedes(newer, older *entity.SMETaskSubmission) bool {
+	if older.ID == newer.ID || older.SupersededBySubmissionID != nil || older.SubmittedAt.After(newer.SubmittedAt) {
+		return false
+	}
+
+	switch {
+	case newer.ReplacesSubmissionID != nil:
+		return *newer.ReplacesSubmissionID == older.ID
+	case newer.SourceURL != nil || older.SourceURL != nil:
+		return newer.SourceURL != nil && older.SourceURL != nil && *newer.SourceURL == *older.SourceURL
+	case newer.ContentType == valueobject.ContentTypeText || newer.ContentType == valueobject.ContentTypeURL:
+		// Pasted text has no name to match on
+		return false
+	default:
+		return newer.FileName != "" && older.ContentType == newer.ContentType && older.FileName == newer.FileName
+	}
+}
+
+// findUnchangedSubmission returns a current submission among submissions
+// whose content hash matches submission's, or nil if the content is new.
+func findUnchangedSubmission(submissions []*entity.SMETaskSubmission, submission *entity.SMETaskSubmission) *entity.SMETaskSubmission {
+	if submission.ContentHash == nil {
+		return nil
+	}
+	for _, other := range submissions {
+		if other.ID == submission.ID || other.SupersededBySubmissionID != nil || other.ContentHash == nil {
+			continue
+		}
+		if *other.ContentHash == *submission.ContentHash && (other.ProcessedAt != nil || other.IsApproved) {
+			return other
+		}
+	}
+	return nil
+}
+
+// supersedeSubmissions deletes the knowledge of the older submissions that
+// newer replaces and marks them superseded. The deletions are recorded in the
+// chunks' history under authorUserID, or as system changes when it is nil.
+// Returns how many were superseded.
+func supersedeSubmissions(
+	ctx context.Context,
+	submissionRepo repository.SMESubmissionRepository,
+	knowledgeRepo repository.SMEKnowledgeRepository,
+	versionRepo repository.SMEKnowledgeVersionRepository,
+	submissions []*entity.SMETaskSubmission,
+	newer *entity.SMETaskSubmission,
+	authorUserID *uuid.UUID,
+) (int, error) {
+	superseded := 0
+	for _, older := range submissions {
+		if !supersedes(newer, older) {
+			continue
+		}
+		reason := fmt.Sprintf("Superseded by submission %s", newer.ID)
+		if err := deleteSubmissionKnowledge(ctx, knowledgeRepo, versionRepo, older.ID, authorUserID, reason); err != nil {
+			return superseded, fmt.Errorf("failed to delete knowledge of submission %s: %w", older.ID, err)
+		}
+		older.SupersededBySubmissionID = &newer.ID
+		if err := submissionRepo.Update(ctx, older); err != nil {
+			return superseded, fmt.Errorf("failed to mark submission %s superseded: %w", older.ID, err)
+		}
+		superseded++
+	}
+	return superseded, nil
+}
+
+// buildKnowledgeDigest lists an SME's chunks as a plain-text summary. It is
+// used when no AI provider is available to write one.
+func buildKnowledgeDigest(chunks []*entity.SMEKnowledgeChunk) string {
+	var sb strings.Builder
+	sb.WriteString("This knowledge base contains ")
+	sb.WriteString(fmt.Sprintf("%d", len(chunks)))
+	sb.WriteString(" piece(s) of knowledge:\n\n")
+	for i, c := range chunks {
+		if c.Topic != "" {
+			sb.WriteString(fmt.Sprintf("%d. **%s**: ", i+1, c.Topic))
+		} else {
+			sb.WriteString(fmt.Sprintf("%d. ", i+1))
+		}
+		// Truncate content for summary if too long
+		content := c.Content
+		if len(content) > 200 {
+			content = content[:200] + "..."
+		}
+		sb.WriteString(content)
+		sb.WriteString("\n\n")
+	}
+	return sb.String()
+}
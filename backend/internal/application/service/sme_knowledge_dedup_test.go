@@ -0,0 +1,227 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+func TestSubmissionContentHashNormalizesWhitespace(t *testing.T) {
+	a := submissionContentHash("Hot tubs  need\n\tregular   testing.\n")
+	b := submissionContentHash("  Hot tubs need regular testing.")
+	if a != b {
+		t.Error("texts differing only in whitespace hashed differently")
+	}
+	if a == submissionContentHash("Hot tubs need weekly testing.") {
+		t.Error("different texts hashed the same")
+	}
+}
+
+func TestJaccard(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"identical", "keep the water clean", "keep the water clean", 1},
+		{"case and punctuation", "Keep the water clean!", "keep, the water... clean", 1},
+		{"disjoint", "keep the water clean", "drain the filter weekly", 0},
+		{"partial", "a b c d", "a b c e", 0.5}, // {ab bc cd} vs {ab bc ce}: 2 shared of 4
+		{"single word", "chlorine", "chlorine", 1},
+		{"empty", "", "chlorine", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jaccard(chunkShingles(tt.a), chunkShingles(tt.b)); got != tt.want {
+				t.Errorf("jaccard(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKnowledgeIndexAdd(t *testing.T) {
+	idx := newKnowledgeIndex([]*entity.SMEKnowledgeChunk{
+		{Content: "Test the water chemistry twice a week and keep the pH between 7.2 and 7.8."},
+	})
+
+	if idx.add("Test the water chemistry twice a week and keep the pH between 7.2 and 7.6.") {
+		t.Error("a reworded existing chunk was added")
+	}
+	if !idx.add("Clean the filter cartridge every month with a garden hose.") {
+		t.Error("a new chunk was rejected")
+	}
+	if idx.add("Clean the filter cartridge every month with a garden hose!") {
+		t.Error("a chunk added earlier in the same batch was added again")
+	}
+}
+
+func TestSupersedes(t *testing.T) {
+	now := time.Now()
+	url := func(s string) *string { return &s }
+	id := func(u uuid.UUID) *uuid.UUID { return &u }
+	submission := func(mutate func(*entity.SMETaskSubmission)) *entity.SMETaskSubmission {
+		s := &entity.SMETaskSubmission{
+			ID:          uuid.New(),
+			TaskID:      uuid.New(),
+			FileName:    "handbook.pdf",
+			ContentType: valueobject.ContentTypeDocument,
+			SubmittedAt: now,
+		}
+		if mutate != nil {
+			mutate(s)
+		}
+		return s
+	}
+
+	older := submission(func(s *entity.SMETaskSubmission) { s.SubmittedAt = now.Add(-time.Hour) })
+	olderPage := submission(func(s *entity.SMETaskSubmission) {
+		s.FileName = ""
+		s.ContentType = valueobject.ContentTypeURL
+		s.SourceURL = url("https://example.com/guide")
+		s.SubmittedAt = now.Add(-time.Hour)
+	})
+	olderText := submission(func(s *entity.SMETaskSubmission) {
+		s.FileName = ""
+		s.ContentType = valueobject.ContentTypeText
+		s.SubmittedAt = now.Add(-time.Hour)
+	})
+
+	tests := []struct {
+		name         string
+		newer, older *entity.SMETaskSubmission
+		want         bool
+	}{
+		{
+			name:  "same file name and type",
+			newer: submission(nil),
+			older: older,
+			want:  true,
+		},
+		{
+			name:  "different file name",
+			newer: submission(func(s *entity.SMETaskSubmission) { s.FileName = "appendix.pdf" }),
+			older: older,
+		},
+		{
+			name:  "same file name, different type",
+			newer: submission(func(s *entity.SMETaskSubmission) { s.ContentType = valueobject.ContentTypeImage }),
+			older: older,
+		},
+		{
+			name:  "same task without a matching source",
+			newer: submission(func(s *entity.SMETaskSubmission) { s.TaskID = older.TaskID; s.FileName = "appendix.pdf" }),
+			older: older,
+		},
+		{
+			name: "same URL",
+			newer: submission(func(s *entity.SMETaskSubmission) {
+				s.FileName = ""
+				s.ContentType = valueobject.ContentTypeURL
+				s.SourceURL = url("https://example.com/guide")
+			}),
+			older: olderPage,
+			want:  true,
+		},
+		{
+			name: "different URL",
+			newer: submission(func(s *entity.SMETaskSubmission) {
+				s.FileName = ""
+				s.ContentType = valueobject.ContentTypeURL
+				s.SourceURL = url("https://example.com/other")
+			}),
+			older: olderPage,
+		},
+		{
+			name:  "file does not replace a page",
+			newer: submission(nil),
+			older: olderPage,
+		},
+		{
+			name: "pasted text",
+			newer: submission(func(s *entity.SMETaskSubmission) {
+				s.FileName = ""
+				s.ContentType = valueobject.ContentTypeText
+				s.TaskID = olderText.TaskID
+			}),
+			older: olderText,
+		},
+		{
+			name: "explicit replacement of pasted text",
+			newer: submission(func(s *entity.SMETaskSubmission) {
+				s.FileName = ""
+				s.ContentType = valueobject.ContentTypeText
+				s.ReplacesSubmissionID = id(olderText.ID)
+			}),
+			older: olderText,
+			want:  true,
+		},
+		{
+			name:  "explicit replacement of another submission",
+			newer: submission(func(s *entity.SMETaskSubmission) { s.ReplacesSubmissionID = id(uuid.New()) }),
+			older: older,
+		},
+		{
+			name:  "older submitted after newer",
+			newer: submission(func(s *entity.SMETaskSubmission) { s.SubmittedAt = now.Add(-2 * time.Hour) }),
+			older: older,
+		},
+		{
+			name:  "older already superseded",
+			newer: submission(nil),
+			older: submission(func(s *entity.SMETaskSubmission) {
+				s.SubmittedAt = now.Add(-time.Hour)
+				s.SupersededBySubmissionID = id(uuid.New())
+			}),
+		},
+		{
+			name:  "same submission",
+			newer: older,
+			older: older,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := supersedes(tt.newer, tt.older); got != tt.want {
+				t.Errorf("supersedes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindUnchangedSubmission(t *testing.T) {
+	hash := submissionContentHash("Keep the pH between 7.2 and 7.8.")
+	otherHash := submissionContentHash("Clean the filter monthly.")
+	processed := time.Now()
+
+	current := &entity.SMETaskSubmission{ID: uuid.New(), ContentHash: &hash, ProcessedAt: &processed}
+	pending := &entity.SMETaskSubmission{ID: uuid.New(), ContentHash: &hash}
+	superseded := &entity.SMETaskSubmission{ID: uuid.New(), ContentHash: &hash, ProcessedAt: &processed, SupersededBySubmissionID: &current.ID}
+	different := &entity.SMETaskSubmission{ID: uuid.New(), ContentHash: &otherHash, ProcessedAt: &processed}
+
+	submission := &entity.SMETaskSubmission{ID: uuid.New(), ContentHash: &hash}
+
+	tests := []struct {
+		name        string
+		submissions []*entity.SMETaskSubmission
+		submission  *entity.SMETaskSubmission
+		want        *entity.SMETaskSubmission
+	}{
+		{"processed match", []*entity.SMETaskSubmission{different, current, submission}, submission, current},
+		{"unprocessed match", []*entity.SMETaskSubmission{pending, submission}, submission, nil},
+		{"superseded match", []*entity.SMETaskSubmission{superseded, submission}, submission, nil},
+		{"different content", []*entity.SMETaskSubmission{different, submission}, submission, nil},
+		{"itself", []*entity.SMETaskSubmission{current}, current, nil},
+		{"no hash", []*entity.SMETaskSubmission{current}, &entity.SMETaskSubmission{ID: uuid.New()}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := findUnchangedSubmission(tt.submissions, tt.submission); got != tt.want {
+				t.Errorf("findUnchangedSubmission() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
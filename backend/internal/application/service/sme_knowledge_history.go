@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// maxDiffCells bounds the line-diff table. Larger edits are shown as the
+// whole old text removed and the new text added.
+const maxDiffCells = 1_000_000
+
+// recordChunkVersions records the current state of each chunk in its history.
+func recordChunkVersions(
+	ctx context.Context,
+	versionRepo repository.SMEKnowledgeVersionRepository,
+	chunks []*entity.SMEKnowledgeChunk,
+	changeType valueobject.KnowledgeChangeType,
+	authorUserID *uuid.UUID,
+	reason *string,
+) error {
+	for _, chunk := range chunks {
+		version := entity.NewSMEKnowledgeChunkVersion(chunk, changeType, authorUserID, reason)
+		if err := versionRepo.Create(ctx, version); err != nil {
+			return fmt.Errorf("failed to record version of chunk %s: %w", chunk.ID, err)
+		}
+	}
+	return nil
+}
+
+// deleteSubmissionKnowledge deletes the chunks created from a submission,
+// recording each deletion in the chunk's history first.
+func deleteSubmissionKnowledge(
+	ctx context.Context,
+	knowledgeRepo repository.SMEKnowledgeRepository,
+	versionRepo repository.SMEKnowledgeVersionRepository,
+	submissionID uuid.UUID,
+	authorUserID *uuid.UUID,
+	reason string,
+) error {
+	chunks, err := knowledgeRepo.ListBySubmissionID(ctx, submissionID)
+	if err != nil {
+		return fmt.Errorf("failed to list chunks of submission %s: %w", submissionID, err)
+	}
+	if len(chunks) == 0 {
+		return nil
+	}
+	if err := recordChunkVersions(ctx, versionRepo, chunks, valueobject.KnowledgeChangeDeleted, authorUserID, &reason); err != nil {
+		return err
+	}
+	return knowledgeRepo.DeleteBySubmissionID(ctx, submissionID)
+}
+
+// versionDiff returns the line diff from the version before v to v. Deleted
+// versions diff to empty text, and the version after a deletion from it.
+func versionDiff(previous, v *entity.SMEKnowledgeChunkVersion) string {
+	var before, after string
+	if previous != nil && previous.ChangeType != valueobject.KnowledgeChangeDeleted {
+		before = versionText(previous)
+	}
+	if v.ChangeType != valueobject.KnowledgeChangeDeleted {
+		after = versionText(v)
+	}
+	return diffLines(before, after)
+}
+
+// versionText renders the reviewable fields of a version for diffing.
+func versionText(v *entity.SMEKnowledgeChunkVersion) string {
+	return fmt.Sprintf("Topic: %s\nKeywords: %s\n\n%s", v.Topic, strings.Join(v.Keywords, ", "), v.Content)
+}
+
+// diffLines returns a line diff of two texts: removed lines are prefixed
+// with "- ", added lines with "+ " and unchanged lines with two spaces.
+func diffLines(before, after string) string {
+	a, b := splitLines(before), splitLines(after)
+
+	// Unchanged leading and trailing lines need no table
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	var sb strings.Builder
+	writeLines := func(mark string, lines []string) {
+		for _, line := range lines {
+			sb.WriteString(mark)
+			sb.WriteString(line)
+			sb.WriteByte('\n')
+		}
+	}
+
+	writeLines("  ", a[:prefix])
+	if len(midA)*len(midB) > maxDiffCells {
+		writeLines("- ", midA)
+		writeLines("+ ", midB)
+	} else {
+		// lcs[i][j] is the longest common subsequence of midA[i:] and midB[j:]
+		lcs := make([][]int, len(midA)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(midB)+1)
+		}
+		for i := len(midA) - 1; i >= 0; i-- {
+			for j := len(midB) - 1; j >= 0; j-- {
+				if midA[i] == midB[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(midA) || j < len(midB) {
+			switch {
+			case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+				writeLines("  ", midA[i:i+1])
+				i++
+				j++
+			case i < len(midA) && (j == len(midB) || lcs[i+1][j] >= lcs[i][j+1]):
+				writeLines("- ", midA[i:i+1])
+				i++
+			default:
+				writeLines("+ ", midB[j:j+1])
+				j++
+			}
+		}
+	}
+	writeLines("  ", a[len(a)-suffix:])
+
+	return sb.String()
+}
+
+// splitLines splits text into lines, returning none for empty text.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// versionAt returns the version of a chunk that was current at t, or nil if
+// the chunk did not exist yet. versions must be ordered oldest first.
+func versionAt(versions []*entity.SMEKnowledgeChunkVersion, t time.Time) *entity.SMEKnowledgeChunkVersion {
+	var current *entity.SMEKnowledgeChunkVersion
+	for _, v := range versions {
+		if v.CreatedAt.After(t) {
+			break
+		}
+		current = v
+	}
+	return current
+}
+
+// knowledgeChangedSince reports whether latest no longer carries the knowledge
+// of an earlier version: it was deleted or its content was edited. Topic and
+// keyword changes alone do not outdate lessons.
+func knowledgeChangedSince(earlier, latest *entity.SMEKnowledgeChunkVersion) bool {
+	return latest.ChangeType == valueobject.KnowledgeChangeDeleted || latest.Content != earlier.Content
+}
+
+// staleLessonReason describes the cited knowledge of the given SMEs that
+// changed after a lesson was generated at generatedAt, or returns "" if none
+// did. byChunk holds the cited chunks' versions, oldest first.
+func staleLessonReason(chunkIDs []uuid.UUID, byChunk map[uuid.UUID][]*entity.SMEKnowledgeChunkVersion, smeIDs []uuid.UUID, generatedAt time.Time) string {
+	seen := make(map[uuid.UUID]bool, len(chunkIDs))
+	edited, deleted := 0, 0
+	for _, id := range chunkIDs {
+		versions := byChunk[id]
+		if seen[id] || len(versions) == 0 || !slices.Contains(smeIDs, versions[0].SMEID) {
+			continue
+		}
+		seen[id] = true
+
+		latest := versions[len(versions)-1]
+		generated := versionAt(versions, generatedAt)
+		if generated == nil || !knowledgeChangedSince(generated, latest) {
+			continue
+		}
+		if latest.ChangeType == valueobject.KnowledgeChangeDeleted {
+			deleted++
+		} else {
+			edited++
+		}
+	}
+
+	var changes []string
+	if edited > 0 {
+		changes = append(changes, fmt.Sprintf("%d edited", edited))
+	}
+	if deleted > 0 {
+		changes = append(changes, fmt.Sprintf("%d deleted", deleted))
+	}
+	if len(changes) == 0 {
+		return ""
+	}
+	return "Cited knowledge chunks changed since generation: " + strings.Join(changes, ", ")
+}
+
+// groupVersionsByChunk groups versions by chunk, keeping their order.
+func groupVersionsByChunk(versions []*entity.SMEKnowledgeChunkVersion) map[uuid.UUID][]*entity.SMEKnowledgeChunkVersion {
+	byChunk := make(map[uuid.UUID][]*entity.SMEKnowledgeChunkVersion)
+	for _, v := range versions {
+		byChunk[v.ChunkID] = append(byChunk[v.ChunkID], v)
+	}
+	return byChunk
+}
+
+// findOutdatedLessons returns the generated lessons citing any of the given
+// chunks whose knowledge has changed since the lesson was generated, newest
+// lessons first. A lesson citing several changed chunks is listed once per chunk.
+func findOutdatedLessons(
+	ctx context.Context,
+	componentRepo repository.LessonComponentRepository,
+	lessonRepo repository.GeneratedLessonRepository,
+	versionRepo repository.SMEKnowledgeVersionRepository,
+	chunkIDs []uuid.UUID,
+) ([]entity.OutdatedLesson, error) {
+	components, err := componentRepo.ListBySMEChunkIDs(ctx, chunkIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list citing components: %w", err)
+	}
+	if len(components) == 0 {
+		return nil, nil
+	}
+
+	versions, err := versionRepo.ListByChunkIDs(ctx, chunkIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chunk versions: %w", err)
+	}
+	byChunk := groupVersionsByChunk(versions)
+
+	lessons := make(map[uuid.UUID]*entity.GeneratedLesson)
+	seen := make(map[[2]uuid.UUID]bool)
+	var outdated []entity.OutdatedLesson
+	for _, component := range components {
+		lesson, ok := lessons[component.LessonID]
+		if !ok {
+			lesson, err = lessonRepo.GetByID(ctx, component.LessonID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get lesson %s: %w", component.LessonID, err)
+			}
+			lessons[component.LessonID] = lesson
+		}
+		if lesson == nil {
+			continue
+		}
+
+		for _, chunkID := range component.SMEChunkIDs {
+			chunkVersions := byChunk[chunkID]
+			key := [2]uuid.UUID{lesson.ID, chunkID}
+			if len(chunkVersions) == 0 || seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			latest := chunkVersions[len(chunkVersions)-1]
+			generated := versionAt(chunkVersions, lesson.GeneratedAt)
+			if generated == nil || !knowledgeChangedSince(generated, latest) {
+				continue
+			}
+			outdated = append(outdated, entity.OutdatedLesson{
+				LessonID:         lesson.ID,
+				CourseID:         lesson.CourseID,
+				Title:            lesson.Title,
+				GeneratedAt:      lesson.GeneratedAt,
+				ChunkID:          chunkID,
+				GeneratedVersion: generated.Version,
+				CurrentVersion:   latest.Version,
+				ChunkDeleted:     latest.ChangeType == valueobject.KnowledgeChangeDeleted,
+			})
+		}
+	}
+
+	sort.SliceStable(outdated, func(i, j int) bool {
+		return outdated[i].GeneratedAt.After(outdated[j].GeneratedAt)
+	})
+	return outdated, nil
+}
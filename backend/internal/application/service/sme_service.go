@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -52,9 +55,15 @@ type SMEService struct {
 	taskRepo       repository.SMETaskRepository
 	submissionRepo repository.SMESubmissionRepository
 	knowledgeRepo  repository.SMEKnowledgeRepository
+	versionRepo    repository.SMEKnowledgeVersionRepository
+	lessonRepo     repository.GeneratedLessonRepository
+	componentRepo  repository.LessonComponentRepository
 	storage        TenantStorageAdapter
 	notifier       TaskNotifier
 	enhancer       ContentEnhancer
+	embedders      EmbedderFactory
+	tokenBudget    *TokenBudget
+	entitlements   *EntitlementService
 	logger         service.Logger
 }
 
@@ -67,9 +76,13 @@ func NewSMEService(
 	taskRepo repository.SMETaskRepository,
 	submissionRepo repository.SMESubmissionRepository,
 	knowledgeRepo repository.SMEKnowledgeRepository,
+	versionRepo repository.SMEKnowledgeVersionRepository,
+	lessonRepo repository.GeneratedLessonRepository,
+	componentRepo repository.LessonComponentRepository,
 	storage TenantStorageAdapter,
 	notifier TaskNotifier,
 	enhancer ContentEnhancer,
+	entitlements *EntitlementService,
 	logger service.Logger,
 ) *SMEService {
 	return &SMEService{
@@ -80,13 +93,35 @@ func NewSMEService(
 		taskRepo:       taskRepo,
 		submissionRepo: submissionRepo,
 		knowledgeRepo:  knowledgeRepo,
+		versionRepo:    versionRepo,
+		lessonRepo:     lessonRepo,
+		componentRepo:  componentRepo,
 		storage:        storage,
 		notifier:       notifier,
 		enhancer:       enhancer,
+		entitlements:   entitlements,
 		logger:         logger,
 	}
 }
 
+// SetEmbedderFactory enables semantic knowledge search. It is set once AI
+// services are available; until then search and approval use keywords only.
+// Embedding tokens are billed to tokenBudget.
+func (s *SMEService) SetEmbedderFactory(embedders EmbedderFactory, tokenBudget *TokenBudget) {
+	s.embedders = embedders
+	s.tokenBudget = tokenBudget
+}
+
+// embedChunks embeds chunks a user added or changed, billing the tokens to
+// their tenant as SME knowledge upkeep.
+func (s *SMEService) embedChunks(ctx context.Context, tenantID uuid.UUID, userID uuid.UUID, chunks []*entity.SMEKnowledgeChunk) error {
+	tokensUsed, err := embedKnowledgeChunks(ctx, s.embedders, s.knowledgeRepo, tenantID, chunks)
+	if s.tokenBudget != nil {
+		s.tokenBudget.RecordTenantUsage(ctx, tenantID, &userID, valueobject.GenerationJobTypeSMEIngestion, tokensUsed)
+	}
+	return err
+}
+
 // CreateSMERequest contains the parameters for creating an SME.
 type CreateSMERequest struct {
 	Name        string
@@ -113,6 +148,10 @@ func (s *SMEService) CreateSME(ctx context.Context, kratosID uuid.UUID, req Crea
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
+	if err := s.entitlements.CheckCanCreateSME(ctx, *user.CompanyID); err != nil {
+		return nil, err
+	}
+
 	sme := &entity.SubjectMatterExpert{
 		TenantID:        *user.TenantID,
 		CompanyID:       *user.CompanyID,
@@ -476,6 +515,9 @@ func (s *SMEService) GetUploadURL(ctx context.Context, kratosID uuid.UUID, taskI
 	// Generate S3 path: tenants/{tenant_id}/sme/{sme_id}/submissions/{task_id}/{filename}
 	path := "sme/" + task.SMEID.String() + "/submissions/" + task.ID.String() + "/" + filename
 	url, err := s.storage.GenerateUploadURL(ctx, *user.TenantID, path, 15*time.Minute)
+	if errors.Is(err, domainerrors.ErrTenantSuspended) {
+		return "", "", err
+	}
 	if err != nil {
 		s.logger.Error("failed to generate upload URL", "error", err)
 		return "", "", domainerrors.ErrInternal.WithCause(err)
@@ -492,6 +534,10 @@ type SubmitContentRequest struct {
 	ContentType   valueobject.ContentType
 	FileSizeBytes int64
 	TextContent   *string // For CONTENT_TYPE_TEXT submissions
+	SourceURL     *string // For CONTENT_TYPE_URL submissions
+
+	// Earlier submission this one replaces, for revisions that aren't the same file or URL
+	ReplacesSubmissionID *uuid.UUID
 }
 
 // SubmitContent records a content submission for a task.
@@ -519,14 +565,44 @@ func (s *SMEService) SubmitContent(ctx context.Context, kratosID uuid.UUID, req
 		}
 	}
 
+	// Validate URL submissions; the page is fetched during ingestion
+	if req.ContentType == valueobject.ContentTypeURL {
+		if req.SourceURL == nil || *req.SourceURL == "" {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("source_url is required for URL submissions")
+		}
+		u, err := url.Parse(*req.SourceURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, domainerrors.ErrInvalidInput.WithMessage("source_url must be an absolute http or https URL")
+		}
+	}
+
+	// A replaced submission must hold knowledge of the same SME
+	if req.ReplacesSubmissionID != nil {
+		replaced, err := s.submissionRepo.GetByID(ctx, *req.ReplacesSubmissionID)
+		if err != nil {
+			log.Error("failed to get replaced submission", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if replaced == nil {
+			return nil, domainerrors.ErrSMESubmissionNotFound.WithMessage("the submission to replace was not found")
+		}
+		if replaced.TaskID != task.ID {
+			replacedTask, err := s.taskRepo.GetByID(ctx, replaced.TaskID)
+			if err != nil || replacedTask == nil || replacedTask.SMEID != task.SMEID {
+				return nil, domainerrors.ErrInvalidInput.WithMessage("a submission can only replace one for the same SME")
+			}
+		}
+	}
+
 	submission := &entity.SMETaskSubmission{
-		TenantID:          *user.TenantID,
-		TaskID:            req.TaskID,
-		SubmittedByUserID: user.ID,
-		FileName:          req.FileName,
-		FilePath:          req.FilePath,
-		ContentType:       req.ContentType,
-		FileSizeBytes:     req.FileSizeBytes,
+		TenantID:             *user.TenantID,
+		TaskID:               req.TaskID,
+		SubmittedByUserID:    user.ID,
+		FileName:             req.FileName,
+		FilePath:             req.FilePath,
+		ContentType:          req.ContentType,
+		FileSizeBytes:        req.FileSizeBytes,
+		ReplacesSubmissionID: req.ReplacesSubmissionID,
 	}
 
 	// For text submissions, set ExtractedText directly (no file to process)
@@ -538,6 +614,16 @@ func (s *SMEService) SubmitContent(ctx context.Context, kratosID uuid.UUID, req
 		}
 	}
 
+	// For URL submissions, keep the URL and show its host and path as the file name
+	if req.ContentType == valueobject.ContentTypeURL {
+		submission.SourceURL = req.SourceURL
+		submission.FilePath = ""
+		submission.FileSizeBytes = 0
+		if req.FileName == "" {
+			submission.FileName = urlDisplayName(*req.SourceURL)
+		}
+	}
+
 	if err := s.submissionRepo.Create(ctx, submission); err != nil {
 		log.Error("failed to create submission", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
@@ -610,6 +696,85 @@ func (s *SMEService) GetKnowledge(ctx context.Context, kratosID uuid.UUID, smeID
 	return chunks, nil
 }
 
+// SearchKnowledgeRequest contains the parameters for searching SME knowledge.
+type SearchKnowledgeRequest struct {
+	SMEIDs []uuid.UUID
+	Query  string
+	Limit  int
+}
+
+const (
+	defaultKnowledgeSearchLimit = 10
+	maxKnowledgeSearchLimit     = 50
+)
+
+// SearchKnowledge searches knowledge chunks across the given SMEs, ranking
+// by semantic similarity blended with keyword matches and relevance score.
+// If the query cannot be embedded, it falls back to keyword ranking.
+func (s *SMEService) SearchKnowledge(ctx context.Context, kratosID uuid.UUID, req SearchKnowledgeRequest) ([]*entity.SMEKnowledgeChunk, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("search query is required")
+	}
+	if len(req.SMEIDs) == 0 {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("at least one SME is required")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultKnowledgeSearchLimit
+	}
+	if limit > maxKnowledgeSearchLimit {
+		limit = maxKnowledgeSearchLimit
+	}
+
+	for _, smeID := range req.SMEIDs {
+		sme, err := s.smeRepo.GetByID(ctx, smeID)
+		if err != nil || sme == nil {
+			return nil, domainerrors.ErrSMENotFound
+		}
+		if !s.userHasSMEAccess(ctx, user, sme) {
+			return nil, domainerrors.ErrSMENoAccess
+		}
+	}
+
+	var queryEmbedding []float32
+	var embeddingModel string
+	if s.embedders != nil {
+		embedder, err := s.embedders.GetEmbedder(ctx, *user.TenantID)
+		if err == nil {
+			var tokensUsed int64
+			embeddingModel = embedder.EmbeddingModel()
+			queryEmbedding, tokensUsed, err = embedder.EmbedQuery(ctx, query)
+			if s.tokenBudget != nil {
+				s.tokenBudget.RecordTenantUsage(ctx, *user.TenantID, &user.ID, valueobject.GenerationJobTypeSMEIngestion, tokensUsed)
+			}
+		}
+		if err != nil {
+			log.Warn("failed to embed search query, using keyword search", "error", err)
+			queryEmbedding = nil
+		}
+	}
+
+	chunks, err := s.knowledgeRepo.Search(ctx, req.SMEIDs, query, queryEmbedding, embeddingModel, limit)
+	if err != nil {
+		log.Error("failed to search knowledge", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return chunks, nil
+}
+
 // userHasSMEAccess checks if a user has access to an SME.
 func (s *SMEService) userHasSMEAccess(ctx context.Context, user *entity.User, sme *entity.SubjectMatterExpert) bool {
 	// Admins have access to all
@@ -689,28 +854,64 @@ func (s *SMEService) ApproveSubmission(ctx context.Context, kratosID uuid.UUID,
 	submission.IsApproved = true
 	submission.ApprovedAt = &now
 	submission.ApprovedByUserID = &user.ID
+	if submission.ContentHash == nil {
+		hash := submissionContentHash(req.ApprovedContent)
+		submission.ContentHash = &hash
+	}
 
 	if err := s.submissionRepo.Update(ctx, submission); err != nil {
 		log.Error("failed to update submission", "error", err)
 		return nil, nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	// Create knowledge chunk from approved content
-	chunk := &entity.SMEKnowledgeChunk{
-		TenantID:       submission.TenantID,
-		SMEID:          task.SMEID,
-		SubmissionID:   &submission.ID,
-		Content:        req.ApprovedContent,
-		Topic:          task.Title,
-		Keywords:       []string{},
-		RelevanceScore: 0.8,
+	// An approved revision replaces the knowledge of the submissions it supersedes
+	submissions, err := s.submissionRepo.ListBySMEID(ctx, task.SMEID)
+	if err != nil {
+		log.Error("failed to list SME submissions", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	superseded, err := supersedeSubmissions(ctx, s.submissionRepo, s.knowledgeRepo, s.versionRepo, submissions, submission, &user.ID)
+	if err != nil {
+		log.Error("failed to supersede older submissions", "error", err)
+		return nil, nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
-	if err := s.knowledgeRepo.Create(ctx, chunk); err != nil {
-		log.Error("failed to create knowledge chunk", "error", err)
+	existingChunks, err := s.knowledgeRepo.ListBySMEID(ctx, task.SMEID)
+	if err != nil {
+		log.Error("failed to list knowledge chunks", "error", err)
 		return nil, nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	// Create knowledge chunk from approved content unless the SME already has it
+	var createdChunks []*entity.SMEKnowledgeChunk
+	if newKnowledgeIndex(existingChunks).add(req.ApprovedContent) {
+		chunk := &entity.SMEKnowledgeChunk{
+			TenantID:       submission.TenantID,
+			SMEID:          task.SMEID,
+			SubmissionID:   &submission.ID,
+			Content:        req.ApprovedContent,
+			Topic:          task.Title,
+			Keywords:       []string{},
+			RelevanceScore: 0.8,
+		}
+
+		if err := s.knowledgeRepo.Create(ctx, chunk); err != nil {
+			log.Error("failed to create knowledge chunk", "error", err)
+			return nil, nil, domainerrors.ErrInternal.WithCause(err)
+		}
+		if err := recordChunkVersions(ctx, s.versionRepo, []*entity.SMEKnowledgeChunk{chunk}, valueobject.KnowledgeChangeCreated, &user.ID, nil); err != nil {
+			log.Warn("failed to record knowledge chunk history", "error", err)
+		}
+
+		if err := s.embedChunks(ctx, submission.TenantID, user.ID, []*entity.SMEKnowledgeChunk{chunk}); err != nil {
+			log.Warn("failed to embed knowledge chunk", "error", err)
+		}
+		createdChunks = append(createdChunks, chunk)
+		existingChunks = append(existingChunks, chunk)
+	} else {
+		log.Info("approved content duplicates existing knowledge, no chunk created")
+	}
+
 	// Update task status to completed
 	task.Status = valueobject.SMETaskStatusCompleted
 	completedAt := time.Now()
@@ -720,47 +921,15 @@ func (s *SMEService) ApproveSubmission(ctx context.Context, kratosID uuid.UUID,
 	}
 
 	// Update SME: set status to active and regenerate knowledge summary
-	smeNeedsUpdate := false
-
-	// Update status if it was draft
 	if sme.Status == valueobject.SMEStatusDraft {
 		sme.Status = valueobject.SMEStatusActive
-		smeNeedsUpdate = true
-	}
-
-	// Aggregate all knowledge chunks into a summary
-	allChunks, err := s.knowledgeRepo.ListBySMEID(ctx, task.SMEID)
-	if err != nil {
-		log.Error("failed to list knowledge chunks for summary", "error", err)
-	} else if len(allChunks) > 0 {
-		// Build a knowledge summary from all chunks
-		var summaryBuilder strings.Builder
-		summaryBuilder.WriteString("This knowledge base contains ")
-		summaryBuilder.WriteString(fmt.Sprintf("%d", len(allChunks)))
-		summaryBuilder.WriteString(" piece(s) of knowledge:\n\n")
-		for i, c := range allChunks {
-			if c.Topic != "" {
-				summaryBuilder.WriteString(fmt.Sprintf("%d. **%s**: ", i+1, c.Topic))
-			} else {
-				summaryBuilder.WriteString(fmt.Sprintf("%d. ", i+1))
-			}
-			// Truncate content for summary if too long
-			content := c.Content
-			if len(content) > 200 {
-				content = content[:200] + "..."
-			}
-			summaryBuilder.WriteString(content)
-			summaryBuilder.WriteString("\n\n")
-		}
-		summary := summaryBuilder.String()
-		sme.KnowledgeSummary = &summary
-		smeNeedsUpdate = true
 	}
 
-	if smeNeedsUpdate {
-		if err := s.smeRepo.Update(ctx, sme); err != nil {
-			log.Error("failed to update SME", "error", err)
-		}
+	// Summarize the full current chunk set, not just this submission
+	summary := buildKnowledgeDigest(existingChunks)
+	sme.KnowledgeSummary = &summary
+	if err := s.smeRepo.Update(ctx, sme); err != nil {
+		log.Error("failed to update SME", "error", err)
 	}
 
 	// Notify the submitter that their content was approved
@@ -777,8 +946,8 @@ func (s *SMEService) ApproveSubmission(ctx context.Context, kratosID uuid.UUID,
 		}
 	}
 
-	log.Info("submission approved", "chunkID", chunk.ID)
-	return submission, []*entity.SMEKnowledgeChunk{chunk}, nil
+	log.Info("submission approved", "chunksCreated", len(createdChunks), "superseded", superseded)
+	return submission, createdChunks, nil
 }
 
 // RequestSubmissionChangesRequest contains the parameters for requesting changes.
@@ -924,13 +1093,14 @@ func (s *SMEService) DeleteTask(ctx context.Context, kratosID uuid.UUID, taskID
 
 // UpdateKnowledgeChunkRequest contains the parameters for updating a knowledge chunk.
 type UpdateKnowledgeChunkRequest struct {
-	ChunkID  uuid.UUID
-	Content  string
-	Topic    *string
-	Keywords []string
+	ChunkID      uuid.UUID
+	Content      string
+	Topic        *string
+	Keywords     []string
+	ChangeReason *string // Recorded in the chunk's history
 }
 
-// UpdateKnowledgeChunk updates a knowledge chunk.
+// UpdateKnowledgeChunk updates a knowledge chunk and records the edit in its history.
 func (s *SMEService) UpdateKnowledgeChunk(ctx context.Context, kratosID uuid.UUID, req UpdateKnowledgeChunkRequest) (*entity.SMEKnowledgeChunk, error) {
 	log := s.logger.With("kratosID", kratosID, "chunkID", req.ChunkID)
 
@@ -949,6 +1119,7 @@ func (s *SMEService) UpdateKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 	}
 
 	// Apply updates
+	previous := *chunk
 	chunk.Content = req.Content
 	if req.Topic != nil {
 		chunk.Topic = *req.Topic
@@ -956,18 +1127,33 @@ func (s *SMEService) UpdateKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 	if req.Keywords != nil {
 		chunk.Keywords = req.Keywords
 	}
+	if chunk.Content == previous.Content && chunk.Topic == previous.Topic && slices.Equal(chunk.Keywords, previous.Keywords) {
+		return chunk, nil // Nothing to record
+	}
 
 	if err := s.knowledgeRepo.Update(ctx, chunk); err != nil {
 		log.Error("failed to update knowledge chunk", "error", err)
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	if err := recordChunkVersions(ctx, s.versionRepo, []*entity.SMEKnowledgeChunk{chunk}, valueobject.KnowledgeChangeUpdated, &user.ID, req.ChangeReason); err != nil {
+		log.Error("failed to record knowledge chunk history", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// Keep semantic search in step with the edited text
+	if chunk.Content != previous.Content {
+		if err := s.embedChunks(ctx, chunk.TenantID, user.ID, []*entity.SMEKnowledgeChunk{chunk}); err != nil {
+			log.Warn("failed to embed knowledge chunk", "error", err)
+		}
+	}
+
 	log.Info("knowledge chunk updated")
 	return chunk, nil
 }
 
-// DeleteKnowledgeChunk deletes a knowledge chunk.
-func (s *SMEService) DeleteKnowledgeChunk(ctx context.Context, kratosID uuid.UUID, chunkID uuid.UUID) error {
+// DeleteKnowledgeChunk deletes a knowledge chunk, keeping its history so it can be restored.
+func (s *SMEService) DeleteKnowledgeChunk(ctx context.Context, kratosID uuid.UUID, chunkID uuid.UUID, reason *string) error {
 	log := s.logger.With("kratosID", kratosID, "chunkID", chunkID)
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
@@ -979,6 +1165,16 @@ func (s *SMEService) DeleteKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 		return domainerrors.ErrForbidden.WithMessage("insufficient permissions to delete knowledge")
 	}
 
+	chunk, err := s.knowledgeRepo.GetByID(ctx, chunkID)
+	if err != nil || chunk == nil {
+		return domainerrors.ErrNotFound.WithMessage("knowledge chunk not found")
+	}
+
+	if err := recordChunkVersions(ctx, s.versionRepo, []*entity.SMEKnowledgeChunk{chunk}, valueobject.KnowledgeChangeDeleted, &user.ID, reason); err != nil {
+		log.Error("failed to record knowledge chunk history", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
 	if err := s.knowledgeRepo.Delete(ctx, chunkID); err != nil {
 		log.Error("failed to delete knowledge chunk", "error", err)
 		return domainerrors.ErrInternal.WithCause(err)
@@ -988,6 +1184,212 @@ func (s *SMEService) DeleteKnowledgeChunk(ctx context.Context, kratosID uuid.UUI
 	return nil
 }
 
+// KnowledgeChunkVersionResult is a version in a chunk's history with the
+// change it made.
+type KnowledgeChunkVersionResult struct {
+	Version *entity.SMEKnowledgeChunkVersion
+	Diff    string // Line diff from the previous version
+}
+
+// KnowledgeChunkHistory is a chunk's version history and the lessons
+// generated from an older version of it.
+type KnowledgeChunkHistory struct {
+	Versions        []KnowledgeChunkVersionResult // Oldest first
+	OutdatedLessons []entity.OutdatedLesson
+}
+
+// ListKnowledgeChunkVersions returns the version history of a knowledge
+// chunk, including chunks that have since been deleted.
+func (s *SMEService) ListKnowledgeChunkVersions(ctx context.Context, kratosID uuid.UUID, chunkID uuid.UUID) (*KnowledgeChunkHistory, error) {
+	log := s.logger.With("kratosID", kratosID, "chunkID", chunkID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	versions, err := s.versionRepo.ListByChunkID(ctx, chunkID)
+	if err != nil {
+		log.Error("failed to list knowledge chunk versions", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if len(versions) == 0 {
+		return nil, domainerrors.ErrNotFound.WithMessage("knowledge chunk not found")
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, versions[0].SMEID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	history := &KnowledgeChunkHistory{Versions: make([]KnowledgeChunkVersionResult, len(versions))}
+	for i, v := range versions {
+		var previous *entity.SMEKnowledgeChunkVersion
+		if i > 0 {
+			previous = versions[i-1]
+		}
+		history.Versions[i] = KnowledgeChunkVersionResult{Version: v, Diff: versionDiff(previous, v)}
+	}
+
+	history.OutdatedLessons, err = findOutdatedLessons(ctx, s.componentRepo, s.lessonRepo, s.versionRepo, []uuid.UUID{chunkID})
+	if err != nil {
+		log.Error("failed to find outdated lessons", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return history, nil
+}
+
+// RestoreKnowledgeChunkVersionRequest contains the parameters for restoring a chunk version.
+type RestoreKnowledgeChunkVersionRequest struct {
+	ChunkID      uuid.UUID
+	Version      int32
+	ChangeReason *string // Defaults to naming the restored version
+}
+
+// RestoreKnowledgeChunkVersion returns a chunk to the content of an earlier
+// version, re-creating it if it was deleted. The restore is itself recorded
+// as a new version.
+func (s *SMEService) RestoreKnowledgeChunkVersion(ctx context.Context, kratosID uuid.UUID, req RestoreKnowledgeChunkVersionRequest) (*entity.SMEKnowledgeChunk, error) {
+	log := s.logger.With("kratosID", kratosID, "chunkID", req.ChunkID, "version", req.Version)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSME() {
+		return nil, domainerrors.ErrForbidden.WithMessage("insufficient permissions to restore knowledge")
+	}
+
+	version, err := s.versionRepo.GetByChunkIDAndVersion(ctx, req.ChunkID, req.Version)
+	if err != nil {
+		log.Error("failed to get knowledge chunk version", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if version == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("knowledge chunk version not found")
+	}
+
+	reason := fmt.Sprintf("Restored version %d", version.Version)
+	if req.ChangeReason != nil && strings.TrimSpace(*req.ChangeReason) != "" {
+		reason = *req.ChangeReason
+	}
+
+	chunk, err := s.knowledgeRepo.GetByID(ctx, req.ChunkID)
+	if err != nil {
+		log.Error("failed to get knowledge chunk", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if chunk == nil {
+		chunk = version.Chunk()
+		if err := s.knowledgeRepo.Restore(ctx, chunk); err != nil {
+			log.Error("failed to restore knowledge chunk", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		if chunk.Content == version.Content && chunk.Topic == version.Topic && slices.Equal(chunk.Keywords, version.Keywords) {
+			return chunk, nil
+		}
+		chunk.Content = version.Content
+		chunk.Topic = version.Topic
+		chunk.Keywords = version.Keywords
+		if err := s.knowledgeRepo.Update(ctx, chunk); err != nil {
+			log.Error("failed to update knowledge chunk", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	if err := recordChunkVersions(ctx, s.versionRepo, []*entity.SMEKnowledgeChunk{chunk}, valueobject.KnowledgeChangeRestored, &user.ID, &reason); err != nil {
+		log.Error("failed to record knowledge chunk history", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if err := s.embedChunks(ctx, chunk.TenantID, user.ID, []*entity.SMEKnowledgeChunk{chunk}); err != nil {
+		log.Warn("failed to embed knowledge chunk", "error", err)
+	}
+
+	log.Info("knowledge chunk version restored")
+	return chunk, nil
+}
+
+// ListDeletedKnowledgeChunks returns the last version of each deleted chunk
+// of an SME, so deleted knowledge can be found and restored.
+func (s *SMEService) ListDeletedKnowledgeChunks(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	versions, err := s.versionRepo.ListDeletedBySMEID(ctx, smeID)
+	if err != nil {
+		s.logger.Error("failed to list deleted knowledge", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return versions, nil
+}
+
+// ListOutdatedLessons returns the generated lessons citing knowledge of an
+// SME that was edited or deleted after the lesson was generated.
+func (s *SMEService) ListOutdatedLessons(ctx context.Context, kratosID uuid.UUID, smeID uuid.UUID) ([]entity.OutdatedLesson, error) {
+	log := s.logger.With("kratosID", kratosID, "smeID", smeID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	sme, err := s.smeRepo.GetByID(ctx, smeID)
+	if err != nil || sme == nil {
+		return nil, domainerrors.ErrSMENotFound
+	}
+
+	if !s.userHasSMEAccess(ctx, user, sme) {
+		return nil, domainerrors.ErrSMENoAccess
+	}
+
+	chunks, err := s.knowledgeRepo.ListBySMEID(ctx, smeID)
+	if err != nil {
+		log.Error("failed to list knowledge", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	deleted, err := s.versionRepo.ListDeletedBySMEID(ctx, smeID)
+	if err != nil {
+		log.Error("failed to list deleted knowledge", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	chunkIDs := make([]uuid.UUID, 0, len(chunks)+len(deleted))
+	for _, chunk := range chunks {
+		chunkIDs = append(chunkIDs, chunk.ID)
+	}
+	for _, v := range deleted {
+		chunkIDs = append(chunkIDs, v.ChunkID)
+	}
+
+	lessons, err := findOutdatedLessons(ctx, s.componentRepo, s.lessonRepo, s.versionRepo, chunkIDs)
+	if err != nil {
+		log.Error("failed to find outdated lessons", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return lessons, nil
+}
+
 // EnhanceSubmissionContentRequest contains the parameters for AI enhancement.
 type EnhanceSubmissionContentRequest struct {
 	SubmissionID uuid.UUID
@@ -1056,3 +1458,16 @@ func (s *SMEService) EnhanceSubmissionContent(ctx context.Context, kratosID uuid
 		OriginalContent: originalContent,
 	}, nil
 }
+
+// urlDisplayName returns a URL's host and path, shortened to fit the
+// submission file name column.
+func urlDisplayName(rawURL string) string {
+	name := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		name = strings.TrimSuffix(u.Host+u.Path, "/")
+	}
+	if len(name) > 255 {
+		name = name[:252] + "..."
+	}
+	return name
+}
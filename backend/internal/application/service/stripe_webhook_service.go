@@ -0,0 +1,337 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// webhookEventStaleAfter is how long a delivery may stay processing before a
+// redelivery takes it over, e.g. after the server stopped mid-event.
+const webhookEventStaleAfter = 5 * time.Minute
+
+// StripeWebhookService applies Stripe webhook events exactly once. Every
+// event is stored with its processing status: redeliveries of an applied
+// event are skipped, failed events are applied again when Stripe redelivers
+// them or an administrator replays them, and subscription events older than
+// the last one applied to the company are skipped.
+type StripeWebhookService struct {
+	billingService *BillingService
+	dunningService *DunningService
+	eventRepo      repository.StripeWebhookEventRepository
+	companyRepo    repository.CompanyRepository
+	pendingRegRepo repository.PendingRegistrationRepository
+	payments       service.PaymentProvider
+	enqueuer       ProvisionTaskEnqueuer
+	email          service.EmailProvider // Optional; refunds are only logged without it
+	logger         service.Logger
+}
+
+// NewStripeWebhookService creates a new Stripe webhook service.
+func NewStripeWebhookService(
+	billingService *BillingService,
+	dunningService *DunningService,
+	eventRepo repository.StripeWebhookEventRepository,
+	companyRepo repository.CompanyRepository,
+	pendingRegRepo repository.PendingRegistrationRepository,
+	payments service.PaymentProvider,
+	enqueuer ProvisionTaskEnqueuer,
+	email service.EmailProvider,
+	logger service.Logger,
+) *StripeWebhookService {
+	return &StripeWebhookService{
+		billingService: billingService,
+		dunningService: dunningService,
+		eventRepo:      eventRepo,
+		companyRepo:    companyRepo,
+		pendingRegRepo: pendingRegRepo,
+		payments:       payments,
+		enqueuer:       enqueuer,
+		email:          email,
+		logger:         logger,
+	}
+}
+
+// HandleEvent stores and applies a verified webhook event. Events already
+// applied or being applied by another delivery are skipped. An error means
+// the event failed and Stripe should deliver it again.
+func (s *StripeWebhookService) HandleEvent(ctx context.Context, event *service.WebhookEvent) error {
+	// Webhooks have no user session; events touch pending registrations and any tenant
+	ctx = tenant.WithSuperAdmin(ctx, true)
+	log := s.logger.With("eventID", event.ID, "type", event.Type)
+
+	stored := &entity.StripeWebhookEvent{
+		ID:              event.ID,
+		Type:            event.Type,
+		Payload:         event.Payload,
+		StripeCreatedAt: event.Created,
+	}
+	claimed, err := s.eventRepo.Claim(ctx, stored, webhookEventStaleAfter)
+	if err != nil {
+		log.Error("failed to store webhook event", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if !claimed {
+		log.Info("webhook event already handled or in progress, skipping (idempotent)")
+		return nil
+	}
+
+	return s.process(ctx, stored, event)
+}
+
+// ReplayEvent applies a stored event again. An event another delivery is
+// applying is refused, and so is a processed event unless force is set:
+// handlers are not all idempotent, e.g. a refund alert would be sent twice.
+// Subscription events superseded by a newer one are still skipped.
+func (s *StripeWebhookService) ReplayEvent(ctx context.Context, id string, force bool) (*entity.StripeWebhookEvent, error) {
+	ctx = tenant.WithSuperAdmin(ctx, true)
+
+	stored, err := s.eventRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if stored == nil {
+		return nil, domainerrors.ErrNotFound.WithMessage("webhook event not found")
+	}
+	if stored.Status == valueobject.WebhookEventStatusProcessed && !force {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("webhook event was already processed; replay it with force to apply it again")
+	}
+
+	event, err := s.payments.ParseWebhookEvent(stored.Payload)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	// The status may have changed since it was read; the claim decides
+	claimed, err := s.eventRepo.ClaimReplay(ctx, id, force, webhookEventStaleAfter)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if claimed == nil {
+		return nil, domainerrors.ErrInvalidInput.WithMessage("webhook event is being processed")
+	}
+
+	s.logger.Info("replaying webhook event", "eventID", claimed.ID, "type", claimed.Type, "attempts", claimed.Attempts, "force", force)
+
+	// The outcome, failed or not, is recorded on the returned event
+	_ = s.process(ctx, claimed, event)
+	return claimed, nil
+}
+
+// ListEvents lists stored webhook events, most recently received first.
+func (s *StripeWebhookService) ListEvents(ctx context.Context, opts entity.StripeWebhookEventListOptions) ([]*entity.StripeWebhookEvent, error) {
+	events, err := s.eventRepo.List(tenant.WithSuperAdmin(ctx, true), opts)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	return events, nil
+}
+
+// process applies a claimed event and records the outcome.
+func (s *StripeWebhookService) process(ctx context.Context, stored *entity.StripeWebhookEvent, event *service.WebhookEvent) error {
+	log := s.logger.With("eventID", event.ID, "type", event.Type, "attempts", stored.Attempts)
+
+	ignoreReason, err := s.apply(ctx, event)
+	switch {
+	case err != nil:
+		log.Error("failed to apply webhook event", "error", err)
+		stored.MarkFailed(err.Error())
+	case ignoreReason != "":
+		log.Info("webhook event ignored", "reason", ignoreReason)
+		stored.MarkIgnored(ignoreReason)
+	default:
+		stored.MarkProcessed()
+	}
+
+	if updateErr := s.eventRepo.Update(ctx, stored); updateErr != nil {
+		log.Error("failed to record webhook event status", "status", stored.Status, "error", updateErr)
+		if err == nil {
+			err = domainerrors.ErrInternal.WithCause(updateErr)
+		}
+	}
+	return err
+}
+
+// apply dispatches an event to its handler. It returns why the event was
+// ignored when there was nothing to apply.
+func (s *StripeWebhookService) apply(ctx context.Context, event *service.WebhookEvent) (string, error) {
+	data := event.Data
+
+	switch event.Type {
+	case "checkout.session.completed":
+		cs := data.CheckoutSession
+		// No company in the metadata means the pending registration flow
+		if cs.CompanyID == uuid.Nil {
+			return s.handlePendingRegistrationPayment(ctx, cs)
+		}
+		// Existing company flow (e.g., onboarding or plan upgrade)
+		return ignoreUnknownCustomer(s.billingService.HandleCheckoutCompleted(ctx, cs.CompanyID.String(), cs.Plan.String(), cs.CustomerID, cs.SubscriptionID))
+
+	case "customer.subscription.created", "customer.subscription.updated", "customer.subscription.deleted":
+		return s.handleSubscriptionEvent(ctx, event)
+
+	case "invoice.payment_failed", "invoice.payment_action_required":
+		inv := data.Invoice
+		// Invoices without a subscription (one-off charges) aren't dunned
+		if inv.SubscriptionID == "" {
+			return "invoice has no subscription", nil
+		}
+		return ignoreUnknownCustomer(s.dunningService.HandlePaymentFailed(ctx, inv.CustomerID, inv.HostedInvoiceURL))
+
+	case "invoice.paid", "invoice.payment_succeeded":
+		inv := data.Invoice
+		if inv.SubscriptionID == "" {
+			return "invoice has no subscription", nil
+		}
+		return ignoreUnknownCustomer(s.dunningService.HandleInvoicePaid(ctx, inv.CustomerID))
+
+	case "customer.updated":
+		return ignoreUnknownCustomer(s.billingService.HandleCustomerUpdated(ctx, data.Customer))
+
+	case "charge.refunded":
+		return s.handleChargeRefunded(ctx, data.Charge)
+	}
+
+	if data.Invoice != nil {
+		// Drafts, finalization and upcoming invoices need no action
+		return "no action for invoice event", nil
+	}
+	return "unhandled event type", nil
+}
+
+// handleSubscriptionEvent applies a subscription event unless a newer
+// subscription event was already applied to the company. The subscription
+// in the event is used as is: ordering makes it the latest known state.
+func (s *StripeWebhookService) handleSubscriptionEvent(ctx context.Context, event *service.WebhookEvent) (string, error) {
+	sub := event.Data.Subscription
+
+	company, err := s.companyRepo.GetByStripeCustomerID(ctx, sub.CustomerID)
+	if err != nil {
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return "no company for customer", nil
+	}
+
+	latest, err := s.companyRepo.ClaimSubscriptionEvent(ctx, company.ID, event.Created)
+	if err != nil {
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if !latest {
+		return "superseded by a newer subscription event", nil
+	}
+
+	if event.Type == "customer.subscription.deleted" {
+		return "", s.billingService.HandleSubscriptionDeleted(ctx, sub.CustomerID)
+	}
+	return "", s.billingService.HandleSubscriptionUpdated(ctx, sub.CustomerID, sub)
+}
+
+// handlePendingRegistrationPayment marks a pending registration as paid after successful checkout
+// and enqueues a provisioning task for background processing.
+func (s *StripeWebhookService) handlePendingRegistrationPayment(ctx context.Context, cs *service.CheckoutSession) (string, error) {
+	log := s.logger.With("checkoutSessionID", cs.ID)
+
+	// Look up pending registration by checkout session ID
+	pending, err := s.pendingRegRepo.GetByCheckoutSessionID(ctx, cs.ID)
+	if err != nil {
+		log.Error("failed to get pending registration", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if pending == nil {
+		log.Warn("no pending registration found for checkout session")
+		return "no pending registration for checkout session", nil
+	}
+
+	log = log.With("email", pending.Email, "company", pending.CompanyName, "status", pending.Status)
+
+	// A registration past "pending" was marked paid by an earlier event or the checkout redirect
+	if pending.Status != valueobject.PendingRegistrationStatusPending {
+		log.Info("registration already processed, skipping (idempotent)")
+		return "registration already processed", nil
+	}
+
+	// Get seat count from subscription (if available)
+	seatCount := 0
+	if cs.SubscriptionID != "" {
+		sub, err := s.payments.GetSubscription(ctx, cs.SubscriptionID)
+		if err == nil && sub != nil && sub.SeatCount > 0 {
+			seatCount = sub.SeatCount
+			log.Info("captured seat count from subscription", "seatCount", seatCount)
+		}
+	}
+
+	// Mark as paid with Stripe details
+	pending.MarkAsPaid(cs.CustomerID, cs.SubscriptionID, seatCount)
+	if err := s.pendingRegRepo.Update(ctx, pending); err != nil {
+		log.Error("failed to mark pending registration as paid", "error", err)
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+
+	log.Info("pending registration marked as paid", "seatCount", pending.SeatCount)
+
+	// Enqueue provisioning task for background processing
+	if err := s.enqueuer.EnqueueStripeProvision(cs.ID, cs.CustomerID, cs.SubscriptionID); err != nil {
+		// Don't fail the event - the registration is marked as paid and the
+		// stuck provisioning check picks it up
+		log.Error("failed to enqueue provisioning task", "error", err)
+	}
+	return "", nil
+}
+
+// handleChargeRefunded alerts platform administrators about a refund so they
+// can decide whether the company keeps its access.
+func (s *StripeWebhookService) handleChargeRefunded(ctx context.Context, charge *service.Charge) (string, error) {
+	company, err := s.companyRepo.GetByStripeCustomerID(ctx, charge.CustomerID)
+	if err != nil {
+		return "", domainerrors.ErrInternal.WithCause(err)
+	}
+	if company == nil {
+		return "no company for customer", nil
+	}
+
+	refund := "Partial refund"
+	if charge.Refunded {
+		refund = "Full refund"
+	}
+	amount := fmt.Sprintf("%.2f of %.2f %s", float64(charge.AmountRefunded)/100, float64(charge.Amount)/100, charge.Currency)
+
+	s.logger.Warn("charge refunded", "companyID", company.ID, "chargeID", charge.ID, "invoiceID", charge.InvoiceID, "amount", amount)
+
+	if s.email == nil {
+		return "", nil
+	}
+	err = s.email.SendAlert(ctx, service.SendAlertRequest{
+		Subject: "[INFO] Mirai: " + refund + " issued to " + company.Name,
+		Body: refund + " of a Stripe charge:\n\n" +
+			"  Company: " + company.Name + " (" + company.ID.String() + ")\n" +
+			"  Customer: " + charge.CustomerID + "\n" +
+			"  Charge: " + charge.ID + "\n" +
+			"  Invoice: " + charge.InvoiceID + "\n" +
+			"  Refunded: " + amount + "\n\n" +
+			"The subscription and access are unchanged. Cancel the subscription in Stripe if access should end.",
+	})
+	if err != nil {
+		return "", domainerrors.ErrExternalService.WithCause(err)
+	}
+	return "", nil
+}
+
+// ignoreUnknownCustomer turns a missing company into an ignored event: the
+// Stripe account may hold customers that aren't ours, and retrying won't help.
+func ignoreUnknownCustomer(err error) (string, error) {
+	if errors.Is(err, domainerrors.ErrCompanyNotFound) {
+		return "no company for customer", nil
+	}
+	return "", err
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// replayEventRepo stores one event. read is the status GetByID reports,
+// which may be stale by the time ClaimReplay runs against stored.
+type replayEventRepo struct {
+	repository.StripeWebhookEventRepository
+	read    valueobject.WebhookEventStatus
+	stored  entity.StripeWebhookEvent
+	updates []valueobject.WebhookEventStatus
+}
+
+func (r *replayEventRepo) GetByID(_ context.Context, id string) (*entity.StripeWebhookEvent, error) {
+	if id != r.stored.ID {
+		return nil, nil
+	}
+	event := r.stored
+	event.Status = r.read
+	return &event, nil
+}
+
+func (r *replayEventRepo) ClaimReplay(_ context.Context, id string, includeProcessed bool, staleAfter time.Duration) (*entity.StripeWebhookEvent, error) {
+	switch {
+	case id != r.stored.ID:
+		return nil, nil
+	case r.stored.Status == valueobject.WebhookEventStatusProcessing && time.Since(r.stored.UpdatedAt) < staleAfter:
+		return nil, nil
+	case r.stored.Status == valueobject.WebhookEventStatusProcessed && !includeProcessed:
+		return nil, nil
+	}
+	r.stored.Status = valueobject.WebhookEventStatusProcessing
+	r.stored.Attempts++
+	r.stored.UpdatedAt = time.Now()
+	event := r.stored
+	return &event, nil
+}
+
+func (r *replayEventRepo) Update(_ context.Context, event *entity.StripeWebhookEvent) error {
+	r.updates = append(r.updates, event.Status)
+	r.stored = *event
+	return nil
+}
+
+// invoicePayments parses every stored event as an invoice event that needs no action.
+type invoicePayments struct {
+	service.PaymentProvider
+}
+
+func (invoicePayments) ParseWebhookEvent([]byte) (*service.WebhookEvent, error) {
+	return &service.WebhookEvent{
+		ID:   "evt_1",
+		Type: "invoice.finalized",
+		Data: service.WebhookEventData{Invoice: &service.Invoice{ID: "in_1"}},
+	}, nil
+}
+
+func TestReplayEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		read    valueobject.WebhookEventStatus
+		stored  valueobject.WebhookEventStatus
+		age     time.Duration // Since the stored event was last updated
+		force   bool
+		wantErr *domainerrors.DomainError
+		wantMsg string
+	}{
+		{
+			name:   "failed",
+			read:   valueobject.WebhookEventStatusFailed,
+			stored: valueobject.WebhookEventStatusFailed,
+		},
+		{
+			name:   "ignored",
+			read:   valueobject.WebhookEventStatusIgnored,
+			stored: valueobject.WebhookEventStatusIgnored,
+		},
+		{
+			name:    "processed without force",
+			read:    valueobject.WebhookEventStatusProcessed,
+			stored:  valueobject.WebhookEventStatusProcessed,
+			wantErr: domainerrors.ErrInvalidInput,
+			wantMsg: "webhook event was already processed; replay it with force to apply it again",
+		},
+		{
+			name:   "processed with force",
+			read:   valueobject.WebhookEventStatusProcessed,
+			stored: valueobject.WebhookEventStatusProcessed,
+			force:  true,
+		},
+		{
+			name:    "being processed",
+			read:    valueobject.WebhookEventStatusProcessing,
+			stored:  valueobject.WebhookEventStatusProcessing,
+			age:     time.Minute,
+			force:   true,
+			wantErr: domainerrors.ErrInvalidInput,
+			wantMsg: "webhook event is being processed",
+		},
+		{
+			name:   "stalled",
+			read:   valueobject.WebhookEventStatusProcessing,
+			stored: valueobject.WebhookEventStatusProcessing,
+			age:    time.Hour,
+		},
+		{
+			// A redelivery or another replay claimed it after it was read
+			name:    "claimed since read",
+			read:    valueobject.WebhookEventStatusFailed,
+			stored:  valueobject.WebhookEventStatusProcessing,
+			age:     time.Second,
+			wantErr: domainerrors.ErrInvalidInput,
+			wantMsg: "webhook event is being processed",
+		},
+		{
+			name:    "processed since read",
+			read:    valueobject.WebhookEventStatusFailed,
+			stored:  valueobject.WebhookEventStatusProcessed,
+			wantErr: domainerrors.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &replayEventRepo{
+				read: tt.read,
+				stored: entity.StripeWebhookEvent{
+					ID:        "evt_1",
+					Type:      "invoice.finalized",
+					Status:    tt.stored,
+					Attempts:  1,
+					UpdatedAt: time.Now().Add(-tt.age),
+				},
+			}
+			svc := NewStripeWebhookService(nil, nil, repo, nil, nil, invoicePayments{}, nil, nil, nopLogger{})
+
+			event, err := svc.ReplayEvent(context.Background(), "evt_1", tt.force)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ReplayEvent() error = %v, want %v", err, tt.wantErr)
+				}
+				if tt.wantMsg != "" && domainerrors.GetDomainError(err).Message != tt.wantMsg {
+					t.Errorf("ReplayEvent() message = %q, want %q", domainerrors.GetDomainError(err).Message, tt.wantMsg)
+				}
+				if len(repo.updates) != 0 {
+					t.Errorf("refused replay recorded statuses %v", repo.updates)
+				}
+				if repo.stored.Status != tt.stored {
+					t.Errorf("stored status = %s, want it left at %s", repo.stored.Status, tt.stored)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReplayEvent() error = %v", err)
+			}
+			if event.Status != valueobject.WebhookEventStatusIgnored || event.Attempts != 2 {
+				t.Errorf("replayed event is %s after %d attempts, want ignored after 2", event.Status, event.Attempts)
+			}
+			if repo.stored.Status != valueobject.WebhookEventStatusIgnored {
+				t.Errorf("stored status = %s, want ignored", repo.stored.Status)
+			}
+		})
+	}
+}
+
+func TestReplayEventNotFound(t *testing.T) {
+	repo := &replayEventRepo{stored: entity.StripeWebhookEvent{ID: "evt_1"}}
+	svc := NewStripeWebhookService(nil, nil, repo, nil, nil, invoicePayments{}, nil, nil, nopLogger{})
+
+	if _, err := svc.ReplayEvent(context.Background(), "evt_2", true); !errors.Is(err, domainerrors.ErrNotFound) {
+		t.Errorf("ReplayEvent() error = %v, want ErrNotFound", err)
+	}
+}
@@ -2,6 +2,10 @@ package service
 
 import (
 	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -12,10 +16,19 @@ import (
 	"github.com/sogos/mirai-backend/internal/infrastructure/crypto"
 )
 
-// TenantSettingsService handles tenant AI settings management.
+// APIKeyTester validates AI provider credentials by making a minimal request.
+type APIKeyTester interface {
+	TestAPIKey(ctx context.Context, cfg service.AIProviderConfig) error
+}
+
+// TenantSettingsService handles tenant AI and LRS settings management.
 type TenantSettingsService struct {
 	userRepo     repository.UserRepository
 	settingsRepo repository.TenantAISettingsRepository
+	tokenBudget  *TokenBudget
+	lrsRepo      repository.TenantLRSSettingsRepository
+	lrsClient    service.LRSClient
+	keyTester    APIKeyTester
 	encryptor    *crypto.Encryptor
 	logger       service.Logger
 }
@@ -24,12 +37,20 @@ type TenantSettingsService struct {
 func NewTenantSettingsService(
 	userRepo repository.UserRepository,
 	settingsRepo repository.TenantAISettingsRepository,
+	tokenBudget *TokenBudget,
+	lrsRepo repository.TenantLRSSettingsRepository,
+	lrsClient service.LRSClient,
+	keyTester APIKeyTester,
 	encryptor *crypto.Encryptor,
 	logger service.Logger,
 ) *TenantSettingsService {
 	return &TenantSettingsService{
 		userRepo:     userRepo,
 		settingsRepo: settingsRepo,
+		tokenBudget:  tokenBudget,
+		lrsRepo:      lrsRepo,
+		lrsClient:    lrsClient,
+		keyTester:    keyTester,
 		encryptor:    encryptor,
 		logger:       logger,
 	}
@@ -73,9 +94,17 @@ func (s *TenantSettingsService) GetAISettings(ctx context.Context, kratosID uuid
 	return &GetAISettingsResult{Settings: settings}, nil
 }
 
-// SetAPIKey encrypts and stores the API key.
-func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUID, provider valueobject.AIProvider, apiKey string) error {
-	log := s.logger.With("kratosID", kratosID, "provider", provider.String())
+// SetAPIKeyRequest contains the provider configuration to store.
+type SetAPIKeyRequest struct {
+	Provider valueobject.AIProvider
+	APIKey   string // Optional for self-hosted providers
+	BaseURL  string // Required for self-hosted providers
+	Model    string // Required for self-hosted providers
+}
+
+// SetAPIKey validates the provider configuration, then encrypts and stores the API key.
+func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUID, req SetAPIKeyRequest) error {
+	log := s.logger.With("kratosID", kratosID, "provider", req.Provider.String())
 
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
@@ -91,13 +120,34 @@ func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUI
 		return domainerrors.ErrUserHasNoCompany
 	}
 
-	// Encrypt the API key
-	encryptedKey, err := s.encryptor.EncryptString(apiKey)
+	cfg, err := normalizeAIProviderConfig(service.AIProviderConfig{
+		Provider: req.Provider,
+		APIKey:   req.APIKey,
+		BaseURL:  req.BaseURL,
+		Model:    req.Model,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Validate the configuration against the chosen provider before storing it
+	if err := s.keyTester.TestAPIKey(ctx, cfg); err != nil {
+		log.Warn("API key validation failed", "error", err)
+		return domainerrors.ErrAIKeyInvalid.WithCause(err)
+	}
+
+	// Encrypt the API key (self-hosted servers without authentication store an empty key)
+	encryptedKey, err := s.encryptor.EncryptString(cfg.APIKey)
 	if err != nil {
 		log.Error("failed to encrypt API key", "error", err)
 		return domainerrors.ErrInternal.WithCause(err)
 	}
 
+	var baseURL, model *string
+	if cfg.Provider.IsSelfHosted() {
+		baseURL, model = &cfg.BaseURL, &cfg.Model
+	}
+
 	// Get existing settings
 	settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
 	if err != nil {
@@ -109,8 +159,10 @@ func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUI
 		// Create new settings
 		settings = &entity.TenantAISettings{
 			TenantID:        *user.TenantID,
-			Provider:        provider,
+			Provider:        cfg.Provider,
 			EncryptedAPIKey: encryptedKey,
+			BaseURL:         baseURL,
+			Model:           model,
 			UpdatedByUserID: &user.ID,
 		}
 		if err := s.settingsRepo.Create(ctx, settings); err != nil {
@@ -119,8 +171,10 @@ func (s *TenantSettingsService) SetAPIKey(ctx context.Context, kratosID uuid.UUI
 		}
 	} else {
 		// Update existing settings
-		settings.Provider = provider
+		settings.Provider = cfg.Provider
 		settings.EncryptedAPIKey = encryptedKey
+		settings.BaseURL = baseURL
+		settings.Model = model
 		settings.UpdatedByUserID = &user.ID
 
 		if err := s.settingsRepo.Update(ctx, settings); err != nil {
@@ -180,8 +234,17 @@ type TestAPIKeyResult struct {
 	Message string
 }
 
-// TestAPIKey tests if the provided or stored API key is valid.
-func (s *TenantSettingsService) TestAPIKey(ctx context.Context, kratosID uuid.UUID, apiKey *string) (*TestAPIKeyResult, error) {
+// TestAPIKeyRequest contains the provider configuration to test.
+// When neither APIKey nor BaseURL is set, the stored configuration is tested.
+type TestAPIKeyRequest struct {
+	Provider valueobject.AIProvider
+	APIKey   string
+	BaseURL  string
+	Model    string
+}
+
+// TestAPIKey tests if the provided or stored provider configuration is valid.
+func (s *TenantSettingsService) TestAPIKey(ctx context.Context, kratosID uuid.UUID, req TestAPIKeyRequest) (*TestAPIKeyResult, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
 		return nil, domainerrors.ErrUserNotFound
@@ -195,28 +258,39 @@ func (s *TenantSettingsService) TestAPIKey(ctx context.Context, kratosID uuid.UU
 		return nil, domainerrors.ErrUserHasNoCompany
 	}
 
-	var keyToTest string
-	if apiKey != nil && *apiKey != "" {
-		keyToTest = *apiKey
-	} else {
-		// Use stored key
-		settings, err := s.settingsRepo.Get(ctx, *user.TenantID)
-		if err != nil {
-			return nil, domainerrors.ErrInternal.WithCause(err)
+	var cfg *service.AIProviderConfig
+	if req.APIKey != "" || req.BaseURL != "" {
+		cfg = &service.AIProviderConfig{
+			Provider: req.Provider,
+			APIKey:   req.APIKey,
+			BaseURL:  req.BaseURL,
+			Model:    req.Model,
 		}
-		if settings == nil || settings.EncryptedAPIKey == nil {
+	} else {
+		// Use stored configuration
+		cfg, err = s.GetAIProviderConfig(ctx, *user.TenantID)
+		if errors.Is(err, domainerrors.ErrAIKeyNotConfigured) {
 			return &TestAPIKeyResult{Valid: false, Message: "No API key configured"}, nil
 		}
-		keyToTest, err = s.encryptor.DecryptString(settings.EncryptedAPIKey)
 		if err != nil {
-			return nil, domainerrors.ErrInternal.WithCause(err)
+			return nil, err
 		}
 	}
 
-	// TODO: Actually test the key against Gemini API
-	// For now, just validate basic format
-	if len(keyToTest) < 20 {
-		return &TestAPIKeyResult{Valid: false, Message: "API key appears to be invalid"}, nil
+	normalized, err := normalizeAIProviderConfig(*cfg)
+	if err != nil {
+		return &TestAPIKeyResult{Valid: false, Message: domainerrors.GetDomainError(err).Message}, nil
+	}
+
+	// Self-hosted base URLs are tenant-supplied, so failure details stay in
+	// the log rather than telling the caller what answers at that address
+	if err := s.keyTester.TestAPIKey(ctx, normalized); err != nil {
+		s.logger.Info("API key test failed", "tenantID", user.TenantID, "provider", normalized.Provider.String(), "error", err)
+		message := "The provider did not accept this API key"
+		if normalized.Provider.IsSelfHosted() {
+			message = "Could not get a response from the server; check the base URL, model and API key"
+		}
+		return &TestAPIKeyResult{Valid: false, Message: message}, nil
 	}
 
 	return &TestAPIKeyResult{Valid: true, Message: "API key is valid"}, nil
@@ -227,9 +301,11 @@ type GetUsageStatsResult struct {
 	TotalTokensUsed   int64
 	MonthlyTokenLimit *int64
 	Provider          valueobject.AIProvider
+	Month             *MonthlyTokenUsage
 }
 
-// GetUsageStats retrieves AI usage statistics.
+// GetUsageStats retrieves AI usage statistics, including the current month's
+// daily breakdown and projected month-end usage.
 func (s *TenantSettingsService) GetUsageStats(ctx context.Context, kratosID uuid.UUID) (*GetUsageStatsResult, error) {
 	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
 	if err != nil || user == nil {
@@ -249,12 +325,18 @@ func (s *TenantSettingsService) GetUsageStats(ctx context.Context, kratosID uuid
 		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
+	month, err := s.tokenBudget.GetMonthlyUsage(ctx, *user.TenantID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
 	// Return default stats if no settings exist yet
 	if settings == nil {
 		return &GetUsageStatsResult{
 			TotalTokensUsed:   0,
 			MonthlyTokenLimit: nil,
 			Provider:          valueobject.AIProviderGemini,
+			Month:             month,
 		}, nil
 	}
 
@@ -262,24 +344,278 @@ func (s *TenantSettingsService) GetUsageStats(ctx context.Context, kratosID uuid
 		TotalTokensUsed:   settings.TotalTokensUsed,
 		MonthlyTokenLimit: settings.MonthlyTokenLimit,
 		Provider:          settings.Provider,
+		Month:             month,
 	}, nil
 }
 
-// GetDecryptedAPIKey retrieves and decrypts the API key for internal use.
-func (s *TenantSettingsService) GetDecryptedAPIKey(ctx context.Context, tenantID uuid.UUID) (string, error) {
+// GetAIProviderConfig retrieves the tenant's provider and decrypted API key for internal use.
+func (s *TenantSettingsService) GetAIProviderConfig(ctx context.Context, tenantID uuid.UUID) (*service.AIProviderConfig, error) {
 	settings, err := s.settingsRepo.Get(ctx, tenantID)
 	if err != nil {
-		return "", domainerrors.ErrInternal.WithCause(err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
 	}
 
 	if settings == nil || settings.EncryptedAPIKey == nil {
-		return "", domainerrors.ErrAIKeyNotConfigured
+		return nil, domainerrors.ErrAIKeyNotConfigured
 	}
 
 	key, err := s.encryptor.DecryptString(settings.EncryptedAPIKey)
 	if err != nil {
-		return "", domainerrors.ErrInternal.WithCause(err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	cfg := &service.AIProviderConfig{
+		Provider: settings.Provider,
+		APIKey:   key,
+	}
+	if settings.BaseURL != nil {
+		cfg.BaseURL = *settings.BaseURL
+	}
+	if settings.Model != nil {
+		cfg.Model = *settings.Model
+	}
+	return cfg, nil
+}
+
+// GetLRSSettings retrieves the LRS settings for the current user's tenant.
+// Returns an unconfigured settings value if none exist yet.
+func (s *TenantSettingsService) GetLRSSettings(ctx context.Context, kratosID uuid.UUID) (*entity.TenantLRSSettings, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins and owners can view LRS settings")
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	settings, err := s.lrsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		s.logger.Error("failed to get LRS settings", "tenantID", user.TenantID, "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if settings == nil {
+		settings = &entity.TenantLRSSettings{TenantID: *user.TenantID}
+	}
+
+	return settings, nil
+}
+
+// SetLRSSettings stores the LRS endpoint and credentials.
+// An empty secret keeps the previously stored secret.
+func (s *TenantSettingsService) SetLRSSettings(ctx context.Context, kratosID uuid.UUID, endpoint, key, secret string) (*entity.TenantLRSSettings, error) {
+	log := s.logger.With("kratosID", kratosID)
+
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins and owners can configure the LRS")
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	endpoint, err = normalizeLRSEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	key = strings.TrimSpace(key)
+	if key == "" {
+		return nil, domainerrors.ErrLRSCredentialsRequired
 	}
 
-	return key, nil
+	settings, err := s.lrsRepo.Get(ctx, *user.TenantID)
+	if err != nil {
+		log.Error("failed to get LRS settings", "error", err)
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var encryptedSecret []byte
+	if secret != "" {
+		encryptedSecret, err = s.encryptor.EncryptString(secret)
+		if err != nil {
+			log.Error("failed to encrypt LRS secret", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	} else if settings != nil {
+		encryptedSecret = settings.EncryptedSecret
+	}
+	if len(encryptedSecret) == 0 {
+		return nil, domainerrors.ErrLRSCredentialsRequired
+	}
+
+	if settings == nil {
+		settings = &entity.TenantLRSSettings{
+			TenantID:        *user.TenantID,
+			Endpoint:        endpoint,
+			Key:             key,
+			EncryptedSecret: encryptedSecret,
+			UpdatedByUserID: &user.ID,
+		}
+		if err := s.lrsRepo.Create(ctx, settings); err != nil {
+			log.Error("failed to create LRS settings", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	} else {
+		settings.Endpoint = endpoint
+		settings.Key = key
+		settings.EncryptedSecret = encryptedSecret
+		settings.UpdatedByUserID = &user.ID
+
+		if err := s.lrsRepo.Update(ctx, settings); err != nil {
+			log.Error("failed to update LRS settings", "error", err)
+			return nil, domainerrors.ErrInternal.WithCause(err)
+		}
+	}
+
+	log.Info("LRS settings configured successfully", "endpoint", endpoint)
+	return settings, nil
+}
+
+// RemoveLRSSettings removes the tenant's LRS configuration.
+func (s *TenantSettingsService) RemoveLRSSettings(ctx context.Context, kratosID uuid.UUID) error {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return domainerrors.ErrForbidden.WithMessage("only admins and owners can remove the LRS")
+	}
+
+	if user.TenantID == nil {
+		return domainerrors.ErrUserHasNoCompany
+	}
+
+	if err := s.lrsRepo.Delete(ctx, *user.TenantID); err != nil {
+		s.logger.Error("failed to delete LRS settings", "tenantID", user.TenantID, "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	s.logger.Info("LRS settings removed", "tenantID", user.TenantID)
+	return nil
+}
+
+// TestLRSConnectionResult contains the LRS connection test result.
+type TestLRSConnectionResult struct {
+	Valid   bool
+	Message string
+}
+
+// TestLRSConnection tests the provided LRS settings, or the stored ones if cfg is nil.
+func (s *TenantSettingsService) TestLRSConnection(ctx context.Context, kratosID uuid.UUID, cfg *service.LRSConfig) (*TestLRSConnectionResult, error) {
+	user, err := s.userRepo.GetByKratosID(ctx, kratosID)
+	if err != nil || user == nil {
+		return nil, domainerrors.ErrUserNotFound
+	}
+
+	if !user.CanManageSettings() {
+		return nil, domainerrors.ErrForbidden.WithMessage("only admins and owners can test the LRS")
+	}
+
+	if user.TenantID == nil {
+		return nil, domainerrors.ErrUserHasNoCompany
+	}
+
+	if cfg == nil {
+		cfg, err = s.GetLRSConfig(ctx, *user.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		if cfg == nil {
+			return &TestLRSConnectionResult{Valid: false, Message: "No LRS configured"}, nil
+		}
+	} else {
+		endpoint, err := normalizeLRSEndpoint(cfg.Endpoint)
+		if err != nil {
+			return &TestLRSConnectionResult{Valid: false, Message: domainerrors.GetDomainError(err).Message}, nil
+		}
+		cfg.Endpoint = endpoint
+	}
+
+	// The endpoint is tenant-supplied, so connection details stay in the log
+	// rather than telling the caller what answers at that address
+	if err := s.lrsClient.TestConnection(ctx, *cfg); err != nil {
+		s.logger.Info("LRS connection test failed", "tenantID", user.TenantID, "error", err)
+		return &TestLRSConnectionResult{Valid: false, Message: "Could not connect to the LRS; check the endpoint, key and secret"}, nil
+	}
+
+	return &TestLRSConnectionResult{Valid: true, Message: "LRS connection succeeded"}, nil
+}
+
+// GetLRSConfig retrieves and decrypts the tenant's LRS configuration for internal use.
+// Returns (nil, nil) if no LRS is configured.
+func (s *TenantSettingsService) GetLRSConfig(ctx context.Context, tenantID uuid.UUID) (*service.LRSConfig, error) {
+	settings, err := s.lrsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	if settings == nil || !settings.HasSecret() {
+		return nil, nil
+	}
+
+	secret, err := s.encryptor.DecryptString(settings.EncryptedSecret)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	return &service.LRSConfig{
+		Endpoint: settings.Endpoint,
+		Key:      settings.Key,
+		Secret:   secret,
+	}, nil
+}
+
+// normalizeAIProviderConfig validates a provider configuration. Self-hosted
+// providers need an http(s) base URL and a model name; hosted providers need
+// an API key and ignore the base URL and model. Which addresses a base URL
+// may reach is enforced when connecting, as DNS can change after validation.
+func normalizeAIProviderConfig(cfg service.AIProviderConfig) (service.AIProviderConfig, error) {
+	if !cfg.Provider.IsValid() {
+		return cfg, domainerrors.ErrInvalidInput.WithMessage("unsupported AI provider")
+	}
+
+	cfg.APIKey = strings.TrimSpace(cfg.APIKey)
+	if !cfg.Provider.IsSelfHosted() {
+		if cfg.APIKey == "" {
+			return cfg, domainerrors.ErrMissingRequired.WithMessage("API key is required")
+		}
+		cfg.BaseURL, cfg.Model = "", ""
+		return cfg, nil
+	}
+
+	u, err := url.Parse(strings.TrimSpace(cfg.BaseURL))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return cfg, domainerrors.ErrAIBaseURLInvalid
+	}
+	u.Path = strings.TrimRight(u.Path, "/")
+	cfg.BaseURL = u.String()
+
+	cfg.Model = strings.TrimSpace(cfg.Model)
+	if cfg.Model == "" {
+		return cfg, domainerrors.ErrAIModelRequired
+	}
+	return cfg, nil
+}
+
+// normalizeLRSEndpoint validates an xAPI endpoint and ensures it ends with a slash.
+func normalizeLRSEndpoint(endpoint string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", domainerrors.ErrLRSEndpointInvalid
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return u.String(), nil
 }
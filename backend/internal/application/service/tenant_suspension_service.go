@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
+)
+
+// tenantStatusCacheTTL bounds how long a status change made outside this
+// service, such as a manual suspension in the database, takes to apply.
+const tenantStatusCacheTTL = time.Minute
+
+// TenantAccessChecker reports whether a tenant may still use the platform.
+type TenantAccessChecker interface {
+	// CheckTenantActive returns domainerrors.ErrTenantSuspended if the tenant is suspended.
+	CheckTenantActive(ctx context.Context, tenantID uuid.UUID) error
+}
+
+// tenantStatusEntry is the cached status of a tenant.
+type tenantStatusEntry struct {
+	Status     string `json:"status"`
+	Restricted bool   `json:"restricted"`
+}
+
+// TenantSuspensionService suspends and restricts tenants whose subscription
+// lapsed and answers whether a tenant is suspended for the API, workers,
+// storage and email. Suspensions and restrictions over billing are lifted once
+// the subscription is active again.
+type TenantSuspensionService struct {
+	tenantRepo repository.TenantRepository
+	cache      cache.Cache // Global cache; tenant status is looked up on every request
+	logger     service.Logger
+}
+
+// NewTenantSuspensionService creates a new tenant suspension service.
+func NewTenantSuspensionService(
+	tenantRepo repository.TenantRepository,
+	cache cache.Cache,
+	logger service.Logger,
+) *TenantSuspensionService {
+	return &TenantSuspensionService{
+		tenantRepo: tenantRepo,
+		cache:      cache,
+		logger:     logger,
+	}
+}
+
+// CheckTenantActive returns domainerrors.ErrTenantSuspended if the tenant is suspended.
+func (s *TenantSuspensionService) CheckTenantActive(ctx context.Context, tenantID uuid.UUID) error {
+	entry, err := s.status(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if entity.TenantStatus(entry.Status) == entity.TenantStatusSuspended {
+		return domainerrors.ErrTenantSuspended
+	}
+	return nil
+}
+
+// CheckTenantUnrestricted returns domainerrors.ErrTenantSuspended if the tenant
+// is suspended and domainerrors.ErrTenantRestricted if its costly features are paused.
+func (s *TenantSuspensionService) CheckTenantUnrestricted(ctx context.Context, tenantID uuid.UUID) error {
+	entry, err := s.status(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if entity.TenantStatus(entry.Status) == entity.TenantStatusSuspended {
+		return domainerrors.ErrTenantSuspended
+	}
+	if entry.Restricted {
+		return domainerrors.ErrTenantRestricted
+	}
+	return nil
+}
+
+// status returns the tenant's status, from the cache when possible.
+func (s *TenantSuspensionService) status(ctx context.Context, tenantID uuid.UUID) (*tenantStatusEntry, error) {
+	key := cache.GlobalCacheKeys.TenantStatus(tenantID.String())
+
+	var entry tenantStatusEntry
+	if cached, err := s.cache.Get(ctx, key, &entry); err == nil && cached != nil {
+		return &entry, nil
+	}
+
+	t, err := s.tenantRepo.GetByID(tenant.WithSuperAdmin(ctx, true), tenantID)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	if t == nil {
+		return &entry, nil // Nothing left to suspend
+	}
+
+	entry.Status = t.Status.String()
+	entry.Restricted = t.IsRestricted()
+	if _, err := s.cache.Set(ctx, key, &entry, "", tenantStatusCacheTTL); err != nil {
+		s.logger.Debug("failed to cache tenant status", "tenantID", tenantID, "error", err)
+	}
+	return &entry, nil
+}
+
+// HandleSubscriptionStatus suspends a tenant whose subscription was canceled
+// and lifts a billing suspension or restriction once the subscription is
+// active again. Past due subscriptions are left to the DunningService.
+func (s *TenantSuspensionService) HandleSubscriptionStatus(ctx context.Context, tenantID uuid.UUID, status valueobject.SubscriptionStatus) error {
+	switch status {
+	case valueobject.SubscriptionStatusCanceled:
+		return s.suspend(ctx, tenantID, entity.TenantSuspensionReasonSubscriptionCanceled)
+	case valueobject.SubscriptionStatusActive:
+		return s.liftBillingSuspension(ctx, tenantID)
+	}
+	return nil
+}
+
+// SuspendForNonPayment suspends a tenant whose overdue payment wasn't settled
+// by the end of dunning.
+func (s *TenantSuspensionService) SuspendForNonPayment(ctx context.Context, tenantID uuid.UUID) error {
+	return s.suspend(ctx, tenantID, entity.TenantSuspensionReasonPaymentPastDue)
+}
+
+// Restrict pauses a tenant's costly features while a payment is overdue.
+func (s *TenantSuspensionService) Restrict(ctx context.Context, tenantID uuid.UUID) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	t, err := s.tenantRepo.GetByID(adminCtx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if t == nil {
+		return domainerrors.ErrNotFound.WithMessage("tenant not found")
+	}
+	if t.IsRestricted() {
+		return nil
+	}
+
+	t.Restrict()
+	if err := s.tenantRepo.Update(adminCtx, t); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidate(ctx, tenantID)
+
+	s.logger.Warn("tenant restricted", "tenantID", tenantID)
+	return nil
+}
+
+// suspend suspends a tenant, keeping the reason of an existing suspension.
+func (s *TenantSuspensionService) suspend(ctx context.Context, tenantID uuid.UUID, reason entity.TenantSuspensionReason) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	t, err := s.tenantRepo.GetByID(adminCtx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if t == nil {
+		return domainerrors.ErrNotFound.WithMessage("tenant not found")
+	}
+	if t.IsSuspended() {
+		return nil
+	}
+
+	t.Suspend(reason)
+	if err := s.tenantRepo.Update(adminCtx, t); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidate(ctx, tenantID)
+
+	s.logger.Warn("tenant suspended", "tenantID", tenantID, "reason", reason)
+	return nil
+}
+
+// liftBillingSuspension reactivates a tenant suspended over its subscription
+// and restores its paused features. Manual suspensions are left in place.
+func (s *TenantSuspensionService) liftBillingSuspension(ctx context.Context, tenantID uuid.UUID) error {
+	adminCtx := tenant.WithSuperAdmin(ctx, true)
+
+	t, err := s.tenantRepo.GetByID(adminCtx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if t == nil || (!t.IsSuspendedForBilling() && !t.IsRestricted()) {
+		return nil
+	}
+
+	if t.IsSuspendedForBilling() {
+		t.Reactivate()
+	}
+	t.LiftRestriction()
+	if err := s.tenantRepo.Update(adminCtx, t); err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	s.invalidate(ctx, tenantID)
+
+	s.logger.Info("tenant reactivated", "tenantID", tenantID)
+	return nil
+}
+
+// invalidate drops the cached status so the change applies on the next check.
+func (s *TenantSuspensionService) invalidate(ctx context.Context, tenantID uuid.UUID) {
+	if err := s.cache.Delete(ctx, cache.GlobalCacheKeys.TenantStatus(tenantID.String())); err != nil {
+		s.logger.Warn("failed to invalidate tenant status", "tenantID", tenantID, "error", err)
+	}
+}
+
+// GuardEmail wraps an email provider so that emails sent on behalf of a
+// suspended tenant (the tenant in the context) are dropped. Administrative
+// alerts, payment reminders and emails sent without a tenant in the context
+// always go out.
+func (s *TenantSuspensionService) GuardEmail(inner service.EmailProvider) service.EmailProvider {
+	return &suspensionGuardedEmail{inner: inner, suspension: s}
+}
+
+// suspensionGuardedEmail drops tenant emails while the tenant is suspended.
+// Every method is listed, so a method added to service.EmailProvider must be
+// guarded or explicitly let through here before it compiles.
+type suspensionGuardedEmail struct {
+	inner      service.EmailProvider
+	suspension *TenantSuspensionService
+}
+
+// allowed reports whether email may be sent for the tenant in the context.
+// Status lookup failures let the email through.
+func (e *suspensionGuardedEmail) allowed(ctx context.Context, kind string) bool {
+	tenantID, ok := tenant.FromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	err := e.suspension.CheckTenantActive(ctx, tenantID)
+	if errors.Is(err, domainerrors.ErrTenantSuspended) {
+		e.suspension.logger.Info("tenant suspended, email not sent", "tenantID", tenantID, "email", kind)
+		return false
+	}
+	if err != nil {
+		e.suspension.logger.Warn("failed to check tenant status for email", "tenantID", tenantID, "error", err)
+	}
+	return true
+}
+
+func (e *suspensionGuardedEmail) SendInvitation(ctx context.Context, req service.SendInvitationRequest) error {
+	if !e.allowed(ctx, "invitation") {
+		return nil
+	}
+	return e.inner.SendInvitation(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendWelcome(ctx context.Context, req service.SendWelcomeRequest) error {
+	if !e.allowed(ctx, "welcome") {
+		return nil
+	}
+	return e.inner.SendWelcome(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendTaskAssignment(ctx context.Context, req service.SendTaskAssignmentRequest) error {
+	if !e.allowed(ctx, "task_assignment") {
+		return nil
+	}
+	return e.inner.SendTaskAssignment(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendIngestionComplete(ctx context.Context, req service.SendIngestionCompleteRequest) error {
+	if !e.allowed(ctx, "ingestion_complete") {
+		return nil
+	}
+	return e.inner.SendIngestionComplete(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendIngestionFailed(ctx context.Context, req service.SendIngestionFailedRequest) error {
+	if !e.allowed(ctx, "ingestion_failed") {
+		return nil
+	}
+	return e.inner.SendIngestionFailed(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendGenerationComplete(ctx context.Context, req service.SendGenerationCompleteRequest) error {
+	if !e.allowed(ctx, "generation_complete") {
+		return nil
+	}
+	return e.inner.SendGenerationComplete(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendGenerationFailed(ctx context.Context, req service.SendGenerationFailedRequest) error {
+	if !e.allowed(ctx, "generation_failed") {
+		return nil
+	}
+	return e.inner.SendGenerationFailed(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendOutlineReady(ctx context.Context, req service.SendOutlineReadyRequest) error {
+	if !e.allowed(ctx, "outline_ready") {
+		return nil
+	}
+	return e.inner.SendOutlineReady(ctx, req)
+}
+
+func (e *suspensionGuardedEmail) SendCourseComplete(ctx context.Context, req service.SendCourseCompleteRequest) error {
+	if !e.allowed(ctx, "course_complete") {
+		return nil
+	}
+	return e.inner.SendCourseComplete(ctx, req)
+}
+
+// SendPaymentReminder always goes out: it tells owners how to restore access.
+func (e *suspensionGuardedEmail) SendPaymentReminder(ctx context.Context, req service.SendPaymentReminderRequest) error {
+	return e.inner.SendPaymentReminder(ctx, req)
+}
+
+// SendAlert always goes out: administrative alerts are about the platform, not the tenant.
+func (e *suspensionGuardedEmail) SendAlert(ctx context.Context, req service.SendAlertRequest) error {
+	return e.inner.SendAlert(ctx, req)
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// estimateSampleSize is how many recent jobs of a type are averaged to
+// estimate what the next one will cost.
+const estimateSampleSize = 20
+
+// TokenAllowanceChecker checks usage against the AI tokens a tenant's plan
+// includes each month.
+type TokenAllowanceChecker interface {
+	CheckMonthlyTokens(ctx context.Context, tenantID uuid.UUID, used, needed int64) error
+}
+
+// TokenBudget enforces per-tenant monthly token limits and records AI usage
+// in the token ledger.
+type TokenBudget struct {
+	settingsRepo repository.TenantAISettingsRepository
+	usageRepo    repository.TokenUsageRepository
+	allowance    TokenAllowanceChecker
+	logger       service.Logger
+}
+
+// NewTokenBudget creates a new token budget.
+func NewTokenBudget(
+	settingsRepo repository.TenantAISettingsRepository,
+	usageRepo repository.TokenUsageRepository,
+	allowance TokenAllowanceChecker,
+	logger service.Logger,
+) *TokenBudget {
+	return &TokenBudget{
+		settingsRepo: settingsRepo,
+		usageRepo:    usageRepo,
+		allowance:    allowance,
+		logger:       logger,
+	}
+}
+
+// CheckBudget returns ErrTokenLimitExceeded when the tenant has used its
+// monthly limit, or when jobCount more jobs of jobType are expected to push
+// it over. The expected cost is the tenant's recent average for the job type.
+// Both the limit the tenant set itself and its plan's allowance apply.
+func (b *TokenBudget) CheckBudget(ctx context.Context, tenantID uuid.UUID, jobType valueobject.GenerationJobType, jobCount int) error {
+	settings, err := b.settingsRepo.Get(ctx, tenantID)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	used, err := b.usageRepo.GetMonthTotal(ctx, tenantID, entity.UsageMonthStart(time.Now()))
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var limit *int64
+	if settings != nil {
+		limit = settings.MonthlyTokenLimit
+	}
+	if limit != nil && used >= *limit {
+		return domainerrors.ErrTokenLimitExceeded.WithMessage(
+			fmt.Sprintf("monthly token limit of %d reached (%d used this month)", *limit, used))
+	}
+	if err := b.allowance.CheckMonthlyTokens(ctx, tenantID, used, 0); err != nil {
+		return err
+	}
+
+	avg, err := b.usageRepo.GetAverageJobTokens(ctx, tenantID, jobType, estimateSampleSize)
+	if err != nil {
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	estimate := avg * int64(jobCount)
+	if limit != nil && used+estimate > *limit {
+		return domainerrors.ErrTokenLimitExceeded.WithMessage(
+			fmt.Sprintf("monthly token limit of %d would be exceeded: %d used this month, about %d more needed", *limit, used, estimate))
+	}
+	return b.allowance.CheckMonthlyTokens(ctx, tenantID, used, estimate)
+}
+
+// RecordUsage adds a job's tokens to the tenant's lifetime counter and the
+// monthly ledger. Jobs that fail after the provider reported usage record it
+// too. Failures are logged, not returned, so a ledger problem never fails a
+// job whose content was already generated.
+func (b *TokenBudget) RecordUsage(ctx context.Context, job *entity.GenerationJob, tokens int64) {
+	b.record(ctx, &entity.TokenUsageEntry{
+		TenantID:   job.TenantID,
+		UsageMonth: entity.UsageMonthStart(time.Now()),
+		JobID:      &job.ID,
+		JobType:    job.Type,
+		UserID:     &job.CreatedByUserID,
+		CourseID:   job.CourseID,
+		TokensUsed: tokens,
+	})
+}
+
+// RecordTenantUsage records tokens spent outside a generation job, such as
+// embedding a knowledge chunk a user edited, under the given job type.
+func (b *TokenBudget) RecordTenantUsage(ctx context.Context, tenantID uuid.UUID, userID *uuid.UUID, jobType valueobject.GenerationJobType, tokens int64) {
+	if tokens <= 0 {
+		return
+	}
+	b.record(ctx, &entity.TokenUsageEntry{
+		TenantID:   tenantID,
+		UsageMonth: entity.UsageMonthStart(time.Now()),
+		JobType:    jobType,
+		UserID:     userID,
+		TokensUsed: tokens,
+	})
+}
+
+func (b *TokenBudget) record(ctx context.Context, entry *entity.TokenUsageEntry) {
+	log := b.logger.With("jobID", entry.JobID, "tenantID", entry.TenantID)
+
+	if err := b.settingsRepo.IncrementTokenUsage(ctx, entry.TenantID, entry.TokensUsed); err != nil {
+		log.Warn("failed to increment token usage", "error", err)
+	}
+	if err := b.usageRepo.Record(ctx, entry); err != nil {
+		log.Warn("failed to record token usage", "error", err)
+	}
+}
+
+// MonthlyTokenUsage summarizes a tenant's token usage for one month.
+type MonthlyTokenUsage struct {
+	Month          time.Time
+	TokensUsed     int64
+	ProjectedTotal int64 // Month-end usage if the month-to-date rate continues
+	Daily          []entity.DailyTokenUsage
+	ByJobType      []entity.TokenUsageByType
+}
+
+// GetMonthlyUsage returns the tenant's usage for the month containing now.
+func (b *TokenBudget) GetMonthlyUsage(ctx context.Context, tenantID uuid.UUID, now time.Time) (*MonthlyTokenUsage, error) {
+	month := entity.UsageMonthStart(now)
+
+	daily, err := b.usageRepo.ListDailyUsage(ctx, tenantID, month)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+	byType, err := b.usageRepo.ListUsageByJobType(ctx, tenantID, month)
+	if err != nil {
+		return nil, domainerrors.ErrInternal.WithCause(err)
+	}
+
+	var used int64
+	for _, day := range daily {
+		used += day.TokensUsed
+	}
+
+	return &MonthlyTokenUsage{
+		Month:          month,
+		TokensUsed:     used,
+		ProjectedTotal: projectMonthEnd(used, month, now),
+		Daily:          daily,
+		ByJobType:      byType,
+	}, nil
+}
+
+// projectMonthEnd extrapolates month-to-date usage linearly to the end of the
+// month. At least one day counts as elapsed so the first hours of a month do
+// not produce wild projections.
+func projectMonthEnd(used int64, month, now time.Time) int64 {
+	monthLength := month.AddDate(0, 1, 0).Sub(month)
+	elapsed := now.Sub(month)
+	if elapsed < 24*time.Hour {
+		elapsed = 24 * time.Hour
+	}
+	if elapsed >= monthLength {
+		return used
+	}
+	return int64(float64(used) * float64(monthLength) / float64(elapsed))
+}
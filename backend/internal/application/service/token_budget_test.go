@@ -0,0 +1,227 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// planAllowance allows up to limit tokens a month, like a plan's AI allowance.
+type planAllowance struct {
+	limit int64
+}
+
+func (a planAllowance) CheckMonthlyTokens(_ context.Context, _ uuid.UUID, used, needed int64) error {
+	if used+needed > a.limit {
+		return domainerrors.ErrTokenLimitExceeded.WithMessage("plan allowance exceeded")
+	}
+	return nil
+}
+
+// usageEntry is a ledger entry of tokens for a job of jobType, dated at.
+func usageEntry(jobType valueobject.GenerationJobType, tokens int64, at time.Time) *entity.TokenUsageEntry {
+	jobID := uuid.New()
+	return &entity.TokenUsageEntry{
+		UsageMonth: entity.UsageMonthStart(at),
+		JobID:      &jobID,
+		JobType:    jobType,
+		TokensUsed: tokens,
+		CreatedAt:  at,
+	}
+}
+
+func TestCheckBudget(t *testing.T) {
+	now := time.Now()
+	lastMonth := entity.UsageMonthStart(now).Add(-time.Hour)
+	limit := func(n int64) *entity.TenantAISettings { return &entity.TenantAISettings{MonthlyTokenLimit: &n} }
+	outline, lesson := valueobject.GenerationJobTypeCourseOutline, valueobject.GenerationJobTypeLessonContent
+
+	tests := []struct {
+		name      string
+		settings  *entity.TenantAISettings
+		allowance int64
+		entries   []*entity.TokenUsageEntry
+		jobCount  int
+		wantErr   bool
+	}{
+		{
+			name:      "no limit and no history",
+			allowance: 1000,
+			jobCount:  10,
+		},
+		{
+			name:      "under limit",
+			settings:  limit(1000),
+			allowance: 10000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(lesson, 200, now), usageEntry(lesson, 300, now)},
+			jobCount:  1,
+		},
+		{
+			name:      "limit reached",
+			settings:  limit(1000),
+			allowance: 10000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(outline, 1000, now)},
+			wantErr:   true,
+		},
+		{
+			name:      "over limit",
+			settings:  limit(1000),
+			allowance: 10000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(outline, 1200, now)},
+			wantErr:   true,
+		},
+		{
+			// Lessons average 300 tokens, so three more need 900 on top of 600
+			name:      "estimate exceeds limit",
+			settings:  limit(1000),
+			allowance: 10000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(lesson, 200, now), usageEntry(lesson, 400, now)},
+			jobCount:  3,
+			wantErr:   true,
+		},
+		{
+			name:      "estimate exceeds plan allowance",
+			allowance: 1000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(lesson, 200, now), usageEntry(lesson, 400, now)},
+			jobCount:  3,
+			wantErr:   true,
+		},
+		{
+			name:      "plan allowance used up",
+			settings:  limit(5000),
+			allowance: 1000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(outline, 1100, now)},
+			wantErr:   true,
+		},
+		{
+			// Last month's usage no longer counts, but still informs the estimate
+			name:      "month rollover",
+			settings:  limit(1000),
+			allowance: 1000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(lesson, 5000, lastMonth), usageEntry(lesson, 300, lastMonth)},
+			jobCount:  2,
+			wantErr:   true,
+		},
+		{
+			name:      "month rollover without estimate",
+			settings:  limit(1000),
+			allowance: 1000,
+			entries:   []*entity.TokenUsageEntry{usageEntry(outline, 5000, lastMonth)},
+			jobCount:  2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := NewTokenBudget(&usageSettingsRepo{settings: tt.settings}, &recordingUsageRepo{entries: tt.entries}, planAllowance{limit: tt.allowance}, nopLogger{})
+
+			err := budget.CheckBudget(context.Background(), uuid.New(), lesson, tt.jobCount)
+			if !tt.wantErr && err != nil {
+				t.Fatalf("CheckBudget() error = %v", err)
+			}
+			if tt.wantErr && !errors.Is(err, domainerrors.ErrTokenLimitExceeded) {
+				t.Fatalf("CheckBudget() error = %v, want ErrTokenLimitExceeded", err)
+			}
+		})
+	}
+}
+
+func TestGetMonthlyUsage(t *testing.T) {
+	month := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC) // 30 days
+	march := time.Date(2026, time.March, 31, 22, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		now           time.Time
+		entries       []*entity.TokenUsageEntry
+		wantUsed      int64
+		wantProjected int64
+		wantDays      int
+	}{
+		{
+			name:          "no history",
+			now:           month.Add(10 * 24 * time.Hour),
+			wantUsed:      0,
+			wantProjected: 0,
+		},
+		{
+			// The first hours of a month count as a full day
+			name:          "zero days of history",
+			now:           month.Add(2 * time.Hour),
+			entries:       []*entity.TokenUsageEntry{usageEntry(valueobject.GenerationJobTypeCourseOutline, 100, month.Add(time.Hour))},
+			wantUsed:      100,
+			wantProjected: 3000,
+			wantDays:      1,
+		},
+		{
+			name: "month rollover",
+			now:  month.Add(time.Hour),
+			entries: []*entity.TokenUsageEntry{
+				usageEntry(valueobject.GenerationJobTypeLessonContent, 9000, march),
+			},
+			wantUsed:      0,
+			wantProjected: 0,
+		},
+		{
+			name: "mid month",
+			now:  month.Add(15 * 24 * time.Hour),
+			entries: []*entity.TokenUsageEntry{
+				usageEntry(valueobject.GenerationJobTypeLessonContent, 9000, march),
+				usageEntry(valueobject.GenerationJobTypeCourseOutline, 400, month.Add(24*time.Hour)),
+				usageEntry(valueobject.GenerationJobTypeLessonContent, 600, month.Add(5*24*time.Hour)),
+				usageEntry(valueobject.GenerationJobTypeLessonContent, 500, month.Add(5*24*time.Hour+time.Hour)),
+			},
+			wantUsed:      1500,
+			wantProjected: 3000,
+			wantDays:      2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			budget := NewTokenBudget(&usageSettingsRepo{}, &recordingUsageRepo{entries: tt.entries}, nil, nopLogger{})
+
+			usage, err := budget.GetMonthlyUsage(context.Background(), uuid.New(), tt.now)
+			if err != nil {
+				t.Fatalf("GetMonthlyUsage() error = %v", err)
+			}
+			if !usage.Month.Equal(month) {
+				t.Errorf("Month = %v, want %v", usage.Month, month)
+			}
+			if usage.TokensUsed != tt.wantUsed || usage.ProjectedTotal != tt.wantProjected {
+				t.Errorf("usage = %d projected to %d, want %d projected to %d", usage.TokensUsed, usage.ProjectedTotal, tt.wantUsed, tt.wantProjected)
+			}
+			if len(usage.Daily) != tt.wantDays {
+				t.Errorf("got %d days of usage, want %d", len(usage.Daily), tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestProjectMonthEnd(t *testing.T) {
+	month := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC) // 28 days
+
+	tests := []struct {
+		name string
+		used int64
+		now  time.Time
+		want int64
+	}{
+		{"month start", 50, month, 1400},
+		{"first hours", 50, month.Add(3 * time.Hour), 1400},
+		{"one week", 700, month.Add(7 * 24 * time.Hour), 2800},
+		{"nothing used", 0, month.Add(7 * 24 * time.Hour), 0},
+		{"last instant", 2800, month.AddDate(0, 1, 0).Add(-time.Nanosecond), 2800},
+		{"after month end", 2800, month.AddDate(0, 1, 2), 2800},
+	}
+	for _, tt := range tests {
+		if got := projectMonthEnd(tt.used, month, tt.now); got != tt.want {
+			t.Errorf("%s: projectMonthEnd(%d) = %d, want %d", tt.name, tt.used, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// XAPIForwardingPath is the base xAPI endpoint exported packages post statements to.
+const XAPIForwardingPath = "/api/v1/xapi/"
+
+// maxForwardedStatements caps the statements accepted in one request.
+const maxForwardedStatements = 50
+
+// LRSConfigProvider retrieves a tenant's decrypted LRS configuration.
+type LRSConfigProvider interface {
+	// GetLRSConfig returns (nil, nil) if the tenant has no LRS configured.
+	GetLRSConfig(ctx context.Context, tenantID uuid.UUID) (*service.LRSConfig, error)
+}
+
+// XAPIStatementService forwards the xAPI statements of exported packages to
+// the tenant's LRS. A package carries a token scoped to one export of one
+// course instead of the LRS credentials: the token can only record statements
+// about that course, and only while the export exists and the tenant is active.
+type XAPIStatementService struct {
+	exportRepo   repository.CourseExportRepository
+	lrsConfig    LRSConfigProvider
+	lrsClient    service.LRSClient
+	tenantAccess TenantAccessChecker // Optional, nil forwards statements of any tenant
+	signingKey   []byte
+	endpoint     string
+	logger       service.Logger
+}
+
+// NewXAPIStatementService creates a new xAPI statement service.
+// backendURL is the public URL packages reach the API at.
+func NewXAPIStatementService(
+	exportRepo repository.CourseExportRepository,
+	lrsConfig LRSConfigProvider,
+	lrsClient service.LRSClient,
+	tenantAccess TenantAccessChecker,
+	signingKey []byte,
+	backendURL string,
+	logger service.Logger,
+) *XAPIStatementService {
+	return &XAPIStatementService{
+		exportRepo:   exportRepo,
+		lrsConfig:    lrsConfig,
+		lrsClient:    lrsClient,
+		tenantAccess: tenantAccess,
+		signingKey:   signingKey,
+		endpoint:     strings.TrimSuffix(backendURL, "/") + XAPIForwardingPath,
+		logger:       logger,
+	}
+}
+
+// StatementForwarding returns the forwarding endpoint and token to embed in an export.
+// Returns (nil, nil) if the tenant has no LRS configured.
+func (s *XAPIStatementService) StatementForwarding(ctx context.Context, export *entity.CourseExport) (*service.StatementForwarding, error) {
+	lrs, err := s.lrsConfig.GetLRSConfig(ctx, export.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if lrs == nil {
+		return nil, nil
+	}
+
+	return &service.StatementForwarding{
+		Endpoint: s.endpoint,
+		Auth:     "Bearer " + s.issueToken(export),
+	}, nil
+}
+
+// ForwardStatements validates a statement or JSON array of statements posted
+// by a package and forwards it to the tenant's LRS.
+func (s *XAPIStatementService) ForwardStatements(ctx context.Context, token string, body []byte) error {
+	tenantID, courseID, exportID, ok := s.parseToken(token)
+	if !ok {
+		return domainerrors.ErrUnauthorized.WithMessage("invalid statement token")
+	}
+
+	log := s.logger.With("tenantID", tenantID, "courseID", courseID, "exportID", exportID)
+	tenantCtx := tenant.WithTenantID(tenant.WithSuperAdmin(ctx, true), tenantID)
+
+	// Tokens stop working once their export is gone
+	export, err := s.exportRepo.GetByID(tenantCtx, exportID)
+	if err != nil {
+		log.Error("failed to get export", "error", err)
+		return domainerrors.ErrInternal.WithCause(err)
+	}
+	if export == nil || export.TenantID != tenantID || export.CourseID != courseID || export.Status != valueobject.ExportStatusCompleted {
+		return domainerrors.ErrUnauthorized.WithMessage("invalid statement token")
+	}
+
+	if s.tenantAccess != nil {
+		if err := s.tenantAccess.CheckTenantActive(tenantCtx, tenantID); err != nil {
+			return err
+		}
+	}
+
+	if err := validateCourseStatements(body, "urn:mirai:course:"+courseID.String()); err != nil {
+		return err
+	}
+
+	lrs, err := s.lrsConfig.GetLRSConfig(tenantCtx, tenantID)
+	if err != nil {
+		log.Error("failed to get LRS settings", "error", err)
+		return err
+	}
+	if lrs == nil {
+		return domainerrors.ErrNotFound.WithMessage("no LRS is configured")
+	}
+
+	if err := s.lrsClient.SendStatements(ctx, *lrs, body); err != nil {
+		log.Warn("failed to forward statements to LRS", "error", err)
+		return domainerrors.ErrExternalService.WithCause(err)
+	}
+
+	return nil
+}
+
+// issueToken signs the export's tenant, course and export IDs.
+func (s *XAPIStatementService) issueToken(export *entity.CourseExport) string {
+	payload := make([]byte, 0, 48)
+	payload = append(payload, export.TenantID[:]...)
+	payload = append(payload, export.CourseID[:]...)
+	payload = append(payload, export.ID[:]...)
+
+	return base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(s.sign(payload))
+}
+
+// parseToken verifies a token issued by issueToken and returns its IDs.
+func (s *XAPIStatementService) parseToken(token string) (tenantID, courseID, exportID uuid.UUID, ok bool) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil || len(payload) != 48 {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(payload)) {
+		return uuid.Nil, uuid.Nil, uuid.Nil, false
+	}
+
+	copy(tenantID[:], payload[0:16])
+	copy(courseID[:], payload[16:32])
+	copy(exportID[:], payload[32:48])
+	return tenantID, courseID, exportID, true
+}
+
+func (s *XAPIStatementService) sign(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte("xapi-statement:"))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+// validateCourseStatements checks body is a statement or an array of statements
+// whose objects are all activities of the course.
+func validateCourseStatements(body []byte, courseIRI string) error {
+	type statement struct {
+		Object struct {
+			ObjectType string `json:"objectType"`
+			ID         string `json:"id"`
+		} `json:"object"`
+	}
+
+	var statements []statement
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &statements); err != nil {
+			return domainerrors.ErrInvalidInput.WithMessage("statements must be valid JSON")
+		}
+	} else {
+		var single statement
+		if err := json.Unmarshal(trimmed, &single); err != nil {
+			return domainerrors.ErrInvalidInput.WithMessage("statements must be valid JSON")
+		}
+		statements = []statement{single}
+	}
+
+	if len(statements) == 0 {
+		return domainerrors.ErrInvalidInput.WithMessage("no statements to record")
+	}
+	if len(statements) > maxForwardedStatements {
+		return domainerrors.ErrInvalidInput.WithMessage(fmt.Sprintf("at most %d statements can be recorded at once", maxForwardedStatements))
+	}
+
+	for _, st := range statements {
+		if st.Object.ObjectType != "" && st.Object.ObjectType != "Activity" {
+			return domainerrors.ErrInvalidInput.WithMessage("statements must be about course activities")
+		}
+		if st.Object.ID != courseIRI && !strings.HasPrefix(st.Object.ID, courseIRI+":") {
+			return domainerrors.ErrInvalidInput.WithMessage("statements must be about course activities")
+		}
+	}
+
+	return nil
+}
@@ -20,6 +20,10 @@ type TenantAISettings struct {
 	// Stored as: nonce (12 bytes) || ciphertext || auth tag (16 bytes)
 	EncryptedAPIKey []byte
 
+	// Self-hosted OpenAI-compatible server (only for AIProviderOpenAICompatible)
+	BaseURL *string
+	Model   *string
+
 	// Usage tracking
 	TotalTokensUsed   int64
 	MonthlyTokenLimit *int64
@@ -33,6 +37,63 @@ func (s *TenantAISettings) HasAPIKey() bool {
 	return len(s.EncryptedAPIKey) > 0
 }
 
+// TokenUsageEntry is a ledger row recording the tokens consumed by one AI job.
+type TokenUsageEntry struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+
+	// First day of the calendar month (UTC) the usage counts against
+	UsageMonth time.Time
+
+	JobID    *uuid.UUID
+	JobType  valueobject.GenerationJobType
+	UserID   *uuid.UUID
+	CourseID *uuid.UUID
+
+	TokensUsed int64
+	CreatedAt  time.Time
+}
+
+// DailyTokenUsage is the tokens a tenant used on one calendar day (UTC).
+type DailyTokenUsage struct {
+	Date       time.Time
+	TokensUsed int64
+}
+
+// TokenUsageByType aggregates a tenant's usage for one job type.
+type TokenUsageByType struct {
+	JobType    valueobject.GenerationJobType
+	TokensUsed int64
+	JobCount   int
+}
+
+// UsageMonthStart returns the first instant of t's calendar month in UTC.
+func UsageMonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// TenantLRSSettings contains the xAPI Learning Record Store configuration for a tenant.
+// Only ADMIN/OWNER roles can access these settings.
+type TenantLRSSettings struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+
+	Endpoint string // Base xAPI endpoint
+	Key      string // Basic auth username
+
+	// Encrypted basic auth secret (AES-256-GCM)
+	EncryptedSecret []byte
+
+	UpdatedAt       time.Time
+	UpdatedByUserID *uuid.UUID
+}
+
+// HasSecret returns true if an LRS secret is configured.
+func (s *TenantLRSSettings) HasSecret() bool {
+	return len(s.EncryptedSecret) > 0
+}
+
 // GenerationJob represents an AI generation job.
 type GenerationJob struct {
 	ID       uuid.UUID
@@ -62,6 +123,9 @@ type GenerationJob struct {
 	// Token usage for billing
 	TokensUsed int64
 
+	// Knowledge chunks selected by retrieval for lesson generation (for auditing retrieval quality)
+	RetrievedChunkIDs []uuid.UUID
+
 	// Retry tracking
 	RetryCount int32
 	MaxRetries int32
@@ -72,6 +136,23 @@ type GenerationJob struct {
 	CompletedAt     *time.Time
 }
 
+// Priority returns the scheduling lane of the job. Lesson jobs of a full course
+// or stale lesson regeneration and SME ingestion run in the bulk lane so they
+// don't hold up single outlines, lessons and component regenerations.
+func (j *GenerationJob) Priority() valueobject.GenerationJobPriority {
+	if j.ParentJobID != nil || j.Type == valueobject.GenerationJobTypeSMEIngestion {
+		return valueobject.GenerationJobPriorityBulk
+	}
+	return valueobject.GenerationJobPriorityInteractive
+}
+
+// GenerationJobQueuePosition is where a queued job stands in the shared
+// generation queue.
+type GenerationJobQueuePosition struct {
+	Position int32 // 1 for the next job to run
+	Depth    int32 // Queued jobs across all tenants
+}
+
 // GenerationJobListOptions provides filtering options for listing jobs.
 type GenerationJobListOptions struct {
 	Type     *valueobject.GenerationJobType
@@ -131,6 +212,18 @@ type OutlineLesson struct {
 	CreatedAt time.Time
 }
 
+// GenerationJobEvent is a live update about a generation job, published as
+// the job runs so clients can follow it without polling.
+type GenerationJobEvent struct {
+	Type valueobject.GenerationJobEventType
+
+	// Job the event is about, as of the event: the watched job or one of its children
+	Job *GenerationJob
+
+	Section *OutlineSection  // Set for section_ready events
+	Lesson  *GeneratedLesson // Set for lesson_ready events, with its components
+}
+
 // GeneratedLesson contains full lesson content.
 type GeneratedLesson struct {
 	ID              uuid.UUID
@@ -142,12 +235,22 @@ type GeneratedLesson struct {
 	Title string
 
 	Components []LessonComponent // Loaded separately or populated
+	Sources    []LessonSource    // SME knowledge cited by the components (loaded for display)
 
 	SegueText *string // Transition to next lesson
 
+	// Set when SME knowledge the lesson cites changed after it was generated
+	StaleSince  *time.Time
+	StaleReason *string
+
 	GeneratedAt time.Time
 }
 
+// IsStale reports whether the lesson has been flagged out of date.
+func (l *GeneratedLesson) IsStale() bool {
+	return l.StaleSince != nil
+}
+
 // LessonComponent represents a content component in a lesson.
 type LessonComponent struct {
 	ID       uuid.UUID
@@ -168,6 +271,22 @@ type LessonComponent struct {
 	UpdatedAt time.Time
 }
 
+// LessonSource identifies where a cited SME knowledge chunk came from, so a
+// component can be traced back to the submission and file behind it.
+type LessonSource struct {
+	ChunkID       uuid.UUID
+	SMEID         uuid.UUID
+	Topic         string
+	SubmissionID  *uuid.UUID
+	FileName      *string
+	SourceHeading *string
+	SourcePage    *int32
+
+	SourceTimestamp *int32 // Seconds into the source recording
+
+	Outdated bool // Chunk was edited or deleted after the lesson was generated
+}
+
 // CourseGenerationInput captures inputs for AI course generation.
 type CourseGenerationInput struct {
 	ID       uuid.UUID
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// BackgroundTask is a task in the background job queues.
+type BackgroundTask struct {
+	ID    string
+	Queue string
+	Type  string // Task type, e.g. "ai:generation"
+	State valueobject.BackgroundTaskState
+
+	Payload []byte // JSON payload the task was enqueued with
+
+	Retried       int
+	MaxRetry      int
+	LastError     string
+	LastFailedAt  *time.Time
+	NextProcessAt *time.Time // Next automatic retry (retry state only)
+}
+
+// BackgroundTaskListOptions provides filtering options for listing failed tasks.
+type BackgroundTaskListOptions struct {
+	State    valueobject.BackgroundTaskState // Retry or archived
+	Queue    string                          // All queues when empty
+	Page     int                             // 1-based
+	PageSize int
+}
@@ -17,10 +17,21 @@ type Company struct {
 	Plan                 valueobject.Plan
 	StripeCustomerID     *string
 	StripeSubscriptionID *string
+	BillingEmail         *string // Email of the Stripe customer invoices go to
 	SubscriptionStatus   valueobject.SubscriptionStatus
-	SeatCount            int // Purchased seats from Stripe subscription (0 = use plan default)
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
+	SeatCount            int        // Purchased seats from Stripe subscription (0 = use plan default)
+	PastDueSince         *time.Time // When the first payment failed or the subscription became past due; nil unless in dunning
+
+	// Dunning of a past due subscription
+	DunningStage          valueobject.DunningStage
+	DunningRemindersSent  int
+	DunningLastReminderAt *time.Time
+	DunningInvoiceURL     *string // Hosted page of the last failed invoice
+
+	EntitlementOverrides *EntitlementOverrides // Enterprise contract terms; nil uses the plan's entitlements
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 // HasStripeCustomer returns true if the company has a Stripe customer.
@@ -54,6 +65,32 @@ func (c *Company) EffectiveSeatCount() int {
 	return c.Plan.DefaultSeatLimit()
 }
 
+// Entitlements returns the limits and features the company is entitled to.
+// Overrides only apply on the enterprise plan, so they lapse on a downgrade.
+func (c *Company) Entitlements() Entitlements {
+	entitlements := PlanEntitlements(c.Plan)
+	if c.Plan == valueobject.PlanEnterprise {
+		entitlements = c.EntitlementOverrides.Apply(entitlements)
+	}
+	return entitlements
+}
+
+// IsInDunning returns true if an overdue payment is being followed up.
+func (c *Company) IsInDunning() bool {
+	return c.PastDueSince != nil
+}
+
+// DunningFields returns the company's current dunning state.
+func (c *Company) DunningFields() DunningFields {
+	return DunningFields{
+		PastDueSince:   c.PastDueSince,
+		Stage:          c.DunningStage,
+		RemindersSent:  c.DunningRemindersSent,
+		LastReminderAt: c.DunningLastReminderAt,
+		InvoiceURL:     c.DunningInvoiceURL,
+	}
+}
+
 // StripeFields contains updateable Stripe-related fields.
 type StripeFields struct {
 	CustomerID     *string
@@ -62,3 +99,13 @@ type StripeFields struct {
 	Plan           valueobject.Plan
 	SeatCount      int // Purchased seats from Stripe subscription
 }
+
+// DunningFields contains updateable dunning fields.
+// Leaving the past_due status through StripeFields resets them.
+type DunningFields struct {
+	PastDueSince   *time.Time
+	Stage          valueobject.DunningStage
+	RemindersSent  int
+	LastReminderAt *time.Time
+	InvoiceURL     *string
+}
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Entitlements are the limits and features a company's plan grants.
+// A limit of 0 means unlimited.
+type Entitlements struct {
+	Plan            valueobject.Plan
+	MaxSMEs         int
+	MaxCourses      int
+	MonthlyAITokens int64
+	ExportFormats   []valueobject.ExportFormat
+	SSO             bool
+	CustomBranding  bool
+}
+
+// PlanEntitlements returns the entitlements every company on the plan gets.
+func PlanEntitlements(plan valueobject.Plan) Entitlements {
+	switch plan {
+	case valueobject.PlanPro:
+		return Entitlements{
+			Plan:            plan,
+			MaxSMEs:         25,
+			MaxCourses:      200,
+			MonthlyAITokens: 5_000_000,
+			ExportFormats:   allExportFormats(),
+			CustomBranding:  true,
+		}
+	case valueobject.PlanEnterprise:
+		return Entitlements{
+			Plan:           plan,
+			ExportFormats:  allExportFormats(),
+			SSO:            true,
+			CustomBranding: true,
+		}
+	default:
+		return Entitlements{
+			Plan:            valueobject.PlanStarter,
+			MaxSMEs:         3,
+			MaxCourses:      10,
+			MonthlyAITokens: 500_000,
+			ExportFormats:   []valueobject.ExportFormat{valueobject.ExportFormatPDF, valueobject.ExportFormatSCORM12},
+		}
+	}
+}
+
+// AllowsSMEs reports whether count SMEs fit the limit.
+func (e Entitlements) AllowsSMEs(count int) bool {
+	return e.MaxSMEs == 0 || count <= e.MaxSMEs
+}
+
+// AllowsCourses reports whether count courses fit the limit.
+func (e Entitlements) AllowsCourses(count int) bool {
+	return e.MaxCourses == 0 || count <= e.MaxCourses
+}
+
+// HasFeature reports whether the feature is included.
+func (e Entitlements) HasFeature(feature valueobject.PlanFeature) bool {
+	switch feature {
+	case valueobject.PlanFeatureSSO:
+		return e.SSO
+	case valueobject.PlanFeatureCustomBranding:
+		return e.CustomBranding
+	}
+	return false
+}
+
+// AllowsExportFormat reports whether courses may be exported in the format.
+func (e Entitlements) AllowsExportFormat(format valueobject.ExportFormat) bool {
+	return slices.Contains(e.ExportFormats, format)
+}
+
+// EntitlementOverrides replace plan entitlements for one company, as agreed in
+// an enterprise contract. Nil fields keep the plan default.
+type EntitlementOverrides struct {
+	MaxSMEs         *int                       `json:"max_smes,omitempty"`
+	MaxCourses      *int                       `json:"max_courses,omitempty"`
+	MonthlyAITokens *int64                     `json:"monthly_ai_tokens,omitempty"`
+	ExportFormats   []valueobject.ExportFormat `json:"export_formats,omitempty"`
+	SSO             *bool                      `json:"sso,omitempty"`
+	CustomBranding  *bool                      `json:"custom_branding,omitempty"`
+}
+
+// Apply returns the entitlements with the overrides in place.
+func (o *EntitlementOverrides) Apply(e Entitlements) Entitlements {
+	if o == nil {
+		return e
+	}
+	if o.MaxSMEs != nil {
+		e.MaxSMEs = *o.MaxSMEs
+	}
+	if o.MaxCourses != nil {
+		e.MaxCourses = *o.MaxCourses
+	}
+	if o.MonthlyAITokens != nil {
+		e.MonthlyAITokens = *o.MonthlyAITokens
+	}
+	if o.ExportFormats != nil {
+		e.ExportFormats = o.ExportFormats
+	}
+	if o.SSO != nil {
+		e.SSO = *o.SSO
+	}
+	if o.CustomBranding != nil {
+		e.CustomBranding = *o.CustomBranding
+	}
+	return e
+}
+
+func allExportFormats() []valueobject.ExportFormat {
+	return []valueobject.ExportFormat{
+		valueobject.ExportFormatSCORM12,
+		valueobject.ExportFormatSCORM2004,
+		valueobject.ExportFormatXAPI,
+		valueobject.ExportFormatPDF,
+	}
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// CourseExport represents an export of a course into a downloadable package.
+// The rendered artifact is stored in S3 at StoragePath.
+type CourseExport struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	CourseID uuid.UUID
+
+	// Course version at the time the export was requested
+	Version int32
+
+	Format valueobject.ExportFormat
+	Status valueobject.ExportStatus
+
+	// Artifact location (set on completion)
+	StoragePath *string // Full S3 key of the artifact
+	FileName    *string // File name presented to the downloader
+	SizeBytes   *int64
+
+	ErrorMessage *string
+
+	RequestedByUserID *uuid.UUID
+	CreatedAt         time.Time
+	StartedAt         *time.Time
+	CompletedAt       *time.Time
+}
@@ -63,3 +63,11 @@ func (p *PendingRegistration) MarkAsFailed(errMsg string) {
 	p.ErrorMessage = &errMsg
 	p.UpdatedAt = time.Now()
 }
+
+// MarkForRetry puts a paid registration whose provisioning failed back to paid
+// so it can be provisioned again.
+func (p *PendingRegistration) MarkForRetry() {
+	p.Status = valueobject.PendingRegistrationStatusPaid
+	p.ErrorMessage = nil
+	p.UpdatedAt = time.Now()
+}
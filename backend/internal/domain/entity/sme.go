@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -75,11 +79,19 @@ type SMETaskSubmission struct {
 	FilePath      string // S3 path
 	ContentType   valueobject.ContentType
 	FileSizeBytes int64
+	SourceURL     *string // Page to fetch, for URL submissions
 
 	// Ingestion results
 	ExtractedText  *string // Raw extracted text
 	AISummary      *string // Gemini-generated summary
 	IngestionError *string // Error if failed
+	ContentHash    *string // SHA-256 of the normalized extracted text
+
+	// Set when a newer submission with the same source replaced this one's knowledge
+	SupersededBySubmissionID *uuid.UUID
+
+	// Earlier submission the submitter said this one replaces, for sources that don't match
+	ReplacesSubmissionID *uuid.UUID
 
 	SubmittedByUserID uuid.UUID
 	SubmittedAt       time.Time
@@ -105,9 +117,133 @@ type SMEKnowledgeChunk struct {
 	Keywords       []string // Extracted keywords
 	RelevanceScore float32  // For ranking in generation
 
+	SourceHeading *string // Heading of the source document section (if known)
+	SourcePage    *int32  // Page or slide number in the source document (if known)
+
+	SourceTimestamp *int32 // Seconds into the source recording (if from audio or video)
+
+	CreatedAt time.Time
+}
+
+// SMEKnowledgeChunkVersion is a snapshot of a knowledge chunk recorded each
+// time it is created, edited, deleted or restored.
+type SMEKnowledgeChunkVersion struct {
+	ID       uuid.UUID
+	TenantID uuid.UUID
+	ChunkID  uuid.UUID
+	SMEID    uuid.UUID
+	Version  int32 // Starts at 1 and increases with each change
+
+	ChangeType   valueobject.KnowledgeChangeType
+	ChangeReason *string    // Why the change was made (if given)
+	AuthorUserID *uuid.UUID // Who made the change; nil for system changes
+	SubmissionID *uuid.UUID // Submission the chunk's knowledge came from
+
+	// Chunk state after the change (before it, for deletions)
+	Content         string
+	Topic           string
+	Keywords        []string
+	RelevanceScore  float32
+	SourceHeading   *string
+	SourcePage      *int32
+	SourceTimestamp *int32
+
 	CreatedAt time.Time
 }
 
+// NewSMEKnowledgeChunkVersion snapshots chunk for its history.
+func NewSMEKnowledgeChunkVersion(chunk *SMEKnowledgeChunk, changeType valueobject.KnowledgeChangeType, authorUserID *uuid.UUID, reason *string) *SMEKnowledgeChunkVersion {
+	return &SMEKnowledgeChunkVersion{
+		TenantID:        chunk.TenantID,
+		ChunkID:         chunk.ID,
+		SMEID:           chunk.SMEID,
+		ChangeType:      changeType,
+		ChangeReason:    reason,
+		AuthorUserID:    authorUserID,
+		SubmissionID:    chunk.SubmissionID,
+		Content:         chunk.Content,
+		Topic:           chunk.Topic,
+		Keywords:        chunk.Keywords,
+		RelevanceScore:  chunk.RelevanceScore,
+		SourceHeading:   chunk.SourceHeading,
+		SourcePage:      chunk.SourcePage,
+		SourceTimestamp: chunk.SourceTimestamp,
+	}
+}
+
+// Chunk returns the knowledge chunk as it was at this version.
+func (v *SMEKnowledgeChunkVersion) Chunk() *SMEKnowledgeChunk {
+	return &SMEKnowledgeChunk{
+		ID:              v.ChunkID,
+		TenantID:        v.TenantID,
+		SMEID:           v.SMEID,
+		SubmissionID:    v.SubmissionID,
+		Content:         v.Content,
+		Topic:           v.Topic,
+		Keywords:        v.Keywords,
+		RelevanceScore:  v.RelevanceScore,
+		SourceHeading:   v.SourceHeading,
+		SourcePage:      v.SourcePage,
+		SourceTimestamp: v.SourceTimestamp,
+	}
+}
+
+// OutdatedLesson is a generated lesson citing a knowledge chunk that has
+// changed or been deleted since the lesson was generated.
+type OutdatedLesson struct {
+	LessonID    uuid.UUID
+	CourseID    uuid.UUID
+	Title       string
+	GeneratedAt time.Time
+
+	ChunkID          uuid.UUID
+	GeneratedVersion int32 // Chunk version current when the lesson was generated
+	CurrentVersion   int32 // Latest chunk version
+	ChunkDeleted     bool  // Whether the latest version is a deletion
+}
+
+// FormatTimestamp formats a recording position as M:SS, or H:MM:SS for
+// recordings of an hour or more.
+func FormatTimestamp(seconds int32) string {
+	h, m, sec := seconds/3600, seconds/60%60, seconds%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, sec)
+	}
+	return fmt.Sprintf("%d:%02d", m, sec)
+}
+
+// ParseTimestamp converts an M:SS or H:MM:SS recording position to seconds.
+// Returns 0 for empty or malformed values.
+func ParseTimestamp(value string) int32 {
+	parts := strings.Split(strings.TrimSpace(value), ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0
+	}
+	var seconds int32
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return 0
+		}
+		seconds = seconds*60 + int32(n)
+	}
+	return seconds
+}
+
+// timeMarker matches the [Time M:SS] markers of a rendered transcript.
+var timeMarker = regexp.MustCompile(`\[Time (\d+:\d{2}(?::\d{2})?)\]`)
+
+// LastTimeMarker returns the position of the last [Time M:SS] marker in a
+// rendered transcript, or -1 when the text has none. Knowledge from the
+// transcript cites the marker above it, so no citation can be later.
+func LastTimeMarker(text string) int32 {
+	last := int32(-1)
+	for _, m := range timeMarker.FindAllStringSubmatch(text, -1) {
+		last = max(last, ParseTimestamp(m[1]))
+	}
+	return last
+}
+
 // SMEListOptions provides filtering options for listing SMEs.
 type SMEListOptions struct {
 	Scope           *valueobject.SMEScope
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// StripeWebhookEvent is a Stripe webhook event as delivered, with how far
+// processing it got. Stripe redelivers events until acknowledged, so the
+// stored status is what keeps an event from being applied twice.
+type StripeWebhookEvent struct {
+	ID              string // Stripe event ID (evt_...)
+	Type            string // e.g. "customer.subscription.updated"
+	Payload         []byte // Full event JSON as delivered
+	StripeCreatedAt time.Time
+	Status          valueobject.WebhookEventStatus
+	Attempts        int
+	ErrorMessage    *string
+	ReceivedAt      time.Time
+	UpdatedAt       time.Time
+	ProcessedAt     *time.Time
+}
+
+// MarkProcessed records that the event was applied.
+func (e *StripeWebhookEvent) MarkProcessed() {
+	now := time.Now()
+	e.Status = valueobject.WebhookEventStatusProcessed
+	e.ErrorMessage = nil
+	e.ProcessedAt = &now
+	e.UpdatedAt = now
+}
+
+// MarkIgnored records that the event had nothing to apply and why.
+func (e *StripeWebhookEvent) MarkIgnored(reason string) {
+	now := time.Now()
+	e.Status = valueobject.WebhookEventStatusIgnored
+	e.ErrorMessage = &reason
+	e.ProcessedAt = &now
+	e.UpdatedAt = now
+}
+
+// MarkFailed records that applying the event failed.
+func (e *StripeWebhookEvent) MarkFailed(errMsg string) {
+	e.Status = valueobject.WebhookEventStatusFailed
+	e.ErrorMessage = &errMsg
+	e.UpdatedAt = time.Now()
+}
+
+// StripeWebhookEventListOptions provides filtering options for listing webhook events.
+type StripeWebhookEventListOptions struct {
+	Status *valueobject.WebhookEventStatus // All statuses when nil
+	Type   string                          // All types when empty
+	Limit  int
+	Offset int
+}
@@ -28,15 +28,37 @@ func (s TenantStatus) String() string {
 	return string(s)
 }
 
+// TenantSuspensionReason records why a tenant was suspended.
+type TenantSuspensionReason string
+
+const (
+	TenantSuspensionReasonSubscriptionCanceled TenantSuspensionReason = "subscription_canceled"
+	TenantSuspensionReasonPaymentPastDue       TenantSuspensionReason = "payment_past_due" // Overdue payment not settled by the end of dunning
+	TenantSuspensionReasonManual               TenantSuspensionReason = "manual"
+)
+
+// String returns the string representation of the suspension reason.
+func (r TenantSuspensionReason) String() string {
+	return string(r)
+}
+
+// IsBilling returns true if the suspension is lifted once the subscription is active again.
+func (r TenantSuspensionReason) IsBilling() bool {
+	return r == TenantSuspensionReasonSubscriptionCanceled || r == TenantSuspensionReasonPaymentPastDue
+}
+
 // Tenant represents a top-level organizational boundary.
 // Multiple companies can belong to a single tenant.
 type Tenant struct {
-	ID        uuid.UUID
-	Name      string
-	Slug      string
-	Status    TenantStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID               uuid.UUID
+	Name             string
+	Slug             string
+	Status           TenantStatus
+	SuspendedAt      *time.Time
+	SuspensionReason *TenantSuspensionReason
+	RestrictedAt     *time.Time // Costly features paused while a payment is overdue
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 // IsActive returns true if the tenant is active.
@@ -48,3 +70,43 @@ func (t *Tenant) IsActive() bool {
 func (t *Tenant) IsSuspended() bool {
 	return t.Status == TenantStatusSuspended
 }
+
+// IsSuspendedForBilling returns true if the tenant is suspended over its subscription.
+func (t *Tenant) IsSuspendedForBilling() bool {
+	return t.IsSuspended() && t.SuspensionReason != nil && t.SuspensionReason.IsBilling()
+}
+
+// Suspend suspends the tenant for the given reason.
+func (t *Tenant) Suspend(reason TenantSuspensionReason) {
+	now := time.Now()
+	t.Status = TenantStatusSuspended
+	t.SuspendedAt = &now
+	t.SuspensionReason = &reason
+	t.UpdatedAt = now
+}
+
+// Reactivate lifts a suspension.
+func (t *Tenant) Reactivate() {
+	t.Status = TenantStatusActive
+	t.SuspendedAt = nil
+	t.SuspensionReason = nil
+	t.UpdatedAt = time.Now()
+}
+
+// IsRestricted returns true if the tenant's costly features are paused.
+func (t *Tenant) IsRestricted() bool {
+	return t.RestrictedAt != nil
+}
+
+// Restrict pauses the tenant's costly features.
+func (t *Tenant) Restrict() {
+	now := time.Now()
+	t.RestrictedAt = &now
+	t.UpdatedAt = now
+}
+
+// LiftRestriction restores the tenant's paused features.
+func (t *Tenant) LiftRestriction() {
+	t.RestrictedAt = nil
+	t.UpdatedAt = time.Now()
+}
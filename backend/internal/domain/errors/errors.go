@@ -120,6 +120,20 @@ var (
 	}
 )
 
+// Tenant errors
+var (
+	ErrTenantSuspended = &DomainError{
+		Code:       "TENANT_SUSPENDED",
+		Message:    "this workspace is suspended; update billing to restore access",
+		HTTPStatus: http.StatusForbidden,
+	}
+	ErrTenantRestricted = &DomainError{
+		Code:       "TENANT_RESTRICTED",
+		Message:    "this feature is paused until the overdue payment is settled",
+		HTTPStatus: http.StatusForbidden,
+	}
+)
+
 // Team errors
 var (
 	ErrTeamNotFound = &DomainError{
@@ -173,6 +187,24 @@ var (
 		HTTPStatus: http.StatusInternalServerError,
 	}
 
+	ErrPlanLimitReached = &DomainError{
+		Code:       "BILLING_PLAN_LIMIT_REACHED",
+		Message:    "your plan's limit has been reached - please upgrade your plan",
+		HTTPStatus: http.StatusForbidden,
+	}
+
+	ErrPlanFeatureUnavailable = &DomainError{
+		Code:       "BILLING_PLAN_FEATURE_UNAVAILABLE",
+		Message:    "this feature is not included in your plan - please upgrade your plan",
+		HTTPStatus: http.StatusForbidden,
+	}
+
+	ErrPlanChangeBlocked = &DomainError{
+		Code:       "BILLING_PLAN_CHANGE_BLOCKED",
+		Message:    "the subscription can't be changed while current usage exceeds the new plan",
+		HTTPStatus: http.StatusPreconditionFailed,
+	}
+
 	ErrWebhookInvalid = &DomainError{
 		Code:       "BILLING_WEBHOOK_INVALID",
 		Message:    "invalid webhook signature",
@@ -301,6 +333,12 @@ var (
 		Message:    "no access to this SME",
 		HTTPStatus: http.StatusForbidden,
 	}
+
+	ErrUnsupportedDocumentFormat = &DomainError{
+		Code:       "UNSUPPORTED_DOCUMENT_FORMAT",
+		Message:    "document format is not supported for text extraction",
+		HTTPStatus: http.StatusBadRequest,
+	}
 )
 
 // Target Audience errors
@@ -326,6 +364,18 @@ var (
 		HTTPStatus: http.StatusBadRequest,
 	}
 
+	ErrAIBaseURLInvalid = &DomainError{
+		Code:       "AI_BASE_URL_INVALID",
+		Message:    "self-hosted model base URL must be an absolute http(s) URL",
+		HTTPStatus: http.StatusBadRequest,
+	}
+
+	ErrAIModelRequired = &DomainError{
+		Code:       "AI_MODEL_REQUIRED",
+		Message:    "self-hosted model name is required",
+		HTTPStatus: http.StatusBadRequest,
+	}
+
 	ErrGenerationJobNotFound = &DomainError{
 		Code:       "GENERATION_JOB_NOT_FOUND",
 		Message:    "generation job not found",
@@ -347,7 +397,7 @@ var (
 	ErrTokenLimitExceeded = &DomainError{
 		Code:       "AI_TOKEN_LIMIT_EXCEEDED",
 		Message:    "monthly token limit exceeded",
-		HTTPStatus: http.StatusForbidden,
+		HTTPStatus: http.StatusTooManyRequests,
 	}
 )
 
@@ -375,6 +425,39 @@ var (
 	}
 )
 
+// Export errors
+var (
+	ErrExportNotFound = &DomainError{
+		Code:       "EXPORT_NOT_FOUND",
+		Message:    "export not found",
+		HTTPStatus: http.StatusNotFound,
+	}
+
+	ErrExportNotReady = &DomainError{
+		Code:       "EXPORT_NOT_READY",
+		Message:    "export has not completed yet",
+		HTTPStatus: http.StatusPreconditionFailed,
+	}
+
+	ErrExportFormatUnsupported = &DomainError{
+		Code:       "EXPORT_FORMAT_UNSUPPORTED",
+		Message:    "export format is not supported",
+		HTTPStatus: http.StatusBadRequest,
+	}
+
+	ErrLRSEndpointInvalid = &DomainError{
+		Code:       "LRS_ENDPOINT_INVALID",
+		Message:    "LRS endpoint must be an absolute http(s) URL",
+		HTTPStatus: http.StatusBadRequest,
+	}
+
+	ErrLRSCredentialsRequired = &DomainError{
+		Code:       "LRS_CREDENTIALS_REQUIRED",
+		Message:    "LRS key and secret are required",
+		HTTPStatus: http.StatusBadRequest,
+	}
+)
+
 // IsDomainError checks if an error is a DomainError.
 func IsDomainError(err error) bool {
 	var domainErr *DomainError
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // TenantAISettingsRepository defines the interface for tenant AI settings data access.
@@ -23,6 +25,43 @@ type TenantAISettingsRepository interface {
 	IncrementTokenUsage(ctx context.Context, tenantID uuid.UUID, tokens int64) error
 }
 
+// TokenUsageRepository defines the interface for the AI token usage ledger.
+// Months are identified by their first day, as returned by entity.UsageMonthStart.
+type TokenUsageRepository interface {
+	// Record appends a usage entry to the ledger.
+	Record(ctx context.Context, entry *entity.TokenUsageEntry) error
+
+	// GetMonthTotal returns the tokens a tenant used in a month.
+	GetMonthTotal(ctx context.Context, tenantID uuid.UUID, month time.Time) (int64, error)
+
+	// ListDailyUsage returns per-day totals for a month, ordered by date.
+	// Days without usage are omitted.
+	ListDailyUsage(ctx context.Context, tenantID uuid.UUID, month time.Time) ([]entity.DailyTokenUsage, error)
+
+	// ListUsageByJobType returns per-job-type totals for a month.
+	ListUsageByJobType(ctx context.Context, tenantID uuid.UUID, month time.Time) ([]entity.TokenUsageByType, error)
+
+	// GetAverageJobTokens returns the mean tokens used by the tenant's most
+	// recent jobs of a type, or 0 when there is no history.
+	GetAverageJobTokens(ctx context.Context, tenantID uuid.UUID, jobType valueobject.GenerationJobType, sampleSize int) (int64, error)
+}
+
+// TenantLRSSettingsRepository defines the interface for tenant LRS settings data access.
+type TenantLRSSettingsRepository interface {
+	// Get retrieves LRS settings for a tenant.
+	// Returns (nil, nil) if settings don't exist yet.
+	Get(ctx context.Context, tenantID uuid.UUID) (*entity.TenantLRSSettings, error)
+
+	// Create creates new LRS settings for a tenant.
+	Create(ctx context.Context, settings *entity.TenantLRSSettings) error
+
+	// Update updates LRS settings.
+	Update(ctx context.Context, settings *entity.TenantLRSSettings) error
+
+	// Delete removes LRS settings for a tenant.
+	Delete(ctx context.Context, tenantID uuid.UUID) error
+}
+
 // GenerationJobRepository defines the interface for generation job data access.
 type GenerationJobRepository interface {
 	// Create creates a new job.
@@ -41,15 +80,30 @@ type GenerationJobRepository interface {
 	// Update updates a job.
 	Update(ctx context.Context, job *entity.GenerationJob) error
 
-	// GetNextQueued atomically claims the next queued job for processing.
+	// GetNextQueued atomically claims the next queued job of the given types for processing.
+	// Jobs are taken fairly across tenants, skipping tenants at their concurrency limit.
 	// Updates status to 'processing' and sets started_at in one atomic operation.
-	GetNextQueued(ctx context.Context) (*entity.GenerationJob, error)
+	GetNextQueued(ctx context.Context, types []valueobject.GenerationJobType) (*entity.GenerationJob, error)
 
 	// ClaimJobByID atomically claims a specific job by ID for processing.
-	// Returns the job if successfully claimed, nil if already processed/claimed.
+	// Returns the job if successfully claimed, nil if already processed/claimed
+	// or its tenant is at its concurrency limit.
 	// Updates status to 'processing' and sets started_at in one atomic operation.
 	ClaimJobByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error)
 
+	// PeekNextQueued returns the queued job the next fair claim would take, without claiming it.
+	// Returns nil if no tenant with queued jobs has a free slot.
+	PeekNextQueued(ctx context.Context) (*entity.GenerationJob, error)
+
+	// GetQueuePosition returns where a queued job stands among all queued jobs.
+	// Returns nil if the job is not queued.
+	GetQueuePosition(ctx context.Context, id uuid.UUID) (*entity.GenerationJobQueuePosition, error)
+
+	// RequeueStaleJobs recovers jobs left 'processing' past the stale timeout by a
+	// worker that stopped: those with retries left are queued again with
+	// RetryCount increased, the rest fail. Returns the jobs it changed.
+	RequeueStaleJobs(ctx context.Context) ([]*entity.GenerationJob, error)
+
 	// ListByParentID retrieves all child jobs for a parent job.
 	ListByParentID(ctx context.Context, parentID uuid.UUID) ([]*entity.GenerationJob, error)
 
@@ -161,6 +215,21 @@ type GeneratedLessonRepository interface {
 
 	// Update updates a lesson.
 	Update(ctx context.Context, lesson *entity.GeneratedLesson) error
+
+	// ListStaleCandidates retrieves lessons not yet flagged stale that cite
+	// knowledge of their course's SMEs changed after both the lesson was
+	// generated and since. Runs across tenants when called as superadmin.
+	ListStaleCandidates(ctx context.Context, since time.Time) ([]*entity.GeneratedLesson, error)
+
+	// ListStaleByCourseID retrieves the stale lessons of a course.
+	ListStaleByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.GeneratedLesson, error)
+
+	// MarkStale flags a lesson as out of date.
+	MarkStale(ctx context.Context, id uuid.UUID, reason string) error
+
+	// DeleteStaleByOutlineLessonID deletes the stale lessons generated for an
+	// outline lesson, once a fresh one has replaced them.
+	DeleteStaleByOutlineLessonID(ctx context.Context, outlineLessonID uuid.UUID) error
 }
 
 // LessonComponentRepository defines the interface for lesson component data access.
@@ -174,6 +243,9 @@ type LessonComponentRepository interface {
 	// ListByLessonID retrieves all components for a lesson.
 	ListByLessonID(ctx context.Context, lessonID uuid.UUID) ([]*entity.LessonComponent, error)
 
+	// ListBySMEChunkIDs retrieves all components citing any of the given SME knowledge chunks.
+	ListBySMEChunkIDs(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.LessonComponent, error)
+
 	// Update updates a component.
 	Update(ctx context.Context, component *entity.LessonComponent) error
 
@@ -59,12 +59,31 @@ type CompanyRepository interface {
 	// GetByStripeCustomerID retrieves a company by its Stripe customer ID.
 	GetByStripeCustomerID(ctx context.Context, stripeCustomerID string) (*entity.Company, error)
 
+	// GetByTenantID retrieves the company of a tenant.
+	GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*entity.Company, error)
+
 	// Update updates a company.
 	Update(ctx context.Context, company *entity.Company) error
 
 	// UpdateStripeFields updates only Stripe-related fields.
 	UpdateStripeFields(ctx context.Context, id uuid.UUID, fields entity.StripeFields) error
 
+	// UpdateDunning updates only dunning fields.
+	UpdateDunning(ctx context.Context, id uuid.UUID, fields entity.DunningFields) error
+
+	// ListInDunning lists companies with an overdue payment being followed up.
+	ListInDunning(ctx context.Context) ([]*entity.Company, error)
+
+	// ClaimSubscriptionEvent records that a subscription event created at the given time
+	// is being applied. It returns false if a newer subscription event was already applied.
+	ClaimSubscriptionEvent(ctx context.Context, id uuid.UUID, createdAt time.Time) (bool, error)
+
+	// UpdateBillingEmail updates the billing email of the Stripe customer.
+	UpdateBillingEmail(ctx context.Context, id uuid.UUID, email *string) error
+
+	// UpdateEntitlementOverrides updates the company's enterprise entitlement overrides.
+	UpdateEntitlementOverrides(ctx context.Context, id uuid.UUID, overrides *entity.EntitlementOverrides) error
+
 	// CountUsersByCompanyID counts the number of users in a company.
 	CountUsersByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error)
 
@@ -159,3 +178,28 @@ type PendingRegistrationRepository interface {
 	// ExistsByEmail checks if a pending registration exists for the given email.
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
 }
+
+// StripeWebhookEventRepository defines the interface for stored Stripe webhook events.
+// Note: All methods require superadmin context as events are platform-wide.
+type StripeWebhookEventRepository interface {
+	// Claim stores a newly delivered event as processing, or takes over an event whose
+	// earlier delivery failed or has been processing for longer than staleAfter.
+	// It returns false, leaving the event untouched, if the event was already applied
+	// or another delivery is applying it. A claimed event is loaded with its stored state.
+	Claim(ctx context.Context, event *entity.StripeWebhookEvent, staleAfter time.Duration) (bool, error)
+
+	// ClaimReplay marks a stored event as processing for a replay, unless another
+	// delivery or replay started applying it less than staleAfter ago, or it was
+	// already processed and includeProcessed is false. It returns nil if the event
+	// does not exist or may not be taken over.
+	ClaimReplay(ctx context.Context, id string, includeProcessed bool, staleAfter time.Duration) (*entity.StripeWebhookEvent, error)
+
+	// GetByID retrieves an event by its Stripe event ID.
+	GetByID(ctx context.Context, id string) (*entity.StripeWebhookEvent, error)
+
+	// List retrieves events, most recently received first.
+	List(ctx context.Context, opts entity.StripeWebhookEventListOptions) ([]*entity.StripeWebhookEvent, error)
+
+	// Update updates an event's status, attempts and error.
+	Update(ctx context.Context, event *entity.StripeWebhookEvent) error
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// CourseExportRepository defines the interface for course export data access.
+type CourseExportRepository interface {
+	// Create creates a new export record.
+	Create(ctx context.Context, export *entity.CourseExport) error
+
+	// GetByID retrieves an export by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseExport, error)
+
+	// ListByCourseID retrieves all exports for a course, newest first.
+	ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseExport, error)
+
+	// Update updates an export record.
+	Update(ctx context.Context, export *entity.CourseExport) error
+
+	// ClaimByID atomically transitions a pending export to processing. An
+	// export left processing for longer than staleAfter is taken over.
+	// Returns nil if the export doesn't exist or is not claimable.
+	ClaimByID(ctx context.Context, id uuid.UUID, staleAfter time.Duration) (*entity.CourseExport, error)
+
+	// ListStale retrieves exports left processing for longer than staleAfter,
+	// whose worker stopped responding. Runs across tenants.
+	ListStale(ctx context.Context, staleAfter time.Duration) ([]*entity.CourseExport, error)
+}
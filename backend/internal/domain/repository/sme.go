@@ -18,6 +18,9 @@ type SMERepository interface {
 	// List retrieves SMEs with optional filtering.
 	List(ctx context.Context, opts entity.SMEListOptions) ([]*entity.SubjectMatterExpert, error)
 
+	// CountActiveByCompanyID counts the company's SMEs that aren't archived.
+	CountActiveByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error)
+
 	// Update updates an SME.
 	Update(ctx context.Context, sme *entity.SubjectMatterExpert) error
 
@@ -66,6 +69,9 @@ type SMESubmissionRepository interface {
 	// ListByTaskID retrieves all submissions for a task.
 	ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.SMETaskSubmission, error)
 
+	// ListBySMEID retrieves all submissions across an SME's tasks, newest first.
+	ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMETaskSubmission, error)
+
 	// Update updates a submission (e.g., after processing).
 	Update(ctx context.Context, submission *entity.SMETaskSubmission) error
 }
@@ -81,8 +87,24 @@ type SMEKnowledgeRepository interface {
 	// ListBySMEID retrieves all chunks for an SME.
 	ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error)
 
-	// Search searches knowledge across SMEs.
-	Search(ctx context.Context, smeIDs []uuid.UUID, query string, limit int) ([]*entity.SMEKnowledgeChunk, error)
+	// ListTopBySMEIDs retrieves at most limit chunks across the given SMEs,
+	// highest relevance score first.
+	ListTopBySMEIDs(ctx context.Context, smeIDs []uuid.UUID, limit int) ([]*entity.SMEKnowledgeChunk, error)
+
+	// ListByIDs retrieves the chunks with the given IDs. Missing IDs are skipped.
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.SMEKnowledgeChunk, error)
+
+	// ListBySubmissionID retrieves all chunks created from a submission.
+	ListBySubmissionID(ctx context.Context, submissionID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error)
+
+	// Search searches knowledge across SMEs with hybrid ranking: cosine
+	// similarity to queryEmbedding blended with keyword matches and relevance
+	// score. Only chunks embedded by embeddingModel are compared with
+	// queryEmbedding. A nil queryEmbedding falls back to keyword matching only.
+	Search(ctx context.Context, smeIDs []uuid.UUID, query string, queryEmbedding []float32, embeddingModel string, limit int) ([]*entity.SMEKnowledgeChunk, error)
+
+	// UpdateEmbedding stores the embedding vector for a chunk and the model that computed it.
+	UpdateEmbedding(ctx context.Context, chunkID uuid.UUID, embedding []float32, embeddingModel string) error
 
 	// Update updates a knowledge chunk.
 	Update(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error
@@ -92,4 +114,29 @@ type SMEKnowledgeRepository interface {
 
 	// DeleteBySMEID deletes all chunks for an SME.
 	DeleteBySMEID(ctx context.Context, smeID uuid.UUID) error
+
+	// DeleteBySubmissionID deletes all chunks created from a submission.
+	DeleteBySubmissionID(ctx context.Context, submissionID uuid.UUID) error
+
+	// Restore re-creates a deleted chunk under its original ID.
+	Restore(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error
+}
+
+// SMEKnowledgeVersionRepository defines the interface for knowledge chunk history.
+type SMEKnowledgeVersionRepository interface {
+	// Create records a version, assigning the chunk's next version number.
+	Create(ctx context.Context, version *entity.SMEKnowledgeChunkVersion) error
+
+	// ListByChunkID retrieves a chunk's versions, oldest first.
+	ListByChunkID(ctx context.Context, chunkID uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error)
+
+	// GetByChunkIDAndVersion retrieves a specific version of a chunk.
+	GetByChunkIDAndVersion(ctx context.Context, chunkID uuid.UUID, version int32) (*entity.SMEKnowledgeChunkVersion, error)
+
+	// ListByChunkIDs retrieves the versions of the given chunks, oldest first.
+	ListByChunkIDs(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error)
+
+	// ListDeletedBySMEID retrieves the last version of each deleted chunk of
+	// an SME that has not been restored, most recently deleted first.
+	ListDeletedBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error)
 }
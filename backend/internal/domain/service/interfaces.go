@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -93,11 +95,20 @@ type PaymentProvider interface {
 	// UpdateSubscriptionQuantity updates the seat count on a subscription.
 	UpdateSubscriptionQuantity(ctx context.Context, subscriptionID string, quantity int) error
 
+	// PreviewSubscriptionChange returns the prorated cost of changing a subscription's plan or seat count.
+	PreviewSubscriptionChange(ctx context.Context, change SubscriptionChange) (*SubscriptionChangePreview, error)
+
+	// ChangeSubscription changes a subscription's plan and seat count, prorating the current period.
+	ChangeSubscription(ctx context.Context, change SubscriptionChange) (*Subscription, error)
+
 	// GetCheckoutSession retrieves a checkout session by ID.
 	GetCheckoutSession(ctx context.Context, sessionID string) (*CheckoutSession, error)
 
 	// VerifyWebhook verifies a webhook signature and parses the event.
 	VerifyWebhook(payload []byte, signature string) (*WebhookEvent, error)
+
+	// ParseWebhookEvent parses a stored event that was verified when it was delivered.
+	ParseWebhookEvent(payload []byte) (*WebhookEvent, error)
 }
 
 // CreateCustomerRequest contains data for creating a Stripe customer.
@@ -109,7 +120,9 @@ type CreateCustomerRequest struct {
 
 // Customer represents a Stripe customer.
 type Customer struct {
-	ID string
+	ID    string
+	Email string
+	Name  string
 }
 
 // CheckoutRequest contains data for creating a checkout session.
@@ -150,17 +163,65 @@ type Subscription struct {
 	ItemID            string // First subscription item ID
 }
 
+// SubscriptionChange describes a plan or seat count change on an existing subscription.
+type SubscriptionChange struct {
+	SubscriptionID string
+	CustomerID     string
+	Plan           valueobject.Plan
+	SeatCount      int
+	ProrationDate  time.Time // Prorate as of this moment, so an applied change costs what its preview showed
+}
+
+// SubscriptionChangePreview is the invoice effect of a subscription change.
+type SubscriptionChangePreview struct {
+	ProratedAmount    int64 // Net proration for the rest of the period in the smallest currency unit, negative for a credit
+	NextInvoiceAmount int64 // Total of the next invoice, including the proration
+	NextInvoiceAt     time.Time
+	Currency          string
+	ProrationDate     time.Time
+}
+
 // WebhookEvent represents a parsed Stripe webhook event.
 type WebhookEvent struct {
-	Type string
-	Data WebhookEventData
+	ID      string
+	Type    string
+	Created time.Time
+	Payload []byte // Full event JSON, stored for redelivery checks and replay
+	Data    WebhookEventData
 }
 
 // WebhookEventData contains the data for a webhook event.
+// Only the object matching the event type is set.
 type WebhookEventData struct {
 	Raw             []byte // Raw JSON for the event object
 	CheckoutSession *CheckoutSession
 	Subscription    *Subscription
+	Invoice         *Invoice
+	Customer        *Customer
+	Charge          *Charge
+}
+
+// Invoice represents a Stripe invoice.
+type Invoice struct {
+	ID               string
+	CustomerID       string
+	SubscriptionID   string // Empty for one-off invoices
+	Status           string
+	AmountDue        int64 // In the smallest currency unit
+	AmountPaid       int64
+	Currency         string
+	HostedInvoiceURL string
+}
+
+// Charge represents a Stripe charge.
+type Charge struct {
+	ID             string
+	CustomerID     string
+	InvoiceID      string
+	Amount         int64 // In the smallest currency unit
+	AmountRefunded int64
+	Currency       string
+	Refunded       bool // Fully refunded
 }
 
 // Logger abstracts structured logging operations.
@@ -229,6 +290,9 @@ type EmailProvider interface {
 	// SendCourseComplete sends a notification when full course generation is complete.
 	SendCourseComplete(ctx context.Context, req SendCourseCompleteRequest) error
 
+	// SendPaymentReminder sends an overdue payment reminder to a company owner.
+	SendPaymentReminder(ctx context.Context, req SendPaymentReminderRequest) error
+
 	// SendAlert sends an administrative alert email (e.g., for orphaned payments).
 	SendAlert(ctx context.Context, req SendAlertRequest) error
 }
@@ -320,6 +384,17 @@ type SendCourseCompleteRequest struct {
 	CourseURL            string
 }
 
+// SendPaymentReminderRequest contains data for overdue payment reminder email.
+type SendPaymentReminderRequest struct {
+	To          string
+	UserName    string
+	CompanyName string
+	Title       string // Stage headline, e.g. "Your payment is overdue"
+	Message     string // What happens next and when
+	BillingURL  string
+	InvoiceURL  string // Hosted page of the failed invoice; empty if unknown
+}
+
 // SendAlertRequest contains data for administrative alert emails.
 type SendAlertRequest struct {
 	Subject string
@@ -334,16 +409,72 @@ type AIProvider interface {
 	// GenerateLessonContent generates content for a single lesson.
 	GenerateLessonContent(ctx context.Context, req GenerateLessonRequest) (*GenerateLessonResult, error)
 
+	// StreamLessonContent generates content for a single lesson, calling
+	// onComponent with each component as soon as it is complete. An error
+	// from onComponent stops generation and is returned.
+	StreamLessonContent(ctx context.Context, req GenerateLessonRequest, onComponent func(LessonComponentResult) error) (*GenerateLessonResult, error)
+
 	// RegenerateComponent regenerates a single component with modifications.
 	RegenerateComponent(ctx context.Context, req RegenerateComponentRequest) (*RegenerateComponentResult, error)
 
 	// ProcessSMEContent processes and distills knowledge from SME submission.
 	ProcessSMEContent(ctx context.Context, req ProcessSMEContentRequest) (*ProcessSMEContentResult, error)
 
+	// SummarizeSMEKnowledge writes an SME's knowledge summary from its current chunks.
+	SummarizeSMEKnowledge(ctx context.Context, req SummarizeSMEKnowledgeRequest) (*SummarizeSMEKnowledgeResult, error)
+
 	// TestConnection tests if the API key is valid.
 	TestConnection(ctx context.Context) error
 }
 
+// UsageError is returned by AI calls that fail after the provider reported
+// token usage, such as a stream cut off halfway or a response that never
+// matched its schema. The tokens are billed all the same.
+type UsageError struct {
+	TokensUsed int64
+	Err        error
+}
+
+func (e *UsageError) Error() string { return e.Err.Error() }
+
+func (e *UsageError) Unwrap() error { return e.Err }
+
+// TokensUsedBy returns the tokens a failed AI call reported using, or zero.
+func TokensUsedBy(err error) int64 {
+	var usageErr *UsageError
+	if errors.As(err, &usageErr) {
+		return usageErr.TokensUsed
+	}
+	return 0
+}
+
+// AIProviderConfig identifies the AI provider a tenant generates with and the
+// decrypted credentials to use.
+type AIProviderConfig struct {
+	Provider valueobject.AIProvider
+	APIKey   string // May be empty for self-hosted servers without authentication
+	BaseURL  string // Self-hosted providers only
+	Model    string // Self-hosted providers only
+}
+
+// EmbeddingDimensions is the length of the embedding vectors stored for SME
+// knowledge chunks. Embedders must return vectors of exactly this length.
+const EmbeddingDimensions = 768
+
+// Embedder computes text embeddings for semantic search over SME knowledge.
+type Embedder interface {
+	// EmbedDocuments returns one embedding per text, in order, and the tokens
+	// the provider reported using.
+	EmbedDocuments(ctx context.Context, texts []string) ([][]float32, int64, error)
+
+	// EmbedQuery returns the embedding for a search query and the tokens used.
+	EmbedQuery(ctx context.Context, text string) ([]float32, int64, error)
+
+	// EmbeddingModel names the model that computes the embeddings. Embeddings
+	// of different models can't be compared.
+	EmbeddingModel() string
+}
+
 // GenerateOutlineRequest contains inputs for outline generation.
 type GenerateOutlineRequest struct {
 	CourseTitle       string
@@ -359,6 +490,8 @@ type SMEKnowledgeInput struct {
 	Domain     string
 	Summary    string
 	Chunks     []string // Knowledge chunks
+	ChunkIDs   []string // IDs of Chunks (same order), cited back in generated content
+	ChunkTimes []string // Recording position of Chunks (same order), e.g. "12:30"; empty if not from a recording
 	Keywords   []string // Combined keywords
 }
 
@@ -412,6 +545,10 @@ type GenerateLessonRequest struct {
 	NextLessonTitle    string  // For segue
 	IsLastInSection    bool
 	IsLastInCourse     bool
+
+	// Components an interrupted attempt already stored; only the rest of the
+	// lesson is generated, numbered from 1
+	CompletedComponents []LessonComponentResult
 }
 
 // GenerateLessonResult contains the generated lesson content.
@@ -423,9 +560,10 @@ type GenerateLessonResult struct {
 
 // LessonComponentResult represents a generated component.
 type LessonComponentResult struct {
-	Type        string // text, heading, image, quiz
-	Order       int
-	ContentJSON string // JSON-encoded content based on type
+	Type           string   // text, heading, image, quiz
+	Order          int
+	ContentJSON    string   // JSON-encoded content based on type
+	SourceChunkIDs []string // SME knowledge chunk IDs the component draws on
 }
 
 // RegenerateComponentRequest contains inputs for component regeneration.
@@ -457,12 +595,111 @@ type ProcessSMEContentResult struct {
 	TokensUsed int64
 }
 
+// SummarizeSMEKnowledgeRequest contains inputs for SME summary generation.
+type SummarizeSMEKnowledgeRequest struct {
+	SMEName   string
+	SMEDomain string
+	Topics    []string // Topic of each chunk (same order as Chunks)
+	Chunks    []string // Chunk content, most relevant first
+}
+
+// SummarizeSMEKnowledgeResult contains the generated SME summary.
+type SummarizeSMEKnowledgeResult struct {
+	Summary    string
+	TokensUsed int64
+}
+
 // SMEChunkResult represents a distilled knowledge chunk.
 type SMEChunkResult struct {
 	Content        string
 	Topic          string
 	Keywords       []string
 	RelevanceScore float32
+
+	// Where the chunk came from in the source document (zero values if unknown)
+	SourceHeading   string
+	SourcePage      int32
+	SourceTimestamp int32 // Seconds into the source recording
+}
+
+// DocumentExtractor extracts text from uploaded SME files, choosing a
+// TextExtractor by MIME type and magic bytes.
+type DocumentExtractor interface {
+	// Extract detects the file format and extracts its text.
+	// Returns domainerrors.ErrUnsupportedDocumentFormat if no extractor handles the file.
+	Extract(ctx context.Context, fileName string, content []byte) (*ExtractedDocument, error)
+}
+
+// TextExtractor extracts text from a single document format.
+type TextExtractor interface {
+	// MIMETypes returns the MIME types this extractor handles.
+	MIMETypes() []string
+
+	// Extract returns the document's text split into sections.
+	Extract(ctx context.Context, content []byte) ([]DocumentSection, error)
+}
+
+// ExtractedDocument contains the text extracted from an uploaded file.
+type ExtractedDocument struct {
+	MIMEType string
+	Sections []DocumentSection
+
+	// Text is the full document rendered with Markdown headings and
+	// [Page N] markers so downstream processing keeps the source structure.
+	Text string
+}
+
+// DocumentSection is a run of text under a single heading.
+type DocumentSection struct {
+	Heading string // Nearest heading, slide title or sheet name
+	Level   int    // Heading level (1-6), 0 for untitled text or a continued section
+	Page    int32  // Page or slide number (1-based), 0 if the format has no pages
+	Text    string
+}
+
+// Transcriber converts the speech in SME audio and video submissions to text.
+type Transcriber interface {
+	// Transcribe returns the timestamped transcript of a recording.
+	Transcribe(ctx context.Context, fileName string, content []byte) (*Transcript, error)
+}
+
+// Transcript contains the speech recognized in a recording.
+type Transcript struct {
+	Segments []TranscriptSegment
+
+	// Text is the full transcript with a [Time MM:SS] marker at the start of
+	// each paragraph so downstream processing keeps the recording position.
+	Text string
+}
+
+// TranscriptSegment is a span of recognized speech.
+type TranscriptSegment struct {
+	Start time.Duration // Offset from the start of the recording
+	End   time.Duration
+	Text  string
+}
+
+// WebCrawler fetches the web pages behind SME URL submissions.
+type WebCrawler interface {
+	// Crawl fetches the page at rawURL and the same-site pages it links to,
+	// up to the configured link depth. Pages disallowed by the site's
+	// robots.txt are never fetched.
+	Crawl(ctx context.Context, rawURL string) (*CrawlResult, error)
+}
+
+// CrawlResult contains the pages fetched for a URL submission.
+type CrawlResult struct {
+	URL       string // Submitted URL after redirects
+	FetchedAt time.Time
+	Pages     []CrawledPage // In crawl order; the submitted page comes first
+}
+
+// CrawledPage is a single fetched web page.
+type CrawledPage struct {
+	URL      string
+	Depth    int    // Link hops from the submitted page
+	MIMEType string // text/html or text/plain
+	Body     []byte // Response body, decoded to UTF-8
 }
 
 // ContentEnhancer abstracts AI content enhancement operations.
@@ -473,3 +710,77 @@ type ContentEnhancer interface {
 	// ImproveContent improves content by cleaning up, clarifying, and structuring.
 	ImproveContent(ctx context.Context, content string) (string, error)
 }
+
+// CourseExporter renders a generated course into a downloadable package.
+// Each implementation produces exactly one export format.
+type CourseExporter interface {
+	// Format returns the export format this exporter produces.
+	Format() valueobject.ExportFormat
+
+	// Export renders the course into a single file (document or archive).
+	Export(ctx context.Context, req CourseExportRequest) (*CourseExportResult, error)
+}
+
+// CourseExportRequest contains the course content to render.
+type CourseExportRequest struct {
+	ExportID    uuid.UUID
+	CourseID    uuid.UUID
+	TenantID    uuid.UUID
+	CourseTitle string
+	Version     int32
+	Sections    []ExportSection
+
+	// StatementForwarding is where packages that emit xAPI statements send a
+	// copy of each statement for the tenant's LRS, if one is configured.
+	StatementForwarding *StatementForwarding
+}
+
+// StatementForwarding is the Mirai endpoint that forwards a package's xAPI
+// statements to the tenant's LRS. Auth is a token scoped to the exported course,
+// so the package never holds the LRS credentials.
+type StatementForwarding struct {
+	Endpoint string // Base xAPI endpoint the package posts statements to
+	Auth     string // Authorization header value
+}
+
+// ExportSection is an outline section with its generated lessons, in order.
+type ExportSection struct {
+	ID          uuid.UUID
+	Title       string
+	Description string
+	Lessons     []ExportLesson
+}
+
+// ExportLesson is an outline lesson paired with its generated content.
+type ExportLesson struct {
+	ID                       uuid.UUID // Outline lesson ID
+	Title                    string
+	Description              string
+	LearningObjectives       []string
+	EstimatedDurationMinutes *int32
+	Components               []entity.LessonComponent // Ordered by position
+	SegueText                string
+}
+
+// CourseExportResult contains the rendered export file.
+type CourseExportResult struct {
+	FileName    string
+	ContentType string
+	Content     []byte
+}
+
+// LRSConfig contains the connection details for an xAPI Learning Record Store.
+type LRSConfig struct {
+	Endpoint string // Base xAPI endpoint, e.g. https://lrs.example.com/xapi/
+	Key      string // Basic auth username
+	Secret   string // Basic auth password
+}
+
+// LRSClient abstracts communication with an xAPI Learning Record Store.
+type LRSClient interface {
+	// TestConnection verifies the endpoint is reachable and accepts the credentials.
+	TestConnection(ctx context.Context, cfg LRSConfig) error
+
+	// SendStatements posts a statement or a JSON array of statements.
+	SendStatements(ctx context.Context, cfg LRSConfig, statements []byte) error
+}
@@ -6,7 +6,10 @@ import "fmt"
 type AIProvider string
 
 const (
-	AIProviderGemini AIProvider = "gemini"
+	AIProviderGemini           AIProvider = "gemini"
+	AIProviderOpenAI           AIProvider = "openai"
+	AIProviderAnthropic        AIProvider = "anthropic"
+	AIProviderOpenAICompatible AIProvider = "openai_compatible" // Self-hosted server (Ollama, vLLM, LM Studio)
 )
 
 func (p AIProvider) String() string {
@@ -15,12 +18,23 @@ func (p AIProvider) String() string {
 
 func (p AIProvider) IsValid() bool {
 	switch p {
-	case AIProviderGemini:
+	case AIProviderGemini, AIProviderOpenAI, AIProviderAnthropic, AIProviderOpenAICompatible:
 		return true
 	}
 	return false
 }
 
+// IsSelfHosted returns true if the provider runs at a tenant-configured base URL.
+func (p AIProvider) IsSelfHosted() bool {
+	return p == AIProviderOpenAICompatible
+}
+
+// SupportsEmbeddings returns true if the provider computes the embeddings used
+// for semantic knowledge search. Other providers search by keywords only.
+func (p AIProvider) SupportsEmbeddings() bool {
+	return p == AIProviderGemini || p == AIProviderOpenAI
+}
+
 func ParseAIProvider(str string) (AIProvider, error) {
 	p := AIProvider(str)
 	if !p.IsValid() {
@@ -38,6 +52,7 @@ const (
 	GenerationJobTypeLessonContent  GenerationJobType = "lesson_content"
 	GenerationJobTypeComponentRegen GenerationJobType = "component_regen"
 	GenerationJobTypeFullCourse     GenerationJobType = "full_course"
+	GenerationJobTypeStaleRegen     GenerationJobType = "stale_lesson_regen"
 )
 
 func (t GenerationJobType) String() string {
@@ -48,12 +63,18 @@ func (t GenerationJobType) IsValid() bool {
 	switch t {
 	case GenerationJobTypeSMEIngestion, GenerationJobTypeCourseOutline,
 		GenerationJobTypeLessonContent, GenerationJobTypeComponentRegen,
-		GenerationJobTypeFullCourse:
+		GenerationJobTypeFullCourse, GenerationJobTypeStaleRegen:
 		return true
 	}
 	return false
 }
 
+// IsParent reports whether jobs of this type track child lesson jobs
+// instead of generating content themselves.
+func (t GenerationJobType) IsParent() bool {
+	return t == GenerationJobTypeFullCourse || t == GenerationJobTypeStaleRegen
+}
+
 func ParseGenerationJobType(str string) (GenerationJobType, error) {
 	t := GenerationJobType(str)
 	if !t.IsValid() {
@@ -62,6 +83,18 @@ func ParseGenerationJobType(str string) (GenerationJobType, error) {
 	return t, nil
 }
 
+// GenerationJobPriority is the scheduling lane of a generation job.
+type GenerationJobPriority string
+
+const (
+	GenerationJobPriorityInteractive GenerationJobPriority = "interactive" // A user is waiting on the result
+	GenerationJobPriorityBulk        GenerationJobPriority = "bulk"        // Course-wide and background work
+)
+
+func (p GenerationJobPriority) String() string {
+	return string(p)
+}
+
 // GenerationJobStatus represents job state.
 type GenerationJobStatus string
 
@@ -94,6 +127,25 @@ func ParseGenerationJobStatus(str string) (GenerationJobStatus, error) {
 	return s, nil
 }
 
+// IsTerminal reports whether a job in this status will not change again.
+func (s GenerationJobStatus) IsTerminal() bool {
+	return s == GenerationJobStatusCompleted || s == GenerationJobStatusFailed || s == GenerationJobStatusCancelled
+}
+
+// GenerationJobEventType categorizes live updates about a running job.
+type GenerationJobEventType string
+
+const (
+	GenerationJobEventProgress     GenerationJobEventType = "progress"      // Progress percent or message changed
+	GenerationJobEventSectionReady GenerationJobEventType = "section_ready" // An outline section was stored
+	GenerationJobEventLessonReady  GenerationJobEventType = "lesson_ready"  // A lesson's content was stored
+	GenerationJobEventStatus       GenerationJobEventType = "status"        // The job completed, failed or was cancelled
+)
+
+func (t GenerationJobEventType) String() string {
+	return string(t)
+}
+
 // OutlineApprovalStatus for generated content review.
 type OutlineApprovalStatus string
 
@@ -0,0 +1,41 @@
+package valueobject
+
+import "fmt"
+
+// BackgroundTaskState is the state of a task in the background job queues.
+type BackgroundTaskState string
+
+const (
+	BackgroundTaskStatePending   BackgroundTaskState = "pending"   // Waiting for a worker
+	BackgroundTaskStateActive    BackgroundTaskState = "active"    // Being processed
+	BackgroundTaskStateScheduled BackgroundTaskState = "scheduled" // Enqueued to run later
+	BackgroundTaskStateRetry     BackgroundTaskState = "retry"     // Failed, waiting for its next automatic retry
+	BackgroundTaskStateArchived  BackgroundTaskState = "archived"  // Out of retries; only runs again when replayed
+	BackgroundTaskStateCompleted BackgroundTaskState = "completed" // Finished and kept for retention
+)
+
+func (s BackgroundTaskState) String() string {
+	return string(s)
+}
+
+func (s BackgroundTaskState) IsValid() bool {
+	switch s {
+	case BackgroundTaskStatePending, BackgroundTaskStateActive, BackgroundTaskStateScheduled,
+		BackgroundTaskStateRetry, BackgroundTaskStateArchived, BackgroundTaskStateCompleted:
+		return true
+	}
+	return false
+}
+
+// IsFailed reports whether a task in this state failed its last run.
+func (s BackgroundTaskState) IsFailed() bool {
+	return s == BackgroundTaskStateRetry || s == BackgroundTaskStateArchived
+}
+
+func ParseBackgroundTaskState(str string) (BackgroundTaskState, error) {
+	s := BackgroundTaskState(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid background task state: %s", str)
+	}
+	return s, nil
+}
@@ -0,0 +1,33 @@
+package valueobject
+
+import "fmt"
+
+// DunningStage is how far the follow-up on a past due subscription has gone.
+type DunningStage string
+
+const (
+	DunningStageNone       DunningStage = "none"       // Subscription paid up
+	DunningStageReminding  DunningStage = "reminding"  // Owners are reminded to settle the payment
+	DunningStageRestricted DunningStage = "restricted" // Costly features are paused
+	DunningStageSuspended  DunningStage = "suspended"  // Tenant suspended until the payment is settled
+)
+
+func (s DunningStage) String() string {
+	return string(s)
+}
+
+func (s DunningStage) IsValid() bool {
+	switch s {
+	case DunningStageNone, DunningStageReminding, DunningStageRestricted, DunningStageSuspended:
+		return true
+	}
+	return false
+}
+
+func ParseDunningStage(str string) (DunningStage, error) {
+	s := DunningStage(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid dunning stage: %s", str)
+	}
+	return s, nil
+}
@@ -0,0 +1,84 @@
+package valueobject
+
+import "fmt"
+
+// ExportFormat represents the package format produced by a course export.
+type ExportFormat string
+
+const (
+	ExportFormatSCORM12   ExportFormat = "scorm_12"
+	ExportFormatSCORM2004 ExportFormat = "scorm_2004"
+	ExportFormatXAPI      ExportFormat = "xapi"
+	ExportFormatPDF       ExportFormat = "pdf"
+)
+
+func (f ExportFormat) String() string {
+	return string(f)
+}
+
+// DisplayName returns the format name shown to users.
+func (f ExportFormat) DisplayName() string {
+	switch f {
+	case ExportFormatSCORM12:
+		return "SCORM 1.2"
+	case ExportFormatSCORM2004:
+		return "SCORM 2004"
+	case ExportFormatXAPI:
+		return "cmi5 (xAPI)"
+	case ExportFormatPDF:
+		return "PDF"
+	default:
+		return string(f)
+	}
+}
+
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatSCORM12, ExportFormatSCORM2004, ExportFormatXAPI, ExportFormatPDF:
+		return true
+	}
+	return false
+}
+
+func ParseExportFormat(str string) (ExportFormat, error) {
+	f := ExportFormat(str)
+	if !f.IsValid() {
+		return "", fmt.Errorf("invalid export format: %s", str)
+	}
+	return f, nil
+}
+
+// ExportStatus represents the state of a course export job.
+type ExportStatus string
+
+const (
+	ExportStatusPending    ExportStatus = "pending"
+	ExportStatusProcessing ExportStatus = "processing"
+	ExportStatusCompleted  ExportStatus = "completed"
+	ExportStatusFailed     ExportStatus = "failed"
+)
+
+func (s ExportStatus) String() string {
+	return string(s)
+}
+
+func (s ExportStatus) IsValid() bool {
+	switch s {
+	case ExportStatusPending, ExportStatusProcessing, ExportStatusCompleted, ExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func ParseExportStatus(str string) (ExportStatus, error) {
+	s := ExportStatus(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid export status: %s", str)
+	}
+	return s, nil
+}
+
+// IsTerminal returns true if the export has finished (successfully or not).
+func (s ExportStatus) IsTerminal() bool {
+	return s == ExportStatusCompleted || s == ExportStatusFailed
+}
@@ -25,6 +25,20 @@ func (p Plan) IsValid() bool {
 	return false
 }
 
+// DisplayName returns the plan name shown to users.
+func (p Plan) DisplayName() string {
+	switch p {
+	case PlanStarter:
+		return "Starter"
+	case PlanPro:
+		return "Pro"
+	case PlanEnterprise:
+		return "Enterprise"
+	default:
+		return string(p)
+	}
+}
+
 // RequiresPayment returns true if this plan requires Stripe checkout.
 func (p Plan) RequiresPayment() bool {
 	return p == PlanStarter || p == PlanPro
@@ -70,3 +84,23 @@ func ParsePlan(s string) (Plan, error) {
 func AllPlans() []Plan {
 	return []Plan{PlanStarter, PlanPro, PlanEnterprise}
 }
+
+// PlanFeature is a capability that only some plans include.
+type PlanFeature string
+
+const (
+	PlanFeatureSSO            PlanFeature = "sso"
+	PlanFeatureCustomBranding PlanFeature = "custom_branding"
+)
+
+// DisplayName returns the feature name shown to users.
+func (f PlanFeature) DisplayName() string {
+	switch f {
+	case PlanFeatureSSO:
+		return "single sign-on"
+	case PlanFeatureCustomBranding:
+		return "custom branding"
+	default:
+		return string(f)
+	}
+}
@@ -156,3 +156,33 @@ func ParseEnhanceType(str string) (EnhanceType, error) {
 	}
 	return e, nil
 }
+
+// KnowledgeChangeType describes a change recorded in a knowledge chunk's history.
+type KnowledgeChangeType string
+
+const (
+	KnowledgeChangeCreated  KnowledgeChangeType = "created"
+	KnowledgeChangeUpdated  KnowledgeChangeType = "updated"
+	KnowledgeChangeDeleted  KnowledgeChangeType = "deleted"
+	KnowledgeChangeRestored KnowledgeChangeType = "restored"
+)
+
+func (k KnowledgeChangeType) String() string {
+	return string(k)
+}
+
+func (k KnowledgeChangeType) IsValid() bool {
+	switch k {
+	case KnowledgeChangeCreated, KnowledgeChangeUpdated, KnowledgeChangeDeleted, KnowledgeChangeRestored:
+		return true
+	}
+	return false
+}
+
+func ParseKnowledgeChangeType(str string) (KnowledgeChangeType, error) {
+	k := KnowledgeChangeType(str)
+	if !k.IsValid() {
+		return "", fmt.Errorf("invalid knowledge change type: %s", str)
+	}
+	return k, nil
+}
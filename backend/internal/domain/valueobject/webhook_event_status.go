@@ -0,0 +1,39 @@
+package valueobject
+
+import "fmt"
+
+// WebhookEventStatus is the processing status of a stored Stripe webhook event.
+type WebhookEventStatus string
+
+const (
+	WebhookEventStatusProcessing WebhookEventStatus = "processing" // A delivery is being applied
+	WebhookEventStatusProcessed  WebhookEventStatus = "processed"  // Applied; redeliveries are skipped
+	WebhookEventStatusIgnored    WebhookEventStatus = "ignored"    // Nothing to apply, e.g. superseded by a newer event
+	WebhookEventStatusFailed     WebhookEventStatus = "failed"     // Applying failed; applied again on redelivery or replay
+)
+
+func (s WebhookEventStatus) String() string {
+	return string(s)
+}
+
+func (s WebhookEventStatus) IsValid() bool {
+	switch s {
+	case WebhookEventStatusProcessing, WebhookEventStatusProcessed,
+		WebhookEventStatusIgnored, WebhookEventStatusFailed:
+		return true
+	}
+	return false
+}
+
+// IsSettled reports whether the event needs no further delivery.
+func (s WebhookEventStatus) IsSettled() bool {
+	return s == WebhookEventStatusProcessed || s == WebhookEventStatusIgnored
+}
+
+func ParseWebhookEventStatus(str string) (WebhookEventStatus, error) {
+	s := WebhookEventStatus(str)
+	if !s.IsValid() {
+		return "", fmt.Errorf("invalid webhook event status: %s", str)
+	}
+	return s, nil
+}
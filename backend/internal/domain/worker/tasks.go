@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 
 	"github.com/hibiken/asynq"
+
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // Task type constants
@@ -15,13 +17,19 @@ const (
 	TypeSMEIngestion     = "sme:ingestion"
 	TypeAIGenerationPoll = "ai:generation:poll" // Scheduled polling task
 	TypeSMEIngestionPoll = "sme:ingestion:poll" // Scheduled polling task
+	TypeCourseExport     = "course:export"
+	TypeStaleLessonScan  = "lessons:stale-scan"     // Scheduled stale lesson detection
+	TypeDunningScan      = "billing:dunning-scan"   // Scheduled dunning of past due subscriptions
+	TypeExportRecovery   = "course:export-recovery" // Scheduled recovery of exports left by a stopped worker
 )
 
 // Queue names for priority handling
 const (
-	QueueCritical = "critical" // Provisioning tasks
-	QueueDefault  = "default"  // AI/SME tasks
-	QueueLow      = "low"      // Cleanup tasks
+	QueueCritical    = "critical"    // Provisioning tasks
+	QueueInteractive = "interactive" // AI jobs a user is waiting on
+	QueueDefault     = "default"     // Export and polling tasks
+	QueueBulk        = "bulk"        // Full course lesson jobs and SME ingestion
+	QueueLow         = "low"         // Cleanup tasks
 )
 
 // StripeProvisionPayload contains data for provisioning a new account after Stripe payment
@@ -42,6 +50,11 @@ type SMEIngestionPayload struct {
 	JobID string `json:"job_id"`
 }
 
+// CourseExportPayload contains data for course export jobs
+type CourseExportPayload struct {
+	ExportID string `json:"export_id"`
+}
+
 // NewStripeProvisionTask creates a new Stripe provisioning task
 func NewStripeProvisionTask(sessionID, customer, subscriptionID string) (*asynq.Task, error) {
 	payload, err := json.Marshal(StripeProvisionPayload{
@@ -60,8 +73,8 @@ func NewStripeReconcileTask() *asynq.Task {
 	return asynq.NewTask(TypeStripeReconcile, nil, asynq.Queue(QueueCritical), asynq.MaxRetry(1))
 }
 
-// NewAIGenerationTask creates a new AI generation task
-func NewAIGenerationTask(jobID, jobType string) (*asynq.Task, error) {
+// NewAIGenerationTask creates a new AI generation task in the queue of the job's priority lane
+func NewAIGenerationTask(jobID, jobType string, priority valueobject.GenerationJobPriority) (*asynq.Task, error) {
 	payload, err := json.Marshal(AIGenerationPayload{
 		JobID:   jobID,
 		JobType: jobType,
@@ -69,7 +82,11 @@ func NewAIGenerationTask(jobID, jobType string) (*asynq.Task, error) {
 	if err != nil {
 		return nil, err
 	}
-	return asynq.NewTask(TypeAIGeneration, payload, asynq.Queue(QueueDefault), asynq.MaxRetry(3)), nil
+	queue := QueueInteractive
+	if priority == valueobject.GenerationJobPriorityBulk {
+		queue = QueueBulk
+	}
+	return asynq.NewTask(TypeAIGeneration, payload, asynq.Queue(queue), asynq.MaxRetry(3)), nil
 }
 
 // NewSMEIngestionTask creates a new SME ingestion task
@@ -80,7 +97,19 @@ func NewSMEIngestionTask(jobID string) (*asynq.Task, error) {
 	if err != nil {
 		return nil, err
 	}
-	return asynq.NewTask(TypeSMEIngestion, payload, asynq.Queue(QueueDefault), asynq.MaxRetry(3)), nil
+	return asynq.NewTask(TypeSMEIngestion, payload, asynq.Queue(QueueBulk), asynq.MaxRetry(3)), nil
+}
+
+// NewCourseExportTask creates a new course export task.
+// A single retry is enough: exports are claimed atomically, so later attempts are no-ops.
+func NewCourseExportTask(exportID string) (*asynq.Task, error) {
+	payload, err := json.Marshal(CourseExportPayload{
+		ExportID: exportID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asynq.NewTask(TypeCourseExport, payload, asynq.Queue(QueueDefault), asynq.MaxRetry(1)), nil
 }
 
 // NewCleanupExpiredTask creates a new cleanup task (no payload needed)
@@ -93,7 +122,22 @@ func NewAIGenerationPollTask() *asynq.Task {
 	return asynq.NewTask(TypeAIGenerationPoll, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(1))
 }
 
+// NewStaleLessonScanTask creates a new stale lesson detection task (scheduled)
+func NewStaleLessonScanTask() *asynq.Task {
+	return asynq.NewTask(TypeStaleLessonScan, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
 // NewSMEIngestionPollTask creates a new SME ingestion polling task (scheduled)
 func NewSMEIngestionPollTask() *asynq.Task {
 	return asynq.NewTask(TypeSMEIngestionPoll, nil, asynq.Queue(QueueDefault), asynq.MaxRetry(1))
 }
+
+// NewDunningScanTask creates a new dunning task for past due subscriptions (scheduled)
+func NewDunningScanTask() *asynq.Task {
+	return asynq.NewTask(TypeDunningScan, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
+
+// NewExportRecoveryTask creates a new task recovering stale course exports (scheduled)
+func NewExportRecoveryTask() *asynq.Task {
+	return asynq.NewTask(TypeExportRecovery, nil, asynq.Queue(QueueLow), asynq.MaxRetry(1))
+}
@@ -163,6 +163,8 @@ func (c *GlobalCache) ReleaseLock(ctx context.Context, key string, lockID string
 // These keys are NOT tenant-scoped and should only be used for cross-tenant mappings.
 var GlobalCacheKeys = struct {
 	UserTenantMapping func(kratosID string) string
+	TenantStatus      func(tenantID string) string
 }{
 	UserTenantMapping: func(kratosID string) string { return "user:tenant:" + kratosID },
+	TenantStatus:      func(tenantID string) string { return "tenant:status:" + tenantID },
 }
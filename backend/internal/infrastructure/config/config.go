@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // Config holds application configuration.
@@ -55,11 +56,40 @@ type Config struct {
 	SMTPPassword string
 	AdminEmail   string // Email address for system alerts (e.g., orphaned payments)
 
+	// Platform administration
+	PlatformAdminIdentityIDs []string // Kratos identity IDs allowed to administer the platform; unset disables it
+
+	// Tenant suspension
+	SuspendedTenantAccess string // "read_only" lets suspended tenants read their data, "blocked" denies every RPC but billing (default: read_only)
+
+	// Dunning of past due subscriptions, in days since the first failed payment
+	DunningReminderDays      []int // Days reminder emails go out (default: 0,3,7)
+	DunningRestrictAfterDays int   // Days until costly features are paused (default: 7)
+	DunningSuspendAfterDays  int   // Days until the tenant is suspended (default: 14)
+
 	// Encryption
 	EncryptionKey string // 32-byte hex-encoded key for AES-256-GCM (API keys, etc.)
 
 	// Worker
 	StaleJobTimeoutMinutes int // Timeout in minutes before a processing job is considered stale (default: 30)
+
+	// Transcription (whisper.cpp) for SME audio/video submissions; disabled when no model is set
+	WhisperBinaryPath string
+	WhisperModelPath  string
+	WhisperLanguage   string
+	WhisperThreads    int
+	FFmpegPath        string
+
+	// URL ingestion for SME URL submissions
+	URLCrawlMaxDepth        int  // Same-site link hops followed from the submitted page (default: 1)
+	URLCrawlMaxPages        int  // Pages fetched per submission (default: 20)
+	URLCrawlAllowPrivateNet bool // Allow fetching private/loopback addresses (local-dev only)
+
+	// xAPI statement forwarding
+	LRSAllowPrivateNet bool // Allow LRS endpoints on private/loopback addresses (local-dev only)
+
+	// Self-hosted AI providers
+	SelfHostedAIAllowedNetworks []string // Internal networks (CIDR or IP) self-hosted model servers may be on; public addresses are always allowed
 }
 
 // Load loads configuration from environment variables.
@@ -102,10 +132,32 @@ func Load() (*Config, error) {
 		SMTPUsername: getEnv("SMTP_USERNAME", ""),
 		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
 		AdminEmail:   getEnv("ADMIN_EMAIL", "john@sogos.io"),
+		// Platform administration
+		PlatformAdminIdentityIDs: getEnvList("PLATFORM_ADMIN_IDENTITY_IDS", ""),
+		// Tenant suspension
+		SuspendedTenantAccess: getEnv("SUSPENDED_TENANT_ACCESS", "read_only"),
+		// Dunning
+		DunningReminderDays:      getEnvIntList("DUNNING_REMINDER_DAYS", "0,3,7"),
+		DunningRestrictAfterDays: getEnvInt("DUNNING_RESTRICT_AFTER_DAYS", 7),
+		DunningSuspendAfterDays:  getEnvInt("DUNNING_SUSPEND_AFTER_DAYS", 14),
 		// Encryption
 		EncryptionKey: getEnv("ENCRYPTION_KEY", ""),
 		// Worker
 		StaleJobTimeoutMinutes: getEnvInt("STALE_JOB_TIMEOUT_MINUTES", 30),
+		// Transcription
+		WhisperBinaryPath: getEnv("WHISPER_BINARY_PATH", "whisper-cli"),
+		WhisperModelPath:  getEnv("WHISPER_MODEL_PATH", ""),
+		WhisperLanguage:   getEnv("WHISPER_LANGUAGE", "auto"),
+		WhisperThreads:    getEnvInt("WHISPER_THREADS", 0),
+		FFmpegPath:        getEnv("FFMPEG_PATH", "ffmpeg"),
+		// URL ingestion
+		URLCrawlMaxDepth:        getEnvInt("URL_CRAWL_MAX_DEPTH", 1),
+		URLCrawlMaxPages:        getEnvInt("URL_CRAWL_MAX_PAGES", 20),
+		URLCrawlAllowPrivateNet: getEnv("URL_CRAWL_ALLOW_PRIVATE_NETWORKS", "false") == "true",
+		// xAPI statement forwarding
+		LRSAllowPrivateNet: getEnv("LRS_ALLOW_PRIVATE_NETWORKS", "false") == "true",
+		// Self-hosted AI providers
+		SelfHostedAIAllowedNetworks: getEnvList("SELF_HOSTED_AI_ALLOWED_NETWORKS", ""),
 	}, nil
 }
 
@@ -116,6 +168,28 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvList splits a comma-separated variable, skipping empty entries.
+func getEnvList(key, defaultValue string) []string {
+	var values []string
+	for _, value := range strings.Split(getEnv(key, defaultValue), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// getEnvIntList reads a comma-separated list of integers, skipping invalid entries.
+func getEnvIntList(key, defaultValue string) []int {
+	var values []int
+	for _, value := range getEnvList(key, defaultValue) {
+		if i, err := strconv.Atoi(value); err == nil {
+			values = append(values, i)
+		}
+	}
+	return values
+}
+
 func getEnvInt(key string, defaultValue int) int {
 	if value := os.Getenv(key); value != "" {
 		if i, err := strconv.Atoi(value); err == nil {
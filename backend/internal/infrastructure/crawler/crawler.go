@@ -0,0 +1,440 @@
+// Package crawler implements service.WebCrawler for SME URL submissions.
+package crawler
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/pkg/httputil"
+)
+
+const (
+	// DefaultMaxPages bounds how many pages one submission may fetch.
+	DefaultMaxPages = 20
+
+	// DefaultMaxPageBytes bounds the size of a single page.
+	DefaultMaxPageBytes = 5 << 20
+
+	// DefaultUserAgent identifies the crawler to sites and their robots.txt.
+	DefaultUserAgent = "MiraiBot/1.0"
+
+	// DefaultTimeout bounds each request, including robots.txt.
+	DefaultTimeout = 30 * time.Second
+
+	maxRedirects   = 5
+	maxRobotsBytes = 500 << 10 // RFC 9309 requires parsing at least 500 KiB
+	maxCrawlDelay  = 10 * time.Second
+)
+
+// Config configures the crawler.
+type Config struct {
+	MaxDepth     int   // Link hops followed from the submitted page; 0 fetches only that page
+	MaxPages     int   // Pages fetched per submission, including the submitted page
+	MaxPageBytes int64 // Larger pages are truncated
+	UserAgent    string
+	Timeout      time.Duration
+
+	// AllowPrivateNetworks permits fetching loopback and private addresses.
+	// Leave it off in production so submissions cannot reach internal services.
+	AllowPrivateNetworks bool
+}
+
+// Crawler implements service.WebCrawler over HTTP.
+type Crawler struct {
+	cfg       Config
+	transport *http.Transport
+}
+
+// NewCrawler creates a crawler. Zero limits use the package defaults.
+func NewCrawler(cfg Config) *Crawler {
+	if cfg.MaxDepth < 0 {
+		cfg.MaxDepth = 0
+	}
+	if cfg.MaxPages <= 0 {
+		cfg.MaxPages = DefaultMaxPages
+	}
+	if cfg.MaxPageBytes <= 0 {
+		cfg.MaxPageBytes = DefaultMaxPageBytes
+	}
+	if cfg.UserAgent == "" {
+		cfg.UserAgent = DefaultUserAgent
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if !cfg.AllowPrivateNetworks {
+		dialer = httputil.NewPublicDialer(nil)
+	}
+
+	return &Crawler{
+		cfg: cfg,
+		transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			MaxIdleConnsPerHost:   2,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+			ForceAttemptHTTP2:     true,
+		},
+	}
+}
+
+// crawl holds the state of a single Crawl call.
+type crawl struct {
+	*Crawler
+	client *http.Client
+	robots map[string]*robotsRules // By scheme and host
+	site   string                  // Host of the submitted page after redirects, without www.
+	last   time.Time               // When the previous page request was sent
+}
+
+// Crawl fetches the submitted page, then follows same-site links breadth
+// first until MaxDepth or MaxPages is reached. Only the submitted page must
+// succeed; linked pages that fail, are disallowed or are not text are skipped.
+func (c *Crawler) Crawl(ctx context.Context, rawURL string) (*service.CrawlResult, error) {
+	start, err := parseHTTPURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	cr := &crawl{Crawler: c, robots: make(map[string]*robotsRules)}
+	cr.client = &http.Client{
+		Transport: c.transport,
+		Timeout:   c.cfg.Timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+				return fmt.Errorf("redirect to unsupported scheme %q", req.URL.Scheme)
+			}
+			if req.URL.Path != "/robots.txt" && !cr.allowed(req.Context(), req.URL) {
+				return fmt.Errorf("redirect to %s is disallowed by robots.txt", req.URL)
+			}
+			return nil
+		},
+	}
+
+	if rules := cr.rulesFor(ctx, start); rules.err != nil {
+		return nil, fmt.Errorf("failed to fetch robots.txt for %s: %w", start.Host, rules.err)
+	}
+	if !cr.allowed(ctx, start) {
+		return nil, fmt.Errorf("%s is disallowed by robots.txt", start)
+	}
+
+	first, links, err := cr.fetch(ctx, start)
+	if err != nil {
+		return nil, err
+	}
+	if first == nil {
+		return nil, fmt.Errorf("%s is not a web page", start)
+	}
+
+	finalURL, _ := url.Parse(first.URL)
+	cr.site = siteHost(finalURL)
+	result := &service.CrawlResult{
+		URL:       first.URL,
+		FetchedAt: time.Now(),
+		Pages:     []service.CrawledPage{*first},
+	}
+
+	type queued struct {
+		u     *url.URL
+		depth int
+	}
+	seen := map[string]bool{start.String(): true, first.URL: true}
+	var queue []queued
+	enqueue := func(links []*url.URL, depth int) {
+		if depth > c.cfg.MaxDepth {
+			return
+		}
+		for _, link := range links {
+			if siteHost(link) != cr.site || seen[link.String()] {
+				continue
+			}
+			seen[link.String()] = true
+			queue = append(queue, queued{u: link, depth: depth})
+		}
+	}
+	enqueue(links, 1)
+
+	for len(queue) > 0 && len(result.Pages) < c.cfg.MaxPages {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		next := queue[0]
+		queue = queue[1:]
+
+		if !cr.allowed(ctx, next.u) {
+			continue
+		}
+		page, links, err := cr.fetch(ctx, next.u)
+		if err != nil || page == nil {
+			continue
+		}
+		// Redirects can land on a page that was already fetched
+		if page.URL != next.u.String() && seen[page.URL] {
+			continue
+		}
+		seen[page.URL] = true
+		page.Depth = next.depth
+		result.Pages = append(result.Pages, *page)
+		enqueue(links, next.depth+1)
+	}
+
+	return result, nil
+}
+
+// fetch downloads a page and returns it with the links it contains. Pages
+// that are not HTML or plain text are returned as nil without an error.
+func (cr *crawl) fetch(ctx context.Context, u *url.URL) (*service.CrawledPage, []*url.URL, error) {
+	if err := cr.wait(ctx, u); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", cr.cfg.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,text/plain;q=0.9")
+
+	resp, err := cr.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch %s: %w", u, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to fetch %s: %s", u, resp.Status)
+	}
+
+	mimeType, params, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	switch mimeType {
+	case "text/html", "application/xhtml+xml":
+		mimeType = "text/html"
+	case "text/plain":
+	default:
+		return nil, nil, nil
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, cr.cfg.MaxPageBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", u, err)
+	}
+
+	body, err := toUTF8(raw, mimeType, params["charset"])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode %s: %w", u, err)
+	}
+
+	page := &service.CrawledPage{
+		URL:      canonical(resp.Request.URL).String(),
+		MIMEType: mimeType,
+		Body:     body,
+	}
+
+	var links []*url.URL
+	if mimeType == "text/html" {
+		links = extractLinks(resp.Request.URL, body)
+	}
+	return page, links, nil
+}
+
+// wait honors the site's crawl delay between page requests.
+func (cr *crawl) wait(ctx context.Context, u *url.URL) error {
+	delay := cr.rulesFor(ctx, u).crawlDelay
+	if delay > maxCrawlDelay {
+		delay = maxCrawlDelay
+	}
+	if remaining := delay - time.Since(cr.last); !cr.last.IsZero() && remaining > 0 {
+		timer := time.NewTimer(remaining)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	cr.last = time.Now()
+	return nil
+}
+
+// allowed reports whether robots.txt permits fetching u.
+func (cr *crawl) allowed(ctx context.Context, u *url.URL) bool {
+	return cr.rulesFor(ctx, u).allowed(u.RequestURI())
+}
+
+// rulesFor returns the robots.txt rules for u's host, fetching them once per crawl.
+func (cr *crawl) rulesFor(ctx context.Context, u *url.URL) *robotsRules {
+	key := u.Scheme + "://" + u.Host
+	if rules, ok := cr.robots[key]; ok {
+		return rules
+	}
+	rules := cr.fetchRobots(ctx, key)
+	cr.robots[key] = rules
+	return rules
+}
+
+// fetchRobots downloads and parses robots.txt. A missing file allows
+// everything; an unreachable one or a server error disallows everything.
+func (cr *crawl) fetchRobots(ctx context.Context, origin string) *robotsRules {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, origin+"/robots.txt", nil)
+	if err != nil {
+		return unreachable(err)
+	}
+	req.Header.Set("User-Agent", cr.cfg.UserAgent)
+
+	resp, err := cr.client.Do(req)
+	if err != nil {
+		return unreachable(err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		content, err := io.ReadAll(io.LimitReader(resp.Body, maxRobotsBytes))
+		if err != nil {
+			return unreachable(err)
+		}
+		return parseRobots(content, productToken(cr.cfg.UserAgent))
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		return allowAll
+	default:
+		return unreachable(fmt.Errorf("server returned %s", resp.Status))
+	}
+}
+
+// extractLinks returns the absolute http(s) links on an HTML page, honoring
+// <base href> and skipping rel="nofollow" links. A robots meta tag with
+// nofollow suppresses all links.
+func extractLinks(pageURL *url.URL, body []byte) []*url.URL {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+
+	base := pageURL
+	var hrefs []string
+	nofollow := false
+
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.DataAtom {
+			case atom.Base:
+				if href := attr(n, "href"); href != "" {
+					if u, err := pageURL.Parse(href); err == nil {
+						base = u
+					}
+				}
+			case atom.Meta:
+				if strings.EqualFold(attr(n, "name"), "robots") &&
+					strings.Contains(strings.ToLower(attr(n, "content")), "nofollow") {
+					nofollow = true
+				}
+			case atom.A:
+				if !strings.Contains(strings.ToLower(attr(n, "rel")), "nofollow") {
+					if href := attr(n, "href"); href != "" {
+						hrefs = append(hrefs, href)
+					}
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	if nofollow {
+		return nil
+	}
+
+	links := make([]*url.URL, 0, len(hrefs))
+	for _, href := range hrefs {
+		u, err := base.Parse(strings.TrimSpace(href))
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		links = append(links, canonical(u))
+	}
+	return links
+}
+
+// toUTF8 decodes a page body using the declared or detected character set.
+func toUTF8(body []byte, mimeType, declared string) ([]byte, error) {
+	contentType := mimeType
+	if declared != "" {
+		contentType += "; charset=" + declared
+	}
+	r, err := charset.NewReader(bytes.NewReader(body), contentType)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// parseHTTPURL parses a submitted URL, which must be absolute http(s).
+func parseHTTPURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid URL %q: must be an absolute http or https URL", rawURL)
+	}
+	return canonical(u), nil
+}
+
+// canonical drops the fragment and credentials so one page has one key.
+func canonical(u *url.URL) *url.URL {
+	c := *u
+	c.Fragment = ""
+	c.RawFragment = ""
+	c.User = nil
+	if c.Path == "" {
+		c.Path = "/"
+	}
+	return &c
+}
+
+// siteHost returns the host that defines a site, treating www.example.com
+// and example.com as the same site.
+func siteHost(u *url.URL) string {
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// productToken returns the robots.txt product token of a user agent,
+// e.g. "MiraiBot" for "MiraiBot/1.0".
+func productToken(userAgent string) string {
+	token, _, _ := strings.Cut(userAgent, "/")
+	return strings.TrimSpace(token)
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,135 @@
+package crawler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// newTestSite serves pages by path; robots is served as /robots.txt when set.
+func newTestSite(t *testing.T, robots string, robotsStatus int, pages map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			if robotsStatus != http.StatusOK {
+				w.WriteHeader(robotsStatus)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte(robots))
+			return
+		}
+		body, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, ".pdf") {
+			w.Header().Set("Content-Type", "application/pdf")
+		} else {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func crawledPaths(t *testing.T, srv *httptest.Server, urls []string) []string {
+	t.Helper()
+	paths := make([]string, 0, len(urls))
+	for _, u := range urls {
+		paths = append(paths, strings.TrimPrefix(u, srv.URL))
+	}
+	return paths
+}
+
+func TestCrawlFollowsAllowedLinks(t *testing.T) {
+	srv := newTestSite(t, "User-agent: *\nDisallow: /private\n", http.StatusOK, map[string]string{
+		"/": `<html><body>
+			<a href="/a">A</a>
+			<a href="/private/b">B</a>
+			<a href="/c" rel="nofollow">C</a>
+			<a href="/doc.pdf">PDF</a>
+			<a href="https://elsewhere.example/">Elsewhere</a>
+		</body></html>`,
+		"/a":         `<html><body><a href="/a/deeper">Deeper</a></body></html>`,
+		"/a/deeper":  `<html><body>Too deep</body></html>`,
+		"/private/b": `<html><body>Private</body></html>`,
+		"/c":         `<html><body>Not followed</body></html>`,
+		"/doc.pdf":   `%PDF-1.4`,
+	})
+
+	c := NewCrawler(Config{MaxDepth: 1, AllowPrivateNetworks: true})
+	result, err := c.Crawl(context.Background(), srv.URL+"/")
+	if err != nil {
+		t.Fatalf("Crawl() error = %v", err)
+	}
+
+	var urls []string
+	for _, page := range result.Pages {
+		urls = append(urls, page.URL)
+	}
+	got := crawledPaths(t, srv, urls)
+	want := []string{"/", "/a"}
+	if !slices.Equal(got, want) {
+		t.Errorf("crawled %v, want %v", got, want)
+	}
+	if result.Pages[1].Depth != 1 {
+		t.Errorf("depth of /a = %d, want 1", result.Pages[1].Depth)
+	}
+}
+
+func TestCrawlHonorsMaxPages(t *testing.T) {
+	srv := newTestSite(t, "", http.StatusNotFound, map[string]string{
+		"/":  `<a href="/1">1</a><a href="/2">2</a><a href="/3">3</a>`,
+		"/1": `one`,
+		"/2": `two`,
+		"/3": `three`,
+	})
+
+	c := NewCrawler(Config{MaxDepth: 1, MaxPages: 2, AllowPrivateNetworks: true})
+	result, err := c.Crawl(context.Background(), srv.URL+"/")
+	if err != nil {
+		t.Fatalf("Crawl() error = %v", err)
+	}
+	if len(result.Pages) != 2 {
+		t.Errorf("crawled %d pages, want 2", len(result.Pages))
+	}
+}
+
+func TestCrawlRefusesDisallowedStart(t *testing.T) {
+	srv := newTestSite(t, "User-agent: MiraiBot\nDisallow: /\n", http.StatusOK, map[string]string{
+		"/": `<html></html>`,
+	})
+
+	c := NewCrawler(Config{AllowPrivateNetworks: true})
+	if _, err := c.Crawl(context.Background(), srv.URL+"/"); err == nil {
+		t.Fatal("Crawl() succeeded on a page disallowed by robots.txt")
+	}
+}
+
+func TestCrawlRefusesWhenRobotsUnavailable(t *testing.T) {
+	srv := newTestSite(t, "", http.StatusServiceUnavailable, map[string]string{
+		"/": `<html></html>`,
+	})
+
+	c := NewCrawler(Config{AllowPrivateNetworks: true})
+	if _, err := c.Crawl(context.Background(), srv.URL+"/"); err == nil {
+		t.Fatal("Crawl() succeeded although robots.txt returned a server error")
+	}
+}
+
+func TestCrawlRefusesPrivateNetworks(t *testing.T) {
+	srv := newTestSite(t, "", http.StatusNotFound, map[string]string{
+		"/": `<html></html>`,
+	})
+
+	c := NewCrawler(Config{})
+	if _, err := c.Crawl(context.Background(), srv.URL+"/"); err == nil {
+		t.Fatal("Crawl() fetched a loopback address")
+	}
+}
@@ -0,0 +1,159 @@
+package crawler
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// robotsRules are the robots.txt rules that apply to the crawler on one host,
+// interpreted as described in RFC 9309.
+type robotsRules struct {
+	rules      []robotsRule
+	disallowed bool          // Set when robots.txt could not be fetched; nothing may be crawled
+	crawlDelay time.Duration // Non-standard Crawl-delay, honored when present
+	err        error         // Why robots.txt could not be fetched
+}
+
+type robotsRule struct {
+	allow   bool
+	pattern string
+}
+
+// allowAll is used when a site has no robots.txt.
+var allowAll = &robotsRules{}
+
+// unreachable is used when a site's robots.txt cannot be fetched, which
+// disallows the whole site.
+func unreachable(err error) *robotsRules {
+	return &robotsRules{disallowed: true, err: err}
+}
+
+// parseRobots extracts the rules for agent from a robots.txt file. Groups
+// naming the agent take precedence over the * group; several matching groups
+// are merged.
+func parseRobots(content []byte, agent string) *robotsRules {
+	agent = strings.ToLower(agent)
+
+	var specific, wildcard robotsRules
+	var groupAgents []string
+	matchedAgent := false // Any group names the agent, even with no rules
+	inRules := false      // A rule line ends the user-agent lines of a group
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		if key == "user-agent" {
+			if inRules {
+				groupAgents = nil
+				inRules = false
+			}
+			groupAgents = append(groupAgents, strings.ToLower(value))
+			continue
+		}
+
+		inRules = true
+		var target *robotsRules
+		for _, ua := range groupAgents {
+			switch {
+			case ua == "*":
+				if target == nil {
+					target = &wildcard
+				}
+			case ua != "" && strings.HasPrefix(agent, ua):
+				target = &specific
+				matchedAgent = true
+			}
+		}
+		if target == nil {
+			continue
+		}
+
+		switch key {
+		case "allow", "disallow":
+			// An empty disallow allows everything, which is the default anyway
+			if value == "" {
+				continue
+			}
+			target.rules = append(target.rules, robotsRule{allow: key == "allow", pattern: value})
+		case "crawl-delay":
+			if seconds, err := strconv.ParseFloat(value, 64); err == nil && seconds > 0 {
+				target.crawlDelay = time.Duration(seconds * float64(time.Second))
+			}
+		}
+	}
+
+	if matchedAgent {
+		return &specific
+	}
+	return &wildcard
+}
+
+// allowed reports whether a path (including any query string) may be crawled.
+// The longest matching rule wins; allow wins a tie.
+func (r *robotsRules) allowed(path string) bool {
+	if r.disallowed {
+		return false
+	}
+	if path == "/robots.txt" {
+		return true
+	}
+
+	allow, matched := true, -1
+	for _, rule := range r.rules {
+		if !robotsMatch(rule.pattern, path) {
+			continue
+		}
+		length := len(rule.pattern)
+		if length > matched || (length == matched && rule.allow) {
+			allow, matched = rule.allow, length
+		}
+	}
+	return allow
+}
+
+// robotsMatch matches a path against a robots.txt pattern, where * matches
+// any sequence of characters and a trailing $ anchors the end of the path.
+func robotsMatch(pattern, path string) bool {
+	anchored := strings.HasSuffix(pattern, "$")
+	parts := strings.Split(strings.TrimSuffix(pattern, "$"), "*")
+
+	if anchored {
+		// The text after the last * must end the path; what precedes it is
+		// then an ordinary prefix match
+		last := parts[len(parts)-1]
+		if !strings.HasSuffix(path, last) {
+			return false
+		}
+		if len(parts) == 1 {
+			return path == last
+		}
+		path = path[:len(path)-len(last)]
+		parts = parts[:len(parts)-1]
+	}
+
+	if !strings.HasPrefix(path, parts[0]) {
+		return false
+	}
+	rest := path[len(parts[0]):]
+	for _, part := range parts[1:] {
+		i := strings.Index(rest, part)
+		if i < 0 {
+			return false
+		}
+		rest = rest[i+len(part):]
+	}
+	return true
+}
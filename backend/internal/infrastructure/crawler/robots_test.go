@@ -0,0 +1,186 @@
+package crawler
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRobotsMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/", "/anything", true},
+		{"/private", "/private", true},
+		{"/private", "/private/page", true},
+		{"/private", "/privateer", true},
+		{"/private", "/public", false},
+		{"/private/", "/private", false},
+		{"/*.php", "/index.php", true},
+		{"/*.php", "/dir/index.php?x=1", true},
+		{"/*.php", "/index.html", false},
+		{"/*.php$", "/index.php", true},
+		{"/*.php$", "/index.php?x=1", false},
+		{"/*.php$", "/index.phps", false},
+		{"/page$", "/page", true},
+		{"/page$", "/page/", false},
+		{"/a*b*c", "/axxbyyc", true},
+		{"/a*b*c", "/axxcyyb", false},
+		{"/a*a$", "/a", false},
+		{"/a*a$", "/aa", true},
+		{"*", "/", true},
+		{"/*?sort=", "/list?sort=name", true},
+	}
+	for _, tt := range tests {
+		if got := robotsMatch(tt.pattern, tt.path); got != tt.want {
+			t.Errorf("robotsMatch(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseRobotsGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		allowed map[string]bool
+	}{
+		{
+			name: "specific group takes precedence over wildcard",
+			content: `
+User-agent: *
+Disallow: /
+
+User-agent: MiraiBot
+Disallow: /private
+`,
+			allowed: map[string]bool{"/": true, "/docs": true, "/private": false},
+		},
+		{
+			name: "wildcard applies when no group names the agent",
+			content: `
+User-agent: OtherBot
+Disallow: /
+
+User-agent: *
+Disallow: /private
+`,
+			allowed: map[string]bool{"/docs": true, "/private": false},
+		},
+		{
+			name: "matching groups are merged",
+			content: `
+User-agent: miraibot
+Disallow: /a
+
+User-agent: *
+Disallow: /b
+
+User-agent: MiraiBot
+Disallow: /c
+`,
+			allowed: map[string]bool{"/a": false, "/b": true, "/c": false, "/d": true},
+		},
+		{
+			name: "user-agent lines before rules share the group",
+			content: `
+User-agent: OtherBot
+User-agent: MiraiBot
+Disallow: /shared
+`,
+			allowed: map[string]bool{"/shared": false, "/docs": true},
+		},
+		{
+			name: "empty group for the agent overrides wildcard",
+			content: `
+User-agent: *
+Disallow: /
+
+User-agent: MiraiBot
+Disallow:
+`,
+			allowed: map[string]bool{"/": true, "/docs": true},
+		},
+		{
+			name: "longest match wins",
+			content: `
+User-agent: *
+Disallow: /docs
+Allow: /docs/public
+`,
+			allowed: map[string]bool{"/docs": false, "/docs/private": false, "/docs/public/page": true},
+		},
+		{
+			name: "allow wins a tie",
+			content: `
+User-agent: *
+Disallow: /page
+Allow: /page
+`,
+			allowed: map[string]bool{"/page": true},
+		},
+		{
+			name: "comments and unknown lines are ignored",
+			content: `
+# Comment
+User-agent: * # everyone
+Sitemap: https://example.com/sitemap.xml
+Disallow: /tmp # scratch
+`,
+			allowed: map[string]bool{"/tmp/file": false, "/docs": true},
+		},
+		{
+			name: "robots.txt itself is always allowed",
+			content: `
+User-agent: *
+Disallow: /
+`,
+			allowed: map[string]bool{"/robots.txt": true, "/": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := parseRobots([]byte(tt.content), "miraibot")
+			for path, want := range tt.allowed {
+				if got := rules.allowed(path); got != want {
+					t.Errorf("allowed(%q) = %v, want %v", path, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseRobotsCrawlDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    time.Duration
+	}{
+		{"whole seconds", "User-agent: *\nCrawl-delay: 2\n", 2 * time.Second},
+		{"fractional seconds", "User-agent: *\nCrawl-delay: 0.5\n", 500 * time.Millisecond},
+		{"invalid value", "User-agent: *\nCrawl-delay: soon\n", 0},
+		{"negative value", "User-agent: *\nCrawl-delay: -1\n", 0},
+		{"specific group", "User-agent: *\nCrawl-delay: 5\n\nUser-agent: MiraiBot\nCrawl-delay: 1\n", time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRobots([]byte(tt.content), "miraibot").crawlDelay; got != tt.want {
+				t.Errorf("crawlDelay = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRobotsRulesDefaults(t *testing.T) {
+	if !allowAll.allowed("/anything") {
+		t.Error("allowAll disallowed a path")
+	}
+
+	rules := unreachable(errors.New("connection refused"))
+	for _, path := range []string{"/", "/robots.txt", "/docs"} {
+		if rules.allowed(path) {
+			t.Errorf("unreachable rules allowed %q", path)
+		}
+	}
+}
@@ -3,7 +3,9 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"errors"
 	"io"
@@ -91,6 +93,14 @@ func (e *Encryptor) DecryptString(ciphertext []byte) (string, error) {
 	return string(plaintext), nil
 }
 
+// DeriveKey derives a 32-byte key for a separate purpose, such as signing,
+// so the encryption key itself is never used for anything else.
+func (e *Encryptor) DeriveKey(purpose string) []byte {
+	mac := hmac.New(sha256.New, e.key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
 // GenerateKey generates a random 32-byte key and returns it hex-encoded.
 // Use this to generate a new encryption key for configuration.
 func GenerateKey() (string, error) {
@@ -0,0 +1,497 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// CMI5Exporter renders a course as a cmi5 package.
+// Sections become blocks and each generated lesson becomes an AU whose page
+// emits xAPI statements to the launching LMS and, when configured, to the
+// tenant's own LRS through Mirai's statement forwarding endpoint.
+type CMI5Exporter struct{}
+
+// NewCMI5Exporter creates a new CMI5Exporter.
+func NewCMI5Exporter() *CMI5Exporter {
+	return &CMI5Exporter{}
+}
+
+// Format returns the export format this exporter produces.
+func (e *CMI5Exporter) Format() valueobject.ExportFormat {
+	return valueobject.ExportFormatXAPI
+}
+
+// Export renders the course outline into cmi5.xml and one AU page per generated lesson.
+func (e *CMI5Exporter) Export(ctx context.Context, req service.CourseExportRequest) (*service.CourseExportResult, error) {
+	courseIRI := "urn:mirai:course:" + req.CourseID.String()
+	structure := cmi5Course{
+		ID:           courseIRI,
+		Title:        req.CourseTitle,
+		Description:  fmt.Sprintf("%s (version %d)", req.CourseTitle, req.Version),
+		PassingScore: scormPassingScore,
+	}
+
+	pkg := newZipPackage()
+
+	for _, section := range req.Sections {
+		block := cmi5Block{
+			ID:          courseIRI + ":section:" + section.ID.String(),
+			Title:       section.Title,
+			Description: fallback(section.Description, section.Title),
+		}
+
+		for _, lesson := range section.Lessons {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("cmi5 export cancelled: %w", ctx.Err())
+			default:
+			}
+
+			// Only generated lessons have content to deliver
+			if len(lesson.Components) == 0 {
+				continue
+			}
+
+			auID := courseIRI + ":lesson:" + lesson.ID.String()
+			href := fmt.Sprintf("lessons/%s/index.html", lesson.ID)
+			page, err := renderLessonPage(lessonPageData{
+				Runtime:      "cmi5",
+				Scripts:      []string{"lesson.js", "lrs-config.js", "cmi5-runtime.js"},
+				ActivityID:   auID,
+				PassingScore: scormPassingScore,
+				CourseTitle:  req.CourseTitle,
+				SectionTitle: section.Title,
+				Lesson:       lesson,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to render lesson %s: %w", lesson.ID, err)
+			}
+			if err := pkg.add(href, page); err != nil {
+				return nil, err
+			}
+
+			block.AUs = append(block.AUs, cmi5AU{
+				ID:            auID,
+				Title:         lesson.Title,
+				Description:   fallback(lesson.Description, lesson.Title),
+				URL:           href,
+				HasAssessment: lessonHasQuiz(lesson),
+			})
+		}
+
+		if len(block.AUs) > 0 {
+			structure.Blocks = append(structure.Blocks, block)
+		}
+	}
+
+	if len(structure.Blocks) == 0 {
+		return nil, fmt.Errorf("course has no generated lessons to export")
+	}
+
+	var courseXML bytes.Buffer
+	if err := cmi5Template.Execute(&courseXML, structure); err != nil {
+		return nil, fmt.Errorf("failed to render cmi5.xml: %w", err)
+	}
+	if err := pkg.add("cmi5.xml", courseXML.Bytes()); err != nil {
+		return nil, err
+	}
+
+	lrsConfig, err := lrsConfigJS(req.StatementForwarding)
+	if err != nil {
+		return nil, err
+	}
+	if err := pkg.add("shared/lrs-config.js", lrsConfig); err != nil {
+		return nil, err
+	}
+	if err := pkg.add("shared/cmi5-runtime.js", []byte(cmi5RuntimeJS)); err != nil {
+		return nil, err
+	}
+	if err := pkg.addLessonAssets(); err != nil {
+		return nil, err
+	}
+
+	content, err := pkg.close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.CourseExportResult{
+		FileName:    slugify(req.CourseTitle) + "-cmi5.zip",
+		ContentType: "application/zip",
+		Content:     content,
+	}, nil
+}
+
+// lrsConfigJS renders the endpoint the runtime forwards statements to. It holds
+// a token scoped to the exported course, never the tenant's LRS credentials.
+func lrsConfigJS(forwarding *service.StatementForwarding) ([]byte, error) {
+	config := []byte("null")
+	if forwarding != nil && forwarding.Endpoint != "" {
+		var err error
+		config, err = json.Marshal(map[string]string{
+			"endpoint": forwarding.Endpoint,
+			"auth":     forwarding.Auth,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode LRS settings: %w", err)
+		}
+	}
+	return []byte(fmt.Sprintf("window.MIRAI_LRS = %s;\n", config)), nil
+}
+
+func fallback(value, def string) string {
+	if value != "" {
+		return value
+	}
+	return def
+}
+
+// Course structure
+
+type cmi5Course struct {
+	ID           string
+	Title        string
+	Description  string
+	PassingScore int
+	Blocks       []cmi5Block
+}
+
+type cmi5Block struct {
+	ID          string
+	Title       string
+	Description string
+	AUs         []cmi5AU
+}
+
+type cmi5AU struct {
+	ID            string
+	Title         string
+	Description   string
+	URL           string
+	HasAssessment bool
+}
+
+var cmi5Template = template.Must(template.New("cmi5").Funcs(manifestFuncs).Parse(`<?xml version="1.0" encoding="utf-8"?>
+<courseStructure xmlns="https://w3id.org/xapi/profiles/cmi5/v1/CourseStructure.xsd">
+  <course id="{{xml .ID}}">
+    <title><langstring lang="en-US">{{xml .Title}}</langstring></title>
+    <description><langstring lang="en-US">{{xml .Description}}</langstring></description>
+  </course>
+{{- range .Blocks}}
+  <block id="{{xml .ID}}">
+    <title><langstring lang="en-US">{{xml .Title}}</langstring></title>
+    <description><langstring lang="en-US">{{xml .Description}}</langstring></description>
+{{- range .AUs}}
+{{- if .HasAssessment}}
+    <au id="{{xml .ID}}" moveOn="CompletedAndPassed" masteryScore="{{scaled $.PassingScore}}" launchMethod="AnyWindow">
+{{- else}}
+    <au id="{{xml .ID}}" moveOn="Completed" launchMethod="AnyWindow">
+{{- end}}
+      <title><langstring lang="en-US">{{xml .Title}}</langstring></title>
+      <description><langstring lang="en-US">{{xml .Description}}</langstring></description>
+      <url>{{xml .URL}}</url>
+    </au>
+{{- end}}
+  </block>
+{{- end}}
+</courseStructure>
+`))
+
+// cmi5RuntimeJS implements the AU side of the cmi5 launch protocol and emits
+// xAPI statements: initialized, answered per quiz, completed, passed/failed
+// and terminated. Every statement is also sent to window.MIRAI_LRS, which
+// forwards it to the tenant's LRS.
+const cmi5RuntimeJS = `(function () {
+  "use strict";
+
+  var XAPI_VERSION = "1.0.3";
+  var CMI5_CATEGORY = { id: "https://w3id.org/xapi/cmi5/context/categories/cmi5" };
+  var MOVEON_CATEGORY = { id: "https://w3id.org/xapi/cmi5/context/categories/moveon" };
+  var MASTERY_SCORE_EXTENSION = "https://w3id.org/xapi/cmi5/context/extensions/masteryscore";
+  var VERBS = {
+    initialized: "http://adlnet.gov/expapi/verbs/initialized",
+    answered: "http://adlnet.gov/expapi/verbs/answered",
+    completed: "http://adlnet.gov/expapi/verbs/completed",
+    passed: "http://adlnet.gov/expapi/verbs/passed",
+    failed: "http://adlnet.gov/expapi/verbs/failed",
+    terminated: "http://adlnet.gov/expapi/verbs/terminated"
+  };
+
+  var root = document.documentElement;
+  var params = new URLSearchParams(window.location.search);
+  var startedAt = Date.now();
+
+  var session = {
+    lrs: [],
+    actor: null,
+    activityId: params.get("activityId") || root.getAttribute("data-activity-id"),
+    registration: params.get("registration") || null,
+    contextTemplate: {},
+    launchMode: "Normal",
+    masteryScore: (parseFloat(root.getAttribute("data-passing-score")) || 80) / 100,
+    ready: false,
+    completed: false,
+    judged: false,
+    terminated: false
+  };
+
+  var results = {};
+
+  function uuid() {
+    if (window.crypto && window.crypto.randomUUID) {
+      return window.crypto.randomUUID();
+    }
+    return "xxxxxxxx-xxxx-4xxx-yxxx-xxxxxxxxxxxx".replace(/[xy]/g, function (c) {
+      var r = (Math.random() * 16) | 0;
+      return (c === "x" ? r : (r & 0x3) | 0x8).toString(16);
+    });
+  }
+
+  function duration() {
+    return "PT" + ((Date.now() - startedAt) / 1000).toFixed(2) + "S";
+  }
+
+  function request(lrs, method, resource, body, keepalive) {
+    return fetch(lrs.endpoint.replace(/\/?$/, "/") + resource, {
+      method: method,
+      headers: {
+        "Authorization": lrs.auth,
+        "X-Experience-API-Version": XAPI_VERSION,
+        "Content-Type": "application/json"
+      },
+      body: body ? JSON.stringify(body) : undefined,
+      keepalive: !!keepalive
+    });
+  }
+
+  function anonymousActor() {
+    var name;
+    try {
+      name = window.localStorage.getItem("mirai.xapi.learner");
+      if (!name) {
+        name = uuid();
+        window.localStorage.setItem("mirai.xapi.learner", name);
+      }
+    } catch (e) {
+      name = uuid();
+    }
+    var homePage = window.location.origin.indexOf("http") === 0 ? window.location.origin : "urn:mirai:learner";
+    return { objectType: "Agent", account: { homePage: homePage, name: name } };
+  }
+
+  // launch performs the cmi5 handshake when started by a cmi5 LMS:
+  // exchange the fetch URL for an auth token, then read LMS.LaunchData.
+  function launch() {
+    var endpoint = params.get("endpoint");
+    var fetchURL = params.get("fetch");
+    var actor = params.get("actor");
+
+    if (actor) {
+      try {
+        session.actor = JSON.parse(actor);
+      } catch (e) {
+        session.actor = null;
+      }
+    }
+    if (!session.actor) {
+      session.actor = anonymousActor();
+    }
+    if (window.MIRAI_LRS && window.MIRAI_LRS.endpoint) {
+      session.lrs.push({ endpoint: window.MIRAI_LRS.endpoint, auth: window.MIRAI_LRS.auth });
+    }
+    if (!endpoint || !fetchURL) {
+      return Promise.resolve();
+    }
+
+    return fetch(fetchURL, { method: "POST" })
+      .then(function (response) {
+        return response.json();
+      })
+      .then(function (data) {
+        if (!data["auth-token"]) {
+          throw new Error("cmi5 fetch URL did not return an auth token");
+        }
+        var lms = { endpoint: endpoint, auth: "Basic " + data["auth-token"] };
+        session.lrs.unshift(lms);
+
+        var query = new URLSearchParams({
+          stateId: "LMS.LaunchData",
+          activityId: session.activityId,
+          agent: JSON.stringify(session.actor)
+        });
+        if (session.registration) {
+          query.set("registration", session.registration);
+        }
+        return request(lms, "GET", "activities/state?" + query.toString());
+      })
+      .then(function (response) {
+        return response.ok ? response.json() : {};
+      })
+      .then(function (launchData) {
+        session.contextTemplate = launchData.contextTemplate || {};
+        session.launchMode = launchData.launchMode || "Normal";
+        if (typeof launchData.masteryScore === "number") {
+          session.masteryScore = launchData.masteryScore;
+        }
+      });
+  }
+
+  function buildContext(cmi5Defined, categories, extensions) {
+    var context = JSON.parse(JSON.stringify(session.contextTemplate || {}));
+    if (session.registration) {
+      context.registration = session.registration;
+    }
+    context.contextActivities = context.contextActivities || {};
+    if (cmi5Defined) {
+      context.contextActivities.category = (context.contextActivities.category || [])
+        .concat([CMI5_CATEGORY], categories || []);
+    }
+    if (extensions) {
+      context.extensions = context.extensions || {};
+      for (var key in extensions) {
+        context.extensions[key] = extensions[key];
+      }
+    }
+    return context;
+  }
+
+  function send(verb, options) {
+    options = options || {};
+    var statement = {
+      id: uuid(),
+      actor: session.actor,
+      verb: { id: VERBS[verb], display: { "en-US": verb } },
+      object: options.object || { objectType: "Activity", id: session.activityId },
+      context: buildContext(options.cmi5Defined !== false, options.categories, options.extensions),
+      timestamp: new Date().toISOString()
+    };
+    if (options.result) {
+      statement.result = options.result;
+    }
+
+    // Statements to each LRS are chained so they arrive in order
+    session.lrs.forEach(function (lrs) {
+      if (options.keepalive) {
+        request(lrs, "POST", "statements", statement, true);
+        return;
+      }
+      lrs.queue = (lrs.queue || Promise.resolve())
+        .then(function () {
+          return request(lrs, "POST", "statements", statement);
+        })
+        .catch(function (err) {
+          window.console && console.warn("xAPI statement failed", err);
+        });
+    });
+  }
+
+  function quizCount() {
+    return document.querySelectorAll(".quiz").length;
+  }
+
+  function complete() {
+    if (session.completed || session.launchMode !== "Normal") {
+      return;
+    }
+    session.completed = true;
+    send("completed", {
+      categories: [MOVEON_CATEGORY],
+      result: { completion: true, duration: duration() }
+    });
+  }
+
+  function judge() {
+    var total = quizCount();
+    var answered = 0;
+    var correct = 0;
+    for (var id in results) {
+      answered++;
+      if (results[id]) {
+        correct++;
+      }
+    }
+    if (session.judged || answered < total || session.launchMode !== "Normal") {
+      return;
+    }
+    session.judged = true;
+
+    var scaled = total > 0 ? correct / total : 1;
+    var passed = scaled >= session.masteryScore;
+    complete();
+    send(passed ? "passed" : "failed", {
+      categories: [MOVEON_CATEGORY],
+      extensions: (function () {
+        var ext = {};
+        ext[MASTERY_SCORE_EXTENSION] = session.masteryScore;
+        return ext;
+      })(),
+      result: {
+        score: { scaled: Math.round(scaled * 100) / 100, raw: correct, min: 0, max: total },
+        success: passed,
+        duration: duration()
+      }
+    });
+  }
+
+  function terminate() {
+    if (!session.ready || session.terminated) {
+      return;
+    }
+    session.terminated = true;
+    send("terminated", { keepalive: true, result: { duration: duration() } });
+  }
+
+  document.addEventListener("mirai:quiz-answered", function (event) {
+    var answer = event.detail;
+    results[answer.quizID] = answer.correct;
+    if (!session.ready) {
+      return;
+    }
+
+    send("answered", {
+      cmi5Defined: false,
+      object: {
+        objectType: "Activity",
+        id: session.activityId + "/quiz/" + answer.quizID,
+        definition: {
+          type: "http://adlnet.gov/expapi/activities/cmi.interaction",
+          name: { "en-US": answer.question },
+          interactionType: "choice",
+          correctResponsesPattern: [answer.correctResponse],
+          choices: answer.choices.map(function (choice) {
+            return { id: choice.id, description: { "en-US": choice.text } };
+          })
+        }
+      },
+      result: { response: answer.response, success: answer.correct }
+    });
+    judge();
+  });
+
+  window.addEventListener("load", function () {
+    launch()
+      .catch(function (err) {
+        window.console && console.warn("cmi5 launch failed", err);
+      })
+      .then(function () {
+        if (session.lrs.length === 0) {
+          return;
+        }
+        session.ready = true;
+        send("initialized");
+        if (quizCount() === 0) {
+          complete();
+        }
+      });
+  });
+
+  window.addEventListener("pagehide", terminate);
+  window.addEventListener("beforeunload", terminate);
+})();
+`
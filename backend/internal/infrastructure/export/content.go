@@ -0,0 +1,137 @@
+// Package export renders generated courses into downloadable packages.
+package export
+
+import (
+	"encoding/json"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+)
+
+// The AI provider stores component content as loosely-typed JSON (for example,
+// heading levels arrive as integers while entity.HeadingContent expects "h2"),
+// so exporters decode through these tolerant shapes instead of the entity types.
+
+type textContent struct {
+	HTML      string `json:"html"`
+	Plaintext string `json:"plaintext"`
+}
+
+type headingContent struct {
+	Level json.RawMessage `json:"level"`
+	Text  string          `json:"text"`
+}
+
+type imageContent struct {
+	URL              string `json:"url"`
+	AltText          string `json:"alt_text"`
+	Caption          string `json:"caption"`
+	ImageDescription string `json:"image_description"`
+}
+
+type quizContent struct {
+	Question          string              `json:"question"`
+	QuestionType      string              `json:"question_type"`
+	Options           []entity.QuizOption `json:"options"`
+	CorrectAnswerID   string              `json:"correct_answer_id"`
+	Explanation       string              `json:"explanation"`
+	CorrectFeedback   string              `json:"correct_feedback"`
+	IncorrectFeedback string              `json:"incorrect_feedback"`
+}
+
+// headingLevel returns the numeric heading level (1-4), accepting either 2 or "h2".
+func (h headingContent) headingLevel() int {
+	raw := strings.Trim(strings.TrimSpace(string(h.Level)), `"`)
+	raw = strings.TrimPrefix(strings.ToLower(raw), "h")
+	level, err := strconv.Atoi(raw)
+	if err != nil || level < 1 {
+		return 2
+	}
+	if level > 4 {
+		return 4
+	}
+	return level
+}
+
+func decodeText(c entity.LessonComponent) textContent {
+	var content textContent
+	_ = json.Unmarshal(c.ContentJSON, &content)
+	if content.Plaintext == "" {
+		content.Plaintext = htmlToText(content.HTML)
+	}
+	return content
+}
+
+func decodeHeading(c entity.LessonComponent) headingContent {
+	var content headingContent
+	_ = json.Unmarshal(c.ContentJSON, &content)
+	return content
+}
+
+func decodeImage(c entity.LessonComponent) imageContent {
+	var content imageContent
+	_ = json.Unmarshal(c.ContentJSON, &content)
+	if content.AltText == "" {
+		content.AltText = content.ImageDescription
+	}
+	return content
+}
+
+func decodeQuiz(c entity.LessonComponent) quizContent {
+	var content quizContent
+	_ = json.Unmarshal(c.ContentJSON, &content)
+	return content
+}
+
+var (
+	scriptStyleRe = regexp.MustCompile(`(?is)<\s*(script|style)[^>]*>.*?<\s*/\s*(script|style)\s*>`)
+	blockBreakRe  = regexp.MustCompile(`(?i)<\s*(br\s*/?|/p|/div|/h[1-6]|/li|/ul|/ol|/tr)\s*>`)
+	listItemRe    = regexp.MustCompile(`(?i)<\s*li[^>]*>`)
+	tagRe         = regexp.MustCompile(`<[^>]*>`)
+	blankLinesRe  = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText converts simple generated HTML into plain text, keeping paragraph
+// and list item boundaries as line breaks.
+func htmlToText(s string) string {
+	s = scriptStyleRe.ReplaceAllString(s, "")
+	s = blockBreakRe.ReplaceAllString(s, "\n")
+	s = listItemRe.ReplaceAllString(s, "\n• ")
+	s = tagRe.ReplaceAllString(s, "")
+	s = html.UnescapeString(s)
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.Fields(line), " ")
+	}
+	s = strings.Join(lines, "\n")
+	s = blankLinesRe.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
+
+// slugify builds a file-name-safe slug from a title.
+func slugify(title string) string {
+	var sb strings.Builder
+	lastDash := false
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			sb.WriteRune(r)
+			lastDash = false
+		case !lastDash && sb.Len() > 0:
+			sb.WriteByte('-')
+			lastDash = true
+		}
+	}
+	slug := strings.TrimSuffix(sb.String(), "-")
+	if len(slug) > 60 {
+		slug = strings.TrimSuffix(slug[:60], "-")
+	}
+	if slug == "" {
+		return "course"
+	}
+	return slug
+}
@@ -0,0 +1,338 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Lesson pages are shared by the LMS package formats (SCORM, cmi5). Each
+// package ships its own runtime script that binds the quizzes to the LMS.
+
+type lessonPageData struct {
+	// Runtime tells the shared runtime scripts which LMS protocol to speak.
+	Runtime      string
+	Scripts      []string
+	ActivityID   string // cmi5 AU ID, used when the LMS doesn't pass one at launch
+	PassingScore int
+	CourseTitle  string
+	SectionTitle string
+	Lesson       service.ExportLesson
+}
+
+// componentView is a template-friendly projection of a LessonComponent.
+type componentView struct {
+	Kind    string
+	ID      string
+	Heading headingView
+	Text    []textBlock
+	Image   imageContent
+	Quiz    quizContent
+}
+
+type headingView struct {
+	Level int
+	Text  string
+}
+
+// textBlock is either a paragraph or a bulleted list.
+type textBlock struct {
+	List  bool
+	Lines []string
+}
+
+func renderLessonPage(data lessonPageData) ([]byte, error) {
+	views := make([]componentView, 0, len(data.Lesson.Components))
+	for _, c := range data.Lesson.Components {
+		views = append(views, newComponentView(c))
+	}
+
+	var buf bytes.Buffer
+	err := lessonPageTemplate.Execute(&buf, struct {
+		lessonPageData
+		Components []componentView
+	}{data, views})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func newComponentView(c entity.LessonComponent) componentView {
+	view := componentView{ID: c.ID.String()}
+	switch c.Type {
+	case valueobject.LessonComponentTypeHeading:
+		heading := decodeHeading(c)
+		view.Kind = "heading"
+		view.Heading = headingView{Level: heading.headingLevel(), Text: heading.Text}
+	case valueobject.LessonComponentTypeText:
+		view.Kind = "text"
+		view.Text = textBlocks(decodeText(c).Plaintext)
+	case valueobject.LessonComponentTypeImage:
+		view.Kind = "image"
+		view.Image = decodeImage(c)
+	case valueobject.LessonComponentTypeQuiz:
+		view.Kind = "quiz"
+		view.Quiz = decodeQuiz(c)
+	}
+	return view
+}
+
+// textBlocks groups plain text lines into paragraphs and bulleted lists.
+// Generated HTML is flattened to text first so packages never carry
+// untrusted markup or scripts into the LMS.
+func textBlocks(text string) []textBlock {
+	var blocks []textBlock
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if item, ok := strings.CutPrefix(line, "• "); ok {
+			if n := len(blocks); n > 0 && blocks[n-1].List {
+				blocks[n-1].Lines = append(blocks[n-1].Lines, item)
+				continue
+			}
+			blocks = append(blocks, textBlock{List: true, Lines: []string{item}})
+			continue
+		}
+		blocks = append(blocks, textBlock{Lines: []string{line}})
+	}
+	return blocks
+}
+
+var lessonPageTemplate = template.Must(template.New("lesson").Parse(`<!DOCTYPE html>
+<html lang="en" data-runtime="{{.Runtime}}" data-passing-score="{{.PassingScore}}"{{if .ActivityID}} data-activity-id="{{.ActivityID}}"{{end}}>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Lesson.Title}}</title>
+<link rel="stylesheet" href="../../shared/style.css">
+{{- range .Scripts}}
+<script src="../../shared/{{.}}"></script>
+{{- end}}
+</head>
+<body>
+<main class="lesson">
+  <header>
+    <p class="breadcrumb">{{.CourseTitle}} &rsaquo; {{.SectionTitle}}</p>
+    <h1>{{.Lesson.Title}}</h1>
+    {{- if .Lesson.Description}}
+    <p class="description">{{.Lesson.Description}}</p>
+    {{- end}}
+  </header>
+  {{- if .Lesson.LearningObjectives}}
+  <section class="objectives">
+    <h2>Learning objectives</h2>
+    <ul>
+      {{- range .Lesson.LearningObjectives}}
+      <li>{{.}}</li>
+      {{- end}}
+    </ul>
+  </section>
+  {{- end}}
+  {{- range .Components}}
+  {{- if eq .Kind "heading"}}
+  {{- if eq .Heading.Level 1}}
+  <h2>{{.Heading.Text}}</h2>
+  {{- else if eq .Heading.Level 2}}
+  <h3>{{.Heading.Text}}</h3>
+  {{- else}}
+  <h4>{{.Heading.Text}}</h4>
+  {{- end}}
+  {{- else if eq .Kind "text"}}
+  {{- range .Text}}
+  {{- if .List}}
+  <ul>
+    {{- range .Lines}}
+    <li>{{.}}</li>
+    {{- end}}
+  </ul>
+  {{- else}}
+  <p>{{index .Lines 0}}</p>
+  {{- end}}
+  {{- end}}
+  {{- else if eq .Kind "image"}}
+  <figure>
+    {{- if .Image.URL}}
+    <img src="{{.Image.URL}}" alt="{{.Image.AltText}}">
+    {{- else}}
+    <div class="image-placeholder" role="img" aria-label="{{.Image.AltText}}">{{.Image.AltText}}</div>
+    {{- end}}
+    {{- if .Image.Caption}}
+    <figcaption>{{.Image.Caption}}</figcaption>
+    {{- end}}
+  </figure>
+  {{- else if eq .Kind "quiz"}}
+  <section class="quiz" data-quiz-id="{{.ID}}" data-correct="{{.Quiz.CorrectAnswerID}}">
+    <h3>Knowledge check</h3>
+    <p class="question">{{.Quiz.Question}}</p>
+    <fieldset>
+      <legend class="visually-hidden">{{.Quiz.Question}}</legend>
+      {{- $id := .ID}}
+      {{- range .Quiz.Options}}
+      <label><input type="radio" name="quiz-{{$id}}" value="{{.ID}}"> {{.Text}}</label>
+      {{- end}}
+    </fieldset>
+    <button type="button" class="quiz-submit">Check answer</button>
+    <p class="quiz-feedback" hidden
+      data-correct-text="{{if .Quiz.CorrectFeedback}}{{.Quiz.CorrectFeedback}}{{else}}Correct!{{end}}"
+      data-incorrect-text="{{if .Quiz.IncorrectFeedback}}{{.Quiz.IncorrectFeedback}}{{else}}Not quite.{{end}}"></p>
+    {{- if .Quiz.Explanation}}
+    <p class="quiz-explanation" hidden>{{.Quiz.Explanation}}</p>
+    {{- end}}
+  </section>
+  {{- end}}
+  {{- end}}
+  {{- if .Lesson.SegueText}}
+  <p class="segue">{{.Lesson.SegueText}}</p>
+  {{- end}}
+</main>
+</body>
+</html>
+`))
+
+func lessonHasQuiz(lesson service.ExportLesson) bool {
+	for _, c := range lesson.Components {
+		if c.Type == valueobject.LessonComponentTypeQuiz {
+			return true
+		}
+	}
+	return false
+}
+
+// zipPackage writes files into an in-memory zip archive.
+type zipPackage struct {
+	buf bytes.Buffer
+	w   *zip.Writer
+}
+
+func newZipPackage() *zipPackage {
+	p := &zipPackage{}
+	p.w = zip.NewWriter(&p.buf)
+	return p
+}
+
+func (p *zipPackage) add(name string, content []byte) error {
+	f, err := p.w.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to package: %w", name, err)
+	}
+	if _, err := f.Write(content); err != nil {
+		return fmt.Errorf("failed to write %s to package: %w", name, err)
+	}
+	return nil
+}
+
+// addLessonAssets adds the stylesheet and quiz script shared by all lesson pages.
+func (p *zipPackage) addLessonAssets() error {
+	if err := p.add("shared/lesson.js", []byte(lessonJS)); err != nil {
+		return err
+	}
+	return p.add("shared/style.css", []byte(lessonStyleCSS))
+}
+
+func (p *zipPackage) close() ([]byte, error) {
+	if err := p.w.Close(); err != nil {
+		return nil, fmt.Errorf("failed to finalize package: %w", err)
+	}
+	return p.buf.Bytes(), nil
+}
+
+// lessonJS drives the quiz UI and announces each answer with a
+// "mirai:quiz-answered" event for the package's LMS runtime script.
+const lessonJS = `(function () {
+  "use strict";
+
+  function bindQuiz(quiz) {
+    var button = quiz.querySelector(".quiz-submit");
+    var feedback = quiz.querySelector(".quiz-feedback");
+    var explanation = quiz.querySelector(".quiz-explanation");
+    var question = quiz.querySelector(".question");
+    var correctID = quiz.getAttribute("data-correct");
+
+    button.addEventListener("click", function () {
+      var selected = quiz.querySelector("input[type=radio]:checked");
+      if (!selected) {
+        return;
+      }
+      var isCorrect = selected.value === correctID;
+
+      var choices = [];
+      var inputs = quiz.querySelectorAll("input[type=radio]");
+      for (var i = 0; i < inputs.length; i++) {
+        inputs[i].disabled = true;
+        choices.push({ id: inputs[i].value, text: inputs[i].parentNode.textContent.trim() });
+      }
+      button.disabled = true;
+
+      feedback.textContent = feedback.getAttribute(isCorrect ? "data-correct-text" : "data-incorrect-text");
+      feedback.className = "quiz-feedback " + (isCorrect ? "correct" : "incorrect");
+      feedback.hidden = false;
+      if (explanation) {
+        explanation.hidden = false;
+      }
+
+      document.dispatchEvent(new CustomEvent("mirai:quiz-answered", {
+        detail: {
+          quizID: quiz.getAttribute("data-quiz-id"),
+          question: question ? question.textContent : "",
+          choices: choices,
+          response: selected.value,
+          correctResponse: correctID,
+          correct: isCorrect
+        }
+      }));
+    });
+  }
+
+  document.addEventListener("DOMContentLoaded", function () {
+    var quizzes = document.querySelectorAll(".quiz");
+    for (var i = 0; i < quizzes.length; i++) {
+      bindQuiz(quizzes[i]);
+    }
+  });
+})();
+`
+
+const lessonStyleCSS = `body {
+  margin: 0;
+  font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Roboto, Helvetica, Arial, sans-serif;
+  line-height: 1.6;
+  color: #1f2937;
+  background: #ffffff;
+}
+.lesson { max-width: 760px; margin: 0 auto; padding: 32px 24px 64px; }
+.breadcrumb { font-size: 0.85rem; color: #6b7280; margin: 0 0 8px; }
+h1 { font-size: 2rem; line-height: 1.25; margin: 0 0 12px; }
+h2, h3, h4 { line-height: 1.3; margin: 32px 0 12px; }
+.description { color: #4b5563; font-size: 1.05rem; }
+.objectives { background: #f3f4f6; border-radius: 8px; padding: 16px 20px; margin: 24px 0; }
+.objectives h2 { margin-top: 0; font-size: 1rem; text-transform: uppercase; letter-spacing: 0.04em; }
+figure { margin: 24px 0; }
+figure img { max-width: 100%; border-radius: 8px; }
+figcaption { font-size: 0.85rem; color: #6b7280; margin-top: 6px; }
+.image-placeholder { background: #f3f4f6; border: 1px dashed #d1d5db; border-radius: 8px; padding: 24px; color: #6b7280; font-style: italic; }
+.quiz { border: 1px solid #e5e7eb; border-radius: 8px; padding: 16px 20px; margin: 24px 0; }
+.quiz h3 { margin-top: 0; font-size: 0.85rem; text-transform: uppercase; letter-spacing: 0.04em; color: #4f46e5; }
+.quiz fieldset { border: 0; padding: 0; margin: 0 0 12px; }
+.quiz label { display: block; padding: 6px 0; cursor: pointer; }
+.quiz-submit { background: #4f46e5; color: #ffffff; border: 0; border-radius: 6px; padding: 8px 16px; cursor: pointer; }
+.quiz-submit:disabled { background: #9ca3af; cursor: default; }
+.quiz-feedback.correct { color: #047857; font-weight: 600; }
+.quiz-feedback.incorrect { color: #b91c1c; font-weight: 600; }
+.quiz-explanation { color: #4b5563; }
+.segue { margin-top: 40px; font-style: italic; color: #4b5563; }
+.visually-hidden { position: absolute; width: 1px; height: 1px; overflow: hidden; clip: rect(0 0 0 0); }
+`
@@ -0,0 +1,320 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// PDFExporter renders a course as a printable PDF document.
+// It uses the standard Helvetica fonts so no font files need to be embedded.
+type PDFExporter struct{}
+
+// NewPDFExporter creates a new PDFExporter.
+func NewPDFExporter() *PDFExporter {
+	return &PDFExporter{}
+}
+
+// Format returns the export format this exporter produces.
+func (e *PDFExporter) Format() valueobject.ExportFormat {
+	return valueobject.ExportFormatPDF
+}
+
+// Export renders the course outline and generated lessons into a PDF.
+func (e *PDFExporter) Export(ctx context.Context, req service.CourseExportRequest) (*service.CourseExportResult, error) {
+	doc := newPDFDocument()
+
+	doc.paragraph(req.CourseTitle, fontBold, 24, 0)
+	doc.space(6)
+	doc.paragraph(fmt.Sprintf("Version %d", req.Version), fontItalic, 10, 0)
+	doc.space(18)
+
+	for si, section := range req.Sections {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("pdf export cancelled: %w", ctx.Err())
+		default:
+		}
+
+		if si > 0 {
+			doc.newPage()
+		}
+		doc.paragraph(fmt.Sprintf("Section %d: %s", si+1, section.Title), fontBold, 18, 0)
+		if section.Description != "" {
+			doc.space(4)
+			doc.paragraph(section.Description, fontItalic, 11, 0)
+		}
+		doc.space(12)
+
+		for li, lesson := range section.Lessons {
+			doc.paragraph(fmt.Sprintf("%d.%d %s", si+1, li+1, lesson.Title), fontBold, 15, 0)
+			doc.space(4)
+			if lesson.Description != "" {
+				doc.paragraph(lesson.Description, fontItalic, 10, 0)
+				doc.space(4)
+			}
+			if len(lesson.LearningObjectives) > 0 {
+				doc.paragraph("Learning objectives", fontBold, 10, 0)
+				for _, obj := range lesson.LearningObjectives {
+					doc.paragraph("• "+obj, fontRegular, 10, 12)
+				}
+				doc.space(6)
+			}
+
+			if len(lesson.Components) == 0 {
+				doc.paragraph("This lesson has not been generated yet.", fontItalic, 10, 0)
+			}
+			for _, comp := range lesson.Components {
+				writePDFComponent(doc, comp)
+			}
+
+			if lesson.SegueText != "" {
+				doc.space(4)
+				doc.paragraph(lesson.SegueText, fontItalic, 10, 0)
+			}
+			doc.space(16)
+		}
+	}
+
+	return &service.CourseExportResult{
+		FileName:    slugify(req.CourseTitle) + ".pdf",
+		ContentType: "application/pdf",
+		Content:     doc.bytes(req.CourseTitle),
+	}, nil
+}
+
+func writePDFComponent(doc *pdfDocument, comp entity.LessonComponent) {
+	switch comp.Type {
+	case valueobject.LessonComponentTypeHeading:
+		heading := decodeHeading(comp)
+		size := []float64{0, 16, 14, 12, 11}[heading.headingLevel()]
+		doc.space(4)
+		doc.paragraph(heading.Text, fontBold, size, 0)
+		doc.space(4)
+	case valueobject.LessonComponentTypeText:
+		doc.paragraph(decodeText(comp).Plaintext, fontRegular, 11, 0)
+		doc.space(8)
+	case valueobject.LessonComponentTypeImage:
+		image := decodeImage(comp)
+		doc.paragraph("[Image] "+image.AltText, fontItalic, 10, 12)
+		if image.Caption != "" {
+			doc.paragraph(image.Caption, fontItalic, 9, 12)
+		}
+		doc.space(8)
+	case valueobject.LessonComponentTypeQuiz:
+		quiz := decodeQuiz(comp)
+		doc.paragraph("Knowledge check: "+quiz.Question, fontBold, 11, 0)
+		doc.space(2)
+		correct := ""
+		for i, opt := range quiz.Options {
+			label := string(rune('A' + i))
+			if opt.ID == quiz.CorrectAnswerID {
+				correct = label
+			}
+			doc.paragraph(fmt.Sprintf("%s. %s", label, opt.Text), fontRegular, 11, 12)
+		}
+		if correct != "" {
+			doc.space(2)
+			answer := "Answer: " + correct
+			if quiz.Explanation != "" {
+				answer += " - " + quiz.Explanation
+			}
+			doc.paragraph(answer, fontItalic, 9, 12)
+		}
+		doc.space(8)
+	}
+}
+
+// Minimal PDF writer
+
+type pdfFont int
+
+const (
+	fontRegular pdfFont = iota + 1
+	fontBold
+	fontItalic
+)
+
+const (
+	pdfPageWidth  = 612.0 // US Letter
+	pdfPageHeight = 792.0
+	pdfMargin     = 72.0
+	pdfLineFactor = 1.35
+)
+
+// pdfDocument lays out wrapped text on fixed-size pages.
+type pdfDocument struct {
+	pages []*bytes.Buffer
+	y     float64
+}
+
+func newPDFDocument() *pdfDocument {
+	d := &pdfDocument{}
+	d.newPage()
+	return d
+}
+
+func (d *pdfDocument) current() *bytes.Buffer {
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *pdfDocument) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pdfPageHeight - pdfMargin
+}
+
+func (d *pdfDocument) space(h float64) {
+	d.y -= h
+}
+
+// paragraph writes text wrapped to the page width, starting new pages as needed.
+func (d *pdfDocument) paragraph(text string, font pdfFont, size, indent float64) {
+	maxWidth := pdfPageWidth - 2*pdfMargin - indent
+	lineHeight := size * pdfLineFactor
+
+	for _, para := range strings.Split(text, "\n") {
+		for _, line := range wrapText(para, font, size, maxWidth) {
+			if d.y-lineHeight < pdfMargin {
+				d.newPage()
+			}
+			d.y -= lineHeight
+			fmt.Fprintf(d.current(), "BT /F%d %.1f Tf %.2f %.2f Td (%s) Tj ET\n",
+				font, size, pdfMargin+indent, d.y, pdfEscape(line))
+		}
+	}
+}
+
+// charWidth approximates the average Helvetica glyph width for a font size.
+func charWidth(font pdfFont, size float64) float64 {
+	if font == fontBold {
+		return size * 0.56
+	}
+	return size * 0.5
+}
+
+func wrapText(text string, font pdfFont, size, maxWidth float64) []string {
+	maxChars := int(maxWidth / charWidth(font, size))
+	if maxChars < 1 {
+		maxChars = 1
+	}
+
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	var current []rune
+	for _, word := range words {
+		w := []rune(word)
+		// Hard-split words that can't fit on a line by themselves
+		for len(w) > maxChars {
+			if len(current) > 0 {
+				lines = append(lines, string(current))
+				current = nil
+			}
+			lines = append(lines, string(w[:maxChars]))
+			w = w[maxChars:]
+		}
+		if len(current) > 0 && len(current)+1+len(w) > maxChars {
+			lines = append(lines, string(current))
+			current = nil
+		}
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+		current = append(current, w...)
+	}
+	if len(current) > 0 {
+		lines = append(lines, string(current))
+	}
+	return lines
+}
+
+// winAnsi maps common typographic characters outside Latin-1 to WinAnsiEncoding.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '…': 0x85, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94,
+	'•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfEscape encodes text as a WinAnsi PDF literal string body.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		var c byte
+		switch {
+		case r < 0x20:
+			c = ' '
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			c = byte(r)
+		default:
+			mapped, ok := winAnsi[r]
+			if !ok {
+				mapped = '?'
+			}
+			c = mapped
+		}
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// bytes assembles the document objects, cross-reference table and trailer.
+func (d *pdfDocument) bytes(title string) []byte {
+	var out bytes.Buffer
+	var offsets []int
+
+	addObject := func(body string) int {
+		offsets = append(offsets, out.Len())
+		id := len(offsets)
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", id, body)
+		return id
+	}
+
+	out.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+
+	const pagesID = 2
+	pageCount := len(d.pages)
+	firstPageID := 6 // catalog, pages, 3 fonts
+	kids := make([]string, pageCount)
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageID+2*i+1)
+	}
+
+	addObject(fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesID))
+	addObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pageCount))
+	addObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	addObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	addObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Oblique /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range d.pages {
+		// Footer with page numbers
+		fmt.Fprintf(page, "BT /F%d 9.0 Tf %.2f %.2f Td (%s) Tj ET\n",
+			fontRegular, pdfPageWidth/2-20, pdfMargin/2, pdfEscape(fmt.Sprintf("Page %d of %d", i+1, pageCount)))
+
+		contentID := addObject(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.Len(), page.String()))
+		addObject(fmt.Sprintf(
+			"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R /F3 5 0 R >> >> /Contents %d 0 R >>",
+			pagesID, pdfPageWidth, pdfPageHeight, contentID))
+	}
+
+	infoID := addObject(fmt.Sprintf("<< /Title (%s) /Producer (Mirai) >>", pdfEscape(title)))
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, infoID, xrefOffset)
+
+	return out.Bytes()
+}
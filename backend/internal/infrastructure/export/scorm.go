@@ -0,0 +1,449 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// scormPassingScore is the default mastery score (percent) for lessons with quizzes.
+// LMSes may override it through cmi.student_data.mastery_score / cmi.scaled_passing_score.
+const scormPassingScore = 80
+
+// SCORMExporter renders a course as a SCORM content package.
+// Each lesson becomes its own SCO; quizzes report their score
+// through the SCORM runtime API so the LMS can track completion and mastery.
+type SCORMExporter struct {
+	format valueobject.ExportFormat
+}
+
+// NewSCORM12Exporter creates an exporter for SCORM 1.2 packages.
+func NewSCORM12Exporter() *SCORMExporter {
+	return &SCORMExporter{format: valueobject.ExportFormatSCORM12}
+}
+
+// NewSCORM2004Exporter creates an exporter for SCORM 2004 (4th Edition) packages.
+func NewSCORM2004Exporter() *SCORMExporter {
+	return &SCORMExporter{format: valueobject.ExportFormatSCORM2004}
+}
+
+// Format returns the export format this exporter produces.
+func (e *SCORMExporter) Format() valueobject.ExportFormat {
+	return e.format
+}
+
+// Export renders the generated lessons into a SCORM zip. It fails, naming
+// the lessons, if any lesson has not been generated.
+func (e *SCORMExporter) Export(ctx context.Context, req service.CourseExportRequest) (*service.CourseExportResult, error) {
+	manifest := scormManifest{
+		Identifier:   "MIRAI-" + req.CourseID.String(),
+		Version:      req.Version,
+		Title:        req.CourseTitle,
+		PassingScore: scormPassingScore,
+	}
+
+	pkg := newZipPackage()
+
+	var missing []string
+	for _, section := range req.Sections {
+		item := scormItem{
+			Identifier: "SEC-" + section.ID.String(),
+			Title:      section.Title,
+		}
+
+		for _, lesson := range section.Lessons {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("scorm export cancelled: %w", ctx.Err())
+			default:
+			}
+
+			// A package without some of its lessons would look complete to the LMS
+			if len(lesson.Components) == 0 {
+				missing = append(missing, lesson.Title)
+				continue
+			}
+
+			href := fmt.Sprintf("lessons/%s/index.html", lesson.ID)
+			page, err := renderLessonPage(lessonPageData{
+				Runtime:      e.runtime(),
+				Scripts:      []string{"lesson.js", "scorm-api.js"},
+				PassingScore: scormPassingScore,
+				CourseTitle:  req.CourseTitle,
+				SectionTitle: section.Title,
+				Lesson:       lesson,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to render lesson %s: %w", lesson.ID, err)
+			}
+			if err := pkg.add(href, page); err != nil {
+				return nil, err
+			}
+
+			item.Children = append(item.Children, scormItem{
+				Identifier:    "ITEM-" + lesson.ID.String(),
+				ResourceRef:   "RES-" + lesson.ID.String(),
+				Title:         lesson.Title,
+				HasAssessment: lessonHasQuiz(lesson),
+			})
+			manifest.Resources = append(manifest.Resources, scormResource{
+				Identifier: "RES-" + lesson.ID.String(),
+				Href:       href,
+			})
+		}
+
+		if len(item.Children) > 0 {
+			manifest.Items = append(manifest.Items, item)
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("lessons have not been generated yet: %s", strings.Join(missing, ", "))
+	}
+	if len(manifest.Resources) == 0 {
+		return nil, fmt.Errorf("course has no lessons to export")
+	}
+
+	manifestXML, err := e.renderManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render manifest: %w", err)
+	}
+	if err := pkg.add("imsmanifest.xml", manifestXML); err != nil {
+		return nil, err
+	}
+	if err := pkg.add("shared/scorm-api.js", []byte(scormRuntimeJS)); err != nil {
+		return nil, err
+	}
+	if err := pkg.addLessonAssets(); err != nil {
+		return nil, err
+	}
+
+	content, err := pkg.close()
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.CourseExportResult{
+		FileName:    fmt.Sprintf("%s-%s.zip", slugify(req.CourseTitle), strings.ReplaceAll(e.format.String(), "_", "")),
+		ContentType: "application/zip",
+		Content:     content,
+	}, nil
+}
+
+// runtime is the SCORM runtime the lesson pages should bind to.
+func (e *SCORMExporter) runtime() string {
+	if e.format == valueobject.ExportFormatSCORM2004 {
+		return "scorm2004"
+	}
+	return "scorm12"
+}
+
+func (e *SCORMExporter) renderManifest(m scormManifest) ([]byte, error) {
+	tmpl := manifest12Template
+	if e.format == valueobject.ExportFormatSCORM2004 {
+		tmpl = manifest2004Template
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Manifest
+
+type scormManifest struct {
+	Identifier   string
+	Version      int32
+	Title        string
+	PassingScore int
+	Items        []scormItem
+	Resources    []scormResource
+}
+
+type scormItem struct {
+	Identifier    string
+	ResourceRef   string
+	Title         string
+	HasAssessment bool
+	Children      []scormItem
+}
+
+type scormResource struct {
+	Identifier string
+	Href       string
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+var manifestFuncs = template.FuncMap{
+	"xml": xmlEscape,
+	"scaled": func(percent int) string {
+		return fmt.Sprintf("%.2f", float64(percent)/100)
+	},
+}
+
+var manifest12Template = template.Must(template.New("manifest12").Funcs(manifestFuncs).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<manifest identifier="{{xml .Identifier}}" version="{{.Version}}"
+  xmlns="http://www.imsproject.org/xsd/imscp_rootv1p1p2"
+  xmlns:adlcp="http://www.adlnet.org/xsd/adlcp_rootv1p2"
+  xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+  xsi:schemaLocation="http://www.imsproject.org/xsd/imscp_rootv1p1p2 imscp_rootv1p1p2.xsd http://www.imsglobal.org/xsd/imsmd_rootv1p2p1 imsmd_rootv1p2p1.xsd http://www.adlnet.org/xsd/adlcp_rootv1p2 adlcp_rootv1p2.xsd">
+  <metadata>
+    <schema>ADL SCORM</schema>
+    <schemaversion>1.2</schemaversion>
+  </metadata>
+  <organizations default="ORG-1">
+    <organization identifier="ORG-1">
+      <title>{{xml .Title}}</title>
+{{- range .Items}}
+      <item identifier="{{.Identifier}}">
+        <title>{{xml .Title}}</title>
+{{- range .Children}}
+        <item identifier="{{.Identifier}}" identifierref="{{.ResourceRef}}">
+          <title>{{xml .Title}}</title>
+{{- if .HasAssessment}}
+          <adlcp:masteryscore>{{$.PassingScore}}</adlcp:masteryscore>
+{{- end}}
+        </item>
+{{- end}}
+      </item>
+{{- end}}
+    </organization>
+  </organizations>
+  <resources>
+{{- range .Resources}}
+    <resource identifier="{{.Identifier}}" type="webcontent" adlcp:scormtype="sco" href="{{xml .Href}}">
+      <file href="{{xml .Href}}"/>
+      <dependency identifierref="SHARED"/>
+    </resource>
+{{- end}}
+    <resource identifier="SHARED" type="webcontent" adlcp:scormtype="asset">
+      <file href="shared/lesson.js"/>
+      <file href="shared/scorm-api.js"/>
+      <file href="shared/style.css"/>
+    </resource>
+  </resources>
+</manifest>
+`))
+
+var manifest2004Template = template.Must(template.New("manifest2004").Funcs(manifestFuncs).Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<manifest identifier="{{xml .Identifier}}" version="{{.Version}}"
+  xmlns="http://www.imsglobal.org/xsd/imscp_v1p1"
+  xmlns:adlcp="http://www.adlnet.org/xsd/adlcp_v1p3"
+  xmlns:adlseq="http://www.adlnet.org/xsd/adlseq_v1p3"
+  xmlns:adlnav="http://www.adlnet.org/xsd/adlnav_v1p3"
+  xmlns:imsss="http://www.imsglobal.org/xsd/imsss"
+  xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+  xsi:schemaLocation="http://www.imsglobal.org/xsd/imscp_v1p1 imscp_v1p1.xsd http://www.adlnet.org/xsd/adlcp_v1p3 adlcp_v1p3.xsd http://www.adlnet.org/xsd/adlseq_v1p3 adlseq_v1p3.xsd http://www.adlnet.org/xsd/adlnav_v1p3 adlnav_v1p3.xsd http://www.imsglobal.org/xsd/imsss imsss_v1p0.xsd">
+  <metadata>
+    <schema>ADL SCORM</schema>
+    <schemaversion>2004 4th Edition</schemaversion>
+  </metadata>
+  <organizations default="ORG-1">
+    <organization identifier="ORG-1">
+      <title>{{xml .Title}}</title>
+{{- range .Items}}
+      <item identifier="{{.Identifier}}">
+        <title>{{xml .Title}}</title>
+{{- range .Children}}
+        <item identifier="{{.Identifier}}" identifierref="{{.ResourceRef}}">
+          <title>{{xml .Title}}</title>
+{{- if .HasAssessment}}
+          <imsss:sequencing>
+            <imsss:objectives>
+              <imsss:primaryObjective objectiveID="{{.Identifier}}-mastery" satisfiedByMeasure="true">
+                <imsss:minNormalizedMeasure>{{scaled $.PassingScore}}</imsss:minNormalizedMeasure>
+              </imsss:primaryObjective>
+            </imsss:objectives>
+          </imsss:sequencing>
+{{- end}}
+        </item>
+{{- end}}
+      </item>
+{{- end}}
+      <imsss:sequencing>
+        <imsss:controlMode choice="true" flow="true"/>
+      </imsss:sequencing>
+    </organization>
+  </organizations>
+  <resources>
+{{- range .Resources}}
+    <resource identifier="{{.Identifier}}" type="webcontent" adlcp:scormType="sco" href="{{xml .Href}}">
+      <file href="{{xml .Href}}"/>
+      <dependency identifierref="SHARED"/>
+    </resource>
+{{- end}}
+    <resource identifier="SHARED" type="webcontent" adlcp:scormType="asset">
+      <file href="shared/lesson.js"/>
+      <file href="shared/scorm-api.js"/>
+      <file href="shared/style.css"/>
+    </resource>
+  </resources>
+</manifest>
+`))
+
+// scormRuntimeJS locates the LMS runtime (SCORM 1.2 "API" or 2004 "API_1484_11")
+// and reports completion, score and interactions as quizzes are answered.
+const scormRuntimeJS = `(function () {
+  "use strict";
+
+  var root = document.documentElement;
+  var is2004 = root.getAttribute("data-runtime") === "scorm2004";
+  var api = null;
+  var initialized = false;
+  var finished = false;
+
+  function findAPI(win) {
+    var name = is2004 ? "API_1484_11" : "API";
+    for (var tries = 0; win && tries < 500; tries++) {
+      if (win[name]) {
+        return win[name];
+      }
+      if (!win.parent || win.parent === win) {
+        break;
+      }
+      win = win.parent;
+    }
+    return null;
+  }
+
+  var rt = {
+    init: function () {
+      api = findAPI(window) || (window.opener ? findAPI(window.opener) : null);
+      if (!api) {
+        return;
+      }
+      initialized = String(is2004 ? api.Initialize("") : api.LMSInitialize("")) === "true";
+    },
+    get: function (key) {
+      if (!initialized) {
+        return "";
+      }
+      return String(is2004 ? api.GetValue(key) : api.LMSGetValue(key));
+    },
+    set: function (key, value) {
+      if (initialized) {
+        is2004 ? api.SetValue(key, String(value)) : api.LMSSetValue(key, String(value));
+      }
+    },
+    commit: function () {
+      if (initialized) {
+        is2004 ? api.Commit("") : api.LMSCommit("");
+      }
+    },
+    finish: function () {
+      if (initialized && !finished) {
+        finished = true;
+        is2004 ? api.Terminate("") : api.LMSFinish("");
+      }
+    }
+  };
+
+  function passingScore() {
+    var fromLMS = is2004 ? rt.get("cmi.scaled_passing_score") : rt.get("cmi.student_data.mastery_score");
+    var value = parseFloat(fromLMS);
+    if (!isNaN(value)) {
+      return is2004 ? value * 100 : value;
+    }
+    return parseFloat(root.getAttribute("data-passing-score")) || 80;
+  }
+
+  var results = {};
+
+  function report() {
+    var quizzes = document.querySelectorAll(".quiz");
+    var total = quizzes.length;
+    var answered = 0;
+    var correct = 0;
+    for (var i = 0; i < quizzes.length; i++) {
+      var result = results[quizzes[i].getAttribute("data-quiz-id")];
+      if (result !== undefined) {
+        answered++;
+        if (result) {
+          correct++;
+        }
+      }
+    }
+
+    if (total === 0) {
+      if (is2004) {
+        rt.set("cmi.completion_status", "completed");
+      } else {
+        rt.set("cmi.core.lesson_status", "completed");
+      }
+      rt.commit();
+      return;
+    }
+
+    var score = Math.round((correct / total) * 100);
+    var done = answered === total;
+    var passed = score >= passingScore();
+
+    if (is2004) {
+      rt.set("cmi.score.min", 0);
+      rt.set("cmi.score.max", 100);
+      rt.set("cmi.score.raw", score);
+      rt.set("cmi.score.scaled", (score / 100).toFixed(2));
+      rt.set("cmi.completion_status", done ? "completed" : "incomplete");
+      if (done) {
+        rt.set("cmi.success_status", passed ? "passed" : "failed");
+      }
+    } else {
+      rt.set("cmi.core.score.min", 0);
+      rt.set("cmi.core.score.max", 100);
+      rt.set("cmi.core.score.raw", score);
+      rt.set("cmi.core.lesson_status", done ? (passed ? "passed" : "failed") : "incomplete");
+    }
+    rt.commit();
+  }
+
+  function recordInteraction(quizID, response, correctResponse, isCorrect) {
+    var n = parseInt(rt.get("cmi.interactions._count"), 10) || 0;
+    var prefix = "cmi.interactions." + n + ".";
+    if (is2004) {
+      rt.set(prefix + "id", "urn:mirai:quiz:" + quizID);
+      rt.set(prefix + "type", "choice");
+      rt.set(prefix + "correct_responses.0.pattern", correctResponse);
+      rt.set(prefix + "learner_response", response);
+      rt.set(prefix + "result", isCorrect ? "correct" : "incorrect");
+      rt.set(prefix + "timestamp", new Date().toISOString().replace(/\.\d+Z$/, ""));
+    } else {
+      rt.set(prefix + "id", "quiz_" + quizID.replace(/-/g, ""));
+      rt.set(prefix + "type", "choice");
+      rt.set(prefix + "correct_responses.0.pattern", correctResponse);
+      rt.set(prefix + "student_response", response);
+      rt.set(prefix + "result", isCorrect ? "correct" : "wrong");
+    }
+  }
+
+  document.addEventListener("mirai:quiz-answered", function (event) {
+    var answer = event.detail;
+    results[answer.quizID] = answer.correct;
+    recordInteraction(answer.quizID, answer.response, answer.correctResponse, answer.correct);
+    report();
+  });
+
+  window.addEventListener("load", function () {
+    rt.init();
+    if (is2004) {
+      rt.set("cmi.exit", "normal");
+    }
+    report();
+  });
+
+  window.addEventListener("pagehide", rt.finish);
+  window.addEventListener("beforeunload", rt.finish);
+})();
+`
@@ -0,0 +1,195 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// manifestDoc is the part of imsmanifest.xml the tests check.
+type manifestDoc struct {
+	SchemaVersion string         `xml:"metadata>schemaversion"`
+	Items         []manifestItem `xml:"organizations>organization>item"`
+	Resources     []struct {
+		Identifier string `xml:"identifier,attr"`
+		Href       string `xml:"href,attr"`
+		Files      []struct {
+			Href string `xml:"href,attr"`
+		} `xml:"file"`
+	} `xml:"resources>resource"`
+}
+
+type manifestItem struct {
+	Identifier    string         `xml:"identifier,attr"`
+	IdentifierRef string         `xml:"identifierref,attr"`
+	Children      []manifestItem `xml:"item"`
+}
+
+func exportLesson(title string, components ...entity.LessonComponent) service.ExportLesson {
+	return service.ExportLesson{ID: uuid.New(), Title: title, Components: components}
+}
+
+func textComponent(text string) entity.LessonComponent {
+	return entity.LessonComponent{
+		ID:          uuid.New(),
+		Type:        valueobject.LessonComponentTypeText,
+		ContentJSON: []byte(`{"plaintext":"` + text + `"}`),
+	}
+}
+
+func quizComponent() entity.LessonComponent {
+	return entity.LessonComponent{
+		ID:          uuid.New(),
+		Type:        valueobject.LessonComponentTypeQuiz,
+		ContentJSON: []byte(`{"question":"Ideal pH?","options":[{"id":"a","text":"7.4"},{"id":"b","text":"9"}],"correct_answer_id":"a"}`),
+	}
+}
+
+func TestSCORMManifest(t *testing.T) {
+	req := service.CourseExportRequest{
+		CourseID:    uuid.New(),
+		CourseTitle: "Pool Care",
+		Version:     2,
+		Sections: []service.ExportSection{
+			{ID: uuid.New(), Title: "Water", Lessons: []service.ExportLesson{
+				exportLesson("Chemistry", textComponent("Test the water daily.")),
+				exportLesson("Quiz", quizComponent()),
+			}},
+			{ID: uuid.New(), Title: "Equipment", Lessons: []service.ExportLesson{
+				exportLesson("Pumps", textComponent("Clean the strainer basket.")),
+			}},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		exporter      *SCORMExporter
+		schemaVersion string
+	}{
+		{"SCORM 1.2", NewSCORM12Exporter(), "1.2"},
+		{"SCORM 2004", NewSCORM2004Exporter(), "2004 4th Edition"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.exporter.Export(context.Background(), req)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			files := unzip(t, result.Content)
+			data, ok := files["imsmanifest.xml"]
+			if !ok {
+				t.Fatal("package has no imsmanifest.xml")
+			}
+			var manifest manifestDoc
+			if err := xml.Unmarshal(data, &manifest); err != nil {
+				t.Fatalf("imsmanifest.xml is not valid XML: %v", err)
+			}
+
+			if manifest.SchemaVersion != tt.schemaVersion {
+				t.Errorf("schemaversion = %q, want %q", manifest.SchemaVersion, tt.schemaVersion)
+			}
+
+			resources := make(map[string]bool)
+			for _, r := range manifest.Resources {
+				resources[r.Identifier] = true
+				if r.Href != "" {
+					if _, ok := files[r.Href]; !ok {
+						t.Errorf("resource %s href %q is not in the package", r.Identifier, r.Href)
+					}
+				}
+				for _, f := range r.Files {
+					if _, ok := files[f.Href]; !ok {
+						t.Errorf("resource %s file %q is not in the package", r.Identifier, f.Href)
+					}
+				}
+			}
+
+			lessons := make(map[string]bool)
+			for _, section := range manifest.Items {
+				for _, item := range section.Children {
+					lessons[item.Identifier] = true
+					if !resources[item.IdentifierRef] {
+						t.Errorf("item %s refers to missing resource %q", item.Identifier, item.IdentifierRef)
+					}
+				}
+			}
+			for _, section := range req.Sections {
+				for _, lesson := range section.Lessons {
+					if !lessons["ITEM-"+lesson.ID.String()] {
+						t.Errorf("lesson %q has no item", lesson.Title)
+					}
+				}
+			}
+			if len(lessons) != 3 {
+				t.Errorf("got %d lesson items, want 3", len(lessons))
+			}
+		})
+	}
+}
+
+func TestSCORMExportRequiresGeneratedLessons(t *testing.T) {
+	req := service.CourseExportRequest{
+		CourseID:    uuid.New(),
+		CourseTitle: "Pool Care",
+		Sections: []service.ExportSection{
+			{ID: uuid.New(), Title: "Water", Lessons: []service.ExportLesson{
+				exportLesson("Chemistry", textComponent("Test the water daily.")),
+				exportLesson("Filtration"),
+			}},
+			{ID: uuid.New(), Title: "Equipment", Lessons: []service.ExportLesson{
+				exportLesson("Pumps"),
+			}},
+		},
+	}
+
+	_, err := NewSCORM12Exporter().Export(context.Background(), req)
+	if err == nil {
+		t.Fatal("Export() succeeded with lessons that have not been generated")
+	}
+	for _, title := range []string{"Filtration", "Pumps"} {
+		if !strings.Contains(err.Error(), title) {
+			t.Errorf("Export() error = %q, want it to name %q", err, title)
+		}
+	}
+	if strings.Contains(err.Error(), "Chemistry") {
+		t.Errorf("Export() error = %q names a generated lesson", err)
+	}
+
+	if _, err := NewSCORM12Exporter().Export(context.Background(), service.CourseExportRequest{CourseTitle: "Empty"}); err == nil {
+		t.Error("Export() succeeded for a course without lessons")
+	}
+}
+
+// unzip returns the files of a zip archive by name.
+func unzip(t *testing.T, content []byte) map[string][]byte {
+	t.Helper()
+	r, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		t.Fatalf("package is not a valid zip: %v", err)
+	}
+	files := make(map[string][]byte, len(r.File))
+	for _, f := range r.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", f.Name, err)
+		}
+		files[f.Name] = data
+	}
+	return files
+}
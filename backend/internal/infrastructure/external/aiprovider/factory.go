@@ -0,0 +1,142 @@
+// Package aiprovider creates the AI provider configured for each tenant.
+package aiprovider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/anthropic"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/gemini"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/openai"
+)
+
+// RequestTimeout bounds a single HTTP request to a model API.
+// Lesson generation responses can take minutes to produce.
+const RequestTimeout = 5 * time.Minute
+
+// SettingsProvider provides access to tenant AI settings for provider selection.
+// This interface is implemented by TenantSettingsService.
+type SettingsProvider interface {
+	GetAIProviderConfig(ctx context.Context, tenantID uuid.UUID) (*service.AIProviderConfig, error)
+}
+
+// Factory creates AIProvider instances per-tenant.
+// Since clients require API keys at construction time and keys are per-tenant,
+// this factory creates a fresh client for each request using the tenant's
+// configured provider and decrypted API key.
+type Factory struct {
+	settingsProvider SettingsProvider
+	clients          Clients
+	logger           service.Logger
+}
+
+// Clients are the HTTP clients used to reach model APIs.
+type Clients struct {
+	// Hosted reaches the hosted provider APIs.
+	Hosted *http.Client
+
+	// SelfHosted reaches the tenant-supplied base URLs of self-hosted servers.
+	// It must refuse internal addresses the operator has not allowed, or
+	// a tenant could use the base URL to probe the internal network.
+	SelfHosted *http.Client
+}
+
+// NewFactory creates a new Factory.
+func NewFactory(settingsProvider SettingsProvider, clients Clients, logger service.Logger) *Factory {
+	return &Factory{
+		settingsProvider: settingsProvider,
+		clients:          clients,
+		logger:           logger,
+	}
+}
+
+// GetProvider creates an AIProvider for the specified tenant.
+func (f *Factory) GetProvider(ctx context.Context, tenantID uuid.UUID) (service.AIProvider, error) {
+	log := f.logger.With("tenantID", tenantID, "component", "ai-provider-factory")
+
+	cfg, err := f.settingsProvider.GetAIProviderConfig(ctx, tenantID)
+	if err != nil {
+		log.Error("failed to get AI provider config", "error", err)
+		return nil, err
+	}
+
+	provider, err := newProvider(ctx, f.clients, *cfg)
+	if err != nil {
+		log.Error("failed to create AI provider", "provider", cfg.Provider, "error", err)
+		return nil, err
+	}
+
+	log.Debug("created AI provider for tenant", "provider", cfg.Provider)
+	return provider, nil
+}
+
+// GetEmbedder creates an Embedder for the specified tenant.
+// Embeddings are available with Gemini and OpenAI; knowledge search falls back
+// to keywords for tenants on other providers, as their AI settings show.
+func (f *Factory) GetEmbedder(ctx context.Context, tenantID uuid.UUID) (service.Embedder, error) {
+	cfg, err := f.settingsProvider.GetAIProviderConfig(ctx, tenantID)
+	if err != nil {
+		f.logger.Error("failed to get AI provider config", "tenantID", tenantID, "component", "ai-provider-factory", "error", err)
+		return nil, err
+	}
+
+	switch cfg.Provider {
+	case valueobject.AIProviderGemini:
+		client, err := gemini.NewClient(ctx, cfg.APIKey)
+		if err != nil {
+			f.logger.Error("failed to create Gemini client", "tenantID", tenantID, "component", "ai-provider-factory", "error", err)
+			return nil, err
+		}
+		return client, nil
+	case valueobject.AIProviderOpenAI:
+		return openai.NewClient(f.clients.Hosted, cfg.APIKey, ""), nil
+	default:
+		return nil, fmt.Errorf("embeddings are not supported for AI provider %s", cfg.Provider)
+	}
+}
+
+// KeyTester validates provider configurations against their provider.
+type KeyTester struct {
+	clients Clients
+}
+
+// NewKeyTester creates a new KeyTester.
+func NewKeyTester(clients Clients) *KeyTester {
+	return &KeyTester{clients: clients}
+}
+
+// TestAPIKey makes a minimal request to the provider with the given configuration.
+func (t *KeyTester) TestAPIKey(ctx context.Context, cfg service.AIProviderConfig) error {
+	p, err := newProvider(ctx, t.clients, cfg)
+	if err != nil {
+		return err
+	}
+	return p.TestConnection(ctx)
+}
+
+// newProvider creates the AIProvider implementation for a provider configuration.
+func newProvider(ctx context.Context, clients Clients, cfg service.AIProviderConfig) (*llm.Provider, error) {
+	switch cfg.Provider {
+	case valueobject.AIProviderGemini:
+		client, err := gemini.NewClient(ctx, cfg.APIKey)
+		if err != nil {
+			return nil, err
+		}
+		return llm.NewProvider(client), nil
+	case valueobject.AIProviderOpenAI:
+		return llm.NewProvider(openai.NewClient(clients.Hosted, cfg.APIKey, "")), nil
+	case valueobject.AIProviderAnthropic:
+		return llm.NewProvider(anthropic.NewClient(clients.Hosted, cfg.APIKey, "")), nil
+	case valueobject.AIProviderOpenAICompatible:
+		return llm.NewProvider(openai.NewCompatibleClient(clients.SelfHosted, cfg.BaseURL, cfg.APIKey, cfg.Model)), nil
+	default:
+		return nil, fmt.Errorf("unsupported AI provider: %s", cfg.Provider)
+	}
+}
@@ -0,0 +1,55 @@
+package aiprovider
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+	"github.com/sogos/mirai-backend/pkg/httputil"
+)
+
+func TestKeyTesterSelfHostedAddresses(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"OK"}}],"usage":{"total_tokens":3}}`))
+	}))
+	defer server.Close()
+
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	tests := []struct {
+		name    string
+		allowed []*net.IPNet
+		wantErr bool
+	}{
+		{"internal address refused", nil, true},
+		{"allowed network", []*net.IPNet{loopback}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = 0
+			// The hosted client could reach the server; only the self-hosted one may be used
+			tester := NewKeyTester(Clients{
+				Hosted:     server.Client(),
+				SelfHosted: httputil.NewPublicClient(5*time.Second, tt.allowed),
+			})
+
+			err := tester.TestAPIKey(context.Background(), service.AIProviderConfig{
+				Provider: valueobject.AIProviderOpenAICompatible,
+				BaseURL:  server.URL,
+				Model:    "llama3.1",
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TestAPIKey() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr && requests != 0 {
+				t.Errorf("refused server received %d requests", requests)
+			}
+		})
+	}
+}
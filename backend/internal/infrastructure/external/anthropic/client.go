@@ -0,0 +1,197 @@
+// Package anthropic implements llm.Completer for the Anthropic messages API.
+package anthropic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+)
+
+const (
+	// DefaultModel is the default Anthropic model to use.
+	DefaultModel = "claude-3-5-haiku-latest"
+
+	// DefaultBaseURL is the Anthropic API base URL.
+	DefaultBaseURL = "https://api.anthropic.com/v1"
+
+	// APIVersion is the API version sent with every request.
+	APIVersion = "2023-06-01"
+
+	// defaultMaxTokens is used when a request does not set MaxTokens;
+	// the messages API requires an explicit limit.
+	defaultMaxTokens = 8192
+)
+
+// Client implements llm.Completer using the Anthropic messages API.
+type Client struct {
+	httpClient *http.Client
+	apiKey     string
+	baseURL    string
+	model      string
+	maxRetries int
+	baseDelay  time.Duration
+}
+
+// NewClient creates a new Anthropic client with the provided API key.
+// An empty baseURL uses DefaultBaseURL.
+func NewClient(httpClient *http.Client, apiKey, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		httpClient: httpClient,
+		apiKey:     apiKey,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		model:      DefaultModel,
+		maxRetries: llm.DefaultMaxRetries,
+		baseDelay:  llm.DefaultBaseDelay,
+	}
+}
+
+type messagesRequest struct {
+	Model      string      `json:"model"`
+	MaxTokens  int         `json:"max_tokens"`
+	Messages   []message   `json:"messages"`
+	Tools      []tool      `json:"tools,omitempty"`
+	ToolChoice *toolChoice `json:"tool_choice,omitempty"`
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type tool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"input_schema"`
+}
+
+type toolChoice struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type messagesResponse struct {
+	Content []struct {
+		Type  string          `json:"type"`
+		Text  string          `json:"text"`
+		Name  string          `json:"name"`
+		Input json.RawMessage `json:"input"`
+	} `json:"content"`
+	StopReason string `json:"stop_reason"`
+	Usage      struct {
+		InputTokens  int64 `json:"input_tokens"`
+		OutputTokens int64 `json:"output_tokens"`
+	} `json:"usage"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Complete sends the prompt as a single user message. When a schema is given
+// the model is forced to call a tool whose input schema is the output schema,
+// and the tool input is returned as the response JSON.
+func (c *Client) Complete(ctx context.Context, req llm.CompletionRequest) (*llm.Completion, error) {
+	maxTokens := req.MaxTokens
+	if maxTokens == 0 {
+		maxTokens = defaultMaxTokens
+	}
+
+	body := messagesRequest{
+		Model:     c.model,
+		MaxTokens: maxTokens,
+		Messages:  []message{{Role: "user", Content: req.Prompt}},
+	}
+	if req.Schema != nil {
+		body.Tools = []tool{{
+			Name:        req.SchemaName,
+			Description: "Record the response in the required structure.",
+			InputSchema: req.Schema,
+		}}
+		body.ToolChoice = &toolChoice{Type: "tool", Name: req.SchemaName}
+	}
+
+	var resp messagesResponse
+	err := llm.Retry(ctx, req.Operation, c.maxRetries, c.baseDelay, func() error {
+		return c.post(ctx, "/messages", body, &resp)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejected responses were still billed
+	tokensUsed := resp.Usage.InputTokens + resp.Usage.OutputTokens
+	if req.Schema != nil && resp.StopReason == "max_tokens" {
+		return nil, llm.WithUsage(fmt.Errorf("%s: response was truncated at the token limit", req.Operation), tokensUsed)
+	}
+
+	var text strings.Builder
+	for _, block := range resp.Content {
+		switch {
+		case req.Schema != nil && block.Type == "tool_use" && block.Name == req.SchemaName:
+			return &llm.Completion{
+				Text:       string(block.Input),
+				TokensUsed: tokensUsed,
+			}, nil
+		case block.Type == "text":
+			text.WriteString(block.Text)
+		}
+	}
+	if req.Schema != nil {
+		return nil, llm.WithUsage(fmt.Errorf("%s: response did not contain structured output", req.Operation), tokensUsed)
+	}
+
+	return &llm.Completion{
+		Text:       text.String(),
+		TokensUsed: tokensUsed,
+	}, nil
+}
+
+// post sends a JSON request to the API and decodes the JSON response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", c.apiKey)
+	req.Header.Set("Anthropic-Version", APIVersion)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach Anthropic API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp errorResponse
+		_ = json.Unmarshal(respBody, &errResp)
+		return &llm.APIError{StatusCode: resp.StatusCode, Message: errResp.Error.Message}
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package anthropic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+)
+
+var testSchema = map[string]any{
+	"type":       "object",
+	"properties": map[string]any{"title": map[string]any{"type": "string"}},
+}
+
+// apiServer answers each request with the next scripted status and body
+// and records the decoded request bodies.
+type apiServer struct {
+	t         *testing.T
+	responses []scriptedReply
+	requests  []map[string]any
+	headers   []http.Header
+}
+
+type scriptedReply struct {
+	status int
+	body   string
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/messages" {
+		s.t.Errorf("request path = %s, want /messages", r.URL.Path)
+	}
+	payload, _ := io.ReadAll(r.Body)
+	var body map[string]any
+	if err := json.Unmarshal(payload, &body); err != nil {
+		s.t.Errorf("request body is not JSON: %v", err)
+	}
+	s.requests = append(s.requests, body)
+	s.headers = append(s.headers, r.Header.Clone())
+
+	if len(s.responses) == 0 {
+		s.t.Error("unexpected extra request")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reply := s.responses[0]
+	s.responses = s.responses[1:]
+	w.WriteHeader(reply.status)
+	_, _ = io.WriteString(w, reply.body)
+}
+
+func newTestClient(t *testing.T, replies ...scriptedReply) (*Client, *apiServer) {
+	api := &apiServer{t: t, responses: replies}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	c := NewClient(server.Client(), "sk-ant-test", server.URL)
+	c.baseDelay = 0
+	return c, api
+}
+
+func TestCompleteRequestBody(t *testing.T) {
+	c, api := newTestClient(t, scriptedReply{http.StatusOK, `{"content":[{"type":"tool_use","name":"course_outline","input":{}}],"stop_reason":"tool_use"}`})
+
+	_, err := c.Complete(context.Background(), llm.CompletionRequest{
+		Operation:  "outline generation",
+		Prompt:     "Outline a course on pool care",
+		SchemaName: "course_outline",
+		Schema:     testSchema,
+		MaxTokens:  2000,
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+
+	header := api.headers[0]
+	if header.Get("X-Api-Key") != "sk-ant-test" || header.Get("Anthropic-Version") != APIVersion {
+		t.Errorf("headers = %v, want the API key and version", header)
+	}
+	body := api.requests[0]
+	if body["model"] != DefaultModel || body["max_tokens"] != float64(2000) {
+		t.Errorf("model = %v, max_tokens = %v, want %s and 2000", body["model"], body["max_tokens"], DefaultModel)
+	}
+	// The prompt is the only message, sent as the user and without a system prompt
+	if _, ok := body["system"]; ok {
+		t.Errorf("system = %v, want none", body["system"])
+	}
+	messages, _ := body["messages"].([]any)
+	if len(messages) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(messages))
+	}
+	if msg := messages[0].(map[string]any); msg["role"] != "user" || msg["content"] != "Outline a course on pool care" {
+		t.Errorf("message = %v, want the prompt as the user", msg)
+	}
+
+	tools, _ := body["tools"].([]any)
+	if len(tools) != 1 {
+		t.Fatalf("sent %d tools, want 1", len(tools))
+	}
+	tool := tools[0].(map[string]any)
+	if _, ok := tool["input_schema"].(map[string]any)["properties"]; tool["name"] != "course_outline" || !ok {
+		t.Errorf("tool = %v, want course_outline with the request schema", tool)
+	}
+	choice, _ := body["tool_choice"].(map[string]any)
+	if choice["type"] != "tool" || choice["name"] != "course_outline" {
+		t.Errorf("tool_choice = %v, want the course_outline tool", choice)
+	}
+}
+
+func TestCompleteDefaultMaxTokens(t *testing.T) {
+	c, api := newTestClient(t, scriptedReply{http.StatusOK, `{"content":[{"type":"text","text":"Hello"}]}`})
+
+	if _, err := c.Complete(context.Background(), llm.CompletionRequest{Operation: "test", Prompt: "Say hello"}); err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	body := api.requests[0]
+	if body["max_tokens"] != float64(defaultMaxTokens) {
+		t.Errorf("max_tokens = %v, want %d", body["max_tokens"], defaultMaxTokens)
+	}
+	if body["tools"] != nil || body["tool_choice"] != nil {
+		t.Errorf("sent tools %v without a schema", body["tools"])
+	}
+}
+
+func TestCompleteResponses(t *testing.T) {
+	tests := []struct {
+		name       string
+		replies    []scriptedReply
+		schema     map[string]any
+		wantText   string
+		wantTokens int64 // Tokens of the completion, or attached to the error
+		wantErr    string
+		wantStatus int // Status of the returned APIError, if any
+		wantSent   int
+	}{
+		{
+			name:       "text",
+			replies:    []scriptedReply{{http.StatusOK, `{"content":[{"type":"text","text":"Hel"},{"type":"text","text":"lo"}],"stop_reason":"end_turn","usage":{"input_tokens":4,"output_tokens":2}}`}},
+			wantText:   "Hello",
+			wantTokens: 6,
+			wantSent:   1,
+		},
+		{
+			name:       "structured output",
+			replies:    []scriptedReply{{http.StatusOK, `{"content":[{"type":"text","text":"Here it is"},{"type":"tool_use","name":"greeting","input":{"title":"Pools"}}],"stop_reason":"tool_use","usage":{"input_tokens":20,"output_tokens":10}}`}},
+			schema:     testSchema,
+			wantText:   `{"title":"Pools"}`,
+			wantTokens: 30,
+			wantSent:   1,
+		},
+		{
+			name:       "truncated structured output",
+			replies:    []scriptedReply{{http.StatusOK, `{"content":[],"stop_reason":"max_tokens","usage":{"input_tokens":20,"output_tokens":30}}`}},
+			schema:     testSchema,
+			wantTokens: 50,
+			wantErr:    "truncated",
+			wantSent:   1,
+		},
+		{
+			name:       "missing structured output",
+			replies:    []scriptedReply{{http.StatusOK, `{"content":[{"type":"text","text":"Sorry"}],"stop_reason":"end_turn","usage":{"input_tokens":20,"output_tokens":2}}`}},
+			schema:     testSchema,
+			wantTokens: 22,
+			wantErr:    "did not contain structured output",
+			wantSent:   1,
+		},
+		{
+			name:       "client error",
+			replies:    []scriptedReply{{http.StatusUnauthorized, `{"type":"error","error":{"type":"authentication_error","message":"invalid x-api-key"}}`}},
+			wantErr:    "invalid x-api-key",
+			wantStatus: http.StatusUnauthorized,
+			wantSent:   1,
+		},
+		{
+			name: "rate limited then served",
+			replies: []scriptedReply{
+				{http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`},
+				{http.StatusOK, `{"content":[{"type":"text","text":"Hello"}],"usage":{"input_tokens":4,"output_tokens":2}}`},
+			},
+			wantText:   "Hello",
+			wantTokens: 6,
+			wantSent:   2,
+		},
+		{
+			name: "rate limited",
+			replies: []scriptedReply{
+				{http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`},
+				{http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`},
+				{http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`},
+				{http.StatusTooManyRequests, `{"type":"error","error":{"type":"rate_limit_error","message":"Rate limited"}}`},
+			},
+			wantErr:    "failed after 3 retries",
+			wantStatus: http.StatusTooManyRequests,
+			wantSent:   llm.DefaultMaxRetries + 1,
+		},
+		{
+			name:     "overloaded then served",
+			replies:  []scriptedReply{{529, `{"type":"error","error":{"type":"overloaded_error","message":"Overloaded"}}`}, {http.StatusOK, `{"content":[{"type":"text","text":"Hello"}]}`}},
+			wantText: "Hello",
+			wantSent: 2,
+		},
+		{
+			name:     "malformed body",
+			replies:  []scriptedReply{{http.StatusOK, `{"content":[{"type":`}},
+			wantErr:  "failed to decode response",
+			wantSent: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, api := newTestClient(t, tt.replies...)
+
+			completion, err := c.Complete(context.Background(), llm.CompletionRequest{
+				Operation:  "test",
+				Prompt:     "Say hello",
+				SchemaName: "greeting",
+				Schema:     tt.schema,
+			})
+			if len(api.requests) != tt.wantSent {
+				t.Errorf("sent %d requests, want %d", len(api.requests), tt.wantSent)
+			}
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Complete() error = %v", err)
+				}
+				if completion.Text != tt.wantText || completion.TokensUsed != tt.wantTokens {
+					t.Errorf("Complete() = %q with %d tokens, want %q with %d", completion.Text, completion.TokensUsed, tt.wantText, tt.wantTokens)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Complete() error = %v, want one containing %q", err, tt.wantErr)
+			}
+			if got := service.TokensUsedBy(err); got != tt.wantTokens {
+				t.Errorf("TokensUsedBy() = %d, want %d", got, tt.wantTokens)
+			}
+			var apiErr *llm.APIError
+			if errors.As(err, &apiErr) != (tt.wantStatus != 0) || (apiErr != nil && apiErr.StatusCode != tt.wantStatus) {
+				t.Errorf("Complete() error = %#v, want an APIError with status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package gemini
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/genai"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+const (
+	// DefaultEmbeddingModel is the Gemini model used for knowledge embeddings.
+	DefaultEmbeddingModel = "text-embedding-004"
+
+	// maxEmbedBatch is the most texts the API accepts in one embed request.
+	maxEmbedBatch = 100
+)
+
+// EmbeddingModel returns the model whose embedding space the vectors belong to.
+func (c *Client) EmbeddingModel() string {
+	return DefaultEmbeddingModel
+}
+
+// EmbedDocuments computes embeddings for knowledge chunks. The Gemini API
+// does not report token usage for embeddings, so none is returned.
+func (c *Client) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, int64, error) {
+	out := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += maxEmbedBatch {
+		end := min(start+maxEmbedBatch, len(texts))
+		vectors, err := c.embed(ctx, texts[start:end], "RETRIEVAL_DOCUMENT")
+		if err != nil {
+			return nil, 0, err
+		}
+		out = append(out, vectors...)
+	}
+	return out, 0, nil
+}
+
+// EmbedQuery computes the embedding for a search query.
+func (c *Client) EmbedQuery(ctx context.Context, text string) ([]float32, int64, error) {
+	vectors, err := c.embed(ctx, []string{text}, "RETRIEVAL_QUERY")
+	if err != nil {
+		return nil, 0, err
+	}
+	return vectors[0], 0, nil
+}
+
+func (c *Client) embed(ctx context.Context, texts []string, taskType string) ([][]float32, error) {
+	contents := make([]*genai.Content, len(texts))
+	for i, text := range texts {
+		contents[i] = genai.NewContentFromText(text, genai.RoleUser)
+	}
+
+	dimensions := int32(service.EmbeddingDimensions)
+	config := &genai.EmbedContentConfig{
+		TaskType:             taskType,
+		OutputDimensionality: &dimensions,
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= c.maxRetries; attempt++ {
+		if err := c.waitForRateLimit(ctx); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Models.EmbedContent(ctx, DefaultEmbeddingModel, contents, config)
+		if err == nil {
+			if len(resp.Embeddings) != len(texts) {
+				return nil, fmt.Errorf("expected %d embeddings, got %d", len(texts), len(resp.Embeddings))
+			}
+			vectors := make([][]float32, len(resp.Embeddings))
+			for i, e := range resp.Embeddings {
+				if e == nil || len(e.Values) != service.EmbeddingDimensions {
+					return nil, fmt.Errorf("embedding %d has unexpected dimensions", i)
+				}
+				vectors[i] = e.Values
+			}
+			return vectors, nil
+		}
+
+		lastErr = err
+		if !isRateLimitError(err) || attempt == c.maxRetries {
+			break
+		}
+
+		// Exponential backoff, matching generateWithRetry
+		delay := c.baseDelay * time.Duration(1<<attempt)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("embedding cancelled during retry backoff: %w", ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+
+	return nil, fmt.Errorf("failed to compute embeddings: %w", lastErr)
+}
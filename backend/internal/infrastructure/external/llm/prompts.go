@@ -0,0 +1,327 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Prompt builders
+
+// buildSectionsOnlyPrompt creates the prompt for the first call - sections with lesson titles only
+func buildSectionsOnlyPrompt(req service.GenerateOutlineRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert instructional designer creating a course outline.\n\n")
+
+	sb.WriteString("## Course Information\n")
+	sb.WriteString(fmt.Sprintf("**Title:** %s\n", req.CourseTitle))
+	sb.WriteString(fmt.Sprintf("**Desired Outcome:** %s\n\n", req.DesiredOutcome))
+
+	sb.WriteString("## Target Audience\n")
+	sb.WriteString(fmt.Sprintf("**Role:** %s\n", req.TargetAudience.Role))
+	sb.WriteString(fmt.Sprintf("**Experience Level:** %s\n", req.TargetAudience.ExperienceLevel))
+	if len(req.TargetAudience.LearningGoals) > 0 {
+		sb.WriteString(fmt.Sprintf("**Learning Goals:** %s\n", strings.Join(req.TargetAudience.LearningGoals, ", ")))
+	}
+	if len(req.TargetAudience.Prerequisites) > 0 {
+		sb.WriteString(fmt.Sprintf("**Prerequisites:** %s\n", strings.Join(req.TargetAudience.Prerequisites, ", ")))
+	}
+	if len(req.TargetAudience.Challenges) > 0 {
+		sb.WriteString(fmt.Sprintf("**Challenges:** %s\n", strings.Join(req.TargetAudience.Challenges, ", ")))
+	}
+	if req.TargetAudience.IndustryContext != "" {
+		sb.WriteString(fmt.Sprintf("**Industry Context:** %s\n", req.TargetAudience.IndustryContext))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Subject Matter Expert Knowledge\n")
+	for _, sme := range req.SMEKnowledge {
+		sb.WriteString(fmt.Sprintf("\n### %s (%s)\n", sme.SMEName, sme.Domain))
+		if sme.Summary != "" {
+			sb.WriteString(fmt.Sprintf("**Summary:** %s\n", sme.Summary))
+		}
+		if len(sme.Keywords) > 0 {
+			sb.WriteString(fmt.Sprintf("**Key Topics:** %s\n", strings.Join(sme.Keywords, ", ")))
+		}
+		for i, chunk := range sme.Chunks {
+			if i < 5 { // Limit chunks to avoid context overflow
+				sb.WriteString(fmt.Sprintf("\n%s\n", chunk))
+			}
+		}
+	}
+	sb.WriteString("\n")
+
+	if req.AdditionalContext != "" {
+		sb.WriteString("## Additional Context\n")
+		sb.WriteString(req.AdditionalContext)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("Create a high-level course outline with sections and lesson titles.\n")
+	sb.WriteString("Each section should have a clear theme and 2-5 lessons.\n")
+	sb.WriteString("For each section, provide the section title, description, and a list of lesson titles.\n")
+	sb.WriteString("Ensure content flows logically and builds on previous sections.\n")
+
+	return sb.String()
+}
+
+// buildSectionLessonsPrompt creates the prompt for generating detailed lessons for a specific section
+func buildSectionLessonsPrompt(req service.GenerateOutlineRequest, sectionTitle, sectionDescription string, lessonTitles []string) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert instructional designer creating detailed lesson plans.\n\n")
+
+	sb.WriteString("## Course Information\n")
+	sb.WriteString(fmt.Sprintf("**Course Title:** %s\n", req.CourseTitle))
+	sb.WriteString(fmt.Sprintf("**Desired Outcome:** %s\n\n", req.DesiredOutcome))
+
+	sb.WriteString("## Current Section\n")
+	sb.WriteString(fmt.Sprintf("**Section Title:** %s\n", sectionTitle))
+	sb.WriteString(fmt.Sprintf("**Section Description:** %s\n\n", sectionDescription))
+
+	sb.WriteString("## Lesson Titles to Expand\n")
+	for i, title := range lessonTitles {
+		sb.WriteString(fmt.Sprintf("%d. %s\n", i+1, title))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Target Audience\n")
+	sb.WriteString(fmt.Sprintf("**Role:** %s\n", req.TargetAudience.Role))
+	sb.WriteString(fmt.Sprintf("**Experience Level:** %s\n", req.TargetAudience.ExperienceLevel))
+	if len(req.TargetAudience.Challenges) > 0 {
+		sb.WriteString(fmt.Sprintf("**Challenges:** %s\n", strings.Join(req.TargetAudience.Challenges, ", ")))
+	}
+	sb.WriteString("\n")
+
+	// Include limited SME knowledge for context
+	if len(req.SMEKnowledge) > 0 {
+		sb.WriteString("## Subject Matter Expert Knowledge (Summary)\n")
+		for _, sme := range req.SMEKnowledge {
+			if sme.Summary != "" {
+				sb.WriteString(fmt.Sprintf("**%s (%s):** %s\n", sme.SMEName, sme.Domain, sme.Summary))
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("For each lesson title provided above, create detailed lesson information:\n")
+	sb.WriteString("- Keep the original title or improve it slightly\n")
+	sb.WriteString("- Write a brief description of what the lesson covers\n")
+	sb.WriteString("- Estimate duration (5-20 minutes)\n")
+	sb.WriteString("- Include 2-4 specific, measurable learning objectives\n")
+	sb.WriteString("- Ensure lessons flow logically within the section\n")
+
+	return sb.String()
+}
+
+func buildLessonPrompt(req service.GenerateLessonRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert instructional designer creating lesson content.\n\n")
+
+	sb.WriteString("## Lesson Information\n")
+	sb.WriteString(fmt.Sprintf("**Course:** %s\n", req.CourseTitle))
+	sb.WriteString(fmt.Sprintf("**Section:** %s\n", req.SectionTitle))
+	sb.WriteString(fmt.Sprintf("**Lesson:** %s\n", req.LessonTitle))
+	sb.WriteString(fmt.Sprintf("**Description:** %s\n\n", req.LessonDescription))
+
+	sb.WriteString("## Learning Objectives\n")
+	for _, obj := range req.LearningObjectives {
+		sb.WriteString(fmt.Sprintf("- %s\n", obj))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Target Audience\n")
+	sb.WriteString(fmt.Sprintf("**Role:** %s\n", req.TargetAudience.Role))
+	sb.WriteString(fmt.Sprintf("**Experience Level:** %s\n", req.TargetAudience.ExperienceLevel))
+	if len(req.TargetAudience.Challenges) > 0 {
+		sb.WriteString(fmt.Sprintf("**Challenges:** %s\n", strings.Join(req.TargetAudience.Challenges, ", ")))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Subject Matter Expert Knowledge\n")
+	for _, sme := range req.SMEKnowledge {
+		sb.WriteString(fmt.Sprintf("\n### %s (%s)\n", sme.SMEName, sme.Domain))
+		// Chunks are already narrowed to the lesson by retrieval
+		for i, chunk := range sme.Chunks {
+			if i < len(sme.ChunkTimes) && sme.ChunkTimes[i] != "" {
+				sb.WriteString(fmt.Sprintf("\n[chunk_id: %s] (from minute %s of a recorded session)\n%s\n", sme.ChunkIDs[i], sme.ChunkTimes[i], chunk))
+			} else if i < len(sme.ChunkIDs) {
+				sb.WriteString(fmt.Sprintf("\n[chunk_id: %s]\n%s\n", sme.ChunkIDs[i], chunk))
+			} else {
+				sb.WriteString(fmt.Sprintf("\n%s\n", chunk))
+			}
+		}
+	}
+	sb.WriteString("\n")
+
+	if req.PreviousLessonTitle != "" {
+		sb.WriteString(fmt.Sprintf("**Previous Lesson:** %s\n", req.PreviousLessonTitle))
+	}
+	if req.NextLessonTitle != "" {
+		sb.WriteString(fmt.Sprintf("**Next Lesson:** %s\n", req.NextLessonTitle))
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("Create engaging lesson content using these component types:\n")
+	sb.WriteString("- **heading**: Section headers (use h2 for main sections, h3 for subsections)\n")
+	sb.WriteString("- **text**: Rich text content with explanations and examples\n")
+	sb.WriteString("- **image**: Suggested images with descriptive placeholders\n")
+	sb.WriteString("- **quiz**: Knowledge check questions to reinforce learning\n\n")
+	sb.WriteString("Structure the lesson with:\n")
+	sb.WriteString("1. Introduction (heading + text)\n")
+	sb.WriteString("2. Main content sections with explanations and examples\n")
+	sb.WriteString("3. At least one quiz to check understanding\n")
+	sb.WriteString("4. Summary or key takeaways\n\n")
+	sb.WriteString("For every component, list in source_chunk_ids the [chunk_id: ...] labels of the SME knowledge it draws on, ")
+	sb.WriteString("copied exactly. Only cite chunks that actually support the component; leave the list empty otherwise. ")
+	sb.WriteString("When content comes from a recorded session, you may point learners to it by time, e.g. \"minute 12:30 of the recorded session\".\n\n")
+
+	if len(req.CompletedComponents) > 0 {
+		sb.WriteString("## Content Already Written\n")
+		sb.WriteString("The start of this lesson has already been written. Continue the lesson from where it stops: ")
+		sb.WriteString("return only the remaining components, without repeating any of these.\n")
+		for _, comp := range req.CompletedComponents {
+			sb.WriteString(fmt.Sprintf("\n%d. %s: %s\n", comp.Order, comp.Type, comp.ContentJSON))
+		}
+		sb.WriteString("\n")
+	}
+
+	if !req.IsLastInCourse && req.NextLessonTitle != "" {
+		sb.WriteString("Include a segue_text that transitions to the next lesson.\n")
+	} else {
+		sb.WriteString("This is the final lesson, so provide a course conclusion in segue_text.\n")
+	}
+
+	return sb.String()
+}
+
+func buildRegeneratePrompt(req service.RegenerateComponentRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert instructional designer modifying lesson content.\n\n")
+
+	sb.WriteString("## Current Content\n")
+	sb.WriteString(fmt.Sprintf("**Component Type:** %s\n", req.ComponentType))
+	sb.WriteString(fmt.Sprintf("**Current Content:**\n```json\n%s\n```\n\n", req.CurrentContentJSON))
+
+	sb.WriteString("## Modification Request\n")
+	sb.WriteString(req.ModificationPrompt)
+	sb.WriteString("\n\n")
+
+	if req.LessonContext != "" {
+		sb.WriteString("## Lesson Context\n")
+		sb.WriteString(req.LessonContext)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("## Target Audience\n")
+	sb.WriteString(fmt.Sprintf("**Role:** %s\n", req.TargetAudience.Role))
+	sb.WriteString(fmt.Sprintf("**Experience Level:** %s\n\n", req.TargetAudience.ExperienceLevel))
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("Regenerate the component according to the modification request.\n")
+	sb.WriteString("Maintain the same component type and structure.\n")
+	sb.WriteString("Ensure the content is appropriate for the target audience.\n")
+
+	return sb.String()
+}
+
+func buildSMEProcessingPrompt(req service.ProcessSMEContentRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert at extracting and organizing knowledge for educational content.\n\n")
+
+	sb.WriteString("## Subject Matter Expert Information\n")
+	sb.WriteString(fmt.Sprintf("**Name:** %s\n", req.SMEName))
+	sb.WriteString(fmt.Sprintf("**Domain:** %s\n\n", req.SMEDomain))
+
+	sb.WriteString("## Source Content\n")
+	sb.WriteString(req.ExtractedText)
+	sb.WriteString("\n\n")
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("Analyze this content and extract key knowledge:\n\n")
+	sb.WriteString("1. **Summary**: Write a comprehensive summary (2-3 paragraphs) of the main knowledge.\n\n")
+	sb.WriteString("2. **Knowledge Chunks**: Extract discrete, self-contained pieces of knowledge:\n")
+	sb.WriteString("   - Each chunk should cover one concept or topic\n")
+	sb.WriteString("   - Assign a topic category to each chunk\n")
+	sb.WriteString("   - Extract relevant keywords\n")
+	sb.WriteString("   - Rate relevance (0-1) based on how useful this is for course creation\n")
+	sb.WriteString("   - Aim for 5-15 chunks depending on content density\n")
+	sb.WriteString("   - Record where each chunk came from: the nearest Markdown heading above it as source_heading, ")
+	sb.WriteString("the number from the nearest [Page N] marker above it as source_page, ")
+	sb.WriteString("and the time from the nearest [Time M:SS] marker above it as source_time (leave these empty or 0 if the content has none)\n\n")
+	sb.WriteString("Focus on actionable knowledge that can be taught to learners.\n")
+
+	return sb.String()
+}
+
+func buildSMESummaryPrompt(req service.SummarizeSMEKnowledgeRequest) string {
+	var sb strings.Builder
+
+	sb.WriteString("You are an expert at organizing knowledge for educational content.\n\n")
+
+	sb.WriteString("## Subject Matter Expert Information\n")
+	sb.WriteString(fmt.Sprintf("**Name:** %s\n", req.SMEName))
+	sb.WriteString(fmt.Sprintf("**Domain:** %s\n\n", req.SMEDomain))
+
+	sb.WriteString("## Knowledge Base\n")
+	for i, chunk := range req.Chunks {
+		if i < len(req.Topics) && req.Topics[i] != "" {
+			sb.WriteString(fmt.Sprintf("### %s\n", req.Topics[i]))
+		}
+		sb.WriteString(chunk)
+		sb.WriteString("\n\n")
+	}
+
+	sb.WriteString("## Instructions\n")
+	sb.WriteString("Write a summary (2-4 paragraphs) of everything this knowledge base covers.\n")
+	sb.WriteString("- Cover every major topic, not just the first few\n")
+	sb.WriteString("- Describe what the knowledge base contains; do not add facts that are not in it\n")
+	sb.WriteString("- Write in a professional, educational tone suitable for planning course content\n\n")
+	sb.WriteString("Return only the summary text without any additional formatting or headers.\n")
+
+	return sb.String()
+}
+
+func buildSummarizePrompt(content string) string {
+	return fmt.Sprintf(`You are an expert at creating concise summaries of knowledge content.
+
+## Content to Summarize
+%s
+
+## Instructions
+Create a clear, concise summary of the above content. The summary should:
+- Capture the key points and main ideas
+- Be 2-4 paragraphs long
+- Be written in a professional, educational tone
+- Preserve important details and facts
+- Be suitable for use as SME knowledge for course generation
+
+Return only the summary text without any additional formatting or headers.`, content)
+}
+
+func buildImprovePrompt(content string) string {
+	return fmt.Sprintf(`You are an expert editor who improves content for clarity and structure.
+
+## Content to Improve
+%s
+
+## Instructions
+Improve the above content by:
+- Fixing grammar and spelling errors
+- Improving clarity and readability
+- Organizing information logically
+- Breaking up long paragraphs
+- Adding appropriate structure (headers, bullet points where helpful)
+- Maintaining the original meaning and facts
+- Keeping a professional, educational tone
+
+Return only the improved content without any additional commentary.`, content)
+}
@@ -0,0 +1,490 @@
+// Package llm implements service.AIProvider on top of any chat model API.
+// Prompts, JSON schemas and response parsing live here so every provider
+// (Gemini, OpenAI, Anthropic) produces the same structured results; each
+// provider package only implements Completer for its HTTP API.
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// CompletionRequest is a single prompt sent to a model.
+type CompletionRequest struct {
+	Operation  string         // Human-readable operation name for errors and retries
+	Prompt     string         // User prompt
+	SchemaName string         // Name of the structured output (required by some APIs)
+	Schema     map[string]any // JSON schema for structured output; nil for free text
+	MaxTokens  int            // Maximum output tokens; 0 uses the provider default
+}
+
+// Completion is a model response.
+type Completion struct {
+	Text       string // Response text; JSON matching the schema when one was given
+	TokensUsed int64
+}
+
+// Completer sends a prompt to a model API and returns its response.
+type Completer interface {
+	Complete(ctx context.Context, req CompletionRequest) (*Completion, error)
+}
+
+// StreamCompleter is implemented by Completers whose API can stream a
+// response as it is generated.
+type StreamCompleter interface {
+	// CompleteStream sends the prompt like Complete, calling onText with each
+	// piece of response text as it arrives. An error from onText stops the
+	// stream and is returned.
+	CompleteStream(ctx context.Context, req CompletionRequest, onText func(string) error) (*Completion, error)
+}
+
+// WithUsage attaches the tokens spent before err occurred, so callers can bill
+// them; see service.UsageError. It returns err unchanged when none were used.
+func WithUsage(err error, tokensUsed int64) error {
+	if tokensUsed == 0 {
+		return err
+	}
+	return &service.UsageError{TokensUsed: tokensUsed, Err: err}
+}
+
+// Provider implements service.AIProvider using a Completer.
+type Provider struct {
+	completer Completer
+}
+
+// NewProvider creates a Provider that sends prompts through the given Completer.
+func NewProvider(completer Completer) *Provider {
+	return &Provider{completer: completer}
+}
+
+// TestConnection tests if the API key is valid by making a simple request.
+func (p *Provider) TestConnection(ctx context.Context) error {
+	_, err := p.completer.Complete(ctx, CompletionRequest{
+		Operation: "test connection",
+		Prompt:    "Say 'OK' if you can read this.",
+		MaxTokens: 10,
+	})
+	if err != nil {
+		return fmt.Errorf("API key validation failed: %w", err)
+	}
+	return nil
+}
+
+// GenerateCourseOutline generates a course outline using structured output.
+// This uses a two-call approach to avoid nested schema depth limits:
+// 1. First call generates sections with lesson titles only (flat schema)
+// 2. Second calls generate detailed lessons for each section
+func (p *Provider) GenerateCourseOutline(ctx context.Context, req service.GenerateOutlineRequest) (*service.GenerateOutlineResult, error) {
+	var totalTokensUsed int64
+
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("outline generation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	// Step 1: Generate sections with lesson titles only
+	var sectionsResp sectionsOnlyResponse
+	_, tokensUsed, err := p.completeJSON(ctx, CompletionRequest{
+		Operation:  "generate sections",
+		Prompt:     buildSectionsOnlyPrompt(req),
+		SchemaName: "course_sections",
+		Schema:     sectionsOnlySchema(),
+	}, &sectionsResp)
+	totalTokensUsed += tokensUsed
+	if err != nil {
+		return nil, WithUsage(fmt.Errorf("failed to generate sections: %w", err), totalTokensUsed)
+	}
+
+	// Step 2: Generate detailed lessons for each section
+	sections := make([]service.OutlineSectionResult, len(sectionsResp.Sections))
+
+	for i, section := range sectionsResp.Sections {
+		// Check for cancellation before each section
+		select {
+		case <-ctx.Done():
+			return nil, WithUsage(fmt.Errorf("outline generation cancelled after %d sections: %w", i, ctx.Err()), totalTokensUsed)
+		default:
+		}
+
+		var lessonsResp sectionLessonsResponse
+		_, tokensUsed, err := p.completeJSON(ctx, CompletionRequest{
+			Operation:  fmt.Sprintf("generate lessons for section %d", i+1),
+			Prompt:     buildSectionLessonsPrompt(req, section.Title, section.Description, section.LessonTitles),
+			SchemaName: "section_lessons",
+			Schema:     sectionLessonsSchema(),
+		}, &lessonsResp)
+		totalTokensUsed += tokensUsed
+		if err != nil {
+			return nil, WithUsage(fmt.Errorf("failed to generate lessons for section %q: %w", section.Title, err), totalTokensUsed)
+		}
+
+		// Convert to domain result
+		lessons := make([]service.OutlineLessonResult, len(lessonsResp.Lessons))
+		for j, l := range lessonsResp.Lessons {
+			lessons[j] = service.OutlineLessonResult{
+				Title:                    l.Title,
+				Description:              l.Description,
+				Order:                    j + 1,
+				EstimatedDurationMinutes: l.EstimatedDurationMinutes,
+				LearningObjectives:       l.LearningObjectives,
+				IsLastInSection:          j == len(lessonsResp.Lessons)-1,
+			}
+		}
+
+		sections[i] = service.OutlineSectionResult{
+			Title:       section.Title,
+			Description: section.Description,
+			Order:       i + 1,
+			Lessons:     lessons,
+		}
+	}
+
+	// Set IsLastInCourse on the last lesson
+	if len(sections) > 0 {
+		lastSection := &sections[len(sections)-1]
+		if len(lastSection.Lessons) > 0 {
+			lastSection.Lessons[len(lastSection.Lessons)-1].IsLastInCourse = true
+		}
+	}
+
+	return &service.GenerateOutlineResult{
+		Sections:   sections,
+		TokensUsed: totalTokensUsed,
+	}, nil
+}
+
+// GenerateLessonContent generates content for a single lesson.
+func (p *Provider) GenerateLessonContent(ctx context.Context, req service.GenerateLessonRequest) (*service.GenerateLessonResult, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("lesson generation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	var lessonResp lessonContentResponse
+	_, tokensUsed, err := p.completeJSON(ctx, CompletionRequest{
+		Operation:  "generate lesson content",
+		Prompt:     buildLessonPrompt(req),
+		SchemaName: "lesson_content",
+		Schema:     lessonContentSchema(),
+	}, &lessonResp)
+	if err != nil {
+		return nil, WithUsage(fmt.Errorf("failed to generate lesson content: %w", err), tokensUsed)
+	}
+
+	// Convert to domain result - transform flat schema to nested contentJSON
+	components := make([]service.LessonComponentResult, len(lessonResp.Components))
+	for i, comp := range lessonResp.Components {
+		contentJSON, err := comp.toContentJSON()
+		if err != nil {
+			return nil, WithUsage(fmt.Errorf("failed to convert component content: %w", err), tokensUsed)
+		}
+		components[i] = service.LessonComponentResult{
+			Type:           comp.ComponentType,
+			Order:          i + 1,
+			ContentJSON:    contentJSON,
+			SourceChunkIDs: comp.SourceChunkIDs,
+		}
+	}
+
+	return &service.GenerateLessonResult{
+		Components: components,
+		SegueText:  lessonResp.SegueText,
+		TokensUsed: tokensUsed,
+	}, nil
+}
+
+// StreamLessonContent generates content for a single lesson, passing each
+// component to onComponent as soon as the model has finished writing it.
+// Completers that cannot stream deliver every component once the whole
+// response has arrived.
+func (p *Provider) StreamLessonContent(ctx context.Context, req service.GenerateLessonRequest, onComponent func(service.LessonComponentResult) error) (*service.GenerateLessonResult, error) {
+	streamer, ok := p.completer.(StreamCompleter)
+	if !ok {
+		result, err := p.GenerateLessonContent(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, comp := range result.Components {
+			if err := onComponent(comp); err != nil {
+				return nil, WithUsage(err, result.TokensUsed)
+			}
+		}
+		return result, nil
+	}
+
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("lesson generation cancelled: %w", ctx.Err())
+	default:
+	}
+
+	var components []service.LessonComponentResult
+	deliver := func(comp flatLessonComponent) error {
+		contentJSON, err := comp.toContentJSON()
+		if err != nil {
+			return fmt.Errorf("failed to convert component content: %w", err)
+		}
+		result := service.LessonComponentResult{
+			Type:           comp.ComponentType,
+			Order:          len(components) + 1,
+			ContentJSON:    contentJSON,
+			SourceChunkIDs: comp.SourceChunkIDs,
+		}
+		if err := onComponent(result); err != nil {
+			return err
+		}
+		components = append(components, result)
+		return nil
+	}
+
+	completionReq := CompletionRequest{
+		Operation:  "generate lesson content",
+		Prompt:     buildLessonPrompt(req),
+		SchemaName: "lesson_content",
+		Schema:     lessonContentSchema(),
+	}
+	stream := newComponentStream()
+	result, err := streamer.CompleteStream(ctx, completionReq, func(text string) error {
+		for _, comp := range stream.write(text) {
+			if err := deliver(comp); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The completer reports what a stream cut off partway through used
+		return nil, WithUsage(fmt.Errorf("failed to generate lesson content: %w", err), service.TokensUsedBy(err))
+	}
+	tokensUsed := result.TokensUsed
+
+	var lessonResp lessonContentResponse
+	text, err := decodeStructured(result.Text, completionReq.Schema)
+	if err == nil {
+		err = json.Unmarshal([]byte(text), &lessonResp)
+	}
+
+	var remaining []flatLessonComponent
+	if err == nil {
+		// Deliver any components the stream could not pick out on its own
+		if len(lessonResp.Components) > len(components) {
+			remaining = lessonResp.Components[len(components):]
+		}
+	} else {
+		// Re-prompt with the rejected response for the rest of the lesson.
+		// Components already delivered are kept and listed as written, so the
+		// corrected response only supplies the components after them.
+		continued := req
+		continued.CompletedComponents = append(slices.Clone(req.CompletedComponents), continueAfter(req.CompletedComponents, components)...)
+		repairReq := completionReq
+		repairReq.Prompt = buildRepairPrompt(buildLessonPrompt(continued), completionReq.Schema, result.Text, err)
+		lessonResp = lessonContentResponse{}
+		_, repairTokens, err := p.completeJSON(ctx, repairReq, &lessonResp)
+		tokensUsed += repairTokens
+		if err != nil {
+			return nil, WithUsage(fmt.Errorf("failed to generate lesson content: %w", err), tokensUsed)
+		}
+		remaining = lessonResp.Components
+	}
+
+	for _, comp := range remaining {
+		if err := deliver(comp); err != nil {
+			return nil, WithUsage(err, tokensUsed)
+		}
+	}
+
+	return &service.GenerateLessonResult{
+		Components: components,
+		SegueText:  lessonResp.SegueText,
+		TokensUsed: tokensUsed,
+	}, nil
+}
+
+// continueAfter numbers components delivered in this call after those an
+// interrupted attempt already stored, as the lesson prompt lists them.
+func continueAfter(completed, delivered []service.LessonComponentResult) []service.LessonComponentResult {
+	var last int
+	for _, comp := range completed {
+		last = max(last, comp.Order)
+	}
+
+	renumbered := make([]service.LessonComponentResult, len(delivered))
+	for i, comp := range delivered {
+		comp.Order = last + comp.Order
+		renumbered[i] = comp
+	}
+	return renumbered
+}
+
+// RegenerateComponent regenerates a single component with modifications.
+func (p *Provider) RegenerateComponent(ctx context.Context, req service.RegenerateComponentRequest) (*service.RegenerateComponentResult, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("component regeneration cancelled: %w", ctx.Err())
+	default:
+	}
+
+	var content map[string]any
+	contentJSON, tokensUsed, err := p.completeJSON(ctx, CompletionRequest{
+		Operation:  "regenerate component",
+		Prompt:     buildRegeneratePrompt(req),
+		SchemaName: req.ComponentType + "_component",
+		Schema:     componentSchema(req.ComponentType),
+	}, &content)
+	if err != nil {
+		return nil, WithUsage(fmt.Errorf("failed to regenerate component: %w", err), tokensUsed)
+	}
+
+	return &service.RegenerateComponentResult{
+		ContentJSON: contentJSON,
+		TokensUsed:  tokensUsed,
+	}, nil
+}
+
+// ProcessSMEContent processes and distills knowledge from SME submission.
+func (p *Provider) ProcessSMEContent(ctx context.Context, req service.ProcessSMEContentRequest) (*service.ProcessSMEContentResult, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("SME content processing cancelled: %w", ctx.Err())
+	default:
+	}
+
+	var smeResp smeProcessingResponse
+	_, tokensUsed, err := p.completeJSON(ctx, CompletionRequest{
+		Operation:  "process SME content",
+		Prompt:     buildSMEProcessingPrompt(req),
+		SchemaName: "sme_knowledge",
+		Schema:     smeProcessingSchema(),
+	}, &smeResp)
+	if err != nil {
+		return nil, WithUsage(fmt.Errorf("failed to process SME content: %w", err), tokensUsed)
+	}
+
+	// Convert to domain result
+	chunks := make([]service.SMEChunkResult, len(smeResp.Chunks))
+	for i, chunk := range smeResp.Chunks {
+		chunks[i] = service.SMEChunkResult{
+			Content:         chunk.Content,
+			Topic:           chunk.Topic,
+			Keywords:        chunk.Keywords,
+			RelevanceScore:  chunk.RelevanceScore,
+			SourceHeading:   chunk.SourceHeading,
+			SourcePage:      chunk.SourcePage,
+			SourceTimestamp: entity.ParseTimestamp(chunk.SourceTime),
+		}
+	}
+
+	return &service.ProcessSMEContentResult{
+		Summary:    smeResp.Summary,
+		Chunks:     chunks,
+		TokensUsed: tokensUsed,
+	}, nil
+}
+
+// SummarizeSMEKnowledge writes an SME's knowledge summary from its current chunks.
+func (p *Provider) SummarizeSMEKnowledge(ctx context.Context, req service.SummarizeSMEKnowledgeRequest) (*service.SummarizeSMEKnowledgeResult, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("SME summarization cancelled: %w", ctx.Err())
+	default:
+	}
+
+	result, err := p.completer.Complete(ctx, CompletionRequest{
+		Operation: "summarize SME knowledge",
+		Prompt:    buildSMESummaryPrompt(req),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize SME knowledge: %w", err)
+	}
+
+	return &service.SummarizeSMEKnowledgeResult{
+		Summary:    strings.TrimSpace(result.Text),
+		TokensUsed: result.TokensUsed,
+	}, nil
+}
+
+// completeJSON sends a structured request and decodes the response into out.
+// Responses that are not valid JSON or do not match the schema are repaired
+// where possible; otherwise the model is re-prompted with the problem, up to
+// maxJSONAttempts in total. Returns the validated JSON text and the tokens
+// used across all attempts.
+func (p *Provider) completeJSON(ctx context.Context, req CompletionRequest, out any) (string, int64, error) {
+	var tokensUsed int64
+	var lastErr error
+	attemptReq := req
+
+	for attempt := 1; attempt <= maxJSONAttempts; attempt++ {
+		result, err := p.completer.Complete(ctx, attemptReq)
+		if err != nil {
+			return "", tokensUsed + service.TokensUsedBy(err), err
+		}
+		tokensUsed += result.TokensUsed
+
+		text, err := decodeStructured(result.Text, req.Schema)
+		if err == nil {
+			if err = json.Unmarshal([]byte(text), out); err == nil {
+				return text, tokensUsed, nil
+			}
+			err = fmt.Errorf("response has the wrong field types: %w", err)
+		}
+
+		lastErr = err
+		attemptReq.Prompt = buildRepairPrompt(req.Prompt, req.Schema, result.Text, err)
+	}
+
+	return "", tokensUsed, fmt.Errorf("%s: no valid response after %d attempts: %w", req.Operation, maxJSONAttempts, lastErr)
+}
+
+// SummarizeContent creates a concise summary of the provided content.
+func (p *Provider) SummarizeContent(ctx context.Context, content string) (string, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("summarization cancelled: %w", ctx.Err())
+	default:
+	}
+
+	result, err := p.completer.Complete(ctx, CompletionRequest{
+		Operation: "summarize content",
+		Prompt:    buildSummarizePrompt(content),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to summarize content: %w", err)
+	}
+
+	return result.Text, nil
+}
+
+// ImproveContent improves the provided content by cleaning up, clarifying, and structuring it.
+func (p *Provider) ImproveContent(ctx context.Context, content string) (string, error) {
+	// Check for cancellation at start
+	select {
+	case <-ctx.Done():
+		return "", fmt.Errorf("content improvement cancelled: %w", ctx.Err())
+	default:
+	}
+
+	result, err := p.completer.Complete(ctx, CompletionRequest{
+		Operation: "improve content",
+		Prompt:    buildImprovePrompt(content),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to improve content: %w", err)
+	}
+
+	return result.Text, nil
+}
@@ -0,0 +1,124 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// scriptedResponse is one reply of a scriptedCompleter. A streamed reply
+// sends text in pieces of 16 bytes before failing with err, if set.
+type scriptedResponse struct {
+	text   string
+	tokens int64
+	err    error
+}
+
+// scriptedCompleter replies to each request with the next scripted response
+// and records the requests it was sent.
+type scriptedCompleter struct {
+	responses []scriptedResponse
+	requests  []CompletionRequest
+}
+
+func (c *scriptedCompleter) next(req CompletionRequest) scriptedResponse {
+	c.requests = append(c.requests, req)
+	if len(c.responses) == 0 {
+		return scriptedResponse{err: errors.New("no more responses")}
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return resp
+}
+
+func (c *scriptedCompleter) Complete(_ context.Context, req CompletionRequest) (*Completion, error) {
+	resp := c.next(req)
+	if resp.err != nil {
+		return nil, WithUsage(resp.err, resp.tokens)
+	}
+	return &Completion{Text: resp.text, TokensUsed: resp.tokens}, nil
+}
+
+func (c *scriptedCompleter) CompleteStream(_ context.Context, req CompletionRequest, onText func(string) error) (*Completion, error) {
+	resp := c.next(req)
+	for start := 0; start < len(resp.text); start += 16 {
+		if err := onText(resp.text[start:min(start+16, len(resp.text))]); err != nil {
+			return nil, WithUsage(err, resp.tokens)
+		}
+	}
+	if resp.err != nil {
+		return nil, WithUsage(resp.err, resp.tokens)
+	}
+	return &Completion{Text: resp.text, TokensUsed: resp.tokens}, nil
+}
+
+func TestStreamLessonContentReportsUsageOnFailure(t *testing.T) {
+	// The stream breaks off inside the quiz, after two complete components
+	cutoff := len(streamedLesson) - 120
+	completer := &scriptedCompleter{responses: []scriptedResponse{
+		{text: streamedLesson[:cutoff], tokens: 90, err: errors.New("connection reset")},
+	}}
+
+	var delivered []string
+	_, err := NewProvider(completer).StreamLessonContent(context.Background(), service.GenerateLessonRequest{}, func(comp service.LessonComponentResult) error {
+		delivered = append(delivered, comp.Type)
+		return nil
+	})
+	if err == nil {
+		t.Fatal("StreamLessonContent() succeeded, want the stream error")
+	}
+	if len(delivered) != 2 {
+		t.Errorf("delivered %v before the failure, want heading and text", delivered)
+	}
+	if got := service.TokensUsedBy(err); got != 90 {
+		t.Errorf("TokensUsedBy() = %d, want 90", got)
+	}
+}
+
+func TestStreamLessonContentReportsUsageWhenStoringFails(t *testing.T) {
+	completer := &scriptedCompleter{responses: []scriptedResponse{{text: streamedLesson, tokens: 150}}}
+
+	storeErr := errors.New("database unavailable")
+	_, err := NewProvider(completer).StreamLessonContent(context.Background(), service.GenerateLessonRequest{}, func(service.LessonComponentResult) error {
+		return storeErr
+	})
+	if !errors.Is(err, storeErr) {
+		t.Fatalf("StreamLessonContent() error = %v, want the storage error", err)
+	}
+	if got := service.TokensUsedBy(err); got != 150 {
+		t.Errorf("TokensUsedBy() = %d, want 150", got)
+	}
+}
+
+func TestRegenerateComponentReportsUsageOnFailure(t *testing.T) {
+	// Every attempt is billed, including the failed final one
+	completer := &scriptedCompleter{responses: []scriptedResponse{
+		{text: "not JSON", tokens: 10},
+		{text: `{"unexpected": true}`, tokens: 12},
+		{tokens: 5, err: errors.New("response was truncated at the token limit")},
+	}}
+
+	_, err := NewProvider(completer).RegenerateComponent(context.Background(), service.RegenerateComponentRequest{ComponentType: "text"})
+	if err == nil {
+		t.Fatal("RegenerateComponent() succeeded, want an error")
+	}
+	if got := service.TokensUsedBy(err); got != 27 {
+		t.Errorf("TokensUsedBy() = %d, want 27", got)
+	}
+}
+
+func TestWithUsage(t *testing.T) {
+	base := errors.New("failed")
+	if err := WithUsage(base, 0); err != base {
+		t.Errorf("WithUsage(err, 0) = %v, want err unchanged", err)
+	}
+	err := WithUsage(base, 42)
+	if !errors.Is(err, base) || service.TokensUsedBy(err) != 42 {
+		t.Errorf("WithUsage(err, 42) = %v with %d tokens", err, service.TokensUsedBy(err))
+	}
+	if service.TokensUsedBy(base) != 0 {
+		t.Error("TokensUsedBy() reported tokens for a plain error")
+	}
+}
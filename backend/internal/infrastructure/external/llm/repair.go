@@ -0,0 +1,161 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// maxJSONAttempts is how many times a structured request is sent before giving
+// up: the first attempt plus re-prompts that quote the problem back to the model.
+const maxJSONAttempts = 3
+
+// maxQuotedResponse bounds how much of an invalid response is quoted in a re-prompt.
+const maxQuotedResponse = 4000
+
+// decodeStructured repairs a structured response and validates it against the
+// schema, returning the repaired JSON text.
+func decodeStructured(text string, schema map[string]any) (string, error) {
+	repaired := repairJSON(text)
+
+	var value any
+	if err := json.Unmarshal([]byte(repaired), &value); err != nil {
+		return "", fmt.Errorf("response is not valid JSON: %w", err)
+	}
+	if err := validateSchema(value, schema, "$"); err != nil {
+		return "", err
+	}
+	return repaired, nil
+}
+
+// repairJSON fixes the common ways models break JSON output: Markdown code
+// fences, prose around the object, trailing commas, and output truncated
+// before closing strings, arrays and objects.
+func repairJSON(text string) string {
+	s := strings.TrimSpace(text)
+
+	// Strip a Markdown code fence (```json ... ```)
+	if strings.HasPrefix(s, "```") {
+		if nl := strings.IndexByte(s, '\n'); nl >= 0 {
+			s = s[nl+1:]
+		}
+		s = strings.TrimSuffix(strings.TrimSpace(s), "```")
+	}
+
+	// Drop prose before the object and after its last closing brace
+	start := strings.IndexByte(s, '{')
+	if start < 0 {
+		return s
+	}
+	s = s[start:]
+	if end := strings.LastIndexByte(s, '}'); end >= 0 && balanced(s[:end+1]) {
+		s = s[:end+1]
+	}
+
+	var out strings.Builder
+	var stack []byte
+	inString, escaped := false, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if inString {
+			out.WriteByte(c)
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '{':
+			stack = append(stack, '}')
+		case '[':
+			stack = append(stack, ']')
+		case '}', ']':
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		case ',':
+			// Skip trailing commas before a closing bracket
+			j := i + 1
+			for j < len(s) && strings.IndexByte(" \t\r\n", s[j]) >= 0 {
+				j++
+			}
+			if j < len(s) && (s[j] == '}' || s[j] == ']') {
+				continue
+			}
+		}
+		out.WriteByte(c)
+	}
+
+	// Close whatever a truncated response left open
+	if inString {
+		if escaped {
+			out.WriteByte('\\')
+		}
+		out.WriteByte('"')
+	}
+	repaired := strings.TrimRight(out.String(), " \t\r\n,:")
+	for i := len(stack) - 1; i >= 0; i-- {
+		repaired += string(stack[i])
+	}
+	return repaired
+}
+
+// balanced reports whether every bracket opened outside strings in s is closed.
+func balanced(s string) bool {
+	depth := 0
+	inString, escaped := false, false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case c == '\\':
+				escaped = true
+			case c == '"':
+				inString = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			inString = true
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+		}
+	}
+	return depth == 0 && !inString
+}
+
+// SchemaInstructions tells a model to answer with JSON matching schema, for
+// APIs that cannot enforce structured output themselves.
+func SchemaInstructions(schema map[string]any) string {
+	schemaJSON, _ := json.MarshalIndent(schema, "", "  ")
+	return fmt.Sprintf("\n\nRespond with only a JSON object that matches this JSON schema, with no surrounding text or code fences:\n```json\n%s\n```\n", schemaJSON)
+}
+
+// buildRepairPrompt re-sends the original prompt with the rejected response
+// and the reason it was rejected.
+func buildRepairPrompt(prompt string, schema map[string]any, response string, problem error) string {
+	if len(response) > maxQuotedResponse {
+		response = response[:maxQuotedResponse] + "…"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(prompt)
+	sb.WriteString("\n\n## Previous Response\n")
+	sb.WriteString(fmt.Sprintf("Your previous response could not be used: %v\n", problem))
+	sb.WriteString(fmt.Sprintf("```\n%s\n```", response))
+	sb.WriteString(SchemaInstructions(schema))
+	return sb.String()
+}
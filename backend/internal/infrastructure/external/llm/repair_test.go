@@ -0,0 +1,198 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRepairJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"valid", `{"title":"Pools","tags":["a","b"]}`, `{"title":"Pools","tags":["a","b"]}`},
+		{"json fence", "```json\n{\"title\":\"Pools\"}\n```", `{"title":"Pools"}`},
+		{"bare fence", "```\n{\"title\":\"Pools\"}\n```\n", `{"title":"Pools"}`},
+		{"prose around", "Here is the outline:\n{\"title\":\"Pools\"}\nLet me know!", `{"title":"Pools"}`},
+		{"trailing comma in object", `{"title":"Pools",}`, `{"title":"Pools"}`},
+		{"trailing comma in array", "{\"tags\":[\"a\",\"b\",\n]}", "{\"tags\":[\"a\",\"b\"\n]}"},
+		{"trailing commas nested", `{"a":{"b":[1,2,],},}`, `{"a":{"b":[1,2]}}`},
+		{"comma inside string", `{"title":"a, ]b",}`, `{"title":"a, ]b"}`},
+		{"truncated in string", `{"title":"Pool ca`, `{"title":"Pool ca"}`},
+		{"truncated after escape", `{"title":"say \`, `{"title":"say \\"}`},
+		{"truncated after key", `{"title":`, `{"title"}`},
+		{"truncated after comma", `{"tags":["a","b",`, `{"tags":["a","b"]}`},
+		{"truncated nested", `{"sections":[{"title":"One","lessons":[{"title":"Intro"`, `{"sections":[{"title":"One","lessons":[{"title":"Intro"}]}]}`},
+		{"truncated fence", "```json\n{\"title\":\"Pools\",\"tags\":[\"a\"", `{"title":"Pools","tags":["a"]}`},
+		{"no object", "I cannot answer that.", "I cannot answer that."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := repairJSON(tt.in); got != tt.want {
+				t.Errorf("repairJSON(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+var titleSchema = map[string]any{
+	"type":     "object",
+	"required": []string{"title"},
+	"properties": map[string]any{
+		"title": map[string]any{"type": "string"},
+	},
+}
+
+func TestDecodeStructured(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		wantErr string
+	}{
+		{"repaired", "```json\n{\"title\":\"Pools\",}\n```", `{"title":"Pools"}`, ""},
+		{"truncated", `{"title":"Poo`, `{"title":"Poo"}`, ""},
+		{"missing field", `{"name":"Pools"}`, "", `missing required field "title"`},
+		{"wrong type", `{"title":42}`, "", "expected a string"},
+		{"not JSON", "I cannot answer that.", "", "not valid JSON"},
+		{"truncated key", `{"title":`, "", "not valid JSON"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeStructured(tt.in, titleSchema)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("decodeStructured(%q) error = %v, want one containing %q", tt.in, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("decodeStructured(%q) = %q, %v; want %q", tt.in, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompleteJSONReprompts(t *testing.T) {
+	const prompt = "Name a course on pool care."
+
+	tests := []struct {
+		name        string
+		responses   []scriptedResponse
+		want        string
+		wantTokens  int64
+		wantErr     string
+		wantProblem []string // Problem quoted in each re-prompt
+	}{
+		{
+			name:       "repaired without re-prompt",
+			responses:  []scriptedResponse{{text: "```json\n{\"title\":\"Pools\",}\n```", tokens: 10}},
+			want:       `{"title":"Pools"}`,
+			wantTokens: 10,
+		},
+		{
+			name: "re-prompted after invalid JSON",
+			responses: []scriptedResponse{
+				{text: "Sure! The course is called Pools.", tokens: 10},
+				{text: `{"title":"Pools"}`, tokens: 12},
+			},
+			want:        `{"title":"Pools"}`,
+			wantTokens:  22,
+			wantProblem: []string{"not valid JSON"},
+		},
+		{
+			name: "re-prompted after schema mismatch",
+			responses: []scriptedResponse{
+				{text: `{"name":"Pools"}`, tokens: 10},
+				{text: `{"title":42}`, tokens: 11},
+				{text: `{"title":"Pools"}`, tokens: 12},
+			},
+			want:        `{"title":"Pools"}`,
+			wantTokens:  33,
+			wantProblem: []string{`missing required field "title"`, "expected a string"},
+		},
+		{
+			name: "gives up",
+			responses: []scriptedResponse{
+				{text: "no", tokens: 1},
+				{text: "still no", tokens: 2},
+				{text: "never", tokens: 3},
+			},
+			wantTokens:  6,
+			wantErr:     "no valid response after 3 attempts",
+			wantProblem: []string{"not valid JSON", "not valid JSON"},
+		},
+		{
+			name: "completer fails during re-prompt",
+			responses: []scriptedResponse{
+				{text: "no", tokens: 4},
+				{tokens: 5, err: errors.New("connection reset")},
+			},
+			wantTokens:  9,
+			wantErr:     "connection reset",
+			wantProblem: []string{"not valid JSON"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			completer := &scriptedCompleter{responses: tt.responses}
+			var out struct {
+				Title string `json:"title"`
+			}
+
+			text, tokens, err := NewProvider(completer).completeJSON(context.Background(), CompletionRequest{
+				Operation:  "course title",
+				Prompt:     prompt,
+				SchemaName: "title",
+				Schema:     titleSchema,
+			}, &out)
+
+			if tokens != tt.wantTokens {
+				t.Errorf("completeJSON() tokens = %d, want %d", tokens, tt.wantTokens)
+			}
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("completeJSON() error = %v, want one containing %q", err, tt.wantErr)
+				}
+			} else if err != nil || text != tt.want || out.Title != "Pools" {
+				t.Errorf("completeJSON() = %q (%+v), %v; want %q", text, out, err, tt.want)
+			}
+
+			if len(completer.requests) != 1+len(tt.wantProblem) {
+				t.Fatalf("sent %d requests, want %d", len(completer.requests), 1+len(tt.wantProblem))
+			}
+			if completer.requests[0].Prompt != prompt {
+				t.Errorf("first prompt = %q, want the original prompt", completer.requests[0].Prompt)
+			}
+			// Each re-prompt repeats the original prompt and quotes the
+			// previous response with the reason it was rejected
+			for i, problem := range tt.wantProblem {
+				reprompt := completer.requests[i+1].Prompt
+				if !strings.HasPrefix(reprompt, prompt) {
+					t.Errorf("re-prompt %d does not start with the original prompt", i+1)
+				}
+				if !strings.Contains(reprompt, tt.responses[i].text) || !strings.Contains(reprompt, problem) {
+					t.Errorf("re-prompt %d = %q, want the previous response and %q", i+1, reprompt, problem)
+				}
+				if !strings.Contains(reprompt, `"required"`) {
+					t.Errorf("re-prompt %d does not include the schema", i+1)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRepairPromptBoundsQuotedResponse(t *testing.T) {
+	response := strings.Repeat("x", 3*maxQuotedResponse)
+	prompt := buildRepairPrompt("Prompt", titleSchema, response, errors.New("not JSON"))
+
+	if strings.Contains(prompt, strings.Repeat("x", maxQuotedResponse+1)) {
+		t.Error("re-prompt quotes more than maxQuotedResponse bytes of the response")
+	}
+	if !strings.Contains(prompt, strings.Repeat("x", maxQuotedResponse)+"…") {
+		t.Error("re-prompt does not mark the quoted response as cut")
+	}
+}
@@ -0,0 +1,139 @@
+package llm
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Response types for JSON parsing
+
+// sectionsOnlyResponse is for the first call - flat schema with just section titles and lesson titles
+type sectionsOnlyResponse struct {
+	Sections []sectionOutline `json:"sections"`
+}
+
+type sectionOutline struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	LessonTitles []string `json:"lesson_titles"`
+}
+
+// sectionLessonsResponse is for the second call - detailed lessons for a single section
+type sectionLessonsResponse struct {
+	Lessons []outlineLesson `json:"lessons"`
+}
+
+type outlineLesson struct {
+	Title                    string   `json:"title"`
+	Description              string   `json:"description"`
+	EstimatedDurationMinutes int      `json:"estimated_duration_minutes"`
+	LearningObjectives       []string `json:"learning_objectives"`
+}
+
+type lessonContentResponse struct {
+	Components []flatLessonComponent `json:"components"`
+	SegueText  string                `json:"segue_text"`
+}
+
+// flatLessonComponent matches the new flat schema where all fields are at the same level
+type flatLessonComponent struct {
+	// Discriminator
+	ComponentType string `json:"component_type"`
+	// Text fields
+	TextHTML string `json:"text_html,omitempty"`
+	// Heading fields
+	HeadingLevel int    `json:"heading_level,omitempty"`
+	HeadingText  string `json:"heading_text,omitempty"`
+	// Image fields
+	ImageDescription string `json:"image_description,omitempty"`
+	ImageAltText     string `json:"image_alt_text,omitempty"`
+	ImageCaption     string `json:"image_caption,omitempty"`
+	// Quiz fields
+	QuizQuestion        string       `json:"quiz_question,omitempty"`
+	QuizOptions         []quizOption `json:"quiz_options,omitempty"`
+	QuizCorrectAnswerID string       `json:"quiz_correct_answer_id,omitempty"`
+	QuizExplanation     string       `json:"quiz_explanation,omitempty"`
+	// Citations (all component types)
+	SourceChunkIDs []string `json:"source_chunk_ids,omitempty"`
+}
+
+type quizOption struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+}
+
+// toContentJSON converts flat component fields to the nested contentJSON format for storage
+func (c *flatLessonComponent) toContentJSON() (string, error) {
+	var content map[string]any
+
+	switch c.ComponentType {
+	case "text":
+		content = map[string]any{
+			"html":      c.TextHTML,
+			"plaintext": stripHTML(c.TextHTML),
+		}
+	case "heading":
+		content = map[string]any{
+			"level": c.HeadingLevel,
+			"text":  c.HeadingText,
+		}
+	case "image":
+		content = map[string]any{
+			"image_description": c.ImageDescription,
+			"alt_text":          c.ImageAltText,
+			"caption":           c.ImageCaption,
+		}
+	case "quiz":
+		options := make([]map[string]string, len(c.QuizOptions))
+		for i, opt := range c.QuizOptions {
+			options[i] = map[string]string{"id": opt.ID, "text": opt.Text}
+		}
+		content = map[string]any{
+			"question":          c.QuizQuestion,
+			"question_type":     "multiple_choice",
+			"options":           options,
+			"correct_answer_id": c.QuizCorrectAnswerID,
+			"explanation":       c.QuizExplanation,
+		}
+	default:
+		content = map[string]any{}
+	}
+
+	jsonBytes, err := json.Marshal(content)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// stripHTML removes HTML tags from a string to create plaintext
+func stripHTML(html string) string {
+	// Simple regex-free approach
+	result := strings.Builder{}
+	inTag := false
+	for _, r := range html {
+		if r == '<' {
+			inTag = true
+		} else if r == '>' {
+			inTag = false
+		} else if !inTag {
+			result.WriteRune(r)
+		}
+	}
+	return result.String()
+}
+
+type smeProcessingResponse struct {
+	Summary string     `json:"summary"`
+	Chunks  []smeChunk `json:"chunks"`
+}
+
+type smeChunk struct {
+	Content        string   `json:"content"`
+	Topic          string   `json:"topic"`
+	Keywords       []string `json:"keywords"`
+	RelevanceScore float32  `json:"relevance_score"`
+	SourceHeading  string   `json:"source_heading"`
+	SourcePage     int32    `json:"source_page"`
+	SourceTime     string   `json:"source_time"`
+}
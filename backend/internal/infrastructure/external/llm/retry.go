@@ -0,0 +1,67 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is how many times HTTP providers retry retryable errors.
+	DefaultMaxRetries = 3
+
+	// DefaultBaseDelay is the first retry delay; it doubles on each attempt.
+	DefaultBaseDelay = 2 * time.Second
+)
+
+// APIError is a non-success response from a model API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("API request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// Retryable reports whether the request may succeed if sent again:
+// rate limits, overload and server errors.
+func (e *APIError) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Retry runs fn until it succeeds or returns an error that is not a retryable
+// APIError, backing off exponentially from baseDelay between attempts.
+func Retry(ctx context.Context, operation string, maxRetries int, baseDelay time.Duration, fn func() error) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || !apiErr.Retryable() {
+			return err
+		}
+		if attempt == maxRetries {
+			break
+		}
+
+		delay := baseDelay * time.Duration(1<<attempt)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s cancelled during retry backoff: %w", operation, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+
+	return fmt.Errorf("%s failed after %d retries: %w", operation, maxRetries, lastErr)
+}
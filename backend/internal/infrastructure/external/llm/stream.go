@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// componentStream picks complete lesson components out of a lesson content
+// response while it is still being streamed, so each can be used before the
+// model has finished the rest of the lesson.
+type componentStream struct {
+	schema map[string]any // Schema of a single component
+	buf    strings.Builder
+
+	pos        int  // Next byte of buf to scan
+	depth      int  // Brackets open at pos, outside strings
+	inString   bool // Whether pos is inside a string
+	escaped    bool // Whether the previous string byte was a backslash
+	keyStart   int  // Start of the last string opened in the top-level object
+	lastKey    string
+	arrayDepth int // Depth inside the components array; 0 until it opens
+	itemStart  int // Start of the component being scanned
+	broken     bool
+}
+
+// newComponentStream creates a componentStream validating components against
+// the item schema of lessonContentSchema.
+func newComponentStream() *componentStream {
+	components := lessonContentSchema()["properties"].(map[string]any)["components"].(map[string]any)
+	return &componentStream{schema: components["items"].(map[string]any)}
+}
+
+// write adds streamed response text and returns the components it completed.
+// Once a component fails to decode, no more are returned; the caller falls
+// back to the whole response.
+func (s *componentStream) write(text string) []flatLessonComponent {
+	s.buf.WriteString(text)
+	data := s.buf.String()
+
+	var completed []flatLessonComponent
+	for ; s.pos < len(data); s.pos++ {
+		c := data[s.pos]
+		if s.inString {
+			switch {
+			case s.escaped:
+				s.escaped = false
+			case c == '\\':
+				s.escaped = true
+			case c == '"':
+				s.inString = false
+				if s.depth == 1 {
+					s.lastKey = data[s.keyStart+1 : s.pos]
+				}
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			s.inString = true
+			s.keyStart = s.pos
+		case '{', '[':
+			s.depth++
+			switch {
+			case c == '[' && s.depth == 2 && s.lastKey == "components":
+				s.arrayDepth = s.depth
+			case c == '{' && s.arrayDepth > 0 && s.depth == s.arrayDepth+1:
+				s.itemStart = s.pos
+			}
+		case '}', ']':
+			if c == '}' && s.arrayDepth > 0 && s.depth == s.arrayDepth+1 && !s.broken {
+				if comp, ok := s.decode(data[s.itemStart : s.pos+1]); ok {
+					completed = append(completed, comp)
+				} else {
+					s.broken = true
+				}
+			}
+			if c == ']' && s.depth == s.arrayDepth {
+				s.arrayDepth = 0
+			}
+			s.depth--
+		}
+	}
+	return completed
+}
+
+// decode validates and decodes a single component object.
+func (s *componentStream) decode(text string) (flatLessonComponent, bool) {
+	var comp flatLessonComponent
+	repaired, err := decodeStructured(text, s.schema)
+	if err != nil {
+		return comp, false
+	}
+	if err := json.Unmarshal([]byte(repaired), &comp); err != nil {
+		return comp, false
+	}
+	return comp, true
+}
@@ -0,0 +1,109 @@
+package llm
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+const streamedLesson = `{
+  "segue_text": "Next we look at [components] of \"filters\" {soon}.",
+  "components": [
+    {"component_type": "heading", "heading_level": 2, "heading_text": "Water \"chemistry\""},
+    {"component_type": "text", "text_html": "<p>Keep pH in [7.2, 7.8] and {balance} it.</p>"},
+    {
+      "component_type": "quiz",
+      "quiz_question": "Which pH is ideal?",
+      "quiz_options": [{"id": "a", "text": "6.0"}, {"id": "b", "text": "7.4"}],
+      "quiz_correct_answer_id": "b"
+    }
+  ]
+}`
+
+// streamIn writes text to a new componentStream in chunks of size bytes and
+// returns the component types it reports, in order.
+func streamIn(text string, size int) []string {
+	s := newComponentStream()
+	var types []string
+	for start := 0; start < len(text); start += size {
+		for _, comp := range s.write(text[start:min(start+size, len(text))]) {
+			types = append(types, comp.ComponentType)
+		}
+	}
+	return types
+}
+
+func TestComponentStreamChunking(t *testing.T) {
+	want := []string{"heading", "text", "quiz"}
+	for _, size := range []int{1, 2, 7, 64, len(streamedLesson)} {
+		if got := streamIn(streamedLesson, size); !slices.Equal(got, want) {
+			t.Errorf("chunk size %d: got %v, want %v", size, got, want)
+		}
+	}
+}
+
+func TestComponentStreamDecodesFields(t *testing.T) {
+	s := newComponentStream()
+	comps := s.write(streamedLesson)
+	if len(comps) != 3 {
+		t.Fatalf("got %d components, want 3", len(comps))
+	}
+	if comps[0].HeadingText != `Water "chemistry"` || comps[0].HeadingLevel != 2 {
+		t.Errorf("heading = %+v", comps[0])
+	}
+	if comps[1].TextHTML != "<p>Keep pH in [7.2, 7.8] and {balance} it.</p>" {
+		t.Errorf("text = %q", comps[1].TextHTML)
+	}
+	if len(comps[2].QuizOptions) != 2 || comps[2].QuizCorrectAnswerID != "b" {
+		t.Errorf("quiz = %+v", comps[2])
+	}
+}
+
+func TestComponentStreamWaitsForCompleteComponents(t *testing.T) {
+	s := newComponentStream()
+	if got := s.write(`{"components": [{"component_type": "text", "text_html": "<p>Par`); len(got) != 0 {
+		t.Fatalf("returned %d components before the first one closed", len(got))
+	}
+	if got := s.write(`tial</p>"}, {"component_type": "heading"`); len(got) != 1 || got[0].TextHTML != "<p>Partial</p>" {
+		t.Fatalf("got %+v, want the completed text component", got)
+	}
+	if got := s.write(`, "heading_text": "Done"}]}`); len(got) != 1 || got[0].HeadingText != "Done" {
+		t.Fatalf("got %+v, want the completed heading component", got)
+	}
+}
+
+func TestComponentStreamIgnoresNestedComponentsKeys(t *testing.T) {
+	text := `{"segue_text": "x", "meta": {"components": [{"component_type": "text"}]}, "components": []}`
+	if got := streamIn(text, 5); len(got) != 0 {
+		t.Errorf("got %v from a nested components key, want none", got)
+	}
+}
+
+func TestComponentStreamStopsAfterInvalidComponent(t *testing.T) {
+	text := `{"components": [
+		{"component_type": "text", "text_html": "<p>ok</p>"},
+		{"component_type": "video"},
+		{"component_type": "text", "text_html": "<p>after</p>"}
+	]}`
+	if got, want := streamIn(text, 3), []string{"text"}; !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestContinueAfter(t *testing.T) {
+	completed := []service.LessonComponentResult{{Order: 1}, {Order: 3}, {Order: 2}}
+	delivered := []service.LessonComponentResult{{Order: 1}, {Order: 2}}
+
+	got := continueAfter(completed, delivered)
+	if len(got) != 2 || got[0].Order != 4 || got[1].Order != 5 {
+		t.Errorf("orders = %+v, want 4 and 5", got)
+	}
+	if delivered[0].Order != 1 {
+		t.Error("continueAfter modified the delivered components")
+	}
+
+	if got := continueAfter(nil, delivered); got[0].Order != 1 || got[1].Order != 2 {
+		t.Errorf("orders without completed components = %+v, want 1 and 2", got)
+	}
+}
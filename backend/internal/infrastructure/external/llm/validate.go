@@ -0,0 +1,98 @@
+package llm
+
+import (
+	"fmt"
+	"math"
+	"slices"
+)
+
+// validateSchema checks a decoded JSON value against the subset of JSON Schema
+// used by the output schemas in this package: type, properties, required,
+// enum, items, minItems/maxItems and minimum/maximum. Optional properties
+// that are null are treated as absent, since models often emit them that way.
+func validateSchema(value any, schema map[string]any, path string) error {
+	switch schema["type"] {
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an object", path)
+		}
+		required, _ := schema["required"].([]string)
+		for _, name := range required {
+			if v, ok := obj[name]; !ok || v == nil {
+				return fmt.Errorf("%s: missing required field %q", path, name)
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		for name, v := range obj {
+			propSchema, ok := properties[name].(map[string]any)
+			if !ok || v == nil {
+				continue
+			}
+			if err := validateSchema(v, propSchema, path+"."+name); err != nil {
+				return err
+			}
+		}
+
+	case "array":
+		arr, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: expected an array", path)
+		}
+		if minItems, ok := schemaNumber(schema, "minItems"); ok && float64(len(arr)) < minItems {
+			return fmt.Errorf("%s: expected at least %v items, got %d", path, minItems, len(arr))
+		}
+		if maxItems, ok := schemaNumber(schema, "maxItems"); ok && float64(len(arr)) > maxItems {
+			return fmt.Errorf("%s: expected at most %v items, got %d", path, maxItems, len(arr))
+		}
+		if items, ok := schema["items"].(map[string]any); ok {
+			for i, item := range arr {
+				if err := validateSchema(item, items, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected a string", path)
+		}
+		if enum, ok := schema["enum"].([]string); ok && !slices.Contains(enum, str) {
+			return fmt.Errorf("%s: %q is not one of %v", path, str, enum)
+		}
+
+	case "integer", "number":
+		num, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("%s: expected a number", path)
+		}
+		if schema["type"] == "integer" && num != math.Trunc(num) {
+			return fmt.Errorf("%s: expected an integer", path)
+		}
+		if minimum, ok := schemaNumber(schema, "minimum"); ok && num < minimum {
+			return fmt.Errorf("%s: %v is below the minimum %v", path, num, minimum)
+		}
+		if maximum, ok := schemaNumber(schema, "maximum"); ok && num > maximum {
+			return fmt.Errorf("%s: %v is above the maximum %v", path, num, maximum)
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: expected a boolean", path)
+		}
+	}
+
+	return nil
+}
+
+// schemaNumber reads a numeric schema keyword written as a Go int or float.
+func schemaNumber(schema map[string]any, key string) (float64, bool) {
+	switch n := schema[key].(type) {
+	case int:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
@@ -0,0 +1,214 @@
+// Package openai implements llm.Completer for the OpenAI chat completions API
+// and for self-hosted servers that expose an OpenAI-compatible API (Ollama,
+// vLLM, LM Studio), and service.Embedder for the OpenAI embeddings API.
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+)
+
+const (
+	// DefaultModel is the default OpenAI model to use.
+	DefaultModel = "gpt-4o-mini"
+
+	// DefaultBaseURL is the OpenAI API base URL.
+	DefaultBaseURL = "https://api.openai.com/v1"
+)
+
+// Client implements llm.Completer using the OpenAI chat completions API
+// and service.Embedder using the embeddings API.
+type Client struct {
+	httpClient *http.Client
+	apiKey     string
+	baseURL    string
+	model      string
+	maxRetries int
+	baseDelay  time.Duration
+
+	// compatible marks a self-hosted OpenAI-compatible server. Such servers
+	// may ignore or reject json_schema response formats, so the schema is
+	// also written into the prompt.
+	compatible bool
+}
+
+// NewClient creates a new OpenAI client with the provided API key.
+// An empty baseURL uses DefaultBaseURL.
+func NewClient(httpClient *http.Client, apiKey, baseURL string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		httpClient: httpClient,
+		apiKey:     apiKey,
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		model:      DefaultModel,
+		maxRetries: llm.DefaultMaxRetries,
+		baseDelay:  llm.DefaultBaseDelay,
+	}
+}
+
+// NewCompatibleClient creates a client for a self-hosted OpenAI-compatible
+// server. The API key may be empty for servers without authentication.
+func NewCompatibleClient(httpClient *http.Client, baseURL, apiKey, model string) *Client {
+	c := NewClient(httpClient, apiKey, baseURL)
+	c.model = model
+	c.compatible = true
+	return c
+}
+
+type chatRequest struct {
+	Model               string          `json:"model"`
+	Messages            []chatMessage   `json:"messages"`
+	ResponseFormat      *responseFormat `json:"response_format,omitempty"`
+	MaxCompletionTokens int             `json:"max_completion_tokens,omitempty"`
+	MaxTokens           int             `json:"max_tokens,omitempty"` // Older name, still expected by compatible servers
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type responseFormat struct {
+	Type       string      `json:"type"`
+	JSONSchema *jsonSchema `json:"json_schema,omitempty"`
+}
+
+type jsonSchema struct {
+	Name   string         `json:"name"`
+	Schema map[string]any `json:"schema"`
+	Strict bool           `json:"strict"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+			Refusal string `json:"refusal"`
+		} `json:"message"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+	Usage struct {
+		TotalTokens int64 `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+type errorResponse struct {
+	Error struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// Complete sends the prompt as a single user message. When a schema is given
+// the response is constrained with a json_schema response format.
+func (c *Client) Complete(ctx context.Context, req llm.CompletionRequest) (*llm.Completion, error) {
+	prompt := req.Prompt
+	if c.compatible && req.Schema != nil {
+		prompt += llm.SchemaInstructions(req.Schema)
+	}
+
+	body := chatRequest{
+		Model:    c.model,
+		Messages: []chatMessage{{Role: "user", Content: prompt}},
+	}
+	if c.compatible {
+		body.MaxTokens = req.MaxTokens
+	} else {
+		body.MaxCompletionTokens = req.MaxTokens
+	}
+	if req.Schema != nil {
+		// Non-strict: strict mode rejects the min/max constraints used in the shared schemas
+		body.ResponseFormat = &responseFormat{
+			Type: "json_schema",
+			JSONSchema: &jsonSchema{
+				Name:   req.SchemaName,
+				Schema: req.Schema,
+			},
+		}
+	}
+
+	var resp chatResponse
+	send := func() error {
+		return llm.Retry(ctx, req.Operation, c.maxRetries, c.baseDelay, func() error {
+			return c.post(ctx, "/chat/completions", body, &resp)
+		})
+	}
+	err := send()
+
+	// Servers without structured output support reject the response format;
+	// the schema is already in the prompt, so retry without it
+	var apiErr *llm.APIError
+	if err != nil && c.compatible && body.ResponseFormat != nil && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+		body.ResponseFormat = nil
+		err = send()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Rejected responses were still billed
+	if len(resp.Choices) == 0 {
+		return nil, llm.WithUsage(fmt.Errorf("%s: response contained no choices", req.Operation), resp.Usage.TotalTokens)
+	}
+	choice := resp.Choices[0]
+	if choice.Message.Refusal != "" {
+		return nil, llm.WithUsage(fmt.Errorf("%s: model refused the request: %s", req.Operation, choice.Message.Refusal), resp.Usage.TotalTokens)
+	}
+	if req.Schema != nil && choice.FinishReason == "length" {
+		return nil, llm.WithUsage(fmt.Errorf("%s: response was truncated at the token limit", req.Operation), resp.Usage.TotalTokens)
+	}
+
+	return &llm.Completion{
+		Text:       choice.Message.Content,
+		TokensUsed: resp.Usage.TotalTokens,
+	}, nil
+}
+
+// post sends a JSON request to the API and decodes the JSON response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach model API at %s: %w", c.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		var errResp errorResponse
+		_ = json.Unmarshal(respBody, &errResp)
+		return &llm.APIError{StatusCode: resp.StatusCode, Message: errResp.Error.Message}
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+)
+
+var testSchema = map[string]any{
+	"type":       "object",
+	"properties": map[string]any{"title": map[string]any{"type": "string"}},
+}
+
+// apiServer answers each request with the next scripted status and body
+// and records the decoded request bodies.
+type apiServer struct {
+	t         *testing.T
+	responses []scriptedReply
+	requests  []map[string]any
+	headers   []http.Header
+}
+
+type scriptedReply struct {
+	status int
+	body   string
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/chat/completions" {
+		s.t.Errorf("request path = %s, want /chat/completions", r.URL.Path)
+	}
+	payload, _ := io.ReadAll(r.Body)
+	var body map[string]any
+	if err := json.Unmarshal(payload, &body); err != nil {
+		s.t.Errorf("request body is not JSON: %v", err)
+	}
+	s.requests = append(s.requests, body)
+	s.headers = append(s.headers, r.Header.Clone())
+
+	if len(s.responses) == 0 {
+		s.t.Error("unexpected extra request")
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	reply := s.responses[0]
+	s.responses = s.responses[1:]
+	w.WriteHeader(reply.status)
+	_, _ = io.WriteString(w, reply.body)
+}
+
+func newTestClient(t *testing.T, compatible bool, replies ...scriptedReply) (*Client, *apiServer) {
+	api := &apiServer{t: t, responses: replies}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	var c *Client
+	if compatible {
+		c = NewCompatibleClient(server.Client(), server.URL, "", "llama3.1")
+	} else {
+		c = NewClient(server.Client(), "sk-test", server.URL)
+	}
+	c.baseDelay = 0
+	return c, api
+}
+
+func TestCompleteRequestBody(t *testing.T) {
+	c, api := newTestClient(t, false, scriptedReply{http.StatusOK, `{"choices":[{"message":{"content":"{}"},"finish_reason":"stop"}]}`})
+
+	_, err := c.Complete(context.Background(), llm.CompletionRequest{
+		Operation:  "outline generation",
+		Prompt:     "Outline a course on pool care",
+		SchemaName: "course_outline",
+		Schema:     testSchema,
+		MaxTokens:  2000,
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+
+	if got := api.headers[0].Get("Authorization"); got != "Bearer sk-test" {
+		t.Errorf("Authorization = %q, want the bearer API key", got)
+	}
+	body := api.requests[0]
+	if body["model"] != DefaultModel {
+		t.Errorf("model = %v, want %s", body["model"], DefaultModel)
+	}
+	if body["max_completion_tokens"] != float64(2000) || body["max_tokens"] != nil {
+		t.Errorf("token limit = %v / %v, want max_completion_tokens only", body["max_completion_tokens"], body["max_tokens"])
+	}
+	// The prompt is the only message, sent as the user and without a system message
+	messages, _ := body["messages"].([]any)
+	if len(messages) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(messages))
+	}
+	if msg := messages[0].(map[string]any); msg["role"] != "user" || msg["content"] != "Outline a course on pool care" {
+		t.Errorf("message = %v, want the prompt as the user", msg)
+	}
+	format, _ := body["response_format"].(map[string]any)
+	schema, _ := format["json_schema"].(map[string]any)
+	if format["type"] != "json_schema" || schema["name"] != "course_outline" || schema["strict"] != false {
+		t.Errorf("response_format = %v, want a non-strict course_outline json_schema", format)
+	}
+	if _, ok := schema["schema"].(map[string]any)["properties"]; !ok {
+		t.Errorf("json_schema.schema = %v, want the request schema", schema["schema"])
+	}
+}
+
+func TestCompleteCompatibleServer(t *testing.T) {
+	// The server rejects the response format, so the request is re-sent
+	// without it, relying on the schema written into the prompt
+	c, api := newTestClient(t, true,
+		scriptedReply{http.StatusBadRequest, `{"error":{"message":"response_format is not supported"}}`},
+		scriptedReply{http.StatusOK, `{"choices":[{"message":{"content":"{\"title\":\"Pools\"}"},"finish_reason":"stop"}],"usage":{"total_tokens":30}}`},
+	)
+
+	completion, err := c.Complete(context.Background(), llm.CompletionRequest{
+		Operation:  "outline generation",
+		Prompt:     "Outline a course on pool care",
+		SchemaName: "course_outline",
+		Schema:     testSchema,
+		MaxTokens:  2000,
+	})
+	if err != nil {
+		t.Fatalf("Complete() error = %v", err)
+	}
+	if completion.Text != `{"title":"Pools"}` {
+		t.Errorf("Text = %q", completion.Text)
+	}
+	if len(api.requests) != 2 {
+		t.Fatalf("sent %d requests, want 2", len(api.requests))
+	}
+	if _, ok := api.headers[0]["Authorization"]; ok {
+		t.Error("sent an Authorization header without an API key")
+	}
+
+	first, retry := api.requests[0], api.requests[1]
+	if first["model"] != "llama3.1" || first["max_tokens"] != float64(2000) || first["max_completion_tokens"] != nil {
+		t.Errorf("request = %v, want the configured model and max_tokens", first)
+	}
+	if first["response_format"] == nil || retry["response_format"] != nil {
+		t.Errorf("response_format = %v then %v, want it dropped on retry", first["response_format"], retry["response_format"])
+	}
+	prompt := retry["messages"].([]any)[0].(map[string]any)["content"].(string)
+	if !strings.HasPrefix(prompt, "Outline a course on pool care") || !strings.Contains(prompt, `"properties"`) {
+		t.Errorf("prompt = %q, want the schema appended", prompt)
+	}
+}
+
+func TestCompleteResponses(t *testing.T) {
+	tests := []struct {
+		name       string
+		replies    []scriptedReply
+		schema     map[string]any
+		wantText   string
+		wantTokens int64 // Tokens of the completion, or attached to the error
+		wantErr    string
+		wantStatus int // Status of the returned APIError, if any
+		wantSent   int
+	}{
+		{
+			name:       "text",
+			replies:    []scriptedReply{{http.StatusOK, `{"choices":[{"message":{"content":"Hello"},"finish_reason":"stop"}],"usage":{"prompt_tokens":4,"completion_tokens":2,"total_tokens":6}}`}},
+			wantText:   "Hello",
+			wantTokens: 6,
+			wantSent:   1,
+		},
+		{
+			name:       "truncated structured output",
+			replies:    []scriptedReply{{http.StatusOK, `{"choices":[{"message":{"content":"{\"ti"},"finish_reason":"length"}],"usage":{"total_tokens":50}}`}},
+			schema:     testSchema,
+			wantTokens: 50,
+			wantErr:    "truncated",
+			wantSent:   1,
+		},
+		{
+			name:       "refusal",
+			replies:    []scriptedReply{{http.StatusOK, `{"choices":[{"message":{"refusal":"I can't help with that"}}],"usage":{"total_tokens":12}}`}},
+			wantTokens: 12,
+			wantErr:    "refused",
+			wantSent:   1,
+		},
+		{
+			name:       "no choices",
+			replies:    []scriptedReply{{http.StatusOK, `{"choices":[],"usage":{"total_tokens":3}}`}},
+			wantTokens: 3,
+			wantErr:    "no choices",
+			wantSent:   1,
+		},
+		{
+			name:       "client error",
+			replies:    []scriptedReply{{http.StatusUnauthorized, `{"error":{"message":"Incorrect API key provided"}}`}},
+			wantErr:    "Incorrect API key provided",
+			wantStatus: http.StatusUnauthorized,
+			wantSent:   1,
+		},
+		{
+			name: "rate limited then served",
+			replies: []scriptedReply{
+				{http.StatusTooManyRequests, `{"error":{"message":"Rate limit reached"}}`},
+				{http.StatusOK, `{"choices":[{"message":{"content":"Hello"}}],"usage":{"total_tokens":6}}`},
+			},
+			wantText:   "Hello",
+			wantTokens: 6,
+			wantSent:   2,
+		},
+		{
+			name: "rate limited",
+			replies: []scriptedReply{
+				{http.StatusTooManyRequests, `{"error":{"message":"Rate limit reached"}}`},
+				{http.StatusTooManyRequests, `{"error":{"message":"Rate limit reached"}}`},
+				{http.StatusTooManyRequests, `{"error":{"message":"Rate limit reached"}}`},
+				{http.StatusTooManyRequests, `{"error":{"message":"Rate limit reached"}}`},
+			},
+			wantErr:    "failed after 3 retries",
+			wantStatus: http.StatusTooManyRequests,
+			wantSent:   llm.DefaultMaxRetries + 1,
+		},
+		{
+			name:     "server error without body",
+			replies:  []scriptedReply{{http.StatusBadGateway, ``}, {http.StatusOK, `{"choices":[{"message":{"content":"Hello"}}]}`}},
+			wantText: "Hello",
+			wantSent: 2,
+		},
+		{
+			name:     "malformed body",
+			replies:  []scriptedReply{{http.StatusOK, `{"choices":[{"message":`}},
+			wantErr:  "failed to decode response",
+			wantSent: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, api := newTestClient(t, false, tt.replies...)
+
+			completion, err := c.Complete(context.Background(), llm.CompletionRequest{
+				Operation:  "test",
+				Prompt:     "Say hello",
+				SchemaName: "greeting",
+				Schema:     tt.schema,
+			})
+			if len(api.requests) != tt.wantSent {
+				t.Errorf("sent %d requests, want %d", len(api.requests), tt.wantSent)
+			}
+
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Complete() error = %v", err)
+				}
+				if completion.Text != tt.wantText || completion.TokensUsed != tt.wantTokens {
+					t.Errorf("Complete() = %q with %d tokens, want %q with %d", completion.Text, completion.TokensUsed, tt.wantText, tt.wantTokens)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Complete() error = %v, want one containing %q", err, tt.wantErr)
+			}
+			if got := service.TokensUsedBy(err); got != tt.wantTokens {
+				t.Errorf("TokensUsedBy() = %d, want %d", got, tt.wantTokens)
+			}
+			var apiErr *llm.APIError
+			if errors.As(err, &apiErr) != (tt.wantStatus != 0) || (apiErr != nil && apiErr.StatusCode != tt.wantStatus) {
+				t.Errorf("Complete() error = %#v, want an APIError with status %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/infrastructure/external/llm"
+)
+
+const (
+	// DefaultEmbeddingModel is the OpenAI model used for knowledge embeddings.
+	// It is shortened to service.EmbeddingDimensions through the dimensions parameter.
+	DefaultEmbeddingModel = "text-embedding-3-small"
+
+	// maxEmbedBatch bounds how many texts are sent in one embeddings request.
+	maxEmbedBatch = 100
+)
+
+type embeddingRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions"`
+}
+
+type embeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+	Usage struct {
+		TotalTokens int64 `json:"total_tokens"`
+	} `json:"usage"`
+}
+
+// EmbeddingModel returns the model whose embedding space the vectors belong to.
+func (c *Client) EmbeddingModel() string {
+	return DefaultEmbeddingModel
+}
+
+// EmbedDocuments computes embeddings for knowledge chunks.
+func (c *Client) EmbedDocuments(ctx context.Context, texts []string) ([][]float32, int64, error) {
+	out := make([][]float32, 0, len(texts))
+	var tokensUsed int64
+	for start := 0; start < len(texts); start += maxEmbedBatch {
+		end := min(start+maxEmbedBatch, len(texts))
+		vectors, tokens, err := c.embed(ctx, texts[start:end])
+		tokensUsed += tokens
+		if err != nil {
+			return nil, tokensUsed, err
+		}
+		out = append(out, vectors...)
+	}
+	return out, tokensUsed, nil
+}
+
+// EmbedQuery computes the embedding for a search query.
+func (c *Client) EmbedQuery(ctx context.Context, text string) ([]float32, int64, error) {
+	vectors, tokensUsed, err := c.embed(ctx, []string{text})
+	if err != nil {
+		return nil, tokensUsed, err
+	}
+	return vectors[0], tokensUsed, nil
+}
+
+// embed computes one batch of embeddings. The tokens used are returned even
+// when the response is rejected, since the API has billed them.
+func (c *Client) embed(ctx context.Context, texts []string) ([][]float32, int64, error) {
+	body := embeddingRequest{
+		Model:      DefaultEmbeddingModel,
+		Input:      texts,
+		Dimensions: service.EmbeddingDimensions,
+	}
+
+	var resp embeddingResponse
+	err := llm.Retry(ctx, "compute embeddings", c.maxRetries, c.baseDelay, func() error {
+		return c.post(ctx, "/embeddings", body, &resp)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	tokensUsed := resp.Usage.TotalTokens
+
+	if len(resp.Data) != len(texts) {
+		return nil, tokensUsed, fmt.Errorf("expected %d embeddings, got %d", len(texts), len(resp.Data))
+	}
+	vectors := make([][]float32, len(texts))
+	for i, e := range resp.Data {
+		if e.Index < 0 || e.Index >= len(texts) || vectors[e.Index] != nil {
+			return nil, tokensUsed, fmt.Errorf("embedding %d has an unexpected index", i)
+		}
+		if len(e.Embedding) != service.EmbeddingDimensions {
+			return nil, tokensUsed, fmt.Errorf("embedding %d has unexpected dimensions", i)
+		}
+		vectors[e.Index] = e.Embedding
+	}
+	return vectors, tokensUsed, nil
+}
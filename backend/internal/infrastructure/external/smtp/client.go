@@ -809,6 +809,88 @@ func (c *Client) renderCourseCompleteEmail(req service.SendCourseCompleteRequest
 	return buf.String(), nil
 }
 
+// SendPaymentReminder sends an overdue payment reminder to a company owner.
+func (c *Client) SendPaymentReminder(ctx context.Context, req service.SendPaymentReminderRequest) error {
+	subject := fmt.Sprintf("%s: %s", req.Title, req.CompanyName)
+
+	body, err := c.renderPaymentReminderEmail(req)
+	if err != nil {
+		return fmt.Errorf("failed to render email template: %w", err)
+	}
+
+	return c.sendEmail(req.To, subject, body)
+}
+
+// renderPaymentReminderEmail renders the overdue payment reminder email template.
+func (c *Client) renderPaymentReminderEmail(req service.SendPaymentReminderRequest) (string, error) {
+	const emailTemplate = `<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.Title}}</title>
+</head>
+<body style="margin: 0; padding: 0; font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, Oxygen, Ubuntu, sans-serif; background-color: #f5f5f5;">
+    <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="background-color: #f5f5f5;">
+        <tr>
+            <td style="padding: 40px 20px;">
+                <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%" style="max-width: 600px; margin: 0 auto; background-color: #ffffff; border-radius: 8px; box-shadow: 0 2px 8px rgba(0,0,0,0.1);">
+                    <tr>
+                        <td style="padding: 40px 40px 20px 40px; text-align: center;">
+                            <h1 style="margin: 0; color: #7c3aed; font-size: 28px; font-weight: 700;">Mirai</h1>
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 20px 40px;">
+                            <h2 style="margin: 0 0 20px 0; color: #1f2937; font-size: 24px; font-weight: 600; text-align: center;">{{.Title}}</h2>
+                            <p style="margin: 0 0 20px 0; color: #4b5563; font-size: 16px; line-height: 1.6;">
+                                Hi {{.UserName}},<br><br>
+                                We couldn't collect the subscription payment for <strong>{{.CompanyName}}</strong>.
+                            </p>
+                            <div style="background-color: #fffbeb; padding: 15px; border-radius: 8px; border-left: 4px solid #f59e0b; margin: 20px 0;">
+                                <p style="margin: 0; color: #92400e; font-size: 14px;">{{.Message}}</p>
+                            </div>
+                            <table role="presentation" cellspacing="0" cellpadding="0" border="0" width="100%">
+                                <tr>
+                                    <td style="padding: 20px 0; text-align: center;">
+                                        <a href="{{.BillingURL}}" style="display: inline-block; padding: 14px 32px; background-color: #7c3aed; color: #ffffff; text-decoration: none; font-size: 16px; font-weight: 600; border-radius: 8px;">Update Payment Method</a>
+                                    </td>
+                                </tr>
+                            </table>
+                            {{if .InvoiceURL}}
+                            <p style="margin: 0; color: #6b7280; font-size: 14px; text-align: center;">
+                                Or <a href="{{.InvoiceURL}}" style="color: #7c3aed;">pay the invoice directly</a>.
+                            </p>
+                            {{end}}
+                        </td>
+                    </tr>
+                    <tr>
+                        <td style="padding: 20px 40px 40px 40px; border-top: 1px solid #e5e7eb;">
+                            <p style="margin: 0; color: #9ca3af; font-size: 12px; text-align: center;">
+                                This is an automated billing notification from Mirai.
+                            </p>
+                        </td>
+                    </tr>
+                </table>
+            </td>
+        </tr>
+    </table>
+</body>
+</html>`
+
+	tmpl, err := template.New("payment_reminder").Parse(emailTemplate)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, req); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
 // SendAlert sends an administrative alert email to the configured admin address.
 func (c *Client) SendAlert(ctx context.Context, req service.SendAlertRequest) error {
 	if c.adminEmail == "" {
@@ -2,7 +2,10 @@ package stripe
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/service"
@@ -11,6 +14,7 @@ import (
 	billingportalsession "github.com/stripe/stripe-go/v76/billingportal/session"
 	"github.com/stripe/stripe-go/v76/checkout/session"
 	"github.com/stripe/stripe-go/v76/customer"
+	"github.com/stripe/stripe-go/v76/invoice"
 	"github.com/stripe/stripe-go/v76/subscription"
 	"github.com/stripe/stripe-go/v76/webhook"
 )
@@ -60,19 +64,9 @@ func (c *Client) CreateCustomer(ctx context.Context, req service.CreateCustomerR
 
 // CreateCheckoutSession creates a Stripe checkout session.
 func (c *Client) CreateCheckoutSession(ctx context.Context, req service.CheckoutRequest) (*service.CheckoutSession, error) {
-	// Determine price ID based on plan
-	var priceID string
-	switch req.Plan {
-	case valueobject.PlanStarter:
-		priceID = c.starterPriceID
-	case valueobject.PlanPro:
-		priceID = c.proPriceID
-	default:
-		return nil, fmt.Errorf("invalid plan for checkout: %s", req.Plan)
-	}
-
-	if priceID == "" {
-		return nil, fmt.Errorf("no price ID configured for plan: %s", req.Plan)
+	priceID, err := c.priceID(req.Plan)
+	if err != nil {
+		return nil, err
 	}
 
 	// Use minimum 1 seat
@@ -173,37 +167,7 @@ func (c *Client) GetSubscription(ctx context.Context, subscriptionID string) (*s
 		return nil, fmt.Errorf("failed to get subscription: %w", err)
 	}
 
-	// Get first item ID for seat updates
-	var itemID string
-	var seatCount int64
-	if len(sub.Items.Data) > 0 {
-		itemID = sub.Items.Data[0].ID
-		seatCount = sub.Items.Data[0].Quantity
-	}
-
-	// Map Stripe status to our status
-	status := mapStripeStatus(sub.Status)
-
-	// Get plan from metadata
-	plan := valueobject.PlanStarter // default
-	if sub.Metadata != nil {
-		if metaPlan, ok := sub.Metadata["plan"]; ok {
-			if p, err := valueobject.ParsePlan(metaPlan); err == nil {
-				plan = p
-			}
-		}
-	}
-
-	return &service.Subscription{
-		ID:                sub.ID,
-		CustomerID:        sub.Customer.ID,
-		Status:            status,
-		Plan:              plan,
-		CurrentPeriodEnd:  sub.CurrentPeriodEnd,
-		CancelAtPeriodEnd: sub.CancelAtPeriodEnd,
-		SeatCount:         int(seatCount),
-		ItemID:            itemID,
-	}, nil
+	return toSubscription(sub), nil
 }
 
 // UpdateSubscriptionQuantity updates the seat count on a subscription.
@@ -238,6 +202,118 @@ func (c *Client) UpdateSubscriptionQuantity(ctx context.Context, subscriptionID
 	return nil
 }
 
+// PreviewSubscriptionChange returns the prorated cost of changing a
+// subscription's plan or seat count, from Stripe's upcoming invoice.
+func (c *Client) PreviewSubscriptionChange(ctx context.Context, change service.SubscriptionChange) (*service.SubscriptionChangePreview, error) {
+	items, err := c.subscriptionChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &stripe.InvoiceUpcomingParams{
+		Customer:                      stripe.String(change.CustomerID),
+		Subscription:                  stripe.String(change.SubscriptionID),
+		SubscriptionItems:             items,
+		SubscriptionProrationBehavior: stripe.String("create_prorations"),
+		SubscriptionProrationDate:     stripe.Int64(change.ProrationDate.Unix()),
+	}
+	params.Context = ctx
+
+	inv, err := invoice.Upcoming(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to preview subscription change: %w", err)
+	}
+
+	var prorated int64
+	if inv.Lines != nil {
+		for _, line := range inv.Lines.Data {
+			if line.Proration {
+				prorated += line.Amount
+			}
+		}
+	}
+
+	nextInvoiceAt := inv.NextPaymentAttempt
+	if nextInvoiceAt == 0 {
+		nextInvoiceAt = inv.PeriodEnd
+	}
+
+	return &service.SubscriptionChangePreview{
+		ProratedAmount:    prorated,
+		NextInvoiceAmount: inv.AmountDue,
+		NextInvoiceAt:     time.Unix(nextInvoiceAt, 0),
+		Currency:          string(inv.Currency),
+		ProrationDate:     change.ProrationDate,
+	}, nil
+}
+
+// ChangeSubscription changes a subscription's plan and seat count. The
+// proration is added to the next invoice.
+func (c *Client) ChangeSubscription(ctx context.Context, change service.SubscriptionChange) (*service.Subscription, error) {
+	items, err := c.subscriptionChangeItems(change)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &stripe.SubscriptionParams{
+		Items:             items,
+		ProrationBehavior: stripe.String("create_prorations"),
+		ProrationDate:     stripe.Int64(change.ProrationDate.Unix()),
+	}
+	params.Context = ctx
+	params.AddMetadata("plan", change.Plan.String())
+
+	sub, err := subscription.Update(change.SubscriptionID, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to change subscription: %w", err)
+	}
+
+	return toSubscription(sub), nil
+}
+
+// subscriptionChangeItems returns the item update moving a subscription's
+// seat item to the change's plan price and seat count.
+func (c *Client) subscriptionChangeItems(change service.SubscriptionChange) ([]*stripe.SubscriptionItemsParams, error) {
+	priceID, err := c.priceID(change.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := subscription.Get(change.SubscriptionID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+	if sub.Items == nil || len(sub.Items.Data) == 0 {
+		return nil, fmt.Errorf("subscription has no items")
+	}
+
+	return []*stripe.SubscriptionItemsParams{
+		{
+			ID:       stripe.String(sub.Items.Data[0].ID),
+			Price:    stripe.String(priceID),
+			Quantity: stripe.Int64(int64(change.SeatCount)),
+		},
+	}, nil
+}
+
+// priceID returns the Stripe price configured for a self-service plan.
+func (c *Client) priceID(plan valueobject.Plan) (string, error) {
+	var priceID string
+	switch plan {
+	case valueobject.PlanStarter:
+		priceID = c.starterPriceID
+	case valueobject.PlanPro:
+		priceID = c.proPriceID
+	default:
+		return "", fmt.Errorf("no self-service price for plan: %s", plan)
+	}
+
+	if priceID == "" {
+		return "", fmt.Errorf("no price ID configured for plan: %s", plan)
+	}
+	return priceID, nil
+}
+
 // GetCheckoutSession retrieves a checkout session by ID.
 func (c *Client) GetCheckoutSession(ctx context.Context, sessionID string) (*service.CheckoutSession, error) {
 	sess, err := session.Get(sessionID, nil)
@@ -245,6 +321,120 @@ func (c *Client) GetCheckoutSession(ctx context.Context, sessionID string) (*ser
 		return nil, fmt.Errorf("failed to get checkout session: %w", err)
 	}
 
+	return toCheckoutSession(sess), nil
+}
+
+// VerifyWebhook verifies a webhook signature and parses the event.
+func (c *Client) VerifyWebhook(payload []byte, signature string) (*service.WebhookEvent, error) {
+	event, err := webhook.ConstructEventWithOptions(payload, signature, c.webhookSecret, webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook signature: %w", err)
+	}
+
+	return toWebhookEvent(event, payload)
+}
+
+// ParseWebhookEvent parses a stored event that was verified when it was delivered.
+func (c *Client) ParseWebhookEvent(payload []byte) (*service.WebhookEvent, error) {
+	var event stripe.Event
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("invalid webhook event: %w", err)
+	}
+	if event.Data == nil {
+		return nil, fmt.Errorf("invalid webhook event: no data")
+	}
+
+	return toWebhookEvent(event, payload)
+}
+
+// toWebhookEvent maps a Stripe event, parsing its object for the event types we handle.
+func toWebhookEvent(event stripe.Event, payload []byte) (*service.WebhookEvent, error) {
+	result := &service.WebhookEvent{
+		ID:      event.ID,
+		Type:    string(event.Type),
+		Created: time.Unix(event.Created, 0),
+		Payload: payload,
+		Data: service.WebhookEventData{
+			Raw: event.Data.Raw, // Raw JSON of the event object for caller to unmarshal
+		},
+	}
+
+	var err error
+	switch {
+	case strings.HasPrefix(result.Type, "checkout.session."):
+		var sess stripe.CheckoutSession
+		if err = json.Unmarshal(event.Data.Raw, &sess); err == nil {
+			result.Data.CheckoutSession = toCheckoutSession(&sess)
+		}
+	case strings.HasPrefix(result.Type, "customer.subscription."):
+		var sub stripe.Subscription
+		if err = json.Unmarshal(event.Data.Raw, &sub); err == nil {
+			result.Data.Subscription = toSubscription(&sub)
+		}
+	case strings.HasPrefix(result.Type, "invoice."):
+		var inv stripe.Invoice
+		if err = json.Unmarshal(event.Data.Raw, &inv); err == nil {
+			result.Data.Invoice = toInvoice(&inv)
+		}
+	case strings.HasPrefix(result.Type, "charge."):
+		var ch stripe.Charge
+		if err = json.Unmarshal(event.Data.Raw, &ch); err == nil {
+			result.Data.Charge = toCharge(&ch)
+		}
+	case strings.HasPrefix(result.Type, "customer."):
+		var cust stripe.Customer
+		if err = json.Unmarshal(event.Data.Raw, &cust); err == nil {
+			result.Data.Customer = &service.Customer{ID: cust.ID, Email: cust.Email, Name: cust.Name}
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s event object: %w", result.Type, err)
+	}
+
+	return result, nil
+}
+
+// toSubscription maps a Stripe subscription to our domain subscription.
+func toSubscription(sub *stripe.Subscription) *service.Subscription {
+	// Get first item ID for seat updates
+	var itemID string
+	var seatCount int64
+	if sub.Items != nil && len(sub.Items.Data) > 0 {
+		itemID = sub.Items.Data[0].ID
+		seatCount = sub.Items.Data[0].Quantity
+	}
+
+	// Get plan from metadata
+	plan := valueobject.PlanStarter // default
+	if sub.Metadata != nil {
+		if metaPlan, ok := sub.Metadata["plan"]; ok {
+			if p, err := valueobject.ParsePlan(metaPlan); err == nil {
+				plan = p
+			}
+		}
+	}
+
+	var customerID string
+	if sub.Customer != nil {
+		customerID = sub.Customer.ID
+	}
+
+	return &service.Subscription{
+		ID:                sub.ID,
+		CustomerID:        customerID,
+		Status:            mapStripeStatus(sub.Status),
+		Plan:              plan,
+		CurrentPeriodEnd:  sub.CurrentPeriodEnd,
+		CancelAtPeriodEnd: sub.CancelAtPeriodEnd,
+		SeatCount:         int(seatCount),
+		ItemID:            itemID,
+	}
+}
+
+// toCheckoutSession maps a Stripe checkout session, reading the company and plan from its metadata.
+func toCheckoutSession(sess *stripe.CheckoutSession) *service.CheckoutSession {
 	// Parse company ID from metadata
 	var companyID uuid.UUID
 	if sess.Metadata != nil {
@@ -263,7 +453,10 @@ func (c *Client) GetCheckoutSession(ctx context.Context, sessionID string) (*ser
 		}
 	}
 
-	var subscriptionID string
+	var customerID, subscriptionID string
+	if sess.Customer != nil {
+		customerID = sess.Customer.ID
+	}
 	if sess.Subscription != nil {
 		subscriptionID = sess.Subscription.ID
 	}
@@ -271,28 +464,48 @@ func (c *Client) GetCheckoutSession(ctx context.Context, sessionID string) (*ser
 	return &service.CheckoutSession{
 		ID:             sess.ID,
 		URL:            sess.URL,
-		CustomerID:     sess.Customer.ID,
+		CustomerID:     customerID,
 		SubscriptionID: subscriptionID,
 		CompanyID:      companyID,
 		Plan:           plan,
-	}, nil
+	}
 }
 
-// VerifyWebhook verifies a webhook signature and parses the event.
-func (c *Client) VerifyWebhook(payload []byte, signature string) (*service.WebhookEvent, error) {
-	event, err := webhook.ConstructEventWithOptions(payload, signature, c.webhookSecret, webhook.ConstructEventOptions{
-		IgnoreAPIVersionMismatch: true,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("invalid webhook signature: %w", err)
+// toInvoice maps a Stripe invoice to our domain invoice.
+func toInvoice(inv *stripe.Invoice) *service.Invoice {
+	result := &service.Invoice{
+		ID:               inv.ID,
+		Status:           string(inv.Status),
+		AmountDue:        inv.AmountDue,
+		AmountPaid:       inv.AmountPaid,
+		Currency:         string(inv.Currency),
+		HostedInvoiceURL: inv.HostedInvoiceURL,
 	}
+	if inv.Customer != nil {
+		result.CustomerID = inv.Customer.ID
+	}
+	if inv.Subscription != nil {
+		result.SubscriptionID = inv.Subscription.ID
+	}
+	return result
+}
 
-	return &service.WebhookEvent{
-		Type: string(event.Type),
-		Data: service.WebhookEventData{
-			Raw: event.Data.Raw, // Raw JSON of the event object for caller to unmarshal
-		},
-	}, nil
+// toCharge maps a Stripe charge to our domain charge.
+func toCharge(ch *stripe.Charge) *service.Charge {
+	result := &service.Charge{
+		ID:             ch.ID,
+		Amount:         ch.Amount,
+		AmountRefunded: ch.AmountRefunded,
+		Currency:       string(ch.Currency),
+		Refunded:       ch.Refunded,
+	}
+	if ch.Customer != nil {
+		result.CustomerID = ch.Customer.ID
+	}
+	if ch.Invoice != nil {
+		result.InvoiceID = ch.Invoice.ID
+	}
+	return result
 }
 
 // mapStripeStatus maps Stripe subscription status to our domain status.
@@ -0,0 +1,99 @@
+package xapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// Version is the xAPI specification version sent with every request.
+const Version = "1.0.3"
+
+// Client implements service.LRSClient for xAPI Learning Record Stores.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a new xAPI LRS client.
+func NewClient(httpClient *http.Client) service.LRSClient {
+	return &Client{httpClient: httpClient}
+}
+
+// TestConnection verifies the endpoint is reachable and accepts the credentials
+// by requesting a single statement from the Statements resource.
+func (c *Client) TestConnection(ctx context.Context, cfg service.LRSConfig) error {
+	statementsURL, err := StatementsURL(cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, statementsURL+"?limit=1", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(cfg.Key, cfg.Secret)
+	req.Header.Set("X-Experience-API-Version", Version)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach LRS: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("LRS rejected the credentials (status %d)", resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("unexpected LRS response status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// SendStatements posts a statement or a JSON array of statements to the Statements resource.
+func (c *Client) SendStatements(ctx context.Context, cfg service.LRSConfig, statements []byte) error {
+	statementsURL, err := StatementsURL(cfg.Endpoint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, statementsURL, bytes.NewReader(statements))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(cfg.Key, cfg.Secret)
+	req.Header.Set("X-Experience-API-Version", Version)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach LRS: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("LRS rejected the credentials (status %d)", resp.StatusCode)
+	case resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent:
+		return fmt.Errorf("LRS rejected the statements (status %d)", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// StatementsURL returns the Statements resource URL for an xAPI endpoint.
+func StatementsURL(endpoint string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid LRS endpoint: %q", endpoint)
+	}
+	return strings.TrimSuffix(u.String(), "/") + "/statements", nil
+}
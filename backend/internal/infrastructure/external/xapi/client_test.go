@@ -0,0 +1,165 @@
+package xapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/pkg/httputil"
+)
+
+// lrsRequest is a request received by the test LRS.
+type lrsRequest struct {
+	method  string
+	path    string
+	query   string
+	key     string
+	secret  string
+	version string
+	ctype   string
+	body    string
+}
+
+// newTestLRS starts an LRS that answers every request with status and
+// records what it received.
+func newTestLRS(t *testing.T, status int) (*httptest.Server, *[]lrsRequest) {
+	var received []lrsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, secret, _ := r.BasicAuth()
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, lrsRequest{
+			method:  r.Method,
+			path:    r.URL.Path,
+			query:   r.URL.RawQuery,
+			key:     key,
+			secret:  secret,
+			version: r.Header.Get("X-Experience-API-Version"),
+			ctype:   r.Header.Get("Content-Type"),
+			body:    string(body),
+		})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &received
+}
+
+func TestTestConnection(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{"accepted", http.StatusOK, ""},
+		{"unauthorized", http.StatusUnauthorized, "rejected the credentials"},
+		{"forbidden", http.StatusForbidden, "rejected the credentials"},
+		{"not an LRS", http.StatusNotFound, "unexpected LRS response status 404"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := newTestLRS(t, tt.status)
+			cfg := service.LRSConfig{Endpoint: server.URL + "/xapi/", Key: "key", Secret: "secret"}
+
+			err := NewClient(server.Client()).TestConnection(context.Background(), cfg)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("TestConnection() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("TestConnection() error = %v, want one containing %q", err, tt.wantErr)
+			}
+
+			if len(*received) != 1 {
+				t.Fatalf("LRS received %d requests, want 1", len(*received))
+			}
+			req := (*received)[0]
+			if req.method != http.MethodGet || req.path != "/xapi/statements" || req.query != "limit=1" {
+				t.Errorf("request = %s %s?%s, want GET /xapi/statements?limit=1", req.method, req.path, req.query)
+			}
+			if req.key != "key" || req.secret != "secret" || req.version != Version {
+				t.Errorf("request credentials %q:%q, version %q", req.key, req.secret, req.version)
+			}
+		})
+	}
+}
+
+func TestSendStatements(t *testing.T) {
+	statements := `[{"actor":{"mbox":"mailto:learner@example.com"},"verb":{"id":"http://adlnet.gov/expapi/verbs/completed"}}]`
+
+	tests := []struct {
+		name    string
+		status  int
+		wantErr string
+	}{
+		{"stored", http.StatusOK, ""},
+		{"stored without content", http.StatusNoContent, ""},
+		{"unauthorized", http.StatusUnauthorized, "rejected the credentials"},
+		{"invalid statements", http.StatusBadRequest, "rejected the statements (status 400)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, received := newTestLRS(t, tt.status)
+			cfg := service.LRSConfig{Endpoint: server.URL + "/xapi", Key: "key", Secret: "secret"}
+
+			err := NewClient(server.Client()).SendStatements(context.Background(), cfg, []byte(statements))
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("SendStatements() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("SendStatements() error = %v, want one containing %q", err, tt.wantErr)
+			}
+
+			if len(*received) != 1 {
+				t.Fatalf("LRS received %d requests, want 1", len(*received))
+			}
+			req := (*received)[0]
+			if req.method != http.MethodPost || req.path != "/xapi/statements" {
+				t.Errorf("request = %s %s, want POST /xapi/statements", req.method, req.path)
+			}
+			if req.body != statements || req.ctype != "application/json" {
+				t.Errorf("forwarded %q as %q, want the statements as JSON", req.body, req.ctype)
+			}
+			if req.key != "key" || req.secret != "secret" || req.version != Version {
+				t.Errorf("request credentials %q:%q, version %q", req.key, req.secret, req.version)
+			}
+		})
+	}
+}
+
+func TestClientRefusesPrivateLRS(t *testing.T) {
+	server, received := newTestLRS(t, http.StatusOK)
+	cfg := service.LRSConfig{Endpoint: server.URL, Key: "key", Secret: "secret"}
+	c := NewClient(httputil.NewPublicClient(httputil.DefaultTimeout, nil))
+
+	if err := c.TestConnection(context.Background(), cfg); err == nil || !strings.Contains(err.Error(), "non-public address") {
+		t.Errorf("TestConnection() error = %v, want the loopback LRS refused", err)
+	}
+	if err := c.SendStatements(context.Background(), cfg, []byte(`[]`)); err == nil {
+		t.Error("SendStatements() to a loopback LRS succeeded")
+	}
+	if len(*received) != 0 {
+		t.Errorf("loopback LRS received %d requests", len(*received))
+	}
+}
+
+func TestStatementsURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{"https://lrs.example.com/xapi/", "https://lrs.example.com/xapi/statements", false},
+		{" https://lrs.example.com/xapi ", "https://lrs.example.com/xapi/statements", false},
+		{"ftp://lrs.example.com/xapi/", "", true},
+		{"lrs.example.com/xapi/", "", true},
+		{"https:///xapi/", "", true},
+	}
+	for _, tt := range tests {
+		got, err := StatementsURL(tt.endpoint)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("StatementsURL(%q) = %q, %v; want %q", tt.endpoint, got, err, tt.want)
+		}
+	}
+}
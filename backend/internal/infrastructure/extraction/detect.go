@@ -0,0 +1,79 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// extensionMIMETypes maps file extensions to MIME types for formats that
+// cannot be told apart by magic bytes alone.
+var extensionMIMETypes = map[string]string{
+	".pdf":      MIMETypePDF,
+	".docx":     MIMETypeDOCX,
+	".pptx":     MIMETypePPTX,
+	".xlsx":     MIMETypeXLSX,
+	".html":     MIMETypeHTML,
+	".htm":      MIMETypeHTML,
+	".md":       MIMETypeMarkdown,
+	".markdown": MIMETypeMarkdown,
+	".txt":      MIMETypePlain,
+	".text":     MIMETypePlain,
+	".csv":      MIMETypePlain,
+}
+
+// DetectMIMEType determines a file's MIME type from its magic bytes,
+// falling back to the file extension for text formats.
+func DetectMIMEType(fileName string, content []byte) string {
+	switch {
+	case bytes.HasPrefix(content, []byte("%PDF-")):
+		return MIMETypePDF
+	case bytes.HasPrefix(content, []byte("PK\x03\x04")):
+		return detectOOXML(content)
+	}
+
+	byExtension := extensionMIMETypes[strings.ToLower(filepath.Ext(fileName))]
+
+	sniffed := http.DetectContentType(content)
+	switch {
+	case strings.HasPrefix(sniffed, MIMETypeHTML):
+		return MIMETypeHTML
+	case strings.HasPrefix(sniffed, "text/"):
+		if byExtension == MIMETypeMarkdown || byExtension == MIMETypeHTML {
+			return byExtension
+		}
+		return MIMETypePlain
+	}
+
+	// DetectContentType only inspects the first 512 bytes, which may cut a
+	// multi-byte character; trust the extension when the text is valid UTF-8.
+	if byExtension != "" && utf8.Valid(content) {
+		return byExtension
+	}
+
+	return sniffed
+}
+
+// detectOOXML identifies Office Open XML packages by their main part.
+func detectOOXML(content []byte) string {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "application/zip"
+	}
+
+	for _, f := range zr.File {
+		switch {
+		case strings.HasPrefix(f.Name, "word/"):
+			return MIMETypeDOCX
+		case strings.HasPrefix(f.Name, "ppt/"):
+			return MIMETypePPTX
+		case strings.HasPrefix(f.Name, "xl/"):
+			return MIMETypeXLSX
+		}
+	}
+
+	return "application/zip"
+}
@@ -0,0 +1,250 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// DOCXExtractor extracts text from Word documents. Heading styles become
+// section headings, tables become " | " separated rows, and page breaks
+// recorded by Word (explicit or last rendered) become page numbers.
+type DOCXExtractor struct{}
+
+// NewDOCXExtractor creates a new DOCXExtractor.
+func NewDOCXExtractor() *DOCXExtractor {
+	return &DOCXExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *DOCXExtractor) MIMETypes() []string {
+	return []string{MIMETypeDOCX}
+}
+
+// Extract parses word/document.xml in document order.
+func (e *DOCXExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	pkg, err := openOOXML(content)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := pkg.read("word/document.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	headingStyles := map[string]int{}
+	if pkg.has("word/styles.xml") {
+		styles, err := pkg.read("word/styles.xml")
+		if err != nil {
+			return nil, err
+		}
+		headingStyles = docxHeadingStyles(styles)
+	}
+
+	return parseDOCXBody(ctx, document, headingStyles)
+}
+
+// parseDOCXBody walks the document body, tracking paragraphs, tables and page breaks.
+func parseDOCXBody(ctx context.Context, document []byte, headingStyles map[string]int) ([]service.DocumentSection, error) {
+	var b sectionBuilder
+
+	// Only number pages when Word recorded page breaks; otherwise the page
+	// layout is unknown and every section stays on page 0.
+	page := int32(0)
+	if bytes.Contains(document, []byte("lastRenderedPageBreak")) || bytes.Contains(document, []byte(`type="page"`)) {
+		page = 1
+		b.page(page)
+	}
+
+	var (
+		para         strings.Builder
+		inPara       bool
+		inText       bool
+		level        int
+		isList       bool
+		pendingPages int32
+		tableDepth   int
+		row          *tableRow
+	)
+
+	breakPage := func() {
+		if page == 0 {
+			return
+		}
+		if inPara && strings.TrimSpace(para.String()) != "" {
+			pendingPages++
+			return
+		}
+		page++
+		b.page(page)
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(document))
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "p":
+				inPara = true
+				para.Reset()
+				level = 0
+				isList = false
+			case "pStyle":
+				if l, ok := headingStyles[attrValue(t, "val")]; ok {
+					level = l
+				} else if l := builtinHeadingLevel(attrValue(t, "val")); l > 0 {
+					level = l
+				}
+			case "outlineLvl":
+				if l, err := strconv.Atoi(attrValue(t, "val")); err == nil && l < 9 && level == 0 {
+					level = l + 1
+				}
+			case "numPr":
+				isList = true
+			case "pageBreakBefore":
+				if v := attrValue(t, "val"); v == "" || v == "1" || v == "true" {
+					breakPage()
+				}
+			case "t":
+				inText = true
+			case "tab":
+				if inPara {
+					para.WriteString("\t")
+				}
+			case "br", "cr":
+				if attrValue(t, "type") == "page" {
+					breakPage()
+				} else {
+					para.WriteString("\n")
+				}
+			case "lastRenderedPageBreak":
+				breakPage()
+			case "tbl":
+				tableDepth++
+			case "tr":
+				if tableDepth == 1 {
+					row = &tableRow{}
+				}
+			}
+
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				inPara = false
+				text := strings.TrimSpace(para.String())
+				switch {
+				case tableDepth > 0 && row != nil:
+					if row.cell.Len() > 0 {
+						row.cell.WriteString(" ")
+					}
+					row.cell.WriteString(text)
+				case level > 0 && level <= 6:
+					b.heading(text, level)
+				case isList && text != "":
+					b.paragraph("- " + text)
+				default:
+					b.paragraph(text)
+				}
+				for ; pendingPages > 0; pendingPages-- {
+					page++
+					b.page(page)
+				}
+			case "tc":
+				if tableDepth == 1 && row != nil {
+					row.endCell()
+				}
+			case "tr":
+				if tableDepth == 1 && row != nil {
+					b.paragraph(row.String())
+					row = nil
+				}
+			case "tbl":
+				tableDepth--
+			}
+
+		case xml.CharData:
+			if inText && inPara {
+				para.Write(t)
+			}
+		}
+	}
+
+	return b.build(), nil
+}
+
+// docxHeadingStyles maps paragraph style IDs to heading levels using the
+// style names and outline levels in word/styles.xml. Style IDs are localized
+// (e.g. "berschrift1"), but style names are not.
+func docxHeadingStyles(data []byte) map[string]int {
+	var styles struct {
+		Styles []struct {
+			Type    string `xml:"type,attr"`
+			StyleID string `xml:"styleId,attr"`
+			Name    struct {
+				Val string `xml:"val,attr"`
+			} `xml:"name"`
+			PPr struct {
+				OutlineLvl *struct {
+					Val string `xml:"val,attr"`
+				} `xml:"outlineLvl"`
+			} `xml:"pPr"`
+		} `xml:"style"`
+	}
+
+	levels := map[string]int{}
+	if err := xml.Unmarshal(data, &styles); err != nil {
+		return levels
+	}
+
+	for _, s := range styles.Styles {
+		if s.Type != "paragraph" {
+			continue
+		}
+		if l := builtinHeadingLevel(s.Name.Val); l > 0 {
+			levels[s.StyleID] = l
+			continue
+		}
+		if s.PPr.OutlineLvl != nil {
+			if l, err := strconv.Atoi(s.PPr.OutlineLvl.Val); err == nil && l < 6 {
+				levels[s.StyleID] = l + 1
+			}
+		}
+	}
+	return levels
+}
+
+// builtinHeadingLevel returns the heading level for Word's built-in style
+// names ("heading 1", "Heading1", "Title"), or 0.
+func builtinHeadingLevel(name string) int {
+	normalized := strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	if normalized == "title" {
+		return 1
+	}
+	if rest, ok := strings.CutPrefix(normalized, "heading"); ok {
+		if l, err := strconv.Atoi(rest); err == nil && l >= 1 && l <= 6 {
+			return l
+		}
+	}
+	return 0
+}
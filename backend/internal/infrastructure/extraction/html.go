@@ -0,0 +1,263 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// HTMLExtractor extracts visible text from HTML documents, using h1-h6 as
+// section boundaries and skipping scripts, styles and page chrome.
+type HTMLExtractor struct{}
+
+// NewHTMLExtractor creates a new HTMLExtractor.
+func NewHTMLExtractor() *HTMLExtractor {
+	return &HTMLExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *HTMLExtractor) MIMETypes() []string {
+	return []string{MIMETypeHTML, "application/xhtml+xml"}
+}
+
+// Extract parses the document and walks the body in order.
+func (e *HTMLExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	doc, err := html.Parse(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	// Web pages often wrap the article in <main> alongside related links and
+	// comments, so a single <article> is the tighter choice
+	var root *html.Node
+	if articles := findElements(doc, atom.Article); len(articles) == 1 {
+		root = articles[0]
+	}
+	if root == nil {
+		root = findElement(doc, atom.Main)
+	}
+	if root == nil {
+		root = findElement(doc, atom.Body)
+	}
+	if root == nil {
+		root = doc
+	}
+
+	w := &htmlWalker{}
+
+	// Use the page title as the top-level heading when the body has none
+	if title := findElement(doc, atom.Title); title != nil && findElement(root, atom.H1) == nil {
+		w.b.heading(nodeText(title), 1)
+	}
+
+	w.walk(root)
+	w.flushInline()
+
+	return w.b.build(), nil
+}
+
+// htmlSkippedElements are never part of the document's main text.
+var htmlSkippedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Nav:      true,
+	atom.Header:   true,
+	atom.Footer:   true,
+	atom.Aside:    true,
+	atom.Form:     true,
+	atom.Svg:      true,
+	atom.Iframe:   true,
+	atom.Head:     true,
+}
+
+// htmlBlockElements end the current paragraph.
+var htmlBlockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true,
+	atom.Blockquote: true, atom.Pre: true, atom.Ul: true, atom.Ol: true,
+	atom.Li: true, atom.Dl: true, atom.Dt: true, atom.Dd: true,
+	atom.Table: true, atom.Tr: true, atom.Figure: true, atom.Figcaption: true,
+	atom.Hr: true, atom.Address: true,
+}
+
+var htmlHeadingLevels = map[atom.Atom]int{
+	atom.H1: 1, atom.H2: 2, atom.H3: 3, atom.H4: 4, atom.H5: 5, atom.H6: 6,
+}
+
+type htmlWalker struct {
+	b      sectionBuilder
+	inline strings.Builder
+	pre    int
+}
+
+func (w *htmlWalker) walk(n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		w.inline.WriteString(n.Data)
+		return
+	case html.ElementNode:
+		if htmlSkippedElements[n.DataAtom] {
+			return
+		}
+		if hidden(n) {
+			return
+		}
+
+		if level, ok := htmlHeadingLevels[n.DataAtom]; ok {
+			w.flushInline()
+			w.b.heading(nodeText(n), level)
+			return
+		}
+
+		switch n.DataAtom {
+		case atom.Br:
+			w.inline.WriteString("\n")
+			return
+		case atom.Td, atom.Th:
+			if w.inline.Len() > 0 {
+				w.inline.WriteString(" | ")
+			}
+		case atom.Li:
+			w.flushInline()
+			w.inline.WriteString("- ")
+		case atom.Img:
+			if alt := attr(n, "alt"); alt != "" {
+				w.inline.WriteString(" " + alt + " ")
+			}
+			return
+		case atom.Pre:
+			w.flushInline()
+			w.pre++
+			defer func() { w.pre-- }()
+		}
+
+		block := htmlBlockElements[n.DataAtom]
+		if block && n.DataAtom != atom.Li {
+			w.flushInline()
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			w.walk(c)
+		}
+		if block {
+			w.flushInline()
+		}
+		return
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		w.walk(c)
+	}
+}
+
+// flushInline ends the current paragraph.
+func (w *htmlWalker) flushInline() {
+	text := w.inline.String()
+	w.inline.Reset()
+	if w.pre > 0 {
+		w.b.paragraph(strings.Trim(text, "\n"))
+		return
+	}
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = collapseSpace(line)
+	}
+	w.b.paragraph(strings.Join(lines, "\n"))
+}
+
+// findElement returns the first element with the given tag in document order.
+func findElement(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// findElements returns all elements with the given tag in document order,
+// without descending into matches.
+func findElements(n *html.Node, a atom.Atom) []*html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return []*html.Node{n}
+	}
+	var found []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		found = append(found, findElements(c, a)...)
+	}
+	return found
+}
+
+// nodeText returns the concatenated text of a node's descendants.
+func nodeText(n *html.Node) string {
+	var sb strings.Builder
+	var collect func(*html.Node)
+	collect = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			sb.WriteString(n.Data)
+		}
+		if n.Type == html.ElementNode && htmlSkippedElements[n.DataAtom] {
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			collect(c)
+		}
+	}
+	collect(n)
+	return sb.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// htmlSkippedRoles are ARIA landmarks equivalent to the skipped page chrome
+// elements, for sites that build navigation out of <div>s.
+var htmlSkippedRoles = map[string]bool{
+	"navigation":    true,
+	"banner":        true,
+	"contentinfo":   true,
+	"complementary": true,
+	"search":        true,
+	"dialog":        true,
+}
+
+// hidden reports whether an element is explicitly hidden from readers or is
+// page chrome marked with an ARIA role.
+func hidden(n *html.Node) bool {
+	for _, a := range n.Attr {
+		switch a.Key {
+		case "role":
+			if htmlSkippedRoles[strings.ToLower(strings.TrimSpace(a.Val))] {
+				return true
+			}
+		case "hidden":
+			return true
+		case "aria-hidden":
+			if a.Val == "true" {
+				return true
+			}
+		case "style":
+			style := strings.ReplaceAll(strings.ToLower(a.Val), " ", "")
+			if strings.Contains(style, "display:none") || strings.Contains(style, "visibility:hidden") {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,141 @@
+package extraction
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// maxPartSize caps how much of a single package part is decompressed, so a
+// malicious archive cannot exhaust worker memory.
+const maxPartSize = 64 << 20
+
+// maxDocumentSize caps how much is decompressed from one document across all
+// of its parts or streams, which may each be small but read many times.
+const maxDocumentSize = 256 << 20
+
+var errDocumentTooLarge = fmt.Errorf("document expands to more than %d MiB", maxDocumentSize>>20)
+
+// ooxmlPackage provides access to the parts of an Office Open XML file.
+type ooxmlPackage struct {
+	files     map[string]*zip.File
+	remaining int // Bytes that may still be decompressed
+}
+
+func openOOXML(content []byte) (*ooxmlPackage, error) {
+	zr, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open package: %w", err)
+	}
+	pkg := &ooxmlPackage{files: make(map[string]*zip.File, len(zr.File)), remaining: maxDocumentSize}
+	for _, f := range zr.File {
+		pkg.files[strings.TrimPrefix(f.Name, "/")] = f
+	}
+	return pkg, nil
+}
+
+// has reports whether the package contains the named part.
+func (p *ooxmlPackage) has(name string) bool {
+	_, ok := p.files[name]
+	return ok
+}
+
+// read returns the content of a part.
+func (p *ooxmlPackage) read(name string) ([]byte, error) {
+	f, ok := p.files[name]
+	if !ok {
+		return nil, fmt.Errorf("package part %s not found", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", name, err)
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(io.LimitReader(rc, maxPartSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	if len(data) > maxPartSize {
+		return nil, fmt.Errorf("package part %s is too large", name)
+	}
+	if len(data) > p.remaining {
+		return nil, errDocumentTooLarge
+	}
+	p.remaining -= len(data)
+	return data, nil
+}
+
+// ooxmlRelationship is an entry in a part's .rels file.
+type ooxmlRelationship struct {
+	ID     string `xml:"Id,attr"`
+	Type   string `xml:"Type,attr"`
+	Target string `xml:"Target,attr"`
+}
+
+// relationships returns the relationships of a part keyed by ID, with
+// targets resolved to package part names.
+func (p *ooxmlPackage) relationships(part string) (map[string]ooxmlRelationship, error) {
+	dir, file := path.Split(part)
+	relsName := dir + "_rels/" + file + ".rels"
+	if !p.has(relsName) {
+		return map[string]ooxmlRelationship{}, nil
+	}
+
+	data, err := p.read(relsName)
+	if err != nil {
+		return nil, err
+	}
+
+	var rels struct {
+		Relationships []ooxmlRelationship `xml:"Relationship"`
+	}
+	if err := xml.Unmarshal(data, &rels); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", relsName, err)
+	}
+
+	out := make(map[string]ooxmlRelationship, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			rel.Target = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			rel.Target = path.Join(dir, rel.Target)
+		}
+		out[rel.ID] = rel
+	}
+	return out, nil
+}
+
+// attrValue returns the value of the attribute with the given local name.
+func attrValue(el xml.StartElement, local string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// tableRow accumulates the cells of a table row into a single " | " separated line.
+type tableRow struct {
+	cells []string
+	cell  strings.Builder
+}
+
+func (r *tableRow) endCell() {
+	r.cells = append(r.cells, collapseSpace(r.cell.String()))
+	r.cell.Reset()
+}
+
+func (r *tableRow) String() string {
+	// Drop trailing empty cells so sparse rows stay short
+	end := len(r.cells)
+	for end > 0 && r.cells[end-1] == "" {
+		end--
+	}
+	return strings.Join(r.cells[:end], " | ")
+}
@@ -0,0 +1,48 @@
+package extraction
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOOXMLDocumentLimit(t *testing.T) {
+	pkg, err := openOOXML(readFixture(t, "sample.docx"))
+	if err != nil {
+		t.Fatalf("openOOXML() error = %v", err)
+	}
+
+	data, err := pkg.read("word/document.xml")
+	if err != nil {
+		t.Fatalf("read() error = %v", err)
+	}
+
+	// Allow one more read of the part, but not two
+	pkg.remaining = len(data) + len(data)/2
+	if _, err := pkg.read("word/document.xml"); err != nil {
+		t.Fatalf("read() within the limit error = %v", err)
+	}
+	if _, err := pkg.read("word/document.xml"); !errors.Is(err, errDocumentTooLarge) {
+		t.Errorf("read() past the limit error = %v, want errDocumentTooLarge", err)
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	tests := []struct {
+		ref  string
+		next int
+		want int
+	}{
+		{"A1", 5, 0},
+		{"C7", 0, 2},
+		{"AA10", 0, 26},
+		{"XFD1", 0, 16383},
+		{"", 4, 4},
+		{"12", 3, 3},
+		{"ZZZZZZZZZZ1", 2, 2}, // Would otherwise pad the row with billions of cells
+	}
+	for _, tt := range tests {
+		if got := columnIndex(tt.ref, tt.next); got != tt.want {
+			t.Errorf("columnIndex(%q, %d) = %d, want %d", tt.ref, tt.next, got, tt.want)
+		}
+	}
+}
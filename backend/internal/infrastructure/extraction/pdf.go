@@ -0,0 +1,611 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// PDFExtractor extracts the text layer of PDF documents. Each PDF page becomes
+// a page in the output, and lines set noticeably larger than the body text
+// become headings. Scanned PDFs without a text layer are rejected.
+type PDFExtractor struct{}
+
+// NewPDFExtractor creates a new PDFExtractor.
+func NewPDFExtractor() *PDFExtractor {
+	return &PDFExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *PDFExtractor) MIMETypes() []string {
+	return []string{MIMETypePDF}
+}
+
+// maxFormDepth limits nesting of form XObjects, which may reference each other.
+const maxFormDepth = 8
+
+// Extract reads pages in page tree order.
+func (e *PDFExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	doc, err := parsePDF(content)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := doc.pages()
+	if len(pages) == 0 {
+		return nil, fmt.Errorf("no pages found in PDF")
+	}
+
+	fonts := make(map[pdfRef]*pdfFont)
+	pageParagraphs := make([][]pdfParagraph, len(pages))
+	for i, page := range pages {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		r := &pdfTextReader{doc: doc, fonts: fonts}
+		r.run(doc.pageContent(page.dict), page.resources, identityMatrix, 0)
+		r.endLine()
+		if doc.exhausted {
+			return nil, errDocumentTooLarge
+		}
+		pageParagraphs[i] = r.paragraphs()
+	}
+
+	bodySize, headingLevels := pdfHeadingLevels(pageParagraphs)
+
+	var b sectionBuilder
+	found := false
+	for i, paragraphs := range pageParagraphs {
+		b.page(int32(i + 1))
+		for _, p := range paragraphs {
+			found = true
+			if level, ok := headingLevels[roundSize(p.size)]; ok && p.size > bodySize && isHeadingCandidate(p) {
+				b.heading(p.text, level)
+				continue
+			}
+			b.paragraph(p.text)
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("PDF has no text layer (scanned documents are not supported)")
+	}
+	return b.build(), nil
+}
+
+// pdfPage is a leaf of the page tree with its inherited resources.
+type pdfPage struct {
+	dict      pdfDict
+	resources pdfDict
+}
+
+// pages walks the page tree from the document catalog.
+func (doc *pdfDocument) pages() []pdfPage {
+	root := doc.dict(doc.trailer["Root"])
+	if root == nil {
+		for _, obj := range doc.objects {
+			if d, ok := obj.(pdfDict); ok {
+				if typ, _ := d["Type"].(pdfName); typ == "Catalog" {
+					root = d
+					break
+				}
+			}
+		}
+	}
+
+	var out []pdfPage
+	if root != nil {
+		visited := make(map[pdfRef]bool)
+		doc.walkPages(root["Pages"], nil, visited, &out, 0)
+	}
+	if len(out) > 0 {
+		return out
+	}
+
+	// Damaged page tree: fall back to every page object in object order
+	var nums []int
+	for num, obj := range doc.objects {
+		if d := doc.dict(obj); d != nil {
+			if typ, _ := d["Type"].(pdfName); typ == "Page" {
+				nums = append(nums, num)
+			}
+		}
+	}
+	sort.Ints(nums)
+	for _, num := range nums {
+		d := doc.dict(doc.objects[num])
+		out = append(out, pdfPage{dict: d, resources: doc.dict(d["Resources"])})
+	}
+	return out
+}
+
+func (doc *pdfDocument) walkPages(node any, inherited pdfDict, visited map[pdfRef]bool, out *[]pdfPage, depth int) {
+	if ref, ok := node.(pdfRef); ok {
+		if visited[ref] {
+			return
+		}
+		visited[ref] = true
+	}
+	if depth > 64 {
+		return
+	}
+
+	d := doc.dict(node)
+	if d == nil {
+		return
+	}
+
+	resources := inherited
+	if r := doc.dict(d["Resources"]); r != nil {
+		resources = r
+	}
+
+	kids := doc.array(d["Kids"])
+	if typ, _ := d["Type"].(pdfName); typ == "Page" || (typ == "" && kids == nil) {
+		*out = append(*out, pdfPage{dict: d, resources: resources})
+		return
+	}
+	for _, kid := range kids {
+		doc.walkPages(kid, resources, visited, out, depth+1)
+	}
+}
+
+// pageContent concatenates and decodes a page's content streams.
+func (doc *pdfDocument) pageContent(page pdfDict) []byte {
+	var streams []any
+	switch c := doc.resolve(page["Contents"]).(type) {
+	case *pdfStream:
+		streams = []any{c}
+	case pdfArray:
+		streams = c
+	}
+
+	var buf bytes.Buffer
+	for _, s := range streams {
+		stream, ok := doc.resolve(s).(*pdfStream)
+		if !ok {
+			continue
+		}
+		data, err := doc.decodeStream(stream)
+		if err != nil {
+			continue
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// pdfMatrix is an affine transform [a b c d e f].
+type pdfMatrix [6]float64
+
+var identityMatrix = pdfMatrix{1, 0, 0, 1, 0, 0}
+
+func (m pdfMatrix) mul(n pdfMatrix) pdfMatrix {
+	return pdfMatrix{
+		m[0]*n[0] + m[1]*n[2],
+		m[0]*n[1] + m[1]*n[3],
+		m[2]*n[0] + m[3]*n[2],
+		m[2]*n[1] + m[3]*n[3],
+		m[4]*n[0] + m[5]*n[2] + n[4],
+		m[4]*n[1] + m[5]*n[3] + n[5],
+	}
+}
+
+func matrixFromArray(a pdfArray) (pdfMatrix, bool) {
+	if len(a) != 6 {
+		return identityMatrix, false
+	}
+	var m pdfMatrix
+	for i, v := range a {
+		f, ok := v.(float64)
+		if !ok {
+			return identityMatrix, false
+		}
+		m[i] = f
+	}
+	return m, true
+}
+
+// pdfLine is a run of text on one baseline.
+type pdfLine struct {
+	text strings.Builder
+	size float64
+	x, y float64 // start of the line
+	endX float64 // where the last glyph ended
+}
+
+// pdfParagraph is a group of consecutive lines set in the same size.
+type pdfParagraph struct {
+	text  string
+	size  float64
+	lines int
+}
+
+type pdfGraphicsState struct {
+	ctm      pdfMatrix
+	font     *pdfFont
+	fontSize float64
+	charSp   float64
+	wordSp   float64
+	hScale   float64
+	leading  float64
+	rise     float64
+}
+
+// pdfTextReader interprets content stream operators that place text and
+// groups the shown text into lines.
+type pdfTextReader struct {
+	doc   *pdfDocument
+	fonts map[pdfRef]*pdfFont
+
+	gs    pdfGraphicsState
+	stack []pdfGraphicsState
+	tm    pdfMatrix
+	lm    pdfMatrix
+
+	moved bool // the text position changed since the last show
+	line  *pdfLine
+	lines []*pdfLine
+}
+
+func (r *pdfTextReader) run(content []byte, resources pdfDict, ctm pdfMatrix, depth int) {
+	r.gs.ctm = ctm
+	if r.gs.hScale == 0 {
+		r.gs.hScale = 1
+	}
+
+	p := newPDFParser(content)
+	var operands []any
+	for {
+		obj, err := p.object()
+		if err != nil {
+			return
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		r.operator(p, string(op), operands, resources, depth)
+		operands = operands[:0]
+	}
+}
+
+func (r *pdfTextReader) operator(p *pdfParser, op string, args []any, resources pdfDict, depth int) {
+	num := func(i int) float64 {
+		if i < len(args) {
+			if f, ok := args[i].(float64); ok {
+				return f
+			}
+		}
+		return 0
+	}
+
+	switch op {
+	case "q":
+		r.stack = append(r.stack, r.gs)
+	case "Q":
+		if n := len(r.stack); n > 0 {
+			r.gs = r.stack[n-1]
+			r.stack = r.stack[:n-1]
+		}
+	case "cm":
+		if len(args) == 6 {
+			m, _ := matrixFromArray(pdfArray(args))
+			r.gs.ctm = m.mul(r.gs.ctm)
+		}
+	case "BT":
+		r.tm, r.lm = identityMatrix, identityMatrix
+		r.moved = true
+	case "Tf":
+		if len(args) == 2 {
+			if name, ok := args[0].(pdfName); ok {
+				r.gs.font = r.font(resources, name)
+			}
+			r.gs.fontSize = num(1)
+		}
+	case "Tc":
+		r.gs.charSp = num(0)
+	case "Tw":
+		r.gs.wordSp = num(0)
+	case "Tz":
+		r.gs.hScale = num(0) / 100
+	case "TL":
+		r.gs.leading = num(0)
+	case "Ts":
+		r.gs.rise = num(0)
+	case "Td":
+		r.translate(num(0), num(1))
+	case "TD":
+		r.gs.leading = -num(1)
+		r.translate(num(0), num(1))
+	case "Tm":
+		if m, ok := matrixFromArray(pdfArray(args)); ok {
+			r.tm, r.lm = m, m
+			r.moved = true
+		}
+	case "T*":
+		r.translate(0, -r.gs.leading)
+	case "Tj":
+		if len(args) == 1 {
+			if s, ok := args[0].(pdfString); ok {
+				r.show(s)
+			}
+		}
+	case "'":
+		r.translate(0, -r.gs.leading)
+		if len(args) == 1 {
+			if s, ok := args[0].(pdfString); ok {
+				r.show(s)
+			}
+		}
+	case "\"":
+		if len(args) == 3 {
+			r.gs.wordSp, r.gs.charSp = num(0), num(1)
+			r.translate(0, -r.gs.leading)
+			if s, ok := args[2].(pdfString); ok {
+				r.show(s)
+			}
+		}
+	case "TJ":
+		if len(args) == 1 {
+			if a, ok := args[0].(pdfArray); ok {
+				r.showArray(a)
+			}
+		}
+	case "Do":
+		if len(args) == 1 && depth < maxFormDepth {
+			if name, ok := args[0].(pdfName); ok {
+				r.form(resources, name, depth)
+			}
+		}
+	case "BI":
+		// Skip inline image data, which may contain arbitrary bytes
+		data := p.lex.data[p.lex.pos:]
+		if i := bytes.Index(data, []byte("ID")); i >= 0 {
+			if j := bytes.Index(data[i:], []byte("EI")); j >= 0 {
+				p.lex.pos += i + j + 2
+			}
+		}
+	}
+}
+
+func (r *pdfTextReader) translate(tx, ty float64) {
+	r.lm = pdfMatrix{1, 0, 0, 1, tx, ty}.mul(r.lm)
+	r.tm = r.lm
+	r.moved = true
+}
+
+// form renders a form XObject with its own resources and matrix.
+func (r *pdfTextReader) form(resources pdfDict, name pdfName, depth int) {
+	xobjects := r.doc.dict(resources["XObject"])
+	if xobjects == nil {
+		return
+	}
+	stream, ok := r.doc.resolve(xobjects[name]).(*pdfStream)
+	if !ok {
+		return
+	}
+	if subtype, _ := stream.dict["Subtype"].(pdfName); subtype != "Form" {
+		return
+	}
+	data, err := r.doc.decodeStream(stream)
+	if err != nil {
+		return
+	}
+
+	formResources := resources
+	if res := r.doc.dict(stream.dict["Resources"]); res != nil {
+		formResources = res
+	}
+	ctm := r.gs.ctm
+	if m, ok := matrixFromArray(r.doc.array(stream.dict["Matrix"])); ok {
+		ctm = m.mul(ctm)
+	}
+
+	saved, savedTM, savedLM := r.gs, r.tm, r.lm
+	r.run(data, formResources, ctm, depth+1)
+	r.gs, r.tm, r.lm = saved, savedTM, savedLM
+}
+
+func (r *pdfTextReader) font(resources pdfDict, name pdfName) *pdfFont {
+	fonts := r.doc.dict(resources["Font"])
+	if fonts == nil {
+		return nil
+	}
+	ref, isRef := fonts[name].(pdfRef)
+	if isRef {
+		if f, ok := r.fonts[ref]; ok {
+			return f
+		}
+	}
+	f := loadPDFFont(r.doc, r.doc.dict(fonts[name]))
+	if isRef {
+		r.fonts[ref] = f
+	}
+	return f
+}
+
+// showArray handles TJ, where numbers adjust the position between strings.
+// Adjustments wider than a fraction of an em are treated as word spaces.
+func (r *pdfTextReader) showArray(a pdfArray) {
+	for _, item := range a {
+		switch v := item.(type) {
+		case pdfString:
+			r.show(v)
+		case float64:
+			tx := -v / 1000 * r.gs.fontSize * r.gs.hScale
+			r.tm = pdfMatrix{1, 0, 0, 1, tx, 0}.mul(r.tm)
+			if v < -200 && r.line != nil {
+				r.appendSpace()
+			}
+		}
+	}
+}
+
+// show decodes a string and appends it to the current line, starting a new
+// line when the baseline moves.
+func (r *pdfTextReader) show(s pdfString) {
+	font := r.gs.font
+	if font == nil {
+		return
+	}
+
+	trm := pdfMatrix{r.gs.fontSize * r.gs.hScale, 0, 0, r.gs.fontSize, 0, r.gs.rise}.mul(r.tm).mul(r.gs.ctm)
+	size := math.Hypot(trm[2], trm[3])
+	x, y := trm[4], trm[5]
+
+	if r.line == nil || math.Abs(y-r.line.y) > size*0.5 {
+		r.endLine()
+		r.line = &pdfLine{size: size, x: x, y: y, endX: x}
+	} else if r.moved {
+		gap := x - r.line.endX
+		// Without glyph widths the gap is unknown, so any explicit move separates words
+		if gap > size*0.15 || (!font.hasWidths && gap > 0) {
+			r.appendSpace()
+		}
+	}
+	r.moved = false
+
+	var advance float64
+	for _, g := range font.decode(s) {
+		r.line.text.WriteString(g.text)
+		w := g.width/1000*r.gs.fontSize + r.gs.charSp
+		if g.isSpace {
+			w += r.gs.wordSp
+		}
+		advance += w * r.gs.hScale
+	}
+	if size > r.line.size {
+		r.line.size = size
+	}
+
+	r.tm = pdfMatrix{1, 0, 0, 1, advance, 0}.mul(r.tm)
+	end := pdfMatrix{r.gs.fontSize * r.gs.hScale, 0, 0, r.gs.fontSize, 0, r.gs.rise}.mul(r.tm).mul(r.gs.ctm)
+	r.line.endX = end[4]
+}
+
+func (r *pdfTextReader) appendSpace() {
+	text := r.line.text.String()
+	if text != "" && !strings.HasSuffix(text, " ") {
+		r.line.text.WriteString(" ")
+	}
+}
+
+func (r *pdfTextReader) endLine() {
+	if r.line != nil && strings.TrimSpace(r.line.text.String()) != "" {
+		r.lines = append(r.lines, r.line)
+	}
+	r.line = nil
+}
+
+// paragraphs groups lines into paragraphs, breaking on vertical gaps wider
+// than normal line spacing, on size changes and on jumps back up the page
+// (such as the top of a new column).
+func (r *pdfTextReader) paragraphs() []pdfParagraph {
+	var out []pdfParagraph
+	var current *pdfParagraph
+	var prev *pdfLine
+
+	for _, line := range r.lines {
+		text := collapseSpace(line.text.String())
+		if text == "" {
+			continue
+		}
+
+		startNew := current == nil
+		if prev != nil {
+			gap := prev.y - line.y
+			sameSize := math.Abs(line.size-prev.size) < 0.5
+			if !sameSize || gap < 0 || gap > math.Max(line.size, prev.size)*1.8 {
+				startNew = true
+			}
+		}
+
+		if startNew {
+			if current != nil {
+				out = append(out, *current)
+			}
+			current = &pdfParagraph{text: text, size: line.size, lines: 1}
+		} else {
+			current.text = joinPDFLines(current.text, text)
+			current.lines++
+		}
+		prev = line
+	}
+	if current != nil {
+		out = append(out, *current)
+	}
+	return out
+}
+
+// joinPDFLines joins wrapped lines, removing end-of-line hyphenation.
+func joinPDFLines(a, b string) string {
+	if strings.HasSuffix(a, "-") && len(a) > 1 && b != "" {
+		prev := a[len(a)-2]
+		next := b[0]
+		if prev >= 'a' && prev <= 'z' && next >= 'a' && next <= 'z' {
+			return a[:len(a)-1] + b
+		}
+	}
+	return a + " " + b
+}
+
+func roundSize(size float64) float64 {
+	return math.Round(size*2) / 2
+}
+
+// isHeadingCandidate reports whether a paragraph is short enough to be a heading.
+func isHeadingCandidate(p pdfParagraph) bool {
+	return p.lines <= 3 && len(p.text) <= 200
+}
+
+// pdfHeadingLevels finds the body text size (the size covering the most
+// characters) and assigns heading levels 1-3 to the larger sizes in use.
+func pdfHeadingLevels(pages [][]pdfParagraph) (float64, map[float64]int) {
+	chars := make(map[float64]int)
+	for _, paragraphs := range pages {
+		for _, p := range paragraphs {
+			chars[roundSize(p.size)] += len(p.text)
+		}
+	}
+
+	var bodySize float64
+	best := -1
+	for size, n := range chars {
+		if n > best || (n == best && size < bodySize) {
+			bodySize, best = size, n
+		}
+	}
+
+	var sizes []float64
+	for _, paragraphs := range pages {
+		for _, p := range paragraphs {
+			size := roundSize(p.size)
+			if size >= bodySize*1.15 && isHeadingCandidate(p) {
+				sizes = append(sizes, size)
+			}
+		}
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(sizes)))
+
+	levels := make(map[float64]int)
+	for _, size := range sizes {
+		if _, ok := levels[size]; ok {
+			continue
+		}
+		level := len(levels) + 1
+		if level > 3 {
+			level = 3
+		}
+		levels[size] = level
+	}
+	return bodySize, levels
+}
@@ -0,0 +1,398 @@
+package extraction
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// pdfFont maps character codes in shown strings to Unicode text and glyph widths.
+type pdfFont struct {
+	toUnicode    *pdfCMap
+	encoding     [256]string // simple fonts only
+	composite    bool        // Type0 fonts use multi-byte codes
+	widths       map[int]float64
+	defaultWidth float64
+	hasWidths    bool
+}
+
+type pdfGlyph struct {
+	text    string
+	width   float64 // in thousandths of an em
+	isSpace bool
+}
+
+func loadPDFFont(doc *pdfDocument, d pdfDict) *pdfFont {
+	f := &pdfFont{widths: make(map[int]float64)}
+	if d == nil {
+		return f
+	}
+
+	if stream, ok := doc.resolve(d["ToUnicode"]).(*pdfStream); ok {
+		if data, err := doc.decodeStream(stream); err == nil {
+			f.toUnicode = parsePDFCMap(data)
+		}
+	}
+
+	subtype, _ := d["Subtype"].(pdfName)
+	if subtype == "Type0" {
+		f.composite = true
+		f.defaultWidth = 1000
+		if descendants := doc.array(d["DescendantFonts"]); len(descendants) > 0 {
+			cid := doc.dict(descendants[0])
+			if dw, ok := doc.resolve(cid["DW"]).(float64); ok {
+				f.defaultWidth = dw
+			}
+			f.loadCIDWidths(doc, doc.array(cid["W"]))
+		}
+		f.hasWidths = true
+		return f
+	}
+
+	f.loadEncoding(doc, d["Encoding"])
+
+	first, _ := doc.resolve(d["FirstChar"]).(float64)
+	for i, w := range doc.array(d["Widths"]) {
+		if width, ok := doc.resolve(w).(float64); ok {
+			f.widths[int(first)+i] = width
+			f.hasWidths = true
+		}
+	}
+	if descriptor := doc.dict(d["FontDescriptor"]); descriptor != nil {
+		if mw, ok := doc.resolve(descriptor["MissingWidth"]).(float64); ok {
+			f.defaultWidth = mw
+		}
+	}
+	if !f.hasWidths {
+		// Standard 14 fonts omit widths; half an em is close to their average
+		f.defaultWidth = 500
+	}
+	return f
+}
+
+// loadCIDWidths parses a CIDFont /W array: "c [w1 w2 ...]" or "cFirst cLast w".
+func (f *pdfFont) loadCIDWidths(doc *pdfDocument, w pdfArray) {
+	for i := 0; i < len(w); {
+		first, ok := doc.resolve(w[i]).(float64)
+		if !ok || i+1 >= len(w) {
+			return
+		}
+		if list, ok := doc.resolve(w[i+1]).(pdfArray); ok {
+			for j, v := range list {
+				if width, ok := doc.resolve(v).(float64); ok {
+					f.widths[int(first)+j] = width
+				}
+			}
+			i += 2
+			continue
+		}
+		if i+2 >= len(w) {
+			return
+		}
+		last, ok1 := doc.resolve(w[i+1]).(float64)
+		width, ok2 := doc.resolve(w[i+2]).(float64)
+		if !ok1 || !ok2 || last-first > 65535 {
+			return
+		}
+		for c := int(first); c <= int(last); c++ {
+			f.widths[c] = width
+		}
+		i += 3
+	}
+}
+
+// loadEncoding builds the code-to-text table of a simple font from its base
+// encoding and /Differences.
+func (f *pdfFont) loadEncoding(doc *pdfDocument, enc any) {
+	for i := 0; i < 256; i++ {
+		f.encoding[i] = string(rune(i))
+	}
+	for code, r := range winAnsiHigh {
+		f.encoding[code] = string(r)
+	}
+
+	d := doc.dict(enc)
+	if d == nil {
+		return
+	}
+	code := 0
+	for _, v := range doc.array(d["Differences"]) {
+		switch item := doc.resolve(v).(type) {
+		case float64:
+			code = int(item)
+		case pdfName:
+			if code >= 0 && code < 256 {
+				if text, ok := glyphNameText(string(item)); ok {
+					f.encoding[code] = text
+				}
+			}
+			code++
+		}
+	}
+}
+
+func (f *pdfFont) decode(s pdfString) []pdfGlyph {
+	var out []pdfGlyph
+	for i := 0; i < len(s); {
+		n := 1
+		if f.toUnicode != nil {
+			n = f.toUnicode.codeLength(s[i:], f.composite)
+		} else if f.composite {
+			n = 2
+		}
+		if i+n > len(s) {
+			n = len(s) - i
+		}
+
+		code := 0
+		for _, c := range s[i : i+n] {
+			code = code<<8 | int(c)
+		}
+		key := string(s[i : i+n])
+		i += n
+
+		var text string
+		if f.toUnicode != nil {
+			text = f.toUnicode.mapping[key]
+		}
+		if text == "" && !f.composite {
+			text = f.encoding[code&0xff]
+		}
+		text = strings.Map(func(r rune) rune {
+			if r < 0x20 && r != '\t' {
+				return -1
+			}
+			return r
+		}, text)
+
+		width, ok := f.widths[code]
+		if !ok {
+			width = f.defaultWidth
+		}
+		out = append(out, pdfGlyph{
+			text:    text,
+			width:   width,
+			isSpace: n == 1 && code == ' ',
+		})
+	}
+	return out
+}
+
+// pdfCMap is a parsed ToUnicode CMap.
+type pdfCMap struct {
+	codespaces []pdfCodespace
+	mapping    map[string]string
+}
+
+type pdfCodespace struct {
+	lo, hi []byte
+}
+
+// codeLength returns the byte length of the code at the start of s.
+func (m *pdfCMap) codeLength(s []byte, composite bool) int {
+	for _, cs := range m.codespaces {
+		n := len(cs.lo)
+		if n == 0 || n > len(s) || len(cs.hi) != n {
+			continue
+		}
+		match := true
+		for i := 0; i < n; i++ {
+			if s[i] < cs.lo[i] || s[i] > cs.hi[i] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return n
+		}
+	}
+	if composite {
+		return 2
+	}
+	return 1
+}
+
+func parsePDFCMap(data []byte) *pdfCMap {
+	m := &pdfCMap{mapping: make(map[string]string)}
+	p := newPDFParser(data)
+
+	var operands []any
+	mode := ""
+	for {
+		obj, err := p.object()
+		if err != nil {
+			break
+		}
+		kw, ok := obj.(pdfKeyword)
+		if !ok {
+			if mode != "" {
+				operands = append(operands, obj)
+			}
+			continue
+		}
+
+		switch string(kw) {
+		case "begincodespacerange", "beginbfchar", "beginbfrange":
+			mode = string(kw)
+			operands = operands[:0]
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					m.codespaces = append(m.codespaces, pdfCodespace{lo: lo, hi: hi})
+				}
+			}
+			mode = ""
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok := operands[i].(pdfString)
+				if !ok {
+					continue
+				}
+				switch dst := operands[i+1].(type) {
+				case pdfString:
+					m.mapping[string(src)] = decodeUTF16BE(dst)
+				case pdfName:
+					if text, ok := glyphNameText(string(dst)); ok {
+						m.mapping[string(src)] = text
+					}
+				}
+			}
+			mode = ""
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if !ok1 || !ok2 || len(lo) != len(hi) || len(lo) == 0 {
+					continue
+				}
+				m.addRange(lo, hi, operands[i+2])
+			}
+			mode = ""
+		default:
+			operands = operands[:0]
+		}
+	}
+	return m
+}
+
+// addRange maps a bfrange; only the last byte of the code varies.
+func (m *pdfCMap) addRange(lo, hi pdfString, dst any) {
+	last := len(lo) - 1
+	start, end := int(lo[last]), int(hi[last])
+	if end < start {
+		return
+	}
+
+	for c := start; c <= end; c++ {
+		code := append(append([]byte{}, lo[:last]...), byte(c))
+		switch d := dst.(type) {
+		case pdfString:
+			if len(d) == 0 {
+				return
+			}
+			target := []rune(decodeUTF16BE(d))
+			if len(target) == 0 {
+				return
+			}
+			target[len(target)-1] += rune(c - start)
+			m.mapping[string(code)] = string(target)
+		case pdfArray:
+			if c-start < len(d) {
+				if s, ok := d[c-start].(pdfString); ok {
+					m.mapping[string(code)] = decodeUTF16BE(s)
+				}
+			}
+		}
+	}
+}
+
+func decodeUTF16BE(b []byte) string {
+	if len(b) == 1 {
+		return string(rune(b[0]))
+	}
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+	}
+	return string(utf16.Decode(units))
+}
+
+// winAnsiHigh lists the WinAnsiEncoding codes that differ from Latin-1.
+var winAnsiHigh = map[int]rune{
+	0x80: '€', 0x82: '‚', 0x83: 'ƒ', 0x84: '„', 0x85: '…', 0x86: '†', 0x87: '‡',
+	0x88: 'ˆ', 0x89: '‰', 0x8a: 'Š', 0x8b: '‹', 0x8c: 'Œ', 0x8e: 'Ž',
+	0x91: '‘', 0x92: '’', 0x93: '“', 0x94: '”', 0x95: '•', 0x96: '–', 0x97: '—',
+	0x98: '˜', 0x99: '™', 0x9a: 'š', 0x9b: '›', 0x9c: 'œ', 0x9e: 'ž', 0x9f: 'Ÿ',
+}
+
+// glyphNames covers the Adobe glyph names commonly used in /Differences
+// arrays beyond single letters.
+var glyphNames = map[string]string{
+	"space": " ", "exclam": "!", "quotedbl": "\"", "numbersign": "#", "dollar": "$",
+	"percent": "%", "ampersand": "&", "quotesingle": "'", "parenleft": "(", "parenright": ")",
+	"asterisk": "*", "plus": "+", "comma": ",", "hyphen": "-", "period": ".", "slash": "/",
+	"zero": "0", "one": "1", "two": "2", "three": "3", "four": "4", "five": "5",
+	"six": "6", "seven": "7", "eight": "8", "nine": "9", "colon": ":", "semicolon": ";",
+	"less": "<", "equal": "=", "greater": ">", "question": "?", "at": "@",
+	"bracketleft": "[", "backslash": "\\", "bracketright": "]", "asciicircum": "^",
+	"underscore": "_", "grave": "`", "braceleft": "{", "bar": "|", "braceright": "}",
+	"asciitilde": "~", "quoteleft": "‘", "quoteright": "’", "quotedblleft": "“",
+	"quotedblright": "”", "quotesinglbase": "‚", "quotedblbase": "„", "bullet": "•",
+	"endash": "–", "emdash": "—", "ellipsis": "…", "dagger": "†", "daggerdbl": "‡",
+	"trademark": "™", "copyright": "©", "registered": "®", "degree": "°", "section": "§",
+	"paragraph": "¶", "fi": "fi", "fl": "fl", "ff": "ff", "ffi": "ffi", "ffl": "ffl",
+	"minus": "−", "multiply": "×", "divide": "÷", "periodcentered": "·", "Euro": "€",
+	"nbspace": " ", "sfthyphen": "-",
+}
+
+// glyphNameText resolves an Adobe glyph name such as "A", "eacute" or "uni00E9".
+func glyphNameText(name string) (string, bool) {
+	if text, ok := glyphNames[name]; ok {
+		return text, true
+	}
+	if len(name) == 1 {
+		return name, true
+	}
+	if hex, ok := strings.CutPrefix(name, "uni"); ok && len(hex) >= 4 {
+		if v, err := strconv.ParseUint(hex[:4], 16, 32); err == nil {
+			return string(rune(v)), true
+		}
+	}
+	if hex, ok := strings.CutPrefix(name, "u"); ok && len(hex) >= 4 && len(hex) <= 6 {
+		if v, err := strconv.ParseUint(hex, 16, 32); err == nil {
+			return string(rune(v)), true
+		}
+	}
+	if r, ok := latinAccented[name]; ok {
+		return string(r), true
+	}
+	return "", false
+}
+
+// latinAccented maps accented Latin glyph names to their characters.
+var latinAccented = func() map[string]rune {
+	m := make(map[string]rune)
+	accents := map[string][]rune{
+		"acute":      {'Á', 'É', 'Í', 'Ó', 'Ú', 'á', 'é', 'í', 'ó', 'ú'},
+		"grave":      {'À', 'È', 'Ì', 'Ò', 'Ù', 'à', 'è', 'ì', 'ò', 'ù'},
+		"circumflex": {'Â', 'Ê', 'Î', 'Ô', 'Û', 'â', 'ê', 'î', 'ô', 'û'},
+		"dieresis":   {'Ä', 'Ë', 'Ï', 'Ö', 'Ü', 'ä', 'ë', 'ï', 'ö', 'ü'},
+	}
+	vowels := []string{"A", "E", "I", "O", "U", "a", "e", "i", "o", "u"}
+	for accent, runes := range accents {
+		for i, v := range vowels {
+			m[v+accent] = runes[i]
+		}
+	}
+	for name, r := range map[string]rune{
+		"Atilde": 'Ã', "Ntilde": 'Ñ', "Otilde": 'Õ', "atilde": 'ã', "ntilde": 'ñ', "otilde": 'õ',
+		"Ccedilla": 'Ç', "ccedilla": 'ç', "Aring": 'Å', "aring": 'å', "AE": 'Æ', "ae": 'æ',
+		"Oslash": 'Ø', "oslash": 'ø', "germandbls": 'ß', "OE": 'Œ', "oe": 'œ',
+		"ydieresis": 'ÿ', "yacute": 'ý', "Yacute": 'Ý', "dotlessi": 'ı',
+	} {
+		m[name] = r
+	}
+	return m
+}()
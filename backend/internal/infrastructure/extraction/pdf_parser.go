@@ -0,0 +1,596 @@
+package extraction
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// A minimal PDF object parser: enough of ISO 32000 to locate pages, fonts and
+// content streams in unencrypted files. Objects are found by scanning for
+// "N G obj" headers rather than trusting the cross-reference table, which
+// also recovers files with damaged xref sections.
+
+type pdfName string
+
+type pdfRef struct {
+	num, gen int
+}
+
+type pdfDict map[pdfName]any
+
+type pdfArray []any
+
+type pdfString []byte
+
+type pdfKeyword string
+
+type pdfStream struct {
+	dict pdfDict
+	raw  []byte
+}
+
+var errPDFEOF = errors.New("unexpected end of PDF data")
+
+// pdfLexer tokenizes PDF object and content stream syntax.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func isPDFWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPDFDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func (l *pdfLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if isPDFWhitespace(c) {
+			l.pos++
+			continue
+		}
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		return
+	}
+}
+
+// next returns the next token: a value, a pdfKeyword, or one of the
+// structural keywords "<<", ">>", "[", "]".
+func (l *pdfLexer) next() (any, error) {
+	l.skipSpace()
+	if l.pos >= len(l.data) {
+		return nil, errPDFEOF
+	}
+
+	c := l.data[l.pos]
+	switch {
+	case c == '/':
+		return l.readName(), nil
+	case c == '(':
+		return l.readLiteralString(), nil
+	case c == '<':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+			l.pos += 2
+			return pdfKeyword("<<"), nil
+		}
+		return l.readHexString(), nil
+	case c == '>':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '>' {
+			l.pos += 2
+			return pdfKeyword(">>"), nil
+		}
+		l.pos++
+		return pdfKeyword(">"), nil
+	case c == '[' || c == ']' || c == '{' || c == '}':
+		l.pos++
+		return pdfKeyword(string(c)), nil
+	case c == ')':
+		l.pos++
+		return pdfKeyword(")"), nil
+	}
+
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	word := string(l.data[start:l.pos])
+	if n, err := strconv.ParseFloat(word, 64); err == nil {
+		return n, nil
+	}
+	switch word {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	return pdfKeyword(word), nil
+}
+
+func (l *pdfLexer) readName() pdfName {
+	l.pos++ // '/'
+	var buf []byte
+	for l.pos < len(l.data) && !isPDFWhitespace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		c := l.data[l.pos]
+		if c == '#' && l.pos+2 < len(l.data) {
+			if b, err := hex.DecodeString(string(l.data[l.pos+1 : l.pos+3])); err == nil {
+				buf = append(buf, b[0])
+				l.pos += 3
+				continue
+			}
+		}
+		buf = append(buf, c)
+		l.pos++
+	}
+	return pdfName(buf)
+}
+
+func (l *pdfLexer) readLiteralString() pdfString {
+	l.pos++ // '('
+	var buf []byte
+	depth := 1
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return buf
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return buf
+			}
+			e := l.data[l.pos]
+			l.pos++
+			switch e {
+			case 'n':
+				buf = append(buf, '\n')
+			case 'r':
+				buf = append(buf, '\r')
+			case 't':
+				buf = append(buf, '\t')
+			case 'b':
+				buf = append(buf, '\b')
+			case 'f':
+				buf = append(buf, '\f')
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+				// line continuation
+			default:
+				if e >= '0' && e <= '7' {
+					v := int(e - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						v = v*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					buf = append(buf, byte(v))
+				} else {
+					buf = append(buf, e)
+				}
+			}
+			continue
+		}
+		buf = append(buf, c)
+	}
+	return buf
+}
+
+func (l *pdfLexer) readHexString() pdfString {
+	l.pos++ // '<'
+	var digits []byte
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		c := l.data[l.pos]
+		if (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F') {
+			digits = append(digits, c)
+		}
+		l.pos++
+	}
+	l.pos++ // '>'
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	out, _ := hex.DecodeString(string(digits))
+	return out
+}
+
+// pdfParser builds objects from lexer tokens.
+type pdfParser struct {
+	lex *pdfLexer
+}
+
+func newPDFParser(data []byte) *pdfParser {
+	return &pdfParser{lex: &pdfLexer{data: data}}
+}
+
+// object parses a single object, resolving "N G R" into pdfRef.
+func (p *pdfParser) object() (any, error) {
+	t, err := p.lex.next()
+	if err != nil {
+		return nil, err
+	}
+
+	switch v := t.(type) {
+	case float64:
+		// Possibly the start of an indirect reference; rewind if not
+		pos := p.lex.pos
+		t2, err2 := p.lex.next()
+		t3, err3 := p.lex.next()
+		if err2 == nil && err3 == nil {
+			if gen, ok := t2.(float64); ok {
+				if kw, ok := t3.(pdfKeyword); ok && kw == "R" {
+					return pdfRef{num: int(v), gen: int(gen)}, nil
+				}
+			}
+		}
+		p.lex.pos = pos
+		return v, nil
+	case pdfKeyword:
+		switch v {
+		case "<<":
+			return p.dict()
+		case "[":
+			return p.array()
+		}
+		return v, nil
+	}
+	return t, nil
+}
+
+func (p *pdfParser) dict() (pdfDict, error) {
+	d := pdfDict{}
+	for {
+		t, err := p.lex.next()
+		if err != nil {
+			return d, err
+		}
+		if kw, ok := t.(pdfKeyword); ok && kw == ">>" {
+			return d, nil
+		}
+		key, ok := t.(pdfName)
+		if !ok {
+			continue // tolerate junk between entries
+		}
+		val, err := p.object()
+		if err != nil {
+			return d, err
+		}
+		if kw, ok := val.(pdfKeyword); ok && kw == ">>" {
+			return d, nil
+		}
+		d[key] = val
+	}
+}
+
+func (p *pdfParser) array() (pdfArray, error) {
+	var a pdfArray
+	for {
+		t, err := p.object()
+		if err != nil {
+			return a, err
+		}
+		if kw, ok := t.(pdfKeyword); ok && kw == "]" {
+			return a, nil
+		}
+		a = append(a, t)
+	}
+}
+
+// pdfDocument holds every object in the file keyed by object number.
+type pdfDocument struct {
+	objects map[int]any
+	trailer pdfDict
+
+	// Bytes that may still be decoded from streams; once it runs out the
+	// document is rejected
+	remaining int
+	exhausted bool
+}
+
+var pdfObjHeaderRe = regexp.MustCompile(`(?m)(?:^|[\r\n\s])(\d+)\s+(\d+)\s+obj\b`)
+
+// parsePDF scans the file for indirect objects and expands object streams.
+func parsePDF(data []byte) (*pdfDocument, error) {
+	doc := &pdfDocument{objects: make(map[int]any), trailer: pdfDict{}, remaining: maxDocumentSize}
+
+	for _, m := range pdfObjHeaderRe.FindAllSubmatchIndex(data, -1) {
+		num, _ := strconv.Atoi(string(data[m[2]:m[3]]))
+		p := newPDFParser(data)
+		p.lex.pos = m[1]
+
+		obj, err := p.object()
+		if err != nil {
+			continue
+		}
+
+		// A dictionary followed by "stream" is a stream object
+		if d, ok := obj.(pdfDict); ok {
+			p.lex.skipSpace()
+			if bytes.HasPrefix(data[p.lex.pos:], []byte("stream")) {
+				raw := doc.streamData(data, p.lex.pos+len("stream"), d)
+				obj = &pdfStream{dict: d, raw: raw}
+			}
+			if typ, _ := d["Type"].(pdfName); typ == "XRef" {
+				mergeTrailer(doc.trailer, d)
+			}
+		}
+
+		// Later definitions (incremental updates) replace earlier ones
+		doc.objects[num] = obj
+	}
+
+	// Classic trailers
+	for _, idx := range regexp.MustCompile(`trailer\s*<<`).FindAllIndex(data, -1) {
+		p := newPDFParser(data)
+		p.lex.pos = idx[0] + len("trailer")
+		if obj, err := p.object(); err == nil {
+			if d, ok := obj.(pdfDict); ok {
+				mergeTrailer(doc.trailer, d)
+			}
+		}
+	}
+
+	if _, ok := doc.trailer["Encrypt"]; ok {
+		return nil, fmt.Errorf("encrypted PDFs are not supported")
+	}
+
+	// Expand compressed object streams
+	for _, obj := range doc.objects {
+		s, ok := obj.(*pdfStream)
+		if !ok {
+			continue
+		}
+		if typ, _ := s.dict["Type"].(pdfName); typ != "ObjStm" {
+			continue
+		}
+		doc.expandObjectStream(s)
+	}
+	if doc.exhausted {
+		return nil, errDocumentTooLarge
+	}
+
+	if len(doc.objects) == 0 {
+		return nil, fmt.Errorf("no PDF objects found")
+	}
+	return doc, nil
+}
+
+func mergeTrailer(dst, src pdfDict) {
+	for _, key := range []pdfName{"Root", "Encrypt", "Info"} {
+		if v, ok := src[key]; ok {
+			dst[key] = v
+		}
+	}
+}
+
+// streamData returns the raw bytes of a stream starting at pos (just after
+// the "stream" keyword), using /Length when it is a direct number and
+// falling back to searching for "endstream".
+func (doc *pdfDocument) streamData(data []byte, pos int, d pdfDict) []byte {
+	if pos < len(data) && data[pos] == '\r' {
+		pos++
+	}
+	if pos < len(data) && data[pos] == '\n' {
+		pos++
+	}
+
+	if length, ok := d["Length"].(float64); ok {
+		end := pos + int(length)
+		if end <= len(data) && end >= pos {
+			rest := bytes.TrimLeft(data[end:min(end+20, len(data))], "\r\n \t")
+			if bytes.HasPrefix(rest, []byte("endstream")) {
+				return data[pos:end]
+			}
+		}
+	}
+
+	end := bytes.Index(data[pos:], []byte("endstream"))
+	if end < 0 {
+		return data[pos:]
+	}
+	return bytes.TrimRight(data[pos:pos+end], "\r\n")
+}
+
+// expandObjectStream adds the objects compressed inside an ObjStm.
+func (doc *pdfDocument) expandObjectStream(s *pdfStream) {
+	data, err := doc.decodeStream(s)
+	if err != nil {
+		return
+	}
+	n, _ := doc.resolve(s.dict["N"]).(float64)
+	first, _ := doc.resolve(s.dict["First"]).(float64)
+	if first < 0 || first > float64(len(data)) {
+		return
+	}
+
+	header := newPDFParser(data[:int(first)])
+	for i := 0; i < int(n); i++ {
+		numTok, err1 := header.lex.next()
+		offTok, err2 := header.lex.next()
+		if err1 != nil || err2 != nil {
+			return
+		}
+		num, ok1 := numTok.(float64)
+		off, ok2 := offTok.(float64)
+		if !ok1 || !ok2 {
+			return
+		}
+		// Objects defined directly in the file take precedence over older compressed copies
+		if _, exists := doc.objects[int(num)]; exists {
+			continue
+		}
+		// Offsets are relative to /First and must point inside the stream
+		if off < 0 || first+off >= float64(len(data)) {
+			continue
+		}
+		p := newPDFParser(data)
+		p.lex.pos = int(first + off)
+		if obj, err := p.object(); err == nil {
+			doc.objects[int(num)] = obj
+		}
+	}
+}
+
+// resolve follows indirect references.
+func (doc *pdfDocument) resolve(v any) any {
+	for i := 0; i < 32; i++ {
+		ref, ok := v.(pdfRef)
+		if !ok {
+			return v
+		}
+		v = doc.objects[ref.num]
+	}
+	return nil
+}
+
+func (doc *pdfDocument) dict(v any) pdfDict {
+	switch d := doc.resolve(v).(type) {
+	case pdfDict:
+		return d
+	case *pdfStream:
+		return d.dict
+	}
+	return nil
+}
+
+func (doc *pdfDocument) array(v any) pdfArray {
+	a, _ := doc.resolve(v).(pdfArray)
+	return a
+}
+
+// decodeStream applies the stream's filters. Decoded bytes count against the
+// document's limit, since a small stream can be referenced by every page.
+func (doc *pdfDocument) decodeStream(s *pdfStream) ([]byte, error) {
+	if doc.exhausted {
+		return nil, errDocumentTooLarge
+	}
+	data := s.raw
+
+	var filters []pdfName
+	switch f := doc.resolve(s.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []pdfName{f}
+	case pdfArray:
+		for _, v := range f {
+			if name, ok := doc.resolve(v).(pdfName); ok {
+				filters = append(filters, name)
+			}
+		}
+	}
+
+	for _, f := range filters {
+		var err error
+		switch f {
+		case "FlateDecode", "Fl":
+			data, err = inflate(data)
+		case "ASCIIHexDecode", "AHx":
+			data = (&pdfLexer{data: append([]byte{'<'}, data...)}).readHexString()
+		case "ASCII85Decode", "A85":
+			data, err = decodeASCII85(data)
+		default:
+			return nil, fmt.Errorf("unsupported stream filter %s", f)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(data) > doc.remaining {
+		doc.exhausted = true
+		return nil, errDocumentTooLarge
+	}
+	doc.remaining -= len(data)
+
+	// PNG predictors only appear on image and xref data, which we do not read
+	return data, nil
+}
+
+// inflate decompresses zlib data, keeping whatever was decoded before any
+// corruption near the end of the stream.
+func inflate(data []byte) ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to inflate stream: %w", err)
+	}
+	defer r.Close()
+
+	out, err := io.ReadAll(io.LimitReader(r, maxPartSize))
+	if err != nil && len(out) == 0 {
+		return nil, fmt.Errorf("failed to inflate stream: %w", err)
+	}
+	return out, nil
+}
+
+func decodeASCII85(data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(bytes.TrimSpace(data), []byte("<~"))
+	if i := bytes.Index(data, []byte("~>")); i >= 0 {
+		data = data[:i]
+	}
+
+	var out []byte
+	var group [5]byte
+	n := 0
+	for _, c := range data {
+		if isPDFWhitespace(c) {
+			continue
+		}
+		if c == 'z' && n == 0 {
+			out = append(out, 0, 0, 0, 0)
+			continue
+		}
+		if c < '!' || c > 'u' {
+			return nil, fmt.Errorf("invalid ASCII85 data")
+		}
+		group[n] = c - '!'
+		n++
+		if n == 5 {
+			out = appendASCII85Group(out, group, 4)
+			n = 0
+		}
+	}
+	if n > 0 {
+		for i := n; i < 5; i++ {
+			group[i] = 'u' - '!'
+		}
+		out = appendASCII85Group(out, group, n-1)
+	}
+	return out, nil
+}
+
+func appendASCII85Group(out []byte, group [5]byte, count int) []byte {
+	var v uint32
+	for _, g := range group {
+		v = v*85 + uint32(g)
+	}
+	b := []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+	return append(out, b[:count]...)
+}
@@ -0,0 +1,174 @@
+package extraction
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+// objectStream builds an ObjStm holding objs, with the given /First and
+// per-object offsets (nil uses the real ones).
+func objectStream(t *testing.T, objs []string, first *int, offsets []int) *pdfStream {
+	t.Helper()
+	var header, body bytes.Buffer
+	for i, obj := range objs {
+		off := body.Len()
+		if offsets != nil {
+			off = offsets[i]
+		}
+		fmt.Fprintf(&header, "%d %d ", i+1, off)
+		body.WriteString(obj + "\n")
+	}
+	firstValue := header.Len()
+	if first != nil {
+		firstValue = *first
+	}
+
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, _ = w.Write(append(header.Bytes(), body.Bytes()...))
+	_ = w.Close()
+
+	return &pdfStream{
+		dict: pdfDict{"Type": pdfName("ObjStm"), "N": float64(len(objs)), "First": float64(firstValue), "Filter": pdfName("FlateDecode")},
+		raw:  compressed.Bytes(),
+	}
+}
+
+func TestExpandObjectStream(t *testing.T) {
+	objs := []string{"<< /A 1 >>", "(two)", "[3]"}
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name    string
+		first   *int
+		offsets []int
+		want    []int // Object numbers expected after expansion
+	}{
+		{name: "valid", want: []int{1, 2, 3}},
+		{name: "negative first", first: intPtr(-5)},
+		{name: "first past the end", first: intPtr(1 << 20)},
+		{name: "negative offsets", offsets: []int{-1, -1000, 11}, want: []int{3}},
+		{name: "offsets past the end", offsets: []int{0, 1 << 30, 1 << 62}, want: []int{1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &pdfDocument{objects: map[int]any{}, remaining: maxDocumentSize}
+			doc.expandObjectStream(objectStream(t, objs, tt.first, tt.offsets))
+
+			if len(doc.objects) != len(tt.want) {
+				t.Fatalf("expanded %d objects, want %v", len(doc.objects), tt.want)
+			}
+			for _, num := range tt.want {
+				if _, ok := doc.objects[num]; !ok {
+					t.Errorf("object %d missing", num)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeStreamDocumentLimit(t *testing.T) {
+	var compressed bytes.Buffer
+	w := zlib.NewWriter(&compressed)
+	_, _ = w.Write(bytes.Repeat([]byte("BT ET "), 1000))
+	_ = w.Close()
+	stream := &pdfStream{dict: pdfDict{"Filter": pdfName("FlateDecode")}, raw: compressed.Bytes()}
+
+	// Room for two decodes of the 6000 byte stream
+	doc := &pdfDocument{objects: map[int]any{}, remaining: 12500}
+	for i := 0; i < 2; i++ {
+		if _, err := doc.decodeStream(stream); err != nil {
+			t.Fatalf("decode %d: error = %v", i+1, err)
+		}
+	}
+	if _, err := doc.decodeStream(stream); !errors.Is(err, errDocumentTooLarge) {
+		t.Fatalf("third decode error = %v, want errDocumentTooLarge", err)
+	}
+	if !doc.exhausted {
+		t.Error("document not marked exhausted")
+	}
+	if _, err := doc.decodeStream(&pdfStream{dict: pdfDict{}, raw: []byte("BT ET")}); !errors.Is(err, errDocumentTooLarge) {
+		t.Errorf("decode after exhaustion error = %v, want errDocumentTooLarge", err)
+	}
+}
+
+func TestPDFLexer(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  any
+	}{
+		{"name with escape", "/A#20B", pdfName("A B")},
+		{"literal string escapes", `(a\(b\)\n\101)`, pdfString("a(b)\nA")},
+		{"nested parentheses", "(a (b) c)", pdfString("a (b) c")},
+		{"unterminated string", "(abc", pdfString("abc")},
+		{"hex string odd length", "<48656C6C6F2>", pdfString("Hello ")},
+		{"unterminated hex string", "<4865", pdfString("He")},
+		{"number", "-12.5", -12.5},
+		{"keyword", "Tj", pdfKeyword("Tj")},
+		{"comment skipped", "% comment\n true", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := (&pdfLexer{data: []byte(tt.input)}).next()
+			if err != nil {
+				t.Fatalf("next() error = %v", err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("next() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPDFParserObject(t *testing.T) {
+	p := newPDFParser([]byte("<< /Kids [1 0 R 2 0 R] /Count 2 /Size 3 0 /Junk >> 4"))
+	obj, err := p.object()
+	if err != nil {
+		t.Fatalf("object() error = %v", err)
+	}
+	d, ok := obj.(pdfDict)
+	if !ok {
+		t.Fatalf("object() = %T, want pdfDict", obj)
+	}
+	kids, _ := d["Kids"].(pdfArray)
+	if len(kids) != 2 || kids[0] != (pdfRef{num: 1}) || kids[1] != (pdfRef{num: 2}) {
+		t.Errorf("Kids = %v, want two references", kids)
+	}
+	if d["Count"] != 2.0 {
+		t.Errorf("Count = %v, want 2", d["Count"])
+	}
+
+	// Truncated dictionaries and arrays end with an error rather than looping
+	for _, input := range []string{"<< /A [1 2", "[1 2 <<", "<< /A"} {
+		if _, err := newPDFParser([]byte(input)).object(); err == nil {
+			t.Errorf("object(%q) succeeded, want an error", input)
+		}
+	}
+}
+
+func TestDecodeASCII85(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"<~87cURD]i,\"Ebo80~>", "Hello World!", false},
+		{"z", "\x00\x00\x00\x00", false},
+		{"87cUR", "Hell", false},
+		{"87c\x7fUR", "", true},
+	}
+	for _, tt := range tests {
+		got, err := decodeASCII85([]byte(tt.input))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("decodeASCII85(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && string(got) != tt.want {
+			t.Errorf("decodeASCII85(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
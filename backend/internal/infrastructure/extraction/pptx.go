@@ -0,0 +1,251 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// PPTXExtractor extracts text from PowerPoint presentations. Each slide
+// becomes a page headed by its title, followed by the slide body and any
+// speaker notes.
+type PPTXExtractor struct{}
+
+// NewPPTXExtractor creates a new PPTXExtractor.
+func NewPPTXExtractor() *PPTXExtractor {
+	return &PPTXExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *PPTXExtractor) MIMETypes() []string {
+	return []string{MIMETypePPTX}
+}
+
+const relTypeNotesSlide = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/notesSlide"
+
+// Extract reads slides in presentation order.
+func (e *PPTXExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	pkg, err := openOOXML(content)
+	if err != nil {
+		return nil, err
+	}
+
+	slides, err := pptxSlideOrder(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	var b sectionBuilder
+	for i, slidePart := range slides {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		data, err := pkg.read(slidePart)
+		if err != nil {
+			return nil, err
+		}
+		slide, err := parseSlideXML(data, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse slide %d: %w", i+1, err)
+		}
+
+		b.page(int32(i + 1))
+		if slide.title != "" {
+			b.heading(slide.title, 2)
+		}
+		for _, p := range slide.paragraphs {
+			b.paragraph(p)
+		}
+
+		// Speaker notes often carry the explanation the slide only hints at
+		rels, err := pkg.relationships(slidePart)
+		if err != nil {
+			return nil, err
+		}
+		for _, rel := range rels {
+			if rel.Type != relTypeNotesSlide || !pkg.has(rel.Target) {
+				continue
+			}
+			notesData, err := pkg.read(rel.Target)
+			if err != nil {
+				return nil, err
+			}
+			notes, err := parseSlideXML(notesData, true)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse notes for slide %d: %w", i+1, err)
+			}
+			if len(notes.paragraphs) > 0 {
+				b.paragraph("Speaker notes: " + strings.Join(notes.paragraphs, "\n"))
+			}
+		}
+	}
+
+	return b.build(), nil
+}
+
+// pptxSlideOrder returns slide part names in the order listed in presentation.xml.
+func pptxSlideOrder(pkg *ooxmlPackage) ([]string, error) {
+	const presentationPart = "ppt/presentation.xml"
+
+	data, err := pkg.read(presentationPart)
+	if err != nil {
+		return nil, err
+	}
+
+	var presentation struct {
+		SlideIDs []struct {
+			Attrs []xml.Attr `xml:",any,attr"`
+		} `xml:"sldIdLst>sldId"`
+	}
+	if err := xml.Unmarshal(data, &presentation); err != nil {
+		return nil, fmt.Errorf("failed to parse presentation: %w", err)
+	}
+
+	rels, err := pkg.relationships(presentationPart)
+	if err != nil {
+		return nil, err
+	}
+
+	var slides []string
+	for _, s := range presentation.SlideIDs {
+		for _, a := range s.Attrs {
+			if a.Name.Local != "id" || a.Name.Space == "" {
+				continue // the unprefixed id attribute is the numeric slide ID
+			}
+			if rel, ok := rels[a.Value]; ok && pkg.has(rel.Target) {
+				slides = append(slides, rel.Target)
+			}
+		}
+	}
+	return slides, nil
+}
+
+type slideText struct {
+	title      string
+	paragraphs []string
+}
+
+// parseSlideXML collects the text of a slide's shapes and tables. For notes
+// slides only the body placeholder is read, skipping the slide thumbnail and
+// slide number placeholders.
+func parseSlideXML(data []byte, notes bool) (*slideText, error) {
+	out := &slideText{}
+
+	var (
+		shapeDepth  int
+		placeholder string
+		hasPh       bool
+		shapeParas  []string
+		para        strings.Builder
+		inPara      bool
+		inText      bool
+		tableDepth  int
+		row         *tableRow
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "sp":
+				shapeDepth++
+				if shapeDepth == 1 {
+					placeholder, hasPh = "", false
+					shapeParas = nil
+				}
+			case "ph":
+				hasPh = true
+				placeholder = attrValue(t, "type")
+			case "p":
+				if t.Name.Space != "" && strings.HasSuffix(t.Name.Space, "/drawingml/2006/main") {
+					inPara = true
+					para.Reset()
+				}
+			case "t":
+				inText = true
+			case "br":
+				para.WriteString("\n")
+			case "tbl":
+				tableDepth++
+			case "tr":
+				if tableDepth == 1 {
+					row = &tableRow{}
+				}
+			}
+
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				if !inPara {
+					continue
+				}
+				inPara = false
+				text := strings.TrimSpace(para.String())
+				switch {
+				case tableDepth > 0 && row != nil:
+					if row.cell.Len() > 0 {
+						row.cell.WriteString(" ")
+					}
+					row.cell.WriteString(text)
+				case shapeDepth > 0 && text != "":
+					shapeParas = append(shapeParas, text)
+				}
+			case "tc":
+				if tableDepth == 1 && row != nil {
+					row.endCell()
+				}
+			case "tr":
+				if tableDepth == 1 && row != nil {
+					if line := row.String(); line != "" && !notes {
+						out.paragraphs = append(out.paragraphs, line)
+					}
+					row = nil
+				}
+			case "tbl":
+				tableDepth--
+			case "sp":
+				shapeDepth--
+				if shapeDepth > 0 {
+					continue
+				}
+				isTitle := hasPh && (placeholder == "title" || placeholder == "ctrTitle")
+				switch {
+				case notes:
+					if hasPh && placeholder == "body" {
+						out.paragraphs = append(out.paragraphs, shapeParas...)
+					}
+				case isTitle && out.title == "":
+					out.title = collapseSpace(strings.Join(shapeParas, " "))
+				case placeholder == "sldNum" || placeholder == "dt" || placeholder == "ftr":
+					// Slide number, date and footer placeholders repeat on every slide
+				default:
+					out.paragraphs = append(out.paragraphs, shapeParas...)
+				}
+			}
+
+		case xml.CharData:
+			if inText && inPara {
+				para.Write(t)
+			}
+		}
+	}
+
+	return out, nil
+}
@@ -0,0 +1,183 @@
+package extraction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// MIME types handled by the built-in extractors.
+const (
+	MIMETypePDF      = "application/pdf"
+	MIMETypeDOCX     = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	MIMETypePPTX     = "application/vnd.openxmlformats-officedocument.presentationml.presentation"
+	MIMETypeXLSX     = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	MIMETypeHTML     = "text/html"
+	MIMETypeMarkdown = "text/markdown"
+	MIMETypePlain    = "text/plain"
+)
+
+// Registry implements service.DocumentExtractor by dispatching to the
+// TextExtractor registered for the detected MIME type.
+type Registry struct {
+	extractors map[string]service.TextExtractor
+}
+
+// NewRegistry creates a registry with the given extractors.
+func NewRegistry(extractors ...service.TextExtractor) *Registry {
+	r := &Registry{extractors: make(map[string]service.TextExtractor)}
+	for _, e := range extractors {
+		r.Register(e)
+	}
+	return r
+}
+
+// NewDefaultRegistry creates a registry with all built-in extractors.
+func NewDefaultRegistry() *Registry {
+	return NewRegistry(
+		NewPDFExtractor(),
+		NewDOCXExtractor(),
+		NewPPTXExtractor(),
+		NewXLSXExtractor(),
+		NewHTMLExtractor(),
+		NewMarkdownExtractor(),
+		NewPlainTextExtractor(),
+	)
+}
+
+// Register adds an extractor, replacing any existing extractor for its MIME types.
+func (r *Registry) Register(e service.TextExtractor) {
+	for _, mimeType := range e.MIMETypes() {
+		r.extractors[mimeType] = e
+	}
+}
+
+// Extract detects the file format and extracts its text.
+func (r *Registry) Extract(ctx context.Context, fileName string, content []byte) (*service.ExtractedDocument, error) {
+	mimeType := DetectMIMEType(fileName, content)
+
+	extractor, ok := r.extractors[mimeType]
+	if !ok {
+		return nil, domainerrors.ErrUnsupportedDocumentFormat.WithMessage(
+			fmt.Sprintf("no text extractor for %s (%s)", fileName, mimeType))
+	}
+
+	sections, err := extractor.Extract(ctx, content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract %s: %w", mimeType, err)
+	}
+
+	doc := &service.ExtractedDocument{
+		MIMEType: mimeType,
+		Sections: sections,
+		Text:     Render(sections),
+	}
+	if strings.TrimSpace(doc.Text) == "" {
+		return nil, fmt.Errorf("no text found in %s", fileName)
+	}
+
+	return doc, nil
+}
+
+// Render joins sections into a single text, writing headings as Markdown
+// and a [Page N] marker whenever the page changes. Sections with a heading
+// but no level continue an earlier section, so the heading is not repeated.
+func Render(sections []service.DocumentSection) string {
+	var sb strings.Builder
+	var page int32
+
+	for _, section := range sections {
+		text := strings.TrimSpace(section.Text)
+		if text == "" && section.Heading == "" {
+			continue
+		}
+
+		if section.Page > 0 && section.Page != page {
+			page = section.Page
+			fmt.Fprintf(&sb, "[Page %d]\n\n", page)
+		}
+
+		if section.Heading != "" && section.Level > 0 {
+			level := section.Level
+			if level > 6 {
+				level = 6
+			}
+			sb.WriteString(strings.Repeat("#", level))
+			sb.WriteString(" ")
+			sb.WriteString(section.Heading)
+			sb.WriteString("\n\n")
+		}
+
+		if text != "" {
+			sb.WriteString(text)
+			sb.WriteString("\n\n")
+		}
+	}
+
+	return strings.TrimSpace(sb.String())
+}
+
+// sectionBuilder accumulates paragraphs into sections, starting a new section
+// at each heading or page change.
+type sectionBuilder struct {
+	sections []service.DocumentSection
+	current  service.DocumentSection
+	text     strings.Builder
+}
+
+// heading starts a new section with the given heading.
+func (b *sectionBuilder) heading(text string, level int) {
+	text = collapseSpace(text)
+	if text == "" {
+		return
+	}
+	b.flush()
+	b.current.Heading = text
+	b.current.Level = level
+}
+
+// page starts a new section on the given page, keeping the current heading.
+func (b *sectionBuilder) page(page int32) {
+	if page == b.current.Page {
+		return
+	}
+	b.flush()
+	b.current.Page = page
+}
+
+// paragraph appends a paragraph to the current section.
+func (b *sectionBuilder) paragraph(text string) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return
+	}
+	if b.text.Len() > 0 {
+		b.text.WriteString("\n\n")
+	}
+	b.text.WriteString(text)
+}
+
+// flush closes the current section. Continuation sections keep the heading
+// but not the level, so the heading is only rendered once.
+func (b *sectionBuilder) flush() {
+	if b.text.Len() > 0 || (b.current.Heading != "" && b.current.Level > 0) {
+		b.current.Text = b.text.String()
+		b.sections = append(b.sections, b.current)
+	}
+	b.text.Reset()
+	b.current.Level = 0
+}
+
+// build returns the accumulated sections.
+func (b *sectionBuilder) build() []service.DocumentSection {
+	b.flush()
+	return b.sections
+}
+
+// collapseSpace trims text and collapses internal whitespace runs to single spaces.
+func collapseSpace(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,180 @@
+package extraction
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return data
+}
+
+func TestRegistryExtract(t *testing.T) {
+	tests := []struct {
+		file     string
+		mimeType string
+		want     []string // Lines expected in the rendered text, in order
+		notWant  []string
+	}{
+		{
+			file:     "simple.pdf",
+			mimeType: MIMETypePDF,
+			want: []string{
+				"[Page 1]", "# Water Chemistry", "Keep the pH between 7.2 and 7.8. Test the water twice a week.",
+				"[Page 2]", "# Filters", "Rinse the filter cartridge monthly.",
+			},
+		},
+		{
+			file:     "objstm.pdf",
+			mimeType: MIMETypePDF,
+			want:     []string{"[Page 1]", "# Water Chemistry", "[Page 2]", "# Filters", "Rinse the filter cartridge monthly."},
+		},
+		{
+			file:     "truncated.pdf",
+			mimeType: MIMETypePDF,
+			want:     []string{"[Page 1]", "# Water Chemistry", "Keep the pH between 7.2 and 7.8. Test the water twice a week."},
+			notWant:  []string{"Filters"},
+		},
+		{
+			file:     "corrupt-stream.pdf",
+			mimeType: MIMETypePDF,
+			want:     []string{"# Water Chemistry", "Keep the pH between 7.2 and 7.8."},
+		},
+		{
+			file:     "sample.docx",
+			mimeType: MIMETypeDOCX,
+			want: []string{
+				"[Page 1]", "# Water Chemistry", "Keep the pH between 7.2 and 7.8.", "- Test twice a week",
+				"Reading | Target", "pH | 7.4", "[Page 2]", "## Filters", "Rinse the filter cartridge monthly.",
+			},
+		},
+		{
+			file:     "sample.pptx",
+			mimeType: MIMETypePPTX,
+			want: []string{
+				"[Page 1]", "## Water Chemistry", "Keep the pH between 7.2 and 7.8.", "Speaker notes: Mention test strips.",
+				"[Page 2]", "## Filters", "Rinse monthly.",
+			},
+			notWant: []string{"\n1\n"},
+		},
+		{
+			file:     "sample.xlsx",
+			mimeType: MIMETypeXLSX,
+			want:     []string{"## Readings", "Reading | Target", "pH |  | 7.4", "TRUE | far"},
+			notWant:  []string{"hidden value", "#DIV/0!"},
+		},
+		{
+			file:     "sample.html",
+			mimeType: MIMETypeHTML,
+			want:     []string{"# Water Chemistry", "Keep the pH between 7.2 and 7.8.", "- Test twice a week", "## Filters"},
+			notWant:  []string{"Home", "track()", "Copyright", "color: red"},
+		},
+		{
+			file:     "sample.md",
+			mimeType: MIMETypeMarkdown,
+			want:     []string{"# Water Chemistry", "Keep the pH between 7.2 and 7.8.", "# not a heading", "## Filters"},
+		},
+	}
+
+	r := NewDefaultRegistry()
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			doc, err := r.Extract(context.Background(), tt.file, readFixture(t, tt.file))
+			if err != nil {
+				t.Fatalf("Extract() error = %v", err)
+			}
+			if doc.MIMEType != tt.mimeType {
+				t.Errorf("MIME type = %s, want %s", doc.MIMEType, tt.mimeType)
+			}
+
+			rest := doc.Text
+			for _, want := range tt.want {
+				i := strings.Index(rest, want)
+				if i < 0 {
+					t.Fatalf("text is missing %q after the previous lines:\n%s", want, doc.Text)
+				}
+				rest = rest[i+len(want):]
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(doc.Text, notWant) {
+					t.Errorf("text contains %q:\n%s", notWant, doc.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestRegistryExtractRejectsMalformed(t *testing.T) {
+	tests := []struct {
+		file string
+		want string // Part of the expected error
+	}{
+		{"encrypted.pdf", "encrypted"},
+		{"objstm-negative-first.pdf", "no pages"},
+		{"objstm-bad-offsets.pdf", "no pages"},
+		{"malformed.docx", "failed to parse document"},
+		{"missing-document.docx", "word/document.xml not found"},
+		{"truncated.docx", "no text extractor"},
+	}
+
+	r := NewDefaultRegistry()
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			_, err := r.Extract(context.Background(), tt.file, readFixture(t, tt.file))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Extract() error = %v, want one containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+// TestExtractorsSurviveTruncation feeds every prefix length of the fixtures
+// to their extractor. Errors are expected; panics and hangs are not.
+func TestExtractorsSurviveTruncation(t *testing.T) {
+	tests := []struct {
+		file      string
+		extractor service.TextExtractor
+	}{
+		{"simple.pdf", NewPDFExtractor()},
+		{"objstm.pdf", NewPDFExtractor()},
+		{"objstm-bad-offsets.pdf", NewPDFExtractor()},
+		{"sample.docx", NewDOCXExtractor()},
+		{"sample.pptx", NewPPTXExtractor()},
+		{"sample.xlsx", NewXLSXExtractor()},
+		{"sample.html", NewHTMLExtractor()},
+		{"sample.md", NewMarkdownExtractor()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			data := readFixture(t, tt.file)
+			for n := 0; n < len(data); n++ {
+				_, _ = tt.extractor.Extract(context.Background(), data[:n])
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	sections := []service.DocumentSection{
+		{Text: "Preface"},
+		{Heading: "Intro", Level: 1, Page: 1, Text: "First page."},
+		{Heading: "Intro", Page: 2, Text: "Continued."},
+		{Heading: "Deep", Level: 9, Page: 2},
+		{Page: 3, Text: "   "},
+	}
+	want := "Preface\n\n[Page 1]\n\n# Intro\n\nFirst page.\n\n[Page 2]\n\nContinued.\n\n###### Deep"
+	if got := Render(sections); got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,122 @@
+package extraction
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// PlainTextExtractor extracts plain text, splitting it into paragraphs.
+type PlainTextExtractor struct{}
+
+// NewPlainTextExtractor creates a new PlainTextExtractor.
+func NewPlainTextExtractor() *PlainTextExtractor {
+	return &PlainTextExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *PlainTextExtractor) MIMETypes() []string {
+	return []string{MIMETypePlain}
+}
+
+// Extract returns the text as a single section.
+func (e *PlainTextExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	text := strings.TrimSpace(normalizeNewlines(string(bytes.TrimPrefix(content, utf8BOM))))
+	if text == "" {
+		return nil, nil
+	}
+	return []service.DocumentSection{{Text: text}}, nil
+}
+
+// MarkdownExtractor extracts Markdown, using ATX and setext headings as section boundaries.
+type MarkdownExtractor struct{}
+
+// NewMarkdownExtractor creates a new MarkdownExtractor.
+func NewMarkdownExtractor() *MarkdownExtractor {
+	return &MarkdownExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *MarkdownExtractor) MIMETypes() []string {
+	return []string{MIMETypeMarkdown, "text/x-markdown"}
+}
+
+var (
+	atxHeadingRe    = regexp.MustCompile(`^ {0,3}(#{1,6})[ \t]+(.*?)(?:[ \t]+#+)?[ \t]*$`)
+	setextHeadingRe = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	fenceRe         = regexp.MustCompile("^ {0,3}(```|~~~)")
+)
+
+// Extract splits the document at headings. Fenced code blocks are kept
+// verbatim so that lines starting with # inside them are not treated as headings.
+func (e *MarkdownExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	var b sectionBuilder
+	var paragraph []string
+	var fence string
+
+	flushParagraph := func() {
+		b.paragraph(strings.Join(paragraph, "\n"))
+		paragraph = paragraph[:0]
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(bytes.TrimPrefix(content, utf8BOM)))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		if m := fenceRe.FindStringSubmatch(line); m != nil {
+			switch fence {
+			case "":
+				fence = m[1]
+			case m[1]:
+				fence = ""
+			}
+			paragraph = append(paragraph, line)
+			continue
+		}
+		if fence != "" {
+			paragraph = append(paragraph, line)
+			continue
+		}
+
+		if m := atxHeadingRe.FindStringSubmatch(line); m != nil {
+			flushParagraph()
+			b.heading(m[2], len(m[1]))
+			continue
+		}
+
+		// A setext underline turns the preceding single-line paragraph into a heading
+		if m := setextHeadingRe.FindStringSubmatch(line); m != nil && len(paragraph) == 1 {
+			level := 2
+			if strings.HasPrefix(m[1], "=") {
+				level = 1
+			}
+			text := paragraph[0]
+			paragraph = paragraph[:0]
+			b.heading(text, level)
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			flushParagraph()
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	flushParagraph()
+
+	return b.build(), nil
+}
+
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+func normalizeNewlines(text string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+}
@@ -0,0 +1,258 @@
+package extraction
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// XLSXExtractor extracts text from Excel workbooks. Each worksheet becomes a
+// section headed by the sheet name, with one " | " separated line per row.
+type XLSXExtractor struct{}
+
+// NewXLSXExtractor creates a new XLSXExtractor.
+func NewXLSXExtractor() *XLSXExtractor {
+	return &XLSXExtractor{}
+}
+
+// MIMETypes returns the MIME types this extractor handles.
+func (e *XLSXExtractor) MIMETypes() []string {
+	return []string{MIMETypeXLSX}
+}
+
+// Extract reads worksheets in workbook order.
+func (e *XLSXExtractor) Extract(ctx context.Context, content []byte) ([]service.DocumentSection, error) {
+	pkg, err := openOOXML(content)
+	if err != nil {
+		return nil, err
+	}
+
+	const workbookPart = "xl/workbook.xml"
+	data, err := pkg.read(workbookPart)
+	if err != nil {
+		return nil, err
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			Name  string     `xml:"name,attr"`
+			State string     `xml:"state,attr"`
+			Attrs []xml.Attr `xml:",any,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	if err := xml.Unmarshal(data, &workbook); err != nil {
+		return nil, fmt.Errorf("failed to parse workbook: %w", err)
+	}
+
+	rels, err := pkg.relationships(workbookPart)
+	if err != nil {
+		return nil, err
+	}
+
+	var sharedStrings []string
+	for _, rel := range rels {
+		if strings.HasSuffix(rel.Type, "/sharedStrings") && pkg.has(rel.Target) {
+			ssData, err := pkg.read(rel.Target)
+			if err != nil {
+				return nil, err
+			}
+			if sharedStrings, err = parseSharedStrings(ssData); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var b sectionBuilder
+	for _, sheet := range workbook.Sheets {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if sheet.State == "hidden" || sheet.State == "veryHidden" {
+			continue
+		}
+
+		var part string
+		for _, a := range sheet.Attrs {
+			if a.Name.Local == "id" && a.Name.Space != "" {
+				if rel, ok := rels[a.Value]; ok {
+					part = rel.Target
+				}
+			}
+		}
+		if part == "" || !pkg.has(part) {
+			continue
+		}
+
+		sheetData, err := pkg.read(part)
+		if err != nil {
+			return nil, err
+		}
+		rows, err := parseWorksheet(sheetData, sharedStrings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sheet %q: %w", sheet.Name, err)
+		}
+		if len(rows) == 0 {
+			continue
+		}
+
+		b.heading(sheet.Name, 2)
+		b.paragraph(strings.Join(rows, "\n"))
+	}
+
+	return b.build(), nil
+}
+
+// parseSharedStrings returns the workbook's shared string table, ignoring
+// phonetic runs.
+func parseSharedStrings(data []byte) ([]string, error) {
+	var (
+		out        []string
+		current    strings.Builder
+		inText     bool
+		inPhonetic bool
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse shared strings: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				current.Reset()
+			case "t":
+				inText = true
+			case "rPh":
+				inPhonetic = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				out = append(out, current.String())
+			case "t":
+				inText = false
+			case "rPh":
+				inPhonetic = false
+			}
+		case xml.CharData:
+			if inText && !inPhonetic {
+				current.Write(t)
+			}
+		}
+	}
+	return out, nil
+}
+
+// parseWorksheet returns one line per non-empty row, placing each cell at its
+// column so that values stay aligned with their header cells.
+func parseWorksheet(data []byte, sharedStrings []string) ([]string, error) {
+	var (
+		rows     []string
+		row      []string
+		cellType string
+		col      int
+		value    strings.Builder
+		inValue  bool
+	)
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				row = row[:0]
+			case "c":
+				cellType = attrValue(t, "t")
+				col = columnIndex(attrValue(t, "r"), len(row))
+				value.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				text := strings.TrimSpace(cellValue(cellType, value.String(), sharedStrings))
+				if text == "" {
+					continue
+				}
+				for len(row) < col {
+					row = append(row, "")
+				}
+				row = append(row, collapseSpace(text))
+			case "row":
+				if len(row) > 0 {
+					rows = append(rows, strings.Join(row, " | "))
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				value.Write(t)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// cellValue resolves a raw cell value according to its type.
+func cellValue(cellType, raw string, sharedStrings []string) string {
+	switch cellType {
+	case "s":
+		i, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil || i < 0 || i >= len(sharedStrings) {
+			return ""
+		}
+		return sharedStrings[i]
+	case "b":
+		if strings.TrimSpace(raw) == "1" {
+			return "TRUE"
+		}
+		return "FALSE"
+	case "e":
+		return "" // Formula errors such as #DIV/0! carry no knowledge
+	default:
+		return raw
+	}
+}
+
+// columnIndex converts a cell reference such as "C7" to a zero-based column,
+// falling back to the next column when the reference is missing or invalid.
+func columnIndex(ref string, next int) int {
+	col := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		n++
+	}
+	// Sheets end at column XFD, so longer references are invalid
+	if n == 0 || n > 3 {
+		return next
+	}
+	return col - 1
+}
@@ -26,7 +26,7 @@ func NewTenantAISettingsRepository(db *sql.DB) repository.TenantAISettingsReposi
 func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID) (*entity.TenantAISettings, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TenantAISettings, error) {
 		query := `
-			SELECT id, tenant_id, provider, encrypted_api_key, total_tokens_used, monthly_token_limit, updated_at, updated_by_user_id
+			SELECT id, tenant_id, provider, encrypted_api_key, base_url, model, total_tokens_used, monthly_token_limit, updated_at, updated_by_user_id
 			FROM tenant_ai_settings
 			WHERE tenant_id = $1
 		`
@@ -37,6 +37,8 @@ func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID
 			&settings.TenantID,
 			&providerStr,
 			&settings.EncryptedAPIKey,
+			&settings.BaseURL,
+			&settings.Model,
 			&settings.TotalTokensUsed,
 			&settings.MonthlyTokenLimit,
 			&settings.UpdatedAt,
@@ -57,14 +59,16 @@ func (r *TenantAISettingsRepository) Get(ctx context.Context, tenantID uuid.UUID
 func (r *TenantAISettingsRepository) Create(ctx context.Context, settings *entity.TenantAISettings) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO tenant_ai_settings (tenant_id, provider, encrypted_api_key, monthly_token_limit, updated_by_user_id)
-			VALUES ($1, $2, $3, $4, $5)
+			INSERT INTO tenant_ai_settings (tenant_id, provider, encrypted_api_key, base_url, model, monthly_token_limit, updated_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
 			RETURNING id, total_tokens_used, updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
 			settings.TenantID,
 			settings.Provider.String(),
 			settings.EncryptedAPIKey,
+			settings.BaseURL,
+			settings.Model,
 			settings.MonthlyTokenLimit,
 			settings.UpdatedByUserID,
 		).Scan(&settings.ID, &settings.TotalTokensUsed, &settings.UpdatedAt)
@@ -76,13 +80,15 @@ func (r *TenantAISettingsRepository) Update(ctx context.Context, settings *entit
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE tenant_ai_settings
-			SET provider = $1, encrypted_api_key = $2, monthly_token_limit = $3, updated_at = NOW(), updated_by_user_id = $4
-			WHERE tenant_id = $5
+			SET provider = $1, encrypted_api_key = $2, base_url = $3, model = $4, monthly_token_limit = $5, updated_at = NOW(), updated_by_user_id = $6
+			WHERE tenant_id = $7
 			RETURNING updated_at
 		`
 		return tx.QueryRowContext(ctx, query,
 			settings.Provider.String(),
 			settings.EncryptedAPIKey,
+			settings.BaseURL,
+			settings.Model,
 			settings.MonthlyTokenLimit,
 			settings.UpdatedByUserID,
 			settings.TenantID,
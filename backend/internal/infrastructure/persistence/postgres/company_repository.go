@@ -3,7 +3,9 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
@@ -11,6 +13,9 @@ import (
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
+// companyColumns is the column list scanned by scanCompany.
+const companyColumns = `id, tenant_id, name, industry, team_size, plan, stripe_customer_id, stripe_subscription_id, billing_email, subscription_status, seat_count, past_due_since, dunning_stage, dunning_reminders_sent, dunning_last_reminder_at, dunning_invoice_url, entitlement_overrides, created_at, updated_at`
+
 // CompanyRepository implements repository.CompanyRepository using PostgreSQL.
 type CompanyRepository struct {
 	db *sql.DB
@@ -50,35 +55,14 @@ func (r *CompanyRepository) Create(ctx context.Context, company *entity.Company)
 // GetByID retrieves a company by its ID.
 func (r *CompanyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Company, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Company, error) {
-		query := `
-			SELECT id, tenant_id, name, industry, team_size, plan, stripe_customer_id, stripe_subscription_id, subscription_status, seat_count, created_at, updated_at
-			FROM companies
-			WHERE id = $1
-		`
-		company := &entity.Company{}
-		var planStr, statusStr string
-		err := tx.QueryRowContext(ctx, query, id).Scan(
-			&company.ID,
-			&company.TenantID,
-			&company.Name,
-			&company.Industry,
-			&company.TeamSize,
-			&planStr,
-			&company.StripeCustomerID,
-			&company.StripeSubscriptionID,
-			&statusStr,
-			&company.SeatCount,
-			&company.CreatedAt,
-			&company.UpdatedAt,
-		)
+		query := `SELECT ` + companyColumns + ` FROM companies WHERE id = $1`
+		company, err := scanCompany(tx.QueryRowContext(ctx, query, id))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to get company: %w", err)
 		}
-		company.Plan = valueobject.Plan(planStr)
-		company.SubscriptionStatus = valueobject.SubscriptionStatus(statusStr)
 		return company, nil
 	})
 }
@@ -88,39 +72,61 @@ func (r *CompanyRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.
 // since the webhook doesn't have tenant context.
 func (r *CompanyRepository) GetByStripeCustomerID(ctx context.Context, stripeCustomerID string) (*entity.Company, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Company, error) {
-		query := `
-			SELECT id, tenant_id, name, industry, team_size, plan, stripe_customer_id, stripe_subscription_id, subscription_status, seat_count, created_at, updated_at
-			FROM companies
-			WHERE stripe_customer_id = $1
-		`
-		company := &entity.Company{}
-		var planStr, statusStr string
-		err := tx.QueryRowContext(ctx, query, stripeCustomerID).Scan(
-			&company.ID,
-			&company.TenantID,
-			&company.Name,
-			&company.Industry,
-			&company.TeamSize,
-			&planStr,
-			&company.StripeCustomerID,
-			&company.StripeSubscriptionID,
-			&statusStr,
-			&company.SeatCount,
-			&company.CreatedAt,
-			&company.UpdatedAt,
-		)
+		query := `SELECT ` + companyColumns + ` FROM companies WHERE stripe_customer_id = $1`
+		company, err := scanCompany(tx.QueryRowContext(ctx, query, stripeCustomerID))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to get company by stripe customer id: %w", err)
 		}
-		company.Plan = valueobject.Plan(planStr)
-		company.SubscriptionStatus = valueobject.SubscriptionStatus(statusStr)
 		return company, nil
 	})
 }
 
+// GetByTenantID retrieves the company of a tenant.
+func (r *CompanyRepository) GetByTenantID(ctx context.Context, tenantID uuid.UUID) (*entity.Company, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Company, error) {
+		query := `SELECT ` + companyColumns + ` FROM companies WHERE tenant_id = $1 ORDER BY created_at LIMIT 1`
+		company, err := scanCompany(tx.QueryRowContext(ctx, query, tenantID))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get company by tenant id: %w", err)
+		}
+		return company, nil
+	})
+}
+
+// ListInDunning lists companies with an overdue payment being followed up, oldest first.
+// Note: This method is called from the worker with superadmin context.
+func (r *CompanyRepository) ListInDunning(ctx context.Context) ([]*entity.Company, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.Company, error) {
+		query := `
+			SELECT ` + companyColumns + `
+			FROM companies
+			WHERE past_due_since IS NOT NULL
+			ORDER BY past_due_since
+		`
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list companies in dunning: %w", err)
+		}
+		defer rows.Close()
+
+		var companies []*entity.Company
+		for rows.Next() {
+			company, err := scanCompany(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan company: %w", err)
+			}
+			companies = append(companies, company)
+		}
+		return companies, rows.Err()
+	})
+}
+
 // Update updates a company.
 func (r *CompanyRepository) Update(ctx context.Context, company *entity.Company) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -136,12 +142,20 @@ func (r *CompanyRepository) Update(ctx context.Context, company *entity.Company)
 }
 
 // UpdateStripeFields updates only Stripe-related fields.
+// past_due_since keeps the start of a past_due streak; it and the dunning state are
+// cleared once the status changes.
 // Note: This method is called from Stripe webhooks with superadmin context.
 func (r *CompanyRepository) UpdateStripeFields(ctx context.Context, id uuid.UUID, fields entity.StripeFields) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE companies
-			SET stripe_customer_id = $1, stripe_subscription_id = $2, subscription_status = $3, plan = $4, seat_count = $5, updated_at = NOW()
+			SET stripe_customer_id = $1, stripe_subscription_id = $2, subscription_status = $3, plan = $4, seat_count = $5,
+				past_due_since = CASE WHEN $3 = 'past_due' THEN COALESCE(past_due_since, NOW()) END,
+				dunning_stage = CASE WHEN $3 = 'past_due' THEN dunning_stage ELSE 'none' END,
+				dunning_reminders_sent = CASE WHEN $3 = 'past_due' THEN dunning_reminders_sent ELSE 0 END,
+				dunning_last_reminder_at = CASE WHEN $3 = 'past_due' THEN dunning_last_reminder_at END,
+				dunning_invoice_url = CASE WHEN $3 = 'past_due' THEN dunning_invoice_url END,
+				updated_at = NOW()
 			WHERE id = $6
 		`
 		result, err := tx.ExecContext(ctx, query, fields.CustomerID, fields.SubscriptionID, fields.Status.String(), fields.Plan.String(), fields.SeatCount, id)
@@ -159,6 +173,101 @@ func (r *CompanyRepository) UpdateStripeFields(ctx context.Context, id uuid.UUID
 	})
 }
 
+// UpdateDunning updates only the dunning fields.
+// Note: This method is called from Stripe webhooks and the worker with superadmin context.
+func (r *CompanyRepository) UpdateDunning(ctx context.Context, id uuid.UUID, fields entity.DunningFields) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE companies
+			SET past_due_since = $1, dunning_stage = $2, dunning_reminders_sent = $3,
+				dunning_last_reminder_at = $4, dunning_invoice_url = $5, updated_at = NOW()
+			WHERE id = $6
+		`
+		result, err := tx.ExecContext(ctx, query, fields.PastDueSince, fields.Stage.String(), fields.RemindersSent, fields.LastReminderAt, fields.InvoiceURL, id)
+		if err != nil {
+			return fmt.Errorf("failed to update dunning fields: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("company not found")
+		}
+		return nil
+	})
+}
+
+// ClaimSubscriptionEvent records the created time of the subscription event being applied.
+// Stripe timestamps have second precision, so events created in the same second all pass.
+// Note: This method is called from Stripe webhooks with superadmin context.
+func (r *CompanyRepository) ClaimSubscriptionEvent(ctx context.Context, id uuid.UUID, createdAt time.Time) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			UPDATE companies
+			SET stripe_subscription_event_at = $1
+			WHERE id = $2 AND (stripe_subscription_event_at IS NULL OR stripe_subscription_event_at <= $1)
+		`
+		result, err := tx.ExecContext(ctx, query, createdAt, id)
+		if err != nil {
+			return false, fmt.Errorf("failed to claim subscription event: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return false, fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		return rows > 0, nil
+	})
+}
+
+// UpdateBillingEmail updates the billing email of the Stripe customer.
+// Note: This method is called from Stripe webhooks with superadmin context.
+func (r *CompanyRepository) UpdateBillingEmail(ctx context.Context, id uuid.UUID, email *string) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE companies SET billing_email = $1, updated_at = NOW() WHERE id = $2`
+		result, err := tx.ExecContext(ctx, query, email, id)
+		if err != nil {
+			return fmt.Errorf("failed to update billing email: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("company not found")
+		}
+		return nil
+	})
+}
+
+// UpdateEntitlementOverrides updates the company's enterprise entitlement overrides.
+// Nil overrides clear them.
+func (r *CompanyRepository) UpdateEntitlementOverrides(ctx context.Context, id uuid.UUID, overrides *entity.EntitlementOverrides) error {
+	var overridesJSON []byte
+	if overrides != nil {
+		var err error
+		if overridesJSON, err = json.Marshal(overrides); err != nil {
+			return fmt.Errorf("failed to marshal entitlement overrides: %w", err)
+		}
+	}
+
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE companies SET entitlement_overrides = $1, updated_at = NOW() WHERE id = $2`
+		result, err := tx.ExecContext(ctx, query, overridesJSON, id)
+		if err != nil {
+			return fmt.Errorf("failed to update entitlement overrides: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get affected rows: %w", err)
+		}
+		if rows == 0 {
+			return fmt.Errorf("company not found")
+		}
+		return nil
+	})
+}
+
 // CountUsersByCompanyID counts the number of users in a company.
 func (r *CompanyRepository) CountUsersByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
@@ -190,3 +299,44 @@ func (r *CompanyRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return nil
 	})
 }
+
+// scanCompany scans a company using the companyColumns order.
+func scanCompany(row rowScanner) (*entity.Company, error) {
+	company := &entity.Company{}
+	var planStr, statusStr, dunningStageStr string
+	var overridesJSON []byte
+	err := row.Scan(
+		&company.ID,
+		&company.TenantID,
+		&company.Name,
+		&company.Industry,
+		&company.TeamSize,
+		&planStr,
+		&company.StripeCustomerID,
+		&company.StripeSubscriptionID,
+		&company.BillingEmail,
+		&statusStr,
+		&company.SeatCount,
+		&company.PastDueSince,
+		&dunningStageStr,
+		&company.DunningRemindersSent,
+		&company.DunningLastReminderAt,
+		&company.DunningInvoiceURL,
+		&overridesJSON,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	company.Plan = valueobject.Plan(planStr)
+	company.SubscriptionStatus = valueobject.SubscriptionStatus(statusStr)
+	company.DunningStage = valueobject.DunningStage(dunningStageStr)
+	if overridesJSON != nil {
+		company.EntitlementOverrides = &entity.EntitlementOverrides{}
+		if err := json.Unmarshal(overridesJSON, company.EntitlementOverrides); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal entitlement overrides: %w", err)
+		}
+	}
+	return company, nil
+}
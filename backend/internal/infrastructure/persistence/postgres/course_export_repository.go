@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const courseExportColumns = `id, tenant_id, course_id, version, format, status, storage_path, file_name, size_bytes, error_message, requested_by_user_id, created_at, started_at, completed_at`
+
+// CourseExportRepository implements repository.CourseExportRepository using PostgreSQL.
+type CourseExportRepository struct {
+	db *sql.DB
+}
+
+// NewCourseExportRepository creates a new PostgreSQL course export repository.
+func NewCourseExportRepository(db *sql.DB) repository.CourseExportRepository {
+	return &CourseExportRepository{db: db}
+}
+
+// Create creates a new export record.
+func (r *CourseExportRepository) Create(ctx context.Context, export *entity.CourseExport) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO course_exports (tenant_id, course_id, version, format, status, requested_by_user_id)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			export.TenantID,
+			export.CourseID,
+			export.Version,
+			export.Format.String(),
+			export.Status.String(),
+			export.RequestedByUserID,
+		).Scan(&export.ID, &export.CreatedAt)
+	})
+}
+
+// GetByID retrieves an export by its ID.
+func (r *CourseExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.CourseExport, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseExport, error) {
+		query := `SELECT ` + courseExportColumns + ` FROM course_exports WHERE id = $1`
+		export, err := scanCourseExport(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get course export: %w", err)
+		}
+		return export, nil
+	})
+}
+
+// ListByCourseID retrieves all exports for a course, newest first.
+func (r *CourseExportRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.CourseExport, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseExport, error) {
+		query := `SELECT ` + courseExportColumns + ` FROM course_exports WHERE course_id = $1 ORDER BY created_at DESC`
+		rows, err := tx.QueryContext(ctx, query, courseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list course exports: %w", err)
+		}
+		defer rows.Close()
+
+		var exports []*entity.CourseExport
+		for rows.Next() {
+			export, err := scanCourseExport(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan course export: %w", err)
+			}
+			exports = append(exports, export)
+		}
+		return exports, rows.Err()
+	})
+}
+
+// Update updates an export record.
+func (r *CourseExportRepository) Update(ctx context.Context, export *entity.CourseExport) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE course_exports
+			SET status = $1, storage_path = $2, file_name = $3, size_bytes = $4, error_message = $5, started_at = $6, completed_at = $7
+			WHERE id = $8
+		`
+		_, err := tx.ExecContext(ctx, query,
+			export.Status.String(),
+			export.StoragePath,
+			export.FileName,
+			export.SizeBytes,
+			export.ErrorMessage,
+			export.StartedAt,
+			export.CompletedAt,
+			export.ID,
+		)
+		return err
+	})
+}
+
+// ClaimByID atomically transitions a pending export to processing. An export
+// left processing for longer than staleAfter is taken over.
+// Returns nil if the export doesn't exist or has already been claimed.
+func (r *CourseExportRepository) ClaimByID(ctx context.Context, id uuid.UUID, staleAfter time.Duration) (*entity.CourseExport, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.CourseExport, error) {
+		query := `
+			UPDATE course_exports
+			SET status = 'processing', started_at = NOW()
+			WHERE id = $1
+			  AND (status = 'pending'
+			    OR (status = 'processing' AND started_at < NOW() - make_interval(secs => $2)))
+			RETURNING ` + courseExportColumns
+		export, err := scanCourseExport(tx.QueryRowContext(ctx, query, id, staleAfter.Seconds()))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim course export: %w", err)
+		}
+		return export, nil
+	})
+}
+
+// ListStale retrieves exports left processing for longer than staleAfter.
+// Uses RLS with superadmin context to access exports across all tenants.
+func (r *CourseExportRepository) ListStale(ctx context.Context, staleAfter time.Duration) ([]*entity.CourseExport, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.CourseExport, error) {
+		query := `
+			SELECT ` + courseExportColumns + ` FROM course_exports
+			WHERE status = 'processing' AND started_at < NOW() - make_interval(secs => $1)
+			ORDER BY started_at`
+		rows, err := tx.QueryContext(ctx, query, staleAfter.Seconds())
+		if err != nil {
+			return nil, fmt.Errorf("failed to list stale course exports: %w", err)
+		}
+		defer rows.Close()
+
+		var exports []*entity.CourseExport
+		for rows.Next() {
+			export, err := scanCourseExport(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan course export: %w", err)
+			}
+			exports = append(exports, export)
+		}
+		return exports, rows.Err()
+	})
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanCourseExport scans a course export using the courseExportColumns order.
+func scanCourseExport(row rowScanner) (*entity.CourseExport, error) {
+	export := &entity.CourseExport{}
+	var formatStr, statusStr string
+	err := row.Scan(
+		&export.ID,
+		&export.TenantID,
+		&export.CourseID,
+		&export.Version,
+		&formatStr,
+		&statusStr,
+		&export.StoragePath,
+		&export.FileName,
+		&export.SizeBytes,
+		&export.ErrorMessage,
+		&export.RequestedByUserID,
+		&export.CreatedAt,
+		&export.StartedAt,
+		&export.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	export.Format = valueobject.ExportFormat(formatStr)
+	export.Status = valueobject.ExportStatus(statusStr)
+	return export, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -13,6 +14,8 @@ import (
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
+const generatedLessonColumns = `id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, stale_since, stale_reason, generated_at`
+
 // GeneratedLessonRepository implements repository.GeneratedLessonRepository using PostgreSQL.
 type GeneratedLessonRepository struct {
 	db *sql.DB
@@ -46,21 +49,11 @@ func (r *GeneratedLessonRepository) Create(ctx context.Context, lesson *entity.G
 func (r *GeneratedLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.GeneratedLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GeneratedLesson, error) {
 		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
+			SELECT ` + generatedLessonColumns + `
 			FROM generated_lessons
 			WHERE id = $1
 		`
-		lesson := &entity.GeneratedLesson{}
-		err := tx.QueryRowContext(ctx, query, id).Scan(
-			&lesson.ID,
-			&lesson.TenantID,
-			&lesson.CourseID,
-			&lesson.SectionID,
-			&lesson.OutlineLessonID,
-			&lesson.Title,
-			&lesson.SegueText,
-			&lesson.GeneratedAt,
-		)
+		lesson, err := scanGeneratedLesson(tx.QueryRowContext(ctx, query, id))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -75,21 +68,11 @@ func (r *GeneratedLessonRepository) GetByID(ctx context.Context, id uuid.UUID) (
 func (r *GeneratedLessonRepository) GetByOutlineLessonID(ctx context.Context, outlineLessonID uuid.UUID) (*entity.GeneratedLesson, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GeneratedLesson, error) {
 		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
+			SELECT ` + generatedLessonColumns + `
 			FROM generated_lessons
 			WHERE outline_lesson_id = $1
 		`
-		lesson := &entity.GeneratedLesson{}
-		err := tx.QueryRowContext(ctx, query, outlineLessonID).Scan(
-			&lesson.ID,
-			&lesson.TenantID,
-			&lesson.CourseID,
-			&lesson.SectionID,
-			&lesson.OutlineLessonID,
-			&lesson.Title,
-			&lesson.SegueText,
-			&lesson.GeneratedAt,
-		)
+		lesson, err := scanGeneratedLesson(tx.QueryRowContext(ctx, query, outlineLessonID))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -102,38 +85,12 @@ func (r *GeneratedLessonRepository) GetByOutlineLessonID(ctx context.Context, ou
 
 // ListByCourseID retrieves all lessons for a course.
 func (r *GeneratedLessonRepository) ListByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
-	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GeneratedLesson, error) {
-		query := `
-			SELECT id, tenant_id, course_id, section_id, outline_lesson_id, title, segue_text, generated_at
-			FROM generated_lessons
-			WHERE course_id = $1
-			ORDER BY generated_at ASC
-		`
-		rows, err := tx.QueryContext(ctx, query, courseID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list lessons: %w", err)
-		}
-		defer rows.Close()
-
-		var lessons []*entity.GeneratedLesson
-		for rows.Next() {
-			lesson := &entity.GeneratedLesson{}
-			if err := rows.Scan(
-				&lesson.ID,
-				&lesson.TenantID,
-				&lesson.CourseID,
-				&lesson.SectionID,
-				&lesson.OutlineLessonID,
-				&lesson.Title,
-				&lesson.SegueText,
-				&lesson.GeneratedAt,
-			); err != nil {
-				return nil, fmt.Errorf("failed to scan lesson: %w", err)
-			}
-			lessons = append(lessons, lesson)
-		}
-		return lessons, nil
-	})
+	return r.list(ctx, `
+		SELECT `+generatedLessonColumns+`
+		FROM generated_lessons
+		WHERE course_id = $1
+		ORDER BY generated_at ASC
+	`, courseID)
 }
 
 // Update updates a lesson.
@@ -153,6 +110,102 @@ func (r *GeneratedLessonRepository) Update(ctx context.Context, lesson *entity.G
 	})
 }
 
+// ListStaleCandidates retrieves lessons not yet flagged stale that cite
+// knowledge of their course's SMEs changed after both the lesson was
+// generated and since.
+func (r *GeneratedLessonRepository) ListStaleCandidates(ctx context.Context, since time.Time) ([]*entity.GeneratedLesson, error) {
+	return r.list(ctx, `
+		SELECT `+generatedLessonColumns+`
+		FROM generated_lessons gl
+		WHERE gl.stale_since IS NULL
+			AND EXISTS (
+				SELECT 1
+				FROM lesson_components lc
+				JOIN course_generation_inputs gi ON gi.course_id = gl.course_id
+				JOIN sme_knowledge_chunk_versions v ON v.chunk_id = ANY(lc.sme_chunk_ids)
+				WHERE lc.lesson_id = gl.id
+					AND v.sme_id = ANY(gi.sme_ids)
+					AND v.created_at > gl.generated_at
+					AND v.created_at > $1
+			)
+		ORDER BY gl.tenant_id, gl.course_id, gl.generated_at ASC
+	`, since)
+}
+
+// ListStaleByCourseID retrieves the stale lessons of a course.
+func (r *GeneratedLessonRepository) ListStaleByCourseID(ctx context.Context, courseID uuid.UUID) ([]*entity.GeneratedLesson, error) {
+	return r.list(ctx, `
+		SELECT `+generatedLessonColumns+`
+		FROM generated_lessons
+		WHERE course_id = $1 AND stale_since IS NOT NULL
+		ORDER BY generated_at ASC
+	`, courseID)
+}
+
+// MarkStale flags a lesson as out of date.
+func (r *GeneratedLessonRepository) MarkStale(ctx context.Context, id uuid.UUID, reason string) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE generated_lessons
+			SET stale_since = NOW(), stale_reason = $1
+			WHERE id = $2 AND stale_since IS NULL
+		`
+		_, err := tx.ExecContext(ctx, query, reason, id)
+		return err
+	})
+}
+
+// DeleteStaleByOutlineLessonID deletes the stale lessons generated for an outline lesson.
+func (r *GeneratedLessonRepository) DeleteStaleByOutlineLessonID(ctx context.Context, outlineLessonID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM generated_lessons WHERE outline_lesson_id = $1 AND stale_since IS NOT NULL`
+		_, err := tx.ExecContext(ctx, query, outlineLessonID)
+		return err
+	})
+}
+
+// list runs a lesson query and scans every row.
+func (r *GeneratedLessonRepository) list(ctx context.Context, query string, args ...any) ([]*entity.GeneratedLesson, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GeneratedLesson, error) {
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list lessons: %w", err)
+		}
+		defer rows.Close()
+
+		var lessons []*entity.GeneratedLesson
+		for rows.Next() {
+			lesson, err := scanGeneratedLesson(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan lesson: %w", err)
+			}
+			lessons = append(lessons, lesson)
+		}
+		return lessons, rows.Err()
+	})
+}
+
+// scanGeneratedLesson scans a row selected with generatedLessonColumns.
+func scanGeneratedLesson(row rowScanner) (*entity.GeneratedLesson, error) {
+	lesson := &entity.GeneratedLesson{}
+	err := row.Scan(
+		&lesson.ID,
+		&lesson.TenantID,
+		&lesson.CourseID,
+		&lesson.SectionID,
+		&lesson.OutlineLessonID,
+		&lesson.Title,
+		&lesson.SegueText,
+		&lesson.StaleSince,
+		&lesson.StaleReason,
+		&lesson.GeneratedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return lesson, nil
+}
+
 // LessonComponentRepository implements repository.LessonComponentRepository using PostgreSQL.
 type LessonComponentRepository struct {
 	db *sql.DB
@@ -268,6 +321,55 @@ func (r *LessonComponentRepository) ListByLessonID(ctx context.Context, lessonID
 	})
 }
 
+// ListBySMEChunkIDs retrieves all components citing any of the given SME knowledge chunks.
+func (r *LessonComponentRepository) ListBySMEChunkIDs(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.LessonComponent, error) {
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.LessonComponent, error) {
+		query := `
+			SELECT id, tenant_id, lesson_id, type, position, content_json, sme_chunk_ids, learning_objective_ids, created_at, updated_at
+			FROM lesson_components
+			WHERE sme_chunk_ids && $1::uuid[]
+			ORDER BY lesson_id, position ASC
+		`
+		rows, err := tx.QueryContext(ctx, query, pq.Array(chunkIDs))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list components: %w", err)
+		}
+		defer rows.Close()
+
+		var components []*entity.LessonComponent
+		for rows.Next() {
+			component := &entity.LessonComponent{}
+			var typeStr string
+			var contentJSON []byte
+			var chunkIDs pq.StringArray
+			var objectiveIDs pq.StringArray
+			if err := rows.Scan(
+				&component.ID,
+				&component.TenantID,
+				&component.LessonID,
+				&typeStr,
+				&component.Position,
+				&contentJSON,
+				&chunkIDs,
+				&objectiveIDs,
+				&component.CreatedAt,
+				&component.UpdatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan component: %w", err)
+			}
+			component.Type, _ = valueobject.ParseLessonComponentType(typeStr)
+			component.ContentJSON = json.RawMessage(contentJSON)
+			component.SMEChunkIDs = parseUUIDs(chunkIDs)
+			component.LearningObjectiveIDs = []string(objectiveIDs)
+			components = append(components, component)
+		}
+		return components, nil
+	})
+}
+
 // Update updates a component.
 func (r *LessonComponentRepository) Update(ctx context.Context, component *entity.LessonComponent) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -6,11 +6,51 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
+// Fair scheduling across tenants. A tenant runs at most max_concurrent_jobs
+// jobs at once and the rest wait queued. Free workers take the interactive
+// lane first, then the tenant with the fewest running jobs per unit of
+// scheduling_weight, then the oldest job. Tenants without AI settings get the
+// column defaults. Jobs of suspended tenants stay queued until reactivation.
+const (
+	// jobClaimLock serializes claims so two workers can't both take a
+	// tenant's last free slot
+	jobClaimLock = `SELECT pg_advisory_xact_lock(hashtext('generation_jobs:claim'))`
+
+	// runningJobs counts each tenant's processing jobs; parent jobs only track
+	// their children
+	runningJobs = `
+		running AS (
+			SELECT tenant_id, COUNT(*) AS jobs
+			FROM generation_jobs
+			WHERE status = 'processing' AND type NOT IN ('full_course', 'stale_lesson_regen')
+			GROUP BY tenant_id
+		)`
+
+	// schedulableJobs joins jobs j of active tenants to their tenant's running
+	// count r and settings s
+	schedulableJobs = `
+		generation_jobs j
+		JOIN tenants t ON t.id = j.tenant_id AND t.status = 'active'
+		LEFT JOIN running r ON r.tenant_id = j.tenant_id
+		LEFT JOIN tenant_ai_settings s ON s.tenant_id = j.tenant_id`
+
+	// underJobLimit holds for jobs whose tenant has a free slot
+	underJobLimit = `COALESCE(r.jobs, 0) < COALESCE(s.max_concurrent_jobs, 3)`
+
+	// fairJobOrder ranks queued jobs in the order workers take them. The lane
+	// mirrors entity.GenerationJob.Priority: 0 interactive, 1 bulk.
+	fairJobOrder = `
+		CASE WHEN j.parent_job_id IS NULL AND j.type <> 'sme_ingestion' THEN 0 ELSE 1 END,
+		COALESCE(r.jobs, 0)::float / COALESCE(s.scheduling_weight, 1),
+		j.created_at`
+)
+
 // GenerationJobRepository implements repository.GenerationJobRepository using PostgreSQL.
 type GenerationJobRepository struct {
 	db                     *sql.DB
@@ -107,12 +147,13 @@ func (r *GenerationJobRepository) CreateBatch(ctx context.Context, jobs []*entit
 func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
 			FROM generation_jobs
 			WHERE id = $1
 		`
 		job := &entity.GenerationJob{}
 		var typeStr, statusStr string
+		var retrievedChunkIDs pq.StringArray
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&job.ID,
 			&job.TenantID,
@@ -135,6 +176,7 @@ func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 			&job.CreatedAt,
 			&job.StartedAt,
 			&job.CompletedAt,
+			&retrievedChunkIDs,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -142,6 +184,7 @@ func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 		if err != nil {
 			return nil, fmt.Errorf("failed to get job: %w", err)
 		}
+		job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
 		var parseErr error
 		job.Type, parseErr = valueobject.ParseGenerationJobType(typeStr)
 		if parseErr != nil {
@@ -160,7 +203,7 @@ func (r *GenerationJobRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 func (r *GenerationJobRepository) List(ctx context.Context, opts entity.GenerationJobListOptions) ([]*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
 			FROM generation_jobs
 			WHERE 1=1
 		`
@@ -197,6 +240,7 @@ func (r *GenerationJobRepository) List(ctx context.Context, opts entity.Generati
 		for rows.Next() {
 			job := &entity.GenerationJob{}
 			var typeStr, statusStr string
+			var retrievedChunkIDs pq.StringArray
 			if err := rows.Scan(
 				&job.ID,
 				&job.TenantID,
@@ -219,9 +263,11 @@ func (r *GenerationJobRepository) List(ctx context.Context, opts entity.Generati
 				&job.CreatedAt,
 				&job.StartedAt,
 				&job.CompletedAt,
+				&retrievedChunkIDs,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan job: %w", err)
 			}
+			job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
 			var parseErr error
 			job.Type, parseErr = valueobject.ParseGenerationJobType(typeStr)
 			if parseErr != nil {
@@ -243,8 +289,8 @@ func (r *GenerationJobRepository) Update(ctx context.Context, job *entity.Genera
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE generation_jobs
-			SET status = $1, progress_percent = $2, progress_message = $3, result_path = $4, error_message = $5, tokens_used = $6, retry_count = $7, started_at = $8, completed_at = $9
-			WHERE id = $10
+			SET status = $1, progress_percent = $2, progress_message = $3, result_path = $4, error_message = $5, tokens_used = $6, retry_count = $7, started_at = $8, completed_at = $9, retrieved_chunk_ids = $10, lesson_id = $11
+			WHERE id = $12
 		`
 		_, err := tx.ExecContext(ctx, query,
 			job.Status.String(),
@@ -256,45 +302,56 @@ func (r *GenerationJobRepository) Update(ctx context.Context, job *entity.Genera
 			job.RetryCount,
 			job.StartedAt,
 			job.CompletedAt,
+			pq.Array(job.RetrievedChunkIDs),
+			job.LessonID,
 			job.ID,
 		)
 		return err
 	})
 }
 
-// GetNextQueued atomically claims the next job for processing.
+// GetNextQueued atomically claims the next job of the given types for processing.
 // Uses RLS with superadmin context to access jobs across all tenants.
 // Atomically updates status to 'processing' and sets started_at in a single statement.
 // This prevents race conditions where multiple workers could pick up the same job.
+// Jobs are taken in fair order, skipping tenants at their concurrency limit.
 //
 // Implements "Push + Sweep" pattern:
 // - Picks up queued jobs (standard flow)
-// - Also picks up stale 'processing' jobs (crash recovery) - jobs stuck for >10 minutes
-func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.GenerationJob, error) {
+// - Stale 'processing' jobs (crash recovery) are put back in the queue by RequeueStaleJobs
+func (r *GenerationJobRepository) GetNextQueued(ctx context.Context, types []valueobject.GenerationJobType) (*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
+		if _, err := tx.ExecContext(ctx, jobClaimLock); err != nil {
+			return nil, fmt.Errorf("failed to lock job claims: %w", err)
+		}
+
+		typeStrs := make([]string, len(types))
+		for i, t := range types {
+			typeStrs[i] = t.String()
+		}
+
 		// Atomic claim: UPDATE with subquery SELECT FOR UPDATE SKIP LOCKED
 		// This ensures only one worker can claim each job
-		// Includes stale job recovery: if a worker crashes, the job stays in 'processing'
-		// forever. This query also picks up jobs stuck in 'processing' for the configured timeout.
-		// NOTE: full_course jobs are excluded - they are parent tracking jobs, not processable work.
-		query := fmt.Sprintf(`
+		// NOTE: parent jobs are never claimed - they are tracking jobs, not processable work.
+		query := `
+			WITH ` + runningJobs + `
 			UPDATE generation_jobs
-			SET status = 'processing', started_at = NOW(), retry_count = retry_count + CASE WHEN status = 'processing' THEN 1 ELSE 0 END
+			SET status = 'processing', started_at = NOW()
 			WHERE id = (
-				SELECT id FROM generation_jobs
-				WHERE (status = 'queued' AND type != 'full_course')
-				   OR (status = 'processing' AND started_at < NOW() - INTERVAL '%d minutes' AND type != 'full_course')
-				ORDER BY
-					CASE WHEN status = 'queued' THEN 0 ELSE 1 END, -- Prefer queued jobs
-					created_at ASC
+				SELECT j.id FROM ` + schedulableJobs + `
+				WHERE j.status = 'queued' AND j.type::text = ANY($1)
+				  AND j.type NOT IN ('full_course', 'stale_lesson_regen')
+				  AND ` + underJobLimit + `
+				ORDER BY ` + fairJobOrder + `
 				LIMIT 1
-				FOR UPDATE SKIP LOCKED
+				FOR UPDATE OF j SKIP LOCKED
 			)
-			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
-		`, r.staleJobTimeoutMinutes)
+			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
+		`
 		job := &entity.GenerationJob{}
 		var typeStr, statusStr string
-		err := tx.QueryRowContext(ctx, query).Scan(
+		var retrievedChunkIDs pq.StringArray
+		err := tx.QueryRowContext(ctx, query, pq.Array(typeStrs)).Scan(
 			&job.ID,
 			&job.TenantID,
 			&typeStr,
@@ -316,6 +373,7 @@ func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.Ge
 			&job.CreatedAt,
 			&job.StartedAt,
 			&job.CompletedAt,
+			&retrievedChunkIDs,
 		)
 		if err == sql.ErrNoRows {
 			return nil, nil
@@ -328,6 +386,7 @@ func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.Ge
 		// Returning an error would rollback the transaction, creating a "poison pill"
 		// job that crashes every worker forever. Let the service layer handle bad data
 		// via failJob() which can properly mark it as failed.
+		job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
 		job.Type, _ = valueobject.ParseGenerationJobType(typeStr)
 		job.Status, _ = valueobject.ParseGenerationJobStatus(statusStr)
 		return job, nil
@@ -335,20 +394,31 @@ func (r *GenerationJobRepository) GetNextQueued(ctx context.Context) (*entity.Ge
 }
 
 // ClaimJobByID atomically claims a specific job by ID for processing.
-// Returns the job if successfully claimed, nil if already processed/claimed.
+// Returns the job if successfully claimed, nil if already processed/claimed,
+// its tenant is at its concurrency limit or suspended.
 // Uses RLS with superadmin context to access jobs across all tenants.
 func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID) (*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJob, error) {
-		// Atomic claim: UPDATE only if status is 'queued'
+		if _, err := tx.ExecContext(ctx, jobClaimLock); err != nil {
+			return nil, fmt.Errorf("failed to lock job claims: %w", err)
+		}
+
+		// Atomic claim: UPDATE only if status is 'queued' and the tenant has a free slot
 		// This ensures idempotency - if job is already claimed, we get no rows
 		query := `
+			WITH ` + runningJobs + `
 			UPDATE generation_jobs
 			SET status = 'processing', started_at = NOW()
-			WHERE id = $1 AND status = 'queued'
-			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			WHERE id = (
+				SELECT j.id FROM ` + schedulableJobs + `
+				WHERE j.id = $1 AND j.status = 'queued' AND ` + underJobLimit + `
+				FOR UPDATE OF j
+			)
+			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
 		`
 		job := &entity.GenerationJob{}
 		var typeStr, statusStr string
+		var retrievedChunkIDs pq.StringArray
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&job.ID,
 			&job.TenantID,
@@ -371,9 +441,11 @@ func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID
 			&job.CreatedAt,
 			&job.StartedAt,
 			&job.CompletedAt,
+			&retrievedChunkIDs,
 		)
 		if err == sql.ErrNoRows {
-			// Job doesn't exist or is not in 'queued' status (already claimed/processed)
+			// Job doesn't exist, is not in 'queued' status (already claimed/processed)
+			// or must wait for its tenant's running jobs or reactivation
 			return nil, nil
 		}
 		if err != nil {
@@ -384,18 +456,149 @@ func (r *GenerationJobRepository) ClaimJobByID(ctx context.Context, id uuid.UUID
 		// Returning an error would rollback the transaction, creating a "poison pill"
 		// job that crashes every worker forever. Let the service layer handle bad data
 		// via failJob() which can properly mark it as failed.
+		job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
 		job.Type, _ = valueobject.ParseGenerationJobType(typeStr)
 		job.Status, _ = valueobject.ParseGenerationJobStatus(statusStr)
 		return job, nil
 	})
 }
 
+// PeekNextQueued returns the queued job the next fair claim would take,
+// without claiming it, or nil if no tenant with queued jobs has a free slot.
+// Uses RLS with superadmin context to access jobs across all tenants.
+func (r *GenerationJobRepository) PeekNextQueued(ctx context.Context) (*entity.GenerationJob, error) {
+	id, err := RLSQuery(ctx, r.db, func(tx *sql.Tx) (uuid.UUID, error) {
+		query := `
+			WITH ` + runningJobs + `
+			SELECT j.id FROM ` + schedulableJobs + `
+			WHERE j.status = 'queued' AND j.type NOT IN ('full_course', 'stale_lesson_regen')
+			  AND ` + underJobLimit + `
+			ORDER BY ` + fairJobOrder + `
+			LIMIT 1
+		`
+		var id uuid.UUID
+		err := tx.QueryRowContext(ctx, query).Scan(&id)
+		if err == sql.ErrNoRows {
+			return uuid.Nil, nil
+		}
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to peek next queued job: %w", err)
+		}
+		return id, nil
+	})
+	if err != nil || id == uuid.Nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id)
+}
+
+// GetQueuePosition returns where a queued job stands in the fair order of all
+// queued jobs, or nil if the job is not queued or its tenant is suspended.
+// Jobs of tenants at their concurrency limit keep their place, so the
+// position is an estimate.
+// Uses RLS with superadmin context to count jobs across all tenants.
+func (r *GenerationJobRepository) GetQueuePosition(ctx context.Context, id uuid.UUID) (*entity.GenerationJobQueuePosition, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.GenerationJobQueuePosition, error) {
+		query := `
+			WITH ` + runningJobs + `,
+			queue AS (
+				SELECT j.id, ROW_NUMBER() OVER (ORDER BY ` + fairJobOrder + `) AS position
+				FROM ` + schedulableJobs + `
+				WHERE j.status = 'queued' AND j.type NOT IN ('full_course', 'stale_lesson_regen')
+			)
+			SELECT position, (SELECT COUNT(*) FROM queue)
+			FROM queue
+			WHERE id = $1
+		`
+		pos := &entity.GenerationJobQueuePosition{}
+		err := tx.QueryRowContext(ctx, query, id).Scan(&pos.Position, &pos.Depth)
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get queue position: %w", err)
+		}
+		return pos, nil
+	})
+}
+
+// RequeueStaleJobs recovers jobs whose worker stopped responding: jobs stuck
+// in 'processing' for the configured timeout go back to the queue while they
+// have retries left, and fail once they have used them all.
+// Uses RLS with superadmin context to access jobs across all tenants.
+func (r *GenerationJobRepository) RequeueStaleJobs(ctx context.Context) ([]*entity.GenerationJob, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
+		// SET expressions see the row before the update, so every CASE tests
+		// the retries used so far
+		query := fmt.Sprintf(`
+			UPDATE generation_jobs
+			SET status = CASE WHEN retry_count < max_retries THEN 'queued' ELSE 'failed' END::generation_job_status,
+			    retry_count = retry_count + CASE WHEN retry_count < max_retries THEN 1 ELSE 0 END,
+			    started_at = CASE WHEN retry_count < max_retries THEN NULL ELSE started_at END,
+			    completed_at = CASE WHEN retry_count < max_retries THEN NULL ELSE NOW() END,
+			    progress_message = CASE WHEN retry_count < max_retries THEN 'Requeued after the worker stopped responding' ELSE progress_message END,
+			    error_message = CASE WHEN retry_count < max_retries THEN error_message ELSE 'Worker stopped responding and no retries are left' END
+			WHERE id IN (
+				SELECT id FROM generation_jobs
+				WHERE status = 'processing'
+				  AND started_at < NOW() - INTERVAL '%d minutes'
+				  AND type NOT IN ('full_course', 'stale_lesson_regen')
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
+		`, r.staleJobTimeoutMinutes)
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("failed to requeue stale jobs: %w", err)
+		}
+		defer rows.Close()
+
+		var jobs []*entity.GenerationJob
+		for rows.Next() {
+			job := &entity.GenerationJob{}
+			var typeStr, statusStr string
+			var retrievedChunkIDs pq.StringArray
+			if err := rows.Scan(
+				&job.ID,
+				&job.TenantID,
+				&typeStr,
+				&statusStr,
+				&job.CourseID,
+				&job.LessonID,
+				&job.OutlineLessonID,
+				&job.SMETaskID,
+				&job.SubmissionID,
+				&job.ParentJobID,
+				&job.ProgressPercent,
+				&job.ProgressMessage,
+				&job.ResultPath,
+				&job.ErrorMessage,
+				&job.TokensUsed,
+				&job.RetryCount,
+				&job.MaxRetries,
+				&job.CreatedByUserID,
+				&job.CreatedAt,
+				&job.StartedAt,
+				&job.CompletedAt,
+				&retrievedChunkIDs,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan requeued job: %w", err)
+			}
+			job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
+			job.Type, _ = valueobject.ParseGenerationJobType(typeStr)
+			job.Status, _ = valueobject.ParseGenerationJobStatus(statusStr)
+			jobs = append(jobs, job)
+		}
+		return jobs, rows.Err()
+	})
+}
+
 // ListByParentID retrieves all child jobs for a parent job.
 // Uses RLS to ensure proper tenant isolation.
 func (r *GenerationJobRepository) ListByParentID(ctx context.Context, parentID uuid.UUID) ([]*entity.GenerationJob, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.GenerationJob, error) {
 		query := `
-			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at
+			SELECT id, tenant_id, type, status, course_id, lesson_id, outline_lesson_id, sme_task_id, submission_id, parent_job_id, progress_percent, progress_message, result_path, error_message, tokens_used, retry_count, max_retries, created_by_user_id, created_at, started_at, completed_at, retrieved_chunk_ids
 			FROM generation_jobs
 			WHERE parent_job_id = $1
 			ORDER BY created_at ASC
@@ -410,6 +613,7 @@ func (r *GenerationJobRepository) ListByParentID(ctx context.Context, parentID u
 		for rows.Next() {
 			job := &entity.GenerationJob{}
 			var typeStr, statusStr string
+			var retrievedChunkIDs pq.StringArray
 			if err := rows.Scan(
 				&job.ID,
 				&job.TenantID,
@@ -432,9 +636,11 @@ func (r *GenerationJobRepository) ListByParentID(ctx context.Context, parentID u
 				&job.CreatedAt,
 				&job.StartedAt,
 				&job.CompletedAt,
+				&retrievedChunkIDs,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan child job: %w", err)
 			}
+			job.RetrievedChunkIDs = parseUUIDs(retrievedChunkIDs)
 			var parseErr error
 			job.Type, parseErr = valueobject.ParseGenerationJobType(typeStr)
 			if parseErr != nil {
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+)
+
+// TenantLRSSettingsRepository implements repository.TenantLRSSettingsRepository using PostgreSQL.
+type TenantLRSSettingsRepository struct {
+	db *sql.DB
+}
+
+// NewTenantLRSSettingsRepository creates a new PostgreSQL tenant LRS settings repository.
+func NewTenantLRSSettingsRepository(db *sql.DB) repository.TenantLRSSettingsRepository {
+	return &TenantLRSSettingsRepository{db: db}
+}
+
+// Get retrieves LRS settings for a tenant.
+// Returns (nil, nil) if settings don't exist yet.
+func (r *TenantLRSSettingsRepository) Get(ctx context.Context, tenantID uuid.UUID) (*entity.TenantLRSSettings, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.TenantLRSSettings, error) {
+		query := `
+			SELECT id, tenant_id, endpoint, key, encrypted_secret, updated_at, updated_by_user_id
+			FROM tenant_lrs_settings
+			WHERE tenant_id = $1
+		`
+		settings := &entity.TenantLRSSettings{}
+		err := tx.QueryRowContext(ctx, query, tenantID).Scan(
+			&settings.ID,
+			&settings.TenantID,
+			&settings.Endpoint,
+			&settings.Key,
+			&settings.EncryptedSecret,
+			&settings.UpdatedAt,
+			&settings.UpdatedByUserID,
+		)
+		if err == sql.ErrNoRows {
+			return nil, nil // No settings exist yet
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get LRS settings: %w", err)
+		}
+		return settings, nil
+	})
+}
+
+// Create creates new LRS settings for a tenant.
+func (r *TenantLRSSettingsRepository) Create(ctx context.Context, settings *entity.TenantLRSSettings) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO tenant_lrs_settings (tenant_id, endpoint, key, encrypted_secret, updated_by_user_id)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id, updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			settings.TenantID,
+			settings.Endpoint,
+			settings.Key,
+			settings.EncryptedSecret,
+			settings.UpdatedByUserID,
+		).Scan(&settings.ID, &settings.UpdatedAt)
+	})
+}
+
+// Update updates LRS settings.
+func (r *TenantLRSSettingsRepository) Update(ctx context.Context, settings *entity.TenantLRSSettings) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE tenant_lrs_settings
+			SET endpoint = $1, key = $2, encrypted_secret = $3, updated_at = NOW(), updated_by_user_id = $4
+			WHERE tenant_id = $5
+			RETURNING updated_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			settings.Endpoint,
+			settings.Key,
+			settings.EncryptedSecret,
+			settings.UpdatedByUserID,
+			settings.TenantID,
+		).Scan(&settings.UpdatedAt)
+	})
+}
+
+// Delete removes LRS settings for a tenant.
+func (r *TenantLRSSettingsRepository) Delete(ctx context.Context, tenantID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, `DELETE FROM tenant_lrs_settings WHERE tenant_id = $1`, tenantID)
+		return err
+	})
+}
@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+const smeKnowledgeVersionColumns = `id, tenant_id, chunk_id, sme_id, version, change_type, change_reason, author_user_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at`
+
+// SMEKnowledgeVersionRepository implements repository.SMEKnowledgeVersionRepository using PostgreSQL.
+type SMEKnowledgeVersionRepository struct {
+	db *sql.DB
+}
+
+// NewSMEKnowledgeVersionRepository creates a new PostgreSQL knowledge chunk history repository.
+func NewSMEKnowledgeVersionRepository(db *sql.DB) repository.SMEKnowledgeVersionRepository {
+	return &SMEKnowledgeVersionRepository{db: db}
+}
+
+// Create records a version, assigning the chunk's next version number.
+func (r *SMEKnowledgeVersionRepository) Create(ctx context.Context, version *entity.SMEKnowledgeChunkVersion) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		// Serialize version numbering per chunk; the unique constraint
+		// rejects any writer that slips past
+		if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, version.ChunkID.String()); err != nil {
+			return fmt.Errorf("failed to lock chunk history: %w", err)
+		}
+
+		query := `
+			INSERT INTO sme_knowledge_chunk_versions (tenant_id, chunk_id, sme_id, version, change_type, change_reason, author_user_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds)
+			SELECT $1, $2, $3, COALESCE(MAX(version), 0) + 1, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
+			FROM sme_knowledge_chunk_versions
+			WHERE chunk_id = $2
+			RETURNING id, version, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			version.TenantID,
+			version.ChunkID,
+			version.SMEID,
+			version.ChangeType.String(),
+			version.ChangeReason,
+			version.AuthorUserID,
+			version.SubmissionID,
+			version.Content,
+			version.Topic,
+			pq.Array(version.Keywords),
+			version.RelevanceScore,
+			version.SourceHeading,
+			version.SourcePage,
+			version.SourceTimestamp,
+		).Scan(&version.ID, &version.Version, &version.CreatedAt)
+	})
+}
+
+// ListByChunkID retrieves a chunk's versions, oldest first.
+func (r *SMEKnowledgeVersionRepository) ListByChunkID(ctx context.Context, chunkID uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error) {
+	return r.list(ctx, `
+		SELECT `+smeKnowledgeVersionColumns+`
+		FROM sme_knowledge_chunk_versions
+		WHERE chunk_id = $1
+		ORDER BY version ASC
+	`, chunkID)
+}
+
+// GetByChunkIDAndVersion retrieves a specific version of a chunk.
+func (r *SMEKnowledgeVersionRepository) GetByChunkIDAndVersion(ctx context.Context, chunkID uuid.UUID, version int32) (*entity.SMEKnowledgeChunkVersion, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMEKnowledgeChunkVersion, error) {
+		query := `
+			SELECT ` + smeKnowledgeVersionColumns + `
+			FROM sme_knowledge_chunk_versions
+			WHERE chunk_id = $1 AND version = $2
+		`
+		v, err := scanSMEKnowledgeVersion(tx.QueryRowContext(ctx, query, chunkID, version))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chunk version: %w", err)
+		}
+		return v, nil
+	})
+}
+
+// ListByChunkIDs retrieves the versions of the given chunks, oldest first.
+func (r *SMEKnowledgeVersionRepository) ListByChunkIDs(ctx context.Context, chunkIDs []uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error) {
+	if len(chunkIDs) == 0 {
+		return nil, nil
+	}
+	return r.list(ctx, `
+		SELECT `+smeKnowledgeVersionColumns+`
+		FROM sme_knowledge_chunk_versions
+		WHERE chunk_id = ANY($1)
+		ORDER BY chunk_id, version ASC
+	`, pq.Array(chunkIDs))
+}
+
+// ListDeletedBySMEID retrieves the last version of each deleted chunk of an
+// SME that has not been restored, most recently deleted first.
+func (r *SMEKnowledgeVersionRepository) ListDeletedBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunkVersion, error) {
+	return r.list(ctx, `
+		SELECT `+smeKnowledgeVersionColumns+`
+		FROM (
+			SELECT DISTINCT ON (chunk_id) *
+			FROM sme_knowledge_chunk_versions
+			WHERE sme_id = $1
+			ORDER BY chunk_id, version DESC
+		) latest
+		WHERE change_type = 'deleted'
+			AND NOT EXISTS (SELECT 1 FROM sme_knowledge_chunks c WHERE c.id = latest.chunk_id)
+		ORDER BY created_at DESC
+	`, smeID)
+}
+
+// list runs a version query and scans every row.
+func (r *SMEKnowledgeVersionRepository) list(ctx context.Context, query string, args ...any) ([]*entity.SMEKnowledgeChunkVersion, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunkVersion, error) {
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunk versions: %w", err)
+		}
+		defer rows.Close()
+
+		var versions []*entity.SMEKnowledgeChunkVersion
+		for rows.Next() {
+			v, err := scanSMEKnowledgeVersion(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan chunk version: %w", err)
+			}
+			versions = append(versions, v)
+		}
+		return versions, rows.Err()
+	})
+}
+
+// scanSMEKnowledgeVersion scans a row selected with smeKnowledgeVersionColumns.
+func scanSMEKnowledgeVersion(row rowScanner) (*entity.SMEKnowledgeChunkVersion, error) {
+	v := &entity.SMEKnowledgeChunkVersion{}
+	var changeTypeStr string
+	var keywords pq.StringArray
+	err := row.Scan(
+		&v.ID,
+		&v.TenantID,
+		&v.ChunkID,
+		&v.SMEID,
+		&v.Version,
+		&changeTypeStr,
+		&v.ChangeReason,
+		&v.AuthorUserID,
+		&v.SubmissionID,
+		&v.Content,
+		&v.Topic,
+		&keywords,
+		&v.RelevanceScore,
+		&v.SourceHeading,
+		&v.SourcePage,
+		&v.SourceTimestamp,
+		&v.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	v.ChangeType, _ = valueobject.ParseKnowledgeChangeType(changeTypeStr)
+	v.Keywords = []string(keywords)
+	return v, nil
+}
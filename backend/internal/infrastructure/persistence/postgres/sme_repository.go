@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -183,6 +185,19 @@ func (r *SMERepository) List(ctx context.Context, opts entity.SMEListOptions) ([
 	})
 }
 
+// CountActiveByCompanyID counts the company's SMEs that aren't archived.
+func (r *SMERepository) CountActiveByCompanyID(ctx context.Context, companyID uuid.UUID) (int, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int, error) {
+		query := `SELECT COUNT(*) FROM subject_matter_experts WHERE company_id = $1 AND status != 'archived'`
+		var count int
+		err := tx.QueryRowContext(ctx, query, companyID).Scan(&count)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count SMEs: %w", err)
+		}
+		return count, nil
+	})
+}
+
 // Update updates an SME.
 func (r *SMERepository) Update(ctx context.Context, sme *entity.SubjectMatterExpert) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -486,6 +501,11 @@ func (r *SMETaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	})
 }
 
+// smeSubmissionColumns lists submission columns in scanSMESubmission order.
+const smeSubmissionColumns = `s.id, s.tenant_id, s.task_id, s.file_name, s.file_path, s.content_type, s.file_size_bytes, s.extracted_text, s.ai_summary, s.ingestion_error, s.submitted_by_user_id, s.submitted_at, s.processed_at,
+	s.reviewer_notes, s.approved_content, s.is_approved, s.approved_at, s.approved_by_user_id, s.source_url, s.content_hash, s.superseded_by_submission_id,
+	s.replaces_submission_id`
+
 // SMESubmissionRepository implements repository.SMESubmissionRepository using PostgreSQL.
 type SMESubmissionRepository struct {
 	db *sql.DB
@@ -500,8 +520,8 @@ func NewSMESubmissionRepository(db *sql.DB) repository.SMESubmissionRepository {
 func (r *SMESubmissionRepository) Create(ctx context.Context, submission *entity.SMETaskSubmission) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO sme_task_submissions (tenant_id, task_id, file_name, file_path, content_type, file_size_bytes, extracted_text, submitted_by_user_id)
-			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			INSERT INTO sme_task_submissions (tenant_id, task_id, file_name, file_path, content_type, file_size_bytes, extracted_text, submitted_by_user_id, source_url, replaces_submission_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 			RETURNING id, submitted_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -513,6 +533,8 @@ func (r *SMESubmissionRepository) Create(ctx context.Context, submission *entity
 			submission.FileSizeBytes,
 			submission.ExtractedText,
 			submission.SubmittedByUserID,
+			submission.SourceURL,
+			submission.ReplacesSubmissionID,
 		).Scan(&submission.ID, &submission.SubmittedAt)
 	})
 }
@@ -520,41 +542,17 @@ func (r *SMESubmissionRepository) Create(ctx context.Context, submission *entity
 // GetByID retrieves a submission by its ID.
 func (r *SMESubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.SMETaskSubmission, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMETaskSubmission, error) {
-		query := `
-			SELECT id, tenant_id, task_id, file_name, file_path, content_type, file_size_bytes, extracted_text, ai_summary, ingestion_error, submitted_by_user_id, submitted_at, processed_at,
-				reviewer_notes, approved_content, is_approved, approved_at, approved_by_user_id
-			FROM sme_task_submissions
-			WHERE id = $1
+		query := `SELECT ` + smeSubmissionColumns + `
+			FROM sme_task_submissions s
+			WHERE s.id = $1
 		`
-		sub := &entity.SMETaskSubmission{}
-		var contentTypeStr string
-		err := tx.QueryRowContext(ctx, query, id).Scan(
-			&sub.ID,
-			&sub.TenantID,
-			&sub.TaskID,
-			&sub.FileName,
-			&sub.FilePath,
-			&contentTypeStr,
-			&sub.FileSizeBytes,
-			&sub.ExtractedText,
-			&sub.AISummary,
-			&sub.IngestionError,
-			&sub.SubmittedByUserID,
-			&sub.SubmittedAt,
-			&sub.ProcessedAt,
-			&sub.ReviewerNotes,
-			&sub.ApprovedContent,
-			&sub.IsApproved,
-			&sub.ApprovedAt,
-			&sub.ApprovedByUserID,
-		)
+		sub, err := scanSMESubmission(tx.QueryRowContext(ctx, query, id))
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
 		if err != nil {
 			return nil, fmt.Errorf("failed to get submission: %w", err)
 		}
-		sub.ContentType, _ = valueobject.ParseContentType(contentTypeStr)
 		return sub, nil
 	})
 }
@@ -562,60 +560,56 @@ func (r *SMESubmissionRepository) GetByID(ctx context.Context, id uuid.UUID) (*e
 // ListByTaskID retrieves all submissions for a task.
 func (r *SMESubmissionRepository) ListByTaskID(ctx context.Context, taskID uuid.UUID) ([]*entity.SMETaskSubmission, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMETaskSubmission, error) {
-		query := `
-			SELECT id, tenant_id, task_id, file_name, file_path, content_type, file_size_bytes, extracted_text, ai_summary, ingestion_error, submitted_by_user_id, submitted_at, processed_at,
-				reviewer_notes, approved_content, is_approved, approved_at, approved_by_user_id
-			FROM sme_task_submissions
-			WHERE task_id = $1
-			ORDER BY submitted_at DESC
+		query := `SELECT ` + smeSubmissionColumns + `
+			FROM sme_task_submissions s
+			WHERE s.task_id = $1
+			ORDER BY s.submitted_at DESC
 		`
-		rows, err := tx.QueryContext(ctx, query, taskID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to list submissions: %w", err)
-		}
-		defer rows.Close()
+		return r.list(ctx, tx, query, taskID)
+	})
+}
 
-		var submissions []*entity.SMETaskSubmission
-		for rows.Next() {
-			sub := &entity.SMETaskSubmission{}
-			var contentTypeStr string
-			if err := rows.Scan(
-				&sub.ID,
-				&sub.TenantID,
-				&sub.TaskID,
-				&sub.FileName,
-				&sub.FilePath,
-				&contentTypeStr,
-				&sub.FileSizeBytes,
-				&sub.ExtractedText,
-				&sub.AISummary,
-				&sub.IngestionError,
-				&sub.SubmittedByUserID,
-				&sub.SubmittedAt,
-				&sub.ProcessedAt,
-				&sub.ReviewerNotes,
-				&sub.ApprovedContent,
-				&sub.IsApproved,
-				&sub.ApprovedAt,
-				&sub.ApprovedByUserID,
-			); err != nil {
-				return nil, fmt.Errorf("failed to scan submission: %w", err)
-			}
-			sub.ContentType, _ = valueobject.ParseContentType(contentTypeStr)
-			submissions = append(submissions, sub)
-		}
-		return submissions, nil
+// ListBySMEID retrieves all submissions across an SME's tasks, newest first.
+func (r *SMESubmissionRepository) ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMETaskSubmission, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMETaskSubmission, error) {
+		query := `SELECT ` + smeSubmissionColumns + `
+			FROM sme_task_submissions s
+			JOIN sme_tasks t ON t.id = s.task_id
+			WHERE t.sme_id = $1
+			ORDER BY s.submitted_at DESC
+		`
+		return r.list(ctx, tx, query, smeID)
 	})
 }
 
+// list runs a submission query and scans every row.
+func (r *SMESubmissionRepository) list(ctx context.Context, tx *sql.Tx, query string, args ...any) ([]*entity.SMETaskSubmission, error) {
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list submissions: %w", err)
+	}
+	defer rows.Close()
+
+	var submissions []*entity.SMETaskSubmission
+	for rows.Next() {
+		sub, err := scanSMESubmission(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan submission: %w", err)
+		}
+		submissions = append(submissions, sub)
+	}
+	return submissions, rows.Err()
+}
+
 // Update updates a submission.
 func (r *SMESubmissionRepository) Update(ctx context.Context, submission *entity.SMETaskSubmission) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE sme_task_submissions
 			SET extracted_text = $1, ai_summary = $2, ingestion_error = $3, processed_at = $4,
-				reviewer_notes = $5, approved_content = $6, is_approved = $7, approved_at = $8, approved_by_user_id = $9
-			WHERE id = $10
+				reviewer_notes = $5, approved_content = $6, is_approved = $7, approved_at = $8, approved_by_user_id = $9,
+				file_path = $10, file_size_bytes = $11, content_hash = $12, superseded_by_submission_id = $13
+			WHERE id = $14
 		`
 		_, err := tx.ExecContext(ctx, query,
 			submission.ExtractedText,
@@ -627,12 +621,51 @@ func (r *SMESubmissionRepository) Update(ctx context.Context, submission *entity
 			submission.IsApproved,
 			submission.ApprovedAt,
 			submission.ApprovedByUserID,
+			submission.FilePath,
+			submission.FileSizeBytes,
+			submission.ContentHash,
+			submission.SupersededBySubmissionID,
 			submission.ID,
 		)
 		return err
 	})
 }
 
+// scanSMESubmission scans a submission using the smeSubmissionColumns order.
+func scanSMESubmission(row rowScanner) (*entity.SMETaskSubmission, error) {
+	sub := &entity.SMETaskSubmission{}
+	var contentTypeStr string
+	err := row.Scan(
+		&sub.ID,
+		&sub.TenantID,
+		&sub.TaskID,
+		&sub.FileName,
+		&sub.FilePath,
+		&contentTypeStr,
+		&sub.FileSizeBytes,
+		&sub.ExtractedText,
+		&sub.AISummary,
+		&sub.IngestionError,
+		&sub.SubmittedByUserID,
+		&sub.SubmittedAt,
+		&sub.ProcessedAt,
+		&sub.ReviewerNotes,
+		&sub.ApprovedContent,
+		&sub.IsApproved,
+		&sub.ApprovedAt,
+		&sub.ApprovedByUserID,
+		&sub.SourceURL,
+		&sub.ContentHash,
+		&sub.SupersededBySubmissionID,
+		&sub.ReplacesSubmissionID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	sub.ContentType, _ = valueobject.ParseContentType(contentTypeStr)
+	return sub, nil
+}
+
 // SMEKnowledgeRepository implements repository.SMEKnowledgeRepository using PostgreSQL.
 type SMEKnowledgeRepository struct {
 	db *sql.DB
@@ -647,8 +680,8 @@ func NewSMEKnowledgeRepository(db *sql.DB) repository.SMEKnowledgeRepository {
 func (r *SMEKnowledgeRepository) Create(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
-			INSERT INTO sme_knowledge_chunks (tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score)
-			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			INSERT INTO sme_knowledge_chunks (tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 			RETURNING id, created_at
 		`
 		return tx.QueryRowContext(ctx, query,
@@ -659,6 +692,9 @@ func (r *SMEKnowledgeRepository) Create(ctx context.Context, chunk *entity.SMEKn
 			chunk.Topic,
 			pq.Array(chunk.Keywords),
 			chunk.RelevanceScore,
+			chunk.SourceHeading,
+			chunk.SourcePage,
+			chunk.SourceTimestamp,
 		).Scan(&chunk.ID, &chunk.CreatedAt)
 	})
 }
@@ -667,7 +703,7 @@ func (r *SMEKnowledgeRepository) Create(ctx context.Context, chunk *entity.SMEKn
 func (r *SMEKnowledgeRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.SMEKnowledgeChunk, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.SMEKnowledgeChunk, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
 			FROM sme_knowledge_chunks
 			WHERE id = $1
 		`
@@ -682,6 +718,9 @@ func (r *SMEKnowledgeRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 			&chunk.Topic,
 			&keywords,
 			&chunk.RelevanceScore,
+			&chunk.SourceHeading,
+			&chunk.SourcePage,
+			&chunk.SourceTimestamp,
 			&chunk.CreatedAt,
 		)
 		if err == sql.ErrNoRows {
@@ -699,7 +738,7 @@ func (r *SMEKnowledgeRepository) GetByID(ctx context.Context, id uuid.UUID) (*en
 func (r *SMEKnowledgeRepository) ListBySMEID(ctx context.Context, smeID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
 		query := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
 			FROM sme_knowledge_chunks
 			WHERE sme_id = $1
 			ORDER BY relevance_score DESC
@@ -723,6 +762,9 @@ func (r *SMEKnowledgeRepository) ListBySMEID(ctx context.Context, smeID uuid.UUI
 				&chunk.Topic,
 				&keywords,
 				&chunk.RelevanceScore,
+				&chunk.SourceHeading,
+				&chunk.SourcePage,
+				&chunk.SourceTimestamp,
 				&chunk.CreatedAt,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan chunk: %w", err)
@@ -734,18 +776,219 @@ func (r *SMEKnowledgeRepository) ListBySMEID(ctx context.Context, smeID uuid.UUI
 	})
 }
 
-// Search searches knowledge across SMEs.
-func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID, query string, limit int) ([]*entity.SMEKnowledgeChunk, error) {
+// ListTopBySMEIDs retrieves the highest-relevance chunks across SMEs.
+func (r *SMEKnowledgeRepository) ListTopBySMEIDs(ctx context.Context, smeIDs []uuid.UUID, limit int) ([]*entity.SMEKnowledgeChunk, error) {
+	if len(smeIDs) == 0 || limit <= 0 {
+		return nil, nil
+	}
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
-		sqlQuery := `
-			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, created_at
+		query := `
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
 			FROM sme_knowledge_chunks
 			WHERE sme_id = ANY($1)
-			AND (content ILIKE '%' || $2 || '%' OR topic ILIKE '%' || $2 || '%' OR $2 = ANY(keywords))
-			ORDER BY relevance_score DESC
-			LIMIT $3
+			ORDER BY relevance_score DESC, created_at DESC
+			LIMIT $2
+		`
+		rows, err := tx.QueryContext(ctx, query, pq.Array(smeIDs), limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunks: %w", err)
+		}
+		defer rows.Close()
+
+		var chunks []*entity.SMEKnowledgeChunk
+		for rows.Next() {
+			chunk := &entity.SMEKnowledgeChunk{}
+			var keywords pq.StringArray
+			if err := rows.Scan(
+				&chunk.ID,
+				&chunk.TenantID,
+				&chunk.SMEID,
+				&chunk.SubmissionID,
+				&chunk.Content,
+				&chunk.Topic,
+				&keywords,
+				&chunk.RelevanceScore,
+				&chunk.SourceHeading,
+				&chunk.SourcePage,
+				&chunk.SourceTimestamp,
+				&chunk.CreatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan chunk: %w", err)
+			}
+			chunk.Keywords = []string(keywords)
+			chunks = append(chunks, chunk)
+		}
+		return chunks, nil
+	})
+}
+
+// ListByIDs retrieves the chunks with the given IDs.
+func (r *SMEKnowledgeRepository) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.SMEKnowledgeChunk, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
+		query := `
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
+			FROM sme_knowledge_chunks
+			WHERE id = ANY($1)
+		`
+		rows, err := tx.QueryContext(ctx, query, pq.Array(ids))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunks: %w", err)
+		}
+		defer rows.Close()
+
+		var chunks []*entity.SMEKnowledgeChunk
+		for rows.Next() {
+			chunk := &entity.SMEKnowledgeChunk{}
+			var keywords pq.StringArray
+			if err := rows.Scan(
+				&chunk.ID,
+				&chunk.TenantID,
+				&chunk.SMEID,
+				&chunk.SubmissionID,
+				&chunk.Content,
+				&chunk.Topic,
+				&keywords,
+				&chunk.RelevanceScore,
+				&chunk.SourceHeading,
+				&chunk.SourcePage,
+				&chunk.SourceTimestamp,
+				&chunk.CreatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan chunk: %w", err)
+			}
+			chunk.Keywords = []string(keywords)
+			chunks = append(chunks, chunk)
+		}
+		return chunks, nil
+	})
+}
+
+// ListBySubmissionID retrieves all chunks created from a submission.
+func (r *SMEKnowledgeRepository) ListBySubmissionID(ctx context.Context, submissionID uuid.UUID) ([]*entity.SMEKnowledgeChunk, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
+		query := `
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
+			FROM sme_knowledge_chunks
+			WHERE submission_id = $1
+		`
+		rows, err := tx.QueryContext(ctx, query, submissionID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list chunks: %w", err)
+		}
+		defer rows.Close()
+
+		var chunks []*entity.SMEKnowledgeChunk
+		for rows.Next() {
+			chunk := &entity.SMEKnowledgeChunk{}
+			var keywords pq.StringArray
+			if err := rows.Scan(
+				&chunk.ID,
+				&chunk.TenantID,
+				&chunk.SMEID,
+				&chunk.SubmissionID,
+				&chunk.Content,
+				&chunk.Topic,
+				&keywords,
+				&chunk.RelevanceScore,
+				&chunk.SourceHeading,
+				&chunk.SourcePage,
+				&chunk.SourceTimestamp,
+				&chunk.CreatedAt,
+			); err != nil {
+				return nil, fmt.Errorf("failed to scan chunk: %w", err)
+			}
+			chunk.Keywords = []string(keywords)
+			chunks = append(chunks, chunk)
+		}
+		return chunks, nil
+	})
+}
+
+// Hybrid search weights. Semantic similarity dominates so that synonyms and
+// paraphrases rank well, while keyword matches keep exact terms (product
+// names, acronyms) near the top and relevance score breaks ties.
+const (
+	searchWeightSemantic  = 0.6
+	searchWeightKeyword   = 0.3
+	searchWeightRelevance = 0.1
+)
+
+// searchCandidatesPerSource is how many candidates per result the nearest
+// neighbour and keyword lookups each contribute before blended ranking.
+const searchCandidatesPerSource = 4
+
+// Search searches knowledge across SMEs using hybrid ranking. Only the nearest
+// neighbours of the query embedding and the best keyword matches are ranked,
+// so the cost does not grow with the size of the knowledge base.
+func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID, query string, queryEmbedding []float32, embeddingModel string, limit int) ([]*entity.SMEKnowledgeChunk, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("search query is empty")
+	}
+
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.SMEKnowledgeChunk, error) {
+		// keyword_score is 1 for exact keyword/topic matches, 0.7 for a
+		// substring match in the content, otherwise the full-text rank.
+		// Query terms are OR-ed so long queries (e.g. a lesson's title and
+		// objectives) still match chunks covering only part of them.
+		sqlQuery := `
+			WITH candidates AS (
+				(
+					SELECT id FROM sme_knowledge_chunks
+					WHERE $3::vector IS NOT NULL AND sme_id = ANY($1)
+						AND embedding IS NOT NULL AND embedding_model = $8
+					ORDER BY embedding <=> $3::vector
+					LIMIT $9
+				)
+				UNION
+				(
+					SELECT id FROM sme_knowledge_chunks
+					WHERE sme_id = ANY($1) AND (
+						lower($2) = ANY(SELECT lower(k) FROM unnest(keywords) AS k)
+						OR topic ILIKE $10 OR content ILIKE $10
+						OR to_tsvector('english', topic || ' ' || content) @@ to_tsquery('english', replace(plainto_tsquery('english', $2)::text, ' & ', ' | '))
+					)
+					ORDER BY ts_rank_cd(to_tsvector('english', topic || ' ' || content), to_tsquery('english', replace(plainto_tsquery('english', $2)::text, ' & ', ' | ')), 32) DESC, relevance_score DESC
+					LIMIT $9
+				)
+			),
+			scored AS (
+				SELECT c.id, c.tenant_id, c.sme_id, c.submission_id, c.content, c.topic, c.keywords, c.relevance_score, c.source_heading, c.source_page, c.source_timestamp_seconds, c.created_at,
+					CASE
+						WHEN $3::vector IS NULL OR c.embedding IS NULL OR c.embedding_model IS DISTINCT FROM $8 THEN 0
+						ELSE 1 - (c.embedding <=> $3::vector)
+					END AS semantic_score,
+					GREATEST(
+						CASE
+							WHEN lower($2) = ANY(SELECT lower(k) FROM unnest(c.keywords) AS k) OR c.topic ILIKE $10 THEN 1.0
+							WHEN c.content ILIKE $10 THEN 0.7
+							ELSE 0
+						END,
+						ts_rank_cd(to_tsvector('english', c.topic || ' ' || c.content), to_tsquery('english', replace(plainto_tsquery('english', $2)::text, ' & ', ' | ')), 32)
+					) AS keyword_score
+				FROM sme_knowledge_chunks c
+				JOIN candidates USING (id)
+			)
+			SELECT id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
+			FROM scored
+			ORDER BY $5 * semantic_score + $6 * keyword_score + $7 * relevance_score DESC
+			LIMIT $4
 		`
-		rows, err := tx.QueryContext(ctx, sqlQuery, pq.Array(smeIDs), query, limit)
+		rows, err := tx.QueryContext(ctx, sqlQuery,
+			pq.Array(smeIDs),
+			query,
+			vectorParam(queryEmbedding),
+			limit,
+			searchWeightSemantic,
+			searchWeightKeyword,
+			searchWeightRelevance,
+			embeddingModel,
+			limit*searchCandidatesPerSource,
+			"%"+escapeLike(query)+"%",
+		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to search chunks: %w", err)
 		}
@@ -764,6 +1007,9 @@ func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID,
 				&chunk.Topic,
 				&keywords,
 				&chunk.RelevanceScore,
+				&chunk.SourceHeading,
+				&chunk.SourcePage,
+				&chunk.SourceTimestamp,
 				&chunk.CreatedAt,
 			); err != nil {
 				return nil, fmt.Errorf("failed to scan chunk: %w", err)
@@ -775,6 +1021,39 @@ func (r *SMEKnowledgeRepository) Search(ctx context.Context, smeIDs []uuid.UUID,
 	})
 }
 
+// UpdateEmbedding stores the embedding vector for a chunk.
+func (r *SMEKnowledgeRepository) UpdateEmbedding(ctx context.Context, chunkID uuid.UUID, embedding []float32, embeddingModel string) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `UPDATE sme_knowledge_chunks SET embedding = $1::vector, embedding_model = $2 WHERE id = $3`
+		_, err := tx.ExecContext(ctx, query, vectorParam(embedding), embeddingModel, chunkID)
+		return err
+	})
+}
+
+// escapeLike escapes the LIKE wildcards in s, so it matches literally with
+// PostgreSQL's default backslash escape character.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// vectorParam encodes an embedding in pgvector's text format ("[1,2,3]"),
+// or NULL for an empty embedding.
+func vectorParam(v []float32) sql.NullString {
+	if len(v) == 0 {
+		return sql.NullString{}
+	}
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, f := range v {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(f), 'g', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sql.NullString{String: sb.String(), Valid: true}
+}
+
 // DeleteBySMEID deletes all chunks for an SME.
 func (r *SMEKnowledgeRepository) DeleteBySMEID(ctx context.Context, smeID uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -784,6 +1063,15 @@ func (r *SMEKnowledgeRepository) DeleteBySMEID(ctx context.Context, smeID uuid.U
 	})
 }
 
+// DeleteBySubmissionID deletes all chunks created from a submission.
+func (r *SMEKnowledgeRepository) DeleteBySubmissionID(ctx context.Context, submissionID uuid.UUID) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `DELETE FROM sme_knowledge_chunks WHERE submission_id = $1`
+		_, err := tx.ExecContext(ctx, query, submissionID)
+		return err
+	})
+}
+
 // Update updates a knowledge chunk.
 func (r *SMEKnowledgeRepository) Update(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
@@ -802,6 +1090,30 @@ func (r *SMEKnowledgeRepository) Update(ctx context.Context, chunk *entity.SMEKn
 	})
 }
 
+// Restore re-creates a deleted chunk under its original ID.
+func (r *SMEKnowledgeRepository) Restore(ctx context.Context, chunk *entity.SMEKnowledgeChunk) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO sme_knowledge_chunks (id, tenant_id, sme_id, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			RETURNING created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			chunk.ID,
+			chunk.TenantID,
+			chunk.SMEID,
+			chunk.SubmissionID,
+			chunk.Content,
+			chunk.Topic,
+			pq.Array(chunk.Keywords),
+			chunk.RelevanceScore,
+			chunk.SourceHeading,
+			chunk.SourcePage,
+			chunk.SourceTimestamp,
+		).Scan(&chunk.CreatedAt)
+	})
+}
+
 // Delete deletes a knowledge chunk by ID.
 func (r *SMEKnowledgeRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
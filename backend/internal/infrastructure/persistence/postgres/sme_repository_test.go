@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestEscapeLike(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"pool care", "pool care"},
+		{"100%", `100\%`},
+		{"pool_ph", `pool\_ph`},
+		{`C:\pools`, `C:\\pools`},
+		{`%_\`, `\%\_\\`},
+	}
+	for _, tt := range tests {
+		if got := escapeLike(tt.in); got != tt.want {
+			t.Errorf("escapeLike(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSearchRejectsEmptyQuery(t *testing.T) {
+	// Rejected before any database access, so no database is needed
+	repo := &SMEKnowledgeRepository{}
+	for _, query := range []string{"", "  \n\t"} {
+		if _, err := repo.Search(context.Background(), []uuid.UUID{uuid.New()}, query, nil, "", 10); err == nil {
+			t.Errorf("Search(%q) succeeded, want an error", query)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// stripeWebhookEventColumns is the column list scanned by scanStripeWebhookEvent.
+const stripeWebhookEventColumns = `id, type, payload, stripe_created_at, status, attempts, error_message, received_at, updated_at, processed_at`
+
+// StripeWebhookEventRepository implements repository.StripeWebhookEventRepository using PostgreSQL.
+// Note: All methods require superadmin context as stripe_webhook_events is accessible only to superadmins.
+type StripeWebhookEventRepository struct {
+	db *sql.DB
+}
+
+// NewStripeWebhookEventRepository creates a new PostgreSQL Stripe webhook event repository.
+func NewStripeWebhookEventRepository(db *sql.DB) repository.StripeWebhookEventRepository {
+	return &StripeWebhookEventRepository{db: db}
+}
+
+// Claim inserts a new event as processing, or takes over a failed or stalled one.
+// The conditional upsert returns no row when the stored event may not be taken over.
+func (r *StripeWebhookEventRepository) Claim(ctx context.Context, event *entity.StripeWebhookEvent, staleAfter time.Duration) (bool, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (bool, error) {
+		query := `
+			INSERT INTO stripe_webhook_events (id, type, payload, stripe_created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (id) DO UPDATE
+			SET status = 'processing', attempts = stripe_webhook_events.attempts + 1, updated_at = NOW()
+			WHERE stripe_webhook_events.status = 'failed'
+				OR (stripe_webhook_events.status = 'processing' AND stripe_webhook_events.updated_at < $5)
+			RETURNING ` + stripeWebhookEventColumns
+		claimed, err := scanStripeWebhookEvent(tx.QueryRowContext(ctx, query,
+			event.ID,
+			event.Type,
+			string(event.Payload),
+			event.StripeCreatedAt,
+			time.Now().Add(-staleAfter),
+		))
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to claim webhook event: %w", err)
+		}
+		*event = *claimed
+		return true, nil
+	})
+}
+
+// ClaimReplay takes over a stored event for a replay. The status check and the
+// update are one statement so two replays, or a replay and a redelivery, cannot
+// both apply the event.
+func (r *StripeWebhookEventRepository) ClaimReplay(ctx context.Context, id string, includeProcessed bool, staleAfter time.Duration) (*entity.StripeWebhookEvent, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.StripeWebhookEvent, error) {
+		query := `
+			UPDATE stripe_webhook_events
+			SET status = 'processing', attempts = attempts + 1, updated_at = NOW()
+			WHERE id = $1
+				AND (status <> 'processing' OR updated_at < $2)
+				AND (status <> 'processed' OR $3)
+			RETURNING ` + stripeWebhookEventColumns
+		event, err := scanStripeWebhookEvent(tx.QueryRowContext(ctx, query, id, time.Now().Add(-staleAfter), includeProcessed))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to claim webhook event for replay: %w", err)
+		}
+		return event, nil
+	})
+}
+
+// GetByID retrieves an event by its Stripe event ID.
+func (r *StripeWebhookEventRepository) GetByID(ctx context.Context, id string) (*entity.StripeWebhookEvent, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.StripeWebhookEvent, error) {
+		query := `SELECT ` + stripeWebhookEventColumns + ` FROM stripe_webhook_events WHERE id = $1`
+		event, err := scanStripeWebhookEvent(tx.QueryRowContext(ctx, query, id))
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get webhook event: %w", err)
+		}
+		return event, nil
+	})
+}
+
+// List retrieves events, most recently received first.
+func (r *StripeWebhookEventRepository) List(ctx context.Context, opts entity.StripeWebhookEventListOptions) ([]*entity.StripeWebhookEvent, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]*entity.StripeWebhookEvent, error) {
+		query := `SELECT ` + stripeWebhookEventColumns + ` FROM stripe_webhook_events WHERE 1=1`
+		args := []interface{}{}
+		argNum := 1
+
+		if opts.Status != nil {
+			query += fmt.Sprintf(" AND status = $%d", argNum)
+			args = append(args, opts.Status.String())
+			argNum++
+		}
+		if opts.Type != "" {
+			query += fmt.Sprintf(" AND type = $%d", argNum)
+			args = append(args, opts.Type)
+			argNum++
+		}
+
+		query += " ORDER BY received_at DESC"
+		if opts.Limit > 0 {
+			query += fmt.Sprintf(" LIMIT $%d", argNum)
+			args = append(args, opts.Limit)
+			argNum++
+		}
+		if opts.Offset > 0 {
+			query += fmt.Sprintf(" OFFSET $%d", argNum)
+			args = append(args, opts.Offset)
+		}
+
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list webhook events: %w", err)
+		}
+		defer rows.Close()
+
+		var events []*entity.StripeWebhookEvent
+		for rows.Next() {
+			event, err := scanStripeWebhookEvent(rows)
+			if err != nil {
+				return nil, fmt.Errorf("failed to scan webhook event: %w", err)
+			}
+			events = append(events, event)
+		}
+		return events, rows.Err()
+	})
+}
+
+// Update updates an event's status, attempts and error.
+func (r *StripeWebhookEventRepository) Update(ctx context.Context, event *entity.StripeWebhookEvent) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			UPDATE stripe_webhook_events
+			SET status = $1, attempts = $2, error_message = $3, processed_at = $4, updated_at = NOW()
+			WHERE id = $5
+			RETURNING updated_at
+		`
+		err := tx.QueryRowContext(ctx, query,
+			event.Status.String(),
+			event.Attempts,
+			event.ErrorMessage,
+			event.ProcessedAt,
+			event.ID,
+		).Scan(&event.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to update webhook event: %w", err)
+		}
+		return nil
+	})
+}
+
+// scanStripeWebhookEvent scans an event using the stripeWebhookEventColumns order.
+func scanStripeWebhookEvent(row rowScanner) (*entity.StripeWebhookEvent, error) {
+	event := &entity.StripeWebhookEvent{}
+	var statusStr string
+	err := row.Scan(
+		&event.ID,
+		&event.Type,
+		&event.Payload,
+		&event.StripeCreatedAt,
+		&statusStr,
+		&event.Attempts,
+		&event.ErrorMessage,
+		&event.ReceivedAt,
+		&event.UpdatedAt,
+		&event.ProcessedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	event.Status = valueobject.WebhookEventStatus(statusStr)
+	return event, nil
+}
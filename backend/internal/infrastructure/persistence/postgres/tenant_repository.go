@@ -42,17 +42,21 @@ func (r *TenantRepository) Create(ctx context.Context, t *entity.Tenant) error {
 func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.Tenant, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Tenant, error) {
 		query := `
-			SELECT id, name, slug, status, created_at, updated_at
+			SELECT id, name, slug, status, suspended_at, suspension_reason, restricted_at, created_at, updated_at
 			FROM tenants
 			WHERE id = $1
 		`
 		t := &entity.Tenant{}
 		var statusStr string
+		var reason sql.NullString
 		err := tx.QueryRowContext(ctx, query, id).Scan(
 			&t.ID,
 			&t.Name,
 			&t.Slug,
 			&statusStr,
+			&t.SuspendedAt,
+			&reason,
+			&t.RestrictedAt,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 		)
@@ -63,6 +67,10 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.T
 			return nil, fmt.Errorf("failed to get tenant: %w", err)
 		}
 		t.Status = entity.TenantStatus(statusStr)
+		if reason.Valid {
+			r := entity.TenantSuspensionReason(reason.String)
+			t.SuspensionReason = &r
+		}
 		return t, nil
 	})
 }
@@ -71,17 +79,21 @@ func (r *TenantRepository) GetByID(ctx context.Context, id uuid.UUID) (*entity.T
 func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*entity.Tenant, error) {
 	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (*entity.Tenant, error) {
 		query := `
-			SELECT id, name, slug, status, created_at, updated_at
+			SELECT id, name, slug, status, suspended_at, suspension_reason, restricted_at, created_at, updated_at
 			FROM tenants
 			WHERE slug = $1
 		`
 		t := &entity.Tenant{}
 		var statusStr string
+		var reason sql.NullString
 		err := tx.QueryRowContext(ctx, query, slug).Scan(
 			&t.ID,
 			&t.Name,
 			&t.Slug,
 			&statusStr,
+			&t.SuspendedAt,
+			&reason,
+			&t.RestrictedAt,
 			&t.CreatedAt,
 			&t.UpdatedAt,
 		)
@@ -92,6 +104,10 @@ func (r *TenantRepository) GetBySlug(ctx context.Context, slug string) (*entity.
 			return nil, fmt.Errorf("failed to get tenant by slug: %w", err)
 		}
 		t.Status = entity.TenantStatus(statusStr)
+		if reason.Valid {
+			r := entity.TenantSuspensionReason(reason.String)
+			t.SuspensionReason = &r
+		}
 		return t, nil
 	})
 }
@@ -101,11 +117,16 @@ func (r *TenantRepository) Update(ctx context.Context, t *entity.Tenant) error {
 	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
 		query := `
 			UPDATE tenants
-			SET name = $1, slug = $2, status = $3, updated_at = NOW()
-			WHERE id = $4
+			SET name = $1, slug = $2, status = $3, suspended_at = $4, suspension_reason = $5, restricted_at = $6, updated_at = NOW()
+			WHERE id = $7
 			RETURNING updated_at
 		`
-		err := tx.QueryRowContext(ctx, query, t.Name, t.Slug, t.Status.String(), t.ID).
+		var reason *string
+		if t.SuspensionReason != nil {
+			r := t.SuspensionReason.String()
+			reason = &r
+		}
+		err := tx.QueryRowContext(ctx, query, t.Name, t.Slug, t.Status.String(), t.SuspendedAt, reason, t.RestrictedAt, t.ID).
 			Scan(&t.UpdatedAt)
 		if err == sql.ErrNoRows {
 			return fmt.Errorf("tenant not found")
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/repository"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// TokenUsageRepository implements repository.TokenUsageRepository using PostgreSQL.
+type TokenUsageRepository struct {
+	db *sql.DB
+}
+
+// NewTokenUsageRepository creates a new PostgreSQL token usage ledger repository.
+func NewTokenUsageRepository(db *sql.DB) repository.TokenUsageRepository {
+	return &TokenUsageRepository{db: db}
+}
+
+// Record appends a usage entry to the ledger.
+func (r *TokenUsageRepository) Record(ctx context.Context, entry *entity.TokenUsageEntry) error {
+	return RLSExec(ctx, r.db, func(tx *sql.Tx) error {
+		query := `
+			INSERT INTO ai_token_usage (tenant_id, usage_month, job_id, job_type, user_id, course_id, tokens_used)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at
+		`
+		return tx.QueryRowContext(ctx, query,
+			entry.TenantID,
+			entry.UsageMonth,
+			entry.JobID,
+			entry.JobType.String(),
+			entry.UserID,
+			entry.CourseID,
+			entry.TokensUsed,
+		).Scan(&entry.ID, &entry.CreatedAt)
+	})
+}
+
+// GetMonthTotal returns the tokens a tenant used in a month.
+func (r *TokenUsageRepository) GetMonthTotal(ctx context.Context, tenantID uuid.UUID, month time.Time) (int64, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int64, error) {
+		query := `
+			SELECT COALESCE(SUM(tokens_used), 0)
+			FROM ai_token_usage
+			WHERE tenant_id = $1 AND usage_month = $2
+		`
+		var total int64
+		if err := tx.QueryRowContext(ctx, query, tenantID, month).Scan(&total); err != nil {
+			return 0, fmt.Errorf("failed to get monthly token usage: %w", err)
+		}
+		return total, nil
+	})
+}
+
+// ListDailyUsage returns per-day totals for a month, ordered by date.
+func (r *TokenUsageRepository) ListDailyUsage(ctx context.Context, tenantID uuid.UUID, month time.Time) ([]entity.DailyTokenUsage, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]entity.DailyTokenUsage, error) {
+		query := `
+			SELECT (created_at AT TIME ZONE 'UTC')::date AS day, SUM(tokens_used)
+			FROM ai_token_usage
+			WHERE tenant_id = $1 AND usage_month = $2
+			GROUP BY day
+			ORDER BY day
+		`
+		rows, err := tx.QueryContext(ctx, query, tenantID, month)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list daily token usage: %w", err)
+		}
+		defer rows.Close()
+
+		var days []entity.DailyTokenUsage
+		for rows.Next() {
+			var day entity.DailyTokenUsage
+			if err := rows.Scan(&day.Date, &day.TokensUsed); err != nil {
+				return nil, fmt.Errorf("failed to scan daily token usage: %w", err)
+			}
+			day.Date = day.Date.UTC()
+			days = append(days, day)
+		}
+		return days, rows.Err()
+	})
+}
+
+// ListUsageByJobType returns per-job-type totals for a month.
+func (r *TokenUsageRepository) ListUsageByJobType(ctx context.Context, tenantID uuid.UUID, month time.Time) ([]entity.TokenUsageByType, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) ([]entity.TokenUsageByType, error) {
+		query := `
+			SELECT job_type, SUM(tokens_used), COUNT(*)
+			FROM ai_token_usage
+			WHERE tenant_id = $1 AND usage_month = $2
+			GROUP BY job_type
+			ORDER BY SUM(tokens_used) DESC
+		`
+		rows, err := tx.QueryContext(ctx, query, tenantID, month)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list token usage by job type: %w", err)
+		}
+		defer rows.Close()
+
+		var usage []entity.TokenUsageByType
+		for rows.Next() {
+			var u entity.TokenUsageByType
+			var typeStr string
+			if err := rows.Scan(&typeStr, &u.TokensUsed, &u.JobCount); err != nil {
+				return nil, fmt.Errorf("failed to scan token usage by job type: %w", err)
+			}
+			var parseErr error
+			u.JobType, parseErr = valueobject.ParseGenerationJobType(typeStr)
+			if parseErr != nil {
+				return nil, fmt.Errorf("failed to parse job type '%s': %w", typeStr, parseErr)
+			}
+			usage = append(usage, u)
+		}
+		return usage, rows.Err()
+	})
+}
+
+// GetAverageJobTokens returns the mean tokens used by the tenant's most recent jobs of a type.
+func (r *TokenUsageRepository) GetAverageJobTokens(ctx context.Context, tenantID uuid.UUID, jobType valueobject.GenerationJobType, sampleSize int) (int64, error) {
+	return RLSQuery(ctx, r.db, func(tx *sql.Tx) (int64, error) {
+		query := `
+			SELECT COALESCE(AVG(tokens_used), 0)::BIGINT
+			FROM (
+				SELECT tokens_used
+				FROM ai_token_usage
+				WHERE tenant_id = $1 AND job_type = $2
+				ORDER BY created_at DESC
+				LIMIT $3
+			) recent
+		`
+		var avg int64
+		if err := tx.QueryRowContext(ctx, query, tenantID, jobType.String(), sampleSize).Scan(&avg); err != nil {
+			return 0, fmt.Errorf("failed to get average job tokens: %w", err)
+		}
+		return avg, nil
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 )
 
@@ -192,6 +193,95 @@ func (p *RedisPubSub) SubscribeUserEvents(ctx context.Context, userID uuid.UUID)
 	return eventCh, cleanup, nil
 }
 
+// jobChannel returns the Redis channel name for a generation job's events.
+func jobChannel(jobID uuid.UUID) string {
+	return fmt.Sprintf("events:job:%s", jobID.String())
+}
+
+// PublishJobEvent publishes a generation job event to the job's channel,
+// and to its parent job's channel for child jobs.
+func (p *RedisPubSub) PublishJobEvent(ctx context.Context, event *entity.GenerationJobEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job event: %w", err)
+	}
+
+	channels := []string{jobChannel(event.Job.ID)}
+	if event.Job.ParentJobID != nil {
+		channels = append(channels, jobChannel(*event.Job.ParentJobID))
+	}
+	for _, channel := range channels {
+		if err := p.client.Publish(ctx, channel, data).Err(); err != nil {
+			return fmt.Errorf("failed to publish job event: %w", err)
+		}
+	}
+
+	p.logger.Debug("published job event",
+		"channels", channels,
+		"event_type", event.Type.String(),
+	)
+
+	return nil
+}
+
+// SubscribeJobEvents subscribes to a generation job's events, including
+// those of its child jobs.
+// Returns a channel that receives events, a cleanup function, and an error.
+func (p *RedisPubSub) SubscribeJobEvents(ctx context.Context, jobID uuid.UUID) (<-chan *entity.GenerationJobEvent, func(), error) {
+	channel := jobChannel(jobID)
+
+	pubsub := p.client.Subscribe(ctx, channel)
+
+	// Verify subscription is active
+	_, err := pubsub.Receive(ctx)
+	if err != nil {
+		pubsub.Close()
+		return nil, nil, fmt.Errorf("failed to subscribe to channel %s: %w", channel, err)
+	}
+
+	eventCh := make(chan *entity.GenerationJobEvent, 32)
+
+	// Goroutine to forward messages to the event channel
+	go func() {
+		defer close(eventCh)
+
+		msgCh := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-msgCh:
+				if !ok {
+					return
+				}
+
+				var event entity.GenerationJobEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil || event.Job == nil {
+					p.logger.Error("failed to unmarshal job event",
+						"error", err,
+						"channel", channel,
+					)
+					continue
+				}
+
+				select {
+				case eventCh <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	cleanup := func() {
+		pubsub.Close()
+	}
+
+	p.logger.Debug("subscribed to job events", "channel", channel)
+
+	return eventCh, cleanup, nil
+}
+
 // Close closes the Redis connection.
 func (p *RedisPubSub) Close() error {
 	return p.client.Close()
@@ -216,3 +306,15 @@ func (p *NoOpPubSub) SubscribeUserEvents(ctx context.Context, userID uuid.UUID)
 	close(ch)
 	return ch, func() {}, nil
 }
+
+// PublishJobEvent does nothing.
+func (p *NoOpPubSub) PublishJobEvent(ctx context.Context, event *entity.GenerationJobEvent) error {
+	return nil
+}
+
+// SubscribeJobEvents returns a closed channel (no events will be received).
+func (p *NoOpPubSub) SubscribeJobEvents(ctx context.Context, jobID uuid.UUID) (<-chan *entity.GenerationJobEvent, func(), error) {
+	ch := make(chan *entity.GenerationJobEvent)
+	close(ch)
+	return ch, func() {}, nil
+}
@@ -8,11 +8,19 @@ import (
 	"github.com/google/uuid"
 )
 
+// TenantAccessChecker reports whether a tenant may still use the platform.
+type TenantAccessChecker interface {
+	// CheckTenantActive returns an error if the tenant is suspended.
+	CheckTenantActive(ctx context.Context, tenantID uuid.UUID) error
+}
+
 // TenantAwareStorage wraps a StorageAdapter with tenant-prefixed paths.
 // It ensures storage isolation between tenants by prefixing all paths
 // with the tenant ID: tenants/{tenant_id}/...
+// Presigned URLs are not issued to suspended tenants once an access checker is set.
 type TenantAwareStorage struct {
-	inner StorageAdapter
+	inner  StorageAdapter
+	access TenantAccessChecker
 }
 
 // NewTenantAwareStorage creates a new TenantAwareStorage wrapping the given adapter.
@@ -20,6 +28,11 @@ func NewTenantAwareStorage(inner StorageAdapter) *TenantAwareStorage {
 	return &TenantAwareStorage{inner: inner}
 }
 
+// SetAccessChecker sets the checker that keeps presigned URLs from suspended tenants.
+func (s *TenantAwareStorage) SetAccessChecker(access TenantAccessChecker) {
+	s.access = access
+}
+
 // BuildPath creates a tenant-prefixed path.
 // Example: BuildPath(tenantID, "courses/123/content.json") -> "tenants/{tenant_id}/courses/123/content.json"
 func (s *TenantAwareStorage) BuildPath(tenantID uuid.UUID, subpath string) string {
@@ -73,6 +86,16 @@ func (s *TenantAwareStorage) DeleteExport(ctx context.Context, tenantID, exportI
 	return s.inner.Delete(ctx, s.ExportPath(tenantID, exportID, filename))
 }
 
+// WriteExportFile writes a binary export artifact (PDF, ZIP, etc.) to S3.
+func (s *TenantAwareStorage) WriteExportFile(ctx context.Context, tenantID, exportID uuid.UUID, filename string, content []byte, contentType string) error {
+	return s.inner.PutContent(ctx, s.ExportPath(tenantID, exportID, filename), content, contentType)
+}
+
+// GenerateExportDownloadURL generates a presigned URL for downloading an export file.
+func (s *TenantAwareStorage) GenerateExportDownloadURL(ctx context.Context, tenantID, exportID uuid.UUID, filename string, expiry time.Duration) (string, error) {
+	return s.GenerateDownloadURL(ctx, tenantID, path.Join("exports", exportID.String(), filename), expiry)
+}
+
 // Inner returns the underlying StorageAdapter for cases where
 // direct access is needed (e.g., binary file uploads).
 func (s *TenantAwareStorage) Inner() StorageAdapter {
@@ -81,16 +104,30 @@ func (s *TenantAwareStorage) Inner() StorageAdapter {
 
 // GenerateUploadURL generates a presigned URL for tenant-scoped uploads.
 func (s *TenantAwareStorage) GenerateUploadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error) {
+	if err := s.checkAccess(ctx, tenantID); err != nil {
+		return "", err
+	}
 	fullPath := s.BuildPath(tenantID, subpath)
 	return s.inner.GenerateUploadURL(ctx, fullPath, expiry)
 }
 
 // GenerateDownloadURL generates a presigned URL for tenant-scoped downloads.
 func (s *TenantAwareStorage) GenerateDownloadURL(ctx context.Context, tenantID uuid.UUID, subpath string, expiry time.Duration) (string, error) {
+	if err := s.checkAccess(ctx, tenantID); err != nil {
+		return "", err
+	}
 	fullPath := s.BuildPath(tenantID, subpath)
 	return s.inner.GenerateDownloadURL(ctx, fullPath, expiry)
 }
 
+// checkAccess returns an error if presigned URLs may not be issued to the tenant.
+func (s *TenantAwareStorage) checkAccess(ctx context.Context, tenantID uuid.UUID) error {
+	if s.access == nil {
+		return nil
+	}
+	return s.access.CheckTenantActive(ctx, tenantID)
+}
+
 // GetContent retrieves raw file content from storage.
 // Implements ContentStorage interface for SMEIngestionService.
 func (s *TenantAwareStorage) GetContent(ctx context.Context, path string) ([]byte, error) {
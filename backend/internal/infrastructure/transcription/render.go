@@ -0,0 +1,37 @@
+package transcription
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// paragraphLength is how much speech is grouped under one [Time M:SS] marker.
+// Speech recognizers emit segments of a few seconds; marking each one would
+// bury the text in markers without making citations more useful.
+const paragraphLength = 30 * time.Second
+
+// Render joins transcript segments into paragraphs, each starting with a
+// [Time M:SS] marker for the position of its first segment.
+func Render(segments []service.TranscriptSegment) string {
+	var sb strings.Builder
+	var paragraphStart time.Duration
+
+	for i, seg := range segments {
+		if i == 0 || seg.Start-paragraphStart >= paragraphLength {
+			if i > 0 {
+				sb.WriteString("\n\n")
+			}
+			paragraphStart = seg.Start
+			fmt.Fprintf(&sb, "[Time %s] ", entity.FormatTimestamp(int32(seg.Start/time.Second)))
+		} else {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(seg.Text)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,72 @@
+package transcription
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+func seg(start time.Duration, text string) service.TranscriptSegment {
+	return service.TranscriptSegment{Start: start, End: start + 4*time.Second, Text: text}
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []service.TranscriptSegment
+		want     string
+	}{
+		{"empty", nil, ""},
+		{"one segment", []service.TranscriptSegment{seg(2500*time.Millisecond, "Welcome.")}, "[Time 0:02] Welcome."},
+		{
+			name: "grouped into paragraphs",
+			segments: []service.TranscriptSegment{
+				seg(0, "Welcome."),
+				seg(5*time.Second, "Today: pH."),
+				seg(29*time.Second, "Test strips first."),
+				seg(30*time.Second, "Then chlorine."),
+				seg(61*time.Second, "Questions?"),
+			},
+			want: "[Time 0:00] Welcome. Today: pH. Test strips first.\n\n[Time 0:30] Then chlorine.\n\n[Time 1:01] Questions?",
+		},
+		{
+			// A paragraph starts at its first segment, not on a 30 second grid
+			name: "paragraph after a pause",
+			segments: []service.TranscriptSegment{
+				seg(10*time.Second, "Before the break."),
+				seg(95*time.Second, "After the break."),
+				seg(120*time.Second, "Same paragraph."),
+			},
+			want: "[Time 0:10] Before the break.\n\n[Time 1:35] After the break. Same paragraph.",
+		},
+		{
+			name:     "hours",
+			segments: []service.TranscriptSegment{seg(time.Hour+2*time.Minute+3*time.Second, "Late.")},
+			want:     "[Time 1:02:03] Late.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Render(tt.segments)
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderedMarkersBoundTimestamps(t *testing.T) {
+	segments := []service.TranscriptSegment{
+		seg(0, "Welcome."),
+		seg(45*time.Second, "Chlorine."),
+		seg(time.Hour+5*time.Second, "Wrap-up."),
+	}
+	if got := entity.LastTimeMarker(Render(segments)); got != 3605 {
+		t.Errorf("LastTimeMarker() = %d, want 3605", got)
+	}
+	if got := entity.LastTimeMarker("A document without markers, [Time] or [Time 1:2]."); got != -1 {
+		t.Errorf("LastTimeMarker() without markers = %d, want -1", got)
+	}
+}
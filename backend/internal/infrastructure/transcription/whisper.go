@@ -0,0 +1,170 @@
+// Package transcription implements service.Transcriber for SME audio and
+// video submissions.
+package transcription
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// maxStderr bounds how much subprocess output is included in errors.
+const maxStderr = 2000
+
+// inputDemuxers are the audio and video containers ffmpeg may open.
+// Playlists and other formats that make ffmpeg fetch further files or URLs
+// are left out.
+const inputDemuxers = "mov,matroska,webm,avi,asf,flv,mpeg,mpegts,ogg,mp3,wav,flac,aac,aiff,amr,caf,w64"
+
+// WhisperConfig configures the whisper.cpp transcriber.
+type WhisperConfig struct {
+	BinaryPath string // whisper.cpp CLI, e.g. "whisper-cli"
+	ModelPath  string // ggml model file, e.g. "/models/ggml-base.en.bin"
+	FFmpegPath string // Used to convert uploads to the 16 kHz mono WAV whisper.cpp expects
+	Language   string // Spoken language code, or "auto" to detect
+	Threads    int    // Inference threads; 0 uses the whisper.cpp default
+}
+
+// WhisperTranscriber implements service.Transcriber by running whisper.cpp
+// as a subprocess, so recordings never leave the server.
+type WhisperTranscriber struct {
+	cfg WhisperConfig
+}
+
+// NewWhisperTranscriber creates a transcriber that runs the configured
+// whisper.cpp binary and model. Empty binary paths default to the names on PATH.
+func NewWhisperTranscriber(cfg WhisperConfig) *WhisperTranscriber {
+	if cfg.BinaryPath == "" {
+		cfg.BinaryPath = "whisper-cli"
+	}
+	if cfg.FFmpegPath == "" {
+		cfg.FFmpegPath = "ffmpeg"
+	}
+	if cfg.Language == "" {
+		cfg.Language = "auto"
+	}
+	return &WhisperTranscriber{cfg: cfg}
+}
+
+// whisperOutput is the JSON file written by whisper.cpp's -oj flag.
+type whisperOutput struct {
+	Transcription []struct {
+		Offsets struct {
+			From int64 `json:"from"` // Milliseconds
+			To   int64 `json:"to"`
+		} `json:"offsets"`
+		Text string `json:"text"`
+	} `json:"transcription"`
+}
+
+// Transcribe converts the recording to WAV with ffmpeg, runs whisper.cpp on
+// it and returns the timestamped transcript.
+func (t *WhisperTranscriber) Transcribe(ctx context.Context, fileName string, content []byte) (*service.Transcript, error) {
+	dir, err := os.MkdirTemp("", "mirai-transcribe-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "input"+filepath.Ext(fileName))
+	if err := os.WriteFile(input, content, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write recording: %w", err)
+	}
+
+	// whisper.cpp only reads 16 kHz mono PCM; ffmpeg also strips video tracks.
+	// The recording is untrusted, so ffmpeg may only read local files with
+	// audio and video demuxers, never follow references to other URLs.
+	wav := filepath.Join(dir, "audio.wav")
+	if err := run(ctx, t.cfg.FFmpegPath,
+		"-nostdin", "-hide_banner", "-loglevel", "error",
+		"-protocol_whitelist", "file,pipe",
+		"-format_whitelist", inputDemuxers,
+		"-i", input,
+		"-vn", "-ar", "16000", "-ac", "1", "-c:a", "pcm_s16le",
+		wav,
+	); err != nil {
+		return nil, fmt.Errorf("failed to convert recording: %w", err)
+	}
+
+	outPrefix := filepath.Join(dir, "transcript")
+	args := []string{
+		"-m", t.cfg.ModelPath,
+		"-f", wav,
+		"-l", t.cfg.Language,
+		"-oj", "-of", outPrefix,
+		"-np",
+	}
+	if t.cfg.Threads > 0 {
+		args = append(args, "-t", strconv.Itoa(t.cfg.Threads))
+	}
+	if err := run(ctx, t.cfg.BinaryPath, args...); err != nil {
+		return nil, fmt.Errorf("whisper.cpp failed: %w", err)
+	}
+
+	data, err := os.ReadFile(outPrefix + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transcript: %w", err)
+	}
+	segments, err := parseWhisperOutput(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &service.Transcript{
+		Segments: segments,
+		Text:     Render(segments),
+	}, nil
+}
+
+// parseWhisperOutput reads the segments of a whisper.cpp JSON transcript,
+// skipping those without text. Offsets are clamped so no segment starts
+// before the recording or ends before it starts.
+func parseWhisperOutput(data []byte) ([]service.TranscriptSegment, error) {
+	var out whisperOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse transcript: %w", err)
+	}
+
+	segments := make([]service.TranscriptSegment, 0, len(out.Transcription))
+	for _, seg := range out.Transcription {
+		text := strings.TrimSpace(seg.Text)
+		if text == "" {
+			continue
+		}
+		start := time.Duration(max(seg.Offsets.From, 0)) * time.Millisecond
+		end := max(time.Duration(seg.Offsets.To)*time.Millisecond, start)
+		segments = append(segments, service.TranscriptSegment{
+			Start: start,
+			End:   end,
+			Text:  text,
+		})
+	}
+	return segments, nil
+}
+
+// run executes a command, including the tail of its stderr in any error.
+func run(ctx context.Context, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxStderr {
+			msg = "…" + msg[len(msg)-maxStderr:]
+		}
+		if msg == "" {
+			return err
+		}
+		return fmt.Errorf("%w: %s", err, msg)
+	}
+	return nil
+}
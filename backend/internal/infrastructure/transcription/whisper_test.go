@@ -0,0 +1,86 @@
+package transcription
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+func TestParseWhisperOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    string
+		want    []service.TranscriptSegment
+		wantErr string
+	}{
+		{
+			name: "segments",
+			json: `{
+				"systeminfo": "AVX = 1",
+				"model": {"type": "base"},
+				"result": {"language": "en"},
+				"transcription": [
+					{"timestamps": {"from": "00:00:00,000", "to": "00:00:04,200"}, "offsets": {"from": 0, "to": 4200}, "text": " Welcome to pool care."},
+					{"timestamps": {"from": "00:00:04,200", "to": "00:00:09,000"}, "offsets": {"from": 4200, "to": 9000}, "text": " Keep the pH between 7.2 and 7.8. "}
+				]
+			}`,
+			want: []service.TranscriptSegment{
+				{Start: 0, End: 4200 * time.Millisecond, Text: "Welcome to pool care."},
+				{Start: 4200 * time.Millisecond, End: 9 * time.Second, Text: "Keep the pH between 7.2 and 7.8."},
+			},
+		},
+		{
+			name: "silence skipped",
+			json: `{"transcription": [
+				{"offsets": {"from": 0, "to": 2000}, "text": "  "},
+				{"offsets": {"from": 2000, "to": 3000}, "text": " Hello."}
+			]}`,
+			want: []service.TranscriptSegment{{Start: 2 * time.Second, End: 3 * time.Second, Text: "Hello."}},
+		},
+		{
+			name: "offsets clamped",
+			json: `{"transcription": [
+				{"offsets": {"from": -500, "to": 1000}, "text": "Early."},
+				{"offsets": {"from": 5000, "to": 4000}, "text": "Backwards."}
+			]}`,
+			want: []service.TranscriptSegment{
+				{Start: 0, End: time.Second, Text: "Early."},
+				{Start: 5 * time.Second, End: 5 * time.Second, Text: "Backwards."},
+			},
+		},
+		{
+			name: "no speech",
+			json: `{"transcription": []}`,
+			want: []service.TranscriptSegment{},
+		},
+		{
+			name:    "malformed",
+			json:    `{"transcription": [{"offsets": {"from": "0"`,
+			wantErr: "failed to parse transcript",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseWhisperOutput([]byte(tt.json))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseWhisperOutput() error = %v, want one containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseWhisperOutput() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseWhisperOutput() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("segment %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/hibiken/asynq"
 
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 	"github.com/sogos/mirai-backend/internal/domain/worker"
 )
 
@@ -52,9 +53,9 @@ func (c *Client) EnqueueStripeProvision(sessionID, customer, subscriptionID stri
 	return nil
 }
 
-// EnqueueAIGeneration enqueues an AI generation task.
-func (c *Client) EnqueueAIGeneration(jobID, jobType string) error {
-	task, err := worker.NewAIGenerationTask(jobID, jobType)
+// EnqueueAIGeneration enqueues an AI generation task in the lane of the given priority.
+func (c *Client) EnqueueAIGeneration(jobID, jobType string, priority valueobject.GenerationJobPriority) error {
+	task, err := worker.NewAIGenerationTask(jobID, jobType, priority)
 	if err != nil {
 		c.logger.Error("failed to create AI generation task", "error", err)
 		return err
@@ -103,3 +104,28 @@ func (c *Client) EnqueueSMEIngestion(jobID string) error {
 	)
 	return nil
 }
+
+// EnqueueCourseExport enqueues a course export task.
+func (c *Client) EnqueueCourseExport(exportID string) error {
+	task, err := worker.NewCourseExportTask(exportID)
+	if err != nil {
+		c.logger.Error("failed to create course export task", "error", err)
+		return err
+	}
+
+	info, err := c.client.Enqueue(task)
+	if err != nil {
+		c.logger.Error("failed to enqueue course export task",
+			"exportID", exportID,
+			"error", err,
+		)
+		return err
+	}
+
+	c.logger.Info("enqueued course export task",
+		"taskID", info.ID,
+		"queue", info.Queue,
+		"exportID", exportID,
+	)
+	return nil
+}
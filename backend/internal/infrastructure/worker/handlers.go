@@ -10,6 +10,7 @@ import (
 	appservice "github.com/sogos/mirai-backend/internal/application/service"
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/tenant"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 	"github.com/sogos/mirai-backend/internal/domain/worker"
 )
 
@@ -19,6 +20,8 @@ type Handlers struct {
 	cleanupService      *appservice.CleanupService
 	aiGenService        *appservice.AIGenerationService
 	smeIngestionService *appservice.SMEIngestionService
+	exportService       *appservice.ExportService
+	dunningService      *appservice.DunningService
 	workerClient        *Client
 	logger              domainservice.Logger
 }
@@ -29,6 +32,8 @@ func NewHandlers(
 	cleanupService *appservice.CleanupService,
 	aiGenService *appservice.AIGenerationService,
 	smeIngestionService *appservice.SMEIngestionService,
+	exportService *appservice.ExportService,
+	dunningService *appservice.DunningService,
 	workerClient *Client,
 	logger domainservice.Logger,
 ) *Handlers {
@@ -37,6 +42,8 @@ func NewHandlers(
 		cleanupService:      cleanupService,
 		aiGenService:        aiGenService,
 		smeIngestionService: smeIngestionService,
+		exportService:       exportService,
+		dunningService:      dunningService,
 		workerClient:        workerClient,
 		logger:              logger,
 	}
@@ -170,6 +177,7 @@ func (h *Handlers) HandleAIGeneration(ctx context.Context, t *asynq.Task) error
 
 	// Call the AI generation service to process this specific job
 	err := h.aiGenService.ProcessJobByID(ctx, payload.JobID)
+	h.dispatchNextQueuedJob(ctx)
 	if err != nil {
 		log.Error("failed to process AI generation job", "error", err)
 		return err
@@ -195,6 +203,7 @@ func (h *Handlers) HandleSMEIngestion(ctx context.Context, t *asynq.Task) error
 
 	// Call the SME ingestion service to process this specific job
 	err := h.smeIngestionService.ProcessJobByID(ctx, payload.JobID)
+	h.dispatchNextQueuedJob(ctx)
 	if err != nil {
 		log.Error("failed to process SME ingestion job", "error", err)
 		return err
@@ -204,6 +213,58 @@ func (h *Handlers) HandleSMEIngestion(ctx context.Context, t *asynq.Task) error
 	return nil
 }
 
+// dispatchNextQueuedJob enqueues the job fair scheduling picks to run next.
+// Jobs skipped while their tenant was at its concurrency limit only run once
+// another job finishes and hands its slot on this way (or the poll finds them).
+func (h *Handlers) dispatchNextQueuedJob(ctx context.Context) {
+	if h.aiGenService == nil || h.workerClient == nil {
+		return
+	}
+
+	job, err := h.aiGenService.NextQueuedJob(ctx)
+	if err != nil {
+		h.logger.Error("failed to find next queued job", "error", err)
+		return
+	}
+	if job == nil {
+		return
+	}
+
+	// Claims are atomic, so a job that already has a task is not run twice
+	if job.Type == valueobject.GenerationJobTypeSMEIngestion {
+		err = h.workerClient.EnqueueSMEIngestion(job.ID.String())
+	} else {
+		err = h.workerClient.EnqueueAIGeneration(job.ID.String(), job.Type.String(), job.Priority())
+	}
+	if err != nil {
+		h.logger.Error("failed to dispatch next queued job", "jobID", job.ID, "error", err)
+	}
+}
+
+// HandleCourseExport processes a course export task.
+// This is called when a user requests a downloadable export of a course.
+func (h *Handlers) HandleCourseExport(ctx context.Context, t *asynq.Task) error {
+	var payload worker.CourseExportPayload
+	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	log := h.logger.With(
+		"task", worker.TypeCourseExport,
+		"exportID", payload.ExportID,
+	)
+	log.Info("processing course export task")
+
+	err := h.exportService.ProcessExportByID(ctx, payload.ExportID)
+	if err != nil {
+		log.Error("failed to process course export", "error", err)
+		return err
+	}
+
+	log.Info("course export completed")
+	return nil
+}
+
 // HandleAIGenerationPoll processes AI generation jobs by polling the database.
 // This is called periodically by the scheduler.
 func (h *Handlers) HandleAIGenerationPoll(ctx context.Context, t *asynq.Task) error {
@@ -216,6 +277,11 @@ func (h *Handlers) HandleAIGenerationPoll(ctx context.Context, t *asynq.Task) er
 		return nil
 	}
 
+	// Requeue jobs left processing by a worker that stopped (crash recovery)
+	if err := h.aiGenService.RecoverStaleJobs(ctx); err != nil {
+		log.Error("failed to recover stale AI generation jobs", "error", err)
+	}
+
 	// Process next queued job (uses FOR UPDATE SKIP LOCKED in DB)
 	// The service method returns nil if no jobs available
 	err := h.aiGenService.ProcessNextQueuedJob(ctx)
@@ -247,3 +313,55 @@ func (h *Handlers) HandleSMEIngestionPoll(ctx context.Context, t *asynq.Task) er
 
 	return nil
 }
+
+// HandleStaleLessonScan flags generated lessons whose SME knowledge changed.
+// This is called periodically by the scheduler.
+func (h *Handlers) HandleStaleLessonScan(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeStaleLessonScan)
+
+	// Only process if service is available
+	if h.aiGenService == nil {
+		return nil
+	}
+
+	if err := h.aiGenService.DetectStaleLessons(ctx); err != nil {
+		log.Error("failed to detect stale lessons", "error", err)
+		return err
+	}
+
+	log.Debug("stale lesson scan completed")
+	return nil
+}
+
+// HandleDunningScan sends overdue payment reminders and restricts or suspends
+// tenants as their payment stays overdue. This is called periodically by the scheduler.
+func (h *Handlers) HandleDunningScan(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeDunningScan)
+
+	// Only process if service is available
+	if h.dunningService == nil {
+		return nil
+	}
+
+	if err := h.dunningService.ProcessDunning(ctx); err != nil {
+		log.Error("failed to process dunning", "error", err)
+		return err
+	}
+
+	log.Debug("dunning scan completed")
+	return nil
+}
+
+// HandleExportRecovery enqueues course exports a stopped worker left
+// processing again. This is called periodically by the scheduler.
+func (h *Handlers) HandleExportRecovery(ctx context.Context, t *asynq.Task) error {
+	log := h.logger.With("task", worker.TypeExportRecovery)
+
+	if err := h.exportService.RecoverStaleExports(ctx); err != nil {
+		log.Error("failed to recover stale exports", "error", err)
+		return err
+	}
+
+	log.Debug("export recovery completed")
+	return nil
+}
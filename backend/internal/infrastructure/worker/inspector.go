@@ -0,0 +1,124 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hibiken/asynq"
+
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// Inspector wraps the Asynq inspector for reading and managing failed tasks.
+type Inspector struct {
+	inspector *asynq.Inspector
+}
+
+// NewInspector creates a new Asynq inspector wrapper.
+func NewInspector(redisAddr string) *Inspector {
+	return &Inspector{
+		inspector: asynq.NewInspector(asynq.RedisClientOpt{Addr: redisAddr}),
+	}
+}
+
+// Close closes the underlying Asynq inspector connection.
+func (i *Inspector) Close() error {
+	return i.inspector.Close()
+}
+
+// ListFailedTasks lists tasks in the given state, across all queues when no
+// queue is given. Pages apply per queue.
+func (i *Inspector) ListFailedTasks(ctx context.Context, opts entity.BackgroundTaskListOptions) ([]*entity.BackgroundTask, error) {
+	queues := []string{opts.Queue}
+	if opts.Queue == "" {
+		var err error
+		queues, err = i.inspector.Queues()
+		if err != nil {
+			return nil, fmt.Errorf("failed to list queues: %w", err)
+		}
+	}
+
+	listOpts := []asynq.ListOption{asynq.Page(opts.Page), asynq.PageSize(opts.PageSize)}
+
+	var tasks []*entity.BackgroundTask
+	for _, queue := range queues {
+		var infos []*asynq.TaskInfo
+		var err error
+		switch opts.State {
+		case valueobject.BackgroundTaskStateRetry:
+			infos, err = i.inspector.ListRetryTasks(queue, listOpts...)
+		case valueobject.BackgroundTaskStateArchived:
+			infos, err = i.inspector.ListArchivedTasks(queue, listOpts...)
+		default:
+			return nil, fmt.Errorf("unsupported task state: %s", opts.State)
+		}
+		if errors.Is(err, asynq.ErrQueueNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s tasks in queue %s: %w", opts.State, queue, err)
+		}
+		for _, info := range infos {
+			tasks = append(tasks, toBackgroundTask(info))
+		}
+	}
+	return tasks, nil
+}
+
+// GetTask returns a task, or nil if it doesn't exist.
+func (i *Inspector) GetTask(ctx context.Context, queue, id string) (*entity.BackgroundTask, error) {
+	info, err := i.inspector.GetTaskInfo(queue, id)
+	if errors.Is(err, asynq.ErrQueueNotFound) || errors.Is(err, asynq.ErrTaskNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+	return toBackgroundTask(info), nil
+}
+
+// RunTask moves a retry or archived task back to pending so it runs now.
+func (i *Inspector) RunTask(ctx context.Context, queue, id string) error {
+	if err := i.inspector.RunTask(queue, id); err != nil {
+		return fmt.Errorf("failed to run task: %w", err)
+	}
+	return nil
+}
+
+// DeleteTask removes a task from its queue.
+func (i *Inspector) DeleteTask(ctx context.Context, queue, id string) error {
+	if err := i.inspector.DeleteTask(queue, id); err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	return nil
+}
+
+// toBackgroundTask converts an Asynq task to its domain entity.
+func toBackgroundTask(info *asynq.TaskInfo) *entity.BackgroundTask {
+	task := &entity.BackgroundTask{
+		ID:        info.ID,
+		Queue:     info.Queue,
+		Type:      info.Type,
+		Payload:   info.Payload,
+		Retried:   info.Retried,
+		MaxRetry:  info.MaxRetry,
+		LastError: info.LastErr,
+	}
+	// Aggregating tasks wait to be grouped, which is pending as far as callers care
+	state, err := valueobject.ParseBackgroundTaskState(info.State.String())
+	if err != nil {
+		state = valueobject.BackgroundTaskStatePending
+	}
+	task.State = state
+	if !info.LastFailedAt.IsZero() {
+		t := info.LastFailedAt
+		task.LastFailedAt = &t
+	}
+	if info.State == asynq.TaskStateRetry && !info.NextProcessAt.IsZero() {
+		t := info.NextProcessAt
+		task.NextProcessAt = &t
+	}
+	return task
+}
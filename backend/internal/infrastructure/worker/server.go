@@ -26,6 +26,8 @@ func NewServer(
 	cleanupService *appservice.CleanupService,
 	aiGenService *appservice.AIGenerationService,
 	smeIngestionService *appservice.SMEIngestionService,
+	exportService *appservice.ExportService,
+	dunningService *appservice.DunningService,
 	workerClient *Client,
 	logger domainservice.Logger,
 ) *Server {
@@ -37,9 +39,11 @@ func NewServer(
 			Concurrency: 10,
 			// Priority queues - higher number = higher priority
 			Queues: map[string]int{
-				worker.QueueCritical: 6, // Provisioning gets most workers
-				worker.QueueDefault:  3, // AI/SME tasks
-				worker.QueueLow:      1, // Cleanup tasks
+				worker.QueueCritical:    6, // Provisioning gets most workers
+				worker.QueueInteractive: 4, // AI jobs a user is waiting on
+				worker.QueueDefault:     3, // Export and polling tasks
+				worker.QueueBulk:        2, // Full course lessons and SME ingestion
+				worker.QueueLow:         1, // Cleanup tasks
 			},
 			// Log errors
 			ErrorHandler: asynq.ErrorHandlerFunc(func(ctx context.Context, task *asynq.Task, err error) {
@@ -65,6 +69,8 @@ func NewServer(
 		cleanupService,
 		aiGenService,
 		smeIngestionService,
+		exportService,
+		dunningService,
 		workerClient,
 		logger,
 	)
@@ -76,8 +82,12 @@ func NewServer(
 	mux.HandleFunc(worker.TypeCleanupExpired, handlers.HandleCleanupExpired)
 	mux.HandleFunc(worker.TypeAIGeneration, handlers.HandleAIGeneration)
 	mux.HandleFunc(worker.TypeSMEIngestion, handlers.HandleSMEIngestion)
+	mux.HandleFunc(worker.TypeCourseExport, handlers.HandleCourseExport)
 	mux.HandleFunc(worker.TypeAIGenerationPoll, handlers.HandleAIGenerationPoll)
 	mux.HandleFunc(worker.TypeSMEIngestionPoll, handlers.HandleSMEIngestionPoll)
+	mux.HandleFunc(worker.TypeStaleLessonScan, handlers.HandleStaleLessonScan)
+	mux.HandleFunc(worker.TypeDunningScan, handlers.HandleDunningScan)
+	mux.HandleFunc(worker.TypeExportRecovery, handlers.HandleExportRecovery)
 
 	return &Server{
 		server:    server,
@@ -129,6 +139,30 @@ func (s *Server) Run() error {
 	}
 	s.logger.Info("registered SME ingestion poll task", "schedule", "@every 5s")
 
+	// Stale lesson detection every 15 minutes
+	_, err = s.scheduler.Register("@every 15m", worker.NewStaleLessonScanTask())
+	if err != nil {
+		s.logger.Error("failed to register stale lesson scan task", "error", err)
+		return err
+	}
+	s.logger.Info("registered stale lesson scan task", "schedule", "@every 15m")
+
+	// Dunning of past due subscriptions every 1 hour
+	_, err = s.scheduler.Register("@every 1h", worker.NewDunningScanTask())
+	if err != nil {
+		s.logger.Error("failed to register dunning scan task", "error", err)
+		return err
+	}
+	s.logger.Info("registered dunning scan task", "schedule", "@every 1h")
+
+	// Recovery of exports left processing by a stopped worker every 5 minutes
+	_, err = s.scheduler.Register("@every 5m", worker.NewExportRecoveryTask())
+	if err != nil {
+		s.logger.Error("failed to register export recovery task", "error", err)
+		return err
+	}
+	s.logger.Info("registered export recovery task", "schedule", "@every 5m")
+
 	// Start the scheduler in a goroutine
 	go func() {
 		if err := s.scheduler.Run(); err != nil {
@@ -2,6 +2,7 @@ package connect
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -388,14 +389,20 @@ func (s *AIGenerationServiceServer) GetJob(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	job, err := s.aiService.GetJob(ctx, kratosID, jobID)
+	result, err := s.aiService.GetJob(ctx, kratosID, jobID)
 	if err != nil {
 		return nil, toConnectError(err)
 	}
 
-	return connect.NewResponse(&v1.GetJobResponse{
-		Job: generationJobToProto(job),
-	}), nil
+	resp := &v1.GetJobResponse{
+		Job: generationJobToProto(result.Job),
+	}
+	if result.QueuePosition != nil {
+		resp.QueuePosition = result.QueuePosition.Position
+		resp.QueueDepth = result.QueuePosition.Depth
+	}
+
+	return connect.NewResponse(resp), nil
 }
 
 // ListJobs returns generation jobs for the current user.
@@ -501,8 +508,14 @@ func (s *AIGenerationServiceServer) GetGeneratedLesson(
 		return nil, toConnectError(err)
 	}
 
+	sources := make([]*v1.ComponentSource, len(lesson.Sources))
+	for i := range lesson.Sources {
+		sources[i] = lessonSourceToProto(&lesson.Sources[i])
+	}
+
 	return connect.NewResponse(&v1.GetGeneratedLessonResponse{
-		Lesson: generatedLessonToProto(lesson),
+		Lesson:  generatedLessonToProto(lesson),
+		Sources: sources,
 	}), nil
 }
 
@@ -541,8 +554,199 @@ func (s *AIGenerationServiceServer) ListGeneratedLessons(
 	}), nil
 }
 
+// ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+func (s *AIGenerationServiceServer) ListStaleLessons(
+	ctx context.Context,
+	req *connect.Request[v1.ListStaleLessonsRequest],
+) (*connect.Response[v1.ListStaleLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lessons, err := s.aiService.ListStaleLessons(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoLessons := make([]*v1.GeneratedLesson, len(lessons))
+	for i, lesson := range lessons {
+		protoLessons[i] = generatedLessonToProto(lesson)
+	}
+
+	return connect.NewResponse(&v1.ListStaleLessonsResponse{
+		Lessons: protoLessons,
+	}), nil
+}
+
+// RegenerateStaleLessons regenerates all stale lessons of a course.
+func (s *AIGenerationServiceServer) RegenerateStaleLessons(
+	ctx context.Context,
+	req *connect.Request[v1.RegenerateStaleLessonsRequest],
+) (*connect.Response[v1.RegenerateStaleLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := s.aiService.RegenerateStaleLessons(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RegenerateStaleLessonsResponse{
+		Job: generationJobToProto(result.Job),
+	}), nil
+}
+
+// ResumeJob continues a failed course generation job.
+func (s *AIGenerationServiceServer) ResumeJob(
+	ctx context.Context,
+	req *connect.Request[v1.ResumeJobRequest],
+) (*connect.Response[v1.ResumeJobResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	jobID, err := parseUUID(req.Msg.JobId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	job, err := s.aiService.ResumeJob(ctx, kratosID, jobID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ResumeJobResponse{
+		Job: generationJobToProto(job),
+	}), nil
+}
+
+// WatchJob streams a job's progress, its finished sections and lessons, and
+// its final status. The stream ends once the job completes, fails or is cancelled.
+func (s *AIGenerationServiceServer) WatchJob(
+	ctx context.Context,
+	req *connect.Request[v1.WatchJobRequest],
+	stream *connect.ServerStream[v1.WatchJobResponse],
+) error {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	jobID, err := parseUUID(req.Msg.JobId)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	watch, err := s.aiService.WatchJob(ctx, kratosID, jobID)
+	if err != nil {
+		return toConnectError(err)
+	}
+	defer watch.Close()
+
+	// Send the current state first so late watchers catch up
+	done := false
+	for _, event := range watch.Snapshot {
+		if err := stream.Send(generationJobEventToProto(event)); err != nil {
+			return err
+		}
+		if event.Job.ID == jobID && event.Job.Status.IsTerminal() {
+			done = true
+		}
+	}
+	if done {
+		return nil
+	}
+
+	// Heartbeat ticker to keep connection alive through Cloudflare/proxy timeouts
+	heartbeat := time.NewTicker(15 * time.Second)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Client disconnected or context cancelled
+			return nil
+		case <-heartbeat.C:
+			resp := &v1.WatchJobResponse{
+				EventType: v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_KEEPALIVE,
+			}
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case event, ok := <-watch.Events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(generationJobEventToProto(event)); err != nil {
+				return err
+			}
+			if event.Type == valueobject.GenerationJobEventStatus && event.Job.ID == jobID && event.Job.Status.IsTerminal() {
+				return nil
+			}
+		}
+	}
+}
+
 // Helper functions for proto conversion
 
+func generationJobEventToProto(event *entity.GenerationJobEvent) *v1.WatchJobResponse {
+	return &v1.WatchJobResponse{
+		EventType: generationJobEventTypeToProto(event.Type),
+		Job:       generationJobToProto(event.Job),
+		Section:   outlineSectionToProto(event.Section),
+		Lesson:    generatedLessonToProto(event.Lesson),
+	}
+}
+
+func generationJobEventTypeToProto(t valueobject.GenerationJobEventType) v1.GenerationJobEventType {
+	switch t {
+	case valueobject.GenerationJobEventProgress:
+		return v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_PROGRESS
+	case valueobject.GenerationJobEventSectionReady:
+		return v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_SECTION_READY
+	case valueobject.GenerationJobEventLessonReady:
+		return v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_LESSON_READY
+	case valueobject.GenerationJobEventStatus:
+		return v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_STATUS
+	default:
+		return v1.GenerationJobEventType_GENERATION_JOB_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
 func generationJobToProto(job *entity.GenerationJob) *v1.GenerationJob {
 	if job == nil {
 		return nil
@@ -590,6 +794,9 @@ func generationJobToProto(job *entity.GenerationJob) *v1.GenerationJob {
 	if job.CompletedAt != nil {
 		proto.CompletedAt = timestamppb.New(*job.CompletedAt)
 	}
+	if len(job.RetrievedChunkIDs) > 0 {
+		proto.RetrievedChunkIds = uuidsToStrings(job.RetrievedChunkIDs)
+	}
 
 	return proto
 }
@@ -679,6 +886,10 @@ func generatedLessonToProto(lesson *entity.GeneratedLesson) *v1.GeneratedLesson
 		Title:           lesson.Title,
 		SegueText:       lesson.SegueText,
 		GeneratedAt:     timestamppb.New(lesson.GeneratedAt),
+		StaleReason:     lesson.StaleReason,
+	}
+	if lesson.StaleSince != nil {
+		proto.StaleSince = timestamppb.New(*lesson.StaleSince)
 	}
 
 	proto.Components = make([]*v1.LessonComponent, len(lesson.Components))
@@ -711,6 +922,24 @@ func lessonComponentToProto(comp *entity.LessonComponent) *v1.LessonComponent {
 	return proto
 }
 
+func lessonSourceToProto(source *entity.LessonSource) *v1.ComponentSource {
+	proto := &v1.ComponentSource{
+		ChunkId:                source.ChunkID.String(),
+		SmeId:                  source.SMEID.String(),
+		Topic:                  source.Topic,
+		FileName:               source.FileName,
+		SourceHeading:          source.SourceHeading,
+		SourcePage:             source.SourcePage,
+		SourceTimestampSeconds: source.SourceTimestamp,
+		Outdated:               source.Outdated,
+	}
+	if source.SubmissionID != nil {
+		submissionID := source.SubmissionID.String()
+		proto.SubmissionId = &submissionID
+	}
+	return proto
+}
+
 func uuidsToStrings(ids []uuid.UUID) []string {
 	if ids == nil {
 		return nil
@@ -732,6 +961,10 @@ func generationJobTypeToProto(t valueobject.GenerationJobType) v1.GenerationJobT
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_LESSON_CONTENT
 	case valueobject.GenerationJobTypeComponentRegen:
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN
+	case valueobject.GenerationJobTypeFullCourse:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE
+	case valueobject.GenerationJobTypeStaleRegen:
+		return v1.GenerationJobType_GENERATION_JOB_TYPE_STALE_LESSON_REGEN
 	default:
 		return v1.GenerationJobType_GENERATION_JOB_TYPE_UNSPECIFIED
 	}
@@ -747,6 +980,10 @@ func protoToGenerationJobType(t v1.GenerationJobType) valueobject.GenerationJobT
 		return valueobject.GenerationJobTypeLessonContent
 	case v1.GenerationJobType_GENERATION_JOB_TYPE_COMPONENT_REGEN:
 		return valueobject.GenerationJobTypeComponentRegen
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_FULL_COURSE:
+		return valueobject.GenerationJobTypeFullCourse
+	case v1.GenerationJobType_GENERATION_JOB_TYPE_STALE_LESSON_REGEN:
+		return valueobject.GenerationJobTypeStaleRegen
 	default:
 		return valueobject.GenerationJobTypeSMEIngestion
 	}
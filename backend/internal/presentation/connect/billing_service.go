@@ -2,12 +2,16 @@ package connect
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/dto"
 	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // BillingServiceServer implements the BillingService Connect handler.
@@ -41,18 +45,7 @@ func (s *BillingServiceServer) GetBillingInfo(
 		return nil, toConnectError(err)
 	}
 
-	resp := &v1.GetBillingInfoResponse{
-		Plan:              planToProto(info.Plan),
-		Status:            subscriptionStatusToProto(info.Status),
-		SeatCount:         int32(info.SeatCount),
-		PricePerSeat:      int32(info.PricePerSeat),
-		CancelAtPeriodEnd: info.CancelAtPeriodEnd,
-	}
-	if info.CurrentPeriodEnd != nil {
-		resp.CurrentPeriodEnd = info.CurrentPeriodEnd
-	}
-
-	return connect.NewResponse(resp), nil
+	return connect.NewResponse(billingInfoToProto(info)), nil
 }
 
 // CreateCheckoutSession creates a Stripe Checkout session for plan upgrade.
@@ -107,3 +100,129 @@ func (s *BillingServiceServer) CreatePortalSession(
 		Url: result.URL,
 	}), nil
 }
+
+// PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+func (s *BillingServiceServer) PreviewSubscriptionChange(
+	ctx context.Context,
+	req *connect.Request[v1.PreviewSubscriptionChangeRequest],
+) (*connect.Response[v1.PreviewSubscriptionChangeResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	preview, err := s.billingService.PreviewSubscriptionChange(ctx, kratosID, requestedPlan(req.Msg.Plan), int(req.Msg.SeatCount))
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.PreviewSubscriptionChangeResponse{
+		CurrentPlan:       planToProto(preview.CurrentPlan),
+		CurrentSeatCount:  int32(preview.CurrentSeatCount),
+		Plan:              planToProto(preview.Plan),
+		SeatCount:         int32(preview.SeatCount),
+		ProratedAmount:    preview.ProratedAmount,
+		NextInvoiceAmount: preview.NextInvoiceAmount,
+		NextInvoiceAt:     preview.NextInvoiceAt,
+		Currency:          preview.Currency,
+		ProrationDate:     preview.ProrationDate,
+	}), nil
+}
+
+// ChangeSubscription changes the plan or seat count of the existing subscription.
+func (s *BillingServiceServer) ChangeSubscription(
+	ctx context.Context,
+	req *connect.Request[v1.ChangeSubscriptionRequest],
+) (*connect.Response[v1.ChangeSubscriptionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	var prorationDate time.Time
+	if req.Msg.ProrationDate != nil {
+		prorationDate = time.Unix(*req.Msg.ProrationDate, 0)
+	}
+
+	info, err := s.billingService.ChangeSubscription(ctx, kratosID, requestedPlan(req.Msg.Plan), int(req.Msg.SeatCount), prorationDate)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ChangeSubscriptionResponse{
+		BillingInfo: billingInfoToProto(info),
+	}), nil
+}
+
+// GetEntitlements returns what the company's plan includes and how much of it is used.
+func (s *BillingServiceServer) GetEntitlements(
+	ctx context.Context,
+	req *connect.Request[v1.GetEntitlementsRequest],
+) (*connect.Response[v1.GetEntitlementsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	result, err := s.billingService.GetEntitlements(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetEntitlementsResponse{
+		Entitlements: entitlementsToProto(result.Entitlements),
+		SmeCount:     int32(result.SMECount),
+		CourseCount:  int32(result.CourseCount),
+	}), nil
+}
+
+func entitlementsToProto(e entity.Entitlements) *v1.Entitlements {
+	proto := &v1.Entitlements{
+		Plan:            planToProto(e.Plan),
+		MaxSmes:         int32(e.MaxSMEs),
+		MaxCourses:      int32(e.MaxCourses),
+		MonthlyAiTokens: e.MonthlyAITokens,
+		Sso:             e.SSO,
+		CustomBranding:  e.CustomBranding,
+	}
+	for _, format := range e.ExportFormats {
+		proto.ExportFormats = append(proto.ExportFormats, exportFormatToProto(format))
+	}
+	return proto
+}
+
+func billingInfoToProto(info *dto.BillingInfoResponse) *v1.GetBillingInfoResponse {
+	return &v1.GetBillingInfoResponse{
+		Plan:              planToProto(info.Plan),
+		Status:            subscriptionStatusToProto(info.Status),
+		SeatCount:         int32(info.SeatCount),
+		PricePerSeat:      int32(info.PricePerSeat),
+		CurrentPeriodEnd:  info.CurrentPeriodEnd,
+		CancelAtPeriodEnd: info.CancelAtPeriodEnd,
+		BillingEmail:      info.BillingEmail,
+	}
+}
+
+// requestedPlan converts an optional plan in a request, keeping the current
+// plan when it is unspecified.
+func requestedPlan(p v1.Plan) valueobject.Plan {
+	if p == v1.Plan_PLAN_UNSPECIFIED {
+		return ""
+	}
+	return planFromProto(p)
+}
@@ -10,17 +10,22 @@ import (
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
 	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
 // CourseServiceServer implements the CourseService Connect handler.
 type CourseServiceServer struct {
 	miraiv1connect.UnimplementedCourseServiceHandler
 	courseService *service.CourseService
+	exportService *service.ExportService
 }
 
 // NewCourseServiceServer creates a new CourseServiceServer.
-func NewCourseServiceServer(courseService *service.CourseService) *CourseServiceServer {
-	return &CourseServiceServer{courseService: courseService}
+func NewCourseServiceServer(courseService *service.CourseService, exportService *service.ExportService) *CourseServiceServer {
+	return &CourseServiceServer{
+		courseService: courseService,
+		exportService: exportService,
+	}
 }
 
 // ListCourses returns a filtered list of courses.
@@ -306,6 +311,137 @@ func (s *CourseServiceServer) DeleteFolder(
 	}), nil
 }
 
+// ExportCourse starts rendering a course into a downloadable package.
+func (s *CourseServiceServer) ExportCourse(
+	ctx context.Context,
+	req *connect.Request[v1.ExportCourseRequest],
+) (*connect.Response[v1.ExportCourseResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	format, ok := exportFormatFromProto(req.Msg.Format)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errFormatRequired)
+	}
+
+	export, err := s.exportService.ExportCourse(ctx, kratosID, courseID, format)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ExportCourseResponse{
+		Export: courseExportToProto(export),
+	}), nil
+}
+
+// GetExportStatus returns the current state of an export.
+func (s *CourseServiceServer) GetExportStatus(
+	ctx context.Context,
+	req *connect.Request[v1.GetExportStatusRequest],
+) (*connect.Response[v1.GetExportStatusResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	exportID, err := parseUUID(req.Msg.ExportId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	export, err := s.exportService.GetExport(ctx, kratosID, exportID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetExportStatusResponse{
+		Export: courseExportToProto(export),
+	}), nil
+}
+
+// DownloadExport returns a short-lived presigned URL for a completed export.
+func (s *CourseServiceServer) DownloadExport(
+	ctx context.Context,
+	req *connect.Request[v1.DownloadExportRequest],
+) (*connect.Response[v1.DownloadExportResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	exportID, err := parseUUID(req.Msg.ExportId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	url, expiresAt, err := s.exportService.GetDownloadURL(ctx, kratosID, exportID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DownloadExportResponse{
+		DownloadUrl: url,
+		ExpiresAt:   timestamppb.New(expiresAt),
+	}), nil
+}
+
+// ListExports returns all exports for a course, newest first.
+func (s *CourseServiceServer) ListExports(
+	ctx context.Context,
+	req *connect.Request[v1.ListExportsRequest],
+) (*connect.Response[v1.ListExportsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	courseID, err := parseUUID(req.Msg.CourseId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	exports, err := s.exportService.ListExports(ctx, kratosID, courseID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.ListExportsResponse{
+		Exports: make([]*v1.CourseExport, len(exports)),
+	}
+	for i, e := range exports {
+		resp.Exports[i] = courseExportToProto(e)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
 // Conversion helpers
 
 func courseStatusToProto(s service.CourseStatus) v1.CourseStatus {
@@ -609,3 +745,63 @@ func anySlice(s []map[string]any) []any {
 	}
 	return result
 }
+
+func exportFormatToProto(f valueobject.ExportFormat) v1.ExportFormat {
+	switch f {
+	case valueobject.ExportFormatSCORM12:
+		return v1.ExportFormat_EXPORT_FORMAT_SCORM_12
+	case valueobject.ExportFormatSCORM2004:
+		return v1.ExportFormat_EXPORT_FORMAT_SCORM_2004
+	case valueobject.ExportFormatXAPI:
+		return v1.ExportFormat_EXPORT_FORMAT_XAPI
+	case valueobject.ExportFormatPDF:
+		return v1.ExportFormat_EXPORT_FORMAT_PDF
+	default:
+		return v1.ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func exportFormatFromProto(f v1.ExportFormat) (valueobject.ExportFormat, bool) {
+	switch f {
+	case v1.ExportFormat_EXPORT_FORMAT_SCORM_12:
+		return valueobject.ExportFormatSCORM12, true
+	case v1.ExportFormat_EXPORT_FORMAT_SCORM_2004:
+		return valueobject.ExportFormatSCORM2004, true
+	case v1.ExportFormat_EXPORT_FORMAT_XAPI:
+		return valueobject.ExportFormatXAPI, true
+	case v1.ExportFormat_EXPORT_FORMAT_PDF:
+		return valueobject.ExportFormatPDF, true
+	default:
+		return "", false
+	}
+}
+
+func exportStatusToProto(s valueobject.ExportStatus) v1.ExportStatus {
+	switch s {
+	case valueobject.ExportStatusPending:
+		return v1.ExportStatus_EXPORT_STATUS_PENDING
+	case valueobject.ExportStatusProcessing:
+		return v1.ExportStatus_EXPORT_STATUS_PROCESSING
+	case valueobject.ExportStatusCompleted:
+		return v1.ExportStatus_EXPORT_STATUS_COMPLETED
+	case valueobject.ExportStatusFailed:
+		return v1.ExportStatus_EXPORT_STATUS_FAILED
+	default:
+		return v1.ExportStatus_EXPORT_STATUS_UNSPECIFIED
+	}
+}
+
+func courseExportToProto(e *entity.CourseExport) *v1.CourseExport {
+	export := &v1.CourseExport{
+		Id:           e.ID.String(),
+		Timestamp:    timestamppb.New(e.CreatedAt),
+		Format:       exportFormatToProto(e.Format),
+		Version:      e.Version,
+		Status:       exportStatusToProto(e.Status),
+		ErrorMessage: e.ErrorMessage,
+	}
+	if e.FileName != nil {
+		export.FilePath = *e.FileName
+	}
+	return export
+}
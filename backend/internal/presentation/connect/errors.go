@@ -16,6 +16,7 @@ var (
 	errMissingToken     = errors.New("token is required")
 	errUnauthenticated  = errors.New("authentication required")
 	errForbidden        = errors.New("permission denied")
+	errFormatRequired   = errors.New("export format is required")
 )
 
 // toConnectError converts domain errors to Connect errors with appropriate codes.
@@ -38,6 +39,10 @@ func toConnectError(err error) error {
 			return connect.NewError(connect.CodePermissionDenied, err)
 		case http.StatusBadRequest:
 			return connect.NewError(connect.CodeInvalidArgument, err)
+		case http.StatusPreconditionFailed:
+			return connect.NewError(connect.CodeFailedPrecondition, err)
+		case http.StatusTooManyRequests:
+			return connect.NewError(connect.CodeResourceExhausted, err)
 		case http.StatusBadGateway, http.StatusServiceUnavailable:
 			return connect.NewError(connect.CodeUnavailable, err)
 		default:
@@ -2,18 +2,65 @@ package connect
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
+	appservice "github.com/sogos/mirai-backend/internal/application/service"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
 	"github.com/sogos/mirai-backend/internal/domain/repository"
 	"github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/tenant"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 )
 
+// SuspendedTenantAccess is how much of the API users of a suspended tenant keep.
+type SuspendedTenantAccess string
+
+const (
+	SuspendedTenantAccessReadOnly SuspendedTenantAccess = "read_only" // Get, List, Search and Watch RPCs keep working
+	SuspendedTenantAccessBlocked  SuspendedTenantAccess = "blocked"   // Only RPCs needed to restore billing work
+)
+
+// IsValid checks if the access mode is a valid value.
+func (a SuspendedTenantAccess) IsValid() bool {
+	return a == SuspendedTenantAccessReadOnly || a == SuspendedTenantAccessBlocked
+}
+
+// suspendedTenantProcedures stay available to suspended tenants so an owner
+// can see why and update billing, including moving to a plan their usage fits.
+var suspendedTenantProcedures = map[string]bool{
+	"/mirai.v1.UserService/GetMe":                        true,
+	"/mirai.v1.BillingService/GetBillingInfo":            true,
+	"/mirai.v1.BillingService/CreateCheckoutSession":     true,
+	"/mirai.v1.BillingService/CreatePortalSession":       true,
+	"/mirai.v1.BillingService/PreviewSubscriptionChange": true,
+	"/mirai.v1.BillingService/ChangeSubscription":        true,
+}
+
+// restrictedTenantProcedures start costly work and are paused while a
+// tenant's payment is overdue.
+var restrictedTenantProcedures = map[string]bool{
+	"/mirai.v1.AIGenerationService/GenerateCourseOutline":  true,
+	"/mirai.v1.AIGenerationService/GenerateLessonContent":  true,
+	"/mirai.v1.AIGenerationService/GenerateAllLessons":     true,
+	"/mirai.v1.AIGenerationService/RegenerateComponent":    true,
+	"/mirai.v1.AIGenerationService/RegenerateStaleLessons": true,
+	"/mirai.v1.AIGenerationService/ResumeJob":              true,
+	"/mirai.v1.CourseService/ExportCourse":                 true,
+	"/mirai.v1.SMEService/GetUploadURL":                    true,
+	"/mirai.v1.SMEService/SubmitContent":                   true,
+	"/mirai.v1.SMEService/EnhanceSubmissionContent":        true,
+	"/mirai.v1.InvitationService/CreateInvitation":         true,
+	"/mirai.v1.InvitationService/ResendInvitation":         true,
+}
+
+// readOnlyMethodPrefixes mark RPCs without side effects by method name.
+var readOnlyMethodPrefixes = []string{"Get", "List", "Search", "Watch", "Subscribe"}
+
 // AuthInterceptor provides authentication for Connect handlers.
 type AuthInterceptor struct {
 	identity service.IdentityProvider
@@ -22,6 +69,10 @@ type AuthInterceptor struct {
 	logger   service.Logger
 	// Procedures that don't require authentication
 	publicProcedures map[string]bool
+
+	// Tenant suspension and restriction enforcement; disabled when suspension is nil
+	suspension      *appservice.TenantSuspensionService
+	suspendedAccess SuspendedTenantAccess
 }
 
 // userTenantMapping caches the kratos ID to tenant ID mapping.
@@ -30,12 +81,21 @@ type userTenantMapping struct {
 }
 
 // NewAuthInterceptor creates a new auth interceptor.
-func NewAuthInterceptor(identity service.IdentityProvider, userRepo repository.UserRepository, cache cache.Cache, logger service.Logger) *AuthInterceptor {
+func NewAuthInterceptor(
+	identity service.IdentityProvider,
+	userRepo repository.UserRepository,
+	cache cache.Cache,
+	suspension *appservice.TenantSuspensionService,
+	suspendedAccess SuspendedTenantAccess,
+	logger service.Logger,
+) *AuthInterceptor {
 	return &AuthInterceptor{
-		identity: identity,
-		userRepo: userRepo,
-		cache:    cache,
-		logger:   logger,
+		identity:        identity,
+		userRepo:        userRepo,
+		cache:           cache,
+		suspension:      suspension,
+		suspendedAccess: suspendedAccess,
+		logger:          logger,
 		publicProcedures: map[string]bool{
 			"/mirai.v1.AuthService/CheckEmail":                 true,
 			"/mirai.v1.AuthService/Register":                   true,
@@ -129,6 +189,9 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 
 			if found {
 				ctx = tenant.WithTenantID(ctx, tenantID)
+				if err := i.checkTenantAccess(ctx, tenantID, procedure); err != nil {
+					return nil, err
+				}
 			}
 		}
 
@@ -136,6 +199,41 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	}
 }
 
+// checkTenantAccess rejects calls a suspended or restricted tenant may no longer make.
+func (i *AuthInterceptor) checkTenantAccess(ctx context.Context, tenantID uuid.UUID, procedure string) error {
+	if i.suspension == nil || suspendedTenantProcedures[procedure] {
+		return nil
+	}
+
+	if restrictedTenantProcedures[procedure] {
+		if err := i.suspension.CheckTenantUnrestricted(ctx, tenantID); err != nil {
+			return toConnectError(err)
+		}
+		return nil
+	}
+
+	err := i.suspension.CheckTenantActive(ctx, tenantID)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, domainerrors.ErrTenantSuspended) &&
+		i.suspendedAccess == SuspendedTenantAccessReadOnly && isReadOnlyProcedure(procedure) {
+		return nil
+	}
+	return toConnectError(err)
+}
+
+// isReadOnlyProcedure reports whether the procedure only reads data.
+func isReadOnlyProcedure(procedure string) bool {
+	method := procedure[strings.LastIndex(procedure, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 // WrapStreamingClient implements connect.Interceptor.
 func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next // No streaming support needed for now
@@ -222,6 +320,9 @@ func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc
 
 			if found {
 				ctx = tenant.WithTenantID(ctx, tenantID)
+				if err := i.checkTenantAccess(ctx, tenantID, procedure); err != nil {
+					return err
+				}
 			}
 		}
 
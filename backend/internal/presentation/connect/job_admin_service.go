@@ -0,0 +1,391 @@
+package connect
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
+	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
+	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	"github.com/sogos/mirai-backend/internal/domain/valueobject"
+)
+
+// JobAdminServiceServer implements the JobAdminService Connect handler.
+type JobAdminServiceServer struct {
+	miraiv1connect.UnimplementedJobAdminServiceHandler
+	jobAdminService *service.JobAdminService
+}
+
+// NewJobAdminServiceServer creates a new JobAdminServiceServer.
+func NewJobAdminServiceServer(jobAdminService *service.JobAdminService) *JobAdminServiceServer {
+	return &JobAdminServiceServer{jobAdminService: jobAdminService}
+}
+
+// ListFailedTasks lists background tasks waiting to retry or archived.
+func (s *JobAdminServiceServer) ListFailedTasks(
+	ctx context.Context,
+	req *connect.Request[v1.ListFailedTasksRequest],
+) (*connect.Response[v1.ListFailedTasksResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks, err := s.jobAdminService.ListFailedTasks(ctx, kratosID, entity.BackgroundTaskListOptions{
+		State:    backgroundTaskStateFromProto(req.Msg.State),
+		Queue:    req.Msg.GetQueue(),
+		Page:     int(req.Msg.Page),
+		PageSize: int(req.Msg.PageSize),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoTasks := make([]*v1.BackgroundTask, len(tasks))
+	for i, task := range tasks {
+		protoTasks[i] = backgroundTaskToProto(task)
+	}
+
+	return connect.NewResponse(&v1.ListFailedTasksResponse{
+		Tasks: protoTasks,
+	}), nil
+}
+
+// GetFailedTask retrieves a background task with its payload and last error.
+func (s *JobAdminServiceServer) GetFailedTask(
+	ctx context.Context,
+	req *connect.Request[v1.GetFailedTaskRequest],
+) (*connect.Response[v1.GetFailedTaskResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.jobAdminService.GetFailedTask(ctx, kratosID, req.Msg.Queue, req.Msg.TaskId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetFailedTaskResponse{
+		Task: backgroundTaskToProto(task),
+	}), nil
+}
+
+// ReplayFailedTask runs a failed background task again now.
+func (s *JobAdminServiceServer) ReplayFailedTask(
+	ctx context.Context,
+	req *connect.Request[v1.ReplayFailedTaskRequest],
+) (*connect.Response[v1.ReplayFailedTaskResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := s.jobAdminService.ReplayFailedTask(ctx, kratosID, req.Msg.Queue, req.Msg.TaskId)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReplayFailedTaskResponse{
+		Task: backgroundTaskToProto(task),
+	}), nil
+}
+
+// DeleteFailedTask removes a failed background task from its queue.
+func (s *JobAdminServiceServer) DeleteFailedTask(
+	ctx context.Context,
+	req *connect.Request[v1.DeleteFailedTaskRequest],
+) (*connect.Response[v1.DeleteFailedTaskResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.jobAdminService.DeleteFailedTask(ctx, kratosID, req.Msg.Queue, req.Msg.TaskId); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.DeleteFailedTaskResponse{}), nil
+}
+
+// ListStuckProvisioning lists paid registrations whose account was never provisioned.
+func (s *JobAdminServiceServer) ListStuckProvisioning(
+	ctx context.Context,
+	req *connect.Request[v1.ListStuckProvisioningRequest],
+) (*connect.Response[v1.ListStuckProvisioningResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	regs, err := s.jobAdminService.ListStuckProvisioning(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoRegs := make([]*v1.StuckRegistration, len(regs))
+	for i, reg := range regs {
+		protoRegs[i] = stuckRegistrationToProto(reg)
+	}
+
+	return connect.NewResponse(&v1.ListStuckProvisioningResponse{
+		Registrations: protoRegs,
+	}), nil
+}
+
+// RetryProvisioning enqueues provisioning of a stuck or failed paid registration.
+func (s *JobAdminServiceServer) RetryProvisioning(
+	ctx context.Context,
+	req *connect.Request[v1.RetryProvisioningRequest],
+) (*connect.Response[v1.RetryProvisioningResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationID, err := parseUUID(req.Msg.RegistrationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	reg, err := s.jobAdminService.RetryProvisioning(ctx, kratosID, registrationID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RetryProvisioningResponse{
+		Registration: stuckRegistrationToProto(reg),
+	}), nil
+}
+
+// ListWebhookEvents lists stored Stripe webhook events.
+func (s *JobAdminServiceServer) ListWebhookEvents(
+	ctx context.Context,
+	req *connect.Request[v1.ListWebhookEventsRequest],
+) (*connect.Response[v1.ListWebhookEventsResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := int(req.Msg.PageSize)
+	opts := entity.StripeWebhookEventListOptions{
+		Status: webhookEventStatusFromProto(req.Msg.Status),
+		Type:   req.Msg.GetType(),
+		Limit:  pageSize,
+	}
+	if req.Msg.Page > 1 && pageSize > 0 {
+		opts.Offset = (int(req.Msg.Page) - 1) * pageSize
+	}
+
+	events, err := s.jobAdminService.ListWebhookEvents(ctx, kratosID, opts)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoEvents := make([]*v1.WebhookEvent, len(events))
+	for i, event := range events {
+		protoEvents[i] = webhookEventToProto(event)
+	}
+
+	return connect.NewResponse(&v1.ListWebhookEventsResponse{
+		Events: protoEvents,
+	}), nil
+}
+
+// ReplayWebhookEvent applies a stored Stripe webhook event again.
+func (s *JobAdminServiceServer) ReplayWebhookEvent(
+	ctx context.Context,
+	req *connect.Request[v1.ReplayWebhookEventRequest],
+) (*connect.Response[v1.ReplayWebhookEventResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := s.jobAdminService.ReplayWebhookEvent(ctx, kratosID, req.Msg.EventId, req.Msg.Force)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ReplayWebhookEventResponse{
+		Event: webhookEventToProto(event),
+	}), nil
+}
+
+// SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+func (s *JobAdminServiceServer) SetEntitlementOverrides(
+	ctx context.Context,
+	req *connect.Request[v1.SetEntitlementOverridesRequest],
+) (*connect.Response[v1.SetEntitlementOverridesResponse], error) {
+	kratosID, err := adminSessionIdentity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	companyID, err := parseUUID(req.Msg.CompanyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var overrides *entity.EntitlementOverrides
+	if !req.Msg.Clear {
+		overrides = &entity.EntitlementOverrides{
+			MonthlyAITokens: req.Msg.MonthlyAiTokens,
+			SSO:             req.Msg.Sso,
+			CustomBranding:  req.Msg.CustomBranding,
+		}
+		if req.Msg.MaxSmes != nil {
+			maxSMEs := int(*req.Msg.MaxSmes)
+			overrides.MaxSMEs = &maxSMEs
+		}
+		if req.Msg.MaxCourses != nil {
+			maxCourses := int(*req.Msg.MaxCourses)
+			overrides.MaxCourses = &maxCourses
+		}
+		for _, f := range req.Msg.ExportFormats {
+			format, ok := exportFormatFromProto(f)
+			if !ok {
+				return nil, connect.NewError(connect.CodeInvalidArgument, errFormatRequired)
+			}
+			overrides.ExportFormats = append(overrides.ExportFormats, format)
+		}
+	}
+
+	entitlements, err := s.jobAdminService.SetEntitlementOverrides(ctx, kratosID, companyID, overrides)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SetEntitlementOverridesResponse{
+		Entitlements: entitlementsToProto(entitlements),
+	}), nil
+}
+
+// adminSessionIdentity returns the Kratos identity ID of the authenticated session.
+func adminSessionIdentity(ctx context.Context) (uuid.UUID, error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return uuid.Nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+	kratosID, err := uuid.Parse(kratosIDStr)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+	return kratosID, nil
+}
+
+func backgroundTaskToProto(task *entity.BackgroundTask) *v1.BackgroundTask {
+	proto := &v1.BackgroundTask{
+		Id:        task.ID,
+		Queue:     task.Queue,
+		Type:      task.Type,
+		State:     backgroundTaskStateToProto(task.State),
+		Payload:   string(task.Payload),
+		Retried:   int32(task.Retried),
+		MaxRetry:  int32(task.MaxRetry),
+		LastError: task.LastError,
+	}
+	if task.LastFailedAt != nil {
+		proto.LastFailedAt = timestamppb.New(*task.LastFailedAt)
+	}
+	if task.NextProcessAt != nil {
+		proto.NextProcessAt = timestamppb.New(*task.NextProcessAt)
+	}
+	return proto
+}
+
+func stuckRegistrationToProto(reg *entity.PendingRegistration) *v1.StuckRegistration {
+	return &v1.StuckRegistration{
+		Id:                reg.ID.String(),
+		Email:             reg.Email,
+		CompanyName:       reg.CompanyName,
+		Plan:              planToProto(reg.Plan),
+		SeatCount:         int32(reg.SeatCount),
+		Status:            reg.Status.String(),
+		CheckoutSessionId: reg.CheckoutSessionID,
+		ErrorMessage:      reg.ErrorMessage,
+		UpdatedAt:         timestamppb.New(reg.UpdatedAt),
+	}
+}
+
+func backgroundTaskStateToProto(s valueobject.BackgroundTaskState) v1.BackgroundTaskState {
+	switch s {
+	case valueobject.BackgroundTaskStatePending:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_PENDING
+	case valueobject.BackgroundTaskStateActive:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_ACTIVE
+	case valueobject.BackgroundTaskStateScheduled:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_SCHEDULED
+	case valueobject.BackgroundTaskStateRetry:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_RETRY
+	case valueobject.BackgroundTaskStateArchived:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_ARCHIVED
+	case valueobject.BackgroundTaskStateCompleted:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_COMPLETED
+	default:
+		return v1.BackgroundTaskState_BACKGROUND_TASK_STATE_UNSPECIFIED
+	}
+}
+
+func backgroundTaskStateFromProto(s v1.BackgroundTaskState) valueobject.BackgroundTaskState {
+	switch s {
+	case v1.BackgroundTaskState_BACKGROUND_TASK_STATE_RETRY:
+		return valueobject.BackgroundTaskStateRetry
+	default:
+		return valueobject.BackgroundTaskStateArchived
+	}
+}
+
+func webhookEventToProto(event *entity.StripeWebhookEvent) *v1.WebhookEvent {
+	proto := &v1.WebhookEvent{
+		Id:              event.ID,
+		Type:            event.Type,
+		Status:          webhookEventStatusToProto(event.Status),
+		Attempts:        int32(event.Attempts),
+		ErrorMessage:    event.ErrorMessage,
+		Payload:         string(event.Payload),
+		StripeCreatedAt: timestamppb.New(event.StripeCreatedAt),
+		ReceivedAt:      timestamppb.New(event.ReceivedAt),
+	}
+	if event.ProcessedAt != nil {
+		proto.ProcessedAt = timestamppb.New(*event.ProcessedAt)
+	}
+	return proto
+}
+
+func webhookEventStatusToProto(s valueobject.WebhookEventStatus) v1.WebhookEventStatus {
+	switch s {
+	case valueobject.WebhookEventStatusProcessing:
+		return v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSING
+	case valueobject.WebhookEventStatusProcessed:
+		return v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSED
+	case valueobject.WebhookEventStatusIgnored:
+		return v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_IGNORED
+	case valueobject.WebhookEventStatusFailed:
+		return v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_FAILED
+	default:
+		return v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_UNSPECIFIED
+	}
+}
+
+func webhookEventStatusFromProto(s v1.WebhookEventStatus) *valueobject.WebhookEventStatus {
+	var status valueobject.WebhookEventStatus
+	switch s {
+	case v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSING:
+		status = valueobject.WebhookEventStatusProcessing
+	case v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_PROCESSED:
+		status = valueobject.WebhookEventStatusProcessed
+	case v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_IGNORED:
+		status = valueobject.WebhookEventStatusIgnored
+	case v1.WebhookEventStatus_WEBHOOK_EVENT_STATUS_FAILED:
+		status = valueobject.WebhookEventStatusFailed
+	default:
+		return nil
+	}
+	return &status
+}
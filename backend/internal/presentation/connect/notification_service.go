@@ -324,6 +324,10 @@ func notificationTypeToProto(t valueobject.NotificationType) v1.NotificationType
 		return v1.NotificationType_NOTIFICATION_TYPE_GENERATION_FAILED
 	case valueobject.NotificationTypeApprovalRequested:
 		return v1.NotificationType_NOTIFICATION_TYPE_APPROVAL_REQUESTED
+	case valueobject.NotificationTypeLessonsStale:
+		return v1.NotificationType_NOTIFICATION_TYPE_LESSONS_STALE
+	case valueobject.NotificationTypePaymentPastDue:
+		return v1.NotificationType_NOTIFICATION_TYPE_PAYMENT_PAST_DUE
 	default:
 		return v1.NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
 	}
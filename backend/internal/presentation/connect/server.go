@@ -2,6 +2,7 @@ package connect
 
 import (
 	"net/http"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
@@ -10,7 +11,6 @@ import (
 	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/infrastructure/cache"
 	"github.com/sogos/mirai-backend/internal/infrastructure/pubsub"
-	"github.com/sogos/mirai-backend/internal/infrastructure/worker"
 )
 
 // ServerConfig contains all dependencies needed for the Connect server.
@@ -20,24 +20,28 @@ type ServerConfig struct {
 	CompanyService        *service.CompanyService
 	TeamService           *service.TeamService
 	BillingService        *service.BillingService
+	StripeWebhookService  *service.StripeWebhookService // Stores and applies Stripe webhook events
 	InvitationService     *service.InvitationService
 	CourseService         *service.CourseService
+	ExportService         *service.ExportService
 	SMEService            *service.SMEService
 	TargetAudienceService *service.TargetAudienceService
 	TenantSettingsService *service.TenantSettingsService
 	NotificationService   *service.NotificationService
 	AIGenerationService   *service.AIGenerationService
+	JobAdminService       *service.JobAdminService
+	TenantSuspension      *service.TenantSuspensionService // Enforces tenant suspension and restriction on RPCs; nil disables it
+	XAPIStatementService  *service.XAPIStatementService    // Forwards exported packages' statements to tenant LRSs; nil disables it
 
-	PendingRegRepo         repository.PendingRegistrationRepository
 	UserRepo               repository.UserRepository // For tenant context in auth interceptor
 	Cache                  cache.Cache               // For caching user tenant mappings
 	NotificationSubscriber pubsub.Subscriber         // For real-time notification streaming
 	Identity               domainservice.IdentityProvider
 	Payments               domainservice.PaymentProvider
-	WorkerClient           *worker.Client // For enqueueing background tasks
 	Logger                 domainservice.Logger
 	AllowedOrigin          string
 	FrontendURL            string
+	SuspendedTenantAccess  SuspendedTenantAccess // RPCs left to users of suspended tenants
 }
 
 // NewServeMux creates a new HTTP mux with all Connect service handlers.
@@ -45,7 +49,7 @@ func NewServeMux(cfg ServerConfig) *http.ServeMux {
 	// Create interceptors
 	interceptors := connect.WithInterceptors(
 		NewLoggingInterceptor(cfg.Logger),
-		NewAuthInterceptor(cfg.Identity, cfg.UserRepo, cfg.Cache, cfg.Logger),
+		NewAuthInterceptor(cfg.Identity, cfg.UserRepo, cfg.Cache, cfg.TenantSuspension, cfg.SuspendedTenantAccess, cfg.Logger),
 	)
 
 	mux := http.NewServeMux()
@@ -99,7 +103,7 @@ func NewServeMux(cfg ServerConfig) *http.ServeMux {
 	// CourseService - content management
 	if cfg.CourseService != nil {
 		path, handler = miraiv1connect.NewCourseServiceHandler(
-			NewCourseServiceServer(cfg.CourseService),
+			NewCourseServiceServer(cfg.CourseService, cfg.ExportService),
 			interceptors,
 		)
 		mux.Handle(path, handler)
@@ -150,10 +154,25 @@ func NewServeMux(cfg ServerConfig) *http.ServeMux {
 		mux.Handle(path, handler)
 	}
 
+	// JobAdminService - platform administration of failed background tasks
+	if cfg.JobAdminService != nil {
+		path, handler = miraiv1connect.NewJobAdminServiceHandler(
+			NewJobAdminServiceServer(cfg.JobAdminService),
+			interceptors,
+		)
+		mux.Handle(path, handler)
+	}
+
 	// Add webhook handler (no interceptors - Stripe handles its own auth)
-	webhookHandler := NewWebhookHandler(cfg.BillingService, cfg.PendingRegRepo, cfg.Payments, cfg.WorkerClient, cfg.Logger)
+	webhookHandler := NewWebhookHandler(cfg.StripeWebhookService, cfg.Payments, cfg.Logger)
 	mux.HandleFunc("/api/v1/billing/webhook", webhookHandler.HandleStripeWebhook)
 
+	// xAPI statements from exported packages (authorized by the package's statement token)
+	if cfg.XAPIStatementService != nil {
+		xapiHandler := NewXAPIHandler(cfg.XAPIStatementService, cfg.Logger)
+		mux.HandleFunc(service.XAPIForwardingPath+"statements", xapiHandler.HandleStatements)
+	}
+
 	// Checkout completion redirect handler
 	// Stripe redirects here after successful payment.
 	// Note: The user's session cookie was set by the frontend during registration,
@@ -200,6 +219,12 @@ func NewServeMux(cfg ServerConfig) *http.ServeMux {
 // CORSMiddleware wraps an http.Handler with CORS support.
 func CORSMiddleware(allowedOrigin string, h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Exported packages post statements from any LMS origin; that handler sets its own CORS headers
+		if strings.HasPrefix(r.URL.Path, service.XAPIForwardingPath) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
 		// Set CORS headers
 		w.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
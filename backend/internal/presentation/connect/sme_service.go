@@ -510,6 +510,14 @@ func (s *SMEServiceServer) SubmitContent(
 		ContentType:   protoToContentType(req.Msg.ContentType),
 		FileSizeBytes: req.Msg.FileSizeBytes,
 		TextContent:   req.Msg.TextContent,
+		SourceURL:     req.Msg.SourceUrl,
+	}
+	if req.Msg.ReplacesSubmissionId != nil {
+		replacesID, err := parseUUID(*req.Msg.ReplacesSubmissionId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		submitReq.ReplacesSubmissionID = &replacesID
 	}
 
 	submission, err := s.smeService.SubmitContent(ctx, kratosID, submitReq)
@@ -597,8 +605,41 @@ func (s *SMEServiceServer) SearchKnowledge(
 	ctx context.Context,
 	req *connect.Request[v1.SearchKnowledgeRequest],
 ) (*connect.Response[v1.SearchKnowledgeResponse], error) {
-	// TODO: Implement semantic search when needed
-	return nil, connect.NewError(connect.CodeUnimplemented, errUnauthenticated)
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeIDs := make([]uuid.UUID, len(req.Msg.SmeIds))
+	for i, id := range req.Msg.SmeIds {
+		smeIDs[i], err = parseUUID(id)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	chunks, err := s.smeService.SearchKnowledge(ctx, kratosID, service.SearchKnowledgeRequest{
+		SMEIDs: smeIDs,
+		Query:  req.Msg.Query,
+		Limit:  int(req.Msg.Limit),
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoChunks := make([]*v1.SMEKnowledgeChunk, len(chunks))
+	for i, chunk := range chunks {
+		protoChunks[i] = knowledgeChunkToProto(chunk)
+	}
+
+	return connect.NewResponse(&v1.SearchKnowledgeResponse{
+		Chunks: protoChunks,
+	}), nil
 }
 
 // GetSubmission returns a specific submission by ID.
@@ -764,10 +805,11 @@ func (s *SMEServiceServer) UpdateKnowledgeChunk(
 	}
 
 	updateReq := service.UpdateKnowledgeChunkRequest{
-		ChunkID:  chunkID,
-		Content:  req.Msg.Content,
-		Topic:    req.Msg.Topic,
-		Keywords: req.Msg.Keywords,
+		ChunkID:      chunkID,
+		Content:      req.Msg.Content,
+		Topic:        req.Msg.Topic,
+		Keywords:     req.Msg.Keywords,
+		ChangeReason: req.Msg.ChangeReason,
 	}
 
 	chunk, err := s.smeService.UpdateKnowledgeChunk(ctx, kratosID, updateReq)
@@ -800,13 +842,149 @@ func (s *SMEServiceServer) DeleteKnowledgeChunk(
 		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	if err := s.smeService.DeleteKnowledgeChunk(ctx, kratosID, chunkID); err != nil {
+	if err := s.smeService.DeleteKnowledgeChunk(ctx, kratosID, chunkID, req.Msg.ChangeReason); err != nil {
 		return nil, toConnectError(err)
 	}
 
 	return connect.NewResponse(&v1.DeleteKnowledgeChunkResponse{}), nil
 }
 
+// ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+func (s *SMEServiceServer) ListKnowledgeChunkVersions(
+	ctx context.Context,
+	req *connect.Request[v1.ListKnowledgeChunkVersionsRequest],
+) (*connect.Response[v1.ListKnowledgeChunkVersionsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	chunkID, err := parseUUID(req.Msg.ChunkId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	history, err := s.smeService.ListKnowledgeChunkVersions(ctx, kratosID, chunkID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoVersions := make([]*v1.SMEKnowledgeChunkVersion, len(history.Versions))
+	for i, v := range history.Versions {
+		protoVersions[i] = knowledgeChunkVersionToProto(v.Version)
+		protoVersions[i].Diff = v.Diff
+	}
+
+	return connect.NewResponse(&v1.ListKnowledgeChunkVersionsResponse{
+		Versions:        protoVersions,
+		OutdatedLessons: outdatedLessonsToProto(history.OutdatedLessons),
+	}), nil
+}
+
+// RestoreKnowledgeChunkVersion returns a chunk to an earlier version.
+func (s *SMEServiceServer) RestoreKnowledgeChunkVersion(
+	ctx context.Context,
+	req *connect.Request[v1.RestoreKnowledgeChunkVersionRequest],
+) (*connect.Response[v1.RestoreKnowledgeChunkVersionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	chunkID, err := parseUUID(req.Msg.ChunkId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	chunk, err := s.smeService.RestoreKnowledgeChunkVersion(ctx, kratosID, service.RestoreKnowledgeChunkVersionRequest{
+		ChunkID:      chunkID,
+		Version:      req.Msg.Version,
+		ChangeReason: req.Msg.ChangeReason,
+	})
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RestoreKnowledgeChunkVersionResponse{
+		Chunk: knowledgeChunkToProto(chunk),
+	}), nil
+}
+
+// ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+func (s *SMEServiceServer) ListDeletedKnowledgeChunks(
+	ctx context.Context,
+	req *connect.Request[v1.ListDeletedKnowledgeChunksRequest],
+) (*connect.Response[v1.ListDeletedKnowledgeChunksResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	versions, err := s.smeService.ListDeletedKnowledgeChunks(ctx, kratosID, smeID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	protoVersions := make([]*v1.SMEKnowledgeChunkVersion, len(versions))
+	for i, v := range versions {
+		protoVersions[i] = knowledgeChunkVersionToProto(v)
+	}
+
+	return connect.NewResponse(&v1.ListDeletedKnowledgeChunksResponse{
+		Versions: protoVersions,
+	}), nil
+}
+
+// ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+func (s *SMEServiceServer) ListOutdatedLessons(
+	ctx context.Context,
+	req *connect.Request[v1.ListOutdatedLessonsRequest],
+) (*connect.Response[v1.ListOutdatedLessonsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	smeID, err := parseUUID(req.Msg.SmeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	lessons, err := s.smeService.ListOutdatedLessons(ctx, kratosID, smeID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.ListOutdatedLessonsResponse{
+		Lessons: outdatedLessonsToProto(lessons),
+	}), nil
+}
+
 // DeleteTask permanently removes a task.
 func (s *SMEServiceServer) DeleteTask(
 	ctx context.Context,
@@ -933,25 +1111,40 @@ func submissionToProto(sub *entity.SMETaskSubmission) *v1.SMETaskSubmission {
 		approvedByUserID = &s
 	}
 
+	var supersededBy *string
+	if sub.SupersededBySubmissionID != nil {
+		s := sub.SupersededBySubmissionID.String()
+		supersededBy = &s
+	}
+
+	var replaces *string
+	if sub.ReplacesSubmissionID != nil {
+		s := sub.ReplacesSubmissionID.String()
+		replaces = &s
+	}
+
 	return &v1.SMETaskSubmission{
-		Id:                sub.ID.String(),
-		TenantId:          sub.TenantID.String(),
-		TaskId:            sub.TaskID.String(),
-		FileName:          sub.FileName,
-		FilePath:          sub.FilePath,
-		ContentType:       contentTypeToProto(sub.ContentType),
-		FileSizeBytes:     sub.FileSizeBytes,
-		ExtractedText:     sub.ExtractedText,
-		AiSummary:         sub.AISummary,
-		IngestionError:    sub.IngestionError,
-		SubmittedByUserId: sub.SubmittedByUserID.String(),
-		SubmittedAt:       timestamppb.New(sub.SubmittedAt),
-		ProcessedAt:       processedAt,
-		ReviewerNotes:     sub.ReviewerNotes,
-		ApprovedContent:   sub.ApprovedContent,
-		IsApproved:        sub.IsApproved,
-		ApprovedAt:        approvedAt,
-		ApprovedByUserId:  approvedByUserID,
+		Id:                       sub.ID.String(),
+		TenantId:                 sub.TenantID.String(),
+		TaskId:                   sub.TaskID.String(),
+		FileName:                 sub.FileName,
+		FilePath:                 sub.FilePath,
+		ContentType:              contentTypeToProto(sub.ContentType),
+		FileSizeBytes:            sub.FileSizeBytes,
+		ExtractedText:            sub.ExtractedText,
+		AiSummary:                sub.AISummary,
+		IngestionError:           sub.IngestionError,
+		SubmittedByUserId:        sub.SubmittedByUserID.String(),
+		SubmittedAt:              timestamppb.New(sub.SubmittedAt),
+		ProcessedAt:              processedAt,
+		ReviewerNotes:            sub.ReviewerNotes,
+		ApprovedContent:          sub.ApprovedContent,
+		IsApproved:               sub.IsApproved,
+		ApprovedAt:               approvedAt,
+		ApprovedByUserId:         approvedByUserID,
+		SourceUrl:                sub.SourceURL,
+		SupersededBySubmissionId: supersededBy,
+		ReplacesSubmissionId:     replaces,
 	}
 }
 
@@ -967,14 +1160,72 @@ func knowledgeChunkToProto(chunk *entity.SMEKnowledgeChunk) *v1.SMEKnowledgeChun
 	}
 
 	return &v1.SMEKnowledgeChunk{
-		Id:             chunk.ID.String(),
-		SmeId:          chunk.SMEID.String(),
-		SubmissionId:   submissionID,
-		Content:        chunk.Content,
-		Topic:          chunk.Topic,
-		Keywords:       chunk.Keywords,
-		RelevanceScore: chunk.RelevanceScore,
-		CreatedAt:      timestamppb.New(chunk.CreatedAt),
+		Id:                     chunk.ID.String(),
+		SmeId:                  chunk.SMEID.String(),
+		SubmissionId:           submissionID,
+		Content:                chunk.Content,
+		Topic:                  chunk.Topic,
+		Keywords:               chunk.Keywords,
+		RelevanceScore:         chunk.RelevanceScore,
+		CreatedAt:              timestamppb.New(chunk.CreatedAt),
+		SourceHeading:          chunk.SourceHeading,
+		SourcePage:             chunk.SourcePage,
+		SourceTimestampSeconds: chunk.SourceTimestamp,
+	}
+}
+
+func knowledgeChunkVersionToProto(v *entity.SMEKnowledgeChunkVersion) *v1.SMEKnowledgeChunkVersion {
+	proto := &v1.SMEKnowledgeChunkVersion{
+		ChunkId:      v.ChunkID.String(),
+		SmeId:        v.SMEID.String(),
+		Version:      v.Version,
+		ChangeType:   knowledgeChangeTypeToProto(v.ChangeType),
+		ChangeReason: v.ChangeReason,
+		Content:      v.Content,
+		Topic:        v.Topic,
+		Keywords:     v.Keywords,
+		CreatedAt:    timestamppb.New(v.CreatedAt),
+	}
+	if v.AuthorUserID != nil {
+		authorUserID := v.AuthorUserID.String()
+		proto.AuthorUserId = &authorUserID
+	}
+	if v.SubmissionID != nil {
+		submissionID := v.SubmissionID.String()
+		proto.SubmissionId = &submissionID
+	}
+	return proto
+}
+
+func outdatedLessonsToProto(lessons []entity.OutdatedLesson) []*v1.OutdatedLesson {
+	protoLessons := make([]*v1.OutdatedLesson, len(lessons))
+	for i, l := range lessons {
+		protoLessons[i] = &v1.OutdatedLesson{
+			LessonId:         l.LessonID.String(),
+			CourseId:         l.CourseID.String(),
+			Title:            l.Title,
+			GeneratedAt:      timestamppb.New(l.GeneratedAt),
+			ChunkId:          l.ChunkID.String(),
+			GeneratedVersion: l.GeneratedVersion,
+			CurrentVersion:   l.CurrentVersion,
+			ChunkDeleted:     l.ChunkDeleted,
+		}
+	}
+	return protoLessons
+}
+
+func knowledgeChangeTypeToProto(ct valueobject.KnowledgeChangeType) v1.KnowledgeChangeType {
+	switch ct {
+	case valueobject.KnowledgeChangeCreated:
+		return v1.KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_CREATED
+	case valueobject.KnowledgeChangeUpdated:
+		return v1.KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_UPDATED
+	case valueobject.KnowledgeChangeDeleted:
+		return v1.KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_DELETED
+	case valueobject.KnowledgeChangeRestored:
+		return v1.KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_RESTORED
+	default:
+		return v1.KnowledgeChangeType_KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED
 	}
 }
 
@@ -10,6 +10,8 @@ import (
 	v1 "github.com/sogos/mirai-backend/gen/mirai/v1"
 	"github.com/sogos/mirai-backend/gen/mirai/v1/miraiv1connect"
 	"github.com/sogos/mirai-backend/internal/application/service"
+	"github.com/sogos/mirai-backend/internal/domain/entity"
+	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
 	"github.com/sogos/mirai-backend/internal/domain/valueobject"
 )
 
@@ -49,18 +51,21 @@ func (s *TenantSettingsServiceServer) GetAISettings(
 	settings := result.Settings
 	return connect.NewResponse(&v1.GetAISettingsResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			BaseUrl:                 settings.BaseURL,
+			Model:                   settings.Model,
+			SemanticSearchAvailable: settings.Provider.SupportsEmbeddings(),
 		},
 	}), nil
 }
 
-// SetAPIKey sets the Gemini API key for the tenant.
+// SetAPIKey validates and sets the API key for the chosen provider.
 func (s *TenantSettingsServiceServer) SetAPIKey(
 	ctx context.Context,
 	req *connect.Request[v1.SetAPIKeyRequest],
@@ -75,12 +80,19 @@ func (s *TenantSettingsServiceServer) SetAPIKey(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	if req.Msg.ApiKey == "" {
+	provider := protoToAIProvider(req.Msg.Provider)
+
+	// Self-hosted servers may run without authentication
+	if req.Msg.ApiKey == "" && !provider.IsSelfHosted() {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errMissingAPIKey)
 	}
 
-	provider := protoToAIProvider(req.Msg.Provider)
-	if err := s.settingsService.SetAPIKey(ctx, kratosID, provider, req.Msg.ApiKey); err != nil {
+	if err := s.settingsService.SetAPIKey(ctx, kratosID, service.SetAPIKeyRequest{
+		Provider: provider,
+		APIKey:   req.Msg.ApiKey,
+		BaseURL:  req.Msg.GetBaseUrl(),
+		Model:    req.Msg.GetModel(),
+	}); err != nil {
 		return nil, toConnectError(err)
 	}
 
@@ -93,13 +105,16 @@ func (s *TenantSettingsServiceServer) SetAPIKey(
 	settings := result.Settings
 	return connect.NewResponse(&v1.SetAPIKeyResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			BaseUrl:                 settings.BaseURL,
+			Model:                   settings.Model,
+			SemanticSearchAvailable: settings.Provider.SupportsEmbeddings(),
 		},
 	}), nil
 }
@@ -132,13 +147,16 @@ func (s *TenantSettingsServiceServer) RemoveAPIKey(
 	settings := result.Settings
 	return connect.NewResponse(&v1.RemoveAPIKeyResponse{
 		Settings: &v1.TenantAISettings{
-			TenantId:          settings.TenantID.String(),
-			Provider:          aiProviderToProto(settings.Provider),
-			ApiKeyConfigured:  settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
-			TotalTokensUsed:   settings.TotalTokensUsed,
-			MonthlyTokenLimit: settings.MonthlyTokenLimit,
-			UpdatedAt:         timestamppb.New(settings.UpdatedAt),
-			UpdatedByUserId:   uuidPtrToString(settings.UpdatedByUserID),
+			TenantId:                settings.TenantID.String(),
+			Provider:                aiProviderToProto(settings.Provider),
+			ApiKeyConfigured:        settings.EncryptedAPIKey != nil && len(settings.EncryptedAPIKey) > 0,
+			TotalTokensUsed:         settings.TotalTokensUsed,
+			MonthlyTokenLimit:       settings.MonthlyTokenLimit,
+			UpdatedAt:               timestamppb.New(settings.UpdatedAt),
+			UpdatedByUserId:         uuidPtrToString(settings.UpdatedByUserID),
+			BaseUrl:                 settings.BaseURL,
+			Model:                   settings.Model,
+			SemanticSearchAvailable: settings.Provider.SupportsEmbeddings(),
 		},
 	}), nil
 }
@@ -158,12 +176,12 @@ func (s *TenantSettingsServiceServer) TestAPIKey(
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	var apiKey *string
-	if req.Msg.ApiKey != "" {
-		apiKey = &req.Msg.ApiKey
-	}
-
-	result, err := s.settingsService.TestAPIKey(ctx, kratosID, apiKey)
+	result, err := s.settingsService.TestAPIKey(ctx, kratosID, service.TestAPIKeyRequest{
+		Provider: protoToAIProvider(req.Msg.Provider),
+		APIKey:   req.Msg.ApiKey,
+		BaseURL:  req.Msg.GetBaseUrl(),
+		Model:    req.Msg.GetModel(),
+	})
 	if err != nil {
 		return nil, toConnectError(err)
 	}
@@ -197,19 +215,170 @@ func (s *TenantSettingsServiceServer) GetUsageStats(
 		return nil, toConnectError(err)
 	}
 
+	month := result.Month
+	usageByType := make([]*v1.UsageByType, len(month.ByJobType))
+	for i, u := range month.ByJobType {
+		usageByType[i] = &v1.UsageByType{
+			JobType:    u.JobType.String(),
+			TokensUsed: u.TokensUsed,
+			JobCount:   int32(u.JobCount),
+		}
+	}
+	dailyUsage := make([]*v1.DailyUsage, len(month.Daily))
+	for i, d := range month.Daily {
+		dailyUsage[i] = &v1.DailyUsage{
+			Date:       timestamppb.New(d.Date),
+			TokensUsed: d.TokensUsed,
+		}
+	}
+
 	return connect.NewResponse(&v1.GetUsageStatsResponse{
-		TotalTokensUsed: result.TotalTokensUsed,
-		TokensThisMonth: 0, // TODO: Implement monthly token tracking
-		MonthlyLimit:    result.MonthlyTokenLimit,
+		TotalTokensUsed:         result.TotalTokensUsed,
+		TokensThisMonth:         month.TokensUsed,
+		MonthlyLimit:            result.MonthlyTokenLimit,
+		UsageByType:             usageByType,
+		DailyUsage:              dailyUsage,
+		ProjectedMonthEndTokens: month.ProjectedTotal,
 	}), nil
 }
 
+// GetLRSSettings returns the current LRS configuration.
+func (s *TenantSettingsServiceServer) GetLRSSettings(
+	ctx context.Context,
+	req *connect.Request[v1.GetLRSSettingsRequest],
+) (*connect.Response[v1.GetLRSSettingsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	settings, err := s.settingsService.GetLRSSettings(ctx, kratosID)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.GetLRSSettingsResponse{
+		Settings: lrsSettingsToProto(settings),
+	}), nil
+}
+
+// SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+func (s *TenantSettingsServiceServer) SetLRSSettings(
+	ctx context.Context,
+	req *connect.Request[v1.SetLRSSettingsRequest],
+) (*connect.Response[v1.SetLRSSettingsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	settings, err := s.settingsService.SetLRSSettings(ctx, kratosID, req.Msg.Endpoint, req.Msg.Key, req.Msg.Secret)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.SetLRSSettingsResponse{
+		Settings: lrsSettingsToProto(settings),
+	}), nil
+}
+
+// RemoveLRSSettings removes the configured LRS.
+func (s *TenantSettingsServiceServer) RemoveLRSSettings(
+	ctx context.Context,
+	req *connect.Request[v1.RemoveLRSSettingsRequest],
+) (*connect.Response[v1.RemoveLRSSettingsResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	if err := s.settingsService.RemoveLRSSettings(ctx, kratosID); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&v1.RemoveLRSSettingsResponse{}), nil
+}
+
+// TestLRSConnection tests if the LRS accepts the credentials.
+func (s *TenantSettingsServiceServer) TestLRSConnection(
+	ctx context.Context,
+	req *connect.Request[v1.TestLRSConnectionRequest],
+) (*connect.Response[v1.TestLRSConnectionResponse], error) {
+	kratosIDStr, ok := ctx.Value(kratosIDKey{}).(string)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, errUnauthenticated)
+	}
+
+	kratosID, err := parseUUID(kratosIDStr)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	// Test the stored settings unless an endpoint is supplied
+	var cfg *domainservice.LRSConfig
+	if req.Msg.Endpoint != nil {
+		cfg = &domainservice.LRSConfig{
+			Endpoint: req.Msg.GetEndpoint(),
+			Key:      req.Msg.GetKey(),
+			Secret:   req.Msg.GetSecret(),
+		}
+	}
+
+	result, err := s.settingsService.TestLRSConnection(ctx, kratosID, cfg)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	resp := &v1.TestLRSConnectionResponse{
+		Valid: result.Valid,
+	}
+	if !result.Valid && result.Message != "" {
+		resp.ErrorMessage = &result.Message
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // Helper functions for proto conversion
 
+func lrsSettingsToProto(settings *entity.TenantLRSSettings) *v1.TenantLRSSettings {
+	pb := &v1.TenantLRSSettings{
+		TenantId:         settings.TenantID.String(),
+		Endpoint:         settings.Endpoint,
+		Key:              settings.Key,
+		SecretConfigured: settings.HasSecret(),
+		UpdatedByUserId:  uuidPtrToString(settings.UpdatedByUserID),
+	}
+	if !settings.UpdatedAt.IsZero() {
+		pb.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+	return pb
+}
+
 func aiProviderToProto(p valueobject.AIProvider) v1.AIProvider {
 	switch p {
 	case valueobject.AIProviderGemini:
 		return v1.AIProvider_AI_PROVIDER_GEMINI
+	case valueobject.AIProviderOpenAI:
+		return v1.AIProvider_AI_PROVIDER_OPENAI
+	case valueobject.AIProviderAnthropic:
+		return v1.AIProvider_AI_PROVIDER_ANTHROPIC
+	case valueobject.AIProviderOpenAICompatible:
+		return v1.AIProvider_AI_PROVIDER_OPENAI_COMPATIBLE
 	default:
 		return v1.AIProvider_AI_PROVIDER_UNSPECIFIED
 	}
@@ -219,6 +388,12 @@ func protoToAIProvider(p v1.AIProvider) valueobject.AIProvider {
 	switch p {
 	case v1.AIProvider_AI_PROVIDER_GEMINI:
 		return valueobject.AIProviderGemini
+	case v1.AIProvider_AI_PROVIDER_OPENAI:
+		return valueobject.AIProviderOpenAI
+	case v1.AIProvider_AI_PROVIDER_ANTHROPIC:
+		return valueobject.AIProviderAnthropic
+	case v1.AIProvider_AI_PROVIDER_OPENAI_COMPATIBLE:
+		return valueobject.AIProviderOpenAICompatible
 	default:
 		return valueobject.AIProviderGemini // Default to Gemini
 	}
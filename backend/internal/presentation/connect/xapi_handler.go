@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/sogos/mirai-backend/internal/application/service"
+	domainerrors "github.com/sogos/mirai-backend/internal/domain/errors"
+	domainservice "github.com/sogos/mirai-backend/internal/domain/service"
+)
+
+// maxStatementBodyBytes caps the size of a statement request.
+const maxStatementBodyBytes = 1 << 20
+
+// XAPIHandler receives xAPI statements from exported packages and forwards
+// them to the tenant's LRS.
+type XAPIHandler struct {
+	statementService *service.XAPIStatementService
+	logger           domainservice.Logger
+}
+
+// NewXAPIHandler creates a new xAPI statement handler.
+func NewXAPIHandler(statementService *service.XAPIStatementService, logger domainservice.Logger) *XAPIHandler {
+	return &XAPIHandler{
+		statementService: statementService,
+		logger:           logger,
+	}
+}
+
+// HandleStatements handles POST /api/v1/xapi/statements.
+// Packages run inside any LMS, so any origin may post; requests carry the
+// package's statement token rather than cookies.
+func (h *XAPIHandler) HandleStatements(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type, X-Experience-API-Version")
+	w.Header().Set("Access-Control-Max-Age", "86400")
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		http.Error(w, "statement token required", http.StatusUnauthorized)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxStatementBodyBytes))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if err := h.statementService.ForwardStatements(r.Context(), token, body); err != nil {
+		if domainErr := domainerrors.GetDomainError(err); domainErr != nil {
+			http.Error(w, domainErr.Message, domainErr.HTTPStatus)
+			return
+		}
+		h.logger.Error("failed to forward xAPI statements", "error", err)
+		http.Error(w, "failed to forward statements", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,22 @@
+-- Revert course exports back to scorm_packages
+
+DROP INDEX IF EXISTS idx_course_exports_course_created;
+
+ALTER TABLE course_exports
+DROP COLUMN IF EXISTS file_name,
+DROP COLUMN IF EXISTS size_bytes,
+DROP COLUMN IF EXISTS requested_by_user_id,
+DROP COLUMN IF EXISTS started_at;
+
+ALTER TABLE course_exports ADD COLUMN manifest_version VARCHAR(20);
+ALTER TABLE course_exports RENAME COLUMN storage_path TO archive_key;
+
+ALTER TABLE course_exports RENAME CONSTRAINT course_exports_format_check TO scorm_format_check;
+ALTER TABLE course_exports RENAME CONSTRAINT course_exports_status_check TO scorm_status_check;
+
+ALTER INDEX idx_course_exports_tenant RENAME TO idx_scorm_tenant;
+ALTER INDEX idx_course_exports_course RENAME TO idx_scorm_course;
+ALTER INDEX idx_course_exports_status RENAME TO idx_scorm_status;
+
+ALTER POLICY course_exports_isolation ON course_exports RENAME TO scorm_isolation;
+ALTER TABLE course_exports RENAME TO scorm_packages;
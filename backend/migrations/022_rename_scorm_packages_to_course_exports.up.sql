@@ -0,0 +1,26 @@
+-- Course exports
+-- Repurposes the unused scorm_packages table to track export jobs for all formats.
+-- The RLS policy and FORCE RLS setting follow the table through the rename.
+
+ALTER TABLE scorm_packages RENAME TO course_exports;
+ALTER POLICY scorm_isolation ON course_exports RENAME TO course_exports_isolation;
+
+ALTER INDEX idx_scorm_tenant RENAME TO idx_course_exports_tenant;
+ALTER INDEX idx_scorm_course RENAME TO idx_course_exports_course;
+ALTER INDEX idx_scorm_status RENAME TO idx_course_exports_status;
+
+ALTER TABLE course_exports RENAME CONSTRAINT scorm_format_check TO course_exports_format_check;
+ALTER TABLE course_exports RENAME CONSTRAINT scorm_status_check TO course_exports_status_check;
+
+-- archive_key holds the full storage key of the rendered artifact for every format
+ALTER TABLE course_exports RENAME COLUMN archive_key TO storage_path;
+ALTER TABLE course_exports DROP COLUMN manifest_version;
+
+ALTER TABLE course_exports
+ADD COLUMN file_name VARCHAR(255),
+ADD COLUMN size_bytes BIGINT,
+ADD COLUMN requested_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+ADD COLUMN started_at TIMESTAMPTZ;
+
+-- Listing exports for a course, newest first
+CREATE INDEX idx_course_exports_course_created ON course_exports(course_id, created_at DESC);
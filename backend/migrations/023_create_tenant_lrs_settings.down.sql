@@ -0,0 +1,2 @@
+DROP POLICY IF EXISTS tenant_lrs_settings_isolation ON tenant_lrs_settings;
+DROP TABLE IF EXISTS tenant_lrs_settings;
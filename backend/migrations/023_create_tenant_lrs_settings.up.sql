@@ -0,0 +1,25 @@
+-- Tenant LRS settings
+-- xAPI Learning Record Store that receives statements from exported cmi5 packages
+
+CREATE TABLE tenant_lrs_settings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL UNIQUE REFERENCES tenants(id) ON DELETE CASCADE,
+
+    endpoint VARCHAR(2048) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+
+    -- Encrypted basic auth secret (AES-256-GCM)
+    encrypted_secret BYTEA NOT NULL,
+
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_by_user_id UUID REFERENCES users(id) ON DELETE SET NULL
+);
+
+ALTER TABLE tenant_lrs_settings ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_lrs_settings_isolation ON tenant_lrs_settings
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+ALTER TABLE tenant_lrs_settings FORCE ROW LEVEL SECURITY;
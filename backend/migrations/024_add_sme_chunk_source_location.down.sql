@@ -0,0 +1,3 @@
+-- Remove source location columns from sme_knowledge_chunks
+ALTER TABLE sme_knowledge_chunks DROP COLUMN source_page;
+ALTER TABLE sme_knowledge_chunks DROP COLUMN source_heading;
@@ -0,0 +1,4 @@
+-- Add source location columns to sme_knowledge_chunks
+-- Heading and page of the source document the chunk was extracted from (NULL when unknown)
+ALTER TABLE sme_knowledge_chunks ADD COLUMN source_heading TEXT;
+ALTER TABLE sme_knowledge_chunks ADD COLUMN source_page INTEGER;
@@ -0,0 +1,4 @@
+-- Remove vector embeddings from sme_knowledge_chunks
+DROP INDEX IF EXISTS idx_sme_knowledge_chunks_embedding;
+ALTER TABLE sme_knowledge_chunks DROP COLUMN embedding;
+DROP EXTENSION IF EXISTS vector;
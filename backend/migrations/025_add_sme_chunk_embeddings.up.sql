@@ -0,0 +1,8 @@
+-- Add vector embeddings to sme_knowledge_chunks for semantic search
+-- Requires the pgvector extension (pgvector/pgvector images ship it)
+CREATE EXTENSION IF NOT EXISTS vector;
+
+-- 768 dimensions matches service.EmbeddingDimensions (NULL until the chunk is embedded)
+ALTER TABLE sme_knowledge_chunks ADD COLUMN embedding vector(768);
+
+CREATE INDEX idx_sme_knowledge_chunks_embedding ON sme_knowledge_chunks USING hnsw (embedding vector_cosine_ops);
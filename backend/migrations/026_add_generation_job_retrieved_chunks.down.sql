@@ -0,0 +1,2 @@
+-- Remove retrieved_chunk_ids column from generation_jobs
+ALTER TABLE generation_jobs DROP COLUMN retrieved_chunk_ids;
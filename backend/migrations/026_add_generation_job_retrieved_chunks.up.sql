@@ -0,0 +1,3 @@
+-- Add retrieved_chunk_ids column to generation_jobs
+-- Knowledge chunks selected by retrieval when generating a lesson, kept for auditing retrieval quality
+ALTER TABLE generation_jobs ADD COLUMN retrieved_chunk_ids UUID[];
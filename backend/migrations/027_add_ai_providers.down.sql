@@ -0,0 +1,3 @@
+-- Rollback AI providers migration
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type,
+-- so the 'openai' and 'anthropic' values remain.
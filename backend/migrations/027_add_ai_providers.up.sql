@@ -0,0 +1,3 @@
+-- Add OpenAI and Anthropic to the ai_provider enum
+ALTER TYPE ai_provider ADD VALUE IF NOT EXISTS 'openai';
+ALTER TYPE ai_provider ADD VALUE IF NOT EXISTS 'anthropic';
@@ -0,0 +1,5 @@
+-- Rollback self-hosted provider migration
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+
+ALTER TABLE tenant_ai_settings DROP COLUMN IF EXISTS model;
+ALTER TABLE tenant_ai_settings DROP COLUMN IF EXISTS base_url;
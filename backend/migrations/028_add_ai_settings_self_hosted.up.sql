@@ -0,0 +1,7 @@
+-- Add self-hosted OpenAI-compatible provider (Ollama, vLLM, LM Studio)
+ALTER TYPE ai_provider ADD VALUE IF NOT EXISTS 'openai_compatible';
+
+-- Add base_url and model columns to tenant_ai_settings
+-- Only used by the openai_compatible provider
+ALTER TABLE tenant_ai_settings ADD COLUMN base_url TEXT;
+ALTER TABLE tenant_ai_settings ADD COLUMN model TEXT;
@@ -0,0 +1,2 @@
+DROP POLICY IF EXISTS ai_token_usage_isolation ON ai_token_usage;
+DROP TABLE IF EXISTS ai_token_usage;
@@ -0,0 +1,31 @@
+-- AI token usage ledger
+-- One row per completed AI job, used for monthly budget enforcement and usage reporting
+
+CREATE TABLE ai_token_usage (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    -- First day of the calendar month (UTC) the usage counts against
+    usage_month DATE NOT NULL,
+
+    job_id UUID REFERENCES generation_jobs(id) ON DELETE SET NULL,
+    job_type generation_job_type NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    course_id UUID REFERENCES courses(id) ON DELETE SET NULL,
+
+    tokens_used BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ai_token_usage_tenant_month ON ai_token_usage(tenant_id, usage_month);
+CREATE INDEX idx_ai_token_usage_tenant_type ON ai_token_usage(tenant_id, job_type, created_at DESC);
+
+ALTER TABLE ai_token_usage ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY ai_token_usage_isolation ON ai_token_usage
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+ALTER TABLE ai_token_usage FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,2 @@
+-- Remove recording position from sme_knowledge_chunks
+ALTER TABLE sme_knowledge_chunks DROP COLUMN source_timestamp_seconds;
@@ -0,0 +1,3 @@
+-- Add recording position to sme_knowledge_chunks
+-- Seconds into the source audio or video the chunk was transcribed from (NULL for documents)
+ALTER TABLE sme_knowledge_chunks ADD COLUMN source_timestamp_seconds INTEGER;
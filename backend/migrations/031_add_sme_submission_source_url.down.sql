@@ -0,0 +1,2 @@
+-- Remove source URL from sme_task_submissions
+ALTER TABLE sme_task_submissions DROP COLUMN source_url;
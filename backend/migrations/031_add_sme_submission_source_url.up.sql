@@ -0,0 +1,3 @@
+-- Add source URL to sme_task_submissions
+-- Web page fetched for URL submissions; file_path then points at the crawl snapshot
+ALTER TABLE sme_task_submissions ADD COLUMN source_url TEXT;
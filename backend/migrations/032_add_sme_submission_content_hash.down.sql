@@ -0,0 +1,4 @@
+-- Remove content hashing and superseding from sme_task_submissions
+DROP INDEX IF EXISTS idx_sme_submissions_content_hash;
+ALTER TABLE sme_task_submissions DROP COLUMN superseded_by_submission_id;
+ALTER TABLE sme_task_submissions DROP COLUMN content_hash;
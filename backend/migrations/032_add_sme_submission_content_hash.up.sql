@@ -0,0 +1,7 @@
+-- Add content hashing and superseding to sme_task_submissions
+-- content_hash: SHA-256 of the normalized extracted text, used to skip unchanged re-uploads
+-- superseded_by_submission_id: newer submission of the same source whose knowledge replaced this one's
+ALTER TABLE sme_task_submissions ADD COLUMN content_hash VARCHAR(64);
+ALTER TABLE sme_task_submissions ADD COLUMN superseded_by_submission_id UUID REFERENCES sme_task_submissions(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_sme_submissions_content_hash ON sme_task_submissions(content_hash) WHERE content_hash IS NOT NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_lesson_components_sme_chunks;
+DROP POLICY IF EXISTS sme_knowledge_chunk_versions_isolation ON sme_knowledge_chunk_versions;
+DROP TABLE IF EXISTS sme_knowledge_chunk_versions;
@@ -0,0 +1,53 @@
+-- SME knowledge chunk version history
+-- One row per change to a chunk (created, updated, deleted, restored), snapshotting
+-- its content so reviewers can see who changed what and restore earlier versions.
+-- chunk_id deliberately has no foreign key: history outlives deleted chunks.
+
+CREATE TABLE sme_knowledge_chunk_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    chunk_id UUID NOT NULL,
+    sme_id UUID NOT NULL REFERENCES subject_matter_experts(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+
+    change_type VARCHAR(20) NOT NULL CHECK (change_type IN ('created', 'updated', 'deleted', 'restored')),
+    change_reason TEXT,
+    author_user_id UUID REFERENCES users(id) ON DELETE SET NULL,  -- NULL for system changes
+    submission_id UUID REFERENCES sme_task_submissions(id) ON DELETE SET NULL,
+
+    -- Chunk state after the change (before it, for deletions)
+    content TEXT NOT NULL,
+    topic VARCHAR(255) NOT NULL,
+    keywords TEXT[],
+    relevance_score REAL NOT NULL,
+    source_heading TEXT,
+    source_page INTEGER,
+    source_timestamp_seconds INTEGER,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (chunk_id, version)
+);
+
+CREATE INDEX idx_sme_chunk_versions_tenant ON sme_knowledge_chunk_versions(tenant_id);
+CREATE INDEX idx_sme_chunk_versions_sme ON sme_knowledge_chunk_versions(sme_id, created_at DESC);
+
+-- Existing chunks start their history at version 1. Bypass RLS on the
+-- source table, which is forced even for the migration role.
+SET app.is_superadmin = 'true';
+INSERT INTO sme_knowledge_chunk_versions (tenant_id, chunk_id, sme_id, version, change_type, submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at)
+SELECT tenant_id, id, sme_id, 1, 'created', submission_id, content, topic, keywords, relevance_score, source_heading, source_page, source_timestamp_seconds, created_at
+FROM sme_knowledge_chunks;
+RESET app.is_superadmin;
+
+-- Finds the lessons citing a chunk
+CREATE INDEX idx_lesson_components_sme_chunks ON lesson_components USING GIN (sme_chunk_ids);
+
+ALTER TABLE sme_knowledge_chunk_versions ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY sme_knowledge_chunk_versions_isolation ON sme_knowledge_chunk_versions
+    FOR ALL
+    USING (tenant_id = current_tenant_id() OR is_superadmin())
+    WITH CHECK (tenant_id = current_tenant_id() OR is_superadmin());
+
+ALTER TABLE sme_knowledge_chunk_versions FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,6 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+-- The 'stale_lesson_regen' and 'lessons_stale' values will remain in their enums
+
+DROP INDEX IF EXISTS idx_generated_lessons_stale;
+ALTER TABLE generated_lessons DROP COLUMN IF EXISTS stale_reason;
+ALTER TABLE generated_lessons DROP COLUMN IF EXISTS stale_since;
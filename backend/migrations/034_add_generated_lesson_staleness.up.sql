@@ -0,0 +1,13 @@
+-- Flag generated lessons whose cited SME knowledge changed after generation
+-- stale_since: when the lesson was found out of date (NULL while current)
+-- stale_reason: what changed, shown to the course owner
+ALTER TABLE generated_lessons ADD COLUMN stale_since TIMESTAMPTZ;
+ALTER TABLE generated_lessons ADD COLUMN stale_reason TEXT;
+
+CREATE INDEX idx_generated_lessons_stale ON generated_lessons(course_id) WHERE stale_since IS NOT NULL;
+
+-- Parent job regenerating a course's stale lessons
+ALTER TYPE generation_job_type ADD VALUE IF NOT EXISTS 'stale_lesson_regen';
+
+-- Notify course owners when lessons go stale
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'lessons_stale';
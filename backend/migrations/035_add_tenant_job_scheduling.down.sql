@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_generation_jobs_tenant_processing;
+ALTER TABLE tenant_ai_settings DROP COLUMN IF EXISTS scheduling_weight;
+ALTER TABLE tenant_ai_settings DROP COLUMN IF EXISTS max_concurrent_jobs;
@@ -0,0 +1,9 @@
+-- Per-tenant limits for fair scheduling of generation jobs across tenants
+-- max_concurrent_jobs: jobs of the tenant processed at once; the rest wait queued
+-- scheduling_weight: share of free workers relative to other tenants with queued jobs
+-- Tenants without AI settings use the defaults
+ALTER TABLE tenant_ai_settings ADD COLUMN max_concurrent_jobs INTEGER NOT NULL DEFAULT 3 CHECK (max_concurrent_jobs > 0);
+ALTER TABLE tenant_ai_settings ADD COLUMN scheduling_weight INTEGER NOT NULL DEFAULT 1 CHECK (scheduling_weight > 0);
+
+-- Count each tenant's running jobs when claiming
+CREATE INDEX idx_generation_jobs_tenant_processing ON generation_jobs(tenant_id) WHERE status = 'processing';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_companies_past_due_since;
+ALTER TABLE companies DROP COLUMN IF EXISTS past_due_since;
+ALTER TABLE tenants DROP COLUMN IF EXISTS suspension_reason;
+ALTER TABLE tenants DROP COLUMN IF EXISTS suspended_at;
@@ -0,0 +1,15 @@
+-- Tenant suspension details
+-- suspension_reason: subscription_canceled and payment_past_due are lifted when the
+-- subscription becomes active again; manual suspensions are only lifted by hand
+ALTER TABLE tenants ADD COLUMN suspended_at TIMESTAMPTZ;
+ALTER TABLE tenants ADD COLUMN suspension_reason VARCHAR(50)
+    CHECK (suspension_reason IN ('subscription_canceled', 'payment_past_due', 'manual'));
+
+UPDATE tenants SET suspended_at = updated_at, suspension_reason = 'manual' WHERE status = 'suspended';
+
+-- When the subscription became past_due, for suspension after the grace period
+ALTER TABLE companies ADD COLUMN past_due_since TIMESTAMPTZ;
+
+UPDATE companies SET past_due_since = updated_at WHERE subscription_status = 'past_due';
+
+CREATE INDEX idx_companies_past_due_since ON companies(past_due_since) WHERE past_due_since IS NOT NULL;
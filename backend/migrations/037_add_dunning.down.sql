@@ -0,0 +1,8 @@
+-- Note: Cannot remove enum values in PostgreSQL without recreating the type
+-- The 'payment_past_due' value will remain in the notification_type enum
+
+ALTER TABLE tenants DROP COLUMN IF EXISTS restricted_at;
+ALTER TABLE companies DROP COLUMN IF EXISTS dunning_invoice_url;
+ALTER TABLE companies DROP COLUMN IF EXISTS dunning_last_reminder_at;
+ALTER TABLE companies DROP COLUMN IF EXISTS dunning_reminders_sent;
+ALTER TABLE companies DROP COLUMN IF EXISTS dunning_stage;
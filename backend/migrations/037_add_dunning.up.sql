@@ -0,0 +1,15 @@
+-- Dunning of past due subscriptions, counted from past_due_since
+-- dunning_stage: reminding until features are restricted, restricted until the tenant is suspended
+ALTER TABLE companies ADD COLUMN dunning_stage VARCHAR(20) NOT NULL DEFAULT 'none'
+    CHECK (dunning_stage IN ('none', 'reminding', 'restricted', 'suspended'));
+ALTER TABLE companies ADD COLUMN dunning_reminders_sent INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE companies ADD COLUMN dunning_last_reminder_at TIMESTAMPTZ;
+ALTER TABLE companies ADD COLUMN dunning_invoice_url TEXT; -- Hosted page of the last failed invoice
+
+UPDATE companies SET dunning_stage = 'reminding' WHERE past_due_since IS NOT NULL;
+
+-- Tenants whose costly features are paused while a payment is overdue
+ALTER TABLE tenants ADD COLUMN restricted_at TIMESTAMPTZ;
+
+-- Remind owners and warn users about overdue payments
+ALTER TYPE notification_type ADD VALUE IF NOT EXISTS 'payment_past_due';
@@ -0,0 +1,5 @@
+ALTER TABLE companies DROP COLUMN IF EXISTS billing_email;
+ALTER TABLE companies DROP COLUMN IF EXISTS stripe_subscription_event_at;
+
+DROP POLICY IF EXISTS stripe_webhook_events_isolation ON stripe_webhook_events;
+DROP TABLE IF EXISTS stripe_webhook_events;
@@ -0,0 +1,40 @@
+-- Stripe webhook events
+-- Every delivered event is stored so redeliveries are recognized and failed events can be replayed
+
+CREATE TABLE stripe_webhook_events (
+    id VARCHAR(255) PRIMARY KEY, -- Stripe event ID (evt_...)
+    type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+
+    -- When Stripe created the event, used to order subscription updates
+    stripe_created_at TIMESTAMPTZ NOT NULL,
+
+    -- processing while a delivery is being applied; ignored when there was nothing to apply
+    status VARCHAR(20) NOT NULL DEFAULT 'processing'
+        CHECK (status IN ('processing', 'processed', 'ignored', 'failed')),
+    attempts INTEGER NOT NULL DEFAULT 1,
+    error_message TEXT,
+
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_stripe_webhook_events_status ON stripe_webhook_events(status, received_at DESC);
+CREATE INDEX idx_stripe_webhook_events_received_at ON stripe_webhook_events(received_at DESC);
+
+-- Events are platform-wide: only the webhook handler and platform administrators read them
+ALTER TABLE stripe_webhook_events ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY stripe_webhook_events_isolation ON stripe_webhook_events
+    FOR ALL
+    USING (is_superadmin())
+    WITH CHECK (is_superadmin());
+
+ALTER TABLE stripe_webhook_events FORCE ROW LEVEL SECURITY;
+
+-- Created time of the last subscription event applied; older events are skipped
+ALTER TABLE companies ADD COLUMN stripe_subscription_event_at TIMESTAMPTZ;
+
+-- Billing email of the Stripe customer, kept in sync by customer.updated
+ALTER TABLE companies ADD COLUMN billing_email VARCHAR(255);
@@ -0,0 +1,3 @@
+-- Per-company overrides of plan entitlements, negotiated in enterprise contracts
+-- Keys left out keep the plan default; a limit of 0 means unlimited
+ALTER TABLE companies ADD COLUMN entitlement_overrides JSONB;
@@ -0,0 +1,2 @@
+-- Remove explicit replacement from sme_task_submissions
+ALTER TABLE sme_task_submissions DROP COLUMN replaces_submission_id;
@@ -0,0 +1,3 @@
+-- Let a submission name the earlier submission it replaces
+-- replaces_submission_id: earlier submission whose knowledge this one replaces, when the sources don't match
+ALTER TABLE sme_task_submissions ADD COLUMN replaces_submission_id UUID REFERENCES sme_task_submissions(id) ON DELETE SET NULL;
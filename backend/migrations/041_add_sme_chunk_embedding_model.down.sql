@@ -0,0 +1,2 @@
+-- Remove the embedding model from sme_knowledge_chunks
+ALTER TABLE sme_knowledge_chunks DROP COLUMN embedding_model;
@@ -0,0 +1,6 @@
+-- Record which model computed each chunk embedding
+-- embedding_model: embeddings of different models can't be compared, so search only uses those of the tenant's current model
+ALTER TABLE sme_knowledge_chunks ADD COLUMN embedding_model VARCHAR(100);
+
+-- Embeddings so far were all computed by Gemini
+UPDATE sme_knowledge_chunks SET embedding_model = 'text-embedding-004' WHERE embedding IS NOT NULL;
@@ -0,0 +1,76 @@
+package httputil
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// PublicIP reports whether ip is a globally routable unicast address.
+func PublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast())
+}
+
+// NewPublicDialer creates a dialer that refuses to connect to non-public
+// addresses, except those within the allowed networks. The address is
+// checked at connect time so DNS answers and redirects cannot smuggle in an
+// internal address. Use it for requests to user-supplied URLs.
+func NewPublicDialer(allowed []*net.IPNet) *net.Dialer {
+	return &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("refusing to connect to non-public address %s", host)
+			}
+			if PublicIP(ip) {
+				return nil
+			}
+			for _, n := range allowed {
+				if n.Contains(ip) {
+					return nil
+				}
+			}
+			return fmt.Errorf("refusing to connect to non-public address %s", host)
+		},
+	}
+}
+
+// NewPublicClient creates an HTTP client like NewClientWithTimeout whose
+// connections go through NewPublicDialer.
+func NewPublicClient(timeout time.Duration, allowed []*net.IPNet) *http.Client {
+	client := NewClientWithTimeout(timeout)
+	client.Transport.(*http.Transport).DialContext = NewPublicDialer(allowed).DialContext
+	return client
+}
+
+// ParseNetworks parses CIDR networks such as "10.0.0.0/8". A bare IP
+// address is taken as a network of that single address.
+func ParseNetworks(values []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(values))
+	for _, v := range values {
+		if ip := net.ParseIP(v); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid network %q: %w", v, err)
+		}
+		networks = append(networks, n)
+	}
+	return networks, nil
+}
@@ -0,0 +1,92 @@
+package httputil
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPublicIP(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.226", false},
+		{"169.254.169.254", false}, // Cloud metadata
+		{"0.0.0.0", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"224.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := PublicIP(net.ParseIP(tt.ip)); got != tt.want {
+			t.Errorf("PublicIP(%s) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestPublicClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	tests := []struct {
+		name    string
+		allowed []*net.IPNet
+		wantErr bool
+	}{
+		{"refused", nil, true},
+		{"allowed network", []*net.IPNet{loopback}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewPublicClient(5*time.Second, tt.allowed).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, want error %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseNetworks(t *testing.T) {
+	networks, err := ParseNetworks([]string{"10.0.0.0/8", "192.168.1.226", "fd00::/8"})
+	if err != nil {
+		t.Fatalf("ParseNetworks() error = %v", err)
+	}
+	contains := map[string]bool{
+		"10.20.30.40":   true,
+		"192.168.1.226": true,
+		"192.168.1.227": false,
+		"fd12::1":       true,
+		"172.16.0.1":    false,
+	}
+	for ip, want := range contains {
+		got := false
+		for _, n := range networks {
+			got = got || n.Contains(net.ParseIP(ip))
+		}
+		if got != want {
+			t.Errorf("networks contain %s = %v, want %v", ip, got, want)
+		}
+	}
+
+	if _, err := ParseNetworks([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("ParseNetworks() accepted an invalid prefix length")
+	}
+	if _, err := ParseNetworks([]string{"ollama.internal"}); err == nil {
+		t.Error("ParseNetworks() accepted a host name")
+	}
+}
@@ -4,7 +4,7 @@ import React, { useState } from 'react';
 import { User, Bell, Lock, Palette, Globe, CreditCard, Users, ChevronRight, Sparkles, AlertCircle } from 'lucide-react';
 import BillingSettings from '@/components/settings/BillingSettings';
 import TeamSettings from '@/components/settings/TeamSettings';
-import { AISettingsPanel } from '@/components/settings/AISettingsPanel';
+import { AISettingsPanel, type SelfHostedConnection } from '@/components/settings/AISettingsPanel';
 import {
   useGetAISettings,
   useSetAPIKey,
@@ -22,12 +22,12 @@ function AISettingsContainer() {
   const testApiKey = useTestAPIKey();
   const removeApiKey = useRemoveAPIKey();
 
-  const handleSetApiKey = async (_provider: AIProvider, apiKey: string) => {
-    await setApiKey.mutate(apiKey);
+  const handleSetApiKey = async (provider: AIProvider, apiKey: string, connection?: SelfHostedConnection) => {
+    await setApiKey.mutate(provider, apiKey, connection);
   };
 
-  const handleTestApiKey = async (_provider: AIProvider, apiKey: string) => {
-    const result = await testApiKey.mutate(apiKey);
+  const handleTestApiKey = async (provider: AIProvider, apiKey: string, connection?: SelfHostedConnection) => {
+    const result = await testApiKey.mutate(provider, apiKey, connection);
     return {
       valid: result.valid,
       errorMessage: result.errorMessage,
@@ -18,6 +18,7 @@ import {
   useGetJob,
   useGetGeneratedLesson,
   GenerationJobStatus,
+  formatQueuePosition,
 } from '@/hooks/useAIGeneration';
 
 interface CourseBlockProps {
@@ -46,7 +47,7 @@ export default function CourseBlock({
 
   // Regeneration hooks
   const regenerateHook = useRegenerateComponent();
-  const { data: currentJob } = useGetJob(currentJobId || undefined);
+  const { data: currentJob, queuePosition } = useGetJob(currentJobId || undefined);
   const { data: lesson, refetch: refetchLesson } = useGetGeneratedLesson(lessonId);
 
   // Watch for job completion
@@ -281,7 +282,7 @@ export default function CourseBlock({
             disabled={!promptValue.trim() || isRegenerating}
             className="px-4 py-1.5 bg-purple-600 text-white text-sm font-medium rounded-lg hover:bg-purple-700 disabled:opacity-50 disabled:cursor-not-allowed transition-colors"
           >
-            {isRegenerating ? 'Updating...' : 'Apply'}
+            {isRegenerating ? (formatQueuePosition(queuePosition) ?? 'Updating...') : 'Apply'}
           </button>
         </div>
       </div>
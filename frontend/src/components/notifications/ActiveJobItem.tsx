@@ -14,6 +14,7 @@ const JOB_TYPE_LABELS: Record<number, string> = {
   [GenerationJobType.UNSPECIFIED]: 'Generation',
   [GenerationJobType.COURSE_OUTLINE]: 'Course Outline',
   [GenerationJobType.FULL_COURSE]: 'Full Course',
+  [GenerationJobType.STALE_LESSON_REGEN]: 'Stale Lessons',
   [GenerationJobType.COMPONENT_REGEN]: 'Component',
   [GenerationJobType.SME_INGESTION]: 'SME Ingestion',
 };
@@ -20,6 +20,8 @@ const TYPE_CONFIG: Record<number, { icon: string; color: string; bgColor: string
   6: { icon: '🎉', color: 'text-green-600', bgColor: 'bg-green-100' }, // GENERATION_COMPLETE
   7: { icon: '⚠️', color: 'text-red-600', bgColor: 'bg-red-100' }, // GENERATION_FAILED
   8: { icon: '👀', color: 'text-indigo-600', bgColor: 'bg-indigo-100' }, // APPROVAL_REQUESTED
+  9: { icon: '🔄', color: 'text-amber-600', bgColor: 'bg-amber-100' }, // LESSONS_STALE
+  10: { icon: '💳', color: 'text-red-600', bgColor: 'bg-red-100' }, // PAYMENT_PAST_DUE
 };
 
 const PRIORITY_INDICATOR: Record<number, string> = {
@@ -2,9 +2,15 @@
 
 import { useState } from 'react';
 import { useUIStore } from '@/store/zustand';
-import type { TenantAISettings, AIProvider, UsageByType } from '@/gen/mirai/v1/tenant_settings_pb';
+import type { TenantAISettings, AIProvider, UsageByType, DailyUsage } from '@/gen/mirai/v1/tenant_settings_pb';
 import { ResponsiveModal } from '@/components/ui/ResponsiveModal';
 
+// Connection details for a self-hosted OpenAI-compatible server
+export interface SelfHostedConnection {
+  baseUrl: string;
+  model: string;
+}
+
 interface AISettingsPanelProps {
   settings: TenantAISettings | null;
   usageStats?: {
@@ -12,18 +18,29 @@ interface AISettingsPanelProps {
     tokensThisMonth: bigint;
     monthlyLimit?: bigint;
     usageByType: UsageByType[];
+    dailyUsage: DailyUsage[];
+    projectedMonthEndTokens: bigint;
   };
   isLoading?: boolean;
-  onSetApiKey: (provider: AIProvider, apiKey: string) => Promise<void>;
-  onTestApiKey: (provider: AIProvider, apiKey: string) => Promise<{ valid: boolean; errorMessage?: string }>;
+  onSetApiKey: (provider: AIProvider, apiKey: string, connection?: SelfHostedConnection) => Promise<void>;
+  onTestApiKey: (
+    provider: AIProvider,
+    apiKey: string,
+    connection?: SelfHostedConnection
+  ) => Promise<{ valid: boolean; errorMessage?: string }>;
   onRemoveApiKey: () => Promise<void>;
 }
 
 const PROVIDER_CONFIG: Record<number, { name: string; description: string; docsUrl: string }> = {
   0: { name: 'Not Configured', description: 'Select an AI provider', docsUrl: '' },
   1: { name: 'Google Gemini', description: 'Google Gemini 2.0 Flash for AI generation', docsUrl: 'https://ai.google.dev/docs' },
+  2: { name: 'OpenAI', description: 'OpenAI GPT-4o mini for AI generation', docsUrl: 'https://platform.openai.com/docs' },
+  3: { name: 'Anthropic', description: 'Anthropic Claude 3.5 Haiku for AI generation', docsUrl: 'https://docs.anthropic.com' },
+  4: { name: 'Self-hosted', description: 'Self-hosted model with an OpenAI-compatible API', docsUrl: 'https://github.com/ollama/ollama/blob/main/docs/openai.md' },
 };
 
+const SELF_HOSTED_PROVIDER = 4;
+
 function formatTokens(tokens: bigint | number): string {
   const num = Number(tokens);
   if (num >= 1000000) return `${(num / 1000000).toFixed(2)}M`;
@@ -57,21 +74,29 @@ export function AISettingsPanel({
 
   const [apiKey, setApiKey] = useState('');
   const [provider, setProvider] = useState<AIProvider>(1); // Default to Gemini
+  const [baseUrl, setBaseUrl] = useState('');
+  const [model, setModel] = useState('');
   const [showRemoveConfirm, setShowRemoveConfirm] = useState(false);
 
   const providerConfig = settings ? PROVIDER_CONFIG[settings.provider] : PROVIDER_CONFIG[0];
+  const isSelfHosted = provider === SELF_HOSTED_PROVIDER;
+  // Self-hosted servers may run without an API key but need a URL and model
+  const canSubmit = isSelfHosted ? baseUrl.trim() !== '' && model.trim() !== '' : apiKey.trim() !== '';
+  const connection = isSelfHosted ? { baseUrl: baseUrl.trim(), model: model.trim() } : undefined;
 
   const handleOpenModal = () => {
     setApiKey('');
+    setBaseUrl(settings?.baseUrl ?? '');
+    setModel(settings?.model ?? '');
     resetApiKeyTest();
     openApiKeyModal();
   };
 
   const handleTestKey = async () => {
-    if (!apiKey.trim()) return;
+    if (!canSubmit) return;
     startApiKeyTest();
     try {
-      const result = await onTestApiKey(provider, apiKey);
+      const result = await onTestApiKey(provider, apiKey, connection);
       if (result.valid) {
         apiKeyTestSuccess();
       } else {
@@ -83,9 +108,9 @@ export function AISettingsPanel({
   };
 
   const handleSaveKey = async () => {
-    if (!apiKey.trim()) return;
+    if (!canSubmit) return;
     try {
-      await onSetApiKey(provider, apiKey);
+      await onSetApiKey(provider, apiKey, connection);
       closeApiKeyModal();
     } catch (error) {
       apiKeyTestFailed(error instanceof Error ? error.message : 'Failed to save API key');
@@ -169,6 +194,16 @@ export function AISettingsPanel({
         {settings?.apiKeyConfigured && (
           <div className="mt-4 p-4 bg-gray-50 rounded-lg">
             <p className="text-sm text-gray-600">{providerConfig.description}</p>
+            {settings.baseUrl && (
+              <p className="mt-1 text-sm text-gray-500">
+                {settings.model} at {settings.baseUrl}
+              </p>
+            )}
+            {!settings.semanticSearchAvailable && (
+              <p className="mt-1 text-sm text-amber-700">
+                This provider doesn't offer embeddings, so SME knowledge is searched by keywords only.
+              </p>
+            )}
             {providerConfig.docsUrl && (
               <a
                 href={providerConfig.docsUrl}
@@ -225,9 +260,18 @@ export function AISettingsPanel({
                   )}
                 </div>
                 <div className="p-4 bg-gray-50 rounded-lg">
-                  <p className="text-xs text-gray-500 uppercase tracking-wide">Total All Time</p>
-                  <p className="mt-1 text-2xl font-semibold text-gray-900">
-                    {formatTokens(usageStats.totalTokensUsed)}
+                  <p className="text-xs text-gray-500 uppercase tracking-wide">Projected Month End</p>
+                  <p
+                    className={`mt-1 text-2xl font-semibold ${
+                      usageStats.monthlyLimit && usageStats.projectedMonthEndTokens > usageStats.monthlyLimit
+                        ? 'text-red-600'
+                        : 'text-gray-900'
+                    }`}
+                  >
+                    {formatTokens(usageStats.projectedMonthEndTokens)}
+                  </p>
+                  <p className="text-xs text-gray-500">
+                    {formatTokens(usageStats.totalTokensUsed)} all time
                   </p>
                 </div>
               </div>
@@ -261,6 +305,27 @@ export function AISettingsPanel({
                 </div>
               )}
 
+              {/* Daily Usage */}
+              {usageStats.dailyUsage.length > 0 && (
+                <div>
+                  <h4 className="text-xs text-gray-500 uppercase tracking-wide mb-2">Daily Usage</h4>
+                  <div className="flex items-end gap-1 h-16">
+                    {usageStats.dailyUsage.map((day) => {
+                      const peak = Math.max(...usageStats.dailyUsage.map((d) => Number(d.tokensUsed)));
+                      const date = day.date ? new Date(Number(day.date.seconds) * 1000) : null;
+                      return (
+                        <div
+                          key={date?.toISOString() ?? String(day.tokensUsed)}
+                          className="flex-1 bg-blue-400 rounded-t"
+                          style={{ height: `${Math.max(4, (Number(day.tokensUsed) / peak) * 100)}%` }}
+                          title={`${date?.toLocaleDateString(undefined, { timeZone: 'UTC' }) ?? ''}: ${formatTokens(day.tokensUsed)}`}
+                        />
+                      );
+                    })}
+                  </div>
+                </div>
+              )}
+
               {/* Usage by Type */}
               {usageStats.usageByType.length > 0 && (
                 <div>
@@ -324,25 +389,64 @@ export function AISettingsPanel({
               </label>
               <select
                 value={provider}
-                onChange={(e) => setProvider(parseInt(e.target.value, 10) as AIProvider)}
+                onChange={(e) => {
+                  setProvider(parseInt(e.target.value, 10) as AIProvider);
+                  resetApiKeyTest();
+                }}
                 className="w-full px-3 py-2 border border-gray-300 rounded-md focus:ring-blue-500 focus:border-blue-500"
               >
                 <option value={1}>Google Gemini</option>
+                <option value={2}>OpenAI</option>
+                <option value={3}>Anthropic</option>
+                <option value={4}>Self-hosted (OpenAI-compatible)</option>
               </select>
               <p className="mt-1 text-xs text-gray-500">
-                Currently only Google Gemini is supported
+                Semantic knowledge search requires Google Gemini; other providers use keyword search
               </p>
             </div>
 
+            {isSelfHosted && (
+              <>
+                <div>
+                  <label className="block text-sm font-medium text-gray-700 mb-1">
+                    Base URL
+                  </label>
+                  <input
+                    type="url"
+                    value={baseUrl}
+                    onChange={(e) => setBaseUrl(e.target.value)}
+                    placeholder="http://localhost:11434/v1"
+                    className="w-full px-3 py-2 border border-gray-300 rounded-md focus:ring-blue-500 focus:border-blue-500"
+                  />
+                  <p className="mt-1 text-xs text-gray-500">
+                    The OpenAI-compatible endpoint of your server, e.g. Ollama or vLLM
+                  </p>
+                </div>
+
+                <div>
+                  <label className="block text-sm font-medium text-gray-700 mb-1">
+                    Model
+                  </label>
+                  <input
+                    type="text"
+                    value={model}
+                    onChange={(e) => setModel(e.target.value)}
+                    placeholder="llama3.1:8b"
+                    className="w-full px-3 py-2 border border-gray-300 rounded-md focus:ring-blue-500 focus:border-blue-500"
+                  />
+                </div>
+              </>
+            )}
+
             <div>
               <label className="block text-sm font-medium text-gray-700 mb-1">
-                API Key
+                API Key{isSelfHosted && ' (optional)'}
               </label>
               <input
                 type="password"
                 value={apiKey}
                 onChange={(e) => setApiKey(e.target.value)}
-                placeholder="Enter your API key"
+                placeholder={isSelfHosted ? 'Leave blank if your server has no authentication' : 'Enter your API key'}
                 className="w-full px-3 py-2 border border-gray-300 rounded-md focus:ring-blue-500 focus:border-blue-500"
               />
               <p className="mt-1 text-xs text-gray-500">
@@ -379,7 +483,7 @@ export function AISettingsPanel({
               <button
                 type="button"
                 onClick={handleTestKey}
-                disabled={!apiKey.trim() || isTestingApiKey}
+                disabled={!canSubmit || isTestingApiKey}
                 className="w-full sm:w-auto px-4 py-2 rounded-md border border-blue-300 bg-blue-50 text-blue-700 hover:bg-blue-100 font-medium disabled:opacity-50"
               >
                 {isTestingApiKey ? 'Testing...' : 'Test Key'}
@@ -387,7 +491,7 @@ export function AISettingsPanel({
               <button
                 type="button"
                 onClick={handleSaveKey}
-                disabled={!apiKey.trim() || apiKeyTestStatus !== 'success'}
+                disabled={!canSubmit || apiKeyTestStatus !== 'success'}
                 className="w-full sm:w-auto px-4 py-2 rounded-md bg-blue-600 text-white hover:bg-blue-700 font-medium disabled:opacity-50"
               >
                 Save Key
@@ -81,6 +81,14 @@ export const listJobs = AIGenerationService.method.listJobs;
  */
 export const cancelJob = AIGenerationService.method.cancelJob;
 
+/**
+ * ResumeJob continues a failed course generation job from the lessons that
+ * did not complete.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ResumeJob
+ */
+export const resumeJob = AIGenerationService.method.resumeJob;
+
 /**
  * GetGeneratedLesson returns generated lesson content.
  *
@@ -94,3 +102,17 @@ export const getGeneratedLesson = AIGenerationService.method.getGeneratedLesson;
  * @generated from rpc mirai.v1.AIGenerationService.ListGeneratedLessons
  */
 export const listGeneratedLessons = AIGenerationService.method.listGeneratedLessons;
+
+/**
+ * ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.ListStaleLessons
+ */
+export const listStaleLessons = AIGenerationService.method.listStaleLessons;
+
+/**
+ * RegenerateStaleLessons regenerates all stale lessons of a course.
+ *
+ * @generated from rpc mirai.v1.AIGenerationService.RegenerateStaleLessons
+ */
+export const regenerateStaleLessons = AIGenerationService.method.regenerateStaleLessons;
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ApproveCourseOutlineRequest, ApproveCourseOutlineResponse, CancelJobRequest, CancelJobResponse, GenerateAllLessonsRequest, GenerateAllLessonsResponse, GenerateCourseOutlineRequest, GenerateCourseOutlineResponse, GenerateLessonContentRequest, GenerateLessonContentResponse, GetCourseOutlineRequest, GetCourseOutlineResponse, GetGeneratedLessonRequest, GetGeneratedLessonResponse, GetJobRequest, GetJobResponse, ListGeneratedLessonsRequest, ListGeneratedLessonsResponse, ListJobsRequest, ListJobsResponse, RegenerateComponentRequest, RegenerateComponentResponse, RejectCourseOutlineRequest, RejectCourseOutlineResponse, UpdateCourseOutlineRequest, UpdateCourseOutlineResponse } from "./ai_generation_pb.js";
+import { ApproveCourseOutlineRequest, ApproveCourseOutlineResponse, CancelJobRequest, CancelJobResponse, GenerateAllLessonsRequest, GenerateAllLessonsResponse, GenerateCourseOutlineRequest, GenerateCourseOutlineResponse, GenerateLessonContentRequest, GenerateLessonContentResponse, GetCourseOutlineRequest, GetCourseOutlineResponse, GetGeneratedLessonRequest, GetGeneratedLessonResponse, GetJobRequest, GetJobResponse, ListGeneratedLessonsRequest, ListGeneratedLessonsResponse, ListJobsRequest, ListJobsResponse, ListStaleLessonsRequest, ListStaleLessonsResponse, RegenerateComponentRequest, RegenerateComponentResponse, RegenerateStaleLessonsRequest, RegenerateStaleLessonsResponse, RejectCourseOutlineRequest, RejectCourseOutlineResponse, ResumeJobRequest, ResumeJobResponse, UpdateCourseOutlineRequest, UpdateCourseOutlineResponse, WatchJobRequest, WatchJobResponse } from "./ai_generation_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -135,6 +135,30 @@ export const AIGenerationService = {
       O: CancelJobResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ResumeJob continues a failed course generation job from the lessons that
+     * did not complete.
+     *
+     * @generated from rpc mirai.v1.AIGenerationService.ResumeJob
+     */
+    resumeJob: {
+      name: "ResumeJob",
+      I: ResumeJobRequest,
+      O: ResumeJobResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WatchJob streams progress, finished sections and lessons, and final status
+     * for a job and its child jobs. The stream ends when the job finishes.
+     *
+     * @generated from rpc mirai.v1.AIGenerationService.WatchJob
+     */
+    watchJob: {
+      name: "WatchJob",
+      I: WatchJobRequest,
+      O: WatchJobResponse,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * GetGeneratedLesson returns generated lesson content.
      *
@@ -157,6 +181,28 @@ export const AIGenerationService = {
       O: ListGeneratedLessonsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+     *
+     * @generated from rpc mirai.v1.AIGenerationService.ListStaleLessons
+     */
+    listStaleLessons: {
+      name: "ListStaleLessons",
+      I: ListStaleLessonsRequest,
+      O: ListStaleLessonsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RegenerateStaleLessons regenerates all stale lessons of a course.
+     *
+     * @generated from rpc mirai.v1.AIGenerationService.RegenerateStaleLessons
+     */
+    regenerateStaleLessons: {
+      name: "RegenerateStaleLessons",
+      I: RegenerateStaleLessonsRequest,
+      O: RegenerateStaleLessonsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/ai_generation.proto.
  */
 export const file_mirai_v1_ai_generation: GenFile = /*@__PURE__*/
-  fileDesc("ChxtaXJhaS92MS9haV9nZW5lcmF0aW9uLnByb3RvEghtaXJhaS52MSKXBgoNR2VuZXJhdGlvbkpvYhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSKQoEdHlwZRgDIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlEi0KBnN0YXR1cxgEIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXMSFgoJY291cnNlX2lkGAUgASgJSACIAQESFgoJbGVzc29uX2lkGAYgASgJSAGIAQESGAoLc21lX3Rhc2tfaWQYByABKAlIAogBARIaCg1zdWJtaXNzaW9uX2lkGAggASgJSAOIAQESGAoQcHJvZ3Jlc3NfcGVyY2VudBgJIAEoBRIdChBwcm9ncmVzc19tZXNzYWdlGAogASgJSASIAQESGAoLcmVzdWx0X3BhdGgYCyABKAlIBYgBARIaCg1lcnJvcl9tZXNzYWdlGAwgASgJSAaIAQESEwoLdG9rZW5zX3VzZWQYDSABKAMSEwoLcmV0cnlfY291bnQYDiABKAUSEwoLbWF4X3JldHJpZXMYDyABKAUSGgoSY3JlYXRlZF9ieV91c2VyX2lkGBAgASgJEi4KCmNyZWF0ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCnN0YXJ0ZWRfYXQYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESNQoMY29tcGxldGVkX2F0GBMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBEhoKDXBhcmVudF9qb2JfaWQYFCABKAlICYgBAUIMCgpfY291cnNlX2lkQgwKCl9sZXNzb25faWRCDgoMX3NtZV90YXNrX2lkQhAKDl9zdWJtaXNzaW9uX2lkQhMKEV9wcm9ncmVzc19tZXNzYWdlQg4KDF9yZXN1bHRfcGF0aEIQCg5fZXJyb3JfbWVzc2FnZUINCgtfc3RhcnRlZF9hdEIPCg1fY29tcGxldGVkX2F0QhAKDl9wYXJlbnRfam9iX2lkIosDCg1Db3Vyc2VPdXRsaW5lEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRIPCgd2ZXJzaW9uGAMgASgFEioKCHNlY3Rpb25zGAQgAygLMhgubWlyYWkudjEuT3V0bGluZVNlY3Rpb24SOAoPYXBwcm92YWxfc3RhdHVzGAUgASgOMh8ubWlyYWkudjEuT3V0bGluZUFwcHJvdmFsU3RhdHVzEh0KEHJlamVjdGlvbl9yZWFzb24YBiABKAlIAIgBARIwCgxnZW5lcmF0ZWRfYXQYByABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjQKC2FwcHJvdmVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBEiAKE2FwcHJvdmVkX2J5X3VzZXJfaWQYCSABKAlIAogBAUITChFfcmVqZWN0aW9uX3JlYXNvbkIOCgxfYXBwcm92ZWRfYXRCFgoUX2FwcHJvdmVkX2J5X3VzZXJfaWQieQoOT3V0bGluZVNlY3Rpb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDQoFb3JkZXIYBCABKAUSKAoHbGVzc29ucxgFIAMoCzIXLm1pcmFpLnYxLk91dGxpbmVMZXNzb24ixgEKDU91dGxpbmVMZXNzb24SCgoCaWQYASABKAkSDQoFdGl0bGUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSDQoFb3JkZXIYBCABKAUSIgoaZXN0aW1hdGVkX2R1cmF0aW9uX21pbnV0ZXMYBSABKAUSGwoTbGVhcm5pbmdfb2JqZWN0aXZlcxgGIAMoCRIaChJpc19sYXN0X2luX3NlY3Rpb24YByABKAgSGQoRaXNfbGFzdF9pbl9jb3Vyc2UYCCABKAgi9wEKD0dlbmVyYXRlZExlc3NvbhIKCgJpZBgBIAEoCRIRCgljb3Vyc2VfaWQYAiABKAkSEgoKc2VjdGlvbl9pZBgDIAEoCRIZChFvdXRsaW5lX2xlc3Nvbl9pZBgEIAEoCRINCgV0aXRsZRgFIAEoCRItCgpjb21wb25lbnRzGAYgAygLMhkubWlyYWkudjEuTGVzc29uQ29tcG9uZW50EhcKCnNlZ3VlX3RleHQYByABKAlIAIgBARIwCgxnZW5lcmF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQg0KC19zZWd1ZV90ZXh0IrMBCg9MZXNzb25Db21wb25lbnQSCgoCaWQYASABKAkSKwoEdHlwZRgCIAEoDjIdLm1pcmFpLnYxLkxlc3NvbkNvbXBvbmVudFR5cGUSDQoFb3JkZXIYAyABKAUSFAoMY29udGVudF9qc29uGAQgASgJEjQKCWFsaWdubWVudBgFIAEoCzIcLm1pcmFpLnYxLkNvbXBvbmVudEFsaWdubWVudEgAiAEBQgwKCl9hbGlnbm1lbnQiSwoSQ29tcG9uZW50QWxpZ25tZW50EhUKDXNtZV9jaHVua19pZHMYASADKAkSHgoWbGVhcm5pbmdfb2JqZWN0aXZlX2lkcxgCIAMoCSIuCgtUZXh0Q29udGVudBIMCgRodG1sGAEgASgJEhEKCXBsYWludGV4dBgCIAEoCSJFCg5IZWFkaW5nQ29udGVudBIlCgVsZXZlbBgBIAEoDjIWLm1pcmFpLnYxLkhlYWRpbmdMZXZlbBIMCgR0ZXh0GAIgASgJIk8KDEltYWdlQ29udGVudBILCgN1cmwYASABKAkSEAoIYWx0X3RleHQYAiABKAkSFAoHY2FwdGlvbhgDIAEoCUgAiAEBQgoKCF9jYXB0aW9uIvkBCgtRdWl6Q29udGVudBIQCghxdWVzdGlvbhgBIAEoCRIVCg1xdWVzdGlvbl90eXBlGAIgASgJEiUKB29wdGlvbnMYAyADKAsyFC5taXJhaS52MS5RdWl6T3B0aW9uEhkKEWNvcnJlY3RfYW5zd2VyX2lkGAQgASgJEhMKC2V4cGxhbmF0aW9uGAUgASgJEh0KEGNvcnJlY3RfZmVlZGJhY2sYBiABKAlIAIgBARIfChJpbmNvcnJlY3RfZmVlZGJhY2sYByABKAlIAYgBAUITChFfY29ycmVjdF9mZWVkYmFja0IVChNfaW5jb3JyZWN0X2ZlZWRiYWNrIiYKClF1aXpPcHRpb24SCgoCaWQYASABKAkSDAoEdGV4dBgCIAEoCSKpAQoVQ291cnNlR2VuZXJhdGlvbklucHV0EhEKCWNvdXJzZV9pZBgBIAEoCRIPCgdzbWVfaWRzGAIgAygJEhsKE3RhcmdldF9hdWRpZW5jZV9pZHMYAyADKAkSFwoPZGVzaXJlZF9vdXRjb21lGAQgASgJEh8KEmFkZGl0aW9uYWxfY29udGV4dBgFIAEoCUgAiAEBQhUKE19hZGRpdGlvbmFsX2NvbnRleHQiTgocR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIuCgVpbnB1dBgBIAEoCzIfLm1pcmFpLnYxLkNvdXJzZUdlbmVyYXRpb25JbnB1dCJFCh1HZW5lcmF0ZUNvdXJzZU91dGxpbmVSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIk4KF0dldENvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIUCgd2ZXJzaW9uGAIgASgFSACIAQFCCgoIX3ZlcnNpb24iRAoYR2V0Q291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIkQKG0FwcHJvdmVDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEgoKb3V0bGluZV9pZBgCIAEoCSJIChxBcHByb3ZlQ291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIlMKGlJlamVjdENvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRISCgpvdXRsaW5lX2lkGAIgASgJEg4KBnJlYXNvbhgDIAEoCSJHChtSZWplY3RDb3Vyc2VPdXRsaW5lUmVzcG9uc2USKAoHb3V0bGluZRgBIAEoCzIXLm1pcmFpLnYxLkNvdXJzZU91dGxpbmUibwoaVXBkYXRlQ291cnNlT3V0bGluZVJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhIKCm91dGxpbmVfaWQYAiABKAkSKgoIc2VjdGlvbnMYAyADKAsyGC5taXJhaS52MS5PdXRsaW5lU2VjdGlvbiJHChtVcGRhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USKAoHb3V0bGluZRgBIAEoCzIXLm1pcmFpLnYxLkNvdXJzZU91dGxpbmUiTAocR2VuZXJhdGVMZXNzb25Db250ZW50UmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSGQoRb3V0bGluZV9sZXNzb25faWQYAiABKAkiRQodR2VuZXJhdGVMZXNzb25Db250ZW50UmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIuChlHZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSJCChpHZW5lcmF0ZUFsbExlc3NvbnNSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iInUKGlJlZ2VuZXJhdGVDb21wb25lbnRSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRIRCglsZXNzb25faWQYAiABKAkSFAoMY29tcG9uZW50X2lkGAMgASgJEhsKE21vZGlmaWNhdGlvbl9wcm9tcHQYBCABKAkiQwobUmVnZW5lcmF0ZUNvbXBvbmVudFJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiHwoNR2V0Sm9iUmVxdWVzdBIOCgZqb2JfaWQYASABKAkiNgoOR2V0Sm9iUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiKvAQoPTGlzdEpvYnNSZXF1ZXN0Ei4KBHR5cGUYASABKA4yGy5taXJhaS52MS5HZW5lcmF0aW9uSm9iVHlwZUgAiAEBEjIKBnN0YXR1cxgCIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXNIAYgBARIWCgljb3Vyc2VfaWQYAyABKAlIAogBAUIHCgVfdHlwZUIJCgdfc3RhdHVzQgwKCl9jb3Vyc2VfaWQiOQoQTGlzdEpvYnNSZXNwb25zZRIlCgRqb2JzGAEgAygLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIiChBDYW5jZWxKb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSI5ChFDYW5jZWxKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIi4KGUdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QSEQoJbGVzc29uX2lkGAEgASgJIkcKGkdldEdlbmVyYXRlZExlc3NvblJlc3BvbnNlEikKBmxlc3NvbhgBIAEoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3NvbiIwChtMaXN0R2VuZXJhdGVkTGVzc29uc1JlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJIkoKHExpc3RHZW5lcmF0ZWRMZXNzb25zUmVzcG9uc2USKgoHbGVzc29ucxgBIAMoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3Nvbir9AQoRR2VuZXJhdGlvbkpvYlR5cGUSIwofR0VORVJBVElPTl9KT0JfVFlQRV9VTlNQRUNJRklFRBAAEiUKIUdFTkVSQVRJT05fSk9CX1RZUEVfU01FX0lOR0VTVElPThABEiYKIkdFTkVSQVRJT05fSk9CX1RZUEVfQ09VUlNFX09VVExJTkUQAhImCiJHRU5FUkFUSU9OX0pPQl9UWVBFX0xFU1NPTl9DT05URU5UEAMSJwojR0VORVJBVElPTl9KT0JfVFlQRV9DT01QT05FTlRfUkVHRU4QBBIjCh9HRU5FUkFUSU9OX0pPQl9UWVBFX0ZVTExfQ09VUlNFEAUq8AEKE0dlbmVyYXRpb25Kb2JTdGF0dXMSJQohR0VORVJBVElPTl9KT0JfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocR0VORVJBVElPTl9KT0JfU1RBVFVTX1FVRVVFRBABEiQKIEdFTkVSQVRJT05fSk9CX1NUQVRVU19QUk9DRVNTSU5HEAISIwofR0VORVJBVElPTl9KT0JfU1RBVFVTX0NPTVBMRVRFRBADEiAKHEdFTkVSQVRJT05fSk9CX1NUQVRVU19GQUlMRUQQBBIjCh9HRU5FUkFUSU9OX0pPQl9TVEFUVVNfQ0FOQ0VMTEVEEAUq6AEKFU91dGxpbmVBcHByb3ZhbFN0YXR1cxInCiNPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19VTlNQRUNJRklFRBAAEioKJk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1BFTkRJTkdfUkVWSUVXEAESJAogT1VUTElORV9BUFBST1ZBTF9TVEFUVVNfQVBQUk9WRUQQAhIkCiBPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19SRUpFQ1RFRBADEi4KKk9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1JFVklTSU9OX1JFUVVFU1RFRBAEKsABChNMZXNzb25Db21wb25lbnRUeXBlEiUKIUxFU1NPTl9DT01QT05FTlRfVFlQRV9VTlNQRUNJRklFRBAAEh4KGkxFU1NPTl9DT01QT05FTlRfVFlQRV9URVhUEAESIQodTEVTU09OX0NPTVBPTkVOVF9UWVBFX0hFQURJTkcQAhIfChtMRVNTT05fQ09NUE9ORU5UX1RZUEVfSU1BR0UQAxIeChpMRVNTT05fQ09NUE9ORU5UX1RZUEVfUVVJWhAEKoUBCgxIZWFkaW5nTGV2ZWwSHQoZSEVBRElOR19MRVZFTF9VTlNQRUNJRklFRBAAEhQKEEhFQURJTkdfTEVWRUxfSDEQARIUChBIRUFESU5HX0xFVkVMX0gyEAISFAoQSEVBRElOR19MRVZFTF9IMxADEhQKEEhFQURJTkdfTEVWRUxfSDQQBDLGCQoTQUlHZW5lcmF0aW9uU2VydmljZRJoChVHZW5lcmF0ZUNvdXJzZU91dGxpbmUSJi5taXJhaS52MS5HZW5lcmF0ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GicubWlyYWkudjEuR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USWQoQR2V0Q291cnNlT3V0bGluZRIhLm1pcmFpLnYxLkdldENvdXJzZU91dGxpbmVSZXF1ZXN0GiIubWlyYWkudjEuR2V0Q291cnNlT3V0bGluZVJlc3BvbnNlEmUKFEFwcHJvdmVDb3Vyc2VPdXRsaW5lEiUubWlyYWkudjEuQXBwcm92ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GiYubWlyYWkudjEuQXBwcm92ZUNvdXJzZU91dGxpbmVSZXNwb25zZRJiChNSZWplY3RDb3Vyc2VPdXRsaW5lEiQubWlyYWkudjEuUmVqZWN0Q291cnNlT3V0bGluZVJlcXVlc3QaJS5taXJhaS52MS5SZWplY3RDb3Vyc2VPdXRsaW5lUmVzcG9uc2USYgoTVXBkYXRlQ291cnNlT3V0bGluZRIkLm1pcmFpLnYxLlVwZGF0ZUNvdXJzZU91dGxpbmVSZXF1ZXN0GiUubWlyYWkudjEuVXBkYXRlQ291cnNlT3V0bGluZVJlc3BvbnNlEmgKFUdlbmVyYXRlTGVzc29uQ29udGVudBImLm1pcmFpLnYxLkdlbmVyYXRlTGVzc29uQ29udGVudFJlcXVlc3QaJy5taXJhaS52MS5HZW5lcmF0ZUxlc3NvbkNvbnRlbnRSZXNwb25zZRJfChJHZW5lcmF0ZUFsbExlc3NvbnMSIy5taXJhaS52MS5HZW5lcmF0ZUFsbExlc3NvbnNSZXF1ZXN0GiQubWlyYWkudjEuR2VuZXJhdGVBbGxMZXNzb25zUmVzcG9uc2USYgoTUmVnZW5lcmF0ZUNvbXBvbmVudBIkLm1pcmFpLnYxLlJlZ2VuZXJhdGVDb21wb25lbnRSZXF1ZXN0GiUubWlyYWkudjEuUmVnZW5lcmF0ZUNvbXBvbmVudFJlc3BvbnNlEjsKBkdldEpvYhIXLm1pcmFpLnYxLkdldEpvYlJlcXVlc3QaGC5taXJhaS52MS5HZXRKb2JSZXNwb25zZRJBCghMaXN0Sm9icxIZLm1pcmFpLnYxLkxpc3RKb2JzUmVxdWVzdBoaLm1pcmFpLnYxLkxpc3RKb2JzUmVzcG9uc2USRAoJQ2FuY2VsSm9iEhoubWlyYWkudjEuQ2FuY2VsSm9iUmVxdWVzdBobLm1pcmFpLnYxLkNhbmNlbEpvYlJlc3BvbnNlEl8KEkdldEdlbmVyYXRlZExlc3NvbhIjLm1pcmFpLnYxLkdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QaJC5taXJhaS52MS5HZXRHZW5lcmF0ZWRMZXNzb25SZXNwb25zZRJlChRMaXN0R2VuZXJhdGVkTGVzc29ucxIlLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVxdWVzdBomLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVzcG9uc2VClwEKDGNvbS5taXJhaS52MUIRQWlHZW5lcmF0aW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChxtaXJhaS92MS9haV9nZW5lcmF0aW9uLnByb3RvEghtaXJhaS52MSK0BgoNR2VuZXJhdGlvbkpvYhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSKQoEdHlwZRgDIAEoDjIbLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JUeXBlEi0KBnN0YXR1cxgEIAEoDjIdLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JTdGF0dXMSFgoJY291cnNlX2lkGAUgASgJSACIAQESFgoJbGVzc29uX2lkGAYgASgJSAGIAQESGAoLc21lX3Rhc2tfaWQYByABKAlIAogBARIaCg1zdWJtaXNzaW9uX2lkGAggASgJSAOIAQESGAoQcHJvZ3Jlc3NfcGVyY2VudBgJIAEoBRIdChBwcm9ncmVzc19tZXNzYWdlGAogASgJSASIAQESGAoLcmVzdWx0X3BhdGgYCyABKAlIBYgBARIaCg1lcnJvcl9tZXNzYWdlGAwgASgJSAaIAQESEwoLdG9rZW5zX3VzZWQYDSABKAMSEwoLcmV0cnlfY291bnQYDiABKAUSEwoLbWF4X3JldHJpZXMYDyABKAUSGgoSY3JlYXRlZF9ieV91c2VyX2lkGBAgASgJEi4KCmNyZWF0ZWRfYXQYESABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjMKCnN0YXJ0ZWRfYXQYEiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAeIAQESNQoMY29tcGxldGVkX2F0GBMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgIiAEBEhoKDXBhcmVudF9qb2JfaWQYFCABKAlICYgBARIbChNyZXRyaWV2ZWRfY2h1bmtfaWRzGBUgAygJQgwKCl9jb3Vyc2VfaWRCDAoKX2xlc3Nvbl9pZEIOCgxfc21lX3Rhc2tfaWRCEAoOX3N1Ym1pc3Npb25faWRCEwoRX3Byb2dyZXNzX21lc3NhZ2VCDgoMX3Jlc3VsdF9wYXRoQhAKDl9lcnJvcl9tZXNzYWdlQg0KC19zdGFydGVkX2F0Qg8KDV9jb21wbGV0ZWRfYXRCEAoOX3BhcmVudF9qb2JfaWQiiwMKDUNvdXJzZU91dGxpbmUSCgoCaWQYASABKAkSEQoJY291cnNlX2lkGAIgASgJEg8KB3ZlcnNpb24YAyABKAUSKgoIc2VjdGlvbnMYBCADKAsyGC5taXJhaS52MS5PdXRsaW5lU2VjdGlvbhI4Cg9hcHByb3ZhbF9zdGF0dXMYBSABKA4yHy5taXJhaS52MS5PdXRsaW5lQXBwcm92YWxTdGF0dXMSHQoQcmVqZWN0aW9uX3JlYXNvbhgGIAEoCUgAiAEBEjAKDGdlbmVyYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoLYXBwcm92ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESIAoTYXBwcm92ZWRfYnlfdXNlcl9pZBgJIAEoCUgCiAEBQhMKEV9yZWplY3Rpb25fcmVhc29uQg4KDF9hcHByb3ZlZF9hdEIWChRfYXBwcm92ZWRfYnlfdXNlcl9pZCJ5Cg5PdXRsaW5lU2VjdGlvbhIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRINCgVvcmRlchgEIAEoBRIoCgdsZXNzb25zGAUgAygLMhcubWlyYWkudjEuT3V0bGluZUxlc3NvbiLGAQoNT3V0bGluZUxlc3NvbhIKCgJpZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRINCgVvcmRlchgEIAEoBRIiChplc3RpbWF0ZWRfZHVyYXRpb25fbWludXRlcxgFIAEoBRIbChNsZWFybmluZ19vYmplY3RpdmVzGAYgAygJEhoKEmlzX2xhc3RfaW5fc2VjdGlvbhgHIAEoCBIZChFpc19sYXN0X2luX2NvdXJzZRgIIAEoCCLUAgoPR2VuZXJhdGVkTGVzc29uEgoKAmlkGAEgASgJEhEKCWNvdXJzZV9pZBgCIAEoCRISCgpzZWN0aW9uX2lkGAMgASgJEhkKEW91dGxpbmVfbGVzc29uX2lkGAQgASgJEg0KBXRpdGxlGAUgASgJEi0KCmNvbXBvbmVudHMYBiADKAsyGS5taXJhaS52MS5MZXNzb25Db21wb25lbnQSFwoKc2VndWVfdGV4dBgHIAEoCUgAiAEBEjAKDGdlbmVyYXRlZF9hdBgIIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLc3RhbGVfc2luY2UYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhkKDHN0YWxlX3JlYXNvbhgKIAEoCUgBiAEBQg0KC19zZWd1ZV90ZXh0Qg8KDV9zdGFsZV9yZWFzb24iswEKD0xlc3NvbkNvbXBvbmVudBIKCgJpZBgBIAEoCRIrCgR0eXBlGAIgASgOMh0ubWlyYWkudjEuTGVzc29uQ29tcG9uZW50VHlwZRINCgVvcmRlchgDIAEoBRIUCgxjb250ZW50X2pzb24YBCABKAkSNAoJYWxpZ25tZW50GAUgASgLMhwubWlyYWkudjEuQ29tcG9uZW50QWxpZ25tZW50SACIAQFCDAoKX2FsaWdubWVudCJLChJDb21wb25lbnRBbGlnbm1lbnQSFQoNc21lX2NodW5rX2lkcxgBIAMoCRIeChZsZWFybmluZ19vYmplY3RpdmVfaWRzGAIgAygJIsYCCg9Db21wb25lbnRTb3VyY2USEAoIY2h1bmtfaWQYASABKAkSDgoGc21lX2lkGAIgASgJEg0KBXRvcGljGAMgASgJEhoKDXN1Ym1pc3Npb25faWQYBCABKAlIAIgBARIWCglmaWxlX25hbWUYBSABKAlIAYgBARIbCg5zb3VyY2VfaGVhZGluZxgGIAEoCUgCiAEBEhgKC3NvdXJjZV9wYWdlGAcgASgFSAOIAQESJQoYc291cmNlX3RpbWVzdGFtcF9zZWNvbmRzGAggASgFSASIAQESEAoIb3V0ZGF0ZWQYCSABKAhCEAoOX3N1Ym1pc3Npb25faWRCDAoKX2ZpbGVfbmFtZUIRCg9fc291cmNlX2hlYWRpbmdCDgoMX3NvdXJjZV9wYWdlQhsKGV9zb3VyY2VfdGltZXN0YW1wX3NlY29uZHMiLgoLVGV4dENvbnRlbnQSDAoEaHRtbBgBIAEoCRIRCglwbGFpbnRleHQYAiABKAkiRQoOSGVhZGluZ0NvbnRlbnQSJQoFbGV2ZWwYASABKA4yFi5taXJhaS52MS5IZWFkaW5nTGV2ZWwSDAoEdGV4dBgCIAEoCSJPCgxJbWFnZUNvbnRlbnQSCwoDdXJsGAEgASgJEhAKCGFsdF90ZXh0GAIgASgJEhQKB2NhcHRpb24YAyABKAlIAIgBAUIKCghfY2FwdGlvbiL5AQoLUXVpekNvbnRlbnQSEAoIcXVlc3Rpb24YASABKAkSFQoNcXVlc3Rpb25fdHlwZRgCIAEoCRIlCgdvcHRpb25zGAMgAygLMhQubWlyYWkudjEuUXVpek9wdGlvbhIZChFjb3JyZWN0X2Fuc3dlcl9pZBgEIAEoCRITCgtleHBsYW5hdGlvbhgFIAEoCRIdChBjb3JyZWN0X2ZlZWRiYWNrGAYgASgJSACIAQESHwoSaW5jb3JyZWN0X2ZlZWRiYWNrGAcgASgJSAGIAQFCEwoRX2NvcnJlY3RfZmVlZGJhY2tCFQoTX2luY29ycmVjdF9mZWVkYmFjayImCgpRdWl6T3B0aW9uEgoKAmlkGAEgASgJEgwKBHRleHQYAiABKAkiqQEKFUNvdXJzZUdlbmVyYXRpb25JbnB1dBIRCgljb3Vyc2VfaWQYASABKAkSDwoHc21lX2lkcxgCIAMoCRIbChN0YXJnZXRfYXVkaWVuY2VfaWRzGAMgAygJEhcKD2Rlc2lyZWRfb3V0Y29tZRgEIAEoCRIfChJhZGRpdGlvbmFsX2NvbnRleHQYBSABKAlIAIgBAUIVChNfYWRkaXRpb25hbF9jb250ZXh0Ik4KHEdlbmVyYXRlQ291cnNlT3V0bGluZVJlcXVlc3QSLgoFaW5wdXQYASABKAsyHy5taXJhaS52MS5Db3Vyc2VHZW5lcmF0aW9uSW5wdXQiRQodR2VuZXJhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiJOChdHZXRDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSFAoHdmVyc2lvbhgCIAEoBUgAiAEBQgoKCF92ZXJzaW9uIkQKGEdldENvdXJzZU91dGxpbmVSZXNwb25zZRIoCgdvdXRsaW5lGAEgASgLMhcubWlyYWkudjEuQ291cnNlT3V0bGluZSJEChtBcHByb3ZlQ291cnNlT3V0bGluZVJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhIKCm91dGxpbmVfaWQYAiABKAkiSAocQXBwcm92ZUNvdXJzZU91dGxpbmVSZXNwb25zZRIoCgdvdXRsaW5lGAEgASgLMhcubWlyYWkudjEuQ291cnNlT3V0bGluZSJTChpSZWplY3RDb3Vyc2VPdXRsaW5lUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEgoKb3V0bGluZV9pZBgCIAEoCRIOCgZyZWFzb24YAyABKAkiRwobUmVqZWN0Q291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIm8KGlVwZGF0ZUNvdXJzZU91dGxpbmVSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCRISCgpvdXRsaW5lX2lkGAIgASgJEioKCHNlY3Rpb25zGAMgAygLMhgubWlyYWkudjEuT3V0bGluZVNlY3Rpb24iRwobVXBkYXRlQ291cnNlT3V0bGluZVJlc3BvbnNlEigKB291dGxpbmUYASABKAsyFy5taXJhaS52MS5Db3Vyc2VPdXRsaW5lIkwKHEdlbmVyYXRlTGVzc29uQ29udGVudFJlcXVlc3QSEQoJY291cnNlX2lkGAEgASgJEhkKEW91dGxpbmVfbGVzc29uX2lkGAIgASgJIkUKHUdlbmVyYXRlTGVzc29uQ29udGVudFJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiLgoZR2VuZXJhdGVBbGxMZXNzb25zUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkiQgoaR2VuZXJhdGVBbGxMZXNzb25zUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiJ1ChpSZWdlbmVyYXRlQ29tcG9uZW50UmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkSEQoJbGVzc29uX2lkGAIgASgJEhQKDGNvbXBvbmVudF9pZBgDIAEoCRIbChNtb2RpZmljYXRpb25fcHJvbXB0GAQgASgJIkMKG1JlZ2VuZXJhdGVDb21wb25lbnRSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIh8KDUdldEpvYlJlcXVlc3QSDgoGam9iX2lkGAEgASgJImMKDkdldEpvYlJlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2ISFgoOcXVldWVfcG9zaXRpb24YAiABKAUSEwoLcXVldWVfZGVwdGgYAyABKAUirwEKD0xpc3RKb2JzUmVxdWVzdBIuCgR0eXBlGAEgASgOMhsubWlyYWkudjEuR2VuZXJhdGlvbkpvYlR5cGVIAIgBARIyCgZzdGF0dXMYAiABKA4yHS5taXJhaS52MS5HZW5lcmF0aW9uSm9iU3RhdHVzSAGIAQESFgoJY291cnNlX2lkGAMgASgJSAKIAQFCBwoFX3R5cGVCCQoHX3N0YXR1c0IMCgpfY291cnNlX2lkIjkKEExpc3RKb2JzUmVzcG9uc2USJQoEam9icxgBIAMoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IiIgoQQ2FuY2VsSm9iUmVxdWVzdBIOCgZqb2JfaWQYASABKAkiOQoRQ2FuY2VsSm9iUmVzcG9uc2USJAoDam9iGAEgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYiIiChBSZXN1bWVKb2JSZXF1ZXN0Eg4KBmpvYl9pZBgBIAEoCSI5ChFSZXN1bWVKb2JSZXNwb25zZRIkCgNqb2IYASABKAsyFy5taXJhaS52MS5HZW5lcmF0aW9uSm9iIiEKD1dhdGNoSm9iUmVxdWVzdBIOCgZqb2JfaWQYASABKAkixAEKEFdhdGNoSm9iUmVzcG9uc2USNAoKZXZlbnRfdHlwZRgBIAEoDjIgLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2JFdmVudFR5cGUSJAoDam9iGAIgASgLMhcubWlyYWkudjEuR2VuZXJhdGlvbkpvYhIpCgdzZWN0aW9uGAMgASgLMhgubWlyYWkudjEuT3V0bGluZVNlY3Rpb24SKQoGbGVzc29uGAQgASgLMhkubWlyYWkudjEuR2VuZXJhdGVkTGVzc29uIi4KGUdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QSEQoJbGVzc29uX2lkGAEgASgJInMKGkdldEdlbmVyYXRlZExlc3NvblJlc3BvbnNlEikKBmxlc3NvbhgBIAEoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3NvbhIqCgdzb3VyY2VzGAIgAygLMhkubWlyYWkudjEuQ29tcG9uZW50U291cmNlIjAKG0xpc3RHZW5lcmF0ZWRMZXNzb25zUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkiSgocTGlzdEdlbmVyYXRlZExlc3NvbnNSZXNwb25zZRIqCgdsZXNzb25zGAEgAygLMhkubWlyYWkudjEuR2VuZXJhdGVkTGVzc29uIiwKF0xpc3RTdGFsZUxlc3NvbnNSZXF1ZXN0EhEKCWNvdXJzZV9pZBgBIAEoCSJGChhMaXN0U3RhbGVMZXNzb25zUmVzcG9uc2USKgoHbGVzc29ucxgBIAMoCzIZLm1pcmFpLnYxLkdlbmVyYXRlZExlc3NvbiIyCh1SZWdlbmVyYXRlU3RhbGVMZXNzb25zUmVxdWVzdBIRCgljb3Vyc2VfaWQYASABKAkiRgoeUmVnZW5lcmF0ZVN0YWxlTGVzc29uc1Jlc3BvbnNlEiQKA2pvYhgBIAEoCzIXLm1pcmFpLnYxLkdlbmVyYXRpb25Kb2IqqQIKEUdlbmVyYXRpb25Kb2JUeXBlEiMKH0dFTkVSQVRJT05fSk9CX1RZUEVfVU5TUEVDSUZJRUQQABIlCiFHRU5FUkFUSU9OX0pPQl9UWVBFX1NNRV9JTkdFU1RJT04QARImCiJHRU5FUkFUSU9OX0pPQl9UWVBFX0NPVVJTRV9PVVRMSU5FEAISJgoiR0VORVJBVElPTl9KT0JfVFlQRV9MRVNTT05fQ09OVEVOVBADEicKI0dFTkVSQVRJT05fSk9CX1RZUEVfQ09NUE9ORU5UX1JFR0VOEAQSIwofR0VORVJBVElPTl9KT0JfVFlQRV9GVUxMX0NPVVJTRRAFEioKJkdFTkVSQVRJT05fSk9CX1RZUEVfU1RBTEVfTEVTU09OX1JFR0VOEAYq8AEKE0dlbmVyYXRpb25Kb2JTdGF0dXMSJQohR0VORVJBVElPTl9KT0JfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIAocR0VORVJBVElPTl9KT0JfU1RBVFVTX1FVRVVFRBABEiQKIEdFTkVSQVRJT05fSk9CX1NUQVRVU19QUk9DRVNTSU5HEAISIwofR0VORVJBVElPTl9KT0JfU1RBVFVTX0NPTVBMRVRFRBADEiAKHEdFTkVSQVRJT05fSk9CX1NUQVRVU19GQUlMRUQQBBIjCh9HRU5FUkFUSU9OX0pPQl9TVEFUVVNfQ0FOQ0VMTEVEEAUqkwIKFkdlbmVyYXRpb25Kb2JFdmVudFR5cGUSKQolR0VORVJBVElPTl9KT0JfRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEiYKIkdFTkVSQVRJT05fSk9CX0VWRU5UX1RZUEVfUFJPR1JFU1MQARIrCidHRU5FUkFUSU9OX0pPQl9FVkVOVF9UWVBFX1NFQ1RJT05fUkVBRFkQAhIqCiZHRU5FUkFUSU9OX0pPQl9FVkVOVF9UWVBFX0xFU1NPTl9SRUFEWRADEiQKIEdFTkVSQVRJT05fSk9CX0VWRU5UX1RZUEVfU1RBVFVTEAQSJwojR0VORVJBVElPTl9KT0JfRVZFTlRfVFlQRV9LRUVQQUxJVkUQBSroAQoVT3V0bGluZUFwcHJvdmFsU3RhdHVzEicKI09VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1VOU1BFQ0lGSUVEEAASKgomT1VUTElORV9BUFBST1ZBTF9TVEFUVVNfUEVORElOR19SRVZJRVcQARIkCiBPVVRMSU5FX0FQUFJPVkFMX1NUQVRVU19BUFBST1ZFRBACEiQKIE9VVExJTkVfQVBQUk9WQUxfU1RBVFVTX1JFSkVDVEVEEAMSLgoqT1VUTElORV9BUFBST1ZBTF9TVEFUVVNfUkVWSVNJT05fUkVRVUVTVEVEEAQqwAEKE0xlc3NvbkNvbXBvbmVudFR5cGUSJQohTEVTU09OX0NPTVBPTkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASHgoaTEVTU09OX0NPTVBPTkVOVF9UWVBFX1RFWFQQARIhCh1MRVNTT05fQ09NUE9ORU5UX1RZUEVfSEVBRElORxACEh8KG0xFU1NPTl9DT01QT05FTlRfVFlQRV9JTUFHRRADEh4KGkxFU1NPTl9DT01QT05FTlRfVFlQRV9RVUlaEAQqhQEKDEhlYWRpbmdMZXZlbBIdChlIRUFESU5HX0xFVkVMX1VOU1BFQ0lGSUVEEAASFAoQSEVBRElOR19MRVZFTF9IMRABEhQKEEhFQURJTkdfTEVWRUxfSDIQAhIUChBIRUFESU5HX0xFVkVMX0gzEAMSFAoQSEVBRElOR19MRVZFTF9INBAEMpkMChNBSUdlbmVyYXRpb25TZXJ2aWNlEmgKFUdlbmVyYXRlQ291cnNlT3V0bGluZRImLm1pcmFpLnYxLkdlbmVyYXRlQ291cnNlT3V0bGluZVJlcXVlc3QaJy5taXJhaS52MS5HZW5lcmF0ZUNvdXJzZU91dGxpbmVSZXNwb25zZRJZChBHZXRDb3Vyc2VPdXRsaW5lEiEubWlyYWkudjEuR2V0Q291cnNlT3V0bGluZVJlcXVlc3QaIi5taXJhaS52MS5HZXRDb3Vyc2VPdXRsaW5lUmVzcG9uc2USZQoUQXBwcm92ZUNvdXJzZU91dGxpbmUSJS5taXJhaS52MS5BcHByb3ZlQ291cnNlT3V0bGluZVJlcXVlc3QaJi5taXJhaS52MS5BcHByb3ZlQ291cnNlT3V0bGluZVJlc3BvbnNlEmIKE1JlamVjdENvdXJzZU91dGxpbmUSJC5taXJhaS52MS5SZWplY3RDb3Vyc2VPdXRsaW5lUmVxdWVzdBolLm1pcmFpLnYxLlJlamVjdENvdXJzZU91dGxpbmVSZXNwb25zZRJiChNVcGRhdGVDb3Vyc2VPdXRsaW5lEiQubWlyYWkudjEuVXBkYXRlQ291cnNlT3V0bGluZVJlcXVlc3QaJS5taXJhaS52MS5VcGRhdGVDb3Vyc2VPdXRsaW5lUmVzcG9uc2USaAoVR2VuZXJhdGVMZXNzb25Db250ZW50EiYubWlyYWkudjEuR2VuZXJhdGVMZXNzb25Db250ZW50UmVxdWVzdBonLm1pcmFpLnYxLkdlbmVyYXRlTGVzc29uQ29udGVudFJlc3BvbnNlEl8KEkdlbmVyYXRlQWxsTGVzc29ucxIjLm1pcmFpLnYxLkdlbmVyYXRlQWxsTGVzc29uc1JlcXVlc3QaJC5taXJhaS52MS5HZW5lcmF0ZUFsbExlc3NvbnNSZXNwb25zZRJiChNSZWdlbmVyYXRlQ29tcG9uZW50EiQubWlyYWkudjEuUmVnZW5lcmF0ZUNvbXBvbmVudFJlcXVlc3QaJS5taXJhaS52MS5SZWdlbmVyYXRlQ29tcG9uZW50UmVzcG9uc2USOwoGR2V0Sm9iEhcubWlyYWkudjEuR2V0Sm9iUmVxdWVzdBoYLm1pcmFpLnYxLkdldEpvYlJlc3BvbnNlEkEKCExpc3RKb2JzEhkubWlyYWkudjEuTGlzdEpvYnNSZXF1ZXN0GhoubWlyYWkudjEuTGlzdEpvYnNSZXNwb25zZRJECglDYW5jZWxKb2ISGi5taXJhaS52MS5DYW5jZWxKb2JSZXF1ZXN0GhsubWlyYWkudjEuQ2FuY2VsSm9iUmVzcG9uc2USRAoJUmVzdW1lSm9iEhoubWlyYWkudjEuUmVzdW1lSm9iUmVxdWVzdBobLm1pcmFpLnYxLlJlc3VtZUpvYlJlc3BvbnNlEkMKCFdhdGNoSm9iEhkubWlyYWkudjEuV2F0Y2hKb2JSZXF1ZXN0GhoubWlyYWkudjEuV2F0Y2hKb2JSZXNwb25zZTABEl8KEkdldEdlbmVyYXRlZExlc3NvbhIjLm1pcmFpLnYxLkdldEdlbmVyYXRlZExlc3NvblJlcXVlc3QaJC5taXJhaS52MS5HZXRHZW5lcmF0ZWRMZXNzb25SZXNwb25zZRJlChRMaXN0R2VuZXJhdGVkTGVzc29ucxIlLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVxdWVzdBomLm1pcmFpLnYxLkxpc3RHZW5lcmF0ZWRMZXNzb25zUmVzcG9uc2USWQoQTGlzdFN0YWxlTGVzc29ucxIhLm1pcmFpLnYxLkxpc3RTdGFsZUxlc3NvbnNSZXF1ZXN0GiIubWlyYWkudjEuTGlzdFN0YWxlTGVzc29uc1Jlc3BvbnNlEmsKFlJlZ2VuZXJhdGVTdGFsZUxlc3NvbnMSJy5taXJhaS52MS5SZWdlbmVyYXRlU3RhbGVMZXNzb25zUmVxdWVzdBooLm1pcmFpLnYxLlJlZ2VuZXJhdGVTdGFsZUxlc3NvbnNSZXNwb25zZUKXAQoMY29tLm1pcmFpLnYxQhFBaUdlbmVyYXRpb25Qcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * GenerationJob represents an AI generation job.
@@ -133,6 +133,13 @@ export type GenerationJob = Message<"mirai.v1.GenerationJob"> & {
    * @generated from field: optional string parent_job_id = 20;
    */
   parentJobId?: string;
+
+  /**
+   * Knowledge chunks selected by retrieval for lesson generation
+   *
+   * @generated from field: repeated string retrieved_chunk_ids = 21;
+   */
+  retrievedChunkIds: string[];
 };
 
 /**
@@ -345,6 +352,18 @@ export type GeneratedLesson = Message<"mirai.v1.GeneratedLesson"> & {
    * @generated from field: google.protobuf.Timestamp generated_at = 8;
    */
   generatedAt?: Timestamp;
+
+  /**
+   * Set when SME knowledge the lesson cites changed after it was generated
+   *
+   * @generated from field: google.protobuf.Timestamp stale_since = 9;
+   */
+  staleSince?: Timestamp;
+
+  /**
+   * @generated from field: optional string stale_reason = 10;
+   */
+  staleReason?: string;
 };
 
 /**
@@ -421,6 +440,77 @@ export type ComponentAlignment = Message<"mirai.v1.ComponentAlignment"> & {
 export const ComponentAlignmentSchema: GenMessage<ComponentAlignment> = /*@__PURE__*/
   messageDesc(file_mirai_v1_ai_generation, 6);
 
+/**
+ * ComponentSource traces a cited SME knowledge chunk back to where it came from.
+ *
+ * @generated from message mirai.v1.ComponentSource
+ */
+export type ComponentSource = Message<"mirai.v1.ComponentSource"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * @generated from field: string sme_id = 2;
+   */
+  smeId: string;
+
+  /**
+   * @generated from field: string topic = 3;
+   */
+  topic: string;
+
+  /**
+   * Submission the chunk was extracted from
+   *
+   * @generated from field: optional string submission_id = 4;
+   */
+  submissionId?: string;
+
+  /**
+   * Uploaded file name of that submission
+   *
+   * @generated from field: optional string file_name = 5;
+   */
+  fileName?: string;
+
+  /**
+   * Heading in the source document
+   *
+   * @generated from field: optional string source_heading = 6;
+   */
+  sourceHeading?: string;
+
+  /**
+   * Page or slide number in the source document
+   *
+   * @generated from field: optional int32 source_page = 7;
+   */
+  sourcePage?: number;
+
+  /**
+   * Position in the source recording
+   *
+   * @generated from field: optional int32 source_timestamp_seconds = 8;
+   */
+  sourceTimestampSeconds?: number;
+
+  /**
+   * Chunk was edited or deleted after the lesson was generated
+   *
+   * @generated from field: bool outdated = 9;
+   */
+  outdated: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.ComponentSource.
+ * Use `create(ComponentSourceSchema)` to create a new message.
+ */
+export const ComponentSourceSchema: GenMessage<ComponentSource> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 7);
+
 /**
  * TextContent for text components.
  *
@@ -443,7 +533,7 @@ export type TextContent = Message<"mirai.v1.TextContent"> & {
  * Use `create(TextContentSchema)` to create a new message.
  */
 export const TextContentSchema: GenMessage<TextContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 7);
+  messageDesc(file_mirai_v1_ai_generation, 8);
 
 /**
  * HeadingContent for heading components.
@@ -467,7 +557,7 @@ export type HeadingContent = Message<"mirai.v1.HeadingContent"> & {
  * Use `create(HeadingContentSchema)` to create a new message.
  */
 export const HeadingContentSchema: GenMessage<HeadingContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 8);
+  messageDesc(file_mirai_v1_ai_generation, 9);
 
 /**
  * ImageContent for image components.
@@ -496,7 +586,7 @@ export type ImageContent = Message<"mirai.v1.ImageContent"> & {
  * Use `create(ImageContentSchema)` to create a new message.
  */
 export const ImageContentSchema: GenMessage<ImageContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 9);
+  messageDesc(file_mirai_v1_ai_generation, 10);
 
 /**
  * QuizContent for quiz/knowledge check components.
@@ -547,7 +637,7 @@ export type QuizContent = Message<"mirai.v1.QuizContent"> & {
  * Use `create(QuizContentSchema)` to create a new message.
  */
 export const QuizContentSchema: GenMessage<QuizContent> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 10);
+  messageDesc(file_mirai_v1_ai_generation, 11);
 
 /**
  * QuizOption represents an answer option.
@@ -571,7 +661,7 @@ export type QuizOption = Message<"mirai.v1.QuizOption"> & {
  * Use `create(QuizOptionSchema)` to create a new message.
  */
 export const QuizOptionSchema: GenMessage<QuizOption> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 11);
+  messageDesc(file_mirai_v1_ai_generation, 12);
 
 /**
  * CourseGenerationInput captures inputs for AI course generation.
@@ -618,7 +708,7 @@ export type CourseGenerationInput = Message<"mirai.v1.CourseGenerationInput"> &
  * Use `create(CourseGenerationInputSchema)` to create a new message.
  */
 export const CourseGenerationInputSchema: GenMessage<CourseGenerationInput> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 12);
+  messageDesc(file_mirai_v1_ai_generation, 13);
 
 /**
  * GenerateCourseOutlineRequest starts outline generation.
@@ -637,7 +727,7 @@ export type GenerateCourseOutlineRequest = Message<"mirai.v1.GenerateCourseOutli
  * Use `create(GenerateCourseOutlineRequestSchema)` to create a new message.
  */
 export const GenerateCourseOutlineRequestSchema: GenMessage<GenerateCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 13);
+  messageDesc(file_mirai_v1_ai_generation, 14);
 
 /**
  * GenerateCourseOutlineResponse returns the job ID to track progress.
@@ -656,7 +746,7 @@ export type GenerateCourseOutlineResponse = Message<"mirai.v1.GenerateCourseOutl
  * Use `create(GenerateCourseOutlineResponseSchema)` to create a new message.
  */
 export const GenerateCourseOutlineResponseSchema: GenMessage<GenerateCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 14);
+  messageDesc(file_mirai_v1_ai_generation, 15);
 
 /**
  * GetCourseOutlineRequest fetches the outline for a course.
@@ -682,7 +772,7 @@ export type GetCourseOutlineRequest = Message<"mirai.v1.GetCourseOutlineRequest"
  * Use `create(GetCourseOutlineRequestSchema)` to create a new message.
  */
 export const GetCourseOutlineRequestSchema: GenMessage<GetCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 15);
+  messageDesc(file_mirai_v1_ai_generation, 16);
 
 /**
  * GetCourseOutlineResponse contains the outline.
@@ -701,7 +791,7 @@ export type GetCourseOutlineResponse = Message<"mirai.v1.GetCourseOutlineRespons
  * Use `create(GetCourseOutlineResponseSchema)` to create a new message.
  */
 export const GetCourseOutlineResponseSchema: GenMessage<GetCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 16);
+  messageDesc(file_mirai_v1_ai_generation, 17);
 
 /**
  * ApproveCourseOutlineRequest approves an outline.
@@ -725,7 +815,7 @@ export type ApproveCourseOutlineRequest = Message<"mirai.v1.ApproveCourseOutline
  * Use `create(ApproveCourseOutlineRequestSchema)` to create a new message.
  */
 export const ApproveCourseOutlineRequestSchema: GenMessage<ApproveCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 17);
+  messageDesc(file_mirai_v1_ai_generation, 18);
 
 /**
  * ApproveCourseOutlineResponse confirms approval.
@@ -744,7 +834,7 @@ export type ApproveCourseOutlineResponse = Message<"mirai.v1.ApproveCourseOutlin
  * Use `create(ApproveCourseOutlineResponseSchema)` to create a new message.
  */
 export const ApproveCourseOutlineResponseSchema: GenMessage<ApproveCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 18);
+  messageDesc(file_mirai_v1_ai_generation, 19);
 
 /**
  * RejectCourseOutlineRequest rejects an outline.
@@ -773,7 +863,7 @@ export type RejectCourseOutlineRequest = Message<"mirai.v1.RejectCourseOutlineRe
  * Use `create(RejectCourseOutlineRequestSchema)` to create a new message.
  */
 export const RejectCourseOutlineRequestSchema: GenMessage<RejectCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 19);
+  messageDesc(file_mirai_v1_ai_generation, 20);
 
 /**
  * RejectCourseOutlineResponse confirms rejection.
@@ -792,7 +882,7 @@ export type RejectCourseOutlineResponse = Message<"mirai.v1.RejectCourseOutlineR
  * Use `create(RejectCourseOutlineResponseSchema)` to create a new message.
  */
 export const RejectCourseOutlineResponseSchema: GenMessage<RejectCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 20);
+  messageDesc(file_mirai_v1_ai_generation, 21);
 
 /**
  * UpdateCourseOutlineRequest allows editing the outline.
@@ -821,7 +911,7 @@ export type UpdateCourseOutlineRequest = Message<"mirai.v1.UpdateCourseOutlineRe
  * Use `create(UpdateCourseOutlineRequestSchema)` to create a new message.
  */
 export const UpdateCourseOutlineRequestSchema: GenMessage<UpdateCourseOutlineRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 21);
+  messageDesc(file_mirai_v1_ai_generation, 22);
 
 /**
  * UpdateCourseOutlineResponse contains the updated outline.
@@ -840,7 +930,7 @@ export type UpdateCourseOutlineResponse = Message<"mirai.v1.UpdateCourseOutlineR
  * Use `create(UpdateCourseOutlineResponseSchema)` to create a new message.
  */
 export const UpdateCourseOutlineResponseSchema: GenMessage<UpdateCourseOutlineResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 22);
+  messageDesc(file_mirai_v1_ai_generation, 23);
 
 /**
  * GenerateLessonContentRequest generates content for one lesson.
@@ -864,7 +954,7 @@ export type GenerateLessonContentRequest = Message<"mirai.v1.GenerateLessonConte
  * Use `create(GenerateLessonContentRequestSchema)` to create a new message.
  */
 export const GenerateLessonContentRequestSchema: GenMessage<GenerateLessonContentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 23);
+  messageDesc(file_mirai_v1_ai_generation, 24);
 
 /**
  * GenerateLessonContentResponse returns the job ID.
@@ -883,7 +973,7 @@ export type GenerateLessonContentResponse = Message<"mirai.v1.GenerateLessonCont
  * Use `create(GenerateLessonContentResponseSchema)` to create a new message.
  */
 export const GenerateLessonContentResponseSchema: GenMessage<GenerateLessonContentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 24);
+  messageDesc(file_mirai_v1_ai_generation, 25);
 
 /**
  * GenerateAllLessonsRequest generates all lessons for a course.
@@ -902,7 +992,7 @@ export type GenerateAllLessonsRequest = Message<"mirai.v1.GenerateAllLessonsRequ
  * Use `create(GenerateAllLessonsRequestSchema)` to create a new message.
  */
 export const GenerateAllLessonsRequestSchema: GenMessage<GenerateAllLessonsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 25);
+  messageDesc(file_mirai_v1_ai_generation, 26);
 
 /**
  * GenerateAllLessonsResponse returns the job ID.
@@ -921,7 +1011,7 @@ export type GenerateAllLessonsResponse = Message<"mirai.v1.GenerateAllLessonsRes
  * Use `create(GenerateAllLessonsResponseSchema)` to create a new message.
  */
 export const GenerateAllLessonsResponseSchema: GenMessage<GenerateAllLessonsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 26);
+  messageDesc(file_mirai_v1_ai_generation, 27);
 
 /**
  * RegenerateComponentRequest regenerates a single component.
@@ -957,7 +1047,7 @@ export type RegenerateComponentRequest = Message<"mirai.v1.RegenerateComponentRe
  * Use `create(RegenerateComponentRequestSchema)` to create a new message.
  */
 export const RegenerateComponentRequestSchema: GenMessage<RegenerateComponentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 27);
+  messageDesc(file_mirai_v1_ai_generation, 28);
 
 /**
  * RegenerateComponentResponse returns the job ID.
@@ -976,7 +1066,7 @@ export type RegenerateComponentResponse = Message<"mirai.v1.RegenerateComponentR
  * Use `create(RegenerateComponentResponseSchema)` to create a new message.
  */
 export const RegenerateComponentResponseSchema: GenMessage<RegenerateComponentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 28);
+  messageDesc(file_mirai_v1_ai_generation, 29);
 
 /**
  * GetJobRequest fetches a job by ID.
@@ -995,7 +1085,7 @@ export type GetJobRequest = Message<"mirai.v1.GetJobRequest"> & {
  * Use `create(GetJobRequestSchema)` to create a new message.
  */
 export const GetJobRequestSchema: GenMessage<GetJobRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 29);
+  messageDesc(file_mirai_v1_ai_generation, 30);
 
 /**
  * GetJobResponse contains the job.
@@ -1007,6 +1097,20 @@ export type GetJobResponse = Message<"mirai.v1.GetJobResponse"> & {
    * @generated from field: mirai.v1.GenerationJob job = 1;
    */
   job?: GenerationJob;
+
+  /**
+   * Position of a queued job among all queued jobs, 1 being next (0 unless queued)
+   *
+   * @generated from field: int32 queue_position = 2;
+   */
+  queuePosition: number;
+
+  /**
+   * Number of queued jobs across all tenants (0 unless queued)
+   *
+   * @generated from field: int32 queue_depth = 3;
+   */
+  queueDepth: number;
 };
 
 /**
@@ -1014,7 +1118,7 @@ export type GetJobResponse = Message<"mirai.v1.GetJobResponse"> & {
  * Use `create(GetJobResponseSchema)` to create a new message.
  */
 export const GetJobResponseSchema: GenMessage<GetJobResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 30);
+  messageDesc(file_mirai_v1_ai_generation, 31);
 
 /**
  * ListJobsRequest contains filters for jobs.
@@ -1043,7 +1147,7 @@ export type ListJobsRequest = Message<"mirai.v1.ListJobsRequest"> & {
  * Use `create(ListJobsRequestSchema)` to create a new message.
  */
 export const ListJobsRequestSchema: GenMessage<ListJobsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 31);
+  messageDesc(file_mirai_v1_ai_generation, 32);
 
 /**
  * ListJobsResponse contains matching jobs.
@@ -1062,7 +1166,7 @@ export type ListJobsResponse = Message<"mirai.v1.ListJobsResponse"> & {
  * Use `create(ListJobsResponseSchema)` to create a new message.
  */
 export const ListJobsResponseSchema: GenMessage<ListJobsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 32);
+  messageDesc(file_mirai_v1_ai_generation, 33);
 
 /**
  * CancelJobRequest cancels a job.
@@ -1081,7 +1185,7 @@ export type CancelJobRequest = Message<"mirai.v1.CancelJobRequest"> & {
  * Use `create(CancelJobRequestSchema)` to create a new message.
  */
 export const CancelJobRequestSchema: GenMessage<CancelJobRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 33);
+  messageDesc(file_mirai_v1_ai_generation, 34);
 
 /**
  * CancelJobResponse confirms cancellation.
@@ -1100,7 +1204,105 @@ export type CancelJobResponse = Message<"mirai.v1.CancelJobResponse"> & {
  * Use `create(CancelJobResponseSchema)` to create a new message.
  */
 export const CancelJobResponseSchema: GenMessage<CancelJobResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 34);
+  messageDesc(file_mirai_v1_ai_generation, 35);
+
+/**
+ * ResumeJobRequest resumes a failed course generation job.
+ *
+ * @generated from message mirai.v1.ResumeJobRequest
+ */
+export type ResumeJobRequest = Message<"mirai.v1.ResumeJobRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ResumeJobRequest.
+ * Use `create(ResumeJobRequestSchema)` to create a new message.
+ */
+export const ResumeJobRequestSchema: GenMessage<ResumeJobRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 36);
+
+/**
+ * ResumeJobResponse returns the resumed job.
+ *
+ * @generated from message mirai.v1.ResumeJobResponse
+ */
+export type ResumeJobResponse = Message<"mirai.v1.ResumeJobResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.ResumeJobResponse.
+ * Use `create(ResumeJobResponseSchema)` to create a new message.
+ */
+export const ResumeJobResponseSchema: GenMessage<ResumeJobResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 37);
+
+/**
+ * WatchJobRequest starts following a job.
+ *
+ * @generated from message mirai.v1.WatchJobRequest
+ */
+export type WatchJobRequest = Message<"mirai.v1.WatchJobRequest"> & {
+  /**
+   * @generated from field: string job_id = 1;
+   */
+  jobId: string;
+};
+
+/**
+ * Describes the message mirai.v1.WatchJobRequest.
+ * Use `create(WatchJobRequestSchema)` to create a new message.
+ */
+export const WatchJobRequestSchema: GenMessage<WatchJobRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 38);
+
+/**
+ * WatchJobResponse is one live update. The stream starts with the current
+ * state of the job and each of its children.
+ *
+ * @generated from message mirai.v1.WatchJobResponse
+ */
+export type WatchJobResponse = Message<"mirai.v1.WatchJobResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJobEventType event_type = 1;
+   */
+  eventType: GenerationJobEventType;
+
+  /**
+   * Job the event is about: the watched job or one of its children
+   *
+   * @generated from field: mirai.v1.GenerationJob job = 2;
+   */
+  job?: GenerationJob;
+
+  /**
+   * Set for SECTION_READY
+   *
+   * @generated from field: mirai.v1.OutlineSection section = 3;
+   */
+  section?: OutlineSection;
+
+  /**
+   * Set for LESSON_READY
+   *
+   * @generated from field: mirai.v1.GeneratedLesson lesson = 4;
+   */
+  lesson?: GeneratedLesson;
+};
+
+/**
+ * Describes the message mirai.v1.WatchJobResponse.
+ * Use `create(WatchJobResponseSchema)` to create a new message.
+ */
+export const WatchJobResponseSchema: GenMessage<WatchJobResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 39);
 
 /**
  * GetGeneratedLessonRequest fetches generated lesson content.
@@ -1119,7 +1321,7 @@ export type GetGeneratedLessonRequest = Message<"mirai.v1.GetGeneratedLessonRequ
  * Use `create(GetGeneratedLessonRequestSchema)` to create a new message.
  */
 export const GetGeneratedLessonRequestSchema: GenMessage<GetGeneratedLessonRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 35);
+  messageDesc(file_mirai_v1_ai_generation, 40);
 
 /**
  * GetGeneratedLessonResponse contains the lesson.
@@ -1131,6 +1333,13 @@ export type GetGeneratedLessonResponse = Message<"mirai.v1.GetGeneratedLessonRes
    * @generated from field: mirai.v1.GeneratedLesson lesson = 1;
    */
   lesson?: GeneratedLesson;
+
+  /**
+   * SME knowledge cited by the lesson's components (see ComponentAlignment.sme_chunk_ids)
+   *
+   * @generated from field: repeated mirai.v1.ComponentSource sources = 2;
+   */
+  sources: ComponentSource[];
 };
 
 /**
@@ -1138,7 +1347,7 @@ export type GetGeneratedLessonResponse = Message<"mirai.v1.GetGeneratedLessonRes
  * Use `create(GetGeneratedLessonResponseSchema)` to create a new message.
  */
 export const GetGeneratedLessonResponseSchema: GenMessage<GetGeneratedLessonResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 36);
+  messageDesc(file_mirai_v1_ai_generation, 41);
 
 /**
  * ListGeneratedLessonsRequest fetches all lessons for a course.
@@ -1157,7 +1366,7 @@ export type ListGeneratedLessonsRequest = Message<"mirai.v1.ListGeneratedLessons
  * Use `create(ListGeneratedLessonsRequestSchema)` to create a new message.
  */
 export const ListGeneratedLessonsRequestSchema: GenMessage<ListGeneratedLessonsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 37);
+  messageDesc(file_mirai_v1_ai_generation, 42);
 
 /**
  * ListGeneratedLessonsResponse contains the lessons.
@@ -1176,7 +1385,83 @@ export type ListGeneratedLessonsResponse = Message<"mirai.v1.ListGeneratedLesson
  * Use `create(ListGeneratedLessonsResponseSchema)` to create a new message.
  */
 export const ListGeneratedLessonsResponseSchema: GenMessage<ListGeneratedLessonsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_ai_generation, 38);
+  messageDesc(file_mirai_v1_ai_generation, 43);
+
+/**
+ * ListStaleLessonsRequest fetches the stale lessons of a course.
+ *
+ * @generated from message mirai.v1.ListStaleLessonsRequest
+ */
+export type ListStaleLessonsRequest = Message<"mirai.v1.ListStaleLessonsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListStaleLessonsRequest.
+ * Use `create(ListStaleLessonsRequestSchema)` to create a new message.
+ */
+export const ListStaleLessonsRequestSchema: GenMessage<ListStaleLessonsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 44);
+
+/**
+ * ListStaleLessonsResponse contains the stale lessons.
+ *
+ * @generated from message mirai.v1.ListStaleLessonsResponse
+ */
+export type ListStaleLessonsResponse = Message<"mirai.v1.ListStaleLessonsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.GeneratedLesson lessons = 1;
+   */
+  lessons: GeneratedLesson[];
+};
+
+/**
+ * Describes the message mirai.v1.ListStaleLessonsResponse.
+ * Use `create(ListStaleLessonsResponseSchema)` to create a new message.
+ */
+export const ListStaleLessonsResponseSchema: GenMessage<ListStaleLessonsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 45);
+
+/**
+ * RegenerateStaleLessonsRequest regenerates the stale lessons of a course.
+ *
+ * @generated from message mirai.v1.RegenerateStaleLessonsRequest
+ */
+export type RegenerateStaleLessonsRequest = Message<"mirai.v1.RegenerateStaleLessonsRequest"> & {
+  /**
+   * @generated from field: string course_id = 1;
+   */
+  courseId: string;
+};
+
+/**
+ * Describes the message mirai.v1.RegenerateStaleLessonsRequest.
+ * Use `create(RegenerateStaleLessonsRequestSchema)` to create a new message.
+ */
+export const RegenerateStaleLessonsRequestSchema: GenMessage<RegenerateStaleLessonsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 46);
+
+/**
+ * RegenerateStaleLessonsResponse returns the parent job.
+ *
+ * @generated from message mirai.v1.RegenerateStaleLessonsResponse
+ */
+export type RegenerateStaleLessonsResponse = Message<"mirai.v1.RegenerateStaleLessonsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GenerationJob job = 1;
+   */
+  job?: GenerationJob;
+};
+
+/**
+ * Describes the message mirai.v1.RegenerateStaleLessonsResponse.
+ * Use `create(RegenerateStaleLessonsResponseSchema)` to create a new message.
+ */
+export const RegenerateStaleLessonsResponseSchema: GenMessage<RegenerateStaleLessonsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_ai_generation, 47);
 
 /**
  * GenerationJobType represents the type of AI generation job.
@@ -1223,6 +1508,13 @@ export enum GenerationJobType {
    * @generated from enum value: GENERATION_JOB_TYPE_FULL_COURSE = 5;
    */
   FULL_COURSE = 5,
+
+  /**
+   * Parent job tracking regeneration of stale lessons
+   *
+   * @generated from enum value: GENERATION_JOB_TYPE_STALE_LESSON_REGEN = 6;
+   */
+  STALE_LESSON_REGEN = 6,
 }
 
 /**
@@ -1274,6 +1566,59 @@ export enum GenerationJobStatus {
 export const GenerationJobStatusSchema: GenEnum<GenerationJobStatus> = /*@__PURE__*/
   enumDesc(file_mirai_v1_ai_generation, 1);
 
+/**
+ * GenerationJobEventType categorizes live job updates streamed by WatchJob.
+ *
+ * @generated from enum mirai.v1.GenerationJobEventType
+ */
+export enum GenerationJobEventType {
+  /**
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Progress percent or message changed
+   *
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_PROGRESS = 1;
+   */
+  PROGRESS = 1,
+
+  /**
+   * An outline section was stored
+   *
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_SECTION_READY = 2;
+   */
+  SECTION_READY = 2,
+
+  /**
+   * A lesson's content was stored
+   *
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_LESSON_READY = 3;
+   */
+  LESSON_READY = 3,
+
+  /**
+   * The job completed, failed or was cancelled
+   *
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_STATUS = 4;
+   */
+  STATUS = 4,
+
+  /**
+   * Heartbeat to keep the connection alive
+   *
+   * @generated from enum value: GENERATION_JOB_EVENT_TYPE_KEEPALIVE = 5;
+   */
+  KEEPALIVE = 5,
+}
+
+/**
+ * Describes the enum mirai.v1.GenerationJobEventType.
+ */
+export const GenerationJobEventTypeSchema: GenEnum<GenerationJobEventType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_ai_generation, 2);
+
 /**
  * OutlineApprovalStatus for generated content review.
  *
@@ -1310,7 +1655,7 @@ export enum OutlineApprovalStatus {
  * Describes the enum mirai.v1.OutlineApprovalStatus.
  */
 export const OutlineApprovalStatusSchema: GenEnum<OutlineApprovalStatus> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 2);
+  enumDesc(file_mirai_v1_ai_generation, 3);
 
 /**
  * LessonComponentType - content block types for lessons.
@@ -1359,7 +1704,7 @@ export enum LessonComponentType {
  * Describes the enum mirai.v1.LessonComponentType.
  */
 export const LessonComponentTypeSchema: GenEnum<LessonComponentType> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 3);
+  enumDesc(file_mirai_v1_ai_generation, 4);
 
 /**
  * HeadingLevel for heading components.
@@ -1397,7 +1742,7 @@ export enum HeadingLevel {
  * Describes the enum mirai.v1.HeadingLevel.
  */
 export const HeadingLevelSchema: GenEnum<HeadingLevel> = /*@__PURE__*/
-  enumDesc(file_mirai_v1_ai_generation, 4);
+  enumDesc(file_mirai_v1_ai_generation, 5);
 
 /**
  * AIGenerationService handles AI generation operations.
@@ -1515,6 +1860,28 @@ export const AIGenerationService: GenService<{
     input: typeof CancelJobRequestSchema;
     output: typeof CancelJobResponseSchema;
   },
+  /**
+   * ResumeJob continues a failed course generation job from the lessons that
+   * did not complete.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ResumeJob
+   */
+  resumeJob: {
+    methodKind: "unary";
+    input: typeof ResumeJobRequestSchema;
+    output: typeof ResumeJobResponseSchema;
+  },
+  /**
+   * WatchJob streams progress, finished sections and lessons, and final status
+   * for a job and its child jobs. The stream ends when the job finishes.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.WatchJob
+   */
+  watchJob: {
+    methodKind: "server_streaming";
+    input: typeof WatchJobRequestSchema;
+    output: typeof WatchJobResponseSchema;
+  },
   /**
    * GetGeneratedLesson returns generated lesson content.
    *
@@ -1535,6 +1902,26 @@ export const AIGenerationService: GenService<{
     input: typeof ListGeneratedLessonsRequestSchema;
     output: typeof ListGeneratedLessonsResponseSchema;
   },
+  /**
+   * ListStaleLessons returns the lessons of a course whose SME knowledge changed since generation.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.ListStaleLessons
+   */
+  listStaleLessons: {
+    methodKind: "unary";
+    input: typeof ListStaleLessonsRequestSchema;
+    output: typeof ListStaleLessonsResponseSchema;
+  },
+  /**
+   * RegenerateStaleLessons regenerates all stale lessons of a course.
+   *
+   * @generated from rpc mirai.v1.AIGenerationService.RegenerateStaleLessons
+   */
+  regenerateStaleLessons: {
+    methodKind: "unary";
+    input: typeof RegenerateStaleLessonsRequestSchema;
+    output: typeof RegenerateStaleLessonsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_ai_generation, 0);
 
@@ -24,3 +24,24 @@ export const createCheckoutSession = BillingService.method.createCheckoutSession
  * @generated from rpc mirai.v1.BillingService.CreatePortalSession
  */
 export const createPortalSession = BillingService.method.createPortalSession;
+
+/**
+ * PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+ *
+ * @generated from rpc mirai.v1.BillingService.PreviewSubscriptionChange
+ */
+export const previewSubscriptionChange = BillingService.method.previewSubscriptionChange;
+
+/**
+ * ChangeSubscription changes the plan or seat count of the existing subscription.
+ *
+ * @generated from rpc mirai.v1.BillingService.ChangeSubscription
+ */
+export const changeSubscription = BillingService.method.changeSubscription;
+
+/**
+ * GetEntitlements returns what the company's plan includes and how much of it is used.
+ *
+ * @generated from rpc mirai.v1.BillingService.GetEntitlements
+ */
+export const getEntitlements = BillingService.method.getEntitlements;
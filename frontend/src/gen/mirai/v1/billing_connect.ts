@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateCheckoutSessionRequest, CreateCheckoutSessionResponse, CreatePortalSessionRequest, CreatePortalSessionResponse, GetBillingInfoRequest, GetBillingInfoResponse } from "./billing_pb.js";
+import { ChangeSubscriptionRequest, ChangeSubscriptionResponse, CreateCheckoutSessionRequest, CreateCheckoutSessionResponse, CreatePortalSessionRequest, CreatePortalSessionResponse, GetBillingInfoRequest, GetBillingInfoResponse, GetEntitlementsRequest, GetEntitlementsResponse, PreviewSubscriptionChangeRequest, PreviewSubscriptionChangeResponse } from "./billing_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -47,6 +47,39 @@ export const BillingService = {
       O: CreatePortalSessionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+     *
+     * @generated from rpc mirai.v1.BillingService.PreviewSubscriptionChange
+     */
+    previewSubscriptionChange: {
+      name: "PreviewSubscriptionChange",
+      I: PreviewSubscriptionChangeRequest,
+      O: PreviewSubscriptionChangeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ChangeSubscription changes the plan or seat count of the existing subscription.
+     *
+     * @generated from rpc mirai.v1.BillingService.ChangeSubscription
+     */
+    changeSubscription: {
+      name: "ChangeSubscription",
+      I: ChangeSubscriptionRequest,
+      O: ChangeSubscriptionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetEntitlements returns what the company's plan includes and how much of it is used.
+     *
+     * @generated from rpc mirai.v1.BillingService.GetEntitlements
+     */
+    getEntitlements: {
+      name: "GetEntitlements",
+      I: GetEntitlementsRequest,
+      O: GetEntitlementsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -6,13 +6,65 @@ import type { GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegen
 import { fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
 import type { Plan, SubscriptionStatus } from "./common_pb";
 import { file_mirai_v1_common } from "./common_pb";
+import type { ExportFormat } from "./course_pb";
+import { file_mirai_v1_course } from "./course_pb";
 import type { Message } from "@bufbuild/protobuf";
 
 /**
  * Describes the file mirai/v1/billing.proto.
  */
 export const file_mirai_v1_billing: GenFile = /*@__PURE__*/
-  fileDesc("ChZtaXJhaS92MS9iaWxsaW5nLnByb3RvEghtaXJhaS52MSIXChVHZXRCaWxsaW5nSW5mb1JlcXVlc3Qi5gEKFkdldEJpbGxpbmdJbmZvUmVzcG9uc2USHAoEcGxhbhgBIAEoDjIOLm1pcmFpLnYxLlBsYW4SLAoGc3RhdHVzGAIgASgOMhwubWlyYWkudjEuU3Vic2NyaXB0aW9uU3RhdHVzEhIKCnNlYXRfY291bnQYAyABKAUSFgoOcHJpY2VfcGVyX3NlYXQYBCABKAUSHwoSY3VycmVudF9wZXJpb2RfZW5kGAUgASgDSACIAQESHAoUY2FuY2VsX2F0X3BlcmlvZF9lbmQYBiABKAhCFQoTX2N1cnJlbnRfcGVyaW9kX2VuZCI8ChxDcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0EhwKBHBsYW4YASABKA4yDi5taXJhaS52MS5QbGFuIiwKHUNyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlc3BvbnNlEgsKA3VybBgBIAEoCSIcChpDcmVhdGVQb3J0YWxTZXNzaW9uUmVxdWVzdCIqChtDcmVhdGVQb3J0YWxTZXNzaW9uUmVzcG9uc2USCwoDdXJsGAEgASgJMrMCCg5CaWxsaW5nU2VydmljZRJTCg5HZXRCaWxsaW5nSW5mbxIfLm1pcmFpLnYxLkdldEJpbGxpbmdJbmZvUmVxdWVzdBogLm1pcmFpLnYxLkdldEJpbGxpbmdJbmZvUmVzcG9uc2USaAoVQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uEiYubWlyYWkudjEuQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVxdWVzdBonLm1pcmFpLnYxLkNyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlc3BvbnNlEmIKE0NyZWF0ZVBvcnRhbFNlc3Npb24SJC5taXJhaS52MS5DcmVhdGVQb3J0YWxTZXNzaW9uUmVxdWVzdBolLm1pcmFpLnYxLkNyZWF0ZVBvcnRhbFNlc3Npb25SZXNwb25zZUKSAQoMY29tLm1pcmFpLnYxQgxCaWxsaW5nUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_mirai_v1_common]);
+  fileDesc("ChZtaXJhaS92MS9iaWxsaW5nLnByb3RvEghtaXJhaS52MSLEAQoMRW50aXRsZW1lbnRzEhwKBHBsYW4YASABKA4yDi5taXJhaS52MS5QbGFuEhAKCG1heF9zbWVzGAIgASgFEhMKC21heF9jb3Vyc2VzGAMgASgFEhkKEW1vbnRobHlfYWlfdG9rZW5zGAQgASgDEi4KDmV4cG9ydF9mb3JtYXRzGAUgAygOMhYubWlyYWkudjEuRXhwb3J0Rm9ybWF0EgsKA3NzbxgGIAEoCBIXCg9jdXN0b21fYnJhbmRpbmcYByABKAgiFwoVR2V0QmlsbGluZ0luZm9SZXF1ZXN0IpQCChZHZXRCaWxsaW5nSW5mb1Jlc3BvbnNlEhwKBHBsYW4YASABKA4yDi5taXJhaS52MS5QbGFuEiwKBnN0YXR1cxgCIAEoDjIcLm1pcmFpLnYxLlN1YnNjcmlwdGlvblN0YXR1cxISCgpzZWF0X2NvdW50GAMgASgFEhYKDnByaWNlX3Blcl9zZWF0GAQgASgFEh8KEmN1cnJlbnRfcGVyaW9kX2VuZBgFIAEoA0gAiAEBEhwKFGNhbmNlbF9hdF9wZXJpb2RfZW5kGAYgASgIEhoKDWJpbGxpbmdfZW1haWwYByABKAlIAYgBAUIVChNfY3VycmVudF9wZXJpb2RfZW5kQhAKDl9iaWxsaW5nX2VtYWlsIjwKHENyZWF0ZUNoZWNrb3V0U2Vzc2lvblJlcXVlc3QSHAoEcGxhbhgBIAEoDjIOLm1pcmFpLnYxLlBsYW4iLAodQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USCwoDdXJsGAEgASgJIhwKGkNyZWF0ZVBvcnRhbFNlc3Npb25SZXF1ZXN0IioKG0NyZWF0ZVBvcnRhbFNlc3Npb25SZXNwb25zZRILCgN1cmwYASABKAkiVAogUHJldmlld1N1YnNjcmlwdGlvbkNoYW5nZVJlcXVlc3QSHAoEcGxhbhgBIAEoDjIOLm1pcmFpLnYxLlBsYW4SEgoKc2VhdF9jb3VudBgCIAEoBSKQAgohUHJldmlld1N1YnNjcmlwdGlvbkNoYW5nZVJlc3BvbnNlEiQKDGN1cnJlbnRfcGxhbhgBIAEoDjIOLm1pcmFpLnYxLlBsYW4SGgoSY3VycmVudF9zZWF0X2NvdW50GAIgASgFEhwKBHBsYW4YAyABKA4yDi5taXJhaS52MS5QbGFuEhIKCnNlYXRfY291bnQYBCABKAUSFwoPcHJvcmF0ZWRfYW1vdW50GAUgASgDEhsKE25leHRfaW52b2ljZV9hbW91bnQYBiABKAMSFwoPbmV4dF9pbnZvaWNlX2F0GAcgASgDEhAKCGN1cnJlbmN5GAggASgJEhYKDnByb3JhdGlvbl9kYXRlGAkgASgDIn0KGUNoYW5nZVN1YnNjcmlwdGlvblJlcXVlc3QSHAoEcGxhbhgBIAEoDjIOLm1pcmFpLnYxLlBsYW4SEgoKc2VhdF9jb3VudBgCIAEoBRIbCg5wcm9yYXRpb25fZGF0ZRgDIAEoA0gAiAEBQhEKD19wcm9yYXRpb25fZGF0ZSJUChpDaGFuZ2VTdWJzY3JpcHRpb25SZXNwb25zZRI2CgxiaWxsaW5nX2luZm8YASABKAsyIC5taXJhaS52MS5HZXRCaWxsaW5nSW5mb1Jlc3BvbnNlIhgKFkdldEVudGl0bGVtZW50c1JlcXVlc3QicAoXR2V0RW50aXRsZW1lbnRzUmVzcG9uc2USLAoMZW50aXRsZW1lbnRzGAEgASgLMhYubWlyYWkudjEuRW50aXRsZW1lbnRzEhEKCXNtZV9jb3VudBgCIAEoBRIUCgxjb3Vyc2VfY291bnQYAyABKAUy4gQKDkJpbGxpbmdTZXJ2aWNlElMKDkdldEJpbGxpbmdJbmZvEh8ubWlyYWkudjEuR2V0QmlsbGluZ0luZm9SZXF1ZXN0GiAubWlyYWkudjEuR2V0QmlsbGluZ0luZm9SZXNwb25zZRJoChVDcmVhdGVDaGVja291dFNlc3Npb24SJi5taXJhaS52MS5DcmVhdGVDaGVja291dFNlc3Npb25SZXF1ZXN0GicubWlyYWkudjEuQ3JlYXRlQ2hlY2tvdXRTZXNzaW9uUmVzcG9uc2USYgoTQ3JlYXRlUG9ydGFsU2Vzc2lvbhIkLm1pcmFpLnYxLkNyZWF0ZVBvcnRhbFNlc3Npb25SZXF1ZXN0GiUubWlyYWkudjEuQ3JlYXRlUG9ydGFsU2Vzc2lvblJlc3BvbnNlEnQKGVByZXZpZXdTdWJzY3JpcHRpb25DaGFuZ2USKi5taXJhaS52MS5QcmV2aWV3U3Vic2NyaXB0aW9uQ2hhbmdlUmVxdWVzdBorLm1pcmFpLnYxLlByZXZpZXdTdWJzY3JpcHRpb25DaGFuZ2VSZXNwb25zZRJfChJDaGFuZ2VTdWJzY3JpcHRpb24SIy5taXJhaS52MS5DaGFuZ2VTdWJzY3JpcHRpb25SZXF1ZXN0GiQubWlyYWkudjEuQ2hhbmdlU3Vic2NyaXB0aW9uUmVzcG9uc2USVgoPR2V0RW50aXRsZW1lbnRzEiAubWlyYWkudjEuR2V0RW50aXRsZW1lbnRzUmVxdWVzdBohLm1pcmFpLnYxLkdldEVudGl0bGVtZW50c1Jlc3BvbnNlQpIBCgxjb20ubWlyYWkudjFCDEJpbGxpbmdQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_mirai_v1_common, file_mirai_v1_course]);
+
+/**
+ * Entitlements are the limits and features a company's plan grants,
+ * including enterprise contract overrides. Limits of 0 are unlimited.
+ *
+ * @generated from message mirai.v1.Entitlements
+ */
+export type Entitlements = Message<"mirai.v1.Entitlements"> & {
+  /**
+   * @generated from field: mirai.v1.Plan plan = 1;
+   */
+  plan: Plan;
+
+  /**
+   * @generated from field: int32 max_smes = 2;
+   */
+  maxSmes: number;
+
+  /**
+   * @generated from field: int32 max_courses = 3;
+   */
+  maxCourses: number;
+
+  /**
+   * @generated from field: int64 monthly_ai_tokens = 4;
+   */
+  monthlyAiTokens: bigint;
+
+  /**
+   * @generated from field: repeated mirai.v1.ExportFormat export_formats = 5;
+   */
+  exportFormats: ExportFormat[];
+
+  /**
+   * @generated from field: bool sso = 6;
+   */
+  sso: boolean;
+
+  /**
+   * @generated from field: bool custom_branding = 7;
+   */
+  customBranding: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.Entitlements.
+ * Use `create(EntitlementsSchema)` to create a new message.
+ */
+export const EntitlementsSchema: GenMessage<Entitlements> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 0);
 
 /**
  * GetBillingInfoRequest is empty as company is identified by auth context.
@@ -27,7 +79,7 @@ export type GetBillingInfoRequest = Message<"mirai.v1.GetBillingInfoRequest"> &
  * Use `create(GetBillingInfoRequestSchema)` to create a new message.
  */
 export const GetBillingInfoRequestSchema: GenMessage<GetBillingInfoRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 0);
+  messageDesc(file_mirai_v1_billing, 1);
 
 /**
  * GetBillingInfoResponse contains the current billing status.
@@ -68,6 +120,13 @@ export type GetBillingInfoResponse = Message<"mirai.v1.GetBillingInfoResponse">
    * @generated from field: bool cancel_at_period_end = 6;
    */
   cancelAtPeriodEnd: boolean;
+
+  /**
+   * Where Stripe sends invoices
+   *
+   * @generated from field: optional string billing_email = 7;
+   */
+  billingEmail?: string;
 };
 
 /**
@@ -75,7 +134,7 @@ export type GetBillingInfoResponse = Message<"mirai.v1.GetBillingInfoResponse">
  * Use `create(GetBillingInfoResponseSchema)` to create a new message.
  */
 export const GetBillingInfoResponseSchema: GenMessage<GetBillingInfoResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 1);
+  messageDesc(file_mirai_v1_billing, 2);
 
 /**
  * CreateCheckoutSessionRequest contains the plan to subscribe to.
@@ -94,7 +153,7 @@ export type CreateCheckoutSessionRequest = Message<"mirai.v1.CreateCheckoutSessi
  * Use `create(CreateCheckoutSessionRequestSchema)` to create a new message.
  */
 export const CreateCheckoutSessionRequestSchema: GenMessage<CreateCheckoutSessionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 2);
+  messageDesc(file_mirai_v1_billing, 3);
 
 /**
  * CreateCheckoutSessionResponse contains the Stripe Checkout session URL.
@@ -113,7 +172,7 @@ export type CreateCheckoutSessionResponse = Message<"mirai.v1.CreateCheckoutSess
  * Use `create(CreateCheckoutSessionResponseSchema)` to create a new message.
  */
 export const CreateCheckoutSessionResponseSchema: GenMessage<CreateCheckoutSessionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 3);
+  messageDesc(file_mirai_v1_billing, 4);
 
 /**
  * CreatePortalSessionRequest is empty as company is identified by auth context.
@@ -128,7 +187,7 @@ export type CreatePortalSessionRequest = Message<"mirai.v1.CreatePortalSessionRe
  * Use `create(CreatePortalSessionRequestSchema)` to create a new message.
  */
 export const CreatePortalSessionRequestSchema: GenMessage<CreatePortalSessionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 4);
+  messageDesc(file_mirai_v1_billing, 5);
 
 /**
  * CreatePortalSessionResponse contains the Stripe Customer Portal URL.
@@ -147,7 +206,204 @@ export type CreatePortalSessionResponse = Message<"mirai.v1.CreatePortalSessionR
  * Use `create(CreatePortalSessionResponseSchema)` to create a new message.
  */
 export const CreatePortalSessionResponseSchema: GenMessage<CreatePortalSessionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_billing, 5);
+  messageDesc(file_mirai_v1_billing, 6);
+
+/**
+ * PreviewSubscriptionChangeRequest contains the plan and seat count to move to.
+ *
+ * @generated from message mirai.v1.PreviewSubscriptionChangeRequest
+ */
+export type PreviewSubscriptionChangeRequest = Message<"mirai.v1.PreviewSubscriptionChangeRequest"> & {
+  /**
+   * Current plan when unspecified
+   *
+   * @generated from field: mirai.v1.Plan plan = 1;
+   */
+  plan: Plan;
+
+  /**
+   * Current seat count when 0
+   *
+   * @generated from field: int32 seat_count = 2;
+   */
+  seatCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.PreviewSubscriptionChangeRequest.
+ * Use `create(PreviewSubscriptionChangeRequestSchema)` to create a new message.
+ */
+export const PreviewSubscriptionChangeRequestSchema: GenMessage<PreviewSubscriptionChangeRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 7);
+
+/**
+ * PreviewSubscriptionChangeResponse contains the cost of the change.
+ *
+ * @generated from message mirai.v1.PreviewSubscriptionChangeResponse
+ */
+export type PreviewSubscriptionChangeResponse = Message<"mirai.v1.PreviewSubscriptionChangeResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Plan current_plan = 1;
+   */
+  currentPlan: Plan;
+
+  /**
+   * @generated from field: int32 current_seat_count = 2;
+   */
+  currentSeatCount: number;
+
+  /**
+   * @generated from field: mirai.v1.Plan plan = 3;
+   */
+  plan: Plan;
+
+  /**
+   * @generated from field: int32 seat_count = 4;
+   */
+  seatCount: number;
+
+  /**
+   * cents, negative for a credit
+   *
+   * @generated from field: int64 prorated_amount = 5;
+   */
+  proratedAmount: bigint;
+
+  /**
+   * cents, including the proration
+   *
+   * @generated from field: int64 next_invoice_amount = 6;
+   */
+  nextInvoiceAmount: bigint;
+
+  /**
+   * unix timestamp
+   *
+   * @generated from field: int64 next_invoice_at = 7;
+   */
+  nextInvoiceAt: bigint;
+
+  /**
+   * @generated from field: string currency = 8;
+   */
+  currency: string;
+
+  /**
+   * unix timestamp, pass to ChangeSubscription to apply the previewed amount
+   *
+   * @generated from field: int64 proration_date = 9;
+   */
+  prorationDate: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.PreviewSubscriptionChangeResponse.
+ * Use `create(PreviewSubscriptionChangeResponseSchema)` to create a new message.
+ */
+export const PreviewSubscriptionChangeResponseSchema: GenMessage<PreviewSubscriptionChangeResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 8);
+
+/**
+ * ChangeSubscriptionRequest contains the plan and seat count to move to.
+ *
+ * @generated from message mirai.v1.ChangeSubscriptionRequest
+ */
+export type ChangeSubscriptionRequest = Message<"mirai.v1.ChangeSubscriptionRequest"> & {
+  /**
+   * Current plan when unspecified
+   *
+   * @generated from field: mirai.v1.Plan plan = 1;
+   */
+  plan: Plan;
+
+  /**
+   * Current seat count when 0
+   *
+   * @generated from field: int32 seat_count = 2;
+   */
+  seatCount: number;
+
+  /**
+   * From a recent preview; prorates as of now when unset
+   *
+   * @generated from field: optional int64 proration_date = 3;
+   */
+  prorationDate?: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.ChangeSubscriptionRequest.
+ * Use `create(ChangeSubscriptionRequestSchema)` to create a new message.
+ */
+export const ChangeSubscriptionRequestSchema: GenMessage<ChangeSubscriptionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 9);
+
+/**
+ * ChangeSubscriptionResponse contains the updated billing status.
+ *
+ * @generated from message mirai.v1.ChangeSubscriptionResponse
+ */
+export type ChangeSubscriptionResponse = Message<"mirai.v1.ChangeSubscriptionResponse"> & {
+  /**
+   * @generated from field: mirai.v1.GetBillingInfoResponse billing_info = 1;
+   */
+  billingInfo?: GetBillingInfoResponse;
+};
+
+/**
+ * Describes the message mirai.v1.ChangeSubscriptionResponse.
+ * Use `create(ChangeSubscriptionResponseSchema)` to create a new message.
+ */
+export const ChangeSubscriptionResponseSchema: GenMessage<ChangeSubscriptionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 10);
+
+/**
+ * GetEntitlementsRequest is empty as company is identified by auth context.
+ *
+ * @generated from message mirai.v1.GetEntitlementsRequest
+ */
+export type GetEntitlementsRequest = Message<"mirai.v1.GetEntitlementsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetEntitlementsRequest.
+ * Use `create(GetEntitlementsRequestSchema)` to create a new message.
+ */
+export const GetEntitlementsRequestSchema: GenMessage<GetEntitlementsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 11);
+
+/**
+ * GetEntitlementsResponse contains the entitlements and current usage.
+ *
+ * @generated from message mirai.v1.GetEntitlementsResponse
+ */
+export type GetEntitlementsResponse = Message<"mirai.v1.GetEntitlementsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Entitlements entitlements = 1;
+   */
+  entitlements?: Entitlements;
+
+  /**
+   * Active SMEs, counted against max_smes
+   *
+   * @generated from field: int32 sme_count = 2;
+   */
+  smeCount: number;
+
+  /**
+   * Counted against max_courses
+   *
+   * @generated from field: int32 course_count = 3;
+   */
+  courseCount: number;
+};
+
+/**
+ * Describes the message mirai.v1.GetEntitlementsResponse.
+ * Use `create(GetEntitlementsResponseSchema)` to create a new message.
+ */
+export const GetEntitlementsResponseSchema: GenMessage<GetEntitlementsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_billing, 12);
 
 /**
  * BillingService handles billing and subscription operations.
@@ -185,6 +441,36 @@ export const BillingService: GenService<{
     input: typeof CreatePortalSessionRequestSchema;
     output: typeof CreatePortalSessionResponseSchema;
   },
+  /**
+   * PreviewSubscriptionChange returns the prorated cost of changing the plan or seat count.
+   *
+   * @generated from rpc mirai.v1.BillingService.PreviewSubscriptionChange
+   */
+  previewSubscriptionChange: {
+    methodKind: "unary";
+    input: typeof PreviewSubscriptionChangeRequestSchema;
+    output: typeof PreviewSubscriptionChangeResponseSchema;
+  },
+  /**
+   * ChangeSubscription changes the plan or seat count of the existing subscription.
+   *
+   * @generated from rpc mirai.v1.BillingService.ChangeSubscription
+   */
+  changeSubscription: {
+    methodKind: "unary";
+    input: typeof ChangeSubscriptionRequestSchema;
+    output: typeof ChangeSubscriptionResponseSchema;
+  },
+  /**
+   * GetEntitlements returns what the company's plan includes and how much of it is used.
+   *
+   * @generated from rpc mirai.v1.BillingService.GetEntitlements
+   */
+  getEntitlements: {
+    methodKind: "unary";
+    input: typeof GetEntitlementsRequestSchema;
+    output: typeof GetEntitlementsResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_billing, 0);
 
@@ -0,0 +1,68 @@
+// @generated by protoc-gen-connect-query v2.2.0 with parameter "target=ts"
+// @generated from file mirai/v1/job_admin.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import { JobAdminService } from "./job_admin_pb";
+
+/**
+ * ListFailedTasks returns tasks waiting to retry or archived after exhausting their retries.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.ListFailedTasks
+ */
+export const listFailedTasks = JobAdminService.method.listFailedTasks;
+
+/**
+ * GetFailedTask returns a task with its payload and last error.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.GetFailedTask
+ */
+export const getFailedTask = JobAdminService.method.getFailedTask;
+
+/**
+ * ReplayFailedTask runs a failed task again now, resetting the job or registration it works on.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.ReplayFailedTask
+ */
+export const replayFailedTask = JobAdminService.method.replayFailedTask;
+
+/**
+ * DeleteFailedTask removes a failed task from its queue.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.DeleteFailedTask
+ */
+export const deleteFailedTask = JobAdminService.method.deleteFailedTask;
+
+/**
+ * ListStuckProvisioning returns paid registrations still waiting for their account or whose provisioning failed.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.ListStuckProvisioning
+ */
+export const listStuckProvisioning = JobAdminService.method.listStuckProvisioning;
+
+/**
+ * RetryProvisioning enqueues provisioning of a stuck or failed registration.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.RetryProvisioning
+ */
+export const retryProvisioning = JobAdminService.method.retryProvisioning;
+
+/**
+ * ListWebhookEvents returns stored Stripe webhook events, most recently received first.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.ListWebhookEvents
+ */
+export const listWebhookEvents = JobAdminService.method.listWebhookEvents;
+
+/**
+ * ReplayWebhookEvent applies a stored Stripe webhook event again.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.ReplayWebhookEvent
+ */
+export const replayWebhookEvent = JobAdminService.method.replayWebhookEvent;
+
+/**
+ * SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+ *
+ * @generated from rpc mirai.v1.JobAdminService.SetEntitlementOverrides
+ */
+export const setEntitlementOverrides = JobAdminService.method.setEntitlementOverrides;
@@ -0,0 +1,119 @@
+// @generated by protoc-gen-connect-es v1.6.1 with parameter "target=ts"
+// @generated from file mirai/v1/job_admin.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import { DeleteFailedTaskRequest, DeleteFailedTaskResponse, GetFailedTaskRequest, GetFailedTaskResponse, ListFailedTasksRequest, ListFailedTasksResponse, ListStuckProvisioningRequest, ListStuckProvisioningResponse, ListWebhookEventsRequest, ListWebhookEventsResponse, ReplayFailedTaskRequest, ReplayFailedTaskResponse, ReplayWebhookEventRequest, ReplayWebhookEventResponse, RetryProvisioningRequest, RetryProvisioningResponse, SetEntitlementOverridesRequest, SetEntitlementOverridesResponse } from "./job_admin_pb.js";
+import { MethodKind } from "@bufbuild/protobuf";
+
+/**
+ * JobAdminService lets platform administrators manage failed background tasks,
+ * Stripe webhook events and enterprise entitlements across all tenants.
+ *
+ * @generated from service mirai.v1.JobAdminService
+ */
+export const JobAdminService = {
+  typeName: "mirai.v1.JobAdminService",
+  methods: {
+    /**
+     * ListFailedTasks returns tasks waiting to retry or archived after exhausting their retries.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.ListFailedTasks
+     */
+    listFailedTasks: {
+      name: "ListFailedTasks",
+      I: ListFailedTasksRequest,
+      O: ListFailedTasksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetFailedTask returns a task with its payload and last error.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.GetFailedTask
+     */
+    getFailedTask: {
+      name: "GetFailedTask",
+      I: GetFailedTaskRequest,
+      O: GetFailedTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReplayFailedTask runs a failed task again now, resetting the job or registration it works on.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.ReplayFailedTask
+     */
+    replayFailedTask: {
+      name: "ReplayFailedTask",
+      I: ReplayFailedTaskRequest,
+      O: ReplayFailedTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteFailedTask removes a failed task from its queue.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.DeleteFailedTask
+     */
+    deleteFailedTask: {
+      name: "DeleteFailedTask",
+      I: DeleteFailedTaskRequest,
+      O: DeleteFailedTaskResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListStuckProvisioning returns paid registrations still waiting for their account or whose provisioning failed.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.ListStuckProvisioning
+     */
+    listStuckProvisioning: {
+      name: "ListStuckProvisioning",
+      I: ListStuckProvisioningRequest,
+      O: ListStuckProvisioningResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RetryProvisioning enqueues provisioning of a stuck or failed registration.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.RetryProvisioning
+     */
+    retryProvisioning: {
+      name: "RetryProvisioning",
+      I: RetryProvisioningRequest,
+      O: RetryProvisioningResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListWebhookEvents returns stored Stripe webhook events, most recently received first.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.ListWebhookEvents
+     */
+    listWebhookEvents: {
+      name: "ListWebhookEvents",
+      I: ListWebhookEventsRequest,
+      O: ListWebhookEventsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ReplayWebhookEvent applies a stored Stripe webhook event again.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.ReplayWebhookEvent
+     */
+    replayWebhookEvent: {
+      name: "ReplayWebhookEvent",
+      I: ReplayWebhookEventRequest,
+      O: ReplayWebhookEventResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+     *
+     * @generated from rpc mirai.v1.JobAdminService.SetEntitlementOverrides
+     */
+    setEntitlementOverrides: {
+      name: "SetEntitlementOverrides",
+      I: SetEntitlementOverridesRequest,
+      O: SetEntitlementOverridesResponse,
+      kind: MethodKind.Unary,
+    },
+  }
+} as const;
+
@@ -0,0 +1,873 @@
+// @generated by protoc-gen-es v2.10.1 with parameter "target=ts"
+// @generated from file mirai/v1/job_admin.proto (package mirai.v1, syntax proto3)
+/* eslint-disable */
+
+import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv2";
+import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv2";
+import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
+import type { Entitlements } from "./billing_pb";
+import { file_mirai_v1_billing } from "./billing_pb";
+import type { Plan } from "./common_pb";
+import { file_mirai_v1_common } from "./common_pb";
+import type { ExportFormat } from "./course_pb";
+import { file_mirai_v1_course } from "./course_pb";
+import type { Message } from "@bufbuild/protobuf";
+
+/**
+ * Describes the file mirai/v1/job_admin.proto.
+ */
+export const file_mirai_v1_job_admin: GenFile = /*@__PURE__*/
+  fileDesc("ChhtaXJhaS92MS9qb2JfYWRtaW4ucHJvdG8SCG1pcmFpLnYxIsoCCg5CYWNrZ3JvdW5kVGFzaxIKCgJpZBgBIAEoCRINCgVxdWV1ZRgCIAEoCRIMCgR0eXBlGAMgASgJEiwKBXN0YXRlGAQgASgOMh0ubWlyYWkudjEuQmFja2dyb3VuZFRhc2tTdGF0ZRIPCgdwYXlsb2FkGAUgASgJEg8KB3JldHJpZWQYBiABKAUSEQoJbWF4X3JldHJ5GAcgASgFEhIKCmxhc3RfZXJyb3IYCCABKAkSNwoObGFzdF9mYWlsZWRfYXQYCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSACIAQESOAoPbmV4dF9wcm9jZXNzX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQhEKD19sYXN0X2ZhaWxlZF9hdEISChBfbmV4dF9wcm9jZXNzX2F0IoECChFTdHVja1JlZ2lzdHJhdGlvbhIKCgJpZBgBIAEoCRINCgVlbWFpbBgCIAEoCRIUCgxjb21wYW55X25hbWUYAyABKAkSHAoEcGxhbhgEIAEoDjIOLm1pcmFpLnYxLlBsYW4SEgoKc2VhdF9jb3VudBgFIAEoBRIOCgZzdGF0dXMYBiABKAkSGwoTY2hlY2tvdXRfc2Vzc2lvbl9pZBgHIAEoCRIaCg1lcnJvcl9tZXNzYWdlGAggASgJSACIAQESLgoKdXBkYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCEAoOX2Vycm9yX21lc3NhZ2Ui1wIKDFdlYmhvb2tFdmVudBIKCgJpZBgBIAEoCRIMCgR0eXBlGAIgASgJEiwKBnN0YXR1cxgDIAEoDjIcLm1pcmFpLnYxLldlYmhvb2tFdmVudFN0YXR1cxIQCghhdHRlbXB0cxgEIAEoBRIaCg1lcnJvcl9tZXNzYWdlGAUgASgJSACIAQESDwoHcGF5bG9hZBgGIAEoCRI1ChFzdHJpcGVfY3JlYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLwoLcmVjZWl2ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDHByb2Nlc3NlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBAUIQCg5fZXJyb3JfbWVzc2FnZUIPCg1fcHJvY2Vzc2VkX2F0IoUBChZMaXN0RmFpbGVkVGFza3NSZXF1ZXN0EiwKBXN0YXRlGAEgASgOMh0ubWlyYWkudjEuQmFja2dyb3VuZFRhc2tTdGF0ZRISCgVxdWV1ZRgCIAEoCUgAiAEBEgwKBHBhZ2UYAyABKAUSEQoJcGFnZV9zaXplGAQgASgFQggKBl9xdWV1ZSJCChdMaXN0RmFpbGVkVGFza3NSZXNwb25zZRInCgV0YXNrcxgBIAMoCzIYLm1pcmFpLnYxLkJhY2tncm91bmRUYXNrIjYKFEdldEZhaWxlZFRhc2tSZXF1ZXN0Eg0KBXF1ZXVlGAEgASgJEg8KB3Rhc2tfaWQYAiABKAkiPwoVR2V0RmFpbGVkVGFza1Jlc3BvbnNlEiYKBHRhc2sYASABKAsyGC5taXJhaS52MS5CYWNrZ3JvdW5kVGFzayI5ChdSZXBsYXlGYWlsZWRUYXNrUmVxdWVzdBINCgVxdWV1ZRgBIAEoCRIPCgd0YXNrX2lkGAIgASgJIkIKGFJlcGxheUZhaWxlZFRhc2tSZXNwb25zZRImCgR0YXNrGAEgASgLMhgubWlyYWkudjEuQmFja2dyb3VuZFRhc2siOQoXRGVsZXRlRmFpbGVkVGFza1JlcXVlc3QSDQoFcXVldWUYASABKAkSDwoHdGFza19pZBgCIAEoCSIaChhEZWxldGVGYWlsZWRUYXNrUmVzcG9uc2UiHgocTGlzdFN0dWNrUHJvdmlzaW9uaW5nUmVxdWVzdCJTCh1MaXN0U3R1Y2tQcm92aXNpb25pbmdSZXNwb25zZRIyCg1yZWdpc3RyYXRpb25zGAEgAygLMhsubWlyYWkudjEuU3R1Y2tSZWdpc3RyYXRpb24iMwoYUmV0cnlQcm92aXNpb25pbmdSZXF1ZXN0EhcKD3JlZ2lzdHJhdGlvbl9pZBgBIAEoCSJOChlSZXRyeVByb3Zpc2lvbmluZ1Jlc3BvbnNlEjEKDHJlZ2lzdHJhdGlvbhgBIAEoCzIbLm1pcmFpLnYxLlN0dWNrUmVnaXN0cmF0aW9uIoUBChhMaXN0V2ViaG9va0V2ZW50c1JlcXVlc3QSLAoGc3RhdHVzGAEgASgOMhwubWlyYWkudjEuV2ViaG9va0V2ZW50U3RhdHVzEhEKBHR5cGUYAiABKAlIAIgBARIMCgRwYWdlGAMgASgFEhEKCXBhZ2Vfc2l6ZRgEIAEoBUIHCgVfdHlwZSJDChlMaXN0V2ViaG9va0V2ZW50c1Jlc3BvbnNlEiYKBmV2ZW50cxgBIAMoCzIWLm1pcmFpLnYxLldlYmhvb2tFdmVudCI8ChlSZXBsYXlXZWJob29rRXZlbnRSZXF1ZXN0EhAKCGV2ZW50X2lkGAEgASgJEg0KBWZvcmNlGAIgASgIIkMKGlJlcGxheVdlYmhvb2tFdmVudFJlc3BvbnNlEiUKBWV2ZW50GAEgASgLMhYubWlyYWkudjEuV2ViaG9va0V2ZW50IsMCCh5TZXRFbnRpdGxlbWVudE92ZXJyaWRlc1JlcXVlc3QSEgoKY29tcGFueV9pZBgBIAEoCRIVCghtYXhfc21lcxgCIAEoBUgAiAEBEhgKC21heF9jb3Vyc2VzGAMgASgFSAGIAQESHgoRbW9udGhseV9haV90b2tlbnMYBCABKANIAogBARIuCg5leHBvcnRfZm9ybWF0cxgFIAMoDjIWLm1pcmFpLnYxLkV4cG9ydEZvcm1hdBIQCgNzc28YBiABKAhIA4gBARIcCg9jdXN0b21fYnJhbmRpbmcYByABKAhIBIgBARINCgVjbGVhchgIIAEoCEILCglfbWF4X3NtZXNCDgoMX21heF9jb3Vyc2VzQhQKEl9tb250aGx5X2FpX3Rva2Vuc0IGCgRfc3NvQhIKEF9jdXN0b21fYnJhbmRpbmciTwofU2V0RW50aXRsZW1lbnRPdmVycmlkZXNSZXNwb25zZRIsCgxlbnRpdGxlbWVudHMYASABKAsyFi5taXJhaS52MS5FbnRpdGxlbWVudHMqkAIKE0JhY2tncm91bmRUYXNrU3RhdGUSJQohQkFDS0dST1VORF9UQVNLX1NUQVRFX1VOU1BFQ0lGSUVEEAASIQodQkFDS0dST1VORF9UQVNLX1NUQVRFX1BFTkRJTkcQARIgChxCQUNLR1JPVU5EX1RBU0tfU1RBVEVfQUNUSVZFEAISIwofQkFDS0dST1VORF9UQVNLX1NUQVRFX1NDSEVEVUxFRBADEh8KG0JBQ0tHUk9VTkRfVEFTS19TVEFURV9SRVRSWRAEEiIKHkJBQ0tHUk9VTkRfVEFTS19TVEFURV9BUkNISVZFRBAFEiMKH0JBQ0tHUk9VTkRfVEFTS19TVEFURV9DT01QTEVURUQQBirGAQoSV2ViaG9va0V2ZW50U3RhdHVzEiQKIFdFQkhPT0tfRVZFTlRfU1RBVFVTX1VOU1BFQ0lGSUVEEAASIwofV0VCSE9PS19FVkVOVF9TVEFUVVNfUFJPQ0VTU0lORxABEiIKHldFQkhPT0tfRVZFTlRfU1RBVFVTX1BST0NFU1NFRBACEiAKHFdFQkhPT0tfRVZFTlRfU1RBVFVTX0lHTk9SRUQQAxIfChtXRUJIT09LX0VWRU5UX1NUQVRVU19GQUlMRUQQBDLoBgoPSm9iQWRtaW5TZXJ2aWNlElYKD0xpc3RGYWlsZWRUYXNrcxIgLm1pcmFpLnYxLkxpc3RGYWlsZWRUYXNrc1JlcXVlc3QaIS5taXJhaS52MS5MaXN0RmFpbGVkVGFza3NSZXNwb25zZRJQCg1HZXRGYWlsZWRUYXNrEh4ubWlyYWkudjEuR2V0RmFpbGVkVGFza1JlcXVlc3QaHy5taXJhaS52MS5HZXRGYWlsZWRUYXNrUmVzcG9uc2USWQoQUmVwbGF5RmFpbGVkVGFzaxIhLm1pcmFpLnYxLlJlcGxheUZhaWxlZFRhc2tSZXF1ZXN0GiIubWlyYWkudjEuUmVwbGF5RmFpbGVkVGFza1Jlc3BvbnNlElkKEERlbGV0ZUZhaWxlZFRhc2sSIS5taXJhaS52MS5EZWxldGVGYWlsZWRUYXNrUmVxdWVzdBoiLm1pcmFpLnYxLkRlbGV0ZUZhaWxlZFRhc2tSZXNwb25zZRJoChVMaXN0U3R1Y2tQcm92aXNpb25pbmcSJi5taXJhaS52MS5MaXN0U3R1Y2tQcm92aXNpb25pbmdSZXF1ZXN0GicubWlyYWkudjEuTGlzdFN0dWNrUHJvdmlzaW9uaW5nUmVzcG9uc2USXAoRUmV0cnlQcm92aXNpb25pbmcSIi5taXJhaS52MS5SZXRyeVByb3Zpc2lvbmluZ1JlcXVlc3QaIy5taXJhaS52MS5SZXRyeVByb3Zpc2lvbmluZ1Jlc3BvbnNlElwKEUxpc3RXZWJob29rRXZlbnRzEiIubWlyYWkudjEuTGlzdFdlYmhvb2tFdmVudHNSZXF1ZXN0GiMubWlyYWkudjEuTGlzdFdlYmhvb2tFdmVudHNSZXNwb25zZRJfChJSZXBsYXlXZWJob29rRXZlbnQSIy5taXJhaS52MS5SZXBsYXlXZWJob29rRXZlbnRSZXF1ZXN0GiQubWlyYWkudjEuUmVwbGF5V2ViaG9va0V2ZW50UmVzcG9uc2USbgoXU2V0RW50aXRsZW1lbnRPdmVycmlkZXMSKC5taXJhaS52MS5TZXRFbnRpdGxlbWVudE92ZXJyaWRlc1JlcXVlc3QaKS5taXJhaS52MS5TZXRFbnRpdGxlbWVudE92ZXJyaWRlc1Jlc3BvbnNlQpMBCgxjb20ubWlyYWkudjFCDUpvYkFkbWluUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp, file_mirai_v1_billing, file_mirai_v1_common, file_mirai_v1_course]);
+
+/**
+ * BackgroundTask is a task in the background job queues.
+ *
+ * @generated from message mirai.v1.BackgroundTask
+ */
+export type BackgroundTask = Message<"mirai.v1.BackgroundTask"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string queue = 2;
+   */
+  queue: string;
+
+  /**
+   * Task type, e.g. "ai:generation"
+   *
+   * @generated from field: string type = 3;
+   */
+  type: string;
+
+  /**
+   * @generated from field: mirai.v1.BackgroundTaskState state = 4;
+   */
+  state: BackgroundTaskState;
+
+  /**
+   * JSON payload the task was enqueued with
+   *
+   * @generated from field: string payload = 5;
+   */
+  payload: string;
+
+  /**
+   * @generated from field: int32 retried = 6;
+   */
+  retried: number;
+
+  /**
+   * @generated from field: int32 max_retry = 7;
+   */
+  maxRetry: number;
+
+  /**
+   * @generated from field: string last_error = 8;
+   */
+  lastError: string;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp last_failed_at = 9;
+   */
+  lastFailedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp next_process_at = 10;
+   */
+  nextProcessAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.BackgroundTask.
+ * Use `create(BackgroundTaskSchema)` to create a new message.
+ */
+export const BackgroundTaskSchema: GenMessage<BackgroundTask> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 0);
+
+/**
+ * StuckRegistration is a paid registration whose account was not provisioned.
+ *
+ * @generated from message mirai.v1.StuckRegistration
+ */
+export type StuckRegistration = Message<"mirai.v1.StuckRegistration"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string email = 2;
+   */
+  email: string;
+
+  /**
+   * @generated from field: string company_name = 3;
+   */
+  companyName: string;
+
+  /**
+   * @generated from field: mirai.v1.Plan plan = 4;
+   */
+  plan: Plan;
+
+  /**
+   * @generated from field: int32 seat_count = 5;
+   */
+  seatCount: number;
+
+  /**
+   * "paid" while waiting, "failed" once provisioning failed
+   *
+   * @generated from field: string status = 6;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string checkout_session_id = 7;
+   */
+  checkoutSessionId: string;
+
+  /**
+   * @generated from field: optional string error_message = 8;
+   */
+  errorMessage?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.StuckRegistration.
+ * Use `create(StuckRegistrationSchema)` to create a new message.
+ */
+export const StuckRegistrationSchema: GenMessage<StuckRegistration> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 1);
+
+/**
+ * WebhookEvent is a stored Stripe webhook event.
+ *
+ * @generated from message mirai.v1.WebhookEvent
+ */
+export type WebhookEvent = Message<"mirai.v1.WebhookEvent"> & {
+  /**
+   * Stripe event ID
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * e.g. "customer.subscription.updated"
+   *
+   * @generated from field: string type = 2;
+   */
+  type: string;
+
+  /**
+   * @generated from field: mirai.v1.WebhookEventStatus status = 3;
+   */
+  status: WebhookEventStatus;
+
+  /**
+   * @generated from field: int32 attempts = 4;
+   */
+  attempts: number;
+
+  /**
+   * Failure, or why the event was ignored
+   *
+   * @generated from field: optional string error_message = 5;
+   */
+  errorMessage?: string;
+
+  /**
+   * Full event JSON as delivered
+   *
+   * @generated from field: string payload = 6;
+   */
+  payload: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp stripe_created_at = 7;
+   */
+  stripeCreatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp received_at = 8;
+   */
+  receivedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional google.protobuf.Timestamp processed_at = 9;
+   */
+  processedAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.WebhookEvent.
+ * Use `create(WebhookEventSchema)` to create a new message.
+ */
+export const WebhookEventSchema: GenMessage<WebhookEvent> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 2);
+
+/**
+ * ListFailedTasksRequest filters failed tasks.
+ *
+ * @generated from message mirai.v1.ListFailedTasksRequest
+ */
+export type ListFailedTasksRequest = Message<"mirai.v1.ListFailedTasksRequest"> & {
+  /**
+   * RETRY or ARCHIVED
+   *
+   * @generated from field: mirai.v1.BackgroundTaskState state = 1;
+   */
+  state: BackgroundTaskState;
+
+  /**
+   * All queues when unset
+   *
+   * @generated from field: optional string queue = 2;
+   */
+  queue?: string;
+
+  /**
+   * 1-based, defaults to 1
+   *
+   * @generated from field: int32 page = 3;
+   */
+  page: number;
+
+  /**
+   * Per queue, defaults to 50
+   *
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message mirai.v1.ListFailedTasksRequest.
+ * Use `create(ListFailedTasksRequestSchema)` to create a new message.
+ */
+export const ListFailedTasksRequestSchema: GenMessage<ListFailedTasksRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 3);
+
+/**
+ * ListFailedTasksResponse contains the tasks.
+ *
+ * @generated from message mirai.v1.ListFailedTasksResponse
+ */
+export type ListFailedTasksResponse = Message<"mirai.v1.ListFailedTasksResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.BackgroundTask tasks = 1;
+   */
+  tasks: BackgroundTask[];
+};
+
+/**
+ * Describes the message mirai.v1.ListFailedTasksResponse.
+ * Use `create(ListFailedTasksResponseSchema)` to create a new message.
+ */
+export const ListFailedTasksResponseSchema: GenMessage<ListFailedTasksResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 4);
+
+/**
+ * GetFailedTaskRequest identifies a task.
+ *
+ * @generated from message mirai.v1.GetFailedTaskRequest
+ */
+export type GetFailedTaskRequest = Message<"mirai.v1.GetFailedTaskRequest"> & {
+  /**
+   * @generated from field: string queue = 1;
+   */
+  queue: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message mirai.v1.GetFailedTaskRequest.
+ * Use `create(GetFailedTaskRequestSchema)` to create a new message.
+ */
+export const GetFailedTaskRequestSchema: GenMessage<GetFailedTaskRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 5);
+
+/**
+ * GetFailedTaskResponse contains the task.
+ *
+ * @generated from message mirai.v1.GetFailedTaskResponse
+ */
+export type GetFailedTaskResponse = Message<"mirai.v1.GetFailedTaskResponse"> & {
+  /**
+   * @generated from field: mirai.v1.BackgroundTask task = 1;
+   */
+  task?: BackgroundTask;
+};
+
+/**
+ * Describes the message mirai.v1.GetFailedTaskResponse.
+ * Use `create(GetFailedTaskResponseSchema)` to create a new message.
+ */
+export const GetFailedTaskResponseSchema: GenMessage<GetFailedTaskResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 6);
+
+/**
+ * ReplayFailedTaskRequest identifies the task to replay.
+ *
+ * @generated from message mirai.v1.ReplayFailedTaskRequest
+ */
+export type ReplayFailedTaskRequest = Message<"mirai.v1.ReplayFailedTaskRequest"> & {
+  /**
+   * @generated from field: string queue = 1;
+   */
+  queue: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayFailedTaskRequest.
+ * Use `create(ReplayFailedTaskRequestSchema)` to create a new message.
+ */
+export const ReplayFailedTaskRequestSchema: GenMessage<ReplayFailedTaskRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 7);
+
+/**
+ * ReplayFailedTaskResponse contains the replayed task.
+ *
+ * @generated from message mirai.v1.ReplayFailedTaskResponse
+ */
+export type ReplayFailedTaskResponse = Message<"mirai.v1.ReplayFailedTaskResponse"> & {
+  /**
+   * @generated from field: mirai.v1.BackgroundTask task = 1;
+   */
+  task?: BackgroundTask;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayFailedTaskResponse.
+ * Use `create(ReplayFailedTaskResponseSchema)` to create a new message.
+ */
+export const ReplayFailedTaskResponseSchema: GenMessage<ReplayFailedTaskResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 8);
+
+/**
+ * DeleteFailedTaskRequest identifies the task to delete.
+ *
+ * @generated from message mirai.v1.DeleteFailedTaskRequest
+ */
+export type DeleteFailedTaskRequest = Message<"mirai.v1.DeleteFailedTaskRequest"> & {
+  /**
+   * @generated from field: string queue = 1;
+   */
+  queue: string;
+
+  /**
+   * @generated from field: string task_id = 2;
+   */
+  taskId: string;
+};
+
+/**
+ * Describes the message mirai.v1.DeleteFailedTaskRequest.
+ * Use `create(DeleteFailedTaskRequestSchema)` to create a new message.
+ */
+export const DeleteFailedTaskRequestSchema: GenMessage<DeleteFailedTaskRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 9);
+
+/**
+ * DeleteFailedTaskResponse is empty on success.
+ *
+ * @generated from message mirai.v1.DeleteFailedTaskResponse
+ */
+export type DeleteFailedTaskResponse = Message<"mirai.v1.DeleteFailedTaskResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.DeleteFailedTaskResponse.
+ * Use `create(DeleteFailedTaskResponseSchema)` to create a new message.
+ */
+export const DeleteFailedTaskResponseSchema: GenMessage<DeleteFailedTaskResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 10);
+
+/**
+ * ListStuckProvisioningRequest has no parameters.
+ *
+ * @generated from message mirai.v1.ListStuckProvisioningRequest
+ */
+export type ListStuckProvisioningRequest = Message<"mirai.v1.ListStuckProvisioningRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.ListStuckProvisioningRequest.
+ * Use `create(ListStuckProvisioningRequestSchema)` to create a new message.
+ */
+export const ListStuckProvisioningRequestSchema: GenMessage<ListStuckProvisioningRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 11);
+
+/**
+ * ListStuckProvisioningResponse contains the registrations.
+ *
+ * @generated from message mirai.v1.ListStuckProvisioningResponse
+ */
+export type ListStuckProvisioningResponse = Message<"mirai.v1.ListStuckProvisioningResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.StuckRegistration registrations = 1;
+   */
+  registrations: StuckRegistration[];
+};
+
+/**
+ * Describes the message mirai.v1.ListStuckProvisioningResponse.
+ * Use `create(ListStuckProvisioningResponseSchema)` to create a new message.
+ */
+export const ListStuckProvisioningResponseSchema: GenMessage<ListStuckProvisioningResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 12);
+
+/**
+ * RetryProvisioningRequest identifies the registration to provision.
+ *
+ * @generated from message mirai.v1.RetryProvisioningRequest
+ */
+export type RetryProvisioningRequest = Message<"mirai.v1.RetryProvisioningRequest"> & {
+  /**
+   * @generated from field: string registration_id = 1;
+   */
+  registrationId: string;
+};
+
+/**
+ * Describes the message mirai.v1.RetryProvisioningRequest.
+ * Use `create(RetryProvisioningRequestSchema)` to create a new message.
+ */
+export const RetryProvisioningRequestSchema: GenMessage<RetryProvisioningRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 13);
+
+/**
+ * RetryProvisioningResponse contains the registration.
+ *
+ * @generated from message mirai.v1.RetryProvisioningResponse
+ */
+export type RetryProvisioningResponse = Message<"mirai.v1.RetryProvisioningResponse"> & {
+  /**
+   * @generated from field: mirai.v1.StuckRegistration registration = 1;
+   */
+  registration?: StuckRegistration;
+};
+
+/**
+ * Describes the message mirai.v1.RetryProvisioningResponse.
+ * Use `create(RetryProvisioningResponseSchema)` to create a new message.
+ */
+export const RetryProvisioningResponseSchema: GenMessage<RetryProvisioningResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 14);
+
+/**
+ * ListWebhookEventsRequest filters webhook events.
+ *
+ * @generated from message mirai.v1.ListWebhookEventsRequest
+ */
+export type ListWebhookEventsRequest = Message<"mirai.v1.ListWebhookEventsRequest"> & {
+  /**
+   * All statuses when unspecified
+   *
+   * @generated from field: mirai.v1.WebhookEventStatus status = 1;
+   */
+  status: WebhookEventStatus;
+
+  /**
+   * All types when unset
+   *
+   * @generated from field: optional string type = 2;
+   */
+  type?: string;
+
+  /**
+   * 1-based, defaults to 1
+   *
+   * @generated from field: int32 page = 3;
+   */
+  page: number;
+
+  /**
+   * Defaults to 50
+   *
+   * @generated from field: int32 page_size = 4;
+   */
+  pageSize: number;
+};
+
+/**
+ * Describes the message mirai.v1.ListWebhookEventsRequest.
+ * Use `create(ListWebhookEventsRequestSchema)` to create a new message.
+ */
+export const ListWebhookEventsRequestSchema: GenMessage<ListWebhookEventsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 15);
+
+/**
+ * ListWebhookEventsResponse contains the events.
+ *
+ * @generated from message mirai.v1.ListWebhookEventsResponse
+ */
+export type ListWebhookEventsResponse = Message<"mirai.v1.ListWebhookEventsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.WebhookEvent events = 1;
+   */
+  events: WebhookEvent[];
+};
+
+/**
+ * Describes the message mirai.v1.ListWebhookEventsResponse.
+ * Use `create(ListWebhookEventsResponseSchema)` to create a new message.
+ */
+export const ListWebhookEventsResponseSchema: GenMessage<ListWebhookEventsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 16);
+
+/**
+ * ReplayWebhookEventRequest identifies the event to replay.
+ *
+ * @generated from message mirai.v1.ReplayWebhookEventRequest
+ */
+export type ReplayWebhookEventRequest = Message<"mirai.v1.ReplayWebhookEventRequest"> & {
+  /**
+   * @generated from field: string event_id = 1;
+   */
+  eventId: string;
+
+  /**
+   * Replay an event that was already processed. Its handler runs again,
+   * e.g. a refund alert is sent a second time.
+   *
+   * @generated from field: bool force = 2;
+   */
+  force: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayWebhookEventRequest.
+ * Use `create(ReplayWebhookEventRequestSchema)` to create a new message.
+ */
+export const ReplayWebhookEventRequestSchema: GenMessage<ReplayWebhookEventRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 17);
+
+/**
+ * ReplayWebhookEventResponse contains the event with the outcome of the replay.
+ *
+ * @generated from message mirai.v1.ReplayWebhookEventResponse
+ */
+export type ReplayWebhookEventResponse = Message<"mirai.v1.ReplayWebhookEventResponse"> & {
+  /**
+   * @generated from field: mirai.v1.WebhookEvent event = 1;
+   */
+  event?: WebhookEvent;
+};
+
+/**
+ * Describes the message mirai.v1.ReplayWebhookEventResponse.
+ * Use `create(ReplayWebhookEventResponseSchema)` to create a new message.
+ */
+export const ReplayWebhookEventResponseSchema: GenMessage<ReplayWebhookEventResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 18);
+
+/**
+ * SetEntitlementOverridesRequest replaces a company's overrides. Unset fields
+ * keep the enterprise plan default.
+ *
+ * @generated from message mirai.v1.SetEntitlementOverridesRequest
+ */
+export type SetEntitlementOverridesRequest = Message<"mirai.v1.SetEntitlementOverridesRequest"> & {
+  /**
+   * @generated from field: string company_id = 1;
+   */
+  companyId: string;
+
+  /**
+   * 0 for unlimited
+   *
+   * @generated from field: optional int32 max_smes = 2;
+   */
+  maxSmes?: number;
+
+  /**
+   * 0 for unlimited
+   *
+   * @generated from field: optional int32 max_courses = 3;
+   */
+  maxCourses?: number;
+
+  /**
+   * 0 for unlimited
+   *
+   * @generated from field: optional int64 monthly_ai_tokens = 4;
+   */
+  monthlyAiTokens?: bigint;
+
+  /**
+   * Plan default when empty
+   *
+   * @generated from field: repeated mirai.v1.ExportFormat export_formats = 5;
+   */
+  exportFormats: ExportFormat[];
+
+  /**
+   * @generated from field: optional bool sso = 6;
+   */
+  sso?: boolean;
+
+  /**
+   * @generated from field: optional bool custom_branding = 7;
+   */
+  customBranding?: boolean;
+
+  /**
+   * Remove all overrides instead
+   *
+   * @generated from field: bool clear = 8;
+   */
+  clear: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.SetEntitlementOverridesRequest.
+ * Use `create(SetEntitlementOverridesRequestSchema)` to create a new message.
+ */
+export const SetEntitlementOverridesRequestSchema: GenMessage<SetEntitlementOverridesRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 19);
+
+/**
+ * SetEntitlementOverridesResponse contains the company's resulting entitlements.
+ *
+ * @generated from message mirai.v1.SetEntitlementOverridesResponse
+ */
+export type SetEntitlementOverridesResponse = Message<"mirai.v1.SetEntitlementOverridesResponse"> & {
+  /**
+   * @generated from field: mirai.v1.Entitlements entitlements = 1;
+   */
+  entitlements?: Entitlements;
+};
+
+/**
+ * Describes the message mirai.v1.SetEntitlementOverridesResponse.
+ * Use `create(SetEntitlementOverridesResponseSchema)` to create a new message.
+ */
+export const SetEntitlementOverridesResponseSchema: GenMessage<SetEntitlementOverridesResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_job_admin, 20);
+
+/**
+ * BackgroundTaskState is the state of a task in the background job queues.
+ *
+ * @generated from enum mirai.v1.BackgroundTaskState
+ */
+export enum BackgroundTaskState {
+  /**
+   * @generated from enum value: BACKGROUND_TASK_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Waiting for a worker
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * Being processed
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_ACTIVE = 2;
+   */
+  ACTIVE = 2,
+
+  /**
+   * Enqueued to run later
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_SCHEDULED = 3;
+   */
+  SCHEDULED = 3,
+
+  /**
+   * Failed, waiting for its next automatic retry
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_RETRY = 4;
+   */
+  RETRY = 4,
+
+  /**
+   * Out of retries; only runs again when replayed
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_ARCHIVED = 5;
+   */
+  ARCHIVED = 5,
+
+  /**
+   * Finished and kept for retention
+   *
+   * @generated from enum value: BACKGROUND_TASK_STATE_COMPLETED = 6;
+   */
+  COMPLETED = 6,
+}
+
+/**
+ * Describes the enum mirai.v1.BackgroundTaskState.
+ */
+export const BackgroundTaskStateSchema: GenEnum<BackgroundTaskState> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_job_admin, 0);
+
+/**
+ * WebhookEventStatus is the processing status of a stored Stripe webhook event.
+ *
+ * @generated from enum mirai.v1.WebhookEventStatus
+ */
+export enum WebhookEventStatus {
+  /**
+   * @generated from enum value: WEBHOOK_EVENT_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A delivery is being applied
+   *
+   * @generated from enum value: WEBHOOK_EVENT_STATUS_PROCESSING = 1;
+   */
+  PROCESSING = 1,
+
+  /**
+   * Applied; redeliveries are skipped
+   *
+   * @generated from enum value: WEBHOOK_EVENT_STATUS_PROCESSED = 2;
+   */
+  PROCESSED = 2,
+
+  /**
+   * Nothing to apply, e.g. superseded by a newer event
+   *
+   * @generated from enum value: WEBHOOK_EVENT_STATUS_IGNORED = 3;
+   */
+  IGNORED = 3,
+
+  /**
+   * Applying failed; applied again on redelivery or replay
+   *
+   * @generated from enum value: WEBHOOK_EVENT_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.WebhookEventStatus.
+ */
+export const WebhookEventStatusSchema: GenEnum<WebhookEventStatus> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_job_admin, 1);
+
+/**
+ * JobAdminService lets platform administrators manage failed background tasks,
+ * Stripe webhook events and enterprise entitlements across all tenants.
+ *
+ * @generated from service mirai.v1.JobAdminService
+ */
+export const JobAdminService: GenService<{
+  /**
+   * ListFailedTasks returns tasks waiting to retry or archived after exhausting their retries.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.ListFailedTasks
+   */
+  listFailedTasks: {
+    methodKind: "unary";
+    input: typeof ListFailedTasksRequestSchema;
+    output: typeof ListFailedTasksResponseSchema;
+  },
+  /**
+   * GetFailedTask returns a task with its payload and last error.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.GetFailedTask
+   */
+  getFailedTask: {
+    methodKind: "unary";
+    input: typeof GetFailedTaskRequestSchema;
+    output: typeof GetFailedTaskResponseSchema;
+  },
+  /**
+   * ReplayFailedTask runs a failed task again now, resetting the job or registration it works on.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.ReplayFailedTask
+   */
+  replayFailedTask: {
+    methodKind: "unary";
+    input: typeof ReplayFailedTaskRequestSchema;
+    output: typeof ReplayFailedTaskResponseSchema;
+  },
+  /**
+   * DeleteFailedTask removes a failed task from its queue.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.DeleteFailedTask
+   */
+  deleteFailedTask: {
+    methodKind: "unary";
+    input: typeof DeleteFailedTaskRequestSchema;
+    output: typeof DeleteFailedTaskResponseSchema;
+  },
+  /**
+   * ListStuckProvisioning returns paid registrations still waiting for their account or whose provisioning failed.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.ListStuckProvisioning
+   */
+  listStuckProvisioning: {
+    methodKind: "unary";
+    input: typeof ListStuckProvisioningRequestSchema;
+    output: typeof ListStuckProvisioningResponseSchema;
+  },
+  /**
+   * RetryProvisioning enqueues provisioning of a stuck or failed registration.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.RetryProvisioning
+   */
+  retryProvisioning: {
+    methodKind: "unary";
+    input: typeof RetryProvisioningRequestSchema;
+    output: typeof RetryProvisioningResponseSchema;
+  },
+  /**
+   * ListWebhookEvents returns stored Stripe webhook events, most recently received first.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.ListWebhookEvents
+   */
+  listWebhookEvents: {
+    methodKind: "unary";
+    input: typeof ListWebhookEventsRequestSchema;
+    output: typeof ListWebhookEventsResponseSchema;
+  },
+  /**
+   * ReplayWebhookEvent applies a stored Stripe webhook event again.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.ReplayWebhookEvent
+   */
+  replayWebhookEvent: {
+    methodKind: "unary";
+    input: typeof ReplayWebhookEventRequestSchema;
+    output: typeof ReplayWebhookEventResponseSchema;
+  },
+  /**
+   * SetEntitlementOverrides stores the entitlements agreed in an enterprise company's contract.
+   *
+   * @generated from rpc mirai.v1.JobAdminService.SetEntitlementOverrides
+   */
+  setEntitlementOverrides: {
+    methodKind: "unary";
+    input: typeof SetEntitlementOverridesRequestSchema;
+    output: typeof SetEntitlementOverridesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_mirai_v1_job_admin, 0);
+
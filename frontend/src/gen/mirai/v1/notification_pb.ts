@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/notification.proto.
  */
 export const file_mirai_v1_notification: GenFile = /*@__PURE__*/
-  fileDesc("ChttaXJhaS92MS9ub3RpZmljYXRpb24ucHJvdG8SCG1pcmFpLnYxIvoDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSKAoEdHlwZRgEIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGUSMAoIcHJpb3JpdHkYBSABKA4yHi5taXJhaS52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEhYKCWNvdXJzZV9pZBgIIAEoCUgAiAEBEhMKBmpvYl9pZBgJIAEoCUgBiAEBEhQKB3Rhc2tfaWQYCiABKAlIAogBARITCgZzbWVfaWQYCyABKAlIA4gBARIXCgphY3Rpb25fdXJsGAwgASgJSASIAQESDAoEcmVhZBgNIAEoCBISCgplbWFpbF9zZW50GA4gASgIEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKB3JlYWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAWIAQFCDAoKX2NvdXJzZV9pZEIJCgdfam9iX2lkQgoKCF90YXNrX2lkQgkKB19zbWVfaWRCDQoLX2FjdGlvbl91cmxCCgoIX3JlYWRfYXQiHwodU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QigwEKHlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXNwb25zZRIzCgpldmVudF90eXBlGAEgASgOMh8ubWlyYWkudjEuTm90aWZpY2F0aW9uRXZlbnRUeXBlEiwKDG5vdGlmaWNhdGlvbhgCIAEoCzIWLm1pcmFpLnYxLk5vdGlmaWNhdGlvbiKrAQoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0EhgKC3VucmVhZF9vbmx5GAEgASgISACIAQESLQoEdHlwZRgCIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGVIAYgBARINCgVsaW1pdBgDIAEoBRITCgZjdXJzb3IYBCABKAlIAogBAUIOCgxfdW5yZWFkX29ubHlCBwoFX3R5cGVCCQoHX2N1cnNvciKJAQoZTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRItCg1ub3RpZmljYXRpb25zGAEgAygLMhYubWlyYWkudjEuTm90aWZpY2F0aW9uEhgKC25leHRfY3Vyc29yGAIgASgJSACIAQESEwoLdG90YWxfY291bnQYAyABKAVCDgoMX25leHRfY3Vyc29yIhcKFUdldFVucmVhZENvdW50UmVxdWVzdCInChZHZXRVbnJlYWRDb3VudFJlc3BvbnNlEg0KBWNvdW50GAEgASgFIi0KEU1hcmtBc1JlYWRSZXF1ZXN0EhgKEG5vdGlmaWNhdGlvbl9pZHMYASADKAkiKgoSTWFya0FzUmVhZFJlc3BvbnNlEhQKDG1hcmtlZF9jb3VudBgBIAEoBSIWChRNYXJrQWxsQXNSZWFkUmVxdWVzdCItChVNYXJrQWxsQXNSZWFkUmVzcG9uc2USFAoMbWFya2VkX2NvdW50GAEgASgFIjQKGURlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QSFwoPbm90aWZpY2F0aW9uX2lkGAEgASgJIhwKGkRlbGV0ZU5vdGlmaWNhdGlvblJlc3BvbnNlKvQCChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19BU1NJR05FRBABEiMKH05PVElGSUNBVElPTl9UWVBFX1RBU0tfRFVFX1NPT04QAhIoCiROT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fQ09NUExFVEUQAxImCiJOT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fRkFJTEVEEAQSIwofTk9USUZJQ0FUSU9OX1RZUEVfT1VUTElORV9SRUFEWRAFEikKJU5PVElGSUNBVElPTl9UWVBFX0dFTkVSQVRJT05fQ09NUExFVEUQBhInCiNOT1RJRklDQVRJT05fVFlQRV9HRU5FUkFUSU9OX0ZBSUxFRBAHEigKJE5PVElGSUNBVElPTl9UWVBFX0FQUFJPVkFMX1JFUVVFU1RFRBAIKp4BChROb3RpZmljYXRpb25Qcmlvcml0eRIlCiFOT1RJRklDQVRJT05fUFJJT1JJVFlfVU5TUEVDSUZJRUQQABIdChlOT1RJRklDQVRJT05fUFJJT1JJVFlfTE9XEAESIAocTk9USUZJQ0FUSU9OX1BSSU9SSVRZX05PUk1BTBACEh4KGk5PVElGSUNBVElPTl9QUklPUklUWV9ISUdIEAMq0wEKFU5vdGlmaWNhdGlvbkV2ZW50VHlwZRInCiNOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEiMKH05PVElGSUNBVElPTl9FVkVOVF9UWVBFX0NSRUFURUQQARIgChxOT1RJRklDQVRJT05fRVZFTlRfVFlQRV9SRUFEEAISIwofTk9USUZJQ0FUSU9OX0VWRU5UX1RZUEVfREVMRVRFRBADEiUKIU5PVElGSUNBVElPTl9FVkVOVF9UWVBFX0tFRVBBTElWRRAEMrMEChNOb3RpZmljYXRpb25TZXJ2aWNlElwKEUxpc3ROb3RpZmljYXRpb25zEiIubWlyYWkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0GiMubWlyYWkudjEuTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRJTCg5HZXRVbnJlYWRDb3VudBIfLm1pcmFpLnYxLkdldFVucmVhZENvdW50UmVxdWVzdBogLm1pcmFpLnYxLkdldFVucmVhZENvdW50UmVzcG9uc2USRwoKTWFya0FzUmVhZBIbLm1pcmFpLnYxLk1hcmtBc1JlYWRSZXF1ZXN0GhwubWlyYWkudjEuTWFya0FzUmVhZFJlc3BvbnNlElAKDU1hcmtBbGxBc1JlYWQSHi5taXJhaS52MS5NYXJrQWxsQXNSZWFkUmVxdWVzdBofLm1pcmFpLnYxLk1hcmtBbGxBc1JlYWRSZXNwb25zZRJfChJEZWxldGVOb3RpZmljYXRpb24SIy5taXJhaS52MS5EZWxldGVOb3RpZmljYXRpb25SZXF1ZXN0GiQubWlyYWkudjEuRGVsZXRlTm90aWZpY2F0aW9uUmVzcG9uc2USbQoWU3Vic2NyaWJlTm90aWZpY2F0aW9ucxInLm1pcmFpLnYxLlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXF1ZXN0GigubWlyYWkudjEuU3Vic2NyaWJlTm90aWZpY2F0aW9uc1Jlc3BvbnNlMAFClwEKDGNvbS5taXJhaS52MUIRTm90aWZpY2F0aW9uUHJvdG9QAVozZ2l0aHViLmNvbS9zb2dvcy9taXJhaS1iYWNrZW5kL2dlbi9taXJhaS92MTttaXJhaXYxogIDTVhYqgIITWlyYWkuVjHKAghNaXJhaVxWMeICFE1pcmFpXFYxXEdQQk1ldGFkYXRh6gIJTWlyYWk6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChttaXJhaS92MS9ub3RpZmljYXRpb24ucHJvdG8SCG1pcmFpLnYxIvoDCgxOb3RpZmljYXRpb24SCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSKAoEdHlwZRgEIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGUSMAoIcHJpb3JpdHkYBSABKA4yHi5taXJhaS52MS5Ob3RpZmljYXRpb25Qcmlvcml0eRINCgV0aXRsZRgGIAEoCRIPCgdtZXNzYWdlGAcgASgJEhYKCWNvdXJzZV9pZBgIIAEoCUgAiAEBEhMKBmpvYl9pZBgJIAEoCUgBiAEBEhQKB3Rhc2tfaWQYCiABKAlIAogBARITCgZzbWVfaWQYCyABKAlIA4gBARIXCgphY3Rpb25fdXJsGAwgASgJSASIAQESDAoEcmVhZBgNIAEoCBISCgplbWFpbF9zZW50GA4gASgIEi4KCmNyZWF0ZWRfYXQYDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKB3JlYWRfYXQYECABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAWIAQFCDAoKX2NvdXJzZV9pZEIJCgdfam9iX2lkQgoKCF90YXNrX2lkQgkKB19zbWVfaWRCDQoLX2FjdGlvbl91cmxCCgoIX3JlYWRfYXQiHwodU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QigwEKHlN1YnNjcmliZU5vdGlmaWNhdGlvbnNSZXNwb25zZRIzCgpldmVudF90eXBlGAEgASgOMh8ubWlyYWkudjEuTm90aWZpY2F0aW9uRXZlbnRUeXBlEiwKDG5vdGlmaWNhdGlvbhgCIAEoCzIWLm1pcmFpLnYxLk5vdGlmaWNhdGlvbiKrAQoYTGlzdE5vdGlmaWNhdGlvbnNSZXF1ZXN0EhgKC3VucmVhZF9vbmx5GAEgASgISACIAQESLQoEdHlwZRgCIAEoDjIaLm1pcmFpLnYxLk5vdGlmaWNhdGlvblR5cGVIAYgBARINCgVsaW1pdBgDIAEoBRITCgZjdXJzb3IYBCABKAlIAogBAUIOCgxfdW5yZWFkX29ubHlCBwoFX3R5cGVCCQoHX2N1cnNvciKJAQoZTGlzdE5vdGlmaWNhdGlvbnNSZXNwb25zZRItCg1ub3RpZmljYXRpb25zGAEgAygLMhYubWlyYWkudjEuTm90aWZpY2F0aW9uEhgKC25leHRfY3Vyc29yGAIgASgJSACIAQESEwoLdG90YWxfY291bnQYAyABKAVCDgoMX25leHRfY3Vyc29yIhcKFUdldFVucmVhZENvdW50UmVxdWVzdCInChZHZXRVbnJlYWRDb3VudFJlc3BvbnNlEg0KBWNvdW50GAEgASgFIi0KEU1hcmtBc1JlYWRSZXF1ZXN0EhgKEG5vdGlmaWNhdGlvbl9pZHMYASADKAkiKgoSTWFya0FzUmVhZFJlc3BvbnNlEhQKDG1hcmtlZF9jb3VudBgBIAEoBSIWChRNYXJrQWxsQXNSZWFkUmVxdWVzdCItChVNYXJrQWxsQXNSZWFkUmVzcG9uc2USFAoMbWFya2VkX2NvdW50GAEgASgFIjQKGURlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QSFwoPbm90aWZpY2F0aW9uX2lkGAEgASgJIhwKGkRlbGV0ZU5vdGlmaWNhdGlvblJlc3BvbnNlKsEDChBOb3RpZmljYXRpb25UeXBlEiEKHU5PVElGSUNBVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASIwofTk9USUZJQ0FUSU9OX1RZUEVfVEFTS19BU1NJR05FRBABEiMKH05PVElGSUNBVElPTl9UWVBFX1RBU0tfRFVFX1NPT04QAhIoCiROT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fQ09NUExFVEUQAxImCiJOT1RJRklDQVRJT05fVFlQRV9JTkdFU1RJT05fRkFJTEVEEAQSIwofTk9USUZJQ0FUSU9OX1RZUEVfT1VUTElORV9SRUFEWRAFEikKJU5PVElGSUNBVElPTl9UWVBFX0dFTkVSQVRJT05fQ09NUExFVEUQBhInCiNOT1RJRklDQVRJT05fVFlQRV9HRU5FUkFUSU9OX0ZBSUxFRBAHEigKJE5PVElGSUNBVElPTl9UWVBFX0FQUFJPVkFMX1JFUVVFU1RFRBAIEiMKH05PVElGSUNBVElPTl9UWVBFX0xFU1NPTlNfU1RBTEUQCRImCiJOT1RJRklDQVRJT05fVFlQRV9QQVlNRU5UX1BBU1RfRFVFEAoqngEKFE5vdGlmaWNhdGlvblByaW9yaXR5EiUKIU5PVElGSUNBVElPTl9QUklPUklUWV9VTlNQRUNJRklFRBAAEh0KGU5PVElGSUNBVElPTl9QUklPUklUWV9MT1cQARIgChxOT1RJRklDQVRJT05fUFJJT1JJVFlfTk9STUFMEAISHgoaTk9USUZJQ0FUSU9OX1BSSU9SSVRZX0hJR0gQAyrTAQoVTm90aWZpY2F0aW9uRXZlbnRUeXBlEicKI05PVElGSUNBVElPTl9FVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASIwofTk9USUZJQ0FUSU9OX0VWRU5UX1RZUEVfQ1JFQVRFRBABEiAKHE5PVElGSUNBVElPTl9FVkVOVF9UWVBFX1JFQUQQAhIjCh9OT1RJRklDQVRJT05fRVZFTlRfVFlQRV9ERUxFVEVEEAMSJQohTk9USUZJQ0FUSU9OX0VWRU5UX1RZUEVfS0VFUEFMSVZFEAQyswQKE05vdGlmaWNhdGlvblNlcnZpY2USXAoRTGlzdE5vdGlmaWNhdGlvbnMSIi5taXJhaS52MS5MaXN0Tm90aWZpY2F0aW9uc1JlcXVlc3QaIy5taXJhaS52MS5MaXN0Tm90aWZpY2F0aW9uc1Jlc3BvbnNlElMKDkdldFVucmVhZENvdW50Eh8ubWlyYWkudjEuR2V0VW5yZWFkQ291bnRSZXF1ZXN0GiAubWlyYWkudjEuR2V0VW5yZWFkQ291bnRSZXNwb25zZRJHCgpNYXJrQXNSZWFkEhsubWlyYWkudjEuTWFya0FzUmVhZFJlcXVlc3QaHC5taXJhaS52MS5NYXJrQXNSZWFkUmVzcG9uc2USUAoNTWFya0FsbEFzUmVhZBIeLm1pcmFpLnYxLk1hcmtBbGxBc1JlYWRSZXF1ZXN0Gh8ubWlyYWkudjEuTWFya0FsbEFzUmVhZFJlc3BvbnNlEl8KEkRlbGV0ZU5vdGlmaWNhdGlvbhIjLm1pcmFpLnYxLkRlbGV0ZU5vdGlmaWNhdGlvblJlcXVlc3QaJC5taXJhaS52MS5EZWxldGVOb3RpZmljYXRpb25SZXNwb25zZRJtChZTdWJzY3JpYmVOb3RpZmljYXRpb25zEicubWlyYWkudjEuU3Vic2NyaWJlTm90aWZpY2F0aW9uc1JlcXVlc3QaKC5taXJhaS52MS5TdWJzY3JpYmVOb3RpZmljYXRpb25zUmVzcG9uc2UwAUKXAQoMY29tLm1pcmFpLnYxQhFOb3RpZmljYXRpb25Qcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * Notification represents a user notification.
@@ -428,6 +428,20 @@ export enum NotificationType {
    * @generated from enum value: NOTIFICATION_TYPE_APPROVAL_REQUESTED = 8;
    */
   APPROVAL_REQUESTED = 8,
+
+  /**
+   * SME knowledge behind generated lessons changed
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_LESSONS_STALE = 9;
+   */
+  LESSONS_STALE = 9,
+
+  /**
+   * Subscription payment overdue (dunning)
+   *
+   * @generated from enum value: NOTIFICATION_TYPE_PAYMENT_PAST_DUE = 10;
+   */
+  PAYMENT_PAST_DUE = 10,
 }
 
 /**
@@ -158,6 +158,34 @@ export const updateKnowledgeChunk = SMEService.method.updateKnowledgeChunk;
  */
 export const deleteKnowledgeChunk = SMEService.method.deleteKnowledgeChunk;
 
+/**
+ * ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+ *
+ * @generated from rpc mirai.v1.SMEService.ListKnowledgeChunkVersions
+ */
+export const listKnowledgeChunkVersions = SMEService.method.listKnowledgeChunkVersions;
+
+/**
+ * RestoreKnowledgeChunkVersion returns a chunk to an earlier version, re-creating it if deleted.
+ *
+ * @generated from rpc mirai.v1.SMEService.RestoreKnowledgeChunkVersion
+ */
+export const restoreKnowledgeChunkVersion = SMEService.method.restoreKnowledgeChunkVersion;
+
+/**
+ * ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+ *
+ * @generated from rpc mirai.v1.SMEService.ListDeletedKnowledgeChunks
+ */
+export const listDeletedKnowledgeChunks = SMEService.method.listDeletedKnowledgeChunks;
+
+/**
+ * ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+ *
+ * @generated from rpc mirai.v1.SMEService.ListOutdatedLessons
+ */
+export const listOutdatedLessons = SMEService.method.listOutdatedLessons;
+
 /**
  * DeleteTask permanently removes a task.
  *
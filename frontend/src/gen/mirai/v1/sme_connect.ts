@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ApproveSubmissionRequest, ApproveSubmissionResponse, CancelTaskRequest, CancelTaskResponse, CreateSMERequest, CreateSMEResponse, CreateTaskRequest, CreateTaskResponse, DeleteKnowledgeChunkRequest, DeleteKnowledgeChunkResponse, DeleteSMERequest, DeleteSMEResponse, DeleteTaskRequest, DeleteTaskResponse, EnhanceSubmissionContentRequest, EnhanceSubmissionContentResponse, GetKnowledgeRequest, GetKnowledgeResponse, GetSMERequest, GetSMEResponse, GetSubmissionRequest, GetSubmissionResponse, GetTaskRequest, GetTaskResponse, GetUploadURLRequest, GetUploadURLResponse, ListSMEsRequest, ListSMEsResponse, ListSubmissionsRequest, ListSubmissionsResponse, ListTasksRequest, ListTasksResponse, RequestSubmissionChangesRequest, RequestSubmissionChangesResponse, RestoreSMERequest, RestoreSMEResponse, SearchKnowledgeRequest, SearchKnowledgeResponse, SubmitContentRequest, SubmitContentResponse, UpdateKnowledgeChunkRequest, UpdateKnowledgeChunkResponse, UpdateSMERequest, UpdateSMEResponse, UpdateTaskRequest, UpdateTaskResponse } from "./sme_pb.js";
+import { ApproveSubmissionRequest, ApproveSubmissionResponse, CancelTaskRequest, CancelTaskResponse, CreateSMERequest, CreateSMEResponse, CreateTaskRequest, CreateTaskResponse, DeleteKnowledgeChunkRequest, DeleteKnowledgeChunkResponse, DeleteSMERequest, DeleteSMEResponse, DeleteTaskRequest, DeleteTaskResponse, EnhanceSubmissionContentRequest, EnhanceSubmissionContentResponse, GetKnowledgeRequest, GetKnowledgeResponse, GetSMERequest, GetSMEResponse, GetSubmissionRequest, GetSubmissionResponse, GetTaskRequest, GetTaskResponse, GetUploadURLRequest, GetUploadURLResponse, ListDeletedKnowledgeChunksRequest, ListDeletedKnowledgeChunksResponse, ListKnowledgeChunkVersionsRequest, ListKnowledgeChunkVersionsResponse, ListOutdatedLessonsRequest, ListOutdatedLessonsResponse, ListSMEsRequest, ListSMEsResponse, ListSubmissionsRequest, ListSubmissionsResponse, ListTasksRequest, ListTasksResponse, RequestSubmissionChangesRequest, RequestSubmissionChangesResponse, RestoreKnowledgeChunkVersionRequest, RestoreKnowledgeChunkVersionResponse, RestoreSMERequest, RestoreSMEResponse, SearchKnowledgeRequest, SearchKnowledgeResponse, SubmitContentRequest, SubmitContentResponse, UpdateKnowledgeChunkRequest, UpdateKnowledgeChunkResponse, UpdateSMERequest, UpdateSMEResponse, UpdateTaskRequest, UpdateTaskResponse } from "./sme_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -256,6 +256,50 @@ export const SMEService = {
       O: DeleteKnowledgeChunkResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+     *
+     * @generated from rpc mirai.v1.SMEService.ListKnowledgeChunkVersions
+     */
+    listKnowledgeChunkVersions: {
+      name: "ListKnowledgeChunkVersions",
+      I: ListKnowledgeChunkVersionsRequest,
+      O: ListKnowledgeChunkVersionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RestoreKnowledgeChunkVersion returns a chunk to an earlier version, re-creating it if deleted.
+     *
+     * @generated from rpc mirai.v1.SMEService.RestoreKnowledgeChunkVersion
+     */
+    restoreKnowledgeChunkVersion: {
+      name: "RestoreKnowledgeChunkVersion",
+      I: RestoreKnowledgeChunkVersionRequest,
+      O: RestoreKnowledgeChunkVersionResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+     *
+     * @generated from rpc mirai.v1.SMEService.ListDeletedKnowledgeChunks
+     */
+    listDeletedKnowledgeChunks: {
+      name: "ListDeletedKnowledgeChunks",
+      I: ListDeletedKnowledgeChunksRequest,
+      O: ListDeletedKnowledgeChunksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+     *
+     * @generated from rpc mirai.v1.SMEService.ListOutdatedLessons
+     */
+    listOutdatedLessons: {
+      name: "ListOutdatedLessons",
+      I: ListOutdatedLessonsRequest,
+      O: ListOutdatedLessonsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * DeleteTask permanently removes a task.
      *
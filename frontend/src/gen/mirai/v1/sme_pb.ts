@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/sme.proto.
  */
 export const file_mirai_v1_sme: GenFile = /*@__PURE__*/
-  fileDesc("ChJtaXJhaS92MS9zbWUucHJvdG8SCG1pcmFpLnYxIscDChNTdWJqZWN0TWF0dGVyRXhwZXJ0EgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDgoGZG9tYWluGAYgASgJEiEKBXNjb3BlGAcgASgOMhIubWlyYWkudjEuU01FU2NvcGUSEAoIdGVhbV9pZHMYCCADKAkSIwoGc3RhdHVzGAkgASgOMhMubWlyYWkudjEuU01FU3RhdHVzEh4KEWtub3dsZWRnZV9zdW1tYXJ5GAogASgJSACIAQESIwoWa25vd2xlZGdlX2NvbnRlbnRfcGF0aBgLIAEoCUgBiAEBEhoKEmNyZWF0ZWRfYnlfdXNlcl9pZBgMIAEoCRIuCgpjcmVhdGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIUChJfa25vd2xlZGdlX3N1bW1hcnlCGQoXX2tub3dsZWRnZV9jb250ZW50X3BhdGgi/wMKB1NNRVRhc2sSCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg4KBnNtZV9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBiABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAcgASgJEhsKE2Fzc2lnbmVkX2J5X3VzZXJfaWQYCCABKAkSFAoHdGVhbV9pZBgJIAEoCUgAiAEBEicKBnN0YXR1cxgKIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXMSMQoIZHVlX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMY29tcGxldGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBQgoKCF90ZWFtX2lkQgsKCV9kdWVfZGF0ZUIPCg1fY29tcGxldGVkX2F0IsoFChFTTUVUYXNrU3VibWlzc2lvbhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSDwoHdGFza19pZBgDIAEoCRIRCglmaWxlX25hbWUYBCABKAkSEQoJZmlsZV9wYXRoGAUgASgJEisKDGNvbnRlbnRfdHlwZRgGIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgHIAEoAxIbCg5leHRyYWN0ZWRfdGV4dBgIIAEoCUgAiAEBEhcKCmFpX3N1bW1hcnkYCSABKAlIAYgBARIcCg9pbmdlc3Rpb25fZXJyb3IYCiABKAlIAogBARIcChRzdWJtaXR0ZWRfYnlfdXNlcl9pZBgLIAEoCRIwCgxzdWJtaXR0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDHByb2Nlc3NlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARIbCg5yZXZpZXdlcl9ub3RlcxgOIAEoCUgEiAEBEh0KEGFwcHJvdmVkX2NvbnRlbnQYDyABKAlIBYgBARITCgtpc19hcHByb3ZlZBgQIAEoCBI0CgthcHByb3ZlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIgChNhcHByb3ZlZF9ieV91c2VyX2lkGBIgASgJSAeIAQFCEQoPX2V4dHJhY3RlZF90ZXh0Qg0KC19haV9zdW1tYXJ5QhIKEF9pbmdlc3Rpb25fZXJyb3JCDwoNX3Byb2Nlc3NlZF9hdEIRCg9fcmV2aWV3ZXJfbm90ZXNCEwoRX2FwcHJvdmVkX2NvbnRlbnRCDgoMX2FwcHJvdmVkX2F0QhYKFF9hcHByb3ZlZF9ieV91c2VyX2lkItgBChFTTUVLbm93bGVkZ2VDaHVuaxIKCgJpZBgBIAEoCRIOCgZzbWVfaWQYAiABKAkSGgoNc3VibWlzc2lvbl9pZBgDIAEoCUgAiAEBEg8KB2NvbnRlbnQYBCABKAkSDQoFdG9waWMYBSABKAkSEAoIa2V5d29yZHMYBiADKAkSFwoPcmVsZXZhbmNlX3Njb3JlGAcgASgCEi4KCmNyZWF0ZWRfYXQYCCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhAKDl9zdWJtaXNzaW9uX2lkInoKEENyZWF0ZVNNRVJlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgtkZXNjcmlwdGlvbhgCIAEoCRIOCgZkb21haW4YAyABKAkSIQoFc2NvcGUYBCABKA4yEi5taXJhaS52MS5TTUVTY29wZRIQCgh0ZWFtX2lkcxgFIAMoCSI/ChFDcmVhdGVTTUVSZXNwb25zZRIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0Ih8KDUdldFNNRVJlcXVlc3QSDgoGc21lX2lkGAEgASgJIjwKDkdldFNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQizgEKD0xpc3RTTUVzUmVxdWVzdBImCgVzY29wZRgBIAEoDjISLm1pcmFpLnYxLlNNRVNjb3BlSACIAQESKAoGc3RhdHVzGAIgASgOMhMubWlyYWkudjEuU01FU3RhdHVzSAGIAQESFAoHdGVhbV9pZBgDIAEoCUgCiAEBEh0KEGluY2x1ZGVfYXJjaGl2ZWQYBCABKAhIA4gBAUIICgZfc2NvcGVCCQoHX3N0YXR1c0IKCghfdGVhbV9pZEITChFfaW5jbHVkZV9hcmNoaXZlZCI/ChBMaXN0U01Fc1Jlc3BvbnNlEisKBHNtZXMYASADKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0IoECChBVcGRhdGVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARITCgZkb21haW4YBCABKAlIAogBARImCgVzY29wZRgFIAEoDjISLm1pcmFpLnYxLlNNRVNjb3BlSAOIAQESEAoIdGVhbV9pZHMYBiADKAkSKAoGc3RhdHVzGAcgASgOMhMubWlyYWkudjEuU01FU3RhdHVzSASIAQFCBwoFX25hbWVCDgoMX2Rlc2NyaXB0aW9uQgkKB19kb21haW5CCAoGX3Njb3BlQgkKB19zdGF0dXMiPwoRVXBkYXRlU01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCIiChBEZWxldGVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSITChFEZWxldGVTTUVSZXNwb25zZSIjChFSZXN0b3JlU01FUmVxdWVzdBIOCgZzbWVfaWQYASABKAkiQAoSUmVzdG9yZVNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQi/AEKEUNyZWF0ZVRhc2tSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCRINCgV0aXRsZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBCABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAUgASgJEhQKB3RlYW1faWQYBiABKAlIAIgBARIxCghkdWVfZGF0ZRgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAYgBAUIKCghfdGVhbV9pZEILCglfZHVlX2RhdGUiNQoSQ3JlYXRlVGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIiEKDkdldFRhc2tSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkiMgoPR2V0VGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIqUBChBMaXN0VGFza3NSZXF1ZXN0EhMKBnNtZV9pZBgBIAEoCUgAiAEBEiAKE2Fzc2lnbmVkX3RvX3VzZXJfaWQYAiABKAlIAYgBARIsCgZzdGF0dXMYAyABKA4yFy5taXJhaS52MS5TTUVUYXNrU3RhdHVzSAKIAQFCCQoHX3NtZV9pZEIWChRfYXNzaWduZWRfdG9fdXNlcl9pZEIJCgdfc3RhdHVzIjUKEUxpc3RUYXNrc1Jlc3BvbnNlEiAKBXRhc2tzGAEgAygLMhEubWlyYWkudjEuU01FVGFzayKBAgoRVXBkYXRlVGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCRISCgV0aXRsZRgCIAEoCUgAiAEBEhgKC2Rlc2NyaXB0aW9uGAMgASgJSAGIAQESOQoVZXhwZWN0ZWRfY29udGVudF90eXBlGAQgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGVIAogBARIxCghkdWVfZGF0ZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBAUIICgZfdGl0bGVCDgoMX2Rlc2NyaXB0aW9uQhgKFl9leHBlY3RlZF9jb250ZW50X3R5cGVCCwoJX2R1ZV9kYXRlIjUKElVwZGF0ZVRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayIkChFDYW5jZWxUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIjUKEkNhbmNlbFRhc2tSZXNwb25zZRIfCgR0YXNrGAEgASgLMhEubWlyYWkudjEuU01FVGFzayJ/ChNHZXRVcGxvYWRVUkxSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkSEQoJZmlsZV9uYW1lGAIgASgJEisKDGNvbnRlbnRfdHlwZRgDIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgEIAEoAyJtChRHZXRVcGxvYWRVUkxSZXNwb25zZRISCgp1cGxvYWRfdXJsGAEgASgJEhEKCWZpbGVfcGF0aBgCIAEoCRIuCgpleHBpcmVzX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK/AQoUU3VibWl0Q29udGVudFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIRCglmaWxlX25hbWUYAiABKAkSEQoJZmlsZV9wYXRoGAMgASgJEisKDGNvbnRlbnRfdHlwZRgEIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgFIAEoAxIZCgx0ZXh0X2NvbnRlbnQYBiABKAlIAIgBAUIPCg1fdGV4dF9jb250ZW50IkgKFVN1Ym1pdENvbnRlbnRSZXNwb25zZRIvCgpzdWJtaXNzaW9uGAEgASgLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iKQoWTGlzdFN1Ym1pc3Npb25zUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIksKF0xpc3RTdWJtaXNzaW9uc1Jlc3BvbnNlEjAKC3N1Ym1pc3Npb25zGAEgAygLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iJQoTR2V0S25vd2xlZGdlUmVxdWVzdBIOCgZzbWVfaWQYASABKAkibwoUR2V0S25vd2xlZGdlUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydBIrCgZjaHVua3MYAiADKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayJHChZTZWFyY2hLbm93bGVkZ2VSZXF1ZXN0Eg8KB3NtZV9pZHMYASADKAkSDQoFcXVlcnkYAiABKAkSDQoFbGltaXQYAyABKAUiRgoXU2VhcmNoS25vd2xlZGdlUmVzcG9uc2USKwoGY2h1bmtzGAEgAygLMhsubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmsiLQoUR2V0U3VibWlzc2lvblJlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCSJIChVHZXRTdWJtaXNzaW9uUmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uIksKGEFwcHJvdmVTdWJtaXNzaW9uUmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEhgKEGFwcHJvdmVkX2NvbnRlbnQYAiABKAkigQEKGUFwcHJvdmVTdWJtaXNzaW9uUmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uEjMKDmNyZWF0ZWRfY2h1bmtzGAIgAygLMhsubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmsiSgofUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzUmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEhAKCGZlZWRiYWNrGAIgASgJIlMKIFJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1Jlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbiJlCh9FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkSKwoMZW5oYW5jZV90eXBlGAIgASgOMhUubWlyYWkudjEuRW5oYW5jZVR5cGUiVgogRW5oYW5jZVN1Ym1pc3Npb25Db250ZW50UmVzcG9uc2USGAoQZW5oYW5jZWRfY29udGVudBgBIAEoCRIYChBvcmlnaW5hbF9jb250ZW50GAIgASgJInAKG1VwZGF0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBIQCghjaHVua19pZBgBIAEoCRIPCgdjb250ZW50GAIgASgJEhIKBXRvcGljGAMgASgJSACIAQESEAoIa2V5d29yZHMYBCADKAlCCAoGX3RvcGljIkoKHFVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USKgoFY2h1bmsYASABKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayIvChtEZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QSEAoIY2h1bmtfaWQYASABKAkiHgocRGVsZXRlS25vd2xlZGdlQ2h1bmtSZXNwb25zZSIkChFEZWxldGVUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJIhQKEkRlbGV0ZVRhc2tSZXNwb25zZSpPCghTTUVTY29wZRIZChVTTUVfU0NPUEVfVU5TUEVDSUZJRUQQABIUChBTTUVfU0NPUEVfR0xPQkFMEAESEgoOU01FX1NDT1BFX1RFQU0QAiqHAQoJU01FU3RhdHVzEhoKFlNNRV9TVEFUVVNfVU5TUEVDSUZJRUQQABIUChBTTUVfU1RBVFVTX0RSQUZUEAESGAoUU01FX1NUQVRVU19JTkdFU1RJTkcQAhIVChFTTUVfU1RBVFVTX0FDVElWRRADEhcKE1NNRV9TVEFUVVNfQVJDSElWRUQQBCqyAgoNU01FVGFza1N0YXR1cxIfChtTTUVfVEFTS19TVEFUVVNfVU5TUEVDSUZJRUQQABIbChdTTUVfVEFTS19TVEFUVVNfUEVORElORxABEh0KGVNNRV9UQVNLX1NUQVRVU19TVUJNSVRURUQQAhIeChpTTUVfVEFTS19TVEFUVVNfUFJPQ0VTU0lORxADEh0KGVNNRV9UQVNLX1NUQVRVU19DT01QTEVURUQQBBIaChZTTUVfVEFTS19TVEFUVVNfRkFJTEVEEAUSHQoZU01FX1RBU0tfU1RBVFVTX0NBTkNFTExFRBAGEiMKH1NNRV9UQVNLX1NUQVRVU19BV0FJVElOR19SRVZJRVcQBxIlCiFTTUVfVEFTS19TVEFUVVNfQ0hBTkdFU19SRVFVRVNURUQQCCphCgtFbmhhbmNlVHlwZRIcChhFTkhBTkNFX1RZUEVfVU5TUEVDSUZJRUQQABIaChZFTkhBTkNFX1RZUEVfU1VNTUFSSVpFEAESGAoURU5IQU5DRV9UWVBFX0lNUFJPVkUQAiq7AQoLQ29udGVudFR5cGUSHAoYQ09OVEVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVQ09OVEVOVF9UWVBFX0RPQ1VNRU5UEAESFgoSQ09OVEVOVF9UWVBFX0lNQUdFEAISFgoSQ09OVEVOVF9UWVBFX1ZJREVPEAMSFgoSQ09OVEVOVF9UWVBFX0FVRElPEAQSFAoQQ09OVEVOVF9UWVBFX1VSTBAFEhUKEUNPTlRFTlRfVFlQRV9URVhUEAYy1Q4KClNNRVNlcnZpY2USRAoJQ3JlYXRlU01FEhoubWlyYWkudjEuQ3JlYXRlU01FUmVxdWVzdBobLm1pcmFpLnYxLkNyZWF0ZVNNRVJlc3BvbnNlEjsKBkdldFNNRRIXLm1pcmFpLnYxLkdldFNNRVJlcXVlc3QaGC5taXJhaS52MS5HZXRTTUVSZXNwb25zZRJBCghMaXN0U01FcxIZLm1pcmFpLnYxLkxpc3RTTUVzUmVxdWVzdBoaLm1pcmFpLnYxLkxpc3RTTUVzUmVzcG9uc2USRAoJVXBkYXRlU01FEhoubWlyYWkudjEuVXBkYXRlU01FUmVxdWVzdBobLm1pcmFpLnYxLlVwZGF0ZVNNRVJlc3BvbnNlEkQKCURlbGV0ZVNNRRIaLm1pcmFpLnYxLkRlbGV0ZVNNRVJlcXVlc3QaGy5taXJhaS52MS5EZWxldGVTTUVSZXNwb25zZRJHCgpSZXN0b3JlU01FEhsubWlyYWkudjEuUmVzdG9yZVNNRVJlcXVlc3QaHC5taXJhaS52MS5SZXN0b3JlU01FUmVzcG9uc2USRwoKQ3JlYXRlVGFzaxIbLm1pcmFpLnYxLkNyZWF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuQ3JlYXRlVGFza1Jlc3BvbnNlEj4KB0dldFRhc2sSGC5taXJhaS52MS5HZXRUYXNrUmVxdWVzdBoZLm1pcmFpLnYxLkdldFRhc2tSZXNwb25zZRJECglMaXN0VGFza3MSGi5taXJhaS52MS5MaXN0VGFza3NSZXF1ZXN0GhsubWlyYWkudjEuTGlzdFRhc2tzUmVzcG9uc2USRwoKVXBkYXRlVGFzaxIbLm1pcmFpLnYxLlVwZGF0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuVXBkYXRlVGFza1Jlc3BvbnNlEkcKCkNhbmNlbFRhc2sSGy5taXJhaS52MS5DYW5jZWxUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkNhbmNlbFRhc2tSZXNwb25zZRJNCgxHZXRVcGxvYWRVUkwSHS5taXJhaS52MS5HZXRVcGxvYWRVUkxSZXF1ZXN0Gh4ubWlyYWkudjEuR2V0VXBsb2FkVVJMUmVzcG9uc2USUAoNU3VibWl0Q29udGVudBIeLm1pcmFpLnYxLlN1Ym1pdENvbnRlbnRSZXF1ZXN0Gh8ubWlyYWkudjEuU3VibWl0Q29udGVudFJlc3BvbnNlElYKD0xpc3RTdWJtaXNzaW9ucxIgLm1pcmFpLnYxLkxpc3RTdWJtaXNzaW9uc1JlcXVlc3QaIS5taXJhaS52MS5MaXN0U3VibWlzc2lvbnNSZXNwb25zZRJNCgxHZXRLbm93bGVkZ2USHS5taXJhaS52MS5HZXRLbm93bGVkZ2VSZXF1ZXN0Gh4ubWlyYWkudjEuR2V0S25vd2xlZGdlUmVzcG9uc2USVgoPU2VhcmNoS25vd2xlZGdlEiAubWlyYWkudjEuU2VhcmNoS25vd2xlZGdlUmVxdWVzdBohLm1pcmFpLnYxLlNlYXJjaEtub3dsZWRnZVJlc3BvbnNlElAKDUdldFN1Ym1pc3Npb24SHi5taXJhaS52MS5HZXRTdWJtaXNzaW9uUmVxdWVzdBofLm1pcmFpLnYxLkdldFN1Ym1pc3Npb25SZXNwb25zZRJcChFBcHByb3ZlU3VibWlzc2lvbhIiLm1pcmFpLnYxLkFwcHJvdmVTdWJtaXNzaW9uUmVxdWVzdBojLm1pcmFpLnYxLkFwcHJvdmVTdWJtaXNzaW9uUmVzcG9uc2UScQoYUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzEikubWlyYWkudjEuUmVxdWVzdFN1Ym1pc3Npb25DaGFuZ2VzUmVxdWVzdBoqLm1pcmFpLnYxLlJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1Jlc3BvbnNlEnEKGEVuaGFuY2VTdWJtaXNzaW9uQ29udGVudBIpLm1pcmFpLnYxLkVuaGFuY2VTdWJtaXNzaW9uQ29udGVudFJlcXVlc3QaKi5taXJhaS52MS5FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXNwb25zZRJlChRVcGRhdGVLbm93bGVkZ2VDaHVuaxIlLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVxdWVzdBomLm1pcmFpLnYxLlVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USZQoURGVsZXRlS25vd2xlZGdlQ2h1bmsSJS5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QaJi5taXJhaS52MS5EZWxldGVLbm93bGVkZ2VDaHVua1Jlc3BvbnNlEkcKCkRlbGV0ZVRhc2sSGy5taXJhaS52MS5EZWxldGVUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkRlbGV0ZVRhc2tSZXNwb25zZUKOAQoMY29tLm1pcmFpLnYxQghTbWVQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("ChJtaXJhaS92MS9zbWUucHJvdG8SCG1pcmFpLnYxIscDChNTdWJqZWN0TWF0dGVyRXhwZXJ0EgoKAmlkGAEgASgJEhEKCXRlbmFudF9pZBgCIAEoCRISCgpjb21wYW55X2lkGAMgASgJEgwKBG5hbWUYBCABKAkSEwoLZGVzY3JpcHRpb24YBSABKAkSDgoGZG9tYWluGAYgASgJEiEKBXNjb3BlGAcgASgOMhIubWlyYWkudjEuU01FU2NvcGUSEAoIdGVhbV9pZHMYCCADKAkSIwoGc3RhdHVzGAkgASgOMhMubWlyYWkudjEuU01FU3RhdHVzEh4KEWtub3dsZWRnZV9zdW1tYXJ5GAogASgJSACIAQESIwoWa25vd2xlZGdlX2NvbnRlbnRfcGF0aBgLIAEoCUgBiAEBEhoKEmNyZWF0ZWRfYnlfdXNlcl9pZBgMIAEoCRIuCgpjcmVhdGVkX2F0GA0gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIUChJfa25vd2xlZGdlX3N1bW1hcnlCGQoXX2tub3dsZWRnZV9jb250ZW50X3BhdGgi/wMKB1NNRVRhc2sSCgoCaWQYASABKAkSEQoJdGVuYW50X2lkGAIgASgJEg4KBnNtZV9pZBgDIAEoCRINCgV0aXRsZRgEIAEoCRITCgtkZXNjcmlwdGlvbhgFIAEoCRI0ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBiABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZRIbChNhc3NpZ25lZF90b191c2VyX2lkGAcgASgJEhsKE2Fzc2lnbmVkX2J5X3VzZXJfaWQYCCABKAkSFAoHdGVhbV9pZBgJIAEoCUgAiAEBEicKBnN0YXR1cxgKIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXMSMQoIZHVlX2RhdGUYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQESLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNQoMY29tcGxldGVkX2F0GA4gASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBQgoKCF90ZWFtX2lkQgsKCV9kdWVfZGF0ZUIPCg1fY29tcGxldGVkX2F0IvwGChFTTUVUYXNrU3VibWlzc2lvbhIKCgJpZBgBIAEoCRIRCgl0ZW5hbnRfaWQYAiABKAkSDwoHdGFza19pZBgDIAEoCRIRCglmaWxlX25hbWUYBCABKAkSEQoJZmlsZV9wYXRoGAUgASgJEisKDGNvbnRlbnRfdHlwZRgGIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhcKD2ZpbGVfc2l6ZV9ieXRlcxgHIAEoAxIbCg5leHRyYWN0ZWRfdGV4dBgIIAEoCUgAiAEBEhcKCmFpX3N1bW1hcnkYCSABKAlIAYgBARIcCg9pbmdlc3Rpb25fZXJyb3IYCiABKAlIAogBARIcChRzdWJtaXR0ZWRfYnlfdXNlcl9pZBgLIAEoCRIwCgxzdWJtaXR0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjUKDHByb2Nlc3NlZF9hdBgNIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIA4gBARIbCg5yZXZpZXdlcl9ub3RlcxgOIAEoCUgEiAEBEh0KEGFwcHJvdmVkX2NvbnRlbnQYDyABKAlIBYgBARITCgtpc19hcHByb3ZlZBgQIAEoCBI0CgthcHByb3ZlZF9hdBgRIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIBogBARIgChNhcHByb3ZlZF9ieV91c2VyX2lkGBIgASgJSAeIAQESFwoKc291cmNlX3VybBgTIAEoCUgIiAEBEigKG3N1cGVyc2VkZWRfYnlfc3VibWlzc2lvbl9pZBgUIAEoCUgJiAEBEiMKFnJlcGxhY2VzX3N1Ym1pc3Npb25faWQYFSABKAlICogBAUIRCg9fZXh0cmFjdGVkX3RleHRCDQoLX2FpX3N1bW1hcnlCEgoQX2luZ2VzdGlvbl9lcnJvckIPCg1fcHJvY2Vzc2VkX2F0QhEKD19yZXZpZXdlcl9ub3Rlc0ITChFfYXBwcm92ZWRfY29udGVudEIOCgxfYXBwcm92ZWRfYXRCFgoUX2FwcHJvdmVkX2J5X3VzZXJfaWRCDQoLX3NvdXJjZV91cmxCHgocX3N1cGVyc2VkZWRfYnlfc3VibWlzc2lvbl9pZEIZChdfcmVwbGFjZXNfc3VibWlzc2lvbl9pZCL2AgoRU01FS25vd2xlZGdlQ2h1bmsSCgoCaWQYASABKAkSDgoGc21lX2lkGAIgASgJEhoKDXN1Ym1pc3Npb25faWQYAyABKAlIAIgBARIPCgdjb250ZW50GAQgASgJEg0KBXRvcGljGAUgASgJEhAKCGtleXdvcmRzGAYgAygJEhcKD3JlbGV2YW5jZV9zY29yZRgHIAEoAhIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIbCg5zb3VyY2VfaGVhZGluZxgJIAEoCUgBiAEBEhgKC3NvdXJjZV9wYWdlGAogASgFSAKIAQESJQoYc291cmNlX3RpbWVzdGFtcF9zZWNvbmRzGAsgASgFSAOIAQFCEAoOX3N1Ym1pc3Npb25faWRCEQoPX3NvdXJjZV9oZWFkaW5nQg4KDF9zb3VyY2VfcGFnZUIbChlfc291cmNlX3RpbWVzdGFtcF9zZWNvbmRzIv0CChhTTUVLbm93bGVkZ2VDaHVua1ZlcnNpb24SEAoIY2h1bmtfaWQYASABKAkSDgoGc21lX2lkGAIgASgJEg8KB3ZlcnNpb24YAyABKAUSMgoLY2hhbmdlX3R5cGUYBCABKA4yHS5taXJhaS52MS5Lbm93bGVkZ2VDaGFuZ2VUeXBlEhoKDWNoYW5nZV9yZWFzb24YBSABKAlIAIgBARIbCg5hdXRob3JfdXNlcl9pZBgGIAEoCUgBiAEBEhoKDXN1Ym1pc3Npb25faWQYByABKAlIAogBARIPCgdjb250ZW50GAggASgJEg0KBXRvcGljGAkgASgJEhAKCGtleXdvcmRzGAogAygJEgwKBGRpZmYYCyABKAkSLgoKY3JlYXRlZF9hdBgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCEAoOX2NoYW5nZV9yZWFzb25CEQoPX2F1dGhvcl91c2VyX2lkQhAKDl9zdWJtaXNzaW9uX2lkItQBCg5PdXRkYXRlZExlc3NvbhIRCglsZXNzb25faWQYASABKAkSEQoJY291cnNlX2lkGAIgASgJEg0KBXRpdGxlGAMgASgJEjAKDGdlbmVyYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIY2h1bmtfaWQYBSABKAkSGQoRZ2VuZXJhdGVkX3ZlcnNpb24YBiABKAUSFwoPY3VycmVudF92ZXJzaW9uGAcgASgFEhUKDWNodW5rX2RlbGV0ZWQYCCABKAgiegoQQ3JlYXRlU01FUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg4KBmRvbWFpbhgDIAEoCRIhCgVzY29wZRgEIAEoDjISLm1pcmFpLnYxLlNNRVNjb3BlEhAKCHRlYW1faWRzGAUgAygJIj8KEUNyZWF0ZVNNRVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQiHwoNR2V0U01FUmVxdWVzdBIOCgZzbWVfaWQYASABKAkiPAoOR2V0U01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCLOAQoPTGlzdFNNRXNSZXF1ZXN0EiYKBXNjb3BlGAEgASgOMhIubWlyYWkudjEuU01FU2NvcGVIAIgBARIoCgZzdGF0dXMYAiABKA4yEy5taXJhaS52MS5TTUVTdGF0dXNIAYgBARIUCgd0ZWFtX2lkGAMgASgJSAKIAQESHQoQaW5jbHVkZV9hcmNoaXZlZBgEIAEoCEgDiAEBQggKBl9zY29wZUIJCgdfc3RhdHVzQgoKCF90ZWFtX2lkQhMKEV9pbmNsdWRlX2FyY2hpdmVkIj8KEExpc3RTTUVzUmVzcG9uc2USKwoEc21lcxgBIAMoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQigQIKEFVwZGF0ZVNNRVJlcXVlc3QSDgoGc21lX2lkGAEgASgJEhEKBG5hbWUYAiABKAlIAIgBARIYCgtkZXNjcmlwdGlvbhgDIAEoCUgBiAEBEhMKBmRvbWFpbhgEIAEoCUgCiAEBEiYKBXNjb3BlGAUgASgOMhIubWlyYWkudjEuU01FU2NvcGVIA4gBARIQCgh0ZWFtX2lkcxgGIAMoCRIoCgZzdGF0dXMYByABKA4yEy5taXJhaS52MS5TTUVTdGF0dXNIBIgBAUIHCgVfbmFtZUIOCgxfZGVzY3JpcHRpb25CCQoHX2RvbWFpbkIICgZfc2NvcGVCCQoHX3N0YXR1cyI/ChFVcGRhdGVTTUVSZXNwb25zZRIqCgNzbWUYASABKAsyHS5taXJhaS52MS5TdWJqZWN0TWF0dGVyRXhwZXJ0IiIKEERlbGV0ZVNNRVJlcXVlc3QSDgoGc21lX2lkGAEgASgJIhMKEURlbGV0ZVNNRVJlc3BvbnNlIiMKEVJlc3RvcmVTTUVSZXF1ZXN0Eg4KBnNtZV9pZBgBIAEoCSJAChJSZXN0b3JlU01FUmVzcG9uc2USKgoDc21lGAEgASgLMh0ubWlyYWkudjEuU3ViamVjdE1hdHRlckV4cGVydCL8AQoRQ3JlYXRlVGFza1JlcXVlc3QSDgoGc21lX2lkGAEgASgJEg0KBXRpdGxlGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJEjQKFWV4cGVjdGVkX2NvbnRlbnRfdHlwZRgEIAEoDjIVLm1pcmFpLnYxLkNvbnRlbnRUeXBlEhsKE2Fzc2lnbmVkX3RvX3VzZXJfaWQYBSABKAkSFAoHdGVhbV9pZBgGIAEoCUgAiAEBEjEKCGR1ZV9kYXRlGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgBiAEBQgoKCF90ZWFtX2lkQgsKCV9kdWVfZGF0ZSI1ChJDcmVhdGVUYXNrUmVzcG9uc2USHwoEdGFzaxgBIAEoCzIRLm1pcmFpLnYxLlNNRVRhc2siIQoOR2V0VGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCSIyCg9HZXRUYXNrUmVzcG9uc2USHwoEdGFzaxgBIAEoCzIRLm1pcmFpLnYxLlNNRVRhc2sipQEKEExpc3RUYXNrc1JlcXVlc3QSEwoGc21lX2lkGAEgASgJSACIAQESIAoTYXNzaWduZWRfdG9fdXNlcl9pZBgCIAEoCUgBiAEBEiwKBnN0YXR1cxgDIAEoDjIXLm1pcmFpLnYxLlNNRVRhc2tTdGF0dXNIAogBAUIJCgdfc21lX2lkQhYKFF9hc3NpZ25lZF90b191c2VyX2lkQgkKB19zdGF0dXMiNQoRTGlzdFRhc2tzUmVzcG9uc2USIAoFdGFza3MYASADKAsyES5taXJhaS52MS5TTUVUYXNrIoECChFVcGRhdGVUYXNrUmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhIKBXRpdGxlGAIgASgJSACIAQESGAoLZGVzY3JpcHRpb24YAyABKAlIAYgBARI5ChVleHBlY3RlZF9jb250ZW50X3R5cGUYBCABKA4yFS5taXJhaS52MS5Db250ZW50VHlwZUgCiAEBEjEKCGR1ZV9kYXRlGAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgDiAEBQggKBl90aXRsZUIOCgxfZGVzY3JpcHRpb25CGAoWX2V4cGVjdGVkX2NvbnRlbnRfdHlwZUILCglfZHVlX2RhdGUiNQoSVXBkYXRlVGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIiQKEUNhbmNlbFRhc2tSZXF1ZXN0Eg8KB3Rhc2tfaWQYASABKAkiNQoSQ2FuY2VsVGFza1Jlc3BvbnNlEh8KBHRhc2sYASABKAsyES5taXJhaS52MS5TTUVUYXNrIn8KE0dldFVwbG9hZFVSTFJlcXVlc3QSDwoHdGFza19pZBgBIAEoCRIRCglmaWxlX25hbWUYAiABKAkSKwoMY29udGVudF90eXBlGAMgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGUSFwoPZmlsZV9zaXplX2J5dGVzGAQgASgDIm0KFEdldFVwbG9hZFVSTFJlc3BvbnNlEhIKCnVwbG9hZF91cmwYASABKAkSEQoJZmlsZV9wYXRoGAIgASgJEi4KCmV4cGlyZXNfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIqcCChRTdWJtaXRDb250ZW50UmVxdWVzdBIPCgd0YXNrX2lkGAEgASgJEhEKCWZpbGVfbmFtZRgCIAEoCRIRCglmaWxlX3BhdGgYAyABKAkSKwoMY29udGVudF90eXBlGAQgASgOMhUubWlyYWkudjEuQ29udGVudFR5cGUSFwoPZmlsZV9zaXplX2J5dGVzGAUgASgDEhkKDHRleHRfY29udGVudBgGIAEoCUgAiAEBEhcKCnNvdXJjZV91cmwYByABKAlIAYgBARIjChZyZXBsYWNlc19zdWJtaXNzaW9uX2lkGAggASgJSAKIAQFCDwoNX3RleHRfY29udGVudEINCgtfc291cmNlX3VybEIZChdfcmVwbGFjZXNfc3VibWlzc2lvbl9pZCJIChVTdWJtaXRDb250ZW50UmVzcG9uc2USLwoKc3VibWlzc2lvbhgBIAEoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uIikKFkxpc3RTdWJtaXNzaW9uc1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCSJLChdMaXN0U3VibWlzc2lvbnNSZXNwb25zZRIwCgtzdWJtaXNzaW9ucxgBIAMoCzIbLm1pcmFpLnYxLlNNRVRhc2tTdWJtaXNzaW9uIiUKE0dldEtub3dsZWRnZVJlcXVlc3QSDgoGc21lX2lkGAEgASgJIm8KFEdldEtub3dsZWRnZVJlc3BvbnNlEioKA3NtZRgBIAEoCzIdLm1pcmFpLnYxLlN1YmplY3RNYXR0ZXJFeHBlcnQSKwoGY2h1bmtzGAIgAygLMhsubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmsiRwoWU2VhcmNoS25vd2xlZGdlUmVxdWVzdBIPCgdzbWVfaWRzGAEgAygJEg0KBXF1ZXJ5GAIgASgJEg0KBWxpbWl0GAMgASgFIkYKF1NlYXJjaEtub3dsZWRnZVJlc3BvbnNlEisKBmNodW5rcxgBIAMoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIi0KFEdldFN1Ym1pc3Npb25SZXF1ZXN0EhUKDXN1Ym1pc3Npb25faWQYASABKAkiSAoVR2V0U3VibWlzc2lvblJlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbiJLChhBcHByb3ZlU3VibWlzc2lvblJlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCRIYChBhcHByb3ZlZF9jb250ZW50GAIgASgJIoEBChlBcHByb3ZlU3VibWlzc2lvblJlc3BvbnNlEi8KCnN1Ym1pc3Npb24YASABKAsyGy5taXJhaS52MS5TTUVUYXNrU3VibWlzc2lvbhIzCg5jcmVhdGVkX2NodW5rcxgCIAMoCzIbLm1pcmFpLnYxLlNNRUtub3dsZWRnZUNodW5rIkoKH1JlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1JlcXVlc3QSFQoNc3VibWlzc2lvbl9pZBgBIAEoCRIQCghmZWVkYmFjaxgCIAEoCSJTCiBSZXF1ZXN0U3VibWlzc2lvbkNoYW5nZXNSZXNwb25zZRIvCgpzdWJtaXNzaW9uGAEgASgLMhsubWlyYWkudjEuU01FVGFza1N1Ym1pc3Npb24iZQofRW5oYW5jZVN1Ym1pc3Npb25Db250ZW50UmVxdWVzdBIVCg1zdWJtaXNzaW9uX2lkGAEgASgJEisKDGVuaGFuY2VfdHlwZRgCIAEoDjIVLm1pcmFpLnYxLkVuaGFuY2VUeXBlIlYKIEVuaGFuY2VTdWJtaXNzaW9uQ29udGVudFJlc3BvbnNlEhgKEGVuaGFuY2VkX2NvbnRlbnQYASABKAkSGAoQb3JpZ2luYWxfY29udGVudBgCIAEoCSKeAQobVXBkYXRlS25vd2xlZGdlQ2h1bmtSZXF1ZXN0EhAKCGNodW5rX2lkGAEgASgJEg8KB2NvbnRlbnQYAiABKAkSEgoFdG9waWMYAyABKAlIAIgBARIQCghrZXl3b3JkcxgEIAMoCRIaCg1jaGFuZ2VfcmVhc29uGAUgASgJSAGIAQFCCAoGX3RvcGljQhAKDl9jaGFuZ2VfcmVhc29uIkoKHFVwZGF0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2USKgoFY2h1bmsYASABKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayJdChtEZWxldGVLbm93bGVkZ2VDaHVua1JlcXVlc3QSEAoIY2h1bmtfaWQYASABKAkSGgoNY2hhbmdlX3JlYXNvbhgCIAEoCUgAiAEBQhAKDl9jaGFuZ2VfcmVhc29uIh4KHERlbGV0ZUtub3dsZWRnZUNodW5rUmVzcG9uc2UiNQohTGlzdEtub3dsZWRnZUNodW5rVmVyc2lvbnNSZXF1ZXN0EhAKCGNodW5rX2lkGAEgASgJIo4BCiJMaXN0S25vd2xlZGdlQ2h1bmtWZXJzaW9uc1Jlc3BvbnNlEjQKCHZlcnNpb25zGAEgAygLMiIubWlyYWkudjEuU01FS25vd2xlZGdlQ2h1bmtWZXJzaW9uEjIKEG91dGRhdGVkX2xlc3NvbnMYAiADKAsyGC5taXJhaS52MS5PdXRkYXRlZExlc3NvbiJ2CiNSZXN0b3JlS25vd2xlZGdlQ2h1bmtWZXJzaW9uUmVxdWVzdBIQCghjaHVua19pZBgBIAEoCRIPCgd2ZXJzaW9uGAIgASgFEhoKDWNoYW5nZV9yZWFzb24YAyABKAlIAIgBAUIQCg5fY2hhbmdlX3JlYXNvbiJSCiRSZXN0b3JlS25vd2xlZGdlQ2h1bmtWZXJzaW9uUmVzcG9uc2USKgoFY2h1bmsYASABKAsyGy5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVuayIzCiFMaXN0RGVsZXRlZEtub3dsZWRnZUNodW5rc1JlcXVlc3QSDgoGc21lX2lkGAEgASgJIloKIkxpc3REZWxldGVkS25vd2xlZGdlQ2h1bmtzUmVzcG9uc2USNAoIdmVyc2lvbnMYASADKAsyIi5taXJhaS52MS5TTUVLbm93bGVkZ2VDaHVua1ZlcnNpb24iLAoaTGlzdE91dGRhdGVkTGVzc29uc1JlcXVlc3QSDgoGc21lX2lkGAEgASgJIkgKG0xpc3RPdXRkYXRlZExlc3NvbnNSZXNwb25zZRIpCgdsZXNzb25zGAEgAygLMhgubWlyYWkudjEuT3V0ZGF0ZWRMZXNzb24iJAoRRGVsZXRlVGFza1JlcXVlc3QSDwoHdGFza19pZBgBIAEoCSIUChJEZWxldGVUYXNrUmVzcG9uc2UqTwoIU01FU2NvcGUSGQoVU01FX1NDT1BFX1VOU1BFQ0lGSUVEEAASFAoQU01FX1NDT1BFX0dMT0JBTBABEhIKDlNNRV9TQ09QRV9URUFNEAIqhwEKCVNNRVN0YXR1cxIaChZTTUVfU1RBVFVTX1VOU1BFQ0lGSUVEEAASFAoQU01FX1NUQVRVU19EUkFGVBABEhgKFFNNRV9TVEFUVVNfSU5HRVNUSU5HEAISFQoRU01FX1NUQVRVU19BQ1RJVkUQAxIXChNTTUVfU1RBVFVTX0FSQ0hJVkVEEAQqsgIKDVNNRVRhc2tTdGF0dXMSHwobU01FX1RBU0tfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGwoXU01FX1RBU0tfU1RBVFVTX1BFTkRJTkcQARIdChlTTUVfVEFTS19TVEFUVVNfU1VCTUlUVEVEEAISHgoaU01FX1RBU0tfU1RBVFVTX1BST0NFU1NJTkcQAxIdChlTTUVfVEFTS19TVEFUVVNfQ09NUExFVEVEEAQSGgoWU01FX1RBU0tfU1RBVFVTX0ZBSUxFRBAFEh0KGVNNRV9UQVNLX1NUQVRVU19DQU5DRUxMRUQQBhIjCh9TTUVfVEFTS19TVEFUVVNfQVdBSVRJTkdfUkVWSUVXEAcSJQohU01FX1RBU0tfU1RBVFVTX0NIQU5HRVNfUkVRVUVTVEVEEAgqYQoLRW5oYW5jZVR5cGUSHAoYRU5IQU5DRV9UWVBFX1VOU1BFQ0lGSUVEEAASGgoWRU5IQU5DRV9UWVBFX1NVTU1BUklaRRABEhgKFEVOSEFOQ0VfVFlQRV9JTVBST1ZFEAIquwEKC0NvbnRlbnRUeXBlEhwKGENPTlRFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhkKFUNPTlRFTlRfVFlQRV9ET0NVTUVOVBABEhYKEkNPTlRFTlRfVFlQRV9JTUFHRRACEhYKEkNPTlRFTlRfVFlQRV9WSURFTxADEhYKEkNPTlRFTlRfVFlQRV9BVURJTxAEEhQKEENPTlRFTlRfVFlQRV9VUkwQBRIVChFDT05URU5UX1RZUEVfVEVYVBAGKskBChNLbm93bGVkZ2VDaGFuZ2VUeXBlEiUKIUtOT1dMRURHRV9DSEFOR0VfVFlQRV9VTlNQRUNJRklFRBAAEiEKHUtOT1dMRURHRV9DSEFOR0VfVFlQRV9DUkVBVEVEEAESIQodS05PV0xFREdFX0NIQU5HRV9UWVBFX1VQREFURUQQAhIhCh1LTk9XTEVER0VfQ0hBTkdFX1RZUEVfREVMRVRFRBADEiIKHktOT1dMRURHRV9DSEFOR0VfVFlQRV9SRVNUT1JFRBAEMqoSCgpTTUVTZXJ2aWNlEkQKCUNyZWF0ZVNNRRIaLm1pcmFpLnYxLkNyZWF0ZVNNRVJlcXVlc3QaGy5taXJhaS52MS5DcmVhdGVTTUVSZXNwb25zZRI7CgZHZXRTTUUSFy5taXJhaS52MS5HZXRTTUVSZXF1ZXN0GhgubWlyYWkudjEuR2V0U01FUmVzcG9uc2USQQoITGlzdFNNRXMSGS5taXJhaS52MS5MaXN0U01Fc1JlcXVlc3QaGi5taXJhaS52MS5MaXN0U01Fc1Jlc3BvbnNlEkQKCVVwZGF0ZVNNRRIaLm1pcmFpLnYxLlVwZGF0ZVNNRVJlcXVlc3QaGy5taXJhaS52MS5VcGRhdGVTTUVSZXNwb25zZRJECglEZWxldGVTTUUSGi5taXJhaS52MS5EZWxldGVTTUVSZXF1ZXN0GhsubWlyYWkudjEuRGVsZXRlU01FUmVzcG9uc2USRwoKUmVzdG9yZVNNRRIbLm1pcmFpLnYxLlJlc3RvcmVTTUVSZXF1ZXN0GhwubWlyYWkudjEuUmVzdG9yZVNNRVJlc3BvbnNlEkcKCkNyZWF0ZVRhc2sSGy5taXJhaS52MS5DcmVhdGVUYXNrUmVxdWVzdBocLm1pcmFpLnYxLkNyZWF0ZVRhc2tSZXNwb25zZRI+CgdHZXRUYXNrEhgubWlyYWkudjEuR2V0VGFza1JlcXVlc3QaGS5taXJhaS52MS5HZXRUYXNrUmVzcG9uc2USRAoJTGlzdFRhc2tzEhoubWlyYWkudjEuTGlzdFRhc2tzUmVxdWVzdBobLm1pcmFpLnYxLkxpc3RUYXNrc1Jlc3BvbnNlEkcKClVwZGF0ZVRhc2sSGy5taXJhaS52MS5VcGRhdGVUYXNrUmVxdWVzdBocLm1pcmFpLnYxLlVwZGF0ZVRhc2tSZXNwb25zZRJHCgpDYW5jZWxUYXNrEhsubWlyYWkudjEuQ2FuY2VsVGFza1JlcXVlc3QaHC5taXJhaS52MS5DYW5jZWxUYXNrUmVzcG9uc2USTQoMR2V0VXBsb2FkVVJMEh0ubWlyYWkudjEuR2V0VXBsb2FkVVJMUmVxdWVzdBoeLm1pcmFpLnYxLkdldFVwbG9hZFVSTFJlc3BvbnNlElAKDVN1Ym1pdENvbnRlbnQSHi5taXJhaS52MS5TdWJtaXRDb250ZW50UmVxdWVzdBofLm1pcmFpLnYxLlN1Ym1pdENvbnRlbnRSZXNwb25zZRJWCg9MaXN0U3VibWlzc2lvbnMSIC5taXJhaS52MS5MaXN0U3VibWlzc2lvbnNSZXF1ZXN0GiEubWlyYWkudjEuTGlzdFN1Ym1pc3Npb25zUmVzcG9uc2USTQoMR2V0S25vd2xlZGdlEh0ubWlyYWkudjEuR2V0S25vd2xlZGdlUmVxdWVzdBoeLm1pcmFpLnYxLkdldEtub3dsZWRnZVJlc3BvbnNlElYKD1NlYXJjaEtub3dsZWRnZRIgLm1pcmFpLnYxLlNlYXJjaEtub3dsZWRnZVJlcXVlc3QaIS5taXJhaS52MS5TZWFyY2hLbm93bGVkZ2VSZXNwb25zZRJQCg1HZXRTdWJtaXNzaW9uEh4ubWlyYWkudjEuR2V0U3VibWlzc2lvblJlcXVlc3QaHy5taXJhaS52MS5HZXRTdWJtaXNzaW9uUmVzcG9uc2USXAoRQXBwcm92ZVN1Ym1pc3Npb24SIi5taXJhaS52MS5BcHByb3ZlU3VibWlzc2lvblJlcXVlc3QaIy5taXJhaS52MS5BcHByb3ZlU3VibWlzc2lvblJlc3BvbnNlEnEKGFJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlcxIpLm1pcmFpLnYxLlJlcXVlc3RTdWJtaXNzaW9uQ2hhbmdlc1JlcXVlc3QaKi5taXJhaS52MS5SZXF1ZXN0U3VibWlzc2lvbkNoYW5nZXNSZXNwb25zZRJxChhFbmhhbmNlU3VibWlzc2lvbkNvbnRlbnQSKS5taXJhaS52MS5FbmhhbmNlU3VibWlzc2lvbkNvbnRlbnRSZXF1ZXN0GioubWlyYWkudjEuRW5oYW5jZVN1Ym1pc3Npb25Db250ZW50UmVzcG9uc2USZQoUVXBkYXRlS25vd2xlZGdlQ2h1bmsSJS5taXJhaS52MS5VcGRhdGVLbm93bGVkZ2VDaHVua1JlcXVlc3QaJi5taXJhaS52MS5VcGRhdGVLbm93bGVkZ2VDaHVua1Jlc3BvbnNlEmUKFERlbGV0ZUtub3dsZWRnZUNodW5rEiUubWlyYWkudjEuRGVsZXRlS25vd2xlZGdlQ2h1bmtSZXF1ZXN0GiYubWlyYWkudjEuRGVsZXRlS25vd2xlZGdlQ2h1bmtSZXNwb25zZRJ3ChpMaXN0S25vd2xlZGdlQ2h1bmtWZXJzaW9ucxIrLm1pcmFpLnYxLkxpc3RLbm93bGVkZ2VDaHVua1ZlcnNpb25zUmVxdWVzdBosLm1pcmFpLnYxLkxpc3RLbm93bGVkZ2VDaHVua1ZlcnNpb25zUmVzcG9uc2USfQocUmVzdG9yZUtub3dsZWRnZUNodW5rVmVyc2lvbhItLm1pcmFpLnYxLlJlc3RvcmVLbm93bGVkZ2VDaHVua1ZlcnNpb25SZXF1ZXN0Gi4ubWlyYWkudjEuUmVzdG9yZUtub3dsZWRnZUNodW5rVmVyc2lvblJlc3BvbnNlEncKGkxpc3REZWxldGVkS25vd2xlZGdlQ2h1bmtzEisubWlyYWkudjEuTGlzdERlbGV0ZWRLbm93bGVkZ2VDaHVua3NSZXF1ZXN0GiwubWlyYWkudjEuTGlzdERlbGV0ZWRLbm93bGVkZ2VDaHVua3NSZXNwb25zZRJiChNMaXN0T3V0ZGF0ZWRMZXNzb25zEiQubWlyYWkudjEuTGlzdE91dGRhdGVkTGVzc29uc1JlcXVlc3QaJS5taXJhaS52MS5MaXN0T3V0ZGF0ZWRMZXNzb25zUmVzcG9uc2USRwoKRGVsZXRlVGFzaxIbLm1pcmFpLnYxLkRlbGV0ZVRhc2tSZXF1ZXN0GhwubWlyYWkudjEuRGVsZXRlVGFza1Jlc3BvbnNlQo4BCgxjb20ubWlyYWkudjFCCFNtZVByb3RvUAFaM2dpdGh1Yi5jb20vc29nb3MvbWlyYWktYmFja2VuZC9nZW4vbWlyYWkvdjE7bWlyYWl2MaICA01YWKoCCE1pcmFpLlYxygIITWlyYWlcVjHiAhRNaXJhaVxWMVxHUEJNZXRhZGF0YeoCCU1pcmFpOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp]);
 
 /**
  * SubjectMatterExpert represents a knowledge source entity.
@@ -319,6 +319,27 @@ export type SMETaskSubmission = Message<"mirai.v1.SMETaskSubmission"> & {
    * @generated from field: optional string approved_by_user_id = 18;
    */
   approvedByUserId?: string;
+
+  /**
+   * Fetched web page (URL submissions); file_path holds its snapshot
+   *
+   * @generated from field: optional string source_url = 19;
+   */
+  sourceUrl?: string;
+
+  /**
+   * Newer submission whose knowledge replaced this one's
+   *
+   * @generated from field: optional string superseded_by_submission_id = 20;
+   */
+  supersededBySubmissionId?: string;
+
+  /**
+   * Earlier submission the submitter said this one replaces
+   *
+   * @generated from field: optional string replaces_submission_id = 21;
+   */
+  replacesSubmissionId?: string;
 };
 
 /**
@@ -383,6 +404,27 @@ export type SMEKnowledgeChunk = Message<"mirai.v1.SMEKnowledgeChunk"> & {
    * @generated from field: google.protobuf.Timestamp created_at = 8;
    */
   createdAt?: Timestamp;
+
+  /**
+   * Heading of the source document section (if known)
+   *
+   * @generated from field: optional string source_heading = 9;
+   */
+  sourceHeading?: string;
+
+  /**
+   * Page or slide number in the source document (if known)
+   *
+   * @generated from field: optional int32 source_page = 10;
+   */
+  sourcePage?: number;
+
+  /**
+   * Position in the source recording (if from audio or video)
+   *
+   * @generated from field: optional int32 source_timestamp_seconds = 11;
+   */
+  sourceTimestampSeconds?: number;
 };
 
 /**
@@ -392,6 +434,152 @@ export type SMEKnowledgeChunk = Message<"mirai.v1.SMEKnowledgeChunk"> & {
 export const SMEKnowledgeChunkSchema: GenMessage<SMEKnowledgeChunk> = /*@__PURE__*/
   messageDesc(file_mirai_v1_sme, 3);
 
+/**
+ * SMEKnowledgeChunkVersion is a snapshot of a knowledge chunk in its history.
+ *
+ * @generated from message mirai.v1.SMEKnowledgeChunkVersion
+ */
+export type SMEKnowledgeChunkVersion = Message<"mirai.v1.SMEKnowledgeChunkVersion"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * @generated from field: string sme_id = 2;
+   */
+  smeId: string;
+
+  /**
+   * Starts at 1 and increases with each change
+   *
+   * @generated from field: int32 version = 3;
+   */
+  version: number;
+
+  /**
+   * @generated from field: mirai.v1.KnowledgeChangeType change_type = 4;
+   */
+  changeType: KnowledgeChangeType;
+
+  /**
+   * Why the change was made (if given)
+   *
+   * @generated from field: optional string change_reason = 5;
+   */
+  changeReason?: string;
+
+  /**
+   * Who made the change; unset for system changes
+   *
+   * @generated from field: optional string author_user_id = 6;
+   */
+  authorUserId?: string;
+
+  /**
+   * Submission the knowledge came from
+   *
+   * @generated from field: optional string submission_id = 7;
+   */
+  submissionId?: string;
+
+  /**
+   * Chunk state after the change (before it, for deletions)
+   *
+   * @generated from field: string content = 8;
+   */
+  content: string;
+
+  /**
+   * @generated from field: string topic = 9;
+   */
+  topic: string;
+
+  /**
+   * @generated from field: repeated string keywords = 10;
+   */
+  keywords: string[];
+
+  /**
+   * Line diff from the previous version ("- " removed, "+ " added)
+   *
+   * @generated from field: string diff = 11;
+   */
+  diff: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 12;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message mirai.v1.SMEKnowledgeChunkVersion.
+ * Use `create(SMEKnowledgeChunkVersionSchema)` to create a new message.
+ */
+export const SMEKnowledgeChunkVersionSchema: GenMessage<SMEKnowledgeChunkVersion> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 4);
+
+/**
+ * OutdatedLesson is a generated lesson citing knowledge that changed after it was generated.
+ *
+ * @generated from message mirai.v1.OutdatedLesson
+ */
+export type OutdatedLesson = Message<"mirai.v1.OutdatedLesson"> & {
+  /**
+   * @generated from field: string lesson_id = 1;
+   */
+  lessonId: string;
+
+  /**
+   * @generated from field: string course_id = 2;
+   */
+  courseId: string;
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp generated_at = 4;
+   */
+  generatedAt?: Timestamp;
+
+  /**
+   * @generated from field: string chunk_id = 5;
+   */
+  chunkId: string;
+
+  /**
+   * Chunk version the lesson was generated from
+   *
+   * @generated from field: int32 generated_version = 6;
+   */
+  generatedVersion: number;
+
+  /**
+   * Latest chunk version
+   *
+   * @generated from field: int32 current_version = 7;
+   */
+  currentVersion: number;
+
+  /**
+   * Whether the chunk has since been deleted
+   *
+   * @generated from field: bool chunk_deleted = 8;
+   */
+  chunkDeleted: boolean;
+};
+
+/**
+ * Describes the message mirai.v1.OutdatedLesson.
+ * Use `create(OutdatedLessonSchema)` to create a new message.
+ */
+export const OutdatedLessonSchema: GenMessage<OutdatedLesson> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 5);
+
 /**
  * CreateSMERequest contains data for a new SME.
  *
@@ -431,7 +619,7 @@ export type CreateSMERequest = Message<"mirai.v1.CreateSMERequest"> & {
  * Use `create(CreateSMERequestSchema)` to create a new message.
  */
 export const CreateSMERequestSchema: GenMessage<CreateSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 4);
+  messageDesc(file_mirai_v1_sme, 6);
 
 /**
  * CreateSMEResponse contains the created SME.
@@ -450,7 +638,7 @@ export type CreateSMEResponse = Message<"mirai.v1.CreateSMEResponse"> & {
  * Use `create(CreateSMEResponseSchema)` to create a new message.
  */
 export const CreateSMEResponseSchema: GenMessage<CreateSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 5);
+  messageDesc(file_mirai_v1_sme, 7);
 
 /**
  * GetSMERequest contains the SME ID to fetch.
@@ -469,7 +657,7 @@ export type GetSMERequest = Message<"mirai.v1.GetSMERequest"> & {
  * Use `create(GetSMERequestSchema)` to create a new message.
  */
 export const GetSMERequestSchema: GenMessage<GetSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 6);
+  messageDesc(file_mirai_v1_sme, 8);
 
 /**
  * GetSMEResponse contains the requested SME.
@@ -488,7 +676,7 @@ export type GetSMEResponse = Message<"mirai.v1.GetSMEResponse"> & {
  * Use `create(GetSMEResponseSchema)` to create a new message.
  */
 export const GetSMEResponseSchema: GenMessage<GetSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 7);
+  messageDesc(file_mirai_v1_sme, 9);
 
 /**
  * ListSMEsRequest contains optional filters.
@@ -524,7 +712,7 @@ export type ListSMEsRequest = Message<"mirai.v1.ListSMEsRequest"> & {
  * Use `create(ListSMEsRequestSchema)` to create a new message.
  */
 export const ListSMEsRequestSchema: GenMessage<ListSMEsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 8);
+  messageDesc(file_mirai_v1_sme, 10);
 
 /**
  * ListSMEsResponse contains SMEs matching the filters.
@@ -543,7 +731,7 @@ export type ListSMEsResponse = Message<"mirai.v1.ListSMEsResponse"> & {
  * Use `create(ListSMEsResponseSchema)` to create a new message.
  */
 export const ListSMEsResponseSchema: GenMessage<ListSMEsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 9);
+  messageDesc(file_mirai_v1_sme, 11);
 
 /**
  * UpdateSMERequest contains fields to update on an SME.
@@ -592,7 +780,7 @@ export type UpdateSMERequest = Message<"mirai.v1.UpdateSMERequest"> & {
  * Use `create(UpdateSMERequestSchema)` to create a new message.
  */
 export const UpdateSMERequestSchema: GenMessage<UpdateSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 10);
+  messageDesc(file_mirai_v1_sme, 12);
 
 /**
  * UpdateSMEResponse contains the updated SME.
@@ -611,7 +799,7 @@ export type UpdateSMEResponse = Message<"mirai.v1.UpdateSMEResponse"> & {
  * Use `create(UpdateSMEResponseSchema)` to create a new message.
  */
 export const UpdateSMEResponseSchema: GenMessage<UpdateSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 11);
+  messageDesc(file_mirai_v1_sme, 13);
 
 /**
  * DeleteSMERequest contains the SME ID to delete.
@@ -630,7 +818,7 @@ export type DeleteSMERequest = Message<"mirai.v1.DeleteSMERequest"> & {
  * Use `create(DeleteSMERequestSchema)` to create a new message.
  */
 export const DeleteSMERequestSchema: GenMessage<DeleteSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 12);
+  messageDesc(file_mirai_v1_sme, 14);
 
 /**
  * DeleteSMEResponse confirms deletion.
@@ -645,7 +833,7 @@ export type DeleteSMEResponse = Message<"mirai.v1.DeleteSMEResponse"> & {
  * Use `create(DeleteSMEResponseSchema)` to create a new message.
  */
 export const DeleteSMEResponseSchema: GenMessage<DeleteSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 13);
+  messageDesc(file_mirai_v1_sme, 15);
 
 /**
  * RestoreSMERequest contains the SME ID to restore.
@@ -664,7 +852,7 @@ export type RestoreSMERequest = Message<"mirai.v1.RestoreSMERequest"> & {
  * Use `create(RestoreSMERequestSchema)` to create a new message.
  */
 export const RestoreSMERequestSchema: GenMessage<RestoreSMERequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 14);
+  messageDesc(file_mirai_v1_sme, 16);
 
 /**
  * RestoreSMEResponse contains the restored SME.
@@ -683,7 +871,7 @@ export type RestoreSMEResponse = Message<"mirai.v1.RestoreSMEResponse"> & {
  * Use `create(RestoreSMEResponseSchema)` to create a new message.
  */
 export const RestoreSMEResponseSchema: GenMessage<RestoreSMEResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 15);
+  messageDesc(file_mirai_v1_sme, 17);
 
 /**
  * CreateTaskRequest contains data for a new task.
@@ -732,7 +920,7 @@ export type CreateTaskRequest = Message<"mirai.v1.CreateTaskRequest"> & {
  * Use `create(CreateTaskRequestSchema)` to create a new message.
  */
 export const CreateTaskRequestSchema: GenMessage<CreateTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 16);
+  messageDesc(file_mirai_v1_sme, 18);
 
 /**
  * CreateTaskResponse contains the created task.
@@ -751,7 +939,7 @@ export type CreateTaskResponse = Message<"mirai.v1.CreateTaskResponse"> & {
  * Use `create(CreateTaskResponseSchema)` to create a new message.
  */
 export const CreateTaskResponseSchema: GenMessage<CreateTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 17);
+  messageDesc(file_mirai_v1_sme, 19);
 
 /**
  * GetTaskRequest contains the task ID to fetch.
@@ -770,7 +958,7 @@ export type GetTaskRequest = Message<"mirai.v1.GetTaskRequest"> & {
  * Use `create(GetTaskRequestSchema)` to create a new message.
  */
 export const GetTaskRequestSchema: GenMessage<GetTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 18);
+  messageDesc(file_mirai_v1_sme, 20);
 
 /**
  * GetTaskResponse contains the requested task.
@@ -789,7 +977,7 @@ export type GetTaskResponse = Message<"mirai.v1.GetTaskResponse"> & {
  * Use `create(GetTaskResponseSchema)` to create a new message.
  */
 export const GetTaskResponseSchema: GenMessage<GetTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 19);
+  messageDesc(file_mirai_v1_sme, 21);
 
 /**
  * ListTasksRequest contains filters for tasks.
@@ -824,7 +1012,7 @@ export type ListTasksRequest = Message<"mirai.v1.ListTasksRequest"> & {
  * Use `create(ListTasksRequestSchema)` to create a new message.
  */
 export const ListTasksRequestSchema: GenMessage<ListTasksRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 20);
+  messageDesc(file_mirai_v1_sme, 22);
 
 /**
  * ListTasksResponse contains tasks matching the filters.
@@ -843,7 +1031,7 @@ export type ListTasksResponse = Message<"mirai.v1.ListTasksResponse"> & {
  * Use `create(ListTasksResponseSchema)` to create a new message.
  */
 export const ListTasksResponseSchema: GenMessage<ListTasksResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 21);
+  messageDesc(file_mirai_v1_sme, 23);
 
 /**
  * UpdateTaskRequest contains fields to update on a task.
@@ -882,7 +1070,7 @@ export type UpdateTaskRequest = Message<"mirai.v1.UpdateTaskRequest"> & {
  * Use `create(UpdateTaskRequestSchema)` to create a new message.
  */
 export const UpdateTaskRequestSchema: GenMessage<UpdateTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 22);
+  messageDesc(file_mirai_v1_sme, 24);
 
 /**
  * UpdateTaskResponse contains the updated task.
@@ -901,7 +1089,7 @@ export type UpdateTaskResponse = Message<"mirai.v1.UpdateTaskResponse"> & {
  * Use `create(UpdateTaskResponseSchema)` to create a new message.
  */
 export const UpdateTaskResponseSchema: GenMessage<UpdateTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 23);
+  messageDesc(file_mirai_v1_sme, 25);
 
 /**
  * CancelTaskRequest contains the task ID to cancel.
@@ -920,7 +1108,7 @@ export type CancelTaskRequest = Message<"mirai.v1.CancelTaskRequest"> & {
  * Use `create(CancelTaskRequestSchema)` to create a new message.
  */
 export const CancelTaskRequestSchema: GenMessage<CancelTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 24);
+  messageDesc(file_mirai_v1_sme, 26);
 
 /**
  * CancelTaskResponse confirms cancellation.
@@ -939,7 +1127,7 @@ export type CancelTaskResponse = Message<"mirai.v1.CancelTaskResponse"> & {
  * Use `create(CancelTaskResponseSchema)` to create a new message.
  */
 export const CancelTaskResponseSchema: GenMessage<CancelTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 25);
+  messageDesc(file_mirai_v1_sme, 27);
 
 /**
  * GetUploadURLRequest requests a presigned URL for upload.
@@ -973,7 +1161,7 @@ export type GetUploadURLRequest = Message<"mirai.v1.GetUploadURLRequest"> & {
  * Use `create(GetUploadURLRequestSchema)` to create a new message.
  */
 export const GetUploadURLRequestSchema: GenMessage<GetUploadURLRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 26);
+  messageDesc(file_mirai_v1_sme, 28);
 
 /**
  * GetUploadURLResponse contains the presigned upload URL.
@@ -1004,7 +1192,7 @@ export type GetUploadURLResponse = Message<"mirai.v1.GetUploadURLResponse"> & {
  * Use `create(GetUploadURLResponseSchema)` to create a new message.
  */
 export const GetUploadURLResponseSchema: GenMessage<GetUploadURLResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 27);
+  messageDesc(file_mirai_v1_sme, 29);
 
 /**
  * SubmitContentRequest records a content submission.
@@ -1049,6 +1237,20 @@ export type SubmitContentRequest = Message<"mirai.v1.SubmitContentRequest"> & {
    * @generated from field: optional string text_content = 6;
    */
   textContent?: string;
+
+  /**
+   * Web page to fetch (for CONTENT_TYPE_URL)
+   *
+   * @generated from field: optional string source_url = 7;
+   */
+  sourceUrl?: string;
+
+  /**
+   * Earlier submission this one replaces, when it isn't the same file or URL
+   *
+   * @generated from field: optional string replaces_submission_id = 8;
+   */
+  replacesSubmissionId?: string;
 };
 
 /**
@@ -1056,7 +1258,7 @@ export type SubmitContentRequest = Message<"mirai.v1.SubmitContentRequest"> & {
  * Use `create(SubmitContentRequestSchema)` to create a new message.
  */
 export const SubmitContentRequestSchema: GenMessage<SubmitContentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 28);
+  messageDesc(file_mirai_v1_sme, 30);
 
 /**
  * SubmitContentResponse contains the created submission.
@@ -1075,7 +1277,7 @@ export type SubmitContentResponse = Message<"mirai.v1.SubmitContentResponse"> &
  * Use `create(SubmitContentResponseSchema)` to create a new message.
  */
 export const SubmitContentResponseSchema: GenMessage<SubmitContentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 29);
+  messageDesc(file_mirai_v1_sme, 31);
 
 /**
  * ListSubmissionsRequest contains the task ID.
@@ -1094,7 +1296,7 @@ export type ListSubmissionsRequest = Message<"mirai.v1.ListSubmissionsRequest">
  * Use `create(ListSubmissionsRequestSchema)` to create a new message.
  */
 export const ListSubmissionsRequestSchema: GenMessage<ListSubmissionsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 30);
+  messageDesc(file_mirai_v1_sme, 32);
 
 /**
  * ListSubmissionsResponse contains all submissions for the task.
@@ -1113,7 +1315,7 @@ export type ListSubmissionsResponse = Message<"mirai.v1.ListSubmissionsResponse"
  * Use `create(ListSubmissionsResponseSchema)` to create a new message.
  */
 export const ListSubmissionsResponseSchema: GenMessage<ListSubmissionsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 31);
+  messageDesc(file_mirai_v1_sme, 33);
 
 /**
  * GetKnowledgeRequest requests knowledge for an SME.
@@ -1132,7 +1334,7 @@ export type GetKnowledgeRequest = Message<"mirai.v1.GetKnowledgeRequest"> & {
  * Use `create(GetKnowledgeRequestSchema)` to create a new message.
  */
 export const GetKnowledgeRequestSchema: GenMessage<GetKnowledgeRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 32);
+  messageDesc(file_mirai_v1_sme, 34);
 
 /**
  * GetKnowledgeResponse contains the SME's knowledge.
@@ -1156,7 +1358,7 @@ export type GetKnowledgeResponse = Message<"mirai.v1.GetKnowledgeResponse"> & {
  * Use `create(GetKnowledgeResponseSchema)` to create a new message.
  */
 export const GetKnowledgeResponseSchema: GenMessage<GetKnowledgeResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 33);
+  messageDesc(file_mirai_v1_sme, 35);
 
 /**
  * SearchKnowledgeRequest searches across SME knowledge.
@@ -1191,7 +1393,7 @@ export type SearchKnowledgeRequest = Message<"mirai.v1.SearchKnowledgeRequest">
  * Use `create(SearchKnowledgeRequestSchema)` to create a new message.
  */
 export const SearchKnowledgeRequestSchema: GenMessage<SearchKnowledgeRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 34);
+  messageDesc(file_mirai_v1_sme, 36);
 
 /**
  * SearchKnowledgeResponse contains matching knowledge chunks.
@@ -1210,7 +1412,7 @@ export type SearchKnowledgeResponse = Message<"mirai.v1.SearchKnowledgeResponse"
  * Use `create(SearchKnowledgeResponseSchema)` to create a new message.
  */
 export const SearchKnowledgeResponseSchema: GenMessage<SearchKnowledgeResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 35);
+  messageDesc(file_mirai_v1_sme, 37);
 
 /**
  * GetSubmissionRequest requests a specific submission.
@@ -1229,7 +1431,7 @@ export type GetSubmissionRequest = Message<"mirai.v1.GetSubmissionRequest"> & {
  * Use `create(GetSubmissionRequestSchema)` to create a new message.
  */
 export const GetSubmissionRequestSchema: GenMessage<GetSubmissionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 36);
+  messageDesc(file_mirai_v1_sme, 38);
 
 /**
  * GetSubmissionResponse contains the requested submission.
@@ -1248,7 +1450,7 @@ export type GetSubmissionResponse = Message<"mirai.v1.GetSubmissionResponse"> &
  * Use `create(GetSubmissionResponseSchema)` to create a new message.
  */
 export const GetSubmissionResponseSchema: GenMessage<GetSubmissionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 37);
+  messageDesc(file_mirai_v1_sme, 39);
 
 /**
  * ApproveSubmissionRequest approves a submission and creates knowledge.
@@ -1274,7 +1476,7 @@ export type ApproveSubmissionRequest = Message<"mirai.v1.ApproveSubmissionReques
  * Use `create(ApproveSubmissionRequestSchema)` to create a new message.
  */
 export const ApproveSubmissionRequestSchema: GenMessage<ApproveSubmissionRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 38);
+  messageDesc(file_mirai_v1_sme, 40);
 
 /**
  * ApproveSubmissionResponse contains the approved submission and created knowledge.
@@ -1298,7 +1500,7 @@ export type ApproveSubmissionResponse = Message<"mirai.v1.ApproveSubmissionRespo
  * Use `create(ApproveSubmissionResponseSchema)` to create a new message.
  */
 export const ApproveSubmissionResponseSchema: GenMessage<ApproveSubmissionResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 39);
+  messageDesc(file_mirai_v1_sme, 41);
 
 /**
  * RequestSubmissionChangesRequest sends submission back for revision.
@@ -1324,7 +1526,7 @@ export type RequestSubmissionChangesRequest = Message<"mirai.v1.RequestSubmissio
  * Use `create(RequestSubmissionChangesRequestSchema)` to create a new message.
  */
 export const RequestSubmissionChangesRequestSchema: GenMessage<RequestSubmissionChangesRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 40);
+  messageDesc(file_mirai_v1_sme, 42);
 
 /**
  * RequestSubmissionChangesResponse contains the updated submission.
@@ -1343,7 +1545,7 @@ export type RequestSubmissionChangesResponse = Message<"mirai.v1.RequestSubmissi
  * Use `create(RequestSubmissionChangesResponseSchema)` to create a new message.
  */
 export const RequestSubmissionChangesResponseSchema: GenMessage<RequestSubmissionChangesResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 41);
+  messageDesc(file_mirai_v1_sme, 43);
 
 /**
  * EnhanceSubmissionContentRequest requests AI enhancement of content.
@@ -1367,7 +1569,7 @@ export type EnhanceSubmissionContentRequest = Message<"mirai.v1.EnhanceSubmissio
  * Use `create(EnhanceSubmissionContentRequestSchema)` to create a new message.
  */
 export const EnhanceSubmissionContentRequestSchema: GenMessage<EnhanceSubmissionContentRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 42);
+  messageDesc(file_mirai_v1_sme, 44);
 
 /**
  * EnhanceSubmissionContentResponse contains enhanced content.
@@ -1391,7 +1593,7 @@ export type EnhanceSubmissionContentResponse = Message<"mirai.v1.EnhanceSubmissi
  * Use `create(EnhanceSubmissionContentResponseSchema)` to create a new message.
  */
 export const EnhanceSubmissionContentResponseSchema: GenMessage<EnhanceSubmissionContentResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 43);
+  messageDesc(file_mirai_v1_sme, 45);
 
 /**
  * UpdateKnowledgeChunkRequest updates a knowledge chunk.
@@ -1418,6 +1620,13 @@ export type UpdateKnowledgeChunkRequest = Message<"mirai.v1.UpdateKnowledgeChunk
    * @generated from field: repeated string keywords = 4;
    */
   keywords: string[];
+
+  /**
+   * Recorded in the chunk's history
+   *
+   * @generated from field: optional string change_reason = 5;
+   */
+  changeReason?: string;
 };
 
 /**
@@ -1425,7 +1634,7 @@ export type UpdateKnowledgeChunkRequest = Message<"mirai.v1.UpdateKnowledgeChunk
  * Use `create(UpdateKnowledgeChunkRequestSchema)` to create a new message.
  */
 export const UpdateKnowledgeChunkRequestSchema: GenMessage<UpdateKnowledgeChunkRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 44);
+  messageDesc(file_mirai_v1_sme, 46);
 
 /**
  * UpdateKnowledgeChunkResponse contains the updated chunk.
@@ -1444,7 +1653,7 @@ export type UpdateKnowledgeChunkResponse = Message<"mirai.v1.UpdateKnowledgeChun
  * Use `create(UpdateKnowledgeChunkResponseSchema)` to create a new message.
  */
 export const UpdateKnowledgeChunkResponseSchema: GenMessage<UpdateKnowledgeChunkResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 45);
+  messageDesc(file_mirai_v1_sme, 47);
 
 /**
  * DeleteKnowledgeChunkRequest deletes a knowledge chunk.
@@ -1456,6 +1665,13 @@ export type DeleteKnowledgeChunkRequest = Message<"mirai.v1.DeleteKnowledgeChunk
    * @generated from field: string chunk_id = 1;
    */
   chunkId: string;
+
+  /**
+   * Recorded in the chunk's history
+   *
+   * @generated from field: optional string change_reason = 2;
+   */
+  changeReason?: string;
 };
 
 /**
@@ -1463,7 +1679,7 @@ export type DeleteKnowledgeChunkRequest = Message<"mirai.v1.DeleteKnowledgeChunk
  * Use `create(DeleteKnowledgeChunkRequestSchema)` to create a new message.
  */
 export const DeleteKnowledgeChunkRequestSchema: GenMessage<DeleteKnowledgeChunkRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 46);
+  messageDesc(file_mirai_v1_sme, 48);
 
 /**
  * DeleteKnowledgeChunkResponse confirms deletion.
@@ -1478,7 +1694,178 @@ export type DeleteKnowledgeChunkResponse = Message<"mirai.v1.DeleteKnowledgeChun
  * Use `create(DeleteKnowledgeChunkResponseSchema)` to create a new message.
  */
 export const DeleteKnowledgeChunkResponseSchema: GenMessage<DeleteKnowledgeChunkResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 47);
+  messageDesc(file_mirai_v1_sme, 49);
+
+/**
+ * ListKnowledgeChunkVersionsRequest requests a chunk's history.
+ *
+ * @generated from message mirai.v1.ListKnowledgeChunkVersionsRequest
+ */
+export type ListKnowledgeChunkVersionsRequest = Message<"mirai.v1.ListKnowledgeChunkVersionsRequest"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListKnowledgeChunkVersionsRequest.
+ * Use `create(ListKnowledgeChunkVersionsRequestSchema)` to create a new message.
+ */
+export const ListKnowledgeChunkVersionsRequestSchema: GenMessage<ListKnowledgeChunkVersionsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 50);
+
+/**
+ * ListKnowledgeChunkVersionsResponse contains the chunk's versions, oldest first.
+ *
+ * @generated from message mirai.v1.ListKnowledgeChunkVersionsResponse
+ */
+export type ListKnowledgeChunkVersionsResponse = Message<"mirai.v1.ListKnowledgeChunkVersionsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.SMEKnowledgeChunkVersion versions = 1;
+   */
+  versions: SMEKnowledgeChunkVersion[];
+
+  /**
+   * Lessons generated from an older version
+   *
+   * @generated from field: repeated mirai.v1.OutdatedLesson outdated_lessons = 2;
+   */
+  outdatedLessons: OutdatedLesson[];
+};
+
+/**
+ * Describes the message mirai.v1.ListKnowledgeChunkVersionsResponse.
+ * Use `create(ListKnowledgeChunkVersionsResponseSchema)` to create a new message.
+ */
+export const ListKnowledgeChunkVersionsResponseSchema: GenMessage<ListKnowledgeChunkVersionsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 51);
+
+/**
+ * RestoreKnowledgeChunkVersionRequest restores a chunk version.
+ *
+ * @generated from message mirai.v1.RestoreKnowledgeChunkVersionRequest
+ */
+export type RestoreKnowledgeChunkVersionRequest = Message<"mirai.v1.RestoreKnowledgeChunkVersionRequest"> & {
+  /**
+   * @generated from field: string chunk_id = 1;
+   */
+  chunkId: string;
+
+  /**
+   * @generated from field: int32 version = 2;
+   */
+  version: number;
+
+  /**
+   * Defaults to naming the restored version
+   *
+   * @generated from field: optional string change_reason = 3;
+   */
+  changeReason?: string;
+};
+
+/**
+ * Describes the message mirai.v1.RestoreKnowledgeChunkVersionRequest.
+ * Use `create(RestoreKnowledgeChunkVersionRequestSchema)` to create a new message.
+ */
+export const RestoreKnowledgeChunkVersionRequestSchema: GenMessage<RestoreKnowledgeChunkVersionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 52);
+
+/**
+ * RestoreKnowledgeChunkVersionResponse contains the restored chunk.
+ *
+ * @generated from message mirai.v1.RestoreKnowledgeChunkVersionResponse
+ */
+export type RestoreKnowledgeChunkVersionResponse = Message<"mirai.v1.RestoreKnowledgeChunkVersionResponse"> & {
+  /**
+   * @generated from field: mirai.v1.SMEKnowledgeChunk chunk = 1;
+   */
+  chunk?: SMEKnowledgeChunk;
+};
+
+/**
+ * Describes the message mirai.v1.RestoreKnowledgeChunkVersionResponse.
+ * Use `create(RestoreKnowledgeChunkVersionResponseSchema)` to create a new message.
+ */
+export const RestoreKnowledgeChunkVersionResponseSchema: GenMessage<RestoreKnowledgeChunkVersionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 53);
+
+/**
+ * ListDeletedKnowledgeChunksRequest requests an SME's deleted knowledge.
+ *
+ * @generated from message mirai.v1.ListDeletedKnowledgeChunksRequest
+ */
+export type ListDeletedKnowledgeChunksRequest = Message<"mirai.v1.ListDeletedKnowledgeChunksRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListDeletedKnowledgeChunksRequest.
+ * Use `create(ListDeletedKnowledgeChunksRequestSchema)` to create a new message.
+ */
+export const ListDeletedKnowledgeChunksRequestSchema: GenMessage<ListDeletedKnowledgeChunksRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 54);
+
+/**
+ * ListDeletedKnowledgeChunksResponse contains the last version of each deleted chunk.
+ *
+ * @generated from message mirai.v1.ListDeletedKnowledgeChunksResponse
+ */
+export type ListDeletedKnowledgeChunksResponse = Message<"mirai.v1.ListDeletedKnowledgeChunksResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.SMEKnowledgeChunkVersion versions = 1;
+   */
+  versions: SMEKnowledgeChunkVersion[];
+};
+
+/**
+ * Describes the message mirai.v1.ListDeletedKnowledgeChunksResponse.
+ * Use `create(ListDeletedKnowledgeChunksResponseSchema)` to create a new message.
+ */
+export const ListDeletedKnowledgeChunksResponseSchema: GenMessage<ListDeletedKnowledgeChunksResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 55);
+
+/**
+ * ListOutdatedLessonsRequest requests lessons outdated by changes to an SME's knowledge.
+ *
+ * @generated from message mirai.v1.ListOutdatedLessonsRequest
+ */
+export type ListOutdatedLessonsRequest = Message<"mirai.v1.ListOutdatedLessonsRequest"> & {
+  /**
+   * @generated from field: string sme_id = 1;
+   */
+  smeId: string;
+};
+
+/**
+ * Describes the message mirai.v1.ListOutdatedLessonsRequest.
+ * Use `create(ListOutdatedLessonsRequestSchema)` to create a new message.
+ */
+export const ListOutdatedLessonsRequestSchema: GenMessage<ListOutdatedLessonsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 56);
+
+/**
+ * ListOutdatedLessonsResponse contains the outdated lessons, newest first.
+ *
+ * @generated from message mirai.v1.ListOutdatedLessonsResponse
+ */
+export type ListOutdatedLessonsResponse = Message<"mirai.v1.ListOutdatedLessonsResponse"> & {
+  /**
+   * @generated from field: repeated mirai.v1.OutdatedLesson lessons = 1;
+   */
+  lessons: OutdatedLesson[];
+};
+
+/**
+ * Describes the message mirai.v1.ListOutdatedLessonsResponse.
+ * Use `create(ListOutdatedLessonsResponseSchema)` to create a new message.
+ */
+export const ListOutdatedLessonsResponseSchema: GenMessage<ListOutdatedLessonsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_sme, 57);
 
 /**
  * DeleteTaskRequest permanently deletes a task.
@@ -1497,7 +1884,7 @@ export type DeleteTaskRequest = Message<"mirai.v1.DeleteTaskRequest"> & {
  * Use `create(DeleteTaskRequestSchema)` to create a new message.
  */
 export const DeleteTaskRequestSchema: GenMessage<DeleteTaskRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 48);
+  messageDesc(file_mirai_v1_sme, 58);
 
 /**
  * DeleteTaskResponse confirms task deletion.
@@ -1512,7 +1899,7 @@ export type DeleteTaskResponse = Message<"mirai.v1.DeleteTaskResponse"> & {
  * Use `create(DeleteTaskResponseSchema)` to create a new message.
  */
 export const DeleteTaskResponseSchema: GenMessage<DeleteTaskResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_sme, 49);
+  messageDesc(file_mirai_v1_sme, 59);
 
 /**
  * SMEScope defines whether an SME is global or team-scoped.
@@ -1758,6 +2145,52 @@ export enum ContentType {
 export const ContentTypeSchema: GenEnum<ContentType> = /*@__PURE__*/
   enumDesc(file_mirai_v1_sme, 4);
 
+/**
+ * KnowledgeChangeType describes a change recorded in a knowledge chunk's history.
+ *
+ * @generated from enum mirai.v1.KnowledgeChangeType
+ */
+export enum KnowledgeChangeType {
+  /**
+   * @generated from enum value: KNOWLEDGE_CHANGE_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Extracted from a submission
+   *
+   * @generated from enum value: KNOWLEDGE_CHANGE_TYPE_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * Edited by a reviewer
+   *
+   * @generated from enum value: KNOWLEDGE_CHANGE_TYPE_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * Deleted by a reviewer or replaced by a newer submission
+   *
+   * @generated from enum value: KNOWLEDGE_CHANGE_TYPE_DELETED = 3;
+   */
+  DELETED = 3,
+
+  /**
+   * Returned to an earlier version
+   *
+   * @generated from enum value: KNOWLEDGE_CHANGE_TYPE_RESTORED = 4;
+   */
+  RESTORED = 4,
+}
+
+/**
+ * Describes the enum mirai.v1.KnowledgeChangeType.
+ */
+export const KnowledgeChangeTypeSchema: GenEnum<KnowledgeChangeType> = /*@__PURE__*/
+  enumDesc(file_mirai_v1_sme, 5);
+
 /**
  * SMEService handles SME and task operations.
  *
@@ -1984,6 +2417,46 @@ export const SMEService: GenService<{
     input: typeof DeleteKnowledgeChunkRequestSchema;
     output: typeof DeleteKnowledgeChunkResponseSchema;
   },
+  /**
+   * ListKnowledgeChunkVersions returns a chunk's edit history and the lessons generated from an older version.
+   *
+   * @generated from rpc mirai.v1.SMEService.ListKnowledgeChunkVersions
+   */
+  listKnowledgeChunkVersions: {
+    methodKind: "unary";
+    input: typeof ListKnowledgeChunkVersionsRequestSchema;
+    output: typeof ListKnowledgeChunkVersionsResponseSchema;
+  },
+  /**
+   * RestoreKnowledgeChunkVersion returns a chunk to an earlier version, re-creating it if deleted.
+   *
+   * @generated from rpc mirai.v1.SMEService.RestoreKnowledgeChunkVersion
+   */
+  restoreKnowledgeChunkVersion: {
+    methodKind: "unary";
+    input: typeof RestoreKnowledgeChunkVersionRequestSchema;
+    output: typeof RestoreKnowledgeChunkVersionResponseSchema;
+  },
+  /**
+   * ListDeletedKnowledgeChunks returns an SME's deleted chunks that can be restored.
+   *
+   * @generated from rpc mirai.v1.SMEService.ListDeletedKnowledgeChunks
+   */
+  listDeletedKnowledgeChunks: {
+    methodKind: "unary";
+    input: typeof ListDeletedKnowledgeChunksRequestSchema;
+    output: typeof ListDeletedKnowledgeChunksResponseSchema;
+  },
+  /**
+   * ListOutdatedLessons returns lessons generated from SME knowledge that has since changed.
+   *
+   * @generated from rpc mirai.v1.SMEService.ListOutdatedLessons
+   */
+  listOutdatedLessons: {
+    methodKind: "unary";
+    input: typeof ListOutdatedLessonsRequestSchema;
+    output: typeof ListOutdatedLessonsResponseSchema;
+  },
   /**
    * DeleteTask permanently removes a task.
    *
@@ -12,7 +12,7 @@ import { TenantSettingsService } from "./tenant_settings_pb";
 export const getAISettings = TenantSettingsService.method.getAISettings;
 
 /**
- * SetAPIKey sets the Gemini API key for the tenant.
+ * SetAPIKey validates and sets the API key for the chosen provider.
  *
  * @generated from rpc mirai.v1.TenantSettingsService.SetAPIKey
  */
@@ -38,3 +38,31 @@ export const testAPIKey = TenantSettingsService.method.testAPIKey;
  * @generated from rpc mirai.v1.TenantSettingsService.GetUsageStats
  */
 export const getUsageStats = TenantSettingsService.method.getUsageStats;
+
+/**
+ * GetLRSSettings returns the current LRS configuration.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.GetLRSSettings
+ */
+export const getLRSSettings = TenantSettingsService.method.getLRSSettings;
+
+/**
+ * SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.SetLRSSettings
+ */
+export const setLRSSettings = TenantSettingsService.method.setLRSSettings;
+
+/**
+ * RemoveLRSSettings removes the configured LRS.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.RemoveLRSSettings
+ */
+export const removeLRSSettings = TenantSettingsService.method.removeLRSSettings;
+
+/**
+ * TestLRSConnection tests if the LRS accepts the credentials.
+ *
+ * @generated from rpc mirai.v1.TenantSettingsService.TestLRSConnection
+ */
+export const testLRSConnection = TenantSettingsService.method.testLRSConnection;
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { GetAISettingsRequest, GetAISettingsResponse, GetUsageStatsRequest, GetUsageStatsResponse, RemoveAPIKeyRequest, RemoveAPIKeyResponse, SetAPIKeyRequest, SetAPIKeyResponse, TestAPIKeyRequest, TestAPIKeyResponse } from "./tenant_settings_pb.js";
+import { GetAISettingsRequest, GetAISettingsResponse, GetLRSSettingsRequest, GetLRSSettingsResponse, GetUsageStatsRequest, GetUsageStatsResponse, RemoveAPIKeyRequest, RemoveAPIKeyResponse, RemoveLRSSettingsRequest, RemoveLRSSettingsResponse, SetAPIKeyRequest, SetAPIKeyResponse, SetLRSSettingsRequest, SetLRSSettingsResponse, TestAPIKeyRequest, TestAPIKeyResponse, TestLRSConnectionRequest, TestLRSConnectionResponse } from "./tenant_settings_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -27,7 +27,7 @@ export const TenantSettingsService = {
       kind: MethodKind.Unary,
     },
     /**
-     * SetAPIKey sets the Gemini API key for the tenant.
+     * SetAPIKey validates and sets the API key for the chosen provider.
      *
      * @generated from rpc mirai.v1.TenantSettingsService.SetAPIKey
      */
@@ -70,6 +70,50 @@ export const TenantSettingsService = {
       O: GetUsageStatsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetLRSSettings returns the current LRS configuration.
+     *
+     * @generated from rpc mirai.v1.TenantSettingsService.GetLRSSettings
+     */
+    getLRSSettings: {
+      name: "GetLRSSettings",
+      I: GetLRSSettingsRequest,
+      O: GetLRSSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+     *
+     * @generated from rpc mirai.v1.TenantSettingsService.SetLRSSettings
+     */
+    setLRSSettings: {
+      name: "SetLRSSettings",
+      I: SetLRSSettingsRequest,
+      O: SetLRSSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveLRSSettings removes the configured LRS.
+     *
+     * @generated from rpc mirai.v1.TenantSettingsService.RemoveLRSSettings
+     */
+    removeLRSSettings: {
+      name: "RemoveLRSSettings",
+      I: RemoveLRSSettingsRequest,
+      O: RemoveLRSSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * TestLRSConnection tests if the LRS accepts the credentials.
+     *
+     * @generated from rpc mirai.v1.TenantSettingsService.TestLRSConnection
+     */
+    testLRSConnection: {
+      name: "TestLRSConnection",
+      I: TestLRSConnectionRequest,
+      O: TestLRSConnectionResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -12,7 +12,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file mirai/v1/tenant_settings.proto.
  */
 export const file_mirai_v1_tenant_settings: GenFile = /*@__PURE__*/
-  fileDesc("Ch5taXJhaS92MS90ZW5hbnRfc2V0dGluZ3MucHJvdG8SCG1pcmFpLnYxIqYCChBUZW5hbnRBSVNldHRpbmdzEhEKCXRlbmFudF9pZBgBIAEoCRImCghwcm92aWRlchgCIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISGgoSYXBpX2tleV9jb25maWd1cmVkGAMgASgIEhkKEXRvdGFsX3Rva2Vuc191c2VkGAQgASgDEiAKE21vbnRobHlfdG9rZW5fbGltaXQYBSABKANIAIgBARIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIfChJ1cGRhdGVkX2J5X3VzZXJfaWQYByABKAlIAYgBAUIWChRfbW9udGhseV90b2tlbl9saW1pdEIVChNfdXBkYXRlZF9ieV91c2VyX2lkIhYKFEdldEFJU2V0dGluZ3NSZXF1ZXN0IkUKFUdldEFJU2V0dGluZ3NSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiSwoQU2V0QVBJS2V5UmVxdWVzdBImCghwcm92aWRlchgBIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISDwoHYXBpX2tleRgCIAEoCSJBChFTZXRBUElLZXlSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiFQoTUmVtb3ZlQVBJS2V5UmVxdWVzdCJEChRSZW1vdmVBUElLZXlSZXNwb25zZRIsCghzZXR0aW5ncxgBIAEoCzIaLm1pcmFpLnYxLlRlbmFudEFJU2V0dGluZ3MiTAoRVGVzdEFQSUtleVJlcXVlc3QSJgoIcHJvdmlkZXIYASABKA4yFC5taXJhaS52MS5BSVByb3ZpZGVyEg8KB2FwaV9rZXkYAiABKAkiUQoSVGVzdEFQSUtleVJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEhoKDWVycm9yX21lc3NhZ2UYAiABKAlIAIgBAUIQCg5fZXJyb3JfbWVzc2FnZSKWAQoUR2V0VXNhZ2VTdGF0c1JlcXVlc3QSMgoJZnJvbV9kYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjAKB3RvX2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCDAoKX2Zyb21fZGF0ZUIKCghfdG9fZGF0ZSJHCgtVc2FnZUJ5VHlwZRIQCghqb2JfdHlwZRgBIAEoCRITCgt0b2tlbnNfdXNlZBgCIAEoAxIRCglqb2JfY291bnQYAyABKAUiqQEKFUdldFVzYWdlU3RhdHNSZXNwb25zZRIZChF0b3RhbF90b2tlbnNfdXNlZBgBIAEoAxIZChF0b2tlbnNfdGhpc19tb250aBgCIAEoAxIaCg1tb250aGx5X2xpbWl0GAMgASgDSACIAQESLAoNdXNhZ2VfYnlfdHlwZRgEIAMoCzIVLm1pcmFpLnYxLlVzYWdlQnlUeXBlQhAKDl9tb250aGx5X2xpbWl0KkEKCkFJUHJvdmlkZXISGwoXQUlfUFJPVklERVJfVU5TUEVDSUZJRUQQABIWChJBSV9QUk9WSURFUl9HRU1JTkkQATKZAwoVVGVuYW50U2V0dGluZ3NTZXJ2aWNlElAKDUdldEFJU2V0dGluZ3MSHi5taXJhaS52MS5HZXRBSVNldHRpbmdzUmVxdWVzdBofLm1pcmFpLnYxLkdldEFJU2V0dGluZ3NSZXNwb25zZRJECglTZXRBUElLZXkSGi5taXJhaS52MS5TZXRBUElLZXlSZXF1ZXN0GhsubWlyYWkudjEuU2V0QVBJS2V5UmVzcG9uc2USTQoMUmVtb3ZlQVBJS2V5Eh0ubWlyYWkudjEuUmVtb3ZlQVBJS2V5UmVxdWVzdBoeLm1pcmFpLnYxLlJlbW92ZUFQSUtleVJlc3BvbnNlEkcKClRlc3RBUElLZXkSGy5taXJhaS52MS5UZXN0QVBJS2V5UmVxdWVzdBocLm1pcmFpLnYxLlRlc3RBUElLZXlSZXNwb25zZRJQCg1HZXRVc2FnZVN0YXRzEh4ubWlyYWkudjEuR2V0VXNhZ2VTdGF0c1JlcXVlc3QaHy5taXJhaS52MS5HZXRVc2FnZVN0YXRzUmVzcG9uc2VCmQEKDGNvbS5taXJhaS52MUITVGVuYW50U2V0dGluZ3NQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
+  fileDesc("Ch5taXJhaS92MS90ZW5hbnRfc2V0dGluZ3MucHJvdG8SCG1pcmFpLnYxIosDChBUZW5hbnRBSVNldHRpbmdzEhEKCXRlbmFudF9pZBgBIAEoCRImCghwcm92aWRlchgCIAEoDjIULm1pcmFpLnYxLkFJUHJvdmlkZXISGgoSYXBpX2tleV9jb25maWd1cmVkGAMgASgIEhkKEXRvdGFsX3Rva2Vuc191c2VkGAQgASgDEiAKE21vbnRobHlfdG9rZW5fbGltaXQYBSABKANIAIgBARIuCgp1cGRhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIfChJ1cGRhdGVkX2J5X3VzZXJfaWQYByABKAlIAYgBARIVCghiYXNlX3VybBgIIAEoCUgCiAEBEhIKBW1vZGVsGAkgASgJSAOIAQESIQoZc2VtYW50aWNfc2VhcmNoX2F2YWlsYWJsZRgKIAEoCEIWChRfbW9udGhseV90b2tlbl9saW1pdEIVChNfdXBkYXRlZF9ieV91c2VyX2lkQgsKCV9iYXNlX3VybEIICgZfbW9kZWwiyAEKEVRlbmFudExSU1NldHRpbmdzEhEKCXRlbmFudF9pZBgBIAEoCRIQCghlbmRwb2ludBgCIAEoCRILCgNrZXkYAyABKAkSGQoRc2VjcmV0X2NvbmZpZ3VyZWQYBCABKAgSLgoKdXBkYXRlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASHwoSdXBkYXRlZF9ieV91c2VyX2lkGAYgASgJSACIAQFCFQoTX3VwZGF0ZWRfYnlfdXNlcl9pZCIWChRHZXRBSVNldHRpbmdzUmVxdWVzdCJFChVHZXRBSVNldHRpbmdzUmVzcG9uc2USLAoIc2V0dGluZ3MYASABKAsyGi5taXJhaS52MS5UZW5hbnRBSVNldHRpbmdzIo0BChBTZXRBUElLZXlSZXF1ZXN0EiYKCHByb3ZpZGVyGAEgASgOMhQubWlyYWkudjEuQUlQcm92aWRlchIPCgdhcGlfa2V5GAIgASgJEhUKCGJhc2VfdXJsGAMgASgJSACIAQESEgoFbW9kZWwYBCABKAlIAYgBAUILCglfYmFzZV91cmxCCAoGX21vZGVsIkEKEVNldEFQSUtleVJlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyIVChNSZW1vdmVBUElLZXlSZXF1ZXN0IkQKFFJlbW92ZUFQSUtleVJlc3BvbnNlEiwKCHNldHRpbmdzGAEgASgLMhoubWlyYWkudjEuVGVuYW50QUlTZXR0aW5ncyKOAQoRVGVzdEFQSUtleVJlcXVlc3QSJgoIcHJvdmlkZXIYASABKA4yFC5taXJhaS52MS5BSVByb3ZpZGVyEg8KB2FwaV9rZXkYAiABKAkSFQoIYmFzZV91cmwYAyABKAlIAIgBARISCgVtb2RlbBgEIAEoCUgBiAEBQgsKCV9iYXNlX3VybEIICgZfbW9kZWwiUQoSVGVzdEFQSUtleVJlc3BvbnNlEg0KBXZhbGlkGAEgASgIEhoKDWVycm9yX21lc3NhZ2UYAiABKAlIAIgBAUIQCg5fZXJyb3JfbWVzc2FnZSKWAQoUR2V0VXNhZ2VTdGF0c1JlcXVlc3QSMgoJZnJvbV9kYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEjAKB3RvX2RhdGUYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wSAGIAQFCDAoKX2Zyb21fZGF0ZUIKCghfdG9fZGF0ZSJHCgtVc2FnZUJ5VHlwZRIQCghqb2JfdHlwZRgBIAEoCRITCgt0b2tlbnNfdXNlZBgCIAEoAxIRCglqb2JfY291bnQYAyABKAUiSwoKRGFpbHlVc2FnZRIoCgRkYXRlGAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBITCgt0b2tlbnNfdXNlZBgCIAEoAyL4AQoVR2V0VXNhZ2VTdGF0c1Jlc3BvbnNlEhkKEXRvdGFsX3Rva2Vuc191c2VkGAEgASgDEhkKEXRva2Vuc190aGlzX21vbnRoGAIgASgDEhoKDW1vbnRobHlfbGltaXQYAyABKANIAIgBARIsCg11c2FnZV9ieV90eXBlGAQgAygLMhUubWlyYWkudjEuVXNhZ2VCeVR5cGUSKQoLZGFpbHlfdXNhZ2UYBSADKAsyFC5taXJhaS52MS5EYWlseVVzYWdlEiIKGnByb2plY3RlZF9tb250aF9lbmRfdG9rZW5zGAYgASgDQhAKDl9tb250aGx5X2xpbWl0IhcKFUdldExSU1NldHRpbmdzUmVxdWVzdCJHChZHZXRMUlNTZXR0aW5nc1Jlc3BvbnNlEi0KCHNldHRpbmdzGAEgASgLMhsubWlyYWkudjEuVGVuYW50TFJTU2V0dGluZ3MiRgoVU2V0TFJTU2V0dGluZ3NSZXF1ZXN0EhAKCGVuZHBvaW50GAEgASgJEgsKA2tleRgCIAEoCRIOCgZzZWNyZXQYAyABKAkiRwoWU2V0TFJTU2V0dGluZ3NSZXNwb25zZRItCghzZXR0aW5ncxgBIAEoCzIbLm1pcmFpLnYxLlRlbmFudExSU1NldHRpbmdzIhoKGFJlbW92ZUxSU1NldHRpbmdzUmVxdWVzdCIbChlSZW1vdmVMUlNTZXR0aW5nc1Jlc3BvbnNlIngKGFRlc3RMUlNDb25uZWN0aW9uUmVxdWVzdBIVCghlbmRwb2ludBgBIAEoCUgAiAEBEhAKA2tleRgCIAEoCUgBiAEBEhMKBnNlY3JldBgDIAEoCUgCiAEBQgsKCV9lbmRwb2ludEIGCgRfa2V5QgkKB19zZWNyZXQiWAoZVGVzdExSU0Nvbm5lY3Rpb25SZXNwb25zZRINCgV2YWxpZBgBIAEoCBIaCg1lcnJvcl9tZXNzYWdlGAIgASgJSACIAQFCEAoOX2Vycm9yX21lc3NhZ2UqlwEKCkFJUHJvdmlkZXISGwoXQUlfUFJPVklERVJfVU5TUEVDSUZJRUQQABIWChJBSV9QUk9WSURFUl9HRU1JTkkQARIWChJBSV9QUk9WSURFUl9PUEVOQUkQAhIZChVBSV9QUk9WSURFUl9BTlRIUk9QSUMQAxIhCh1BSV9QUk9WSURFUl9PUEVOQUlfQ09NUEFUSUJMRRAEMv8FChVUZW5hbnRTZXR0aW5nc1NlcnZpY2USUAoNR2V0QUlTZXR0aW5ncxIeLm1pcmFpLnYxLkdldEFJU2V0dGluZ3NSZXF1ZXN0Gh8ubWlyYWkudjEuR2V0QUlTZXR0aW5nc1Jlc3BvbnNlEkQKCVNldEFQSUtleRIaLm1pcmFpLnYxLlNldEFQSUtleVJlcXVlc3QaGy5taXJhaS52MS5TZXRBUElLZXlSZXNwb25zZRJNCgxSZW1vdmVBUElLZXkSHS5taXJhaS52MS5SZW1vdmVBUElLZXlSZXF1ZXN0Gh4ubWlyYWkudjEuUmVtb3ZlQVBJS2V5UmVzcG9uc2USRwoKVGVzdEFQSUtleRIbLm1pcmFpLnYxLlRlc3RBUElLZXlSZXF1ZXN0GhwubWlyYWkudjEuVGVzdEFQSUtleVJlc3BvbnNlElAKDUdldFVzYWdlU3RhdHMSHi5taXJhaS52MS5HZXRVc2FnZVN0YXRzUmVxdWVzdBofLm1pcmFpLnYxLkdldFVzYWdlU3RhdHNSZXNwb25zZRJTCg5HZXRMUlNTZXR0aW5ncxIfLm1pcmFpLnYxLkdldExSU1NldHRpbmdzUmVxdWVzdBogLm1pcmFpLnYxLkdldExSU1NldHRpbmdzUmVzcG9uc2USUwoOU2V0TFJTU2V0dGluZ3MSHy5taXJhaS52MS5TZXRMUlNTZXR0aW5nc1JlcXVlc3QaIC5taXJhaS52MS5TZXRMUlNTZXR0aW5nc1Jlc3BvbnNlElwKEVJlbW92ZUxSU1NldHRpbmdzEiIubWlyYWkudjEuUmVtb3ZlTFJTU2V0dGluZ3NSZXF1ZXN0GiMubWlyYWkudjEuUmVtb3ZlTFJTU2V0dGluZ3NSZXNwb25zZRJcChFUZXN0TFJTQ29ubmVjdGlvbhIiLm1pcmFpLnYxLlRlc3RMUlNDb25uZWN0aW9uUmVxdWVzdBojLm1pcmFpLnYxLlRlc3RMUlNDb25uZWN0aW9uUmVzcG9uc2VCmQEKDGNvbS5taXJhaS52MUITVGVuYW50U2V0dGluZ3NQcm90b1ABWjNnaXRodWIuY29tL3NvZ29zL21pcmFpLWJhY2tlbmQvZ2VuL21pcmFpL3YxO21pcmFpdjGiAgNNWFiqAghNaXJhaS5WMcoCCE1pcmFpXFYx4gIUTWlyYWlcVjFcR1BCTWV0YWRhdGHqAglNaXJhaTo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp]);
 
 /**
  * TenantAISettings contains AI configuration for a tenant.
@@ -59,6 +59,25 @@ export type TenantAISettings = Message<"mirai.v1.TenantAISettings"> & {
    * @generated from field: optional string updated_by_user_id = 7;
    */
   updatedByUserId?: string;
+
+  /**
+   * Self-hosted provider connection (AI_PROVIDER_OPENAI_COMPATIBLE only)
+   *
+   * @generated from field: optional string base_url = 8;
+   */
+  baseUrl?: string;
+
+  /**
+   * @generated from field: optional string model = 9;
+   */
+  model?: string;
+
+  /**
+   * False when the provider has no embeddings API; SME knowledge is then searched by keywords only
+   *
+   * @generated from field: bool semantic_search_available = 10;
+   */
+  semanticSearchAvailable: boolean;
 };
 
 /**
@@ -68,6 +87,57 @@ export type TenantAISettings = Message<"mirai.v1.TenantAISettings"> & {
 export const TenantAISettingsSchema: GenMessage<TenantAISettings> = /*@__PURE__*/
   messageDesc(file_mirai_v1_tenant_settings, 0);
 
+/**
+ * TenantLRSSettings contains the xAPI Learning Record Store used by cmi5 exports.
+ * Only ADMIN/OWNER roles can access these settings.
+ *
+ * @generated from message mirai.v1.TenantLRSSettings
+ */
+export type TenantLRSSettings = Message<"mirai.v1.TenantLRSSettings"> & {
+  /**
+   * @generated from field: string tenant_id = 1;
+   */
+  tenantId: string;
+
+  /**
+   * xAPI endpoint, e.g. https://lrs.example.com/xapi/
+   *
+   * @generated from field: string endpoint = 2;
+   */
+  endpoint: string;
+
+  /**
+   * Basic auth key (username)
+   *
+   * @generated from field: string key = 3;
+   */
+  key: string;
+
+  /**
+   * True if secret is set (never expose actual secret)
+   *
+   * @generated from field: bool secret_configured = 4;
+   */
+  secretConfigured: boolean;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 5;
+   */
+  updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional string updated_by_user_id = 6;
+   */
+  updatedByUserId?: string;
+};
+
+/**
+ * Describes the message mirai.v1.TenantLRSSettings.
+ * Use `create(TenantLRSSettingsSchema)` to create a new message.
+ */
+export const TenantLRSSettingsSchema: GenMessage<TenantLRSSettings> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 1);
+
 /**
  * GetAISettingsRequest is empty as tenant is from auth context.
  *
@@ -81,7 +151,7 @@ export type GetAISettingsRequest = Message<"mirai.v1.GetAISettingsRequest"> & {
  * Use `create(GetAISettingsRequestSchema)` to create a new message.
  */
 export const GetAISettingsRequestSchema: GenMessage<GetAISettingsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 1);
+  messageDesc(file_mirai_v1_tenant_settings, 2);
 
 /**
  * GetAISettingsResponse contains the AI settings.
@@ -100,7 +170,7 @@ export type GetAISettingsResponse = Message<"mirai.v1.GetAISettingsResponse"> &
  * Use `create(GetAISettingsResponseSchema)` to create a new message.
  */
 export const GetAISettingsResponseSchema: GenMessage<GetAISettingsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 2);
+  messageDesc(file_mirai_v1_tenant_settings, 3);
 
 /**
  * SetAPIKeyRequest contains the API key to set.
@@ -114,11 +184,25 @@ export type SetAPIKeyRequest = Message<"mirai.v1.SetAPIKeyRequest"> & {
   provider: AIProvider;
 
   /**
-   * Plain text, will be encrypted server-side
+   * Plain text, will be encrypted server-side; optional for self-hosted
    *
    * @generated from field: string api_key = 2;
    */
   apiKey: string;
+
+  /**
+   * Required for AI_PROVIDER_OPENAI_COMPATIBLE
+   *
+   * @generated from field: optional string base_url = 3;
+   */
+  baseUrl?: string;
+
+  /**
+   * Required for AI_PROVIDER_OPENAI_COMPATIBLE
+   *
+   * @generated from field: optional string model = 4;
+   */
+  model?: string;
 };
 
 /**
@@ -126,7 +210,7 @@ export type SetAPIKeyRequest = Message<"mirai.v1.SetAPIKeyRequest"> & {
  * Use `create(SetAPIKeyRequestSchema)` to create a new message.
  */
 export const SetAPIKeyRequestSchema: GenMessage<SetAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 3);
+  messageDesc(file_mirai_v1_tenant_settings, 4);
 
 /**
  * SetAPIKeyResponse confirms the key was set.
@@ -145,7 +229,7 @@ export type SetAPIKeyResponse = Message<"mirai.v1.SetAPIKeyResponse"> & {
  * Use `create(SetAPIKeyResponseSchema)` to create a new message.
  */
 export const SetAPIKeyResponseSchema: GenMessage<SetAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 4);
+  messageDesc(file_mirai_v1_tenant_settings, 5);
 
 /**
  * RemoveAPIKeyRequest removes the API key.
@@ -160,7 +244,7 @@ export type RemoveAPIKeyRequest = Message<"mirai.v1.RemoveAPIKeyRequest"> & {
  * Use `create(RemoveAPIKeyRequestSchema)` to create a new message.
  */
 export const RemoveAPIKeyRequestSchema: GenMessage<RemoveAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 5);
+  messageDesc(file_mirai_v1_tenant_settings, 6);
 
 /**
  * RemoveAPIKeyResponse confirms removal.
@@ -179,10 +263,11 @@ export type RemoveAPIKeyResponse = Message<"mirai.v1.RemoveAPIKeyResponse"> & {
  * Use `create(RemoveAPIKeyResponseSchema)` to create a new message.
  */
 export const RemoveAPIKeyResponseSchema: GenMessage<RemoveAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 6);
+  messageDesc(file_mirai_v1_tenant_settings, 7);
 
 /**
  * TestAPIKeyRequest tests an API key without saving.
+ * If api_key and base_url are empty, the stored configuration is tested.
  *
  * @generated from message mirai.v1.TestAPIKeyRequest
  */
@@ -196,6 +281,16 @@ export type TestAPIKeyRequest = Message<"mirai.v1.TestAPIKeyRequest"> & {
    * @generated from field: string api_key = 2;
    */
   apiKey: string;
+
+  /**
+   * @generated from field: optional string base_url = 3;
+   */
+  baseUrl?: string;
+
+  /**
+   * @generated from field: optional string model = 4;
+   */
+  model?: string;
 };
 
 /**
@@ -203,7 +298,7 @@ export type TestAPIKeyRequest = Message<"mirai.v1.TestAPIKeyRequest"> & {
  * Use `create(TestAPIKeyRequestSchema)` to create a new message.
  */
 export const TestAPIKeyRequestSchema: GenMessage<TestAPIKeyRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 7);
+  messageDesc(file_mirai_v1_tenant_settings, 8);
 
 /**
  * TestAPIKeyResponse indicates if the key is valid.
@@ -227,7 +322,7 @@ export type TestAPIKeyResponse = Message<"mirai.v1.TestAPIKeyResponse"> & {
  * Use `create(TestAPIKeyResponseSchema)` to create a new message.
  */
 export const TestAPIKeyResponseSchema: GenMessage<TestAPIKeyResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 8);
+  messageDesc(file_mirai_v1_tenant_settings, 9);
 
 /**
  * GetUsageStatsRequest fetches usage statistics.
@@ -251,7 +346,7 @@ export type GetUsageStatsRequest = Message<"mirai.v1.GetUsageStatsRequest"> & {
  * Use `create(GetUsageStatsRequestSchema)` to create a new message.
  */
 export const GetUsageStatsRequestSchema: GenMessage<GetUsageStatsRequest> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 9);
+  messageDesc(file_mirai_v1_tenant_settings, 10);
 
 /**
  * UsageByType breaks down usage by job type.
@@ -280,10 +375,35 @@ export type UsageByType = Message<"mirai.v1.UsageByType"> & {
  * Use `create(UsageByTypeSchema)` to create a new message.
  */
 export const UsageByTypeSchema: GenMessage<UsageByType> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 10);
+  messageDesc(file_mirai_v1_tenant_settings, 11);
+
+/**
+ * DailyUsage is the tokens used on one day (UTC).
+ *
+ * @generated from message mirai.v1.DailyUsage
+ */
+export type DailyUsage = Message<"mirai.v1.DailyUsage"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp date = 1;
+   */
+  date?: Timestamp;
+
+  /**
+   * @generated from field: int64 tokens_used = 2;
+   */
+  tokensUsed: bigint;
+};
+
+/**
+ * Describes the message mirai.v1.DailyUsage.
+ * Use `create(DailyUsageSchema)` to create a new message.
+ */
+export const DailyUsageSchema: GenMessage<DailyUsage> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 12);
 
 /**
  * GetUsageStatsResponse contains usage statistics.
+ * Monthly figures cover the current calendar month (UTC).
  *
  * @generated from message mirai.v1.GetUsageStatsResponse
  */
@@ -304,9 +424,25 @@ export type GetUsageStatsResponse = Message<"mirai.v1.GetUsageStatsResponse"> &
   monthlyLimit?: bigint;
 
   /**
+   * This month, by job type
+   *
    * @generated from field: repeated mirai.v1.UsageByType usage_by_type = 4;
    */
   usageByType: UsageByType[];
+
+  /**
+   * This month, days without usage omitted
+   *
+   * @generated from field: repeated mirai.v1.DailyUsage daily_usage = 5;
+   */
+  dailyUsage: DailyUsage[];
+
+  /**
+   * Month-to-date usage extrapolated to month end
+   *
+   * @generated from field: int64 projected_month_end_tokens = 6;
+   */
+  projectedMonthEndTokens: bigint;
 };
 
 /**
@@ -314,7 +450,175 @@ export type GetUsageStatsResponse = Message<"mirai.v1.GetUsageStatsResponse"> &
  * Use `create(GetUsageStatsResponseSchema)` to create a new message.
  */
 export const GetUsageStatsResponseSchema: GenMessage<GetUsageStatsResponse> = /*@__PURE__*/
-  messageDesc(file_mirai_v1_tenant_settings, 11);
+  messageDesc(file_mirai_v1_tenant_settings, 13);
+
+/**
+ * GetLRSSettingsRequest is empty as tenant is from auth context.
+ *
+ * @generated from message mirai.v1.GetLRSSettingsRequest
+ */
+export type GetLRSSettingsRequest = Message<"mirai.v1.GetLRSSettingsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.GetLRSSettingsRequest.
+ * Use `create(GetLRSSettingsRequestSchema)` to create a new message.
+ */
+export const GetLRSSettingsRequestSchema: GenMessage<GetLRSSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 14);
+
+/**
+ * GetLRSSettingsResponse contains the LRS settings.
+ *
+ * @generated from message mirai.v1.GetLRSSettingsResponse
+ */
+export type GetLRSSettingsResponse = Message<"mirai.v1.GetLRSSettingsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantLRSSettings settings = 1;
+   */
+  settings?: TenantLRSSettings;
+};
+
+/**
+ * Describes the message mirai.v1.GetLRSSettingsResponse.
+ * Use `create(GetLRSSettingsResponseSchema)` to create a new message.
+ */
+export const GetLRSSettingsResponseSchema: GenMessage<GetLRSSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 15);
+
+/**
+ * SetLRSSettingsRequest contains the LRS endpoint and credentials to set.
+ *
+ * @generated from message mirai.v1.SetLRSSettingsRequest
+ */
+export type SetLRSSettingsRequest = Message<"mirai.v1.SetLRSSettingsRequest"> & {
+  /**
+   * @generated from field: string endpoint = 1;
+   */
+  endpoint: string;
+
+  /**
+   * @generated from field: string key = 2;
+   */
+  key: string;
+
+  /**
+   * Plain text, encrypted server-side; empty keeps the current secret
+   *
+   * @generated from field: string secret = 3;
+   */
+  secret: string;
+};
+
+/**
+ * Describes the message mirai.v1.SetLRSSettingsRequest.
+ * Use `create(SetLRSSettingsRequestSchema)` to create a new message.
+ */
+export const SetLRSSettingsRequestSchema: GenMessage<SetLRSSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 16);
+
+/**
+ * SetLRSSettingsResponse confirms the settings were saved.
+ *
+ * @generated from message mirai.v1.SetLRSSettingsResponse
+ */
+export type SetLRSSettingsResponse = Message<"mirai.v1.SetLRSSettingsResponse"> & {
+  /**
+   * @generated from field: mirai.v1.TenantLRSSettings settings = 1;
+   */
+  settings?: TenantLRSSettings;
+};
+
+/**
+ * Describes the message mirai.v1.SetLRSSettingsResponse.
+ * Use `create(SetLRSSettingsResponseSchema)` to create a new message.
+ */
+export const SetLRSSettingsResponseSchema: GenMessage<SetLRSSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 17);
+
+/**
+ * RemoveLRSSettingsRequest removes the LRS configuration.
+ *
+ * @generated from message mirai.v1.RemoveLRSSettingsRequest
+ */
+export type RemoveLRSSettingsRequest = Message<"mirai.v1.RemoveLRSSettingsRequest"> & {
+};
+
+/**
+ * Describes the message mirai.v1.RemoveLRSSettingsRequest.
+ * Use `create(RemoveLRSSettingsRequestSchema)` to create a new message.
+ */
+export const RemoveLRSSettingsRequestSchema: GenMessage<RemoveLRSSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 18);
+
+/**
+ * RemoveLRSSettingsResponse confirms removal.
+ *
+ * @generated from message mirai.v1.RemoveLRSSettingsResponse
+ */
+export type RemoveLRSSettingsResponse = Message<"mirai.v1.RemoveLRSSettingsResponse"> & {
+};
+
+/**
+ * Describes the message mirai.v1.RemoveLRSSettingsResponse.
+ * Use `create(RemoveLRSSettingsResponseSchema)` to create a new message.
+ */
+export const RemoveLRSSettingsResponseSchema: GenMessage<RemoveLRSSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 19);
+
+/**
+ * TestLRSConnectionRequest tests LRS settings without saving.
+ * When endpoint is omitted the stored settings are tested.
+ *
+ * @generated from message mirai.v1.TestLRSConnectionRequest
+ */
+export type TestLRSConnectionRequest = Message<"mirai.v1.TestLRSConnectionRequest"> & {
+  /**
+   * @generated from field: optional string endpoint = 1;
+   */
+  endpoint?: string;
+
+  /**
+   * @generated from field: optional string key = 2;
+   */
+  key?: string;
+
+  /**
+   * @generated from field: optional string secret = 3;
+   */
+  secret?: string;
+};
+
+/**
+ * Describes the message mirai.v1.TestLRSConnectionRequest.
+ * Use `create(TestLRSConnectionRequestSchema)` to create a new message.
+ */
+export const TestLRSConnectionRequestSchema: GenMessage<TestLRSConnectionRequest> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 20);
+
+/**
+ * TestLRSConnectionResponse indicates if the LRS accepted the connection.
+ *
+ * @generated from message mirai.v1.TestLRSConnectionResponse
+ */
+export type TestLRSConnectionResponse = Message<"mirai.v1.TestLRSConnectionResponse"> & {
+  /**
+   * @generated from field: bool valid = 1;
+   */
+  valid: boolean;
+
+  /**
+   * @generated from field: optional string error_message = 2;
+   */
+  errorMessage?: string;
+};
+
+/**
+ * Describes the message mirai.v1.TestLRSConnectionResponse.
+ * Use `create(TestLRSConnectionResponseSchema)` to create a new message.
+ */
+export const TestLRSConnectionResponseSchema: GenMessage<TestLRSConnectionResponse> = /*@__PURE__*/
+  messageDesc(file_mirai_v1_tenant_settings, 21);
 
 /**
  * AIProvider represents supported AI providers.
@@ -331,6 +635,23 @@ export enum AIProvider {
    * @generated from enum value: AI_PROVIDER_GEMINI = 1;
    */
   AI_PROVIDER_GEMINI = 1,
+
+  /**
+   * @generated from enum value: AI_PROVIDER_OPENAI = 2;
+   */
+  AI_PROVIDER_OPENAI = 2,
+
+  /**
+   * @generated from enum value: AI_PROVIDER_ANTHROPIC = 3;
+   */
+  AI_PROVIDER_ANTHROPIC = 3,
+
+  /**
+   * Self-hosted server with an OpenAI-compatible API (Ollama, vLLM)
+   *
+   * @generated from enum value: AI_PROVIDER_OPENAI_COMPATIBLE = 4;
+   */
+  AI_PROVIDER_OPENAI_COMPATIBLE = 4,
 }
 
 /**
@@ -357,7 +678,7 @@ export const TenantSettingsService: GenService<{
     output: typeof GetAISettingsResponseSchema;
   },
   /**
-   * SetAPIKey sets the Gemini API key for the tenant.
+   * SetAPIKey validates and sets the API key for the chosen provider.
    *
    * @generated from rpc mirai.v1.TenantSettingsService.SetAPIKey
    */
@@ -396,6 +717,46 @@ export const TenantSettingsService: GenService<{
     input: typeof GetUsageStatsRequestSchema;
     output: typeof GetUsageStatsResponseSchema;
   },
+  /**
+   * GetLRSSettings returns the current LRS configuration.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.GetLRSSettings
+   */
+  getLRSSettings: {
+    methodKind: "unary";
+    input: typeof GetLRSSettingsRequestSchema;
+    output: typeof GetLRSSettingsResponseSchema;
+  },
+  /**
+   * SetLRSSettings sets the LRS endpoint and credentials for the tenant.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.SetLRSSettings
+   */
+  setLRSSettings: {
+    methodKind: "unary";
+    input: typeof SetLRSSettingsRequestSchema;
+    output: typeof SetLRSSettingsResponseSchema;
+  },
+  /**
+   * RemoveLRSSettings removes the configured LRS.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.RemoveLRSSettings
+   */
+  removeLRSSettings: {
+    methodKind: "unary";
+    input: typeof RemoveLRSSettingsRequestSchema;
+    output: typeof RemoveLRSSettingsResponseSchema;
+  },
+  /**
+   * TestLRSConnection tests if the LRS accepts the credentials.
+   *
+   * @generated from rpc mirai.v1.TenantSettingsService.TestLRSConnection
+   */
+  testLRSConnection: {
+    methodKind: "unary";
+    input: typeof TestLRSConnectionRequestSchema;
+    output: typeof TestLRSConnectionResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_mirai_v1_tenant_settings, 0);
 
@@ -12,8 +12,11 @@ import {
   getJob,
   listJobs,
   cancelJob,
+  resumeJob,
   getGeneratedLesson,
   listGeneratedLessons,
+  listStaleLessons,
+  regenerateStaleLessons,
 } from '@/gen/mirai/v1/ai_generation-AIGenerationService_connectquery';
 import {
   listNotifications,
@@ -36,8 +39,10 @@ import {
   RejectCourseOutlineRequestSchema,
   UpdateCourseOutlineRequestSchema,
   GenerateAllLessonsRequestSchema,
+  RegenerateStaleLessonsRequestSchema,
   RegenerateComponentRequestSchema,
   CancelJobRequestSchema,
+  ResumeJobRequestSchema,
   CourseGenerationInputSchema,
 } from '@/gen/mirai/v1/ai_generation_pb';
 
@@ -218,6 +223,26 @@ export function useGenerateAllLessons() {
   };
 }
 
+/**
+ * Hook to regenerate the stale lessons of a course.
+ */
+export function useRegenerateStaleLessons() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(regenerateStaleLessons);
+
+  return {
+    mutate: async (courseId: string) => {
+      const request = create(RegenerateStaleLessonsRequestSchema, { courseId });
+
+      const result = await mutation.mutateAsync(request);
+      await invalidateJobQueries(queryClient);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to regenerate a component.
  */
@@ -284,12 +309,30 @@ export function useGetJob(
 
   return {
     data: query.data?.job,
+    // Place among all queued jobs while the job waits (0 otherwise)
+    queuePosition: query.data?.queuePosition ?? 0,
+    queueDepth: query.data?.queueDepth ?? 0,
     isLoading: query.isLoading,
     error: query.error,
     refetch: query.refetch,
   };
 }
 
+/**
+ * Formats a queue position for display, e.g. "3rd in queue".
+ * Returns undefined when the job is not queued.
+ */
+export function formatQueuePosition(position: number): string | undefined {
+  if (position <= 0) return undefined;
+  if (position === 1) return 'Next in queue';
+  const tens = position % 100;
+  const suffix =
+    tens >= 11 && tens <= 13
+      ? 'th'
+      : ({ 1: 'st', 2: 'nd', 3: 'rd' } as Record<number, string>)[position % 10] ?? 'th';
+  return `${position}${suffix} in queue`;
+}
+
 /**
  * Hook to cancel a job.
  */
@@ -309,6 +352,25 @@ export function useCancelJob() {
   };
 }
 
+/**
+ * Hook to resume a failed course generation job from its unfinished lessons.
+ */
+export function useResumeJob() {
+  const queryClient = useQueryClient();
+  const mutation = useMutation(resumeJob);
+
+  return {
+    mutate: async (jobId: string) => {
+      const request = create(ResumeJobRequestSchema, { jobId });
+      const result = await mutation.mutateAsync(request);
+      await invalidateJobQueries(queryClient);
+      return result;
+    },
+    isLoading: mutation.isPending,
+    error: mutation.error,
+  };
+}
+
 /**
  * Hook to get a generated lesson.
  */
@@ -345,6 +407,24 @@ export function useListGeneratedLessons(courseId: string | undefined) {
   };
 }
 
+/**
+ * Hook to list the stale lessons of a course.
+ */
+export function useListStaleLessons(courseId: string | undefined) {
+  const query = useQuery(
+    listStaleLessons,
+    courseId ? { courseId } : undefined,
+    { enabled: !!courseId }
+  );
+
+  return {
+    data: query.data?.lessons ?? [],
+    isLoading: query.isLoading,
+    error: query.error,
+    refetch: query.refetch,
+  };
+}
+
 /**
  * Hook to get active generation jobs (queued or processing).
  * Uses adaptive polling: 3 seconds when jobs are active, 30 seconds idle.
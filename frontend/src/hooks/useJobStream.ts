@@ -0,0 +1,125 @@
+import { useEffect, useState } from 'react';
+import { useQueryClient } from '@tanstack/react-query';
+import { createConnectQueryKey } from '@connectrpc/connect-query';
+import { createClient } from '@connectrpc/connect';
+import { create } from '@bufbuild/protobuf';
+import { transport } from '@/lib/connect';
+import {
+  AIGenerationService,
+  GenerationJobEventType,
+  GenerationJobStatus,
+  WatchJobRequestSchema,
+  type GenerationJob,
+  type OutlineSection,
+  type GeneratedLesson,
+} from '@/gen/mirai/v1/ai_generation_pb';
+import {
+  getJob,
+  listJobs,
+  getCourseOutline,
+  listGeneratedLessons,
+} from '@/gen/mirai/v1/ai_generation-AIGenerationService_connectquery';
+
+export interface JobStreamState {
+  job?: GenerationJob;
+  children: Record<string, GenerationJob>;
+  sections: OutlineSection[];
+  lessons: GeneratedLesson[];
+  isStreaming: boolean;
+}
+
+const initialState: JobStreamState = {
+  children: {},
+  sections: [],
+  lessons: [],
+  isStreaming: false,
+};
+
+function isFinished(job: GenerationJob) {
+  return (
+    job.status === GenerationJobStatus.COMPLETED ||
+    job.status === GenerationJobStatus.FAILED ||
+    job.status === GenerationJobStatus.CANCELLED
+  );
+}
+
+/**
+ * Hook that streams a generation job's progress, finished outline sections and
+ * lessons as they are produced. The stream closes once the job finishes.
+ */
+export function useJobStream(jobId: string | undefined): JobStreamState {
+  const queryClient = useQueryClient();
+  const [state, setState] = useState<JobStreamState>(initialState);
+
+  useEffect(() => {
+    setState(initialState);
+    if (!jobId) return;
+
+    const client = createClient(AIGenerationService, transport);
+    const abortController = new AbortController();
+
+    const run = async () => {
+      setState((prev) => ({ ...prev, isStreaming: true }));
+      try {
+        const request = create(WatchJobRequestSchema, { jobId });
+        for await (const event of client.watchJob(request, {
+          signal: abortController.signal,
+        })) {
+          if (event.eventType === GenerationJobEventType.KEEPALIVE) {
+            continue;
+          }
+
+          setState((prev) => {
+            const next = { ...prev };
+            if (event.job) {
+              if (event.job.id === jobId) {
+                next.job = event.job;
+              } else {
+                next.children = { ...prev.children, [event.job.id]: event.job };
+              }
+            }
+            if (event.eventType === GenerationJobEventType.SECTION_READY && event.section) {
+              next.sections = [...prev.sections, event.section];
+            }
+            if (event.eventType === GenerationJobEventType.LESSON_READY && event.lesson) {
+              next.lessons = [...prev.lessons, event.lesson];
+            }
+            return next;
+          });
+
+          if (event.job?.id === jobId && isFinished(event.job)) {
+            // Refresh cached job and content queries with the final results
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: getJob, cardinality: undefined }),
+            });
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: listJobs, cardinality: undefined }),
+            });
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: getCourseOutline, cardinality: undefined }),
+            });
+            queryClient.invalidateQueries({
+              queryKey: createConnectQueryKey({ schema: listGeneratedLessons, cardinality: undefined }),
+            });
+          }
+        }
+      } catch (err) {
+        if (!(err instanceof Error && err.name === 'AbortError')) {
+          console.error('Job stream error:', err);
+        }
+      } finally {
+        if (!abortController.signal.aborted) {
+          setState((prev) => ({ ...prev, isStreaming: false }));
+        }
+      }
+    };
+
+    run();
+
+    return () => {
+      abortController.abort();
+    };
+  }, [jobId, queryClient]);
+
+  return state;
+}
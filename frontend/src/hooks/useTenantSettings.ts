@@ -49,8 +49,8 @@ export function useSetAPIKey() {
   const mutation = useMutation(setAPIKey);
 
   return {
-    mutate: async (apiKey: string) => {
-      const request = create(SetAPIKeyRequestSchema, { apiKey });
+    mutate: async (provider: AIProvider, apiKey: string, connection?: { baseUrl: string; model: string }) => {
+      const request = create(SetAPIKeyRequestSchema, { provider, apiKey, ...connection });
       const result = await mutation.mutateAsync(request);
       // Invalidate AI settings query using the proper connect-query key
       await Promise.all([
@@ -104,8 +104,8 @@ export function useTestAPIKey() {
   const mutation = useMutation(testAPIKey);
 
   return {
-    mutate: async (apiKey: string) => {
-      const request = create(TestAPIKeyRequestSchema, { apiKey });
+    mutate: async (provider: AIProvider, apiKey: string, connection?: { baseUrl: string; model: string }) => {
+      const request = create(TestAPIKeyRequestSchema, { provider, apiKey, ...connection });
       return await mutation.mutateAsync(request);
     },
     isLoading: mutation.isPending,
@@ -126,6 +126,8 @@ export function useGetUsageStats() {
       tokensThisMonth: query.data.tokensThisMonth,
       monthlyLimit: query.data.monthlyLimit,
       usageByType: query.data.usageByType,
+      dailyUsage: query.data.dailyUsage,
+      projectedMonthEndTokens: query.data.projectedMonthEndTokens,
     } : undefined,
     isLoading: query.isLoading,
     error: query.error,